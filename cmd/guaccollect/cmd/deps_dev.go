@@ -69,7 +69,7 @@ var depsDevCmd = &cobra.Command{
 		}
 
 		// Register collector
-		depsDevCollector, err := deps_dev.NewDepsCollector(ctx, opts.dataSource, opts.poll, opts.retrieveDependencies, 30*time.Second)
+		depsDevCollector, err := deps_dev.NewDepsCollector(ctx, opts.dataSource, opts.poll, opts.retrieveDependencies, 30*time.Second, deps_dev.WithStateStore(getStateStore(ctx)))
 		if err != nil {
 			logger.Errorf("unable to register oci collector: %v", err)
 		}
@@ -29,6 +29,8 @@ import (
 	"github.com/guacsec/guac/pkg/handler/collector/file"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -61,7 +63,7 @@ var filesCmd = &cobra.Command{
 		logger := logging.FromContext(ctx)
 
 		// Register collector
-		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second)
+		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second, file.WithStateStore(getStateStore(ctx)))
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Errorf("unable to register file collector: %v", err)
@@ -85,18 +87,34 @@ func validateFilesFlags(natsAddr string, poll bool, args []string) (filesOptions
 	return opts, nil
 }
 
-func getCollectorPublish(ctx context.Context) (func(*processor.Document) error, error) {
-	return func(d *processor.Document) error {
-		return collector.Publish(ctx, d)
-	}, nil
+func getCollectorPublish(ctx context.Context) (collector.Emitter, error) {
+	return collector.Publish, nil
 }
 
 func initializeNATsandCollector(ctx context.Context, natsAddr string) {
 	logger := logging.FromContext(ctx)
+
+	shutdownTracing, err := tracing.Setup(ctx, "guaccollect")
+	if err != nil {
+		logger.Errorf("unable to set up tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Errorf("unable to flush traces: %v", err)
+		}
+	}()
+	if port := viper.GetInt("metrics-port"); port != 0 {
+		ctx, err = metrics.ServePipeline(ctx, port)
+		if err != nil {
+			logger.Errorf("unable to set up metrics: %v", err)
+			os.Exit(1)
+		}
+	}
+
 	// initialize jetstream
 	// TODO: pass in credentials file for NATS secure login
 	jetStream := emitter.NewJetStream(natsAddr, "", "")
-	ctx, err := jetStream.JetStreamInit(ctx)
+	ctx, err = jetStream.JetStreamInit(ctx)
 	if err != nil {
 		logger.Errorf("jetStream initialization failed with error: %v", err)
 		os.Exit(1)
@@ -111,8 +129,8 @@ func initializeNATsandCollector(ctx context.Context, natsAddr string) {
 	}
 
 	// Set emit function to go through the entire pipeline
-	emit := func(d *processor.Document) error {
-		err = collectorPubFunc(d)
+	emit := func(ctx context.Context, d *processor.Document) error {
+		err = collectorPubFunc(ctx, d)
 		if err != nil {
 			logger.Errorf("error publishing document from collector: %v", err)
 			os.Exit(1)
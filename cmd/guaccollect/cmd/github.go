@@ -79,6 +79,7 @@ var githubCmd = &cobra.Command{
 		collectorOpts := []github.Opt{
 			github.WithCollectDataSource(opts.dataSource),
 			github.WithClient(ghc),
+			github.WithStateStore(getStateStore(ctx)),
 		}
 		if opts.poll {
 			collectorOpts = append(collectorOpts, github.WithPolling(30*time.Second))
@@ -0,0 +1,64 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/redis"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/viper"
+)
+
+var tikvGS func(context.Context, string) (kv.Store, error)
+
+// getStateStore returns the store collectors record their checkpoints in, as
+// selected by the collector-state flag.
+func getStateStore(ctx context.Context) state.Store {
+	logger := logging.FromContext(ctx)
+	switch s := viper.GetString("collector-state"); s {
+	case "memory":
+		return state.NewMemoryStore()
+	case "file":
+		path := viper.GetString("collector-state-file")
+		store, err := state.NewFileStore(path)
+		if err != nil {
+			logger.Fatalf("error with collector state file: %v", err)
+		}
+		logger.Infof("recording collector state in %s", path)
+		return store
+	case "redis":
+		store, err := redis.GetStore(viper.GetString("kv-redis"))
+		if err != nil {
+			logger.Fatalf("error with Redis: %v", err)
+		}
+		return state.NewKVStore(store)
+	case "tikv":
+		if tikvGS == nil {
+			logger.Fatal("TiKV not supported on 32-bit")
+		}
+		store, err := tikvGS(ctx, viper.GetString("kv-tikv"))
+		if err != nil {
+			logger.Fatalf("error with TiKV: %v", err)
+		}
+		return state.NewKVStore(store)
+	default:
+		logger.Fatalf("unsupported collector state store %q, expected memory, file, redis or tikv", s)
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/collectsub/server"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
 	"github.com/guacsec/guac/pkg/version"
 
 	"github.com/spf13/cobra"
@@ -36,6 +37,7 @@ type csubOptions struct {
 	port        int
 	tlsCertFile string
 	tlsKeyFile  string
+	metricsPort int
 }
 
 var rootCmd = &cobra.Command{
@@ -48,6 +50,7 @@ var rootCmd = &cobra.Command{
 			viper.GetInt("csub-listen-port"),
 			viper.GetString("csub-tls-cert-file"),
 			viper.GetString("csub-tls-key-file"),
+			viper.GetInt("metrics-port"),
 		)
 
 		if err != nil {
@@ -66,6 +69,15 @@ var rootCmd = &cobra.Command{
 		}
 
 		var wg sync.WaitGroup
+		if opts.metricsPort != 0 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := metrics.Serve(ctx, opts.metricsPort); err != nil {
+					logger.Errorf("metrics server terminated with error: %v", err)
+				}
+			}()
+		}
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -83,11 +95,12 @@ var rootCmd = &cobra.Command{
 	},
 }
 
-func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string) (csubOptions, error) {
+func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string, metricsPort int) (csubOptions, error) {
 	var opts csubOptions
 	opts.port = port
 	opts.tlsCertFile = tlsCertFile
 	opts.tlsKeyFile = tlsKeyFile
+	opts.metricsPort = metricsPort
 
 	return opts, nil
 }
@@ -95,7 +108,7 @@ func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string) (csubOpt
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
 
-	set, err := cli.BuildFlags([]string{"csub-listen-port", "csub-tls-cert-file", "csub-tls-key-file"})
+	set, err := cli.BuildFlags([]string{"csub-listen-port", "csub-tls-cert-file", "csub-tls-key-file", "metrics-port"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
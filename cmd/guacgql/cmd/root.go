@@ -32,6 +32,7 @@ var flags = struct {
 	port        int
 	tlsCertFile string
 	tlsKeyFile  string
+	authConfig  string
 	debug       bool
 	tracegql    bool
 
@@ -73,6 +74,7 @@ var rootCmd = &cobra.Command{
 		flags.port = viper.GetInt("gql-listen-port")
 		flags.tlsCertFile = viper.GetString("gql-tls-cert-file")
 		flags.tlsKeyFile = viper.GetString("gql-tls-key-file")
+		flags.authConfig = viper.GetString("gql-auth-config")
 		flags.debug = viper.GetBool("gql-debug")
 		flags.tracegql = viper.GetBool("gql-trace")
 
@@ -112,7 +114,7 @@ func init() {
 		"arango-addr", "arango-user", "arango-pass",
 		"neo4j-addr", "neo4j-user", "neo4j-pass", "neo4j-realm",
 		"neptune-endpoint", "neptune-port", "neptune-region", "neptune-user", "neptune-realm",
-		"gql-listen-port", "gql-tls-cert-file", "gql-tls-key-file", "gql-debug", "gql-backend", "gql-trace",
+		"gql-listen-port", "gql-tls-cert-file", "gql-tls-key-file", "gql-auth-config", "gql-debug", "gql-backend", "gql-trace",
 		"db-address", "db-driver", "db-debug", "db-migrate",
 		"kv-store", "kv-redis", "kv-tikv",
 	})
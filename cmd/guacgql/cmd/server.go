@@ -17,6 +17,7 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"os"
@@ -37,7 +38,10 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/guacsec/guac/pkg/assembler/kv/redis"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 )
@@ -74,7 +78,13 @@ func startServer(cmd *cobra.Command) {
 		os.Exit(1)
 	}
 
-	srv, err := getGraphqlServer(ctx)
+	authenticators, tlsConfig, err := getAuth(ctx)
+	if err != nil {
+		logger.Errorf("unable to initialize authentication: %v", err)
+		os.Exit(1)
+	}
+
+	srv, err := getGraphqlServer(ctx, len(authenticators) > 0)
 	if err != nil {
 		logger.Errorf("unable to initialize graphql server: %v", err)
 		os.Exit(1)
@@ -85,19 +95,43 @@ func startServer(cmd *cobra.Command) {
 		srv.Use(tracer)
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx, "guacgql")
+	if err != nil {
+		logger.Errorf("unable to set up tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Errorf("unable to flush traces: %v", err)
+		}
+	}()
+	m, err := metrics.NewPipeline()
+	if err != nil {
+		logger.Errorf("unable to set up metrics: %v", err)
+		os.Exit(1)
+	}
+	srv.Use(telemetry{metrics: m})
+
 	http.HandleFunc("/healthz", healthHandler)
+	http.Handle("/metrics", metrics.Handler())
 
-	http.Handle("/query", srv)
 	proto := "http"
 	if flags.tlsCertFile != "" && flags.tlsKeyFile != "" {
 		proto = "https"
 	}
+	if len(authenticators) > 0 {
+		if proto == "http" {
+			logger.Warnf("client credentials are sent in clear text, set up TLS to protect them")
+		}
+		http.Handle("/query", tracing.Handler(auth.Middleware(ctx, authenticators, srv)))
+	} else {
+		http.Handle("/query", tracing.Handler(srv))
+	}
 	if flags.debug {
 		http.Handle("/", playground.Handler("GraphQL playground", "/query"))
 		logger.Infof("connect to %s://localhost:%d/ for GraphQL playground", proto, flags.port)
 	}
 
-	server := &http.Server{Addr: fmt.Sprintf(":%d", flags.port)}
+	server := &http.Server{Addr: fmt.Sprintf(":%d", flags.port), TLSConfig: tlsConfig}
 	logger.Info("starting server")
 	go func() {
 		if proto == "https" {
@@ -139,16 +173,46 @@ func validateFlags() error {
 	return nil
 }
 
-func getGraphqlServer(ctx context.Context) (*handler.Server, error) {
+// getAuth returns the authenticators of the clients, none if authentication is
+// disabled, and the TLS configuration verifying client certificates, if they
+// are used.
+func getAuth(ctx context.Context) ([]auth.Authenticator, *tls.Config, error) {
+	logger := logging.FromContext(ctx)
+	if flags.authConfig == "" {
+		logger.Warnf("no authentication configured, every client can ingest and delete nodes")
+		return nil, nil, nil
+	}
+	cfg, err := auth.LoadConfig(flags.authConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	authenticators, err := cfg.Authenticators()
+	if err != nil {
+		return nil, nil, err
+	}
+	var tlsConfig *tls.Config
+	if cfg.ClientCerts != nil {
+		if flags.tlsCertFile == "" || flags.tlsKeyFile == "" {
+			return nil, nil, fmt.Errorf("client certificates require the server TLS certificate and key")
+		}
+		tlsConfig, err = cfg.ClientCerts.TLSConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return authenticators, tlsConfig, nil
+}
+
+func getGraphqlServer(ctx context.Context, authz bool) (*handler.Server, error) {
 	var topResolver resolvers.Resolver
 
 	backend, err := backends.Get(flags.backend, ctx, getOpts[flags.backend](ctx))
 	if err != nil {
 		return nil, fmt.Errorf("Error creating %v backend: %w", flags.backend, err)
 	}
-	topResolver = resolvers.Resolver{Backend: backend}
+	topResolver = resolvers.Resolver{Backend: backend, Broker: &resolvers.Broker{}}
 
-	config := generated.Config{Resolvers: &topResolver}
+	config := generated.Config{Resolvers: &topResolver, Directives: resolvers.NewDirectives(authz)}
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
 
 	return srv, nil
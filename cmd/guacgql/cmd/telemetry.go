@@ -0,0 +1,90 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// telemetry is a gqlgen extension that traces the GraphQL operations and
+// observes their latency. Traces are named after the operations, while the
+// latency is observed by the type and root field of the operation, as the
+// operation names are chosen by the clients and would make the metric labels
+// unbounded.
+type telemetry struct {
+	metrics metrics.Metrics
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = telemetry{}
+
+func (telemetry) ExtensionName() string {
+	return "Telemetry"
+}
+
+func (telemetry) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t telemetry) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	opCtx := graphql.GetOperationContext(ctx)
+	name := opCtx.OperationName
+	if name == "" {
+		name = "anonymous"
+	}
+	var opType, field string
+	if opCtx.Operation != nil {
+		opType = string(opCtx.Operation.Operation)
+		field = rootField(opCtx.Operation)
+	}
+	ctx, span := tracing.Start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+		attribute.String("graphql.operation.name", name),
+		attribute.String("graphql.operation.type", opType),
+	))
+	start := time.Now()
+	resp := next(ctx)
+	t.metrics.ObserveSummaryVec(metrics.ResolverSeconds, time.Since(start).Seconds(), opType+" "+field)
+	var err error
+	if resp != nil && len(resp.Errors) > 0 {
+		err = resp.Errors
+	}
+	tracing.End(span, err)
+	return resp
+}
+
+// rootField returns the name of the first root field of the operation, which
+// is a field of the schema.
+func rootField(op *ast.OperationDefinition) string {
+	for _, sel := range op.SelectionSet {
+		if f, ok := sel.(*ast.Field); ok {
+			return f.Name
+		}
+	}
+	return "unknown"
+}
@@ -23,13 +23,23 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/collectsub/client"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/process"
 	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	keyfile "github.com/guacsec/guac/pkg/ingestor/key/file"
+	"github.com/guacsec/guac/pkg/ingestor/key/inmemory"
+	"github.com/guacsec/guac/pkg/ingestor/trust"
+	"github.com/guacsec/guac/pkg/ingestor/verifier"
+	"github.com/guacsec/guac/pkg/ingestor/verifier/sigstore_verifier"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -38,6 +48,10 @@ type options struct {
 	natsAddr          string
 	csubClientOptions client.CsubClientOptions
 	graphqlEndpoint   string
+	gqlClientOptions  auth.ClientOptions
+	verifierKeys      string
+	trustPolicy       string
+	metricsPort       int
 }
 
 func ingest(cmd *cobra.Command, args []string) {
@@ -48,6 +62,12 @@ func ingest(cmd *cobra.Command, args []string) {
 		viper.GetBool("csub-tls"),
 		viper.GetBool("csub-tls-skip-verify"),
 		viper.GetString("gql-addr"),
+		viper.GetString("gql-token"),
+		viper.GetString("gql-client-cert-file"),
+		viper.GetString("gql-client-key-file"),
+		viper.GetString("verifier-keys"),
+		viper.GetString("trust-policy"),
+		viper.GetInt("metrics-port"),
 		args)
 	if err != nil {
 		fmt.Printf("unable to validate flags: %v\n", err)
@@ -58,6 +78,52 @@ func ingest(cmd *cobra.Command, args []string) {
 	ctx, cf := context.WithCancel(logging.WithLogger(context.Background()))
 	logger := logging.FromContext(ctx)
 
+	shutdownTracing, err := tracing.Setup(ctx, "guacingest")
+	if err != nil {
+		logger.Errorf("unable to set up tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Errorf("unable to flush traces: %v", err)
+		}
+	}()
+	if opts.metricsPort != 0 {
+		ctx, err = metrics.ServePipeline(ctx, opts.metricsPort)
+		if err != nil {
+			logger.Errorf("unable to set up metrics: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	if opts.verifierKeys != "" {
+		fileKeys, err := keyfile.NewFileProvider(opts.verifierKeys)
+		if err != nil {
+			logger.Errorf("unable to load verifier keys: %v", err)
+			os.Exit(1)
+		}
+		if err := key.RegisterKeyProvider(fileKeys, fileKeys.Type()); err != nil {
+			logger.Errorf("unable to register key provider: %v", err)
+		}
+		if err := fileKeys.Watch(ctx); err != nil {
+			logger.Errorf("unable to watch verifier keys: %v", err)
+		}
+	}
+
+	if opts.trustPolicy != "" {
+		keyProvider := inmemory.NewInmemoryProvider()
+		if err := key.RegisterKeyProvider(keyProvider, keyProvider.Type()); err != nil {
+			logger.Errorf("unable to register key provider: %v", err)
+		}
+		sigstoreAndKeyVerifier := sigstore_verifier.NewSigstoreAndKeyVerifier()
+		if err := verifier.RegisterVerifier(sigstoreAndKeyVerifier, sigstoreAndKeyVerifier.Type()); err != nil {
+			logger.Errorf("unable to register verifier: %v", err)
+		}
+		if err := trust.Setup(ctx, opts.trustPolicy, keyProvider.Type()); err != nil {
+			logger.Errorf("unable to set up trust policy: %v", err)
+			os.Exit(1)
+		}
+	}
+
 	// initialize jetstream
 	// TODO: pass in credentials file for NATS secure login
 	jetStream := emitter.NewJetStream(opts.natsAddr, "", "")
@@ -68,6 +134,13 @@ func ingest(cmd *cobra.Command, args []string) {
 	}
 	defer jetStream.Close()
 
+	httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+	if err != nil {
+		logger.Errorf("graphQL client initialization failed with error: %v", err)
+		os.Exit(1)
+	}
+	gqlclient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
+
 	// initialize collectsub client
 	csubClient, err := csub_client.NewClient(opts.csubClientOptions)
 	if err != nil {
@@ -76,8 +149,8 @@ func ingest(cmd *cobra.Command, args []string) {
 	}
 	defer csubClient.Close()
 
-	emit := func(d *processor.Document) error {
-		return ingestor.Ingest(ctx, d, opts.graphqlEndpoint, csubClient)
+	emit := func(ctx context.Context, d *processor.Document) error {
+		return ingestor.Ingest(ctx, d, gqlclient, csubClient)
 	}
 
 	// Assuming that publisher and consumer are different processes.
@@ -100,7 +173,7 @@ func ingest(cmd *cobra.Command, args []string) {
 	wg.Wait()
 }
 
-func validateFlags(natsAddr string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, graphqlEndpoint string, args []string) (options, error) {
+func validateFlags(natsAddr string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, graphqlEndpoint string, gqlToken string, gqlClientCertFile string, gqlClientKeyFile string, verifierKeys string, trustPolicy string, metricsPort int, args []string) (options, error) {
 	var opts options
 	opts.natsAddr = natsAddr
 	csubOpts, err := client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
@@ -109,6 +182,14 @@ func validateFlags(natsAddr string, csubAddr string, csubTls bool, csubTlsSkipVe
 	}
 	opts.csubClientOptions = csubOpts
 	opts.graphqlEndpoint = graphqlEndpoint
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
+	opts.verifierKeys = verifierKeys
+	opts.trustPolicy = trustPolicy
+	opts.metricsPort = metricsPort
 
 	return opts, nil
 }
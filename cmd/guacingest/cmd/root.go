@@ -30,7 +30,7 @@ import (
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
 
-	set, err := cli.BuildFlags([]string{"nats-addr", "csub-addr", "gql-addr"})
+	set, err := cli.BuildFlags([]string{"nats-addr", "csub-addr", "gql-addr", "gql-token", "gql-client-cert-file", "gql-client-key-file", "verifier-keys", "trust-policy", "metrics-port"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
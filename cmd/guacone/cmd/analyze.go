@@ -13,18 +13,17 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package backend
+package cmd
 
 import (
-	"context"
-	"fmt"
-
-	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/spf13/cobra"
 )
 
-// Remove the ebedded backends.Backend interface in the EntBackend struct so
-// that we know which methods are not implemented.
+var analyzeCmd = &cobra.Command{
+	Use:   "analyze",
+	Short: "Runs graph analyses against GraphQL",
+}
 
-func (b *EntBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
-	return nil, fmt.Errorf("not implemented: Path")
+func init() {
+	rootCmd.AddCommand(analyzeCmd)
 }
@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/logging"
@@ -59,187 +61,223 @@ var queryBadCmd = &cobra.Command{
 		httpClient := http.Client{}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
-		certifyBadResponse, err := model.CertifyBads(ctx, gqlclient, model.CertifyBadSpec{})
+		certifyBads, err := clienthelpers.CertifyBads(ctx, gqlclient, model.CertifyBadSpec{})
 		if err != nil {
 			logger.Fatalf("error querying for package: %v", err)
 		}
 
-		mapCertifyBad := map[string][]model.CertifyBadsCertifyBad{}
-		for _, certifyBad := range certifyBadResponse.CertifyBad {
-			switch subject := certifyBad.Subject.(type) {
-			case *model.AllCertifyBadSubjectPackage:
-				version := ""
-				if len(subject.Namespaces[0].Names[0].Versions) == 1 {
-					version = subject.Namespaces[0].Names[0].Versions[0].Version
-				}
-				purl := helpers.PkgToPurl(subject.Type, subject.Namespaces[0].Namespace, subject.Namespaces[0].Names[0].Name, version, "", []string{})
-				purlAndJustification := purl + " (" + certifyBad.Justification + ")"
-				mapCertifyBad[purlAndJustification] = append(mapCertifyBad[purlAndJustification], certifyBad)
-			case *model.AllCertifyBadSubjectSource:
-				namespace := ""
-				if !strings.HasPrefix(subject.Namespaces[0].Namespace, "https://") {
-					namespace = "https://" + subject.Namespaces[0].Namespace
-				} else {
-					namespace = subject.Namespaces[0].Namespace
-				}
-				sourceURL := subject.Type + "+" + namespace + "/" + subject.Namespaces[0].Names[0].Name
-				sourceURLAndJustification := sourceURL + " (" + certifyBad.Justification + ")"
-				mapCertifyBad[sourceURLAndJustification] = append(mapCertifyBad[sourceURLAndJustification], certifyBad)
-			case *model.AllCertifyBadSubjectArtifact:
-				artifactDigest := subject.Algorithm + ":" + subject.Digest
-				artifactDigestAndJustification := artifactDigest + " (" + certifyBad.Justification + ")"
-				mapCertifyBad[artifactDigestAndJustification] = append(mapCertifyBad[artifactDigestAndJustification], certifyBad)
-			}
+		mapCertifyBad := map[string][]model.AllCertifyBad{}
+		for _, certifyBad := range certifyBads {
+			subjectAndJustification := certifyBadSubject(certifyBad) + " (" + certifyBad.Justification + ")"
+			mapCertifyBad[subjectAndJustification] = append(mapCertifyBad[subjectAndJustification], certifyBad)
 		}
 
 		var certifyBadValues []string
 		for certifyBadValue := range mapCertifyBad {
 			certifyBadValues = append(certifyBadValues, certifyBadValue)
 		}
+		sort.Strings(certifyBadValues)
 
-		prompt := promptui.Select{
-			Label: "Select CertifyBad to Query",
-			Items: certifyBadValues,
+		output := viper.GetString("output")
+		// the table output lets the user select the certifyBad to query, the
+		// other outputs report all of them
+		selected := certifyBadValues
+		if output == outputTable {
+			prompt := promptui.Select{
+				Label: "Select CertifyBad to Query",
+				Items: certifyBadValues,
+			}
+
+			_, certifyBadSelected, err := prompt.Run()
+			if err != nil {
+				fmt.Printf("Prompt failed %v\n", err)
+				return
+			}
+			selected = []string{certifyBadSelected}
 		}
 
-		_, certifyBadSelected, err := prompt.Run()
-		if err != nil {
-			fmt.Printf("Prompt failed %v\n", err)
-			return
+		result := &queryResult{Query: "bad"}
+		for _, certifyBadValue := range selected {
+			for _, certifyBad := range mapCertifyBad[certifyBadValue] {
+				result.Results = append(result.Results, queryBadPaths(ctx, gqlclient, certifyBad, opts.depth))
+			}
 		}
 
-		for _, selectedCertifyBad := range mapCertifyBad[certifyBadSelected] {
-			switch subject := selectedCertifyBad.Subject.(type) {
-			case *model.AllCertifyBadSubjectPackage:
-				var path []string
+		if err := writeQueryResult(os.Stdout, output, result); err != nil {
+			logger.Fatalf("unable to write query result: %v", err)
+		}
+	},
+}
 
-				var pkgVersions []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion
-				if len(subject.Namespaces[0].Names[0].Versions) == 0 {
-					pkgFilter := &model.PkgSpec{
-						Type:      &subject.Type,
-						Namespace: &subject.Namespaces[0].Namespace,
-						Name:      &subject.Namespaces[0].Names[0].Name,
-					}
-					pkgResponse, err := model.Packages(ctx, gqlclient, *pkgFilter)
-					if err != nil {
-						logger.Fatalf("error querying for package: %v", err)
-					}
-					if len(pkgResponse.Packages) != 1 {
-						logger.Fatalf("failed to located package based on package from certifyBad")
-					}
-					pkgVersions = pkgResponse.Packages[0].Namespaces[0].Names[0].Versions
-				} else {
-					pkgVersions = subject.Namespaces[0].Names[0].Versions
-				}
+// certifyBadSubject returns the purl, source URL or artifact digest of the
+// subject of the certifyBad.
+func certifyBadSubject(certifyBad model.AllCertifyBad) string {
+	switch subject := certifyBad.Subject.(type) {
+	case *model.AllCertifyBadSubjectPackage:
+		version := ""
+		if len(subject.Namespaces[0].Names[0].Versions) == 1 {
+			version = subject.Namespaces[0].Names[0].Versions[0].Version
+		}
+		return helpers.PkgToPurl(subject.Type, subject.Namespaces[0].Namespace, subject.Namespaces[0].Names[0].Name, version, "", []string{})
+	case *model.AllCertifyBadSubjectSource:
+		namespace := ""
+		if !strings.HasPrefix(subject.Namespaces[0].Namespace, "https://") {
+			namespace = "https://" + subject.Namespaces[0].Namespace
+		} else {
+			namespace = subject.Namespaces[0].Namespace
+		}
+		return subject.Type + "+" + namespace + "/" + subject.Namespaces[0].Names[0].Name
+	case *model.AllCertifyBadSubjectArtifact:
+		return subject.Algorithm + ":" + subject.Digest
+	default:
+		return ""
+	}
+}
 
-				pkgPath, err := searchDependencyPackagesReverse(ctx, gqlclient, "", pkgVersions[0].Id, opts.depth)
-				if err != nil {
-					logger.Fatalf("error searching dependency packages match: %v", err)
-				}
+// queryBadPaths returns the certifyBad and the path from its subject to the
+// packages, sources and artifacts depending on it.
+func queryBadPaths(ctx context.Context, gqlclient graphql.Client, certifyBad model.AllCertifyBad, depth int) resultGroup {
+	logger := logging.FromContext(ctx)
+	badSubject := certifyBadSubject(certifyBad)
+	group := resultGroup{
+		Title: badSubject,
+		Nodes: []resultNode{{
+			ID:      certifyBad.Id,
+			Type:    badLinkStr,
+			Info:    "justification: " + certifyBad.Justification,
+			Subject: badSubject,
+		}},
+	}
+	switch subject := certifyBad.Subject.(type) {
+	case *model.AllCertifyBadSubjectPackage:
+		var path []string
 
-				var fullCertifyBadPath []string
-				for _, version := range pkgVersions {
-					fullCertifyBadPath = append([]string{selectedCertifyBad.Id,
-						version.Id,
-						subject.Namespaces[0].Names[0].Id, subject.Namespaces[0].Id,
-						subject.Id}, pkgPath...)
-				}
-				if len(pkgPath) > 0 {
-					path = append(path, fullCertifyBadPath...)
-					fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
-				} else {
-					fmt.Printf("No paths to bad package found!\n")
-				}
-			case *model.AllCertifyBadSubjectSource:
-				var path []string
-				srcFilter := &model.SourceSpec{
-					Type:      &subject.Type,
-					Namespace: &subject.Namespaces[0].Namespace,
-					Name:      &subject.Namespaces[0].Names[0].Name,
-					Tag:       subject.Namespaces[0].Names[0].Tag,
-					Commit:    subject.Namespaces[0].Names[0].Commit,
-				}
-				srcResponse, err := model.Sources(ctx, gqlclient, *srcFilter)
-				if err != nil {
-					logger.Fatalf("error querying for sources: %v", err)
-				}
-				if len(srcResponse.Sources) != 1 {
-					logger.Fatalf("failed to located sources based on vcs")
-				}
+		var pkgVersions []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion
+		if len(subject.Namespaces[0].Names[0].Versions) == 0 {
+			pkgFilter := &model.PkgSpec{
+				Type:      &subject.Type,
+				Namespace: &subject.Namespaces[0].Namespace,
+				Name:      &subject.Namespaces[0].Names[0].Name,
+			}
+			pkgs, err := clienthelpers.Packages(ctx, gqlclient, *pkgFilter)
+			if err != nil {
+				logger.Fatalf("error querying for package: %v", err)
+			}
+			if len(pkgs) != 1 {
+				logger.Fatalf("failed to located package based on package from certifyBad")
+			}
+			pkgVersions = pkgs[0].Namespaces[0].Names[0].Versions
+		} else {
+			pkgVersions = subject.Namespaces[0].Names[0].Versions
+		}
 
-				neighborResponse, err := model.Neighbors(ctx, gqlclient, srcResponse.Sources[0].Namespaces[0].Names[0].Id, []model.Edge{model.EdgeSourceHasSourceAt, model.EdgeSourceIsOccurrence})
-				if err != nil {
-					logger.Fatalf("error querying neighbors: %v", err)
-				}
-				for _, neighbor := range neighborResponse.Neighbors {
-					switch v := neighbor.(type) {
-					case *model.NeighborsNeighborsHasSourceAt:
-						if len(v.Package.Namespaces[0].Names[0].Versions) > 0 {
-							path = append(path, v.Id, v.Package.Namespaces[0].Names[0].Versions[0].Id, v.Package.Namespaces[0].Names[0].Id, v.Package.Namespaces[0].Id, v.Package.Id)
-						} else {
-							path = append(path, v.Id, v.Package.Namespaces[0].Names[0].Id, v.Package.Namespaces[0].Id, v.Package.Id)
-						}
-					case *model.NeighborsNeighborsIsOccurrence:
-						path = append(path, v.Id, v.Artifact.Id)
-					default:
-						continue
-					}
-				}
+		pkgPath, err := searchDependencyPackagesReverse(ctx, gqlclient, "", pkgVersions[0].Id, depth)
+		if err != nil {
+			logger.Fatalf("error searching dependency packages match: %v", err)
+		}
+
+		var fullCertifyBadPath []string
+		for _, version := range pkgVersions {
+			fullCertifyBadPath = append([]string{certifyBad.Id,
+				version.Id,
+				subject.Namespaces[0].Names[0].Id, subject.Namespaces[0].Id,
+				subject.Id}, pkgPath...)
+		}
+		if len(pkgPath) > 0 {
+			path = append(path, fullCertifyBadPath...)
+			group.setPath(path)
+		} else {
+			group.Message = "No paths to bad package found!"
+		}
+	case *model.AllCertifyBadSubjectSource:
+		var path []string
+		srcFilter := &model.SourceSpec{
+			Type:      &subject.Type,
+			Namespace: &subject.Namespaces[0].Namespace,
+			Name:      &subject.Namespaces[0].Names[0].Name,
+			Tag:       subject.Namespaces[0].Names[0].Tag,
+			Commit:    subject.Namespaces[0].Names[0].Commit,
+		}
+		srcs, err := clienthelpers.Sources(ctx, gqlclient, *srcFilter)
+		if err != nil {
+			logger.Fatalf("error querying for sources: %v", err)
+		}
+		if len(srcs) != 1 {
+			logger.Fatalf("failed to located sources based on vcs")
+		}
 
-				if len(path) > 0 {
-					fullCertifyBadPath := append([]string{selectedCertifyBad.Id,
-						subject.Namespaces[0].Names[0].Id,
-						subject.Namespaces[0].Id, subject.Id}, path...)
-					path = append(path, fullCertifyBadPath...)
-					fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+		neighborResponse, err := model.Neighbors(ctx, gqlclient, srcs[0].Namespaces[0].Names[0].Id, []model.Edge{model.EdgeSourceHasSourceAt, model.EdgeSourceIsOccurrence})
+		if err != nil {
+			logger.Fatalf("error querying neighbors: %v", err)
+		}
+		for _, neighbor := range neighborResponse.Neighbors {
+			switch v := neighbor.(type) {
+			case *model.NeighborsNeighborsHasSourceAt:
+				if len(v.Package.Namespaces[0].Names[0].Versions) > 0 {
+					path = append(path, v.Id, v.Package.Namespaces[0].Names[0].Versions[0].Id, v.Package.Namespaces[0].Names[0].Id, v.Package.Namespaces[0].Id, v.Package.Id)
 				} else {
-					fmt.Printf("No paths to bad source found!\n")
+					path = append(path, v.Id, v.Package.Namespaces[0].Names[0].Id, v.Package.Namespaces[0].Id, v.Package.Id)
 				}
+			case *model.NeighborsNeighborsIsOccurrence:
+				path = append(path, v.Id, v.Artifact.Id)
+			default:
+				continue
+			}
+		}
 
-			case *model.AllCertifyBadSubjectArtifact:
-				var path []string
-				artifactFilter := &model.ArtifactSpec{
-					Algorithm: &subject.Algorithm,
-					Digest:    &subject.Digest,
-				}
+		if len(path) > 0 {
+			fullCertifyBadPath := append([]string{certifyBad.Id,
+				subject.Namespaces[0].Names[0].Id,
+				subject.Namespaces[0].Id, subject.Id}, path...)
+			path = append(path, fullCertifyBadPath...)
+			group.setPath(path)
+		} else {
+			group.Message = "No paths to bad source found!"
+		}
 
-				artifactResponse, err := model.Artifacts(ctx, gqlclient, *artifactFilter)
-				if err != nil {
-					logger.Fatalf("error querying for artifacts: %v", err)
-				}
-				if len(artifactResponse.Artifacts) != 1 {
-					logger.Fatalf("failed to located artifacts based on (algorithm:digest)")
-				}
-				neighborResponse, err := model.Neighbors(ctx, gqlclient, artifactResponse.Artifacts[0].Id, []model.Edge{model.EdgeArtifactHashEqual, model.EdgeArtifactIsOccurrence})
-				if err != nil {
-					logger.Fatalf("error querying neighbors: %v", err)
-				}
-				for _, neighbor := range neighborResponse.Neighbors {
-					switch v := neighbor.(type) {
-					case *model.NeighborsNeighborsHashEqual:
-						path = append(path, v.Id)
-					case *model.NeighborsNeighborsIsOccurrence:
-						switch occurrenceSubject := v.Subject.(type) {
-						case *model.AllIsOccurrencesTreeSubjectPackage:
-							path = append(path, v.Id, occurrenceSubject.Namespaces[0].Names[0].Versions[0].Id, occurrenceSubject.Namespaces[0].Names[0].Id, occurrenceSubject.Namespaces[0].Id, occurrenceSubject.Id)
-						case *model.AllIsOccurrencesTreeSubjectSource:
-							path = append(path, v.Id, occurrenceSubject.Namespaces[0].Names[0].Id, occurrenceSubject.Namespaces[0].Id, occurrenceSubject.Id)
-						}
-					default:
-						continue
-					}
-				}
-				if len(path) > 0 {
-					fullCertifyBadPath := append([]string{selectedCertifyBad.Id,
-						subject.Id}, path...)
-					path = append(path, fullCertifyBadPath...)
-					fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
-				} else {
-					fmt.Printf("No paths to bad artifact found!\n")
+	case *model.AllCertifyBadSubjectArtifact:
+		var path []string
+		artifactFilter := &model.ArtifactSpec{
+			Algorithm: &subject.Algorithm,
+			Digest:    &subject.Digest,
+		}
+
+		artifacts, err := clienthelpers.Artifacts(ctx, gqlclient, *artifactFilter)
+		if err != nil {
+			logger.Fatalf("error querying for artifacts: %v", err)
+		}
+		if len(artifacts) != 1 {
+			logger.Fatalf("failed to located artifacts based on (algorithm:digest)")
+		}
+		neighborResponse, err := model.Neighbors(ctx, gqlclient, artifacts[0].Id, []model.Edge{model.EdgeArtifactHashEqual, model.EdgeArtifactIsOccurrence})
+		if err != nil {
+			logger.Fatalf("error querying neighbors: %v", err)
+		}
+		for _, neighbor := range neighborResponse.Neighbors {
+			switch v := neighbor.(type) {
+			case *model.NeighborsNeighborsHashEqual:
+				path = append(path, v.Id)
+			case *model.NeighborsNeighborsIsOccurrence:
+				switch occurrenceSubject := v.Subject.(type) {
+				case *model.AllIsOccurrencesTreeSubjectPackage:
+					path = append(path, v.Id, occurrenceSubject.Namespaces[0].Names[0].Versions[0].Id, occurrenceSubject.Namespaces[0].Names[0].Id, occurrenceSubject.Namespaces[0].Id, occurrenceSubject.Id)
+				case *model.AllIsOccurrencesTreeSubjectSource:
+					path = append(path, v.Id, occurrenceSubject.Namespaces[0].Names[0].Id, occurrenceSubject.Namespaces[0].Id, occurrenceSubject.Id)
 				}
+			default:
+				continue
 			}
 		}
-	},
+		if len(path) > 0 {
+			fullCertifyBadPath := append([]string{certifyBad.Id,
+				subject.Id}, path...)
+			path = append(path, fullCertifyBadPath...)
+			group.setPath(path)
+		} else {
+			group.Message = "No paths to bad artifact found!"
+		}
+	}
+	return group
 }
 
 func validateQueryBadFlags(graphqlEndpoint string, depth int) (queryBadOptions, error) {
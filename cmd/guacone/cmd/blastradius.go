@@ -0,0 +1,155 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/cli"
+	analysis "github.com/guacsec/guac/pkg/guacanalytics"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type analyzeBlastRadiusOptions struct {
+	graphqlEndpoint string
+	vulnerabilityID string
+	depth           int
+}
+
+var analyzeBlastRadiusCmd = &cobra.Command{
+	Use:   "blast-radius [flags] vuln-id",
+	Short: "find the top-level artifacts and packages affected by the specified vulnerability and how they depend on it",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateAnalyzeBlastRadiusFlags(
+			viper.GetString("gql-addr"),
+			viper.GetInt("search-depth"),
+			args,
+		)
+
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{}
+		gqlClient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		affected, affectedPkgs, err := analysis.SearchBlastRadiusFromVuln(ctx, gqlClient, opts.vulnerabilityID, opts.depth)
+		if err != nil {
+			logger.Fatalf("error searching blast radius: %v", err)
+		}
+
+		if len(affected) == 0 && len(affectedPkgs) == 0 {
+			fmt.Printf("no artifacts or packages affected by %s found\n", opts.vulnerabilityID)
+			return
+		}
+
+		pretty := map[string]string{}
+		var path []string
+		if len(affected) > 0 {
+			fmt.Printf("---AFFECTED ARTIFACTS---\n")
+		}
+		for _, a := range affected {
+			chain, err := makeBlastRadiusPathPretty(ctx, gqlClient, a.Path, pretty)
+			if err != nil {
+				logger.Fatalf("%v", err)
+			}
+			fmt.Printf("\n%s: algorithm-%s | digest:%s\n", a.Artifact.Id, a.Artifact.Algorithm, a.Artifact.Digest)
+			fmt.Printf("  %s\n", strings.Join(chain, "\n  -> "))
+			path = append(path, a.Path...)
+		}
+		if len(affectedPkgs) > 0 {
+			fmt.Printf("\n---AFFECTED PACKAGES---\n")
+		}
+		for _, p := range affectedPkgs {
+			chain, err := makeBlastRadiusPathPretty(ctx, gqlClient, p.Path, pretty)
+			if err != nil {
+				logger.Fatalf("%v", err)
+			}
+			fmt.Printf("\n%s\n", chain[len(chain)-1])
+			fmt.Printf("  %s\n", strings.Join(chain, "\n  -> "))
+			path = append(path, p.Path...)
+		}
+
+		fmt.Printf("\n---SUBGRAPH VISUALIZER URL--- \nhttp://localhost:3000/?path=%s\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+	},
+}
+
+// makeBlastRadiusPathPretty describes the packages and artifacts of a blast
+// radius path, the vulnerability and the evidence between them are left out.
+func makeBlastRadiusPathPretty(ctx context.Context, gqlClient graphql.Client, path []string, pretty map[string]string) ([]string, error) {
+	var chain []string
+	// path alternates between nodes and evidence, starting from the
+	// vulnerability and the CertifyVuln
+	for i := 2; i < len(path); i += 2 {
+		id := path[i]
+		if _, ok := pretty[id]; !ok {
+			node, err := model.Node(ctx, gqlClient, id)
+			if err != nil {
+				return nil, fmt.Errorf("error querying node %s: %w", id, err)
+			}
+			switch node := node.Node.(type) {
+			case *model.NodeNodePackage:
+				pretty[id] = makePkgPretty(*node, true)
+			case *model.NodeNodeArtifact:
+				pretty[id] = makeArtifactPretty(*node)
+			default:
+				return nil, fmt.Errorf("discovered unexpected node type in blast radius (expect pkg or artifact)")
+			}
+		}
+		chain = append(chain, pretty[id])
+	}
+	return chain, nil
+}
+
+func validateAnalyzeBlastRadiusFlags(graphqlEndpoint string, depth int, args []string) (analyzeBlastRadiusOptions, error) {
+	var opts analyzeBlastRadiusOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.depth = depth
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected a single vulnerability ID")
+	}
+	opts.vulnerabilityID = args[0]
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"search-depth"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	analyzeBlastRadiusCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(analyzeBlastRadiusCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	analyzeCmd.AddCommand(analyzeBlastRadiusCmd)
+}
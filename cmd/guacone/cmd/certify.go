@@ -22,9 +22,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/assembler"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/ingestor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -34,7 +36,8 @@ import (
 
 type certifyOptions struct {
 	// gql endpoint
-	graphqlEndpoint string
+	graphqlEndpoint  string
+	gqlClientOptions auth.ClientOptions
 	// // certifyBad/certifyGood
 	good          bool
 	certifyType   string
@@ -58,6 +61,9 @@ var certifyCmd = &cobra.Command{
 
 		opts, err := validateCertifyFlags(
 			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
 			viper.GetBool("cert-good"),
 			viper.GetBool("package-name"),
 			args,
@@ -69,7 +75,12 @@ var certifyCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		assemblerFunc := ingestor.GetAssembler(ctx, opts.graphqlEndpoint)
+		httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
+		assemblerFunc := ingestor.GetAssembler(ctx, gqlclient)
 
 		preds := &assembler.IngestPredicates{}
 		var pkgInput *model.PkgInputSpec
@@ -156,9 +167,14 @@ var certifyCmd = &cobra.Command{
 	},
 }
 
-func validateCertifyFlags(graphqlEndpoint string, good, pkgName bool, args []string) (certifyOptions, error) {
+func validateCertifyFlags(graphqlEndpoint string, gqlToken string, gqlClientCertFile string, gqlClientKeyFile string, good, pkgName bool, args []string) (certifyOptions, error) {
 	var opts certifyOptions
 	opts.graphqlEndpoint = graphqlEndpoint
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
 	opts.good = good
 	opts.pkgName = pkgName
 	if len(args) != 3 {
@@ -0,0 +1,144 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const maxErrorWidth = 80
+
+var dlqCmd = &cobra.Command{
+	Use:   "dlq",
+	Short: "Inspects and replays the documents that failed in the NATS pipeline",
+}
+
+var dlqListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the failed documents",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, closeJetStream := dlqJetStream()
+		defer closeJetStream()
+		logger := logging.FromContext(ctx)
+
+		failed, err := emitter.ListFailed(ctx)
+		if err != nil {
+			logger.Fatalf("unable to list failed documents: %v", err)
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"Sequence", "Failed At", "Subject", "Stage", "Attempts", "Error"})
+		for _, f := range failed {
+			t.AppendRow(table.Row{f.Sequence, f.FailedAt.Format(time.RFC3339), f.Subject, f.Stage, f.Attempts, truncate(f.Error, maxErrorWidth)})
+		}
+		t.AppendFooter(table.Row{"Total", len(failed)})
+		t.Render()
+	},
+}
+
+var dlqReplayCmd = &cobra.Command{
+	Use:   "replay [flags] [sequence...]",
+	Short: "publish failed documents back to the subject they failed on, all of them if no sequence is given",
+	Run: func(cmd *cobra.Command, args []string) {
+		var seqs []uint64
+		for _, arg := range args {
+			seq, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				fmt.Printf("invalid sequence %q: %v\n", arg, err)
+				_ = cmd.Help()
+				os.Exit(1)
+			}
+			seqs = append(seqs, seq)
+		}
+
+		ctx, closeJetStream := dlqJetStream()
+		defer closeJetStream()
+		logger := logging.FromContext(ctx)
+
+		replayed, err := emitter.ReplayFailed(ctx, seqs...)
+		if err != nil {
+			logger.Fatalf("unable to replay failed documents, %d replayed: %v", replayed, err)
+		}
+		fmt.Printf("replayed %d failed documents\n", replayed)
+	},
+}
+
+var dlqPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "remove all the failed documents",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, closeJetStream := dlqJetStream()
+		defer closeJetStream()
+		logger := logging.FromContext(ctx)
+
+		if err := emitter.PurgeFailed(ctx); err != nil {
+			logger.Fatalf("unable to purge failed documents: %v", err)
+		}
+		fmt.Println("purged failed documents")
+	},
+}
+
+// dlqJetStream connects to the NATS server and returns the context holding
+// the jetstream, along with the function closing the connection.
+func dlqJetStream() (context.Context, func()) {
+	ctx := logging.WithLogger(context.Background())
+	logger := logging.FromContext(ctx)
+
+	// TODO: pass in credentials file for NATS secure login
+	jetStream := emitter.NewJetStream(viper.GetString("nats-addr"), "", "")
+	ctx, err := jetStream.JetStreamInit(ctx)
+	if err != nil {
+		logger.Fatalf("jetStream initialization failed with error: %v", err)
+	}
+	return ctx, jetStream.Close
+}
+
+func truncate(s string, width int) string {
+	if len(s) <= width {
+		return s
+	}
+	return s[:width-3] + "..."
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"nats-addr"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	dlqCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(dlqCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	dlqCmd.AddCommand(dlqListCmd)
+	dlqCmd.AddCommand(dlqReplayCmd)
+	dlqCmd.AddCommand(dlqPurgeCmd)
+	rootCmd.AddCommand(dlqCmd)
+}
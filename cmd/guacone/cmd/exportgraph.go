@@ -0,0 +1,81 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
+	"github.com/guacsec/guac/pkg/export/graph"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type exportGraphOptions struct {
+	graphqlEndpoint  string
+	gqlClientOptions auth.ClientOptions
+}
+
+var exportGraphCmd = &cobra.Command{
+	Use:   "graph [flags]",
+	Short: "export every node and evidence of the graph as JSON lines, to back it up or import it into another backend",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateExportGraphFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlClient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
+
+		if err := graph.Export(ctx, gqlClient, os.Stdout); err != nil {
+			logger.Fatalf("error exporting graph: %v", err)
+		}
+	},
+}
+
+func validateExportGraphFlags(graphqlEndpoint, gqlToken, gqlClientCertFile, gqlClientKeyFile string) (exportGraphOptions, error) {
+	var opts exportGraphOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
+	return opts, nil
+}
+
+func init() {
+	exportCmd.AddCommand(exportGraphCmd)
+}
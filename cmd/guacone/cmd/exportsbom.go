@@ -0,0 +1,103 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/export/sbom"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type exportSBOMOptions struct {
+	graphqlEndpoint string
+	format          sbom.Format
+	subject         string
+}
+
+var exportSBOMCmd = &cobra.Command{
+	Use:   "sbom [flags] <purl | algorithm:digest>",
+	Short: "export an SBOM of a package or artifact built from the graph, merging every ingested source",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateExportSBOMFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("format"),
+			args,
+		)
+
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{}
+		gqlClient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		s, err := sbom.Build(ctx, gqlClient, opts.subject)
+		if err != nil {
+			logger.Fatalf("error building SBOM: %v", err)
+		}
+		if err := s.Write(os.Stdout, opts.format); err != nil {
+			logger.Fatalf("error writing SBOM: %v", err)
+		}
+	},
+}
+
+func validateExportSBOMFlags(graphqlEndpoint, format string, args []string) (exportSBOMOptions, error) {
+	var opts exportSBOMOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+
+	switch sbom.Format(format) {
+	case "":
+		opts.format = sbom.FormatSPDX
+	case sbom.FormatSPDX, sbom.FormatCycloneDX:
+		opts.format = sbom.Format(format)
+	default:
+		return opts, fmt.Errorf("expected format to be %s or %s, got %s", sbom.FormatSPDX, sbom.FormatCycloneDX, format)
+	}
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected subject input to be a purl or an artifact as algorithm:digest")
+	}
+	opts.subject = args[0]
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"format"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	exportSBOMCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(exportSBOMCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	exportCmd.AddCommand(exportSBOMCmd)
+}
@@ -0,0 +1,103 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/export/vex"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type exportVEXOptions struct {
+	graphqlEndpoint string
+	format          vex.Format
+	subject         string
+}
+
+var exportVEXCmd = &cobra.Command{
+	Use:   "vex [flags] <purl | algorithm:digest>",
+	Short: "export a VEX document of a package or artifact and its dependencies built from the vulnerabilities in the graph",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateExportVEXFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("format"),
+			args,
+		)
+
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{}
+		gqlClient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		d, err := vex.Build(ctx, gqlClient, opts.subject)
+		if err != nil {
+			logger.Fatalf("error building VEX document: %v", err)
+		}
+		if err := d.Write(os.Stdout, opts.format); err != nil {
+			logger.Fatalf("error writing VEX document: %v", err)
+		}
+	},
+}
+
+func validateExportVEXFlags(graphqlEndpoint, format string, args []string) (exportVEXOptions, error) {
+	var opts exportVEXOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+
+	switch vex.Format(format) {
+	case "":
+		opts.format = vex.FormatOpenVEX
+	case vex.FormatOpenVEX, vex.FormatCSAF:
+		opts.format = vex.Format(format)
+	default:
+		return opts, fmt.Errorf("expected format to be %s or %s, got %s", vex.FormatOpenVEX, vex.FormatCSAF, format)
+	}
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected subject input to be a purl or an artifact as algorithm:digest")
+	}
+	opts.subject = args[0]
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"format"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	exportVEXCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(exportVEXCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	exportCmd.AddCommand(exportVEXCmd)
+}
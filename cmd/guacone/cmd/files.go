@@ -24,6 +24,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/collectsub/client"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
@@ -32,7 +34,9 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor"
 	"github.com/guacsec/guac/pkg/ingestor/key"
+	keyfile "github.com/guacsec/guac/pkg/ingestor/key/file"
 	"github.com/guacsec/guac/pkg/ingestor/key/inmemory"
+	"github.com/guacsec/guac/pkg/ingestor/trust"
 	"github.com/guacsec/guac/pkg/ingestor/verifier"
 	"github.com/guacsec/guac/pkg/ingestor/verifier/sigstore_verifier"
 	"github.com/guacsec/guac/pkg/logging"
@@ -48,10 +52,15 @@ type fileOptions struct {
 	keyPath string
 	// ID related to the key being stored
 	keyID string
+	// path to the directory or keyring file of the verifier keys
+	verifierKeys string
+	// path to the trust policy
+	trustPolicy string
 	// path to folder with documents to collect
 	path string
 	// gql endpoint
-	graphqlEndpoint string
+	graphqlEndpoint  string
+	gqlClientOptions auth.ClientOptions
 	// csub client options for identifier strings
 	csubClientOptions client.CsubClientOptions
 }
@@ -66,7 +75,12 @@ var filesCmd = &cobra.Command{
 		opts, err := validateFilesFlags(
 			viper.GetString("verifier-key-path"),
 			viper.GetString("verifier-key-id"),
+			viper.GetString("verifier-keys"),
+			viper.GetString("trust-policy"),
 			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
 			viper.GetString("csub-addr"),
 			viper.GetBool("csub-tls"),
 			viper.GetBool("csub-tls-skip-verify"),
@@ -84,6 +98,17 @@ var filesCmd = &cobra.Command{
 			logger.Errorf("unable to register key provider: %v", err)
 		}
 
+		if opts.verifierKeys != "" {
+			fileKeys, err := keyfile.NewFileProvider(opts.verifierKeys)
+			if err != nil {
+				logger.Errorf("unable to load verifier keys: %v", err)
+				os.Exit(1)
+			}
+			if err := key.RegisterKeyProvider(fileKeys, fileKeys.Type()); err != nil {
+				logger.Errorf("unable to register key provider: %v", err)
+			}
+		}
+
 		if opts.keyPath != "" && opts.keyID != "" {
 			keyRaw, err := os.ReadFile(opts.keyPath)
 			if err != nil {
@@ -104,6 +129,13 @@ var filesCmd = &cobra.Command{
 			logger.Errorf("unable to register key provider: %v", err)
 		}
 
+		if opts.trustPolicy != "" {
+			if err := trust.Setup(ctx, opts.trustPolicy, inmemory.Type()); err != nil {
+				logger.Errorf("unable to set up trust policy: %v", err)
+				os.Exit(1)
+			}
+		}
+
 		// Register collector
 		fileCollector := file.NewFileCollector(ctx, opts.path, false, time.Second)
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
@@ -111,6 +143,12 @@ var filesCmd = &cobra.Command{
 			logger.Errorf("unable to register file collector: %v", err)
 		}
 
+		httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
+
 		// initialize collectsub client
 		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
 		if err != nil {
@@ -140,9 +178,9 @@ var filesCmd = &cobra.Command{
 			files.SetLimit(1)
 		}
 
-		emit := func(d *processor.Document) error {
+		emit := func(_ context.Context, d *processor.Document) error {
 			totalNum += 1
-			err := ingestor.Ingest(filesCtx, d, opts.graphqlEndpoint, csubClient)
+			err := ingestor.Ingest(filesCtx, d, gqlclient, csubClient)
 
 			if err != nil {
 				gotErr = true
@@ -179,9 +217,14 @@ var filesCmd = &cobra.Command{
 	},
 }
 
-func validateFilesFlags(keyPath string, keyID string, graphqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, args []string) (fileOptions, error) {
+func validateFilesFlags(keyPath string, keyID string, verifierKeys string, trustPolicy string, graphqlEndpoint string, gqlToken string, gqlClientCertFile string, gqlClientKeyFile string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, args []string) (fileOptions, error) {
 	var opts fileOptions
 	opts.graphqlEndpoint = graphqlEndpoint
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
 
 	if keyPath != "" {
 		if strings.HasSuffix(keyPath, "pem") {
@@ -193,6 +236,8 @@ func validateFilesFlags(keyPath string, keyID string, graphqlEndpoint string, cs
 	if keyPath != "" {
 		opts.keyID = keyID
 	}
+	opts.verifierKeys = verifierKeys
+	opts.trustPolicy = trustPolicy
 
 	if len(args) != 1 {
 		return opts, fmt.Errorf("expected positional argument for file_path")
@@ -217,7 +262,7 @@ func printErrors(filesWithErrors []string) string {
 }
 
 func init() {
-	set, err := cli.BuildFlags([]string{"verifier-key-path", "verifier-key-id"})
+	set, err := cli.BuildFlags([]string{"verifier-key-path", "verifier-key-id", "verifier-keys", "trust-policy"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
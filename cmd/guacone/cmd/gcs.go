@@ -21,6 +21,8 @@ import (
 	"os"
 
 	"cloud.google.com/go/storage"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/collectsub/client"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
@@ -37,6 +39,7 @@ import (
 
 type gcsOptions struct {
 	graphqlEndpoint   string
+	gqlClientOptions  auth.ClientOptions
 	csubClientOptions client.CsubClientOptions
 	bucket            string
 }
@@ -54,6 +57,9 @@ var gcsCmd = &cobra.Command{
 
 		opts, err := validateGCSFlags(
 			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
 			viper.GetString("csub-addr"),
 			viper.GetBool("csub-tls"),
 			viper.GetBool("csub-tls-skip-verify"),
@@ -91,6 +97,12 @@ var gcsCmd = &cobra.Command{
 			logger.Fatalf("unable to register gcs collector: %v", err)
 		}
 
+		httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
+
 		// initialize collectsub client
 		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
 		if err != nil {
@@ -103,9 +115,9 @@ var gcsCmd = &cobra.Command{
 		totalNum := 0
 		gotErr := false
 
-		emit := func(d *processor.Document) error {
+		emit := func(ctx context.Context, d *processor.Document) error {
 			totalNum += 1
-			err := ingestor.Ingest(ctx, d, opts.graphqlEndpoint, csubClient)
+			err := ingestor.Ingest(ctx, d, gqlclient, csubClient)
 
 			if err != nil {
 				gotErr = true
@@ -135,9 +147,14 @@ var gcsCmd = &cobra.Command{
 	},
 }
 
-func validateGCSFlags(gqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, credentialsPath string, args []string) (gcsOptions, error) {
+func validateGCSFlags(gqlEndpoint string, gqlToken string, gqlClientCertFile string, gqlClientKeyFile string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, credentialsPath string, args []string) (gcsOptions, error) {
 	var opts gcsOptions
 	opts.graphqlEndpoint = gqlEndpoint
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
 
 	csubOpts, err := client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
 	if err != nil {
@@ -0,0 +1,98 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
+	"github.com/guacsec/guac/pkg/export/graph"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type importGraphOptions struct {
+	graphqlEndpoint  string
+	gqlClientOptions auth.ClientOptions
+	// file is the graph export to import, or - for stdin
+	file string
+}
+
+var importGraphCmd = &cobra.Command{
+	Use:   "graph [flags] <file | ->",
+	Short: "import a graph exported by \"export graph\", reading it from stdin if the file is -",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateImportGraphFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		var r io.Reader = os.Stdin
+		if opts.file != "-" {
+			f, err := os.Open(opts.file)
+			if err != nil {
+				logger.Fatalf("error opening graph export: %v", err)
+			}
+			defer f.Close()
+			r = f
+		}
+
+		httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlClient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
+
+		if err := graph.Import(ctx, gqlClient, r); err != nil {
+			logger.Fatalf("error importing graph: %v", err)
+		}
+	},
+}
+
+func validateImportGraphFlags(graphqlEndpoint, gqlToken, gqlClientCertFile, gqlClientKeyFile string, args []string) (importGraphOptions, error) {
+	var opts importGraphOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected a graph export file")
+	}
+	opts.file = args[0]
+	return opts, nil
+}
+
+func init() {
+	importCmd.AddCommand(importGraphCmd)
+}
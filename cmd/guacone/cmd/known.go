@@ -25,6 +25,7 @@ import (
 	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -44,6 +45,7 @@ const (
 	badLinkStr          string = "badLink"
 	goodLinkStr         string = "goodLink"
 	pkgEqualStr         string = "pkgEqual"
+	pointOfContactStr   string = "pointOfContact"
 	packageSubjectType  string = "package"
 	sourceSubjectType   string = "source"
 	artifactSubjectType string = "artifact"
@@ -103,11 +105,7 @@ var queryKnownCmd = &cobra.Command{
 		httpClient := http.Client{}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
-		t := table.NewWriter()
-		tTemp := table.Table{}
-		tTemp.Render()
-		t.AppendHeader(rowHeader)
-
+		result := &queryResult{Query: "known", Subject: opts.subject}
 		var path []string
 		switch opts.subjectType {
 		case packageSubjectType:
@@ -132,68 +130,39 @@ var queryKnownCmd = &cobra.Command{
 				Subpath:    pkgInput.Subpath,
 				Qualifiers: pkgQualifierFilter,
 			}
-			pkgResponse, err := model.Packages(ctx, gqlclient, *pkgFilter)
+			pkgs, err := clienthelpers.Packages(ctx, gqlclient, *pkgFilter)
 			if err != nil {
 				logger.Fatalf("error querying for package: %v", err)
 			}
-			if len(pkgResponse.Packages) != 1 {
+			if len(pkgs) != 1 {
 				logger.Fatalf("failed to located package based on purl")
 			}
 
-			pkgNameNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, pkgResponse.Packages[0].Namespaces[0].Names[0].Id)
+			pkgNameNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, pkgs[0].Namespaces[0].Names[0].Id)
 			if err != nil {
 				logger.Fatalf("error querying for package name neighbors: %v", err)
 			}
-			t.SetTitle("Package Name Nodes")
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgNameNeighbors, hasSrcAtStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgNameNeighbors, badLinkStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgNameNeighbors, goodLinkStr, packageSubjectType))
-			t.AppendSeparator()
-
-			path = append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Id,
-				pkgResponse.Packages[0].Namespaces[0].Id,
-				pkgResponse.Packages[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
-
-			pkgVersionNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id)
+			pkgNameGroup := getKnownGroup(ctx, gqlclient, "Package Name Nodes", pkgNameNeighbors, packageSubjectType,
+				hasSrcAtStr, badLinkStr, goodLinkStr)
+
+			path = append([]string{pkgs[0].Namespaces[0].Names[0].Id,
+				pkgs[0].Namespaces[0].Id,
+				pkgs[0].Id}, neighborsPath...)
+			pkgNameGroup.setPath(path)
+			result.Results = append(result.Results, pkgNameGroup)
+
+			pkgVersionNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, pkgs[0].Namespaces[0].Names[0].Versions[0].Id)
 			if err != nil {
 				logger.Fatalf("error querying for package version neighbors: %v", err)
 			}
 
-			// instantiate new table for package version nodes
-			t := table.NewWriter()
-			tTemp := table.Table{}
-			tTemp.Render()
-			t.AppendHeader(rowHeader)
-
-			t.SetTitle("Package Version Nodes")
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, hasSrcAtStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, occurrenceStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, certifyVulnStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, hasSBOMStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, hasSLSAStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, vexLinkStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, pkgEqualStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, badLinkStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, goodLinkStr, packageSubjectType))
-			path = append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id,
-				pkgResponse.Packages[0].Namespaces[0].Names[0].Id, pkgResponse.Packages[0].Namespaces[0].Id,
-				pkgResponse.Packages[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+			pkgVersionGroup := getKnownGroup(ctx, gqlclient, "Package Version Nodes", pkgVersionNeighbors, packageSubjectType,
+				hasSrcAtStr, occurrenceStr, certifyVulnStr, hasSBOMStr, hasSLSAStr, vexLinkStr, pkgEqualStr, badLinkStr, goodLinkStr)
+			path = append([]string{pkgs[0].Namespaces[0].Names[0].Versions[0].Id,
+				pkgs[0].Namespaces[0].Names[0].Id, pkgs[0].Namespaces[0].Id,
+				pkgs[0].Id}, neighborsPath...)
+			pkgVersionGroup.setPath(path)
+			result.Results = append(result.Results, pkgVersionGroup)
 
 		case sourceSubjectType:
 			srcInput, err := helpers.VcsToSrc(opts.subject)
@@ -208,31 +177,23 @@ var queryKnownCmd = &cobra.Command{
 				Tag:       srcInput.Tag,
 				Commit:    srcInput.Commit,
 			}
-			srcResponse, err := model.Sources(ctx, gqlclient, *srcFilter)
+			srcs, err := clienthelpers.Sources(ctx, gqlclient, *srcFilter)
 			if err != nil {
 				logger.Fatalf("error querying for sources: %v", err)
 			}
-			if len(srcResponse.Sources) != 1 {
+			if len(srcs) != 1 {
 				logger.Fatalf("failed to located sources based on vcs")
 			}
-			sourceNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, srcResponse.Sources[0].Namespaces[0].Names[0].Id)
+			sourceNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, srcs[0].Namespaces[0].Names[0].Id)
 			if err != nil {
 				logger.Fatalf("error querying for source neighbors: %v", err)
 			}
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, hasSrcAtStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, occurrenceStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, scorecardStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, badLinkStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, goodLinkStr, sourceSubjectType))
-			path = append([]string{srcResponse.Sources[0].Namespaces[0].Names[0].Id,
-				srcResponse.Sources[0].Namespaces[0].Id, srcResponse.Sources[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+			sourceGroup := getKnownGroup(ctx, gqlclient, "", sourceNeighbors, sourceSubjectType,
+				hasSrcAtStr, occurrenceStr, scorecardStr, badLinkStr, goodLinkStr)
+			path = append([]string{srcs[0].Namespaces[0].Names[0].Id,
+				srcs[0].Namespaces[0].Id, srcs[0].Id}, neighborsPath...)
+			sourceGroup.setPath(path)
+			result.Results = append(result.Results, sourceGroup)
 		case artifactSubjectType:
 			split := strings.Split(opts.subject, ":")
 			if len(split) != 2 {
@@ -243,37 +204,29 @@ var queryKnownCmd = &cobra.Command{
 				Digest:    ptrfrom.String(strings.ToLower(string(split[1]))),
 			}
 
-			artifactResponse, err := model.Artifacts(ctx, gqlclient, *artifactFilter)
+			artifacts, err := clienthelpers.Artifacts(ctx, gqlclient, *artifactFilter)
 			if err != nil {
 				logger.Fatalf("error querying for artifacts: %v", err)
 			}
-			if len(artifactResponse.Artifacts) != 1 {
+			if len(artifacts) != 1 {
 				logger.Fatalf("failed to located artifacts based on (algorithm:digest)")
 			}
-			artifactNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, artifactResponse.Artifacts[0].Id)
+			artifactNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, artifacts[0].Id)
 			if err != nil {
 				logger.Fatalf("error querying for artifact neighbors: %v", err)
 			}
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, hashEqualStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, occurrenceStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, hasSBOMStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, hasSLSAStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, vexLinkStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, badLinkStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, goodLinkStr, artifactSubjectType))
-			path = append([]string{artifactResponse.Artifacts[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+			artifactGroup := getKnownGroup(ctx, gqlclient, "", artifactNeighbors, artifactSubjectType,
+				hashEqualStr, occurrenceStr, hasSBOMStr, hasSLSAStr, vexLinkStr, badLinkStr, goodLinkStr)
+			path = append([]string{artifacts[0].Id}, neighborsPath...)
+			artifactGroup.setPath(path)
+			result.Results = append(result.Results, artifactGroup)
 		default:
 			logger.Fatalf("expected type to be either a package, source or artifact")
 		}
+
+		if err := writeQueryResult(os.Stdout, viper.GetString("output"), result); err != nil {
+			logger.Fatalf("unable to write query result: %v", err)
+		}
 	},
 }
 
@@ -326,44 +279,63 @@ func queryKnownNeighbors(ctx context.Context, gqlclient graphql.Client, subjectQ
 	return collectedNeighbors, path, nil
 }
 
-func getOutputBasedOnNode(ctx context.Context, gqlclient graphql.Client, collectedNeighbors *neighbors, nodeType string, subjectType string) []table.Row {
+// getKnownGroup returns the nodes of the node types among the collected
+// neighbors, in order.
+func getKnownGroup(ctx context.Context, gqlclient graphql.Client, title string, collectedNeighbors *neighbors, subjectType string, nodeTypes ...string) resultGroup {
+	group := resultGroup{Title: title, separated: true}
+	for _, nodeType := range nodeTypes {
+		group.Nodes = append(group.Nodes, getOutputBasedOnNode(ctx, gqlclient, collectedNeighbors, nodeType, subjectType)...)
+	}
+	return group
+}
+
+func getOutputBasedOnNode(ctx context.Context, gqlclient graphql.Client, collectedNeighbors *neighbors, nodeType string, subjectType string) []resultNode {
 	logger := logging.FromContext(ctx)
-	var tableRows []table.Row
+	var nodes []resultNode
 	switch nodeType {
 	case certifyVulnStr:
 		for _, certVuln := range collectedNeighbors.certifyVulns {
 			if certVuln.Vulnerability.Type != noVulnType {
 				for _, vuln := range certVuln.Vulnerability.VulnerabilityIDs {
-					tableRows = append(tableRows, table.Row{certifyVulnStr, certVuln.Id, "vulnerability ID: " + vuln.VulnerabilityID})
+					nodes = append(nodes, resultNode{
+						ID:              certVuln.Id,
+						Type:            certifyVulnStr,
+						Info:            "vulnerability ID: " + vuln.VulnerabilityID,
+						VulnerabilityID: vuln.VulnerabilityID,
+					})
 				}
 			} else {
-				tableRows = append(tableRows, table.Row{certifyVulnStr, certVuln.Id, "vulnerability ID: " + noVulnType})
+				nodes = append(nodes, resultNode{ID: certVuln.Id, Type: certifyVulnStr, Info: "vulnerability ID: " + noVulnType})
 			}
 		}
 	case badLinkStr:
 		for _, bad := range collectedNeighbors.badLinks {
-			tableRows = append(tableRows, table.Row{badLinkStr, bad.Id, "justification: " + bad.Justification})
+			nodes = append(nodes, resultNode{ID: bad.Id, Type: badLinkStr, Info: "justification: " + bad.Justification})
 		}
 	case goodLinkStr:
 		for _, good := range collectedNeighbors.goodLinks {
-			tableRows = append(tableRows, table.Row{goodLinkStr, good.Id, "justification: " + good.Justification})
+			nodes = append(nodes, resultNode{ID: good.Id, Type: goodLinkStr, Info: "justification: " + good.Justification})
 		}
 	case scorecardStr:
 		for _, score := range collectedNeighbors.scorecards {
-			tableRows = append(tableRows, table.Row{scorecardStr, score.Id, "Overall Score: " + fmt.Sprintf("%f", score.Scorecard.AggregateScore)})
+			nodes = append(nodes, resultNode{ID: score.Id, Type: scorecardStr, Info: "Overall Score: " + fmt.Sprintf("%f", score.Scorecard.AggregateScore)})
 		}
 	case vexLinkStr:
 		for _, vex := range collectedNeighbors.vexLinks {
-			tableRows = append(tableRows, table.Row{vexLinkStr, vex.Id, "Vex Status: " + vex.Status})
+			node := resultNode{ID: vex.Id, Type: vexLinkStr, Info: "Vex Status: " + string(vex.Status), Status: string(vex.Status)}
+			if len(vex.Vulnerability.VulnerabilityIDs) > 0 {
+				node.VulnerabilityID = vex.Vulnerability.VulnerabilityIDs[0].VulnerabilityID
+			}
+			nodes = append(nodes, node)
 		}
 	case hasSBOMStr:
 		for _, sbom := range collectedNeighbors.hasSBOMs {
-			tableRows = append(tableRows, table.Row{hasSBOMStr, sbom.Id, "SBOM Download Location: " + sbom.DownloadLocation})
+			nodes = append(nodes, resultNode{ID: sbom.Id, Type: hasSBOMStr, Info: "SBOM Download Location: " + sbom.DownloadLocation})
 		}
 	case hasSLSAStr:
 		if len(collectedNeighbors.hasSLSAs) > 0 {
 			for _, slsa := range collectedNeighbors.hasSLSAs {
-				tableRows = append(tableRows, table.Row{hasSLSAStr, slsa.Id, "SLSA Attestation Location: " + slsa.Slsa.Origin})
+				nodes = append(nodes, resultNode{ID: slsa.Id, Type: hasSLSAStr, Info: "SLSA Attestation Location: " + slsa.Slsa.Origin})
 			}
 		} else {
 			// if there is an isOccurrence, check to see if there are slsa attestation associated with it
@@ -372,20 +344,20 @@ func getOutputBasedOnNode(ctx context.Context, gqlclient graphql.Client, collect
 					Algorithm: &occurrence.Artifact.Algorithm,
 					Digest:    &occurrence.Artifact.Digest,
 				}
-				artifactResponse, err := model.Artifacts(ctx, gqlclient, *artifactFilter)
+				artifacts, err := clienthelpers.Artifacts(ctx, gqlclient, *artifactFilter)
 				if err != nil {
 					logger.Debugf("error querying for artifacts: %v", err)
 				}
-				if len(artifactResponse.Artifacts) != 1 {
+				if len(artifacts) != 1 {
 					logger.Debugf("failed to located artifacts based on (algorithm:digest)")
 				}
-				neighborResponseHasSLSA, err := model.Neighbors(ctx, gqlclient, artifactResponse.Artifacts[0].Id, []model.Edge{model.EdgeArtifactHasSlsa})
+				neighborResponseHasSLSA, err := model.Neighbors(ctx, gqlclient, artifacts[0].Id, []model.Edge{model.EdgeArtifactHasSlsa})
 				if err != nil {
 					logger.Debugf("error querying neighbors: %v", err)
 				} else {
 					for _, neighborHasSLSA := range neighborResponseHasSLSA.Neighbors {
 						if hasSLSA, ok := neighborHasSLSA.(*model.NeighborsNeighborsHasSLSA); ok {
-							tableRows = append(tableRows, table.Row{hasSLSAStr, hasSLSA.Id, "SLSA Attestation Location: " + hasSLSA.Slsa.Origin})
+							nodes = append(nodes, resultNode{ID: hasSLSA.Id, Type: hasSLSAStr, Info: "SLSA Attestation Location: " + hasSLSA.Slsa.Origin})
 						}
 					}
 				}
@@ -400,18 +372,18 @@ func getOutputBasedOnNode(ctx context.Context, gqlclient graphql.Client, collect
 				} else {
 					namespace = src.Source.Namespaces[0].Namespace
 				}
-				tableRows = append(tableRows, table.Row{hasSrcAtStr, src.Id, "Source: " + src.Source.Type + "+" + namespace + "/" +
+				nodes = append(nodes, resultNode{ID: src.Id, Type: hasSrcAtStr, Info: "Source: " + src.Source.Type + "+" + namespace + "/" +
 					src.Source.Namespaces[0].Names[0].Name})
 			} else {
 				purl := helpers.PkgToPurl(src.Package.Type, src.Package.Namespaces[0].Namespace,
 					src.Package.Namespaces[0].Names[0].Name, "", "", []string{})
 
-				tableRows = append(tableRows, table.Row{hasSrcAtStr, src.Id, "Source for Package: " + purl})
+				nodes = append(nodes, resultNode{ID: src.Id, Type: hasSrcAtStr, Info: "Source for Package: " + purl})
 			}
 		}
 	case hashEqualStr:
 		for _, hash := range collectedNeighbors.hashEquals {
-			tableRows = append(tableRows, table.Row{hashEqualStr, hash.Id, ""})
+			nodes = append(nodes, resultNode{ID: hash.Id, Type: hashEqualStr})
 		}
 	case occurrenceStr:
 		for _, occurrence := range collectedNeighbors.occurrences {
@@ -421,7 +393,7 @@ func getOutputBasedOnNode(ctx context.Context, gqlclient graphql.Client, collect
 					purl := helpers.PkgToPurl(v.Type, v.Namespaces[0].Namespace,
 						v.Namespaces[0].Names[0].Name, v.Namespaces[0].Names[0].Versions[0].Version, "", []string{})
 
-					tableRows = append(tableRows, table.Row{occurrenceStr, occurrence.Id, "Occurrence for Package: " + purl})
+					nodes = append(nodes, resultNode{ID: occurrence.Id, Type: occurrenceStr, Info: "Occurrence for Package: " + purl})
 				case *model.AllIsOccurrencesTreeSubjectSource:
 					namespace := ""
 					if !strings.HasPrefix(v.Namespaces[0].Namespace, "https://") {
@@ -429,20 +401,20 @@ func getOutputBasedOnNode(ctx context.Context, gqlclient graphql.Client, collect
 					} else {
 						namespace = v.Namespaces[0].Namespace
 					}
-					tableRows = append(tableRows, table.Row{occurrenceStr, occurrence.Id, "Occurrence for Package: " + v.Type + "+" + namespace + "/" +
+					nodes = append(nodes, resultNode{ID: occurrence.Id, Type: occurrenceStr, Info: "Occurrence for Package: " + v.Type + "+" + namespace + "/" +
 						v.Namespaces[0].Names[0].Name})
 				}
 			} else {
-				tableRows = append(tableRows, table.Row{occurrenceStr, occurrence.Id, "Occurrence for Artifact: " + occurrence.Artifact.Algorithm + ":" + occurrence.Artifact.Digest})
+				nodes = append(nodes, resultNode{ID: occurrence.Id, Type: occurrenceStr, Info: "Occurrence for Artifact: " + occurrence.Artifact.Algorithm + ":" + occurrence.Artifact.Digest})
 			}
 		}
 	case pkgEqualStr:
 		for _, equal := range collectedNeighbors.pkgEquals {
-			tableRows = append(tableRows, table.Row{pkgEqualStr, equal.Id, ""})
+			nodes = append(nodes, resultNode{ID: equal.Id, Type: pkgEqualStr})
 		}
 	}
 
-	return tableRows
+	return nodes
 }
 
 func validateQueryKnownFlags(graphqlEndpoint string, args []string) (queryKnownOptions, error) {
@@ -21,6 +21,8 @@ import (
 	"os"
 	"time"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/collectsub/client"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
@@ -37,6 +39,7 @@ import (
 
 type ociOptions struct {
 	graphqlEndpoint   string
+	gqlClientOptions  auth.ClientOptions
 	dataSource        datasource.CollectSource
 	csubClientOptions client.CsubClientOptions
 }
@@ -51,6 +54,9 @@ var ociCmd = &cobra.Command{
 
 		opts, err := validateOCIFlags(
 			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
 			viper.GetString("csub-addr"),
 			viper.GetBool("csub-tls"),
 			viper.GetBool("csub-tls-skip-verify"),
@@ -68,6 +74,12 @@ var ociCmd = &cobra.Command{
 			logger.Errorf("unable to register oci collector: %v", err)
 		}
 
+		httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
+
 		// initialize collectsub client
 		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
 		if err != nil {
@@ -80,9 +92,9 @@ var ociCmd = &cobra.Command{
 		totalNum := 0
 		gotErr := false
 		// Set emit function to go through the entire pipeline
-		emit := func(d *processor.Document) error {
+		emit := func(ctx context.Context, d *processor.Document) error {
 			totalNum += 1
-			err := ingestor.Ingest(ctx, d, opts.graphqlEndpoint, csubClient)
+			err := ingestor.Ingest(ctx, d, gqlclient, csubClient)
 
 			if err != nil {
 				gotErr = true
@@ -112,9 +124,14 @@ var ociCmd = &cobra.Command{
 	},
 }
 
-func validateOCIFlags(gqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, args []string) (ociOptions, error) {
+func validateOCIFlags(gqlEndpoint string, gqlToken string, gqlClientCertFile string, gqlClientKeyFile string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, args []string) (ociOptions, error) {
 	var opts ociOptions
 	opts.graphqlEndpoint = gqlEndpoint
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
 
 	csubOpts, err := client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
 	if err != nil {
@@ -18,7 +18,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"os/signal"
 	"sync"
@@ -27,10 +26,12 @@ import (
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/certifier"
 	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
 	"github.com/guacsec/guac/pkg/certifier/osv"
+	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/collectsub/client"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/handler/processor"
@@ -42,9 +43,11 @@ import (
 
 type osvOptions struct {
 	graphqlEndpoint   string
+	gqlClientOptions  auth.ClientOptions
 	poll              bool
 	csubClientOptions client.CsubClientOptions
 	interval          time.Duration
+	offlineDB         string
 }
 
 var osvCmd = &cobra.Command{
@@ -56,11 +59,15 @@ var osvCmd = &cobra.Command{
 
 		opts, err := validateOSVFlags(
 			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
 			viper.GetBool("poll"),
 			viper.GetString("interval"),
 			viper.GetString("csub-addr"),
 			viper.GetBool("csub-tls"),
 			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetString("offline-db"),
 		)
 
 		if err != nil {
@@ -69,7 +76,17 @@ var osvCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		if err := certify.RegisterCertifier(osv.NewOSVCertificationParser, certifier.CertifierOSV); err != nil {
+		osvCertifier := osv.NewOSVCertificationParser
+		if opts.offlineDB != "" {
+			offlineCertifier, err := osv.NewOfflineOSVCertificationParser(opts.offlineDB)
+			if err != nil {
+				logger.Fatalf("unable to load offline OSV database: %v", err)
+			}
+			// this is to satisfy the RegisterCertifier function
+			osvCertifier = func() certifier.Certifier { return offlineCertifier }
+		}
+
+		if err := certify.RegisterCertifier(osvCertifier, certifier.CertifierOSV); err != nil {
 			logger.Fatalf("unable to register certifier: %v", err)
 		}
 
@@ -82,8 +99,11 @@ var osvCmd = &cobra.Command{
 			defer csubClient.Close()
 		}
 
-		httpClient := http.Client{}
-		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+		httpClient, err := auth.NewHTTPClient(opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, httpClient)
 		packageQuery := root_package.NewPackageQuery(gqlclient, 0)
 
 		totalNum := 0
@@ -103,7 +123,7 @@ var osvCmd = &cobra.Command{
 				select {
 				case <-ticker.C:
 					if len(totalDocs) > 0 {
-						err = ingestor.MergedIngest(ctx, totalDocs, opts.graphqlEndpoint, csubClient)
+						err = ingestor.MergedIngest(ctx, totalDocs, gqlclient, csubClient)
 						if err != nil {
 							stop = true
 							atomic.StoreInt32(&gotErr, 1)
@@ -116,7 +136,7 @@ var osvCmd = &cobra.Command{
 					totalNum += 1
 					totalDocs = append(totalDocs, d)
 					if len(totalDocs) >= threshold {
-						err = ingestor.MergedIngest(ctx, totalDocs, opts.graphqlEndpoint, csubClient)
+						err = ingestor.MergedIngest(ctx, totalDocs, gqlclient, csubClient)
 						if err != nil {
 							stop = true
 							atomic.StoreInt32(&gotErr, 1)
@@ -135,7 +155,7 @@ var osvCmd = &cobra.Command{
 				totalNum += 1
 				totalDocs = append(totalDocs, <-docChan)
 				if len(totalDocs) >= threshold {
-					err = ingestor.MergedIngest(ctx, totalDocs, opts.graphqlEndpoint, csubClient)
+					err = ingestor.MergedIngest(ctx, totalDocs, gqlclient, csubClient)
 					if err != nil {
 						atomic.StoreInt32(&gotErr, 1)
 						logger.Errorf("unable to ingest documents: %v", err)
@@ -144,7 +164,7 @@ var osvCmd = &cobra.Command{
 				}
 			}
 			if len(totalDocs) > 0 {
-				err = ingestor.MergedIngest(ctx, totalDocs, opts.graphqlEndpoint, csubClient)
+				err = ingestor.MergedIngest(ctx, totalDocs, gqlclient, csubClient)
 				if err != nil {
 					atomic.StoreInt32(&gotErr, 1)
 					logger.Errorf("unable to ingest documents: %v", err)
@@ -203,9 +223,15 @@ var osvCmd = &cobra.Command{
 	},
 }
 
-func validateOSVFlags(graphqlEndpoint string, poll bool, interval string, csubAddr string, csubTls bool, csubTlsSkipVerify bool) (osvOptions, error) {
+func validateOSVFlags(graphqlEndpoint string, gqlToken string, gqlClientCertFile string, gqlClientKeyFile string, poll bool, interval string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, offlineDB string) (osvOptions, error) {
 	var opts osvOptions
 	opts.graphqlEndpoint = graphqlEndpoint
+	opts.offlineDB = offlineDB
+	gqlClientOpts, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+	opts.gqlClientOptions = gqlClientOpts
 	opts.poll = poll
 	i, err := time.ParseDuration(interval)
 	if err != nil {
@@ -223,5 +249,16 @@ func validateOSVFlags(graphqlEndpoint string, poll bool, interval string, csubAd
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"offline-db"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	osvCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(osvCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
 	certifierCmd.AddCommand(osvCmd)
 }
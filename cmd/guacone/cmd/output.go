@@ -0,0 +1,317 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// formats of the output of the query commands
+const (
+	outputTable string = "table"
+	outputJSON  string = "json"
+	outputSARIF string = "sarif"
+)
+
+const visualizerURL = "http://localhost:3000/?path="
+
+// queryResult is the output of a query command. Unlike the tables, its
+// schema is stable, so that it can be consumed by other tools.
+type queryResult struct {
+	// Query is the query command, such as vuln or known
+	Query string `json:"query"`
+	// Subject is the package, source or artifact queried, if any
+	Subject string `json:"subject,omitempty"`
+	// Results are the sections of the output
+	Results []resultGroup `json:"results"`
+}
+
+// resultGroup is a section of the output of a query command, with the nodes
+// found and the path between them in the graph.
+type resultGroup struct {
+	Title string       `json:"title,omitempty"`
+	Nodes []resultNode `json:"nodes"`
+	// Path are the IDs of the nodes of the subgraph of the section
+	Path          []string `json:"path,omitempty"`
+	VisualizerURL string   `json:"visualizerUrl,omitempty"`
+	// Message explains the section, such as when nothing is found
+	Message string `json:"message,omitempty"`
+	// separated separates the nodes of different types in the table
+	separated bool
+}
+
+// resultNode is a node found by a query command
+type resultNode struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// Info is the human readable information of the table
+	Info string `json:"info,omitempty"`
+	// VulnerabilityID is set on the nodes linking to a vulnerability
+	VulnerabilityID string `json:"vulnerabilityId,omitempty"`
+	// Status is the VEX status of vexLink nodes
+	Status string `json:"status,omitempty"`
+	// Subject is the purl, source or artifact the node is about, if known
+	Subject string `json:"subject,omitempty"`
+	// SubjectID is the ID of the node the node is about, if known
+	SubjectID string `json:"subjectId,omitempty"`
+}
+
+// setPath sets the path of the group, without duplicate nodes, and the URL of
+// the visualizer showing it.
+func (g *resultGroup) setPath(path []string) {
+	g.Path = removeDuplicateValuesFromPath(path)
+	g.VisualizerURL = visualizerURL + strings.Join(g.Path, `,`)
+}
+
+func validateOutputFlag(output string) error {
+	switch output {
+	case outputTable, outputJSON, outputSARIF:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format %q, expected table, json or sarif", output)
+	}
+}
+
+// writeQueryResult writes the result of a query command to w in the output
+// format.
+func writeQueryResult(w io.Writer, output string, r *queryResult) error {
+	switch output {
+	case outputJSON:
+		if r.Results == nil {
+			r.Results = []resultGroup{}
+		}
+		for i := range r.Results {
+			if r.Results[i].Nodes == nil {
+				r.Results[i].Nodes = []resultNode{}
+			}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	case outputSARIF:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(toSARIF(r))
+	default:
+		return writeTable(w, r)
+	}
+}
+
+func writeTable(w io.Writer, r *queryResult) error {
+	for _, g := range r.Results {
+		if len(g.Nodes) > 0 {
+			t := table.NewWriter()
+			if g.Title != "" {
+				t.SetTitle(g.Title)
+			}
+			t.AppendHeader(rowHeader)
+			for i, n := range g.Nodes {
+				if g.separated && i > 0 && n.Type != g.Nodes[i-1].Type {
+					t.AppendSeparator()
+				}
+				t.AppendRow(table.Row{n.Type, n.ID, n.Info})
+			}
+			if _, err := fmt.Fprintln(w, t.Render()); err != nil {
+				return err
+			}
+		}
+		if g.VisualizerURL != "" {
+			if _, err := fmt.Fprintf(w, "Visualizer url: %s\n", g.VisualizerURL); err != nil {
+				return err
+			}
+		}
+		if g.Message != "" {
+			if _, err := fmt.Fprintln(w, g.Message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pkgTreePurl returns the purl of the package tree, with the version of its
+// first version, if any.
+func pkgTreePurl(pkg model.AllPkgTree) string {
+	version := ""
+	if len(pkg.Namespaces[0].Names[0].Versions) > 0 {
+		version = pkg.Namespaces[0].Names[0].Versions[0].Version
+	}
+	return helpers.PkgToPurl(pkg.Type, pkg.Namespaces[0].Namespace, pkg.Namespaces[0].Names[0].Name, version, "", []string{})
+}
+
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+	Properties          sarifProperties   `json:"properties"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+// sarifPhysicalLocation is where code scanning tools show a result. The
+// subject of the result, such as a purl, is its URI.
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+type sarifProperties struct {
+	NodeID        string   `json:"guacNodeId"`
+	NodeType      string   `json:"guacNodeType"`
+	Path          []string `json:"guacPath,omitempty"`
+	VisualizerURL string   `json:"visualizerUrl,omitempty"`
+}
+
+// toSARIF reports the vulnerabilities found by a query command, one result
+// per node linking to a vulnerability, as a SARIF log.
+func toSARIF(r *queryResult) sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "guac",
+			InformationURI: "https://guac.sh",
+			Version:        version.Version,
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+	rules := map[string]bool{}
+	for _, g := range r.Results {
+		for _, n := range g.Nodes {
+			if n.VulnerabilityID == "" || n.VulnerabilityID == noVulnType {
+				continue
+			}
+			if !rules[n.VulnerabilityID] {
+				rules[n.VulnerabilityID] = true
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+					ID:               n.VulnerabilityID,
+					ShortDescription: sarifMessage{Text: "vulnerability " + n.VulnerabilityID},
+					HelpURI:          "https://osv.dev/vulnerability/" + n.VulnerabilityID,
+				})
+			}
+			subject := n.Subject
+			if subject == "" {
+				subject = r.Subject
+			}
+			res := sarifResult{
+				RuleID:  n.VulnerabilityID,
+				Level:   sarifLevel(n),
+				Message: sarifMessage{Text: sarifText(n, subject)},
+				PartialFingerprints: map[string]string{
+					"guacSubject/v1": subject + "#" + n.VulnerabilityID,
+				},
+				Properties: sarifProperties{
+					NodeID:        n.ID,
+					NodeType:      n.Type,
+					Path:          g.Path,
+					VisualizerURL: g.VisualizerURL,
+				},
+			}
+			if subject != "" {
+				res.Locations = []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: subject}},
+					LogicalLocations: []sarifLogicalLocation{{
+						FullyQualifiedName: subject,
+						Kind:               "package",
+					}},
+				}}
+			}
+			run.Results = append(run.Results, res)
+		}
+	}
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+}
+
+func sarifLevel(n resultNode) string {
+	if n.Type != vexLinkStr {
+		return "error"
+	}
+	switch model.VexStatus(n.Status) {
+	case model.VexStatusNotAffected, model.VexStatusFixed:
+		return "note"
+	case model.VexStatusAffected:
+		return "error"
+	default:
+		return "warning"
+	}
+}
+
+func sarifText(n resultNode, subject string) string {
+	if subject == "" {
+		subject = "the package"
+	}
+	if n.Type == vexLinkStr {
+		return fmt.Sprintf("%s has VEX status %s for vulnerability %s", subject, n.Status, n.VulnerabilityID)
+	}
+	return fmt.Sprintf("%s is affected by vulnerability %s", subject, n.VulnerabilityID)
+}
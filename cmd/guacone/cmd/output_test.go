@@ -0,0 +1,154 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+var testQueryResult = queryResult{
+	Query:   "vuln",
+	Subject: "pkg:golang/github.com/example/app@v1.0.0",
+	Results: []resultGroup{{
+		Nodes: []resultNode{
+			{
+				ID:              "10",
+				Type:            certifyVulnStr,
+				Info:            "vulnerability ID: ghsa-xxxx",
+				VulnerabilityID: "ghsa-xxxx",
+				Subject:         "pkg:golang/github.com/example/lib@v0.1.0",
+			},
+			{
+				ID:              "11",
+				Type:            vexLinkStr,
+				Info:            "vulnerability ID: cve-2023-1234, Vex Status: NOT_AFFECTED",
+				VulnerabilityID: "cve-2023-1234",
+				Status:          "NOT_AFFECTED",
+			},
+			{
+				ID:   "12",
+				Type: hasSBOMStr,
+				Info: "SBOM Download Location: https://example.com/sbom.json",
+			},
+		},
+		Path:          []string{"1", "10", "11"},
+		VisualizerURL: visualizerURL + "1,10,11",
+	}},
+}
+
+func TestWriteQueryResult_JSON(t *testing.T) {
+	r := testQueryResult
+	var buf bytes.Buffer
+	if err := writeQueryResult(&buf, outputJSON, &r); err != nil {
+		t.Fatalf("writeQueryResult() error = %v", err)
+	}
+	var got queryResult
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if diff := cmp.Diff(testQueryResult, got, cmp.AllowUnexported(resultGroup{})); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+	for _, field := range []string{`"query"`, `"results"`, `"nodes"`, `"id"`, `"type"`, `"path"`, `"visualizerUrl"`} {
+		if !strings.Contains(buf.String(), field) {
+			t.Errorf("output is missing the %s field", field)
+		}
+	}
+}
+
+func TestWriteQueryResult_SARIF(t *testing.T) {
+	r := testQueryResult
+	var buf bytes.Buffer
+	if err := writeQueryResult(&buf, outputSARIF, &r); err != nil {
+		t.Fatalf("writeQueryResult() error = %v", err)
+	}
+	var got sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	schema := gojsonschema.NewReferenceLoader("file://./testdata/sarif-schema-2.1.0.json")
+	validation, err := gojsonschema.Validate(schema, gojsonschema.NewBytesLoader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to validate the SARIF log: %v", err)
+	}
+	for _, e := range validation.Errors() {
+		t.Errorf("SARIF log does not match the SARIF 2.1.0 schema: %s", e)
+	}
+	if got.Version != "2.1.0" || len(got.Runs) != 1 {
+		t.Fatalf("unexpected SARIF log: %+v", got)
+	}
+	run := got.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 {
+		t.Errorf("got %d rules, want one per vulnerability", len(run.Tool.Driver.Rules))
+	}
+	want := []struct {
+		ruleID, level, location, nodeID string
+	}{
+		{"ghsa-xxxx", "error", "pkg:golang/github.com/example/lib@v0.1.0", "10"},
+		{"cve-2023-1234", "note", "pkg:golang/github.com/example/app@v1.0.0", "11"},
+	}
+	if len(run.Results) != len(want) {
+		t.Fatalf("got %d results, want %d", len(run.Results), len(want))
+	}
+	for i, w := range want {
+		res := run.Results[i]
+		if res.RuleID != w.ruleID || res.Level != w.level || res.Properties.NodeID != w.nodeID {
+			t.Errorf("result %d = %+v, want rule %s, level %s and node %s", i, res, w.ruleID, w.level, w.nodeID)
+		}
+		if len(res.Locations) != 1 || res.Locations[0].LogicalLocations[0].FullyQualifiedName != w.location ||
+			res.Locations[0].PhysicalLocation.ArtifactLocation.URI != w.location {
+			t.Errorf("result %d locations = %+v, want %s", i, res.Locations, w.location)
+		}
+		if diff := cmp.Diff([]string{"1", "10", "11"}, res.Properties.Path); diff != "" {
+			t.Errorf("result %d path (-want +got):\n%s", i, diff)
+		}
+	}
+}
+
+func TestWriteQueryResult_Table(t *testing.T) {
+	r := queryResult{
+		Query: "vuln",
+		Results: []resultGroup{
+			testQueryResult.Results[0],
+			{Message: "No path to vulnerabilities found!"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := writeQueryResult(&buf, outputTable, &r); err != nil {
+		t.Fatalf("writeQueryResult() error = %v", err)
+	}
+	for _, s := range []string{"NODE TYPE", "ghsa-xxxx", "Visualizer url: " + visualizerURL + "1,10,11", "No path to vulnerabilities found!"} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("table output is missing %q:\n%s", s, buf.String())
+		}
+	}
+}
+
+func TestValidateOutputFlag(t *testing.T) {
+	for _, output := range []string{outputTable, outputJSON, outputSARIF} {
+		if err := validateOutputFlag(output); err != nil {
+			t.Errorf("validateOutputFlag(%q) error = %v", output, err)
+		}
+	}
+	if err := validateOutputFlag("yaml"); err == nil {
+		t.Error("validateOutputFlag(\"yaml\") did not fail")
+	}
+}
@@ -20,10 +20,10 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"strings"
 
 	"github.com/Khan/genqlient/graphql"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/cli"
 	analysis "github.com/guacsec/guac/pkg/guacanalytics"
@@ -92,13 +92,19 @@ var queryPatchCmd = &cobra.Command{
 			logger.Fatalf("error searching dependents-- %s\n", err)
 		}
 
+		output := viper.GetString("output")
 		frontiers, infoNodes, err := analysis.TopoSortFromBfsNodeMap(ctx, gqlClient, bfsMap)
 
 		if err != nil {
-			fmt.Printf("WARNING: There was cycle detected in the toposort so the results are incomplete: %s\n", err)
+			if output == outputTable {
+				fmt.Printf("WARNING: There was cycle detected in the toposort so the results are incomplete: %s\n", err)
+			} else {
+				logger.Warnf("there was cycle detected in the toposort so the results are incomplete: %s", err)
+			}
 		}
 
-		var poc []string
+		var frontierGroups []resultGroup
+		var poc []resultNode
 		for level := 0; level < len(frontiers); level++ {
 			frontierList := frontiers[level]
 			allNodes := []string{}
@@ -106,53 +112,91 @@ var queryPatchCmd = &cobra.Command{
 				path = append(path, id)
 				allNodes = append(allNodes, id)
 			}
-			fmt.Printf("\n---FRONTIER LEVEL %d---\n", level)
 
-			nodesInfo, err := printNodesInfo(ctx, gqlClient, bfsMap, allNodes)
+			nodes, nodesPOC, err := getNodesInfo(ctx, gqlClient, bfsMap, allNodes)
 
 			if err != nil {
 				logger.Fatalf("%s", err)
 			}
 
-			poc = append(poc, nodesInfo...)
+			frontierGroups = append(frontierGroups, resultGroup{Title: fmt.Sprintf("Frontier level %d", level), Nodes: nodes})
+			poc = append(poc, nodesPOC...)
 		}
 
-		fmt.Printf("\n---INFO NODES---\n")
+		infoGroup := resultGroup{Title: "Info nodes"}
 		if len(infoNodes) == 0 {
-			fmt.Printf("no info nodes found\n")
+			infoGroup.Message = "no info nodes found"
 		} else {
-			nodesInfo, err := printNodesInfo(ctx, gqlClient, bfsMap, infoNodes)
+			nodes, nodesPOC, err := getNodesInfo(ctx, gqlClient, bfsMap, infoNodes)
 
 			if err != nil {
 				logger.Fatalf("%s", err)
 			}
 
-			poc = append(poc, nodesInfo...)
+			infoGroup.Nodes = nodes
+			poc = append(poc, nodesPOC...)
 		}
 
-		fmt.Printf("\n---POINTS OF CONTACT---")
+		pocGroup := resultGroup{Title: "Points of contact", Nodes: poc}
 		if len(poc) == 0 {
-			fmt.Printf("\nno POCs found\n")
+			pocGroup.Message = "no POCs found"
+		}
+
+		subgraphGroup := resultGroup{Title: "Subgraph"}
+		subgraphGroup.setPath(path)
+
+		if output != outputTable {
+			result := &queryResult{Query: "patch", Subject: opts.startPurl}
+			result.Results = append(result.Results, frontierGroups...)
+			result.Results = append(result.Results, infoGroup, pocGroup, subgraphGroup)
+			if err := writeQueryResult(os.Stdout, output, result); err != nil {
+				logger.Fatalf("unable to write query result: %v", err)
+			}
+			return
+		}
+
+		for level, frontierGroup := range frontierGroups {
+			fmt.Printf("\n---FRONTIER LEVEL %d---\n", level)
+			for _, node := range frontierGroup.Nodes {
+				fmt.Printf("%s: %s\n", node.ID, node.Info)
+			}
+		}
+
+		fmt.Printf("\n---INFO NODES---\n")
+		if len(infoGroup.Nodes) == 0 {
+			fmt.Printf("%s\n", infoGroup.Message)
 		} else {
-			for _, id := range poc {
-				fmt.Printf("\n%s: %s", id, makePOCPretty(bfsMap[id].PointOfContact))
+			for _, node := range infoGroup.Nodes {
+				fmt.Printf("%s: %s\n", node.ID, node.Info)
 			}
 		}
 
-		fmt.Printf("\n\n---SUBGRAPH VISUALIZER URL--- \nhttp://localhost:3000/?path=%s\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+		fmt.Printf("\n---POINTS OF CONTACT---")
+		if len(pocGroup.Nodes) == 0 {
+			fmt.Printf("\n%s\n", pocGroup.Message)
+		} else {
+			for _, node := range pocGroup.Nodes {
+				fmt.Printf("\n%s: %s", node.SubjectID, node.Info)
+			}
+		}
+
+		fmt.Printf("\n\n---SUBGRAPH VISUALIZER URL--- \n%s\n", subgraphGroup.VisualizerURL)
 	},
 }
 
-func printNodesInfo(ctx context.Context, gqlClient graphql.Client, bfsMap map[string]analysis.BfsNode, nodes []string) ([]string, error) {
-	poc := []string{}
+// getNodesInfo returns the packages, sources and artifacts of the nodes and
+// the points of contact found for them.
+func getNodesInfo(ctx context.Context, gqlClient graphql.Client, bfsMap map[string]analysis.BfsNode, nodes []string) ([]resultNode, []resultNode, error) {
+	var infoNodes []resultNode
+	poc := []resultNode{}
 	for _, id := range nodes {
 		node, err := model.Node(ctx, gqlClient, id)
 
 		if err != nil {
-			fmt.Printf("error: %s \n", err)
+			fmt.Fprintf(os.Stderr, "error: %s \n", err)
 		}
 
-		var pretty string
+		var pretty, nodeType string
 		switch node := node.Node.(type) {
 		case *model.NodeNodePackage:
 			if bfsMap[id].Type == analysis.PackageName {
@@ -160,21 +204,30 @@ func printNodesInfo(ctx context.Context, gqlClient graphql.Client, bfsMap map[st
 			} else {
 				pretty = makePkgPretty(*node, true)
 			}
+			nodeType = packageSubjectType
 		case *model.NodeNodeSource:
 			pretty = makeSrcPretty(*node)
+			nodeType = sourceSubjectType
 		case *model.NodeNodeArtifact:
 			pretty = makeArtifactPretty(*node)
+			nodeType = artifactSubjectType
 		default:
-			return nil, fmt.Errorf("discovered unexpected node type in bfsMap (expect plg, source, or artifact)")
+			return nil, nil, fmt.Errorf("discovered unexpected node type in bfsMap (expect plg, source, or artifact)")
 		}
 
-		fmt.Printf("%s: %s\n", id, pretty)
+		infoNodes = append(infoNodes, resultNode{ID: id, Type: nodeType, Info: pretty, Subject: pretty})
 
 		if bfsMap[id].PointOfContact.Email != "" {
-			poc = append(poc, id)
+			poc = append(poc, resultNode{
+				ID:        bfsMap[id].PointOfContact.Id,
+				Type:      pointOfContactStr,
+				Info:      makePOCPretty(bfsMap[id].PointOfContact),
+				Subject:   pretty,
+				SubjectID: id,
+			})
 		}
 	}
-	return poc, nil
+	return infoNodes, poc, nil
 }
 
 func makePOCPretty(poc model.AllPointOfContact) string {
@@ -245,9 +298,9 @@ func getPkgID(ctx context.Context, gqlClient graphql.Client, purl string, isPack
 		}
 	}
 
-	pkgResponse, err := model.Packages(ctx, gqlClient, pkgFilter)
+	pkgs, err := clienthelpers.Packages(ctx, gqlClient, pkgFilter)
 
-	if err != nil || len(pkgResponse.Packages) == 0 {
+	if err != nil || len(pkgs) == 0 {
 		if err != nil {
 			return "", fmt.Errorf("error finding package with given purl: %s, got error: %s", purl, err)
 		}
@@ -255,9 +308,9 @@ func getPkgID(ctx context.Context, gqlClient graphql.Client, purl string, isPack
 	}
 
 	if version {
-		return pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id, nil
+		return pkgs[0].Namespaces[0].Names[0].Versions[0].Id, nil
 	}
-	return pkgResponse.Packages[0].Namespaces[0].Names[0].Id, nil
+	return pkgs[0].Namespaces[0].Names[0].Id, nil
 }
 
 func validateQueryPatchFlags(graphqlEndpoint, startPurl string, stopPurl string, depth int, isPackageVersionStart bool, isPackageVersionStop bool, args []string) (queryPatchOptions, error) {
@@ -0,0 +1,106 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type policyEvalOptions struct {
+	graphqlEndpoint string
+	policy          *policy.Policy
+	subject         string
+}
+
+var policyEvalCmd = &cobra.Command{
+	Use:   "eval [flags] <purl | algorithm:digest>",
+	Short: "evaluate a policy against a package or artifact, exiting with a non-zero status if any rule fails",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validatePolicyEvalFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("policy"),
+			args,
+		)
+
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{}
+		gqlClient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		report, err := policy.Evaluate(ctx, gqlClient, opts.policy, opts.subject)
+		if err != nil {
+			logger.Fatalf("error evaluating policy: %v", err)
+		}
+		if err := report.Write(os.Stdout); err != nil {
+			logger.Fatalf("error writing policy report: %v", err)
+		}
+		if !report.Passed {
+			os.Exit(1)
+		}
+	},
+}
+
+func validatePolicyEvalFlags(graphqlEndpoint, policyPath string, args []string) (policyEvalOptions, error) {
+	var opts policyEvalOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+
+	if policyPath == "" {
+		return opts, fmt.Errorf("expected a policy file")
+	}
+	p, err := policy.Load(policyPath)
+	if err != nil {
+		return opts, err
+	}
+	opts.policy = p
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected subject input to be a purl or an artifact as algorithm:digest")
+	}
+	opts.subject = args[0]
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"policy"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	policyEvalCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(policyEvalCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	policyCmd.AddCommand(policyEvalCmd)
+}
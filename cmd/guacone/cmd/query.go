@@ -16,14 +16,33 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+
+	"github.com/guacsec/guac/pkg/cli"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var queryCmd = &cobra.Command{
 	Use:   "query",
 	Short: "Runs the query command against GraphQL",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return validateOutputFlag(viper.GetString("output"))
+	},
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"output"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	queryCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(queryCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
 	rootCmd.AddCommand(queryCmd)
 }
@@ -22,6 +22,8 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/cli"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/handler/collector"
@@ -44,6 +46,7 @@ type s3Options struct {
 	mpEndpoint        string                        // endpoint for the message provider (only for polling behaviour)
 	poll              bool                          // polling or non-polling behaviour? (defaults to non-polling)
 	graphqlEndpoint   string                        // endpoint for the graphql server
+	gqlClientOptions  auth.ClientOptions            // credentials for the graphql server
 	csubClientOptions csub_client.CsubClientOptions // options for the collectsub client
 }
 
@@ -57,6 +60,9 @@ var s3Cmd = &cobra.Command{
 
 		s3Opts, err := validateS3Opts(
 			viper.GetString("gql-addr"),
+			viper.GetString("gql-token"),
+			viper.GetString("gql-client-cert-file"),
+			viper.GetString("gql-client-key-file"),
 			viper.GetString("csub-addr"),
 			viper.GetBool("csub-tls"),
 			viper.GetBool("csub-tls-skip-verify"),
@@ -94,6 +100,12 @@ var s3Cmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		httpClient, err := auth.NewHTTPClient(s3Opts.gqlClientOptions)
+		if err != nil {
+			logger.Fatalf("unable to create graphQL client: %v", err)
+		}
+		gqlclient := graphql.NewClient(s3Opts.graphqlEndpoint, httpClient)
+
 		csubClient, err := csub_client.NewClient(s3Opts.csubClientOptions)
 		if err != nil {
 			logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
@@ -104,8 +116,8 @@ var s3Cmd = &cobra.Command{
 
 		errFound := false
 
-		emit := func(d *processor.Document) error {
-			err := ingestor.Ingest(ctx, d, s3Opts.graphqlEndpoint, csubClient)
+		emit := func(ctx context.Context, d *processor.Document) error {
+			err := ingestor.Ingest(ctx, d, gqlclient, csubClient)
 
 			if err != nil {
 				errFound = true
@@ -143,7 +155,7 @@ var s3Cmd = &cobra.Command{
 	},
 }
 
-func validateS3Opts(graphqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, s3url string, s3bucket string, region string, s3item string, mp string, mpEndpoint string, queues string, poll bool) (s3Options, error) {
+func validateS3Opts(graphqlEndpoint string, gqlToken string, gqlClientCertFile string, gqlClientKeyFile string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, s3url string, s3bucket string, region string, s3item string, mp string, mpEndpoint string, queues string, poll bool) (s3Options, error) {
 	var opts s3Options
 
 	if poll {
@@ -161,12 +173,17 @@ func validateS3Opts(graphqlEndpoint string, csubAddr string, csubTls bool, csubT
 		return opts, fmt.Errorf("expected s3 bucket")
 	}
 
+	gqlClientOptions, err := auth.ValidateClientFlags(gqlToken, gqlClientCertFile, gqlClientKeyFile)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate graphQL client flags: %w", err)
+	}
+
 	csubClientOptions, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
 	if err != nil {
 		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
 	}
 
-	opts = s3Options{s3url, s3bucket, region, s3item, queues, mp, mpEndpoint, poll, graphqlEndpoint, csubClientOptions}
+	opts = s3Options{s3url, s3bucket, region, s3item, queues, mp, mpEndpoint, poll, graphqlEndpoint, gqlClientOptions, csubClientOptions}
 
 	return opts, nil
 }
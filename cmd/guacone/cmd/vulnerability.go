@@ -24,11 +24,11 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/misc/depversion"
-	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -72,11 +72,6 @@ var queryVulnCmd = &cobra.Command{
 		httpClient := http.Client{}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
-		t := table.NewWriter()
-		tTemp := table.Table{}
-		tTemp.Render()
-		t.AppendHeader(rowHeader)
-
 		pkgInput, err := helpers.PurlToPkg(opts.purl)
 		if err != nil {
 			logger.Fatalf("failed to parse PURL: %v", err)
@@ -98,77 +93,87 @@ var queryVulnCmd = &cobra.Command{
 			Subpath:    pkgInput.Subpath,
 			Qualifiers: pkgQualifierFilter,
 		}
-		pkgResponse, err := model.Packages(ctx, gqlclient, *pkgFilter)
+		pkgs, err := clienthelpers.Packages(ctx, gqlclient, *pkgFilter)
 		if err != nil {
 			logger.Fatalf("error querying for package: %v", err)
 		}
-		if len(pkgResponse.Packages) != 1 {
+		if len(pkgs) != 1 {
 			logger.Fatalf("failed to located package based on purl")
 		}
 
+		result := &queryResult{Query: "vuln", Subject: opts.purl}
+		var group resultGroup
 		if opts.vulnerabilityID != "" {
-			var tableRows []table.Row
+			var nodes []resultNode
 
-			vulnResponse, err := model.Vulnerabilities(ctx, gqlclient, model.VulnerabilitySpec{VulnerabilityID: &opts.vulnerabilityID})
+			vulns, err := clienthelpers.Vulnerabilities(ctx, gqlclient, model.VulnerabilitySpec{VulnerabilityID: &opts.vulnerabilityID})
 			if err != nil {
 				logger.Fatalf("error querying for vulnerabilities: %v", err)
 			}
 			var path []string
-			if len(vulnResponse.Vulnerabilities) > 0 {
-
-				tableRows = append(tableRows, table.Row{vulnResponse.Vulnerabilities[0].Type, vulnResponse.Vulnerabilities[0].Id, "vulnerability ID: " + vulnResponse.Vulnerabilities[0].VulnerabilityIDs[0].VulnerabilityID})
-
-				path, err = queryVulnsViaVulnNodeNeighbors(ctx, gqlclient, pkgResponse, vulnResponse.Vulnerabilities, model.EdgeVulnerabilityCertifyVuln, opts.depth, opts.pathsToReturn)
+			if len(vulns) > 0 {
+				vulnID := vulns[0].VulnerabilityIDs[0].VulnerabilityID
+				nodes = append(nodes, resultNode{
+					ID:              vulns[0].Id,
+					Type:            vulns[0].Type,
+					Info:            "vulnerability ID: " + vulnID,
+					VulnerabilityID: vulnID,
+					Subject:         opts.purl,
+				})
+
+				path, err = queryVulnsViaVulnNodeNeighbors(ctx, gqlclient, pkgs, vulns, model.EdgeVulnerabilityCertifyVuln, opts.depth, opts.pathsToReturn)
 				if err != nil {
 					logger.Fatalf("error querying neighbor: %v", err)
 				}
 			} else {
-				fmt.Printf("Failed to identify vulnerability \n")
+				group.Message = "Failed to identify vulnerability"
 			}
 			if len(path) > 0 {
-				t.AppendRows(tableRows)
-				fmt.Println(t.Render())
-				fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+				group.Nodes = nodes
+				group.setPath(path)
 			} else {
-				fmt.Printf("No path to vulnerability ID found!\n")
+				group.Message = strings.TrimSpace(group.Message + "\nNo path to vulnerability ID found!")
 			}
 		} else {
 			var path []string
-			var tableRows []table.Row
-			if pkgResponse.Packages[0].Type != guacType {
-				vulnPath, pkgVulnTableRows, err := queryVulnsViaPackageNeighbors(ctx, gqlclient, pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id, []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageCertifyVexStatement})
+			var nodes []resultNode
+			if pkgs[0].Type != guacType {
+				vulnPath, pkgVulnNodes, err := queryVulnsViaPackageNeighbors(ctx, gqlclient, pkgs[0].Namespaces[0].Names[0].Versions[0].Id, []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageCertifyVexStatement})
 				if err != nil {
 					logger.Fatalf("error querying neighbor: %v", err)
 				}
 				path = append(path, vulnPath...)
-				tableRows = append(tableRows, pkgVulnTableRows...)
+				nodes = append(nodes, pkgVulnNodes...)
 			}
 
-			depVulnPath, depVulnTableRows, err := searchDependencyPackages(ctx, gqlclient, pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id, opts.depth)
+			depVulnPath, depVulnNodes, err := searchDependencyPackages(ctx, gqlclient, pkgs[0].Namespaces[0].Names[0].Versions[0].Id, opts.depth)
 			if err != nil {
 				logger.Fatalf("error searching dependency packages match: %v", err)
 			}
 			path = append(path, depVulnPath...)
-			tableRows = append(tableRows, depVulnTableRows...)
+			nodes = append(nodes, depVulnNodes...)
 
 			if len(path) > 0 {
-				path = append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id,
-					pkgResponse.Packages[0].Namespaces[0].Names[0].Id, pkgResponse.Packages[0].Namespaces[0].Id,
-					pkgResponse.Packages[0].Id}, path...)
-				t.AppendRows(tableRows)
-
-				fmt.Println(t.Render())
-				fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+				path = append([]string{pkgs[0].Namespaces[0].Names[0].Versions[0].Id,
+					pkgs[0].Namespaces[0].Names[0].Id, pkgs[0].Namespaces[0].Id,
+					pkgs[0].Id}, path...)
+				group.Nodes = nodes
+				group.setPath(path)
 			} else {
-				fmt.Printf("No path to vulnerabilities found!\n")
+				group.Message = "No path to vulnerabilities found!"
 			}
 		}
+		result.Results = append(result.Results, group)
+
+		if err := writeQueryResult(os.Stdout, viper.GetString("output"), result); err != nil {
+			logger.Fatalf("unable to write query result: %v", err)
+		}
 	},
 }
 
-func queryVulnsViaPackageNeighbors(ctx context.Context, gqlclient graphql.Client, pkgVersionID string, edgeTypes []model.Edge) ([]string, []table.Row, error) {
+func queryVulnsViaPackageNeighbors(ctx context.Context, gqlclient graphql.Client, pkgVersionID string, edgeTypes []model.Edge) ([]string, []resultNode, error) {
 	var path []string
-	var tableRows []table.Row
+	var nodes []resultNode
 	pkgVersionNeighborResponse, err := model.Neighbors(ctx, gqlclient, pkgVersionID, edgeTypes)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error querying neighbor for vulnerability: %w", err)
@@ -179,7 +184,13 @@ func queryVulnsViaPackageNeighbors(ctx context.Context, gqlclient graphql.Client
 			certifyVulnFound = true
 			if certifyVuln.Vulnerability.Type != noVulnType {
 				for _, vuln := range certifyVuln.Vulnerability.VulnerabilityIDs {
-					tableRows = append(tableRows, table.Row{certifyVulnStr, certifyVuln.Id, "vulnerability ID: " + vuln.VulnerabilityID})
+					nodes = append(nodes, resultNode{
+						ID:              certifyVuln.Id,
+						Type:            certifyVulnStr,
+						Info:            "vulnerability ID: " + vuln.VulnerabilityID,
+						VulnerabilityID: vuln.VulnerabilityID,
+						Subject:         pkgTreePurl(certifyVuln.Package.AllPkgTree),
+					})
 					path = append(path, []string{vuln.Id, certifyVuln.Id,
 						certifyVuln.Package.Namespaces[0].Names[0].Versions[0].Id,
 						certifyVuln.Package.Namespaces[0].Names[0].Id, certifyVuln.Package.Namespaces[0].Id,
@@ -190,7 +201,14 @@ func queryVulnsViaPackageNeighbors(ctx context.Context, gqlclient graphql.Client
 
 		if certifyVex, ok := neighbor.(*model.NeighborsNeighborsCertifyVEXStatement); ok {
 			for _, vuln := range certifyVex.Vulnerability.VulnerabilityIDs {
-				tableRows = append(tableRows, table.Row{vexLinkStr, certifyVex.Id, "vulnerability ID: " + vuln.VulnerabilityID + ", Vex Status: " + string(certifyVex.Status) + ", Subject: " + vexSubjectString(certifyVex.Subject)})
+				nodes = append(nodes, resultNode{
+					ID:              certifyVex.Id,
+					Type:            vexLinkStr,
+					Info:            "vulnerability ID: " + vuln.VulnerabilityID + ", Vex Status: " + string(certifyVex.Status) + ", Subject: " + vexSubjectString(certifyVex.Subject),
+					VulnerabilityID: vuln.VulnerabilityID,
+					Status:          string(certifyVex.Status),
+					Subject:         vexSubjectPurl(certifyVex.Subject),
+				})
 				path = append(path, certifyVex.Id, vuln.Id)
 			}
 			path = append(path, vexSubjectIds(certifyVex.Subject)...)
@@ -200,7 +218,7 @@ func queryVulnsViaPackageNeighbors(ctx context.Context, gqlclient graphql.Client
 	if !certifyVulnFound {
 		return nil, nil, fmt.Errorf("error certify vulnerability node not found, incomplete data. Please ensure certifier has run")
 	}
-	return path, tableRows, nil
+	return path, nodes, nil
 }
 
 func vexSubjectString(s model.AllCertifyVEXStatementSubjectPackageOrArtifact) string {
@@ -218,6 +236,17 @@ func vexSubjectString(s model.AllCertifyVEXStatementSubjectPackageOrArtifact) st
 		return "unknown subject"
 	}
 }
+func vexSubjectPurl(s model.AllCertifyVEXStatementSubjectPackageOrArtifact) string {
+	switch v := s.(type) {
+	case *model.AllCertifyVEXStatementSubjectArtifact:
+		return v.Algorithm + ":" + v.Digest
+	case *model.AllCertifyVEXStatementSubjectPackage:
+		return pkgTreePurl(v.AllPkgTree)
+	default:
+		return ""
+	}
+}
+
 func vexSubjectIds(s model.AllCertifyVEXStatementSubjectPackageOrArtifact) []string {
 	switch v := s.(type) {
 	case *model.AllCertifyVEXStatementSubjectArtifact:
@@ -233,9 +262,9 @@ func vexSubjectIds(s model.AllCertifyVEXStatementSubjectPackageOrArtifact) []str
 	}
 }
 
-func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, topPkgID string, maxLength int) ([]string, []table.Row, error) {
+func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, topPkgID string, maxLength int) ([]string, []resultNode, error) {
 	var path []string
-	var tableRows []table.Row
+	var nodes []resultNode
 	queue := make([]string, 0) // the queue of nodes in bfs
 	type dfsNode struct {
 		expanded bool // true once all node neighbors are added to queue
@@ -272,14 +301,14 @@ func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, top
 					Name:      &isDependency.DependencyPackage.Namespaces[0].Names[0].Name,
 				}
 
-				depPkgResponse, err := model.Packages(ctx, gqlclient, *depPkgFilter)
+				depPkgs, err := clienthelpers.Packages(ctx, gqlclient, *depPkgFilter)
 				if err != nil {
 					return nil, nil, fmt.Errorf("error querying for dependent package: %w", err)
 				}
 
 				depPkgVersionsMap := map[string]string{}
 				depPkgVersions := []string{}
-				for _, depPkgVersion := range depPkgResponse.Packages[0].Namespaces[0].Names[0].Versions {
+				for _, depPkgVersion := range depPkgs[0].Namespaces[0].Names[0].Versions {
 					depPkgVersions = append(depPkgVersions, depPkgVersion.Version)
 					depPkgVersionsMap[depPkgVersion.Version] = depPkgVersion.Id
 				}
@@ -295,16 +324,16 @@ func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, top
 
 				for matchingDepPkgVersion := range matchingDepPkgVersions {
 					matchingDepPkgVersionID := depPkgVersionsMap[matchingDepPkgVersion]
-					vulnPath, foundVulnTableRow, err := queryVulnsViaPackageNeighbors(ctx, gqlclient, matchingDepPkgVersionID, []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageCertifyVexStatement})
+					vulnPath, foundVulnNodes, err := queryVulnsViaPackageNeighbors(ctx, gqlclient, matchingDepPkgVersionID, []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageCertifyVexStatement})
 					if err != nil {
 						return nil, nil, fmt.Errorf("error querying neighbor: %w", err)
 					}
 					if len(vulnPath) > 0 {
 						path = append(path, isDependency.Id, matchingDepPkgVersionID,
-							depPkgResponse.Packages[0].Namespaces[0].Names[0].Id, depPkgResponse.Packages[0].Namespaces[0].Id,
-							depPkgResponse.Packages[0].Id)
+							depPkgs[0].Namespaces[0].Names[0].Id, depPkgs[0].Namespaces[0].Id,
+							depPkgs[0].Id)
 						path = append(path, vulnPath...)
-						tableRows = append(tableRows, foundVulnTableRow...)
+						nodes = append(nodes, foundVulnNodes...)
 					}
 
 					dfsN, seen := nodeMap[matchingDepPkgVersionID]
@@ -326,10 +355,10 @@ func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, top
 		nodeMap[now] = nowNode
 	}
 
-	return path, tableRows, nil
+	return path, nodes, nil
 }
 
-func queryVulnsViaVulnNodeNeighbors(ctx context.Context, gqlclient graphql.Client, topPkgResponse *model.PackagesResponse, vulnerabilitiesResponses []model.VulnerabilitiesVulnerabilitiesVulnerability, edgeType model.Edge, depth, pathsToReturn int) ([]string, error) {
+func queryVulnsViaVulnNodeNeighbors(ctx context.Context, gqlclient graphql.Client, topPkgs []model.AllPkgTree, vulnerabilitiesResponses []model.AllVulnerabilityTree, edgeType model.Edge, depth, pathsToReturn int) ([]string, error) {
 	type vulnNeighbor struct {
 		node model.NeighborsNeighborsNode
 		id   string
@@ -357,7 +386,7 @@ func queryVulnsViaVulnNodeNeighbors(ctx context.Context, gqlclient graphql.Clien
 	for _, neighbor := range vulnNodeNeighborResponses {
 		if certifyVuln, ok := neighbor.node.(*model.NeighborsNeighborsCertifyVuln); ok {
 			certifyVulnFound = true
-			pkgPath, err := searchDependencyPackagesReverse(ctx, gqlclient, topPkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id, certifyVuln.Package.Namespaces[0].Names[0].Versions[0].Id, depth)
+			pkgPath, err := searchDependencyPackagesReverse(ctx, gqlclient, topPkgs[0].Namespaces[0].Names[0].Versions[0].Id, certifyVuln.Package.Namespaces[0].Names[0].Versions[0].Id, depth)
 			if err != nil {
 				return nil, fmt.Errorf("error searching dependency packages match: %w", err)
 			}
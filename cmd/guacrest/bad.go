@@ -26,6 +26,7 @@ import (
 	"github.com/Khan/genqlient/graphql"
 	"github.com/gin-gonic/gin"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 )
 
 // badHandler is a function that returns a gin.HandlerFunc. It handles requests to the /bad endpoint.
@@ -53,14 +54,14 @@ func badHandler(ctx context.Context) func(c *gin.Context) {
 		httpClient := &http.Client{Timeout: httpTimeout}
 		gqlclient := graphql.NewClient(graphqlEndpoint, httpClient)
 
-		certifyBadResponse, err := model.CertifyBads(ctx, gqlclient, model.CertifyBadSpec{})
+		certifyBads, err := clienthelpers.CertifyBads(ctx, gqlclient, model.CertifyBadSpec{})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying for package: %v", err)})
 			return
 		}
 
 		// Iterate over the bad certifications.
-		for _, certifyBad := range certifyBadResponse.CertifyBad {
+		for _, certifyBad := range certifyBads {
 			// Handle the different types of subjects.
 			switch subject := certifyBad.Subject.(type) {
 			case *model.AllCertifyBadSubjectPackage:
@@ -73,16 +74,16 @@ func badHandler(ctx context.Context) func(c *gin.Context) {
 						Namespace: &subject.Namespaces[0].Namespace,
 						Name:      &subject.Namespaces[0].Names[0].Name,
 					}
-					pkgResponse, err := model.Packages(ctx, gqlclient, *pkgFilter)
+					pkgs, err := clienthelpers.Packages(ctx, gqlclient, *pkgFilter)
 					if err != nil {
 						c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying for package: %v", err)})
 						return
 					}
-					if len(pkgResponse.Packages) != 1 {
+					if len(pkgs) != 1 {
 						c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, "failed to located package based on package from certifyBad"})
 						return
 					}
-					pkgVersions = pkgResponse.Packages[0].Namespaces[0].Names[0].Versions
+					pkgVersions = pkgs[0].Namespaces[0].Names[0].Versions
 				} else {
 					pkgVersions = subject.Namespaces[0].Names[0].Versions
 				}
@@ -117,17 +118,17 @@ func badHandler(ctx context.Context) func(c *gin.Context) {
 					Tag:       subject.Namespaces[0].Names[0].Tag,
 					Commit:    subject.Namespaces[0].Names[0].Commit,
 				}
-				srcResponse, err := model.Sources(ctx, gqlclient, *srcFilter)
+				srcs, err := clienthelpers.Sources(ctx, gqlclient, *srcFilter)
 				if err != nil {
 					c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying for sources: %v", err)})
 					return
 				}
-				if len(srcResponse.Sources) != 1 {
+				if len(srcs) != 1 {
 					c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, "failed to located sources based on vcs"})
 					return
 				}
 
-				neighborResponse, err := model.Neighbors(ctx, gqlclient, srcResponse.Sources[0].Namespaces[0].Names[0].Id, []model.Edge{model.EdgeSourceHasSourceAt, model.EdgeSourceIsOccurrence})
+				neighborResponse, err := model.Neighbors(ctx, gqlclient, srcs[0].Namespaces[0].Names[0].Id, []model.Edge{model.EdgeSourceHasSourceAt, model.EdgeSourceIsOccurrence})
 				if err != nil {
 					c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying neighbors: %v", err)})
 					return
@@ -167,16 +168,16 @@ func badHandler(ctx context.Context) func(c *gin.Context) {
 					Digest:    &subject.Digest,
 				}
 
-				artifactResponse, err := model.Artifacts(ctx, gqlclient, *artifactFilter)
+				artifacts, err := clienthelpers.Artifacts(ctx, gqlclient, *artifactFilter)
 				if err != nil {
 					c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying for artifacts: %v", err)})
 					return
 				}
-				if len(artifactResponse.Artifacts) != 1 {
+				if len(artifacts) != 1 {
 					c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, "failed to located artifacts based on (algorithm:digest)"})
 					return
 				}
-				neighborResponse, err := model.Neighbors(ctx, gqlclient, artifactResponse.Artifacts[0].Id, []model.Edge{model.EdgeArtifactHashEqual, model.EdgeArtifactIsOccurrence})
+				neighborResponse, err := model.Neighbors(ctx, gqlclient, artifacts[0].Id, []model.Edge{model.EdgeArtifactHashEqual, model.EdgeArtifactIsOccurrence})
 				if err != nil {
 					c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying neighbors: %v", err)})
 					return
@@ -23,6 +23,7 @@ import (
 
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 
 	"github.com/Khan/genqlient/graphql"
@@ -64,22 +65,22 @@ func artifactHandlerForArtifact(ctx context.Context) func(c *gin.Context) {
 			Digest:    ptrfrom.String(strings.ToLower(split[1])),
 		}
 
-		artifactResponse, err := model.Artifacts(ctx, gqlclient, *artifactFilter)
+		artifacts, err := clienthelpers.Artifacts(ctx, gqlclient, *artifactFilter)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying for artifacts: %v", err)})
 			return
 		}
-		if len(artifactResponse.Artifacts) != 1 {
+		if len(artifacts) != 1 {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, "failed to located artifacts based on (algorithm:digest)"})
 			return
 		}
-		artifactNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, artifactResponse.Artifacts[0].Id)
+		artifactNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, artifacts[0].Id)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying for artifact neighbors: %v", err)})
 			return
 		}
 
-		path := append([]string{artifactResponse.Artifacts[0].Id}, neighborsPath...)
+		path := append([]string{artifacts[0].Id}, neighborsPath...)
 
 		response := Response{
 			NeighborsData: artifactNeighbors,
@@ -128,25 +129,25 @@ func sourceHandlerForVCS(ctx context.Context) func(c *gin.Context) {
 			Commit:    srcInput.Commit,
 		}
 
-		srcResponse, err := model.Sources(ctx, gqlclient, *srcFilter)
+		srcs, err := clienthelpers.Sources(ctx, gqlclient, *srcFilter)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("Error querying source: %v", err)})
 			return
 		}
 
-		if len(srcResponse.Sources) != 1 {
+		if len(srcs) != 1 {
 			c.JSON(http.StatusNotFound, HTTPError{http.StatusNotFound, "No source found for the given vcs"})
 			return
 		}
 
-		sourceNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, srcResponse.Sources[0].Namespaces[0].Names[0].Id)
+		sourceNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, srcs[0].Namespaces[0].Names[0].Id)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("Error querying for source Neighbors: %v", err)})
 			return
 		}
 
-		path := append([]string{srcResponse.Sources[0].Namespaces[0].Names[0].Id,
-			srcResponse.Sources[0].Namespaces[0].Id, srcResponse.Sources[0].Id}, neighborsPath...)
+		path := append([]string{srcs[0].Namespaces[0].Names[0].Id,
+			srcs[0].Namespaces[0].Id, srcs[0].Id}, neighborsPath...)
 
 		response := Response{
 			NeighborsData: sourceNeighbors,
@@ -191,19 +192,19 @@ func packageHandlerForHash(ctx context.Context) func(c *gin.Context) {
 		pkgFilter := createPackageFilter(pkgInput)
 
 		// Query for the package using the package filter
-		pkgResponse, err := model.Packages(ctx, gqlclient, *pkgFilter)
+		pkgs, err := clienthelpers.Packages(ctx, gqlclient, *pkgFilter)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("Error querying package: %v", err)})
 			return
 		}
 
-		if len(pkgResponse.Packages) != 1 {
+		if len(pkgs) != 1 {
 			c.JSON(http.StatusNotFound, HTTPError{http.StatusNotFound, "No package found for the given hash"})
 			return
 		}
 
 		// Query for the package's neighbors
-		res, path, err := queryNeighborsForPackage(ctx, gqlclient, pkgResponse.Packages[0])
+		res, path, err := queryNeighborsForPackage(ctx, gqlclient, pkgs[0])
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("Error querying Neighbors: %v", err)})
 			return
@@ -261,7 +262,7 @@ func createPackageFilter(pkgInput *model.PkgInputSpec) *model.PkgSpec {
 // queryNeighborsForPackage is a function that queries for the neighbors of a given package.
 // It takes in a context, a graphql client, and a package model.
 // It returns a slice of pointers to Neighbors and an error.
-func queryNeighborsForPackage(ctx context.Context, gqlclient graphql.Client, pkg model.PackagesPackagesPackage) ([]*Neighbors, []*string, error) {
+func queryNeighborsForPackage(ctx context.Context, gqlclient graphql.Client, pkg model.AllPkgTree) ([]*Neighbors, []*string, error) {
 	var res []*Neighbors
 	var path []*string
 
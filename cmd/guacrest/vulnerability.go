@@ -28,6 +28,7 @@ import (
 	"github.com/Khan/genqlient/graphql"
 	"github.com/gin-gonic/gin"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/misc/depversion"
 )
 
@@ -70,21 +71,21 @@ func vulnerabilityHandler(ctx context.Context) func(c *gin.Context) {
 		pkgFilter := createPackageFilter(pkgInput)
 
 		// Query for the package
-		pkgResponse, err := model.Packages(ctx, gqlclient, *pkgFilter)
+		pkgs, err := clienthelpers.Packages(ctx, gqlclient, *pkgFilter)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying package: %v", err)})
 			return
 		}
 
-		if len(pkgResponse.Packages) != 1 {
+		if len(pkgs) != 1 {
 			c.JSON(http.StatusNotFound, HTTPError{http.StatusNotFound, "no package found for the given hash"})
 			return
 		}
 
 		if vulnID != "" {
-			handleVulnerabilityIDQuery(ctx, c, gqlclient, vulnID, pkgResponse, searchDepth, pathsToReturn)
+			handleVulnerabilityIDQuery(ctx, c, gqlclient, vulnID, pkgs, searchDepth, pathsToReturn)
 		} else {
-			handleNoVulnerabilityIDQuery(ctx, c, gqlclient, pkgResponse, searchDepth)
+			handleNoVulnerabilityIDQuery(ctx, c, gqlclient, pkgs, searchDepth)
 		}
 	}
 }
@@ -126,8 +127,8 @@ func parseQueryParameters(c *gin.Context) (string, string, int, int, error) {
 // It queries for vulnerabilities based on the vulnerability ID and handles the response.
 // It takes a context, a gin.Context, a GraphQL client, a vulnerability ID, a package response, a search depth, and a number of paths to return.
 // It does not return anything, but it does output a gin context in the form of a string.
-func handleVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient graphql.Client, vulnID string, pkgResponse *model.PackagesResponse, searchDepth, pathsToReturn int) {
-	vulnResponse, err := model.Vulnerabilities(ctx, gqlclient, model.VulnerabilitySpec{VulnerabilityID: &vulnID})
+func handleVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient graphql.Client, vulnID string, pkgs []model.AllPkgTree, searchDepth, pathsToReturn int) {
+	vulns, err := clienthelpers.Vulnerabilities(ctx, gqlclient, model.VulnerabilitySpec{VulnerabilityID: &vulnID})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying for vulnerabilities: %v", err)})
 		return
@@ -135,8 +136,8 @@ func handleVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient g
 
 	var path []string
 
-	if len(vulnResponse.Vulnerabilities) > 0 {
-		path, err = QueryVulnsViaVulnNodeNeighbors(ctx, gqlclient, pkgResponse, vulnResponse.Vulnerabilities, model.EdgeVulnerabilityCertifyVuln, searchDepth, pathsToReturn)
+	if len(vulns) > 0 {
+		path, err = QueryVulnsViaVulnNodeNeighbors(ctx, gqlclient, pkgs, vulns, model.EdgeVulnerabilityCertifyVuln, searchDepth, pathsToReturn)
 
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying vulnerabilities via node neighbors: %v", err)})
@@ -146,7 +147,7 @@ func handleVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient g
 
 	if len(path) > 0 {
 		response := Response{
-			NeighborsData: vulnResponse.Vulnerabilities,
+			NeighborsData: vulns,
 			VisualizerURL: fmt.Sprintf("http://localhost:3000/?path=%v", strings.Join(removeDuplicateValuesFromPath(path), `,`)),
 		}
 		c.IndentedJSON(200, response)
@@ -160,11 +161,11 @@ func handleVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient g
 // It queries for vulnerabilities based on the package response and the search depth.
 // It takes a context, a gin.Context, a GraphQL client, a package response, and a search depth.
 // It does not return anything, but it does output a gin context in the form of a string.
-func handleNoVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient graphql.Client, pkgResponse *model.PackagesResponse, searchDepth int) {
+func handleNoVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient graphql.Client, pkgs []model.AllPkgTree, searchDepth int) {
 	path, res := []string{}, []*Neighbors{}
 
-	if pkgResponse.Packages[0].Type != guacType {
-		vulnPath, neighbors, err := queryVulnsViaPackageNeighbors(ctx, gqlclient, pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id, []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageCertifyVexStatement})
+	if pkgs[0].Type != guacType {
+		vulnPath, neighbors, err := queryVulnsViaPackageNeighbors(ctx, gqlclient, pkgs[0].Namespaces[0].Names[0].Versions[0].Id, []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageCertifyVexStatement})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error querying package neighbors: %v", err)})
 			return
@@ -174,7 +175,7 @@ func handleNoVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient
 		res = append(res, neighbors...)
 	}
 
-	depVulnPath, depVulnNeighbors, err := searchDependencyPackages(ctx, gqlclient, pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id, searchDepth)
+	depVulnPath, depVulnNeighbors, err := searchDependencyPackages(ctx, gqlclient, pkgs[0].Namespaces[0].Names[0].Versions[0].Id, searchDepth)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, HTTPError{http.StatusInternalServerError, fmt.Sprintf("error searching dependency packages: %v", err)})
 	}
@@ -182,9 +183,9 @@ func handleNoVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient
 	res = append(res, depVulnNeighbors...)
 
 	if len(path) > 0 {
-		path = append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id,
-			pkgResponse.Packages[0].Namespaces[0].Names[0].Id, pkgResponse.Packages[0].Namespaces[0].Id,
-			pkgResponse.Packages[0].Id}, path...)
+		path = append([]string{pkgs[0].Namespaces[0].Names[0].Versions[0].Id,
+			pkgs[0].Namespaces[0].Names[0].Id, pkgs[0].Namespaces[0].Id,
+			pkgs[0].Id}, path...)
 
 		response := Response{
 			NeighborsData: res,
@@ -200,7 +201,7 @@ func handleNoVulnerabilityIDQuery(ctx context.Context, c *gin.Context, gqlclient
 // This function traverses through the graph with searchDependencyPackagesReverse.
 // It takes a context, a GraphQL client, a package response, a list of vulnerabilities, an edge type, a search depth, and a number of paths to return.
 // It returns a list of paths to the vulnerabilities and an error if the query fails.
-func QueryVulnsViaVulnNodeNeighbors(ctx context.Context, gqlclient graphql.Client, topPkgResponse *model.PackagesResponse, vulnerabilitiesResponses []model.VulnerabilitiesVulnerabilitiesVulnerability, edgeType model.Edge, depth, pathsToReturn int) ([]string, error) {
+func QueryVulnsViaVulnNodeNeighbors(ctx context.Context, gqlclient graphql.Client, topPkgs []model.AllPkgTree, vulnerabilitiesResponses []model.AllVulnerabilityTree, edgeType model.Edge, depth, pathsToReturn int) ([]string, error) {
 	type vulnNeighbor struct {
 		node model.NeighborsNeighborsNode
 		id   string
@@ -228,7 +229,7 @@ func QueryVulnsViaVulnNodeNeighbors(ctx context.Context, gqlclient graphql.Clien
 	for _, neighbor := range vulnNodeNeighborResponses {
 		if certifyVuln, ok := neighbor.node.(*model.NeighborsNeighborsCertifyVuln); ok {
 			certifyVulnFound = true
-			pkgPath, err := searchDependencyPackagesReverse(ctx, gqlclient, topPkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id, certifyVuln.Package.Namespaces[0].Names[0].Versions[0].Id, depth)
+			pkgPath, err := searchDependencyPackagesReverse(ctx, gqlclient, topPkgs[0].Namespaces[0].Names[0].Versions[0].Id, certifyVuln.Package.Namespaces[0].Names[0].Versions[0].Id, depth)
 			if err != nil {
 				return nil, fmt.Errorf("error searching dependency packages match: %w", err)
 			}
@@ -363,7 +364,7 @@ func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, top
 					Name:      &isDependency.DependencyPackage.Namespaces[0].Names[0].Name,
 				}
 
-				depPkgResponse, err := model.Packages(ctx, gqlclient, *depPkgFilter)
+				depPkgs, err := clienthelpers.Packages(ctx, gqlclient, *depPkgFilter)
 				if err != nil {
 					return nil, nil, fmt.Errorf("error querying for dependent package: %w", err)
 				}
@@ -371,7 +372,7 @@ func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, top
 				// Create a map and a slice for the versions of the dependency package
 				depPkgVersionsMap := map[string]string{}
 				depPkgVersions := []string{}
-				for _, depPkgVersion := range depPkgResponse.Packages[0].Namespaces[0].Names[0].Versions {
+				for _, depPkgVersion := range depPkgs[0].Namespaces[0].Names[0].Versions {
 					depPkgVersions = append(depPkgVersions, depPkgVersion.Version)
 					depPkgVersionsMap[depPkgVersion.Version] = depPkgVersion.Id
 				}
@@ -395,8 +396,8 @@ func searchDependencyPackages(ctx context.Context, gqlclient graphql.Client, top
 					// If vulnerabilities are found, add them to the path and result slices
 					if len(vulnPath) > 0 {
 						path = append(path, isDependency.Id, matchingDepPkgVersionID,
-							depPkgResponse.Packages[0].Namespaces[0].Names[0].Id, depPkgResponse.Packages[0].Namespaces[0].Id,
-							depPkgResponse.Packages[0].Id)
+							depPkgs[0].Namespaces[0].Names[0].Id, depPkgs[0].Namespaces[0].Id,
+							depPkgs[0].Id)
 						path = append(path, vulnPath...)
 						res = append(res, foundVulnTableRow...)
 					}
@@ -42,7 +42,7 @@ func collectExample(cmd *cobra.Command, args []string) {
 	}
 
 	// Collect
-	emit := func(d *processor.Document) error {
+	emit := func(_ context.Context, d *processor.Document) error {
 		logger.Infof("emitted document: %+v", d)
 		return nil
 	}
@@ -128,8 +128,8 @@ var filesCmd = &cobra.Command{
 		}
 
 		// Set emit function to go through the entire pipeline
-		emit := func(d *processor.Document) error {
-			err = collectorPubFunc(d)
+		emit := func(ctx context.Context, d *processor.Document) error {
+			err = collectorPubFunc(ctx, d)
 			if err != nil {
 				logger.Errorf("collector ended with error: %v", err)
 				os.Exit(1)
@@ -147,7 +147,7 @@ var filesCmd = &cobra.Command{
 			return false
 		}
 
-		ingest := func(d *processor.Document) error {
+		ingest := func(ctx context.Context, d *processor.Document) error {
 			docTree, err := process.Process(ctx, d)
 			if err != nil {
 				logger.Error("[processor] failed process document: %v", err)
@@ -213,10 +213,8 @@ func validateFlags(user string, pass string, dbAddr string, realm string, natsAd
 	return opts, nil
 }
 
-func getCollectorPublish(ctx context.Context) (func(*processor.Document) error, error) {
-	return func(d *processor.Document) error {
-		return collector.Publish(ctx, d)
-	}, nil
+func getCollectorPublish(ctx context.Context) (collector.Emitter, error) {
+	return collector.Publish, nil
 }
 
 func getIngestor(ctx context.Context, transportFunc func([]assembler.IngestPredicates, []*parser_common.IdentifierStrings) error) (func() error, error) {
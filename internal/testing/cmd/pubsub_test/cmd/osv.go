@@ -97,7 +97,7 @@ var osvCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		ingest := func(d *processor.Document) error {
+		ingest := func(ctx context.Context, d *processor.Document) error {
 			docTree, err := process.Process(ctx, d)
 			if err != nil {
 				logger.Error("[processor] failed process document: %v", err)
@@ -0,0 +1,49 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gqlserver runs an in-process GraphQL server for tests of code that
+// talks to GUAC through the generated client.
+package gqlserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+// NewClient starts a GraphQL server backed by an empty keyvalue backend and
+// returns a client for it. The server is stopped when the test ends.
+func NewClient(t *testing.T) graphql.Client {
+	t.Helper()
+	backend, err := backends.Get("keyvalue", nil, memmap.GetStore())
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+	config := generated.Config{
+		Resolvers:  &resolvers.Resolver{Backend: backend},
+		Directives: resolvers.NewDirectives(false),
+	}
+	server := httptest.NewServer(handler.NewDefaultServer(generated.NewExecutableSchema(config)))
+	t.Cleanup(server.Close)
+	return graphql.NewClient(server.URL, http.DefaultClient)
+}
@@ -50,6 +50,21 @@ func (mr *MockBackendMockRecorder) Artifacts(ctx, artifactSpec interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Artifacts", reflect.TypeOf((*MockBackend)(nil).Artifacts), ctx, artifactSpec)
 }
 
+// ArtifactsList mocks base method.
+func (m *MockBackend) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtifactsList", ctx, artifactSpec, after, first)
+	ret0, _ := ret[0].(*model.ArtifactConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtifactsList indicates an expected call of ArtifactsList.
+func (mr *MockBackendMockRecorder) ArtifactsList(ctx, artifactSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtifactsList", reflect.TypeOf((*MockBackend)(nil).ArtifactsList), ctx, artifactSpec, after, first)
+}
+
 // Builders mocks base method.
 func (m *MockBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +80,21 @@ func (mr *MockBackendMockRecorder) Builders(ctx, builderSpec interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Builders", reflect.TypeOf((*MockBackend)(nil).Builders), ctx, builderSpec)
 }
 
+// BuildersList mocks base method.
+func (m *MockBackend) BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildersList", ctx, builderSpec, after, first)
+	ret0, _ := ret[0].(*model.BuilderConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildersList indicates an expected call of BuildersList.
+func (mr *MockBackendMockRecorder) BuildersList(ctx, builderSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildersList", reflect.TypeOf((*MockBackend)(nil).BuildersList), ctx, builderSpec, after, first)
+}
+
 // CertifyBad mocks base method.
 func (m *MockBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +110,21 @@ func (mr *MockBackendMockRecorder) CertifyBad(ctx, certifyBadSpec interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyBad", reflect.TypeOf((*MockBackend)(nil).CertifyBad), ctx, certifyBadSpec)
 }
 
+// CertifyBadList mocks base method.
+func (m *MockBackend) CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyBadList", ctx, certifyBadSpec, after, first)
+	ret0, _ := ret[0].(*model.CertifyBadConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyBadList indicates an expected call of CertifyBadList.
+func (mr *MockBackendMockRecorder) CertifyBadList(ctx, certifyBadSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyBadList", reflect.TypeOf((*MockBackend)(nil).CertifyBadList), ctx, certifyBadSpec, after, first)
+}
+
 // CertifyGood mocks base method.
 func (m *MockBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +140,21 @@ func (mr *MockBackendMockRecorder) CertifyGood(ctx, certifyGoodSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyGood", reflect.TypeOf((*MockBackend)(nil).CertifyGood), ctx, certifyGoodSpec)
 }
 
+// CertifyGoodList mocks base method.
+func (m *MockBackend) CertifyGoodList(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyGoodList", ctx, certifyGoodSpec, after, first)
+	ret0, _ := ret[0].(*model.CertifyGoodConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyGoodList indicates an expected call of CertifyGoodList.
+func (mr *MockBackendMockRecorder) CertifyGoodList(ctx, certifyGoodSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyGoodList", reflect.TypeOf((*MockBackend)(nil).CertifyGoodList), ctx, certifyGoodSpec, after, first)
+}
+
 // CertifyLegal mocks base method.
 func (m *MockBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +170,21 @@ func (mr *MockBackendMockRecorder) CertifyLegal(ctx, certifyLegalSpec interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegal", reflect.TypeOf((*MockBackend)(nil).CertifyLegal), ctx, certifyLegalSpec)
 }
 
+// CertifyLegalList mocks base method.
+func (m *MockBackend) CertifyLegalList(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyLegalList", ctx, certifyLegalSpec, after, first)
+	ret0, _ := ret[0].(*model.CertifyLegalConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyLegalList indicates an expected call of CertifyLegalList.
+func (mr *MockBackendMockRecorder) CertifyLegalList(ctx, certifyLegalSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegalList", reflect.TypeOf((*MockBackend)(nil).CertifyLegalList), ctx, certifyLegalSpec, after, first)
+}
+
 // CertifyVEXStatement mocks base method.
 func (m *MockBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +200,21 @@ func (mr *MockBackendMockRecorder) CertifyVEXStatement(ctx, certifyVEXStatementS
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatement", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatement), ctx, certifyVEXStatementSpec)
 }
 
+// CertifyVEXStatementList mocks base method.
+func (m *MockBackend) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVEXStatementList", ctx, certifyVEXStatementSpec, after, first)
+	ret0, _ := ret[0].(*model.CertifyVEXStatementConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVEXStatementList indicates an expected call of CertifyVEXStatementList.
+func (mr *MockBackendMockRecorder) CertifyVEXStatementList(ctx, certifyVEXStatementSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatementList", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatementList), ctx, certifyVEXStatementSpec, after, first)
+}
+
 // CertifyVuln mocks base method.
 func (m *MockBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +230,276 @@ func (mr *MockBackendMockRecorder) CertifyVuln(ctx, certifyVulnSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVuln", reflect.TypeOf((*MockBackend)(nil).CertifyVuln), ctx, certifyVulnSpec)
 }
 
+// CertifyVulnList mocks base method.
+func (m *MockBackend) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVulnList", ctx, certifyVulnSpec, after, first)
+	ret0, _ := ret[0].(*model.CertifyVulnConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVulnList indicates an expected call of CertifyVulnList.
+func (mr *MockBackendMockRecorder) CertifyVulnList(ctx, certifyVulnSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnList", reflect.TypeOf((*MockBackend)(nil).CertifyVulnList), ctx, certifyVulnSpec, after, first)
+}
+
+// DeleteCertifyBad mocks base method.
+func (m *MockBackend) DeleteCertifyBad(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertifyBad", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertifyBad indicates an expected call of DeleteCertifyBad.
+func (mr *MockBackendMockRecorder) DeleteCertifyBad(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertifyBad", reflect.TypeOf((*MockBackend)(nil).DeleteCertifyBad), ctx, id)
+}
+
+// DeleteCertifyGood mocks base method.
+func (m *MockBackend) DeleteCertifyGood(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertifyGood", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertifyGood indicates an expected call of DeleteCertifyGood.
+func (mr *MockBackendMockRecorder) DeleteCertifyGood(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertifyGood", reflect.TypeOf((*MockBackend)(nil).DeleteCertifyGood), ctx, id)
+}
+
+// DeleteCertifyLegal mocks base method.
+func (m *MockBackend) DeleteCertifyLegal(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertifyLegal", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertifyLegal indicates an expected call of DeleteCertifyLegal.
+func (mr *MockBackendMockRecorder) DeleteCertifyLegal(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertifyLegal", reflect.TypeOf((*MockBackend)(nil).DeleteCertifyLegal), ctx, id)
+}
+
+// DeleteCertifyScorecard mocks base method.
+func (m *MockBackend) DeleteCertifyScorecard(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertifyScorecard", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertifyScorecard indicates an expected call of DeleteCertifyScorecard.
+func (mr *MockBackendMockRecorder) DeleteCertifyScorecard(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertifyScorecard", reflect.TypeOf((*MockBackend)(nil).DeleteCertifyScorecard), ctx, id)
+}
+
+// DeleteCertifyVEXStatement mocks base method.
+func (m *MockBackend) DeleteCertifyVEXStatement(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertifyVEXStatement", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertifyVEXStatement indicates an expected call of DeleteCertifyVEXStatement.
+func (mr *MockBackendMockRecorder) DeleteCertifyVEXStatement(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertifyVEXStatement", reflect.TypeOf((*MockBackend)(nil).DeleteCertifyVEXStatement), ctx, id)
+}
+
+// DeleteCertifyVuln mocks base method.
+func (m *MockBackend) DeleteCertifyVuln(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertifyVuln", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertifyVuln indicates an expected call of DeleteCertifyVuln.
+func (mr *MockBackendMockRecorder) DeleteCertifyVuln(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertifyVuln", reflect.TypeOf((*MockBackend)(nil).DeleteCertifyVuln), ctx, id)
+}
+
+// DeleteHasMetadata mocks base method.
+func (m *MockBackend) DeleteHasMetadata(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHasMetadata", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHasMetadata indicates an expected call of DeleteHasMetadata.
+func (mr *MockBackendMockRecorder) DeleteHasMetadata(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHasMetadata", reflect.TypeOf((*MockBackend)(nil).DeleteHasMetadata), ctx, id)
+}
+
+// DeleteHasSbom mocks base method.
+func (m *MockBackend) DeleteHasSbom(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHasSbom", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHasSbom indicates an expected call of DeleteHasSbom.
+func (mr *MockBackendMockRecorder) DeleteHasSbom(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHasSbom", reflect.TypeOf((*MockBackend)(nil).DeleteHasSbom), ctx, id)
+}
+
+// DeleteHasSlsa mocks base method.
+func (m *MockBackend) DeleteHasSlsa(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHasSlsa", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHasSlsa indicates an expected call of DeleteHasSlsa.
+func (mr *MockBackendMockRecorder) DeleteHasSlsa(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHasSlsa", reflect.TypeOf((*MockBackend)(nil).DeleteHasSlsa), ctx, id)
+}
+
+// DeleteHasSourceAt mocks base method.
+func (m *MockBackend) DeleteHasSourceAt(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHasSourceAt", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHasSourceAt indicates an expected call of DeleteHasSourceAt.
+func (mr *MockBackendMockRecorder) DeleteHasSourceAt(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHasSourceAt", reflect.TypeOf((*MockBackend)(nil).DeleteHasSourceAt), ctx, id)
+}
+
+// DeleteHashEqual mocks base method.
+func (m *MockBackend) DeleteHashEqual(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHashEqual", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHashEqual indicates an expected call of DeleteHashEqual.
+func (mr *MockBackendMockRecorder) DeleteHashEqual(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHashEqual", reflect.TypeOf((*MockBackend)(nil).DeleteHashEqual), ctx, id)
+}
+
+// DeleteIsDependency mocks base method.
+func (m *MockBackend) DeleteIsDependency(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIsDependency", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIsDependency indicates an expected call of DeleteIsDependency.
+func (mr *MockBackendMockRecorder) DeleteIsDependency(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIsDependency", reflect.TypeOf((*MockBackend)(nil).DeleteIsDependency), ctx, id)
+}
+
+// DeleteIsOccurrence mocks base method.
+func (m *MockBackend) DeleteIsOccurrence(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIsOccurrence", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIsOccurrence indicates an expected call of DeleteIsOccurrence.
+func (mr *MockBackendMockRecorder) DeleteIsOccurrence(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIsOccurrence", reflect.TypeOf((*MockBackend)(nil).DeleteIsOccurrence), ctx, id)
+}
+
+// DeletePkgEqual mocks base method.
+func (m *MockBackend) DeletePkgEqual(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePkgEqual", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePkgEqual indicates an expected call of DeletePkgEqual.
+func (mr *MockBackendMockRecorder) DeletePkgEqual(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePkgEqual", reflect.TypeOf((*MockBackend)(nil).DeletePkgEqual), ctx, id)
+}
+
+// DeletePointOfContact mocks base method.
+func (m *MockBackend) DeletePointOfContact(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePointOfContact", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePointOfContact indicates an expected call of DeletePointOfContact.
+func (mr *MockBackendMockRecorder) DeletePointOfContact(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePointOfContact", reflect.TypeOf((*MockBackend)(nil).DeletePointOfContact), ctx, id)
+}
+
+// DeleteVulnEqual mocks base method.
+func (m *MockBackend) DeleteVulnEqual(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVulnEqual", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVulnEqual indicates an expected call of DeleteVulnEqual.
+func (mr *MockBackendMockRecorder) DeleteVulnEqual(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVulnEqual", reflect.TypeOf((*MockBackend)(nil).DeleteVulnEqual), ctx, id)
+}
+
+// DeleteVulnerabilityMetadata mocks base method.
+func (m *MockBackend) DeleteVulnerabilityMetadata(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVulnerabilityMetadata", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVulnerabilityMetadata indicates an expected call of DeleteVulnerabilityMetadata.
+func (mr *MockBackendMockRecorder) DeleteVulnerabilityMetadata(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVulnerabilityMetadata", reflect.TypeOf((*MockBackend)(nil).DeleteVulnerabilityMetadata), ctx, id)
+}
+
 // FindSoftware mocks base method.
 func (m *MockBackend) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +530,21 @@ func (mr *MockBackendMockRecorder) HasMetadata(ctx, hasMetadataSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMetadata", reflect.TypeOf((*MockBackend)(nil).HasMetadata), ctx, hasMetadataSpec)
 }
 
+// HasMetadataList mocks base method.
+func (m *MockBackend) HasMetadataList(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMetadataList", ctx, hasMetadataSpec, after, first)
+	ret0, _ := ret[0].(*model.HasMetadataConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasMetadataList indicates an expected call of HasMetadataList.
+func (mr *MockBackendMockRecorder) HasMetadataList(ctx, hasMetadataSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMetadataList", reflect.TypeOf((*MockBackend)(nil).HasMetadataList), ctx, hasMetadataSpec, after, first)
+}
+
 // HasSBOM mocks base method.
 func (m *MockBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +560,36 @@ func (mr *MockBackendMockRecorder) HasSBOM(ctx, hasSBOMSpec interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOM", reflect.TypeOf((*MockBackend)(nil).HasSBOM), ctx, hasSBOMSpec)
 }
 
+// HasSBOMList mocks base method.
+func (m *MockBackend) HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSBOMList", ctx, hasSBOMSpec, after, first)
+	ret0, _ := ret[0].(*model.HasSBOMConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSBOMList indicates an expected call of HasSBOMList.
+func (mr *MockBackendMockRecorder) HasSBOMList(ctx, hasSBOMSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOMList", reflect.TypeOf((*MockBackend)(nil).HasSBOMList), ctx, hasSBOMSpec, after, first)
+}
+
+// HasSLSAList mocks base method.
+func (m *MockBackend) HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSLSAList", ctx, hasSLSASpec, after, first)
+	ret0, _ := ret[0].(*model.HasSLSAConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSLSAList indicates an expected call of HasSLSAList.
+func (mr *MockBackendMockRecorder) HasSLSAList(ctx, hasSLSASpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSLSAList", reflect.TypeOf((*MockBackend)(nil).HasSLSAList), ctx, hasSLSASpec, after, first)
+}
+
 // HasSlsa mocks base method.
 func (m *MockBackend) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +620,21 @@ func (mr *MockBackendMockRecorder) HasSourceAt(ctx, hasSourceAtSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSourceAt", reflect.TypeOf((*MockBackend)(nil).HasSourceAt), ctx, hasSourceAtSpec)
 }
 
+// HasSourceAtList mocks base method.
+func (m *MockBackend) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSourceAtList", ctx, hasSourceAtSpec, after, first)
+	ret0, _ := ret[0].(*model.HasSourceAtConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSourceAtList indicates an expected call of HasSourceAtList.
+func (mr *MockBackendMockRecorder) HasSourceAtList(ctx, hasSourceAtSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSourceAtList", reflect.TypeOf((*MockBackend)(nil).HasSourceAtList), ctx, hasSourceAtSpec, after, first)
+}
+
 // HashEqual mocks base method.
 func (m *MockBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
 	m.ctrl.T.Helper()
@@ -230,6 +650,21 @@ func (mr *MockBackendMockRecorder) HashEqual(ctx, hashEqualSpec interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashEqual", reflect.TypeOf((*MockBackend)(nil).HashEqual), ctx, hashEqualSpec)
 }
 
+// HashEqualList mocks base method.
+func (m *MockBackend) HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashEqualList", ctx, hashEqualSpec, after, first)
+	ret0, _ := ret[0].(*model.HashEqualConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashEqualList indicates an expected call of HashEqualList.
+func (mr *MockBackendMockRecorder) HashEqualList(ctx, hashEqualSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashEqualList", reflect.TypeOf((*MockBackend)(nil).HashEqualList), ctx, hashEqualSpec, after, first)
+}
+
 // IngestArtifact mocks base method.
 func (m *MockBackend) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (string, error) {
 	m.ctrl.T.Helper()
@@ -935,6 +1370,21 @@ func (mr *MockBackendMockRecorder) IsDependency(ctx, isDependencySpec interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDependency", reflect.TypeOf((*MockBackend)(nil).IsDependency), ctx, isDependencySpec)
 }
 
+// IsDependencyList mocks base method.
+func (m *MockBackend) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDependencyList", ctx, isDependencySpec, after, first)
+	ret0, _ := ret[0].(*model.IsDependencyConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDependencyList indicates an expected call of IsDependencyList.
+func (mr *MockBackendMockRecorder) IsDependencyList(ctx, isDependencySpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDependencyList", reflect.TypeOf((*MockBackend)(nil).IsDependencyList), ctx, isDependencySpec, after, first)
+}
+
 // IsOccurrence mocks base method.
 func (m *MockBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
 	m.ctrl.T.Helper()
@@ -950,6 +1400,21 @@ func (mr *MockBackendMockRecorder) IsOccurrence(ctx, isOccurrenceSpec interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOccurrence", reflect.TypeOf((*MockBackend)(nil).IsOccurrence), ctx, isOccurrenceSpec)
 }
 
+// IsOccurrenceList mocks base method.
+func (m *MockBackend) IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOccurrenceList", ctx, isOccurrenceSpec, after, first)
+	ret0, _ := ret[0].(*model.IsOccurrenceConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOccurrenceList indicates an expected call of IsOccurrenceList.
+func (mr *MockBackendMockRecorder) IsOccurrenceList(ctx, isOccurrenceSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOccurrenceList", reflect.TypeOf((*MockBackend)(nil).IsOccurrenceList), ctx, isOccurrenceSpec, after, first)
+}
+
 // Licenses mocks base method.
 func (m *MockBackend) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
 	m.ctrl.T.Helper()
@@ -965,6 +1430,21 @@ func (mr *MockBackendMockRecorder) Licenses(ctx, licenseSpec interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Licenses", reflect.TypeOf((*MockBackend)(nil).Licenses), ctx, licenseSpec)
 }
 
+// LicensesList mocks base method.
+func (m *MockBackend) LicensesList(ctx context.Context, licenseSpec *model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LicensesList", ctx, licenseSpec, after, first)
+	ret0, _ := ret[0].(*model.LicenseConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LicensesList indicates an expected call of LicensesList.
+func (mr *MockBackendMockRecorder) LicensesList(ctx, licenseSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LicensesList", reflect.TypeOf((*MockBackend)(nil).LicensesList), ctx, licenseSpec, after, first)
+}
+
 // Neighbors mocks base method.
 func (m *MockBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
 	m.ctrl.T.Helper()
@@ -1025,6 +1505,21 @@ func (mr *MockBackendMockRecorder) Packages(ctx, pkgSpec interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Packages", reflect.TypeOf((*MockBackend)(nil).Packages), ctx, pkgSpec)
 }
 
+// PackagesList mocks base method.
+func (m *MockBackend) PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PackagesList", ctx, pkgSpec, after, first)
+	ret0, _ := ret[0].(*model.PackageConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PackagesList indicates an expected call of PackagesList.
+func (mr *MockBackendMockRecorder) PackagesList(ctx, pkgSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PackagesList", reflect.TypeOf((*MockBackend)(nil).PackagesList), ctx, pkgSpec, after, first)
+}
+
 // Path mocks base method.
 func (m *MockBackend) Path(ctx context.Context, subject, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
 	m.ctrl.T.Helper()
@@ -1055,6 +1550,21 @@ func (mr *MockBackendMockRecorder) PkgEqual(ctx, pkgEqualSpec interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PkgEqual", reflect.TypeOf((*MockBackend)(nil).PkgEqual), ctx, pkgEqualSpec)
 }
 
+// PkgEqualList mocks base method.
+func (m *MockBackend) PkgEqualList(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PkgEqualList", ctx, pkgEqualSpec, after, first)
+	ret0, _ := ret[0].(*model.PkgEqualConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PkgEqualList indicates an expected call of PkgEqualList.
+func (mr *MockBackendMockRecorder) PkgEqualList(ctx, pkgEqualSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PkgEqualList", reflect.TypeOf((*MockBackend)(nil).PkgEqualList), ctx, pkgEqualSpec, after, first)
+}
+
 // PointOfContact mocks base method.
 func (m *MockBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
 	m.ctrl.T.Helper()
@@ -1070,6 +1580,36 @@ func (mr *MockBackendMockRecorder) PointOfContact(ctx, pointOfContactSpec interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PointOfContact", reflect.TypeOf((*MockBackend)(nil).PointOfContact), ctx, pointOfContactSpec)
 }
 
+// PointOfContactList mocks base method.
+func (m *MockBackend) PointOfContactList(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PointOfContactList", ctx, pointOfContactSpec, after, first)
+	ret0, _ := ret[0].(*model.PointOfContactConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PointOfContactList indicates an expected call of PointOfContactList.
+func (mr *MockBackendMockRecorder) PointOfContactList(ctx, pointOfContactSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PointOfContactList", reflect.TypeOf((*MockBackend)(nil).PointOfContactList), ctx, pointOfContactSpec, after, first)
+}
+
+// RetractEvidence mocks base method.
+func (m *MockBackend) RetractEvidence(ctx context.Context, origin string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetractEvidence", ctx, origin)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetractEvidence indicates an expected call of RetractEvidence.
+func (mr *MockBackendMockRecorder) RetractEvidence(ctx, origin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetractEvidence", reflect.TypeOf((*MockBackend)(nil).RetractEvidence), ctx, origin)
+}
+
 // Scorecards mocks base method.
 func (m *MockBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	m.ctrl.T.Helper()
@@ -1085,6 +1625,21 @@ func (mr *MockBackendMockRecorder) Scorecards(ctx, certifyScorecardSpec interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scorecards", reflect.TypeOf((*MockBackend)(nil).Scorecards), ctx, certifyScorecardSpec)
 }
 
+// ScorecardsList mocks base method.
+func (m *MockBackend) ScorecardsList(ctx context.Context, scorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScorecardsList", ctx, scorecardSpec, after, first)
+	ret0, _ := ret[0].(*model.CertifyScorecardConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScorecardsList indicates an expected call of ScorecardsList.
+func (mr *MockBackendMockRecorder) ScorecardsList(ctx, scorecardSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScorecardsList", reflect.TypeOf((*MockBackend)(nil).ScorecardsList), ctx, scorecardSpec, after, first)
+}
+
 // Sources mocks base method.
 func (m *MockBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
 	m.ctrl.T.Helper()
@@ -1100,6 +1655,21 @@ func (mr *MockBackendMockRecorder) Sources(ctx, sourceSpec interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sources", reflect.TypeOf((*MockBackend)(nil).Sources), ctx, sourceSpec)
 }
 
+// SourcesList mocks base method.
+func (m *MockBackend) SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourcesList", ctx, sourceSpec, after, first)
+	ret0, _ := ret[0].(*model.SourceConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SourcesList indicates an expected call of SourcesList.
+func (mr *MockBackendMockRecorder) SourcesList(ctx, sourceSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourcesList", reflect.TypeOf((*MockBackend)(nil).SourcesList), ctx, sourceSpec, after, first)
+}
+
 // VulnEqual mocks base method.
 func (m *MockBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
 	m.ctrl.T.Helper()
@@ -1115,6 +1685,21 @@ func (mr *MockBackendMockRecorder) VulnEqual(ctx, vulnEqualSpec interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnEqual", reflect.TypeOf((*MockBackend)(nil).VulnEqual), ctx, vulnEqualSpec)
 }
 
+// VulnEqualList mocks base method.
+func (m *MockBackend) VulnEqualList(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnEqualList", ctx, vulnEqualSpec, after, first)
+	ret0, _ := ret[0].(*model.VulnEqualConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnEqualList indicates an expected call of VulnEqualList.
+func (mr *MockBackendMockRecorder) VulnEqualList(ctx, vulnEqualSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnEqualList", reflect.TypeOf((*MockBackend)(nil).VulnEqualList), ctx, vulnEqualSpec, after, first)
+}
+
 // Vulnerabilities mocks base method.
 func (m *MockBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
 	m.ctrl.T.Helper()
@@ -1130,6 +1715,21 @@ func (mr *MockBackendMockRecorder) Vulnerabilities(ctx, vulnSpec interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vulnerabilities", reflect.TypeOf((*MockBackend)(nil).Vulnerabilities), ctx, vulnSpec)
 }
 
+// VulnerabilitiesList mocks base method.
+func (m *MockBackend) VulnerabilitiesList(ctx context.Context, vulnSpec *model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnerabilitiesList", ctx, vulnSpec, after, first)
+	ret0, _ := ret[0].(*model.VulnerabilityConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnerabilitiesList indicates an expected call of VulnerabilitiesList.
+func (mr *MockBackendMockRecorder) VulnerabilitiesList(ctx, vulnSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilitiesList", reflect.TypeOf((*MockBackend)(nil).VulnerabilitiesList), ctx, vulnSpec, after, first)
+}
+
 // VulnerabilityMetadata mocks base method.
 func (m *MockBackend) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
 	m.ctrl.T.Helper()
@@ -1145,6 +1745,21 @@ func (mr *MockBackendMockRecorder) VulnerabilityMetadata(ctx, vulnerabilityMetad
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilityMetadata", reflect.TypeOf((*MockBackend)(nil).VulnerabilityMetadata), ctx, vulnerabilityMetadataSpec)
 }
 
+// VulnerabilityMetadataList mocks base method.
+func (m *MockBackend) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnerabilityMetadataList", ctx, vulnerabilityMetadataSpec, after, first)
+	ret0, _ := ret[0].(*model.VulnerabilityMetadataConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnerabilityMetadataList indicates an expected call of VulnerabilityMetadataList.
+func (mr *MockBackendMockRecorder) VulnerabilityMetadataList(ctx, vulnerabilityMetadataSpec, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilityMetadataList", reflect.TypeOf((*MockBackend)(nil).VulnerabilityMetadataList), ctx, vulnerabilityMetadataSpec, after, first)
+}
+
 // MockBackendArgs is a mock of BackendArgs interface.
 type MockBackendArgs struct {
 	ctrl     *gomock.Controller
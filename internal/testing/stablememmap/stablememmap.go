@@ -17,7 +17,6 @@ package stablememmap
 
 import (
 	"context"
-	"slices"
 
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
@@ -41,11 +40,22 @@ func (s *Store) Set(ctx context.Context, c, k string, v any) error {
 	return s.mm.Set(ctx, c, k, v)
 }
 
-func (s *Store) Keys(ctx context.Context, c string) ([]string, error) {
-	keys, err := s.mm.Keys(ctx, c)
-	if err != nil {
-		return nil, err
-	}
-	slices.Sort(keys)
-	return keys, nil
+func (s *Store) Delete(ctx context.Context, c, k string) error {
+	return s.mm.Delete(ctx, c, k)
+}
+
+func (s *Store) MultiGet(ctx context.Context, c string, ks []string, v any) error {
+	return s.mm.MultiGet(ctx, c, ks, v)
+}
+
+func (s *Store) BatchSet(ctx context.Context, c string, vs map[string]any) error {
+	return s.mm.BatchSet(ctx, c, vs)
+}
+
+func (s *Store) Scan(ctx context.Context, c, prefix, cursor string, count int) ([]string, string, error) {
+	return s.mm.Scan(ctx, c, prefix, cursor, count)
+}
+
+func (s *Store) Increment(ctx context.Context, c, k string, delta uint64) (uint64, error) {
+	return s.mm.Increment(ctx, c, k, delta)
 }
@@ -55,6 +55,14 @@ var (
 	//go:embed exampledata/invalid-spdx-identifier-spdx.json
 	SpdxInvalidSPDXIdentifierExample []byte
 
+	// SPDX 3.0 JSON-LD document with the Software, Build and Security profiles
+	//go:embed exampledata/spdx3-example.json
+	Spdx3Example []byte
+
+	// SPDX 3.0 document of an SPDX 2 specVersion, without SpdxDocument
+	//go:embed exampledata/invalid-spdx3.json
+	Spdx3InvalidExample []byte
+
 	// Example scorecard
 	//go:embed exampledata/kubernetes-scorecard.json
 	ScorecardExample []byte
@@ -105,6 +113,9 @@ var (
 	//go:embed exampledata/cyclonedx-vex-affected.json
 	CycloneDXVEXAffected []byte
 
+	//go:embed exampledata/cyclonedx-services-licenses.json
+	CycloneDXServicesLicenses []byte
+
 	//go:embed exampledata/cyclonedx-vex.xml
 	CyloneDXVEXExampleXML []byte
 
@@ -120,6 +131,9 @@ var (
 	//go:embed exampledata/certify-novuln.json
 	ITE6NoVulnExample []byte
 
+	//go:embed exampledata/certify-vuln-details.json
+	ITE6VulnDetailsExample []byte
+
 	//go:embed exampledata/oci-kubectl-linux-amd64-in-toto.json
 	OCIKubectlLinuxAMD64ITE6 []byte
 
@@ -604,6 +618,8 @@ var (
 
 	isCDXDepJustifyDependsJustification = "CDX BOM Dependency"
 
+	isCDXDepJustifyTransitiveJustification = "CDX BOM transitive dependency"
+
 	isOccJustifyFile = &model.IsOccurrenceInputSpec{
 		Justification: "spdx file with checksum",
 	}
@@ -614,7 +630,7 @@ var (
 			DepPkg:          baselayoutPack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeDirect,
 				VersionRange:   "3.2.0-r22",
 				Justification:  isDepJustifyTopPkgJustification,
 			},
@@ -624,7 +640,7 @@ var (
 			DepPkg:          baselayoutdataPack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeDirect,
 				VersionRange:   "3.2.0-r22",
 				Justification:  isDepJustifyTopPkgJustification,
 			},
@@ -634,7 +650,7 @@ var (
 			DepPkg:          keysPack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeIndirect,
 				VersionRange:   "2.4-r1",
 				Justification:  isDepJustifyTopPkgJustification,
 			},
@@ -644,7 +660,7 @@ var (
 			DepPkg:          worldFilePack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeDirect,
 				VersionRange:   "",
 				Justification:  isDepJustifyTopPkgJustification,
 			},
@@ -654,7 +670,7 @@ var (
 			DepPkg:          rootFilePack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeIndirect,
 				VersionRange:   "",
 				Justification:  isDepJustifyTopPkgJustification,
 			},
@@ -664,7 +680,7 @@ var (
 			DepPkg:          triggersFilePack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeDirect,
 				VersionRange:   "",
 				Justification:  isDepJustifyTopPkgJustification,
 			},
@@ -674,7 +690,7 @@ var (
 			DepPkg:          rsaPubFilePack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeIndirect,
 				VersionRange:   "",
 				Justification:  isDepJustifyTopPkgJustification,
 			},
@@ -684,9 +700,10 @@ var (
 			DepPkg:          keysPack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
-				VersionRange:   "2.4-r1",
-				Justification:  isDepJustifyDependencyOfJustification,
+				DependencyType:  model.DependencyTypeDirect,
+				DependencyScope: ptrfrom.Any(model.DependencyScopeUnknown),
+				VersionRange:    "2.4-r1",
+				Justification:   isDepJustifyDependencyOfJustification,
 			},
 		},
 		{
@@ -694,9 +711,10 @@ var (
 			DepPkg:          rsaPubFilePack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
-				VersionRange:   "",
-				Justification:  isDepJustifyDependsOnJustification,
+				DependencyType:  model.DependencyTypeDirect,
+				DependencyScope: ptrfrom.Any(model.DependencyScopeUnknown),
+				VersionRange:    "",
+				Justification:   isDepJustifyDependsOnJustification,
 			},
 		},
 		{
@@ -704,9 +722,10 @@ var (
 			DepPkg:          rootFilePack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
-				VersionRange:   "",
-				Justification:  isDepJustifyContainsJustification,
+				DependencyType:  model.DependencyTypeUnknown,
+				DependencyScope: ptrfrom.Any(model.DependencyScopeUnknown),
+				VersionRange:    "",
+				Justification:   isDepJustifyContainsJustification,
 			},
 		},
 		{
@@ -714,9 +733,10 @@ var (
 			DepPkg:          rsaPubFilePack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
-				VersionRange:   "",
-				Justification:  isDepJustifyContainedByJustification,
+				DependencyType:  model.DependencyTypeUnknown,
+				DependencyScope: ptrfrom.Any(model.DependencyScopeUnknown),
+				VersionRange:    "",
+				Justification:   isDepJustifyContainedByJustification,
 			},
 		},
 	}
@@ -924,6 +944,200 @@ var (
 		CertifyLegal: SpdxCertifyLegal,
 	}
 
+	// SPDX 3 Testdata
+	spdx3AppPack, _        = asmhelpers.PurlToPkg("pkg:npm/web-app@1.4.0")
+	spdx3ExpressPack, _    = asmhelpers.PurlToPkg("pkg:npm/express@4.18.2")
+	spdx3BodyParserPack, _ = asmhelpers.PurlToPkg("pkg:npm/body-parser@1.20.1")
+	spdx3JestPack, _       = asmhelpers.PurlToPkg("pkg:guac/pkg/jest@29.7.0")
+	spdx3IndexFilePack, _  = asmhelpers.PurlToPkg(asmhelpers.GuacFilePurl("sha1", "ba1c68d88439599dcca7594d610030a19eda4f63", ptrfrom.String("src/index.js")))
+
+	spdx3Time = parseRfc3339("2024-05-02T10:15:00Z")
+
+	spdx3ExpressVuln    = &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2024-29041"}
+	spdx3ExpressVexTime = parseRfc3339("2024-04-01T00:00:00Z")
+
+	Spdx3IngestionPredicates = assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{
+			{
+				Pkg:             spdx3AppPack,
+				DepPkg:          spdx3ExpressPack,
+				DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType:  model.DependencyTypeDirect,
+					DependencyScope: ptrfrom.Any(model.DependencyScopeUnknown),
+					VersionRange:    "4.18.2",
+					Justification:   "Derived from SPDX dependsOn relationship",
+				},
+			},
+			{
+				Pkg:             spdx3AppPack,
+				DepPkg:          spdx3JestPack,
+				DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType:  model.DependencyTypeDirect,
+					DependencyScope: ptrfrom.Any(model.DependencyScopeTest),
+					VersionRange:    "29.7.0",
+					Justification:   "Derived from SPDX dependsOn relationship",
+				},
+			},
+			{
+				Pkg:             spdx3ExpressPack,
+				DepPkg:          spdx3BodyParserPack,
+				DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType:  model.DependencyTypeDirect,
+					DependencyScope: ptrfrom.Any(model.DependencyScopeOptional),
+					VersionRange:    "1.20.1",
+					Justification:   "Derived from SPDX hasOptionalDependency relationship",
+				},
+			},
+			{
+				Pkg:             spdx3AppPack,
+				DepPkg:          spdx3BodyParserPack,
+				DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType: model.DependencyTypeIndirect,
+					VersionRange:   "1.20.1",
+					Justification:  "SPDX transitive dependency",
+				},
+			},
+			{
+				Pkg:             spdx3AppPack,
+				DepPkg:          spdx3IndexFilePack,
+				DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType:  model.DependencyTypeUnknown,
+					DependencyScope: ptrfrom.Any(model.DependencyScopeUnknown),
+					VersionRange:    "",
+					Justification:   "Derived from SPDX contains relationship",
+				},
+			},
+		},
+		IsOccurrence: []assembler.IsOccurrenceIngest{
+			{
+				Pkg: spdx3IndexFilePack,
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha1",
+					Digest:    "ba1c68d88439599dcca7594d610030a19eda4f63",
+				},
+				IsOccurrence: isOccJustifyFile,
+			},
+			{
+				Pkg: spdx3AppPack,
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha256",
+					Digest:    "0f4a1c3e8e3fbd3c0bbd8d8e5b1d7f0e33a6a5c1a2f4a9d6f6d2c8a1e0b7c6d5",
+				},
+				IsOccurrence: &model.IsOccurrenceInputSpec{
+					Justification: "spdx package with checksum",
+				},
+			},
+		},
+		HasSBOM: []assembler.HasSBOMIngest{
+			{
+				Pkg: spdx3AppPack,
+				HasSBOM: &model.HasSBOMInputSpec{
+					Uri:              "https://example.com/spdx/web-app/document",
+					Algorithm:        "sha256",
+					Digest:           "ce0587b3f64861643c0020995379927f8b58f520ef9fb0c77d5d48199c1e3fb2",
+					DownloadLocation: "TestSource",
+					KnownSince:       spdx3Time,
+				},
+			},
+		},
+		CertifyLegal: []assembler.CertifyLegalIngest{
+			{
+				Pkg: spdx3AppPack,
+				Declared: []model.LicenseInputSpec{
+					{
+						Name:        "Apache-2.0",
+						ListVersion: ptrfrom.String("3.23"),
+					},
+					{
+						Name:   "LicenseRef-6bf64628",
+						Inline: ptrfrom.String("Example Inc. end user license agreement"),
+					},
+				},
+				CertifyLegal: &model.CertifyLegalInputSpec{
+					DeclaredLicense: "Apache-2.0 AND LicenseRef-6bf64628",
+					Attribution:     "Copyright 2024 Example Inc.",
+					Justification:   "Found in SPDX document.",
+					TimeScanned:     spdx3Time,
+				},
+			},
+			{
+				Pkg: spdx3ExpressPack,
+				Discovered: []model.LicenseInputSpec{
+					{
+						Name:        "MIT",
+						ListVersion: ptrfrom.String("3.23"),
+					},
+				},
+				CertifyLegal: &model.CertifyLegalInputSpec{
+					DiscoveredLicense: "MIT",
+					Justification:     "Found in SPDX document.",
+					TimeScanned:       spdx3Time,
+				},
+			},
+		},
+		HasMetadata: []assembler.HasMetadataIngest{
+			{
+				Pkg:          spdx3ExpressPack,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				HasMetadata: &model.HasMetadataInputSpec{
+					Key:           "cpe",
+					Value:         "cpe:2.3:a:expressjs:express:4.18.2:*:*:*:*:node.js:*:*",
+					Justification: "spdx cpe external identifier",
+					Origin:        "GUAC SPDX",
+					Collector:     "GUAC",
+				},
+			},
+		},
+		Vex: []assembler.VexIngest{
+			{
+				Pkg:           spdx3ExpressPack,
+				Vulnerability: spdx3ExpressVuln,
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusAffected,
+					VexJustification: model.VexJustificationNotProvided,
+					Statement:        "Upgrade express to 4.19.2",
+					StatusNotes:      "AFFECTED:NOT_PROVIDED",
+					KnownSince:       spdx3ExpressVexTime,
+				},
+			},
+			{
+				Pkg:           spdx3BodyParserPack,
+				Vulnerability: &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2024-45590"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusNotAffected,
+					VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+					Statement:        "urlencoded parsing is not enabled",
+					StatusNotes:      "NOT_AFFECTED:VULNERABLE_CODE_NOT_IN_EXECUTE_PATH",
+					KnownSince:       spdx3Time,
+				},
+			},
+		},
+		CertifyVuln: []assembler.CertifyVulnIngest{
+			{
+				Pkg:           spdx3ExpressPack,
+				Vulnerability: spdx3ExpressVuln,
+				VulnData: &model.ScanMetadataInput{
+					TimeScanned: spdx3ExpressVexTime,
+				},
+			},
+		},
+		VulnMetadata: []assembler.VulnMetadataIngest{
+			{
+				Vulnerability: spdx3ExpressVuln,
+				VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+					ScoreType:  model.VulnerabilityScoreTypeCvssv31,
+					ScoreValue: 6.1,
+					Timestamp:  spdx3Time,
+				},
+			},
+		},
+	}
+
 	// CycloneDX Testdata
 	cdxTopLevelPack, _ = asmhelpers.PurlToPkg("pkg:guac/cdx/gcr.io/distroless/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?tag=nonroot")
 
@@ -981,9 +1195,22 @@ var (
 		},
 	}
 
+	CdxCertifyLegal = []assembler.CertifyLegalIngest{
+		{
+			Pkg:      cdxNetbasePack,
+			Declared: []model.LicenseInputSpec{{Name: "GPL-2.0-only"}},
+			CertifyLegal: &model.CertifyLegalInputSpec{
+				DeclaredLicense: "GPL-2.0-only",
+				Justification:   "Found in CycloneDX document.",
+				TimeScanned:     cdxTime,
+			},
+		},
+	}
+
 	CdxIngestionPredicates = assembler.IngestPredicates{
 		IsDependency: CdxDeps,
 		HasSBOM:      CdxHasSBOM,
+		CertifyLegal: CdxCertifyLegal,
 	}
 
 	cdxTopQuarkusPack, _ = asmhelpers.PurlToPkg("pkg:maven/org.acme/getting-started@1.0.0-SNAPSHOT?type=jar")
@@ -998,9 +1225,9 @@ var (
 			DepPkg:          cdxResteasyPack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeDirect,
 				VersionRange:   "2.13.4.Final",
-				Justification:  isDepJustifyTopPkgJustification,
+				Justification:  isCDXDepJustifyDependsJustification,
 			},
 		},
 		{
@@ -1008,9 +1235,9 @@ var (
 			DepPkg:          cdxReactiveCommonPack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeIndirect,
 				VersionRange:   "2.13.4.Final",
-				Justification:  isDepJustifyTopPkgJustification,
+				Justification:  isCDXDepJustifyTransitiveJustification,
 			},
 		},
 		{
@@ -1018,7 +1245,7 @@ var (
 			DepPkg:          cdxReactiveCommonPack,
 			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
 			IsDependency: &model.IsDependencyInputSpec{
-				DependencyType: model.DependencyTypeUnknown,
+				DependencyType: model.DependencyTypeDirect,
 				VersionRange:   "2.13.4.Final",
 				Justification:  isCDXDepJustifyDependsJustification,
 			},
@@ -1075,10 +1302,32 @@ var (
 		},
 	}
 
+	CdxQuarkusCertifyLegal = []assembler.CertifyLegalIngest{
+		{
+			Pkg:      cdxResteasyPack,
+			Declared: []model.LicenseInputSpec{{Name: "Apache-2.0"}},
+			CertifyLegal: &model.CertifyLegalInputSpec{
+				DeclaredLicense: "Apache-2.0",
+				Justification:   "Found in CycloneDX document.",
+				TimeScanned:     cdxQuarkusTime,
+			},
+		},
+		{
+			Pkg:      cdxReactiveCommonPack,
+			Declared: []model.LicenseInputSpec{{Name: "Apache-2.0"}},
+			CertifyLegal: &model.CertifyLegalInputSpec{
+				DeclaredLicense: "Apache-2.0",
+				Justification:   "Found in CycloneDX document.",
+				TimeScanned:     cdxQuarkusTime,
+			},
+		},
+	}
+
 	CdxQuarkusIngestionPredicates = assembler.IngestPredicates{
 		IsDependency: CdxQuarkusDeps,
 		IsOccurrence: CdxQuarkusOccurrence,
 		HasSBOM:      CdxQuarkusHasSBOM,
+		CertifyLegal: CdxQuarkusCertifyLegal,
 	}
 
 	cdxWebAppPackage, _ = asmhelpers.PurlToPkg("pkg:npm/web-app@1.0.0")
@@ -1136,6 +1385,17 @@ var (
 
 	CdxEmptyIngestionPredicates = assembler.IngestPredicates{
 		HasSBOM: quarkusParentPackageHasSBOM,
+		CertifyLegal: []assembler.CertifyLegalIngest{
+			{
+				Pkg:      quarkusParentPackage,
+				Declared: []model.LicenseInputSpec{{Name: "Apache-2.0"}},
+				CertifyLegal: &model.CertifyLegalInputSpec{
+					DeclaredLicense: "Apache-2.0",
+					Justification:   "Found in CycloneDX document.",
+					TimeScanned:     quarkusTime,
+				},
+			},
+		},
 	}
 
 	// ceritifer testdata
@@ -22,6 +22,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -36,7 +37,7 @@ func (c *arangoClient) Artifacts(ctx context.Context, artifactSpec *model.Artifa
 		"digest": art.digest
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "Artifacts")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "id", "Artifacts")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for artifacts: %w", err)
 	}
@@ -45,6 +46,17 @@ func (c *arangoClient) Artifacts(ctx context.Context, artifactSpec *model.Artifa
 	return getArtifacts(ctx, cursor)
 }
 
+// ArtifactsList returns a page of the artifacts query results, ordered by ID.
+func (c *arangoClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	p, err := listPage(ctx, "Artifacts", after, first, func(n *model.Artifact) string { return n.ID }, func(ctx context.Context) ([]*model.Artifact, error) {
+		return c.Artifacts(ctx, artifactSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.ArtifactConnection(p), nil
+}
+
 func setArtifactMatchValues(artifactSpec *model.ArtifactSpec, queryValues map[string]any) *arangoQueryBuilder {
 	arangoQueryBuilder := newForQuery(artifactsStr, "art")
 	if artifactSpec != nil {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -34,7 +35,7 @@ func (c *arangoClient) Builders(ctx context.Context, builderSpec *model.BuilderS
 		"uri": build.uri,
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "Builders")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "id", "Builders")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for builder: %w", err)
 	}
@@ -43,6 +44,17 @@ func (c *arangoClient) Builders(ctx context.Context, builderSpec *model.BuilderS
 	return getBuilders(ctx, cursor)
 }
 
+// BuildersList returns a page of the builders query results, ordered by ID.
+func (c *arangoClient) BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
+	p, err := listPage(ctx, "Builders", after, first, func(n *model.Builder) string { return n.ID }, func(ctx context.Context) ([]*model.Builder, error) {
+		return c.Builders(ctx, builderSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.BuilderConnection(p), nil
+}
+
 func setBuilderMatchValues(builderSpec *model.BuilderSpec, queryValues map[string]any) *arangoQueryBuilder {
 	arangoQueryBuilder := newForQuery(buildersStr, "build")
 	if builderSpec != nil {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -157,6 +158,17 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 	}
 }
 
+// CertifyBadList returns a page of the CertifyBad query results, ordered by ID.
+func (c *arangoClient) CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	p, err := listPage(ctx, "CertifyBad", after, first, func(n *model.CertifyBad) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyBad, error) {
+		return c.CertifyBad(ctx, certifyBadSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyBadConnection(p), nil
+}
+
 func getSrcCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyBad, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -173,11 +185,13 @@ func getSrcCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryB
 		'certifyBad_id': certifyBad._id,
 		'justification': certifyBad.justification,
 		'collector': certifyBad.collector,
+		'verified': certifyBad.verified,
+		'signer': certifyBad.signer,
 		'knownSince': certifyBad.knownSince,
 		'origin': certifyBad.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "CertifyBad")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyBad_id", "CertifyBad")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyBad: %w", err)
 	}
@@ -197,11 +211,13 @@ func getArtCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryB
 		'certifyBad_id': certifyBad._id,
 		'justification': certifyBad.justification,
 		'collector': certifyBad.collector,
+		'verified': certifyBad.verified,
+		'signer': certifyBad.signer,
 		'knownSince': certifyBad.knownSince,
 		'origin': certifyBad.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "CertifyBad")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyBad_id", "CertifyBad")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyBad: %w", err)
 	}
@@ -229,6 +245,8 @@ func getPkgCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryB
 			'certifyBad_id': certifyBad._id,
 			'justification': certifyBad.justification,
 			'collector': certifyBad.collector,
+			'verified': certifyBad.verified,
+			'signer': certifyBad.signer,
 			'knownSince': certifyBad.knownSince,
 			'origin': certifyBad.origin
 		  }`)
@@ -246,12 +264,14 @@ func getPkgCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryB
 			'certifyBad_id': certifyBad._id,
 			'justification': certifyBad.justification,
 			'collector': certifyBad.collector,
+			'verified': certifyBad.verified,
+			'signer': certifyBad.signer,
 			'knownSince': certifyBad.knownSince,
 			'origin': certifyBad.origin
 		  }`)
 	}
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "CertifyBad")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyBad_id", "CertifyBad")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyBad: %w", err)
 	}
@@ -305,6 +325,8 @@ func getCertifyBadQueryValues(pkg *model.PkgInputSpec, pkgMatchType *model.Match
 	values["justification"] = certifyBad.Justification
 	values["origin"] = certifyBad.Origin
 	values["collector"] = certifyBad.Collector
+	values["verified"] = certifyBad.Verified
+	values["signer"] = certifyBad.Signer
 	values[knownSince] = certifyBad.KnownSince.UTC()
 
 	return values
@@ -327,7 +349,7 @@ func (c *arangoClient) IngestCertifyBad(ctx context.Context, subject model.Packa
 		  
 		  LET certifyBad = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
-				  INSERT {  packageID:firstPkg.version_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
+				  INSERT {  packageID:firstPkg.version_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince } 
 				  UPDATE {} IN certifyBads
 				  RETURN {
 					'_id': NEW._id,
@@ -359,7 +381,7 @@ func (c *arangoClient) IngestCertifyBad(ctx context.Context, subject model.Packa
 			  
 			  LET certifyBad = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
-					  INSERT {  packageID:firstPkg.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
+					  INSERT {  packageID:firstPkg.name_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince } 
 					  UPDATE {} IN certifyBads
 					  RETURN {
 						'_id': NEW._id,
@@ -384,7 +406,7 @@ func (c *arangoClient) IngestCertifyBad(ctx context.Context, subject model.Packa
 		  
 		LET certifyBad = FIRST(
 			UPSERT { artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
-				INSERT { artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
+				INSERT { artifactID:artifact._id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince } 
 				UPDATE {} IN certifyBads
 				RETURN {
 					'_id': NEW._id,
@@ -416,7 +438,7 @@ func (c *arangoClient) IngestCertifyBad(ctx context.Context, subject model.Packa
 		  
 		LET certifyBad = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
-				INSERT { sourceID:firstSrc.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince } 
+				INSERT { sourceID:firstSrc.name_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince } 
 				UPDATE {} IN certifyBads
 				RETURN {
 					'_id': NEW._id,
@@ -495,7 +517,7 @@ func (c *arangoClient) IngestCertifyBads(ctx context.Context, subjects model.Pac
 		  
 		  LET certifyBad = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				  INSERT {  packageID:firstPkg.version_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				  INSERT {  packageID:firstPkg.version_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				  UPDATE {} IN certifyBads
 				  RETURN {
 					'_id': NEW._id,
@@ -530,7 +552,7 @@ func (c *arangoClient) IngestCertifyBads(ctx context.Context, subjects model.Pac
 			  
 			  LET certifyBad = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-					  INSERT {  packageID:firstPkg.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+					  INSERT {  packageID:firstPkg.name_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 					  UPDATE {} IN certifyBads
 					  RETURN {
 						'_id': NEW._id,
@@ -586,7 +608,7 @@ func (c *arangoClient) IngestCertifyBads(ctx context.Context, subjects model.Pac
 		  
 		LET certifyBad = FIRST(
 			UPSERT { artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				INSERT { artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				INSERT { artifactID:artifact._id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				UPDATE {} IN certifyBads
 				RETURN {
 					'_id': NEW._id,
@@ -648,7 +670,7 @@ func (c *arangoClient) IngestCertifyBads(ctx context.Context, subjects model.Pac
 		  
 		LET certifyBad = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				INSERT { sourceID:firstSrc.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				INSERT { sourceID:firstSrc.name_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				UPDATE {} IN certifyBads
 				RETURN {
 					'_id': NEW._id,
@@ -693,6 +715,8 @@ func getCertifyBadFromCursor(ctx context.Context, cursor driver.Cursor, ingestio
 		CertifyBadID  string          `json:"certifyBad_id"`
 		Justification string          `json:"justification"`
 		Collector     string          `json:"collector"`
+		Verified      *bool           `json:"verified"`
+		Signer        *string         `json:"signer"`
 		KnownSince    time.Time       `json:"knownSince"`
 		Origin        string          `json:"origin"`
 	}
@@ -729,6 +753,8 @@ func getCertifyBadFromCursor(ctx context.Context, cursor driver.Cursor, ingestio
 			Justification: createdValue.Justification,
 			Origin:        createdValue.Collector,
 			Collector:     createdValue.Origin,
+			Verified:      createdValue.Verified,
+			Signer:        createdValue.Signer,
 			KnownSince:    createdValue.KnownSince,
 		}
 
@@ -794,6 +820,8 @@ func (c *arangoClient) queryCertifyBadNodeByID(ctx context.Context, filter *mode
 		ArtifactID    *string `json:"artifactID"`
 		Justification string  `json:"justification"`
 		Collector     string  `json:"collector"`
+		Verified      *bool   `json:"verified"`
+		Signer        *string `json:"signer"`
 		Origin        string  `json:"origin"`
 	}
 
@@ -821,6 +849,8 @@ func (c *arangoClient) queryCertifyBadNodeByID(ctx context.Context, filter *mode
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}
 
 	if collectedValues[0].PackageID != nil {
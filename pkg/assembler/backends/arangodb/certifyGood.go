@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -157,6 +158,17 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 	}
 }
 
+// CertifyGoodList returns a page of the CertifyGood query results, ordered by ID.
+func (c *arangoClient) CertifyGoodList(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
+	p, err := listPage(ctx, "certifyGood", after, first, func(n *model.CertifyGood) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyGood, error) {
+		return c.CertifyGood(ctx, certifyGoodSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyGoodConnection(p), nil
+}
+
 func getSrcCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyGood, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -173,11 +185,13 @@ func getSrcCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQuery
 		'certifyGood_id': certifyGood._id,
 		'justification': certifyGood.justification,
 		'collector': certifyGood.collector,
+		'verified': certifyGood.verified,
+		'signer': certifyGood.signer,
 		'knownSince': certifyGood.knownSince,
 		'origin': certifyGood.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "certifyGood")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyGood_id", "certifyGood")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for certifyGood: %w", err)
 	}
@@ -197,11 +211,13 @@ func getArtCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQuery
 		'certifyGood_id': certifyGood._id,
 		'justification': certifyGood.justification,
 		'collector': certifyGood.collector,
+		'verified': certifyGood.verified,
+		'signer': certifyGood.signer,
 		'knownSince': certifyGood.knownSince,
 		'origin': certifyGood.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "certifyGood")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyGood_id", "certifyGood")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for certifyGood: %w", err)
 	}
@@ -229,6 +245,8 @@ func getPkgCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQuery
 			'certifyGood_id': certifyGood._id,
 			'justification': certifyGood.justification,
 			'collector': certifyGood.collector,
+			'verified': certifyGood.verified,
+			'signer': certifyGood.signer,
 			'knownSince': certifyGood.knownSince,
 			'origin': certifyGood.origin
 		  }`)
@@ -247,12 +265,14 @@ func getPkgCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQuery
 			'certifyGood_id': certifyGood._id,
 			'justification': certifyGood.justification,
 			'collector': certifyGood.collector,
+			'verified': certifyGood.verified,
+			'signer': certifyGood.signer,
 			'knownSince': certifyGood.knownSince,
 			'origin': certifyGood.origin
 		  }`)
 	}
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "certifyGood")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyGood_id", "certifyGood")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for certifyGood: %w", err)
 	}
@@ -306,6 +326,8 @@ func getCertifyGoodQueryValues(pkg *model.PkgInputSpec, pkgMatchType *model.Matc
 	values["justification"] = certifyGood.Justification
 	values["origin"] = certifyGood.Origin
 	values["collector"] = certifyGood.Collector
+	values["verified"] = certifyGood.Verified
+	values["signer"] = certifyGood.Signer
 	values["knownSince"] = certifyGood.KnownSince.UTC()
 
 	return values
@@ -328,7 +350,7 @@ func (c *arangoClient) IngestCertifyGood(ctx context.Context, subject model.Pack
 
 		  LET certifyGood = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
-				  INSERT {  packageID:firstPkg.version_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
+				  INSERT {  packageID:firstPkg.version_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince }
 				  UPDATE {} IN certifyGoods
 				  RETURN {
 					'_id': NEW._id,
@@ -360,7 +382,7 @@ func (c *arangoClient) IngestCertifyGood(ctx context.Context, subject model.Pack
 
 			  LET certifyGood = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
-					  INSERT {  packageID:firstPkg.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
+					  INSERT {  packageID:firstPkg.name_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince }
 					  UPDATE {} IN certifyGoods
 					  RETURN {
 						'_id': NEW._id,
@@ -386,7 +408,7 @@ func (c *arangoClient) IngestCertifyGood(ctx context.Context, subject model.Pack
 
 		LET certifyGood = FIRST(
 			UPSERT { artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
-				INSERT { artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
+				INSERT { artifactID:artifact._id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince }
 				UPDATE {} IN certifyGoods
 				RETURN {
 					'_id': NEW._id,
@@ -418,7 +440,7 @@ func (c *arangoClient) IngestCertifyGood(ctx context.Context, subject model.Pack
 
 		LET certifyGood = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
-				INSERT { sourceID:firstSrc.name_id, justification:@justification, collector:@collector, origin:@origin, knownSince:@knownSince }
+				INSERT { sourceID:firstSrc.name_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince }
 				UPDATE {} IN certifyGoods
 				RETURN {
 					'_id': NEW._id,
@@ -502,7 +524,7 @@ func (c *arangoClient) IngestCertifyGoods(ctx context.Context, subjects model.Pa
 		  
 		  LET certifyGood = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				  INSERT {  packageID:firstPkg.version_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				  INSERT {  packageID:firstPkg.version_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				  UPDATE {} IN certifyGoods
 				  RETURN {
 					'_id': NEW._id,
@@ -536,7 +558,7 @@ func (c *arangoClient) IngestCertifyGoods(ctx context.Context, subjects model.Pa
 			  
 			  LET certifyGood = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-					  INSERT {  packageID:firstPkg.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+					  INSERT {  packageID:firstPkg.name_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 					  UPDATE {} IN certifyGoods
 					  RETURN {
 						'_id': NEW._id,
@@ -596,7 +618,7 @@ func (c *arangoClient) IngestCertifyGoods(ctx context.Context, subjects model.Pa
 		  
 		LET certifyGood = FIRST(
 			UPSERT { artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				INSERT { artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				INSERT { artifactID:artifact._id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				UPDATE {} IN certifyGoods
 				RETURN {
 					'_id': NEW._id,
@@ -663,7 +685,7 @@ func (c *arangoClient) IngestCertifyGoods(ctx context.Context, subjects model.Pa
 		  
 		LET certifyGood = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				INSERT { sourceID:firstSrc.name_id, justification:doc.justification, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				INSERT { sourceID:firstSrc.name_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				UPDATE {} IN certifyGoods
 				RETURN {
 					'_id': NEW._id,
@@ -708,6 +730,8 @@ func getCertifyGoodFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 		CertifyGoodID string          `json:"certifyGood_id"`
 		Justification string          `json:"justification"`
 		Collector     string          `json:"collector"`
+		Verified      *bool           `json:"verified"`
+		Signer        *string         `json:"signer"`
 		KnownSince    time.Time       `json:"knownSince"`
 		Origin        string          `json:"origin"`
 	}
@@ -743,6 +767,8 @@ func getCertifyGoodFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 			Justification: createdValue.Justification,
 			Origin:        createdValue.Collector,
 			Collector:     createdValue.Origin,
+			Verified:      createdValue.Verified,
+			Signer:        createdValue.Signer,
 			KnownSince:    createdValue.KnownSince,
 		}
 		if pkg != nil {
@@ -807,6 +833,8 @@ func (c *arangoClient) queryCertifyGoodNodeByID(ctx context.Context, filter *mod
 		ArtifactID    *string `json:"artifactID"`
 		Justification string  `json:"justification"`
 		Collector     string  `json:"collector"`
+		Verified      *bool   `json:"verified"`
+		Signer        *string `json:"signer"`
 		Origin        string  `json:"origin"`
 	}
 
@@ -834,6 +862,8 @@ func (c *arangoClient) queryCertifyGoodNodeByID(ctx context.Context, filter *mod
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}
 
 	if collectedValues[0].PackageID != nil {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -107,6 +108,17 @@ func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model
 	return combinedCertifyLegal, nil
 }
 
+// CertifyLegalList returns a page of the CertifyLegal query results, ordered by ID.
+func (c *arangoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	p, err := listPage(ctx, "CertifyLegal", after, first, func(n *model.CertifyLegal) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyLegal, error) {
+		return c.CertifyLegal(ctx, certifyLegalSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyLegalConnection(p), nil
+}
+
 func getSrcCertifyLegalForQuery(ctx context.Context, c *arangoClient,
 	aqb *arangoQueryBuilder, values map[string]any,
 	decFilter, disFilter []*model.LicenseSpec) ([]*model.CertifyLegal, error) {
@@ -131,10 +143,12 @@ func getSrcCertifyLegalForQuery(ctx context.Context, c *arangoClient,
   'justification': certifyLegal.justification,
   'timeScanned': certifyLegal.timeScanned,
   'collector': certifyLegal.collector,
+  'verified': certifyLegal.verified,
+  'signer': certifyLegal.signer,
   'origin': certifyLegal.origin
 }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, aqb.string(), values, "CertifyLegal")
+	cursor, err := executePagedQuery(ctx, c.db, aqb.string(), values, "certifyLegal_id", "CertifyLegal")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyLegal: %w", err)
 	}
@@ -169,10 +183,12 @@ func getPkgCertifyLegalForQuery(ctx context.Context, c *arangoClient,
   'justification': certifyLegal.justification,
   'timeScanned': certifyLegal.timeScanned,
   'collector': certifyLegal.collector,
+  'verified': certifyLegal.verified,
+  'signer': certifyLegal.signer,
   'origin': certifyLegal.origin
 }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, aqb.string(), values, "CertifyLegal")
+	cursor, err := executePagedQuery(ctx, c.db, aqb.string(), values, "certifyLegal_id", "CertifyLegal")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyLegal: %w", err)
 	}
@@ -255,6 +271,8 @@ func getCertifyLegalQueryValues(pkg *model.PkgInputSpec, source *model.SourceInp
 	values["timeScanned"] = certifyLegal.TimeScanned.UTC()
 	values["origin"] = certifyLegal.Origin
 	values["collector"] = certifyLegal.Collector
+	values["verified"] = certifyLegal.Verified
+	values["signer"] = certifyLegal.Signer
 
 	return values
 }
@@ -310,6 +328,8 @@ LET certifyLegal = FIRST(
     justification:@justification,
     timeScanned:@timeScanned,
     collector:@collector,
+    verified:@verified,
+    signer:@signer,
     origin:@origin
   }
   UPDATE {} IN certifyLegals
@@ -384,6 +404,8 @@ LET certifyLegal = FIRST(
     justification:@justification,
     timeScanned:@timeScanned,
     collector:@collector,
+    verified:@verified,
+    signer:@signer,
     origin:@origin
   }
   UPDATE {} IN certifyLegals
@@ -505,6 +527,8 @@ LET certifyLegal = FIRST(
     justification:doc.justification,
     timeScanned:doc.timeScanned,
     collector:doc.collector,
+    verified:doc.verified,
+    signer:doc.signer,
     origin:doc.origin
   }
   UPDATE {} IN certifyLegals
@@ -620,6 +644,8 @@ LET certifyLegal = FIRST(
     justification:doc.justification,
     timeScanned:doc.timeScanned,
     collector:doc.collector,
+    verified:doc.verified,
+    signer:doc.signer,
     origin:doc.origin
   }
   UPDATE {} IN certifyLegals
@@ -681,6 +707,8 @@ func (c *arangoClient) getCertifyLegalFromCursor(ctx context.Context,
 		Justification      string        `json:"justification"`
 		TimeScanned        time.Time     `json:"timeScanned"`
 		Collector          string        `json:"collector"`
+		Verified           *bool         `json:"verified"`
+		Signer             *string       `json:"signer"`
 		Origin             string        `json:"origin"`
 	}
 
@@ -736,6 +764,8 @@ func (c *arangoClient) getCertifyLegalFromCursor(ctx context.Context,
 			TimeScanned:       createdValue.TimeScanned,
 			Origin:            createdValue.Origin,
 			Collector:         createdValue.Collector,
+			Verified:          createdValue.Verified,
+			Signer:            createdValue.Signer,
 		}
 
 		dec, err := c.getLicensesByID(ctx, createdValue.DeclaredLicenses)
@@ -821,6 +851,8 @@ func (c *arangoClient) queryCertifyLegalNodeByID(ctx context.Context, filter *mo
 		Justification      string    `json:"justification"`
 		TimeScanned        time.Time `json:"timeScanned"`
 		Collector          string    `json:"collector"`
+		Verified           *bool     `json:"verified"`
+		Signer             *string   `json:"signer"`
 		Origin             string    `json:"origin"`
 	}
 
@@ -852,6 +884,8 @@ func (c *arangoClient) queryCertifyLegalNodeByID(ctx context.Context, filter *mo
 		TimeScanned:       collectedValues[0].TimeScanned,
 		Origin:            collectedValues[0].Origin,
 		Collector:         collectedValues[0].Collector,
+		Verified:          collectedValues[0].Verified,
+		Signer:            collectedValues[0].Signer,
 	}
 
 	dec, err := c.getLicensesByID(ctx, collectedValues[0].DeclaredLicenses)
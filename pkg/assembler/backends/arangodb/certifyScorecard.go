@@ -25,6 +25,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -79,10 +80,12 @@ func (c *arangoClient) Scorecards(ctx context.Context, certifyScorecardSpec *mod
 		'scorecardVersion': scorecard.scorecardVersion,
 		'scorecardCommit': scorecard.scorecardCommit,
 		'collector': scorecard.collector,
+		'verified': scorecard.verified,
+		'signer': scorecard.signer,
 		'origin': scorecard.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "Scorecards")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "scorecard_id", "Scorecards")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for Scorecards: %w", err)
 	}
@@ -91,6 +94,17 @@ func (c *arangoClient) Scorecards(ctx context.Context, certifyScorecardSpec *mod
 	return getCertifyScorecardFromCursor(ctx, cursor, false)
 }
 
+// ScorecardsList returns a page of the scorecards query results, ordered by ID.
+func (c *arangoClient) ScorecardsList(ctx context.Context, scorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	p, err := listPage(ctx, "Scorecards", after, first, func(n *model.CertifyScorecard) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyScorecard, error) {
+		return c.Scorecards(ctx, scorecardSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyScorecardConnection(p), nil
+}
+
 func setCertifyScorecardMatchValues(arangoQueryBuilder *arangoQueryBuilder, certifyScorecardSpec *model.CertifyScorecardSpec, queryValues map[string]any) {
 	if certifyScorecardSpec.ID != nil {
 		arangoQueryBuilder.filter("scorecard", "_id", "==", "@id")
@@ -173,6 +187,8 @@ func getScorecardValues(src *model.SourceInputSpec, scorecard *model.ScorecardIn
 	values[scorecardCommitStr] = scorecard.ScorecardCommit
 	values[origin] = scorecard.Origin
 	values[collector] = scorecard.Collector
+	values["verified"] = scorecard.Verified
+	values["signer"] = scorecard.Signer
 
 	return values
 }
@@ -220,7 +236,7 @@ func (c *arangoClient) IngestScorecards(ctx context.Context, sources []*model.So
 	  	  
 	LET scorecard = FIRST(
 		UPSERT { sourceID:firstSrc.name_id, checks:doc.checks, aggregateScore:doc.aggregateScore, timeScanned:doc.timeScanned, scorecardVersion:doc.scorecardVersion, scorecardCommit:doc.scorecardCommit, collector:doc.collector, origin:doc.origin } 
-			INSERT { sourceID:firstSrc.name_id, checks:doc.checks, aggregateScore:doc.aggregateScore, timeScanned:doc.timeScanned, scorecardVersion:doc.scorecardVersion, scorecardCommit:doc.scorecardCommit, collector:doc.collector, origin:doc.origin } 
+			INSERT { sourceID:firstSrc.name_id, checks:doc.checks, aggregateScore:doc.aggregateScore, timeScanned:doc.timeScanned, scorecardVersion:doc.scorecardVersion, scorecardCommit:doc.scorecardCommit, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 			UPDATE {} IN scorecards
 			RETURN {
 				'_id': NEW._id,
@@ -268,7 +284,7 @@ func (c *arangoClient) IngestScorecard(ctx context.Context, source model.SourceI
 	  	  
 	LET scorecard = FIRST(
 		UPSERT { sourceID:firstSrc.name_id, checks:@checks, aggregateScore:@aggregateScore, timeScanned:@timeScanned, scorecardVersion:@scorecardVersion, scorecardCommit:@scorecardCommit, collector:@collector, origin:@origin } 
-			INSERT { sourceID:firstSrc.name_id, checks:@checks, aggregateScore:@aggregateScore, timeScanned:@timeScanned, scorecardVersion:@scorecardVersion, scorecardCommit:@scorecardCommit, collector:@collector, origin:@origin } 
+			INSERT { sourceID:firstSrc.name_id, checks:@checks, aggregateScore:@aggregateScore, timeScanned:@timeScanned, scorecardVersion:@scorecardVersion, scorecardCommit:@scorecardCommit, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			UPDATE {} IN scorecards
 			RETURN {
 				'_id': NEW._id,
@@ -329,6 +345,8 @@ func getCertifyScorecardFromCursor(ctx context.Context, cursor driver.Cursor, in
 		ScorecardVersion string     `json:"scorecardVersion"`
 		ScorecardCommit  string     `json:"scorecardCommit"`
 		Collector        string     `json:"collector"`
+		Verified         *bool      `json:"verified"`
+		Signer           *string    `json:"signer"`
 		Origin           string     `json:"origin"`
 	}
 
@@ -361,6 +379,8 @@ func getCertifyScorecardFromCursor(ctx context.Context, cursor driver.Cursor, in
 			ScorecardCommit:  createdValue.ScorecardCommit,
 			Origin:           createdValue.Origin,
 			Collector:        createdValue.Collector,
+			Verified:         createdValue.Verified,
+			Signer:           createdValue.Signer,
 		}
 
 		certifyScorecard := &model.CertifyScorecard{
@@ -429,6 +449,8 @@ func (c *arangoClient) queryCertifyScorecardNodeByID(ctx context.Context, filter
 		ScorecardVersion string    `json:"scorecardVersion"`
 		ScorecardCommit  string    `json:"scorecardCommit"`
 		Collector        string    `json:"collector"`
+		Verified         *bool     `json:"verified"`
+		Signer           *string   `json:"signer"`
 		Origin           string    `json:"origin"`
 	}
 
@@ -463,6 +485,8 @@ func (c *arangoClient) queryCertifyScorecardNodeByID(ctx context.Context, filter
 		ScorecardCommit:  collectedValues[0].ScorecardCommit,
 		Origin:           collectedValues[0].Origin,
 		Collector:        collectedValues[0].Collector,
+		Verified:         collectedValues[0].Verified,
+		Signer:           collectedValues[0].Signer,
 	}
 
 	builtSource, err := c.buildSourceResponseFromID(ctx, collectedValues[0].SourceID, filter.Source)
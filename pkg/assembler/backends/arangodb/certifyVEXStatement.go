@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -107,6 +108,17 @@ func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatem
 	}
 }
 
+// CertifyVEXStatementList returns a page of the CertifyVEXStatement query results, ordered by ID.
+func (c *arangoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	p, err := listPage(ctx, "CertifyVEXStatement", after, first, func(n *model.CertifyVEXStatement) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyVEXStatement, error) {
+		return c.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVEXStatementConnection(p), nil
+}
+
 func getPkgVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyVEXStatement, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -135,10 +147,12 @@ func getPkgVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder
 		'statusNotes': certifyVex.statusNotes,
 		'knownSince': certifyVex.knownSince,
 		'collector': certifyVex.collector,
+		'verified': certifyVex.verified,
+		'signer': certifyVex.signer,
 		'origin': certifyVex.origin  
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "CertifyVEXStatement")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyVex_id", "CertifyVEXStatement")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyVEXStatement: %w", err)
 	}
@@ -168,10 +182,12 @@ func getArtifactVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBui
 		'statusNotes': certifyVex.statusNotes,
 		'knownSince': certifyVex.knownSince,
 		'collector': certifyVex.collector,
+		'verified': certifyVex.verified,
+		'signer': certifyVex.signer,
 		'origin': certifyVex.origin  
 	}`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "CertifyVEXStatement")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyVex_id", "CertifyVEXStatement")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyVEXStatement: %w", err)
 	}
@@ -255,6 +271,8 @@ func getVEXStatementQueryValues(pkg *model.PkgInputSpec, artifact *model.Artifac
 	values[knownSinceStr] = vexStatement.KnownSince.UTC()
 	values[origin] = vexStatement.Origin
 	values[collector] = vexStatement.Collector
+	values["verified"] = vexStatement.Verified
+	values["signer"] = vexStatement.Signer
 
 	return values
 }
@@ -302,7 +320,7 @@ func (c *arangoClient) IngestVEXStatements(ctx context.Context, subjects model.P
 		  
 		LET certifyVex = FIRST(
 			UPSERT { artifactID:artifact._id, vulnerabilityID:firstVuln.vuln_id, status:doc.status, vexJustification:doc.vexJustification, statement:doc.statement, statusNotes:doc.statusNotes, knownSince:doc.knownSince, collector:doc.collector, origin:doc.origin } 
-				INSERT {artifactID:artifact._id, vulnerabilityID:firstVuln.vuln_id, status:doc.status, vexJustification:doc.vexJustification, statement:doc.statement, statusNotes:doc.statusNotes, knownSince:doc.knownSince, collector:doc.collector, origin:doc.origin } 
+				INSERT {artifactID:artifact._id, vulnerabilityID:firstVuln.vuln_id, status:doc.status, vexJustification:doc.vexJustification, statement:doc.statement, statusNotes:doc.statusNotes, knownSince:doc.knownSince, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				UPDATE {} IN certifyVEXs
 				RETURN {
 					'_id': NEW._id,
@@ -385,7 +403,7 @@ func (c *arangoClient) IngestVEXStatements(ctx context.Context, subjects model.P
 		  
 		LET certifyVex = FIRST(
 			UPSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, status:doc.status, vexJustification:doc.vexJustification, statement:doc.statement, statusNotes:doc.statusNotes, knownSince:doc.knownSince, collector:doc.collector, origin:doc.origin } 
-				INSERT {packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, status:doc.status, vexJustification:doc.vexJustification, statement:doc.statement, statusNotes:doc.statusNotes, knownSince:doc.knownSince, collector:doc.collector, origin:doc.origin } 
+				INSERT {packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, status:doc.status, vexJustification:doc.vexJustification, statement:doc.statement, statusNotes:doc.statusNotes, knownSince:doc.knownSince, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				UPDATE {} IN certifyVEXs
 				RETURN {
 					'_id': NEW._id,
@@ -440,7 +458,7 @@ func (c *arangoClient) IngestVEXStatement(ctx context.Context, subject model.Pac
 		  
 		  LET certifyVex = FIRST(
 			  UPSERT { artifactID:artifact._id, vulnerabilityID:firstVuln.vuln_id, status:@status, vexJustification:@vexJustification, statement:@statement, statusNotes:@statusNotes, knownSince:@knownSince, collector:@collector, origin:@origin } 
-				  INSERT {artifactID:artifact._id, vulnerabilityID:firstVuln.vuln_id, status:@status, vexJustification:@vexJustification, statement:@statement, statusNotes:@statusNotes, knownSince:@knownSince, collector:@collector, origin:@origin } 
+				  INSERT {artifactID:artifact._id, vulnerabilityID:firstVuln.vuln_id, status:@status, vexJustification:@vexJustification, statement:@statement, statusNotes:@statusNotes, knownSince:@knownSince, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				  UPDATE {} IN certifyVEXs
 				  RETURN {
 					'_id': NEW._id,
@@ -490,7 +508,7 @@ func (c *arangoClient) IngestVEXStatement(ctx context.Context, subject model.Pac
 		  
 		LET certifyVex = FIRST(
 			UPSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, status:@status, vexJustification:@vexJustification, statement:@statement, statusNotes:@statusNotes, knownSince:@knownSince, collector:@collector, origin:@origin } 
-				INSERT {packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, status:@status, vexJustification:@vexJustification, statement:@statement, statusNotes:@statusNotes, knownSince:@knownSince, collector:@collector, origin:@origin } 
+				INSERT {packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, status:@status, vexJustification:@vexJustification, statement:@statement, statusNotes:@statusNotes, knownSince:@knownSince, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				UPDATE {} IN certifyVEXs
 				RETURN {
 					'_id': NEW._id,
@@ -535,6 +553,8 @@ func getCertifyVexFromCursor(ctx context.Context, cursor driver.Cursor, ingestio
 		StatusNotes      string          `json:"statusNotes"`
 		KnownSince       time.Time       `json:"knownSince"`
 		Collector        string          `json:"collector"`
+		Verified         *bool           `json:"verified"`
+		Signer           *string         `json:"signer"`
 		Origin           string          `json:"origin"`
 	}
 
@@ -570,6 +590,8 @@ func getCertifyVexFromCursor(ctx context.Context, cursor driver.Cursor, ingestio
 			KnownSince:       createdValue.KnownSince,
 			Origin:           createdValue.Origin,
 			Collector:        createdValue.Collector,
+			Verified:         createdValue.Verified,
+			Signer:           createdValue.Signer,
 		}
 		if pkg != nil {
 			certifyVex.Subject = pkg
@@ -654,6 +676,8 @@ func (c *arangoClient) queryCertifyVexNodeByID(ctx context.Context, filter *mode
 		StatusNotes      string    `json:"statusNotes"`
 		KnownSince       time.Time `json:"knownSince"`
 		Collector        string    `json:"collector"`
+		Verified         *bool     `json:"verified"`
+		Signer           *string   `json:"signer"`
 		Origin           string    `json:"origin"`
 	}
 
@@ -684,6 +708,8 @@ func (c *arangoClient) queryCertifyVexNodeByID(ctx context.Context, filter *mode
 		KnownSince:       collectedValues[0].KnownSince,
 		Origin:           collectedValues[0].Origin,
 		Collector:        collectedValues[0].Collector,
+		Verified:         collectedValues[0].Verified,
+		Signer:           collectedValues[0].Signer,
 	}
 
 	builtVuln, err := c.buildVulnResponseByID(ctx, collectedValues[0].VulnerabilityID, filter.Vulnerability)
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -66,6 +67,17 @@ func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.C
 	}
 }
 
+// CertifyVulnList returns a page of the CertifyVuln query results, ordered by ID.
+func (c *arangoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	p, err := listPage(ctx, "CertifyVuln", after, first, func(n *model.CertifyVuln) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyVuln, error) {
+		return c.CertifyVuln(ctx, certifyVulnSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVulnConnection(p), nil
+}
+
 func getPkgCertifyVulnForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyVuln, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -94,10 +106,12 @@ func getPkgCertifyVulnForQuery(ctx context.Context, c *arangoClient, arangoQuery
 		'scannerUri': certifyVuln.scannerUri,
 		'scannerVersion': certifyVuln.scannerVersion,
 		'collector': certifyVuln.collector,
+		'verified': certifyVuln.verified,
+		'signer': certifyVuln.signer,
 		'origin': certifyVuln.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "CertifyVuln")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "certifyVuln_id", "CertifyVuln")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for CertifyVuln: %w", err)
 	}
@@ -185,6 +199,8 @@ func getCertifyVulnQueryValues(pkg *model.PkgInputSpec, vulnerability *model.Vul
 	values[scannerVersionStr] = certifyVuln.ScannerVersion
 	values[origin] = certifyVuln.Origin
 	values[collector] = certifyVuln.Collector
+	values["verified"] = certifyVuln.Verified
+	values["signer"] = certifyVuln.Signer
 
 	return values
 }
@@ -239,7 +255,7 @@ func (c *arangoClient) IngestCertifyVulns(ctx context.Context, pkgs []*model.Pkg
 		  
 		  LET certifyVuln = FIRST(
 			  UPSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, timeScanned:doc.timeScanned, dbUri:doc.dbUri, dbVersion:doc.dbVersion, scannerUri:doc.scannerUri, scannerVersion:doc.scannerVersion, collector:doc.collector, origin:doc.origin } 
-				  INSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, timeScanned:doc.timeScanned, dbUri:doc.dbUri, dbVersion:doc.dbVersion, scannerUri:doc.scannerUri, scannerVersion:doc.scannerVersion, collector:doc.collector, origin:doc.origin } 
+				  INSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, timeScanned:doc.timeScanned, dbUri:doc.dbUri, dbVersion:doc.dbVersion, scannerUri:doc.scannerUri, scannerVersion:doc.scannerVersion, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				  UPDATE {} IN certifyVulns
 				RETURN {
 					'_id': NEW._id,
@@ -295,7 +311,7 @@ func (c *arangoClient) IngestCertifyVuln(ctx context.Context, pkg model.PkgInput
 		  
 		  LET certifyVuln = FIRST(
 			  UPSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, timeScanned:@timeScanned, dbUri:@dbUri, dbVersion:@dbVersion, scannerUri:@scannerUri, scannerVersion:@scannerVersion, collector:@collector, origin:@origin } 
-				  INSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, timeScanned:@timeScanned, dbUri:@dbUri, dbVersion:@dbVersion, scannerUri:@scannerUri, scannerVersion:@scannerVersion, collector:@collector, origin:@origin } 
+				  INSERT { packageID:firstPkg.version_id, vulnerabilityID:firstVuln.vuln_id, timeScanned:@timeScanned, dbUri:@dbUri, dbVersion:@dbVersion, scannerUri:@scannerUri, scannerVersion:@scannerVersion, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				  UPDATE {} IN certifyVulns
 				  RETURN {
 					'_id': NEW._id,
@@ -337,6 +353,8 @@ func geCertifyVulnFromCursor(ctx context.Context, cursor driver.Cursor, ingestio
 		ScannerUri     string        `json:"scannerUri"`
 		ScannerVersion string        `json:"scannerVersion"`
 		Collector      string        `json:"collector"`
+		Verified       *bool         `json:"verified"`
+		Signer         *string       `json:"signer"`
 		Origin         string        `json:"origin"`
 	}
 
@@ -367,6 +385,8 @@ func geCertifyVulnFromCursor(ctx context.Context, cursor driver.Cursor, ingestio
 				ScannerVersion: createdValue.ScannerVersion,
 				Origin:         createdValue.Origin,
 				Collector:      createdValue.Collector,
+				Verified:       createdValue.Verified,
+				Signer:         createdValue.Signer,
 			},
 		}
 		if createdValue.PkgVersion != nil {
@@ -449,6 +469,8 @@ func (c *arangoClient) queryCertifyVulnNodeByID(ctx context.Context, filter *mod
 		ScannerUri      string    `json:"scannerUri"`
 		ScannerVersion  string    `json:"scannerVersion"`
 		Collector       string    `json:"collector"`
+		Verified        *bool     `json:"verified"`
+		Signer          *string   `json:"signer"`
 		Origin          string    `json:"origin"`
 	}
 
@@ -481,6 +503,8 @@ func (c *arangoClient) queryCertifyVulnNodeByID(ctx context.Context, filter *mod
 			ScannerVersion: collectedValues[0].ScannerVersion,
 			Origin:         collectedValues[0].Origin,
 			Collector:      collectedValues[0].Collector,
+			Verified:       collectedValues[0].Verified,
+			Signer:         collectedValues[0].Signer,
 		},
 	}
 
@@ -0,0 +1,169 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arangodb/go-driver"
+)
+
+// evidenceEdges maps each evidence collection to the edge collections that
+// connect its documents to the rest of the graph.
+var evidenceEdges = map[string][]string{
+	certifyBadsStr:    {certifyBadPkgVersionEdgesStr, certifyBadPkgNameEdgesStr, certifyBadSrcEdgesStr, certifyBadArtEdgesStr},
+	certifyGoodsStr:   {certifyGoodPkgVersionEdgesStr, certifyGoodPkgNameEdgesStr, certifyGoodSrcEdgesStr, certifyGoodArtEdgesStr},
+	certifyLegalsStr:  {certifyLegalPkgEdgesStr, certifyLegalSrcEdgesStr, certifyLegalDeclaredLicensesEdgesStr, certifyLegalDiscoveredLicensesEdgesStr},
+	scorecardStr:      {scorecardSrcEdgesStr},
+	certifyVEXsStr:    {certifyVexPkgEdgesStr, certifyVexArtEdgesStr, certifyVexVulnEdgesStr},
+	certifyVulnsStr:   {certifyVulnPkgEdgesStr, certifyVulnEdgesStr},
+	hasMetadataStr:    {hasMetadataPkgVersionEdgesStr, hasMetadataPkgNameEdgesStr, hasMetadataSrcEdgesStr, hasMetadataArtEdgesStr},
+	hasSBOMsStr:       {hasSBOMPkgEdgesStr, hasSBOMArtEdgesStr},
+	hasSLSAsStr:       {hasSLSASubjectArtEdgesStr, hasSLSABuiltByEdgesStr, hasSLSABuiltFromEdgesStr},
+	hasSourceAtsStr:   {hasSourceAtPkgVersionEdgesStr, hasSourceAtPkgNameEdgesStr, hasSourceAtEdgesStr},
+	hashEqualsStr:     {hashEqualArtEdgesStr, hashEqualSubjectArtEdgesStr},
+	isDependenciesStr: {isDependencyDepPkgVersionEdgesStr, isDependencyDepPkgNameEdgesStr, isDependencySubjectPkgEdgesStr},
+	isOccurrencesStr:  {isOccurrenceArtEdgesStr, isOccurrenceSubjectPkgEdgesStr, isOccurrenceSubjectSrcEdgesStr},
+	pkgEqualsStr:      {pkgEqualPkgEdgesStr, pkgEqualSubjectPkgEdgesStr},
+	pointOfContactStr: {pointOfContactPkgVersionEdgesStr, pointOfContactPkgNameEdgesStr, pointOfContactSrcEdgesStr, pointOfContactArtEdgesStr},
+	vulnEqualsStr:     {vulnEqualVulnEdgesStr, vulnEqualSubjectVulnEdgesStr},
+	vulnMetadataStr:   {vulnMetadataEdgesStr},
+}
+
+func (c *arangoClient) DeleteCertifyBad(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, certifyBadsStr, id)
+}
+
+func (c *arangoClient) DeleteCertifyGood(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, certifyGoodsStr, id)
+}
+
+func (c *arangoClient) DeleteCertifyLegal(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, certifyLegalsStr, id)
+}
+
+func (c *arangoClient) DeleteCertifyScorecard(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, scorecardStr, id)
+}
+
+func (c *arangoClient) DeleteCertifyVEXStatement(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, certifyVEXsStr, id)
+}
+
+func (c *arangoClient) DeleteCertifyVuln(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, certifyVulnsStr, id)
+}
+
+func (c *arangoClient) DeleteHasMetadata(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, hasMetadataStr, id)
+}
+
+func (c *arangoClient) DeleteHasSbom(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, hasSBOMsStr, id)
+}
+
+func (c *arangoClient) DeleteHasSlsa(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, hasSLSAsStr, id)
+}
+
+func (c *arangoClient) DeleteHasSourceAt(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, hasSourceAtsStr, id)
+}
+
+func (c *arangoClient) DeleteHashEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, hashEqualsStr, id)
+}
+
+func (c *arangoClient) DeleteIsDependency(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, isDependenciesStr, id)
+}
+
+func (c *arangoClient) DeleteIsOccurrence(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, isOccurrencesStr, id)
+}
+
+func (c *arangoClient) DeletePkgEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, pkgEqualsStr, id)
+}
+
+func (c *arangoClient) DeletePointOfContact(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, pointOfContactStr, id)
+}
+
+func (c *arangoClient) DeleteVulnEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, vulnEqualsStr, id)
+}
+
+func (c *arangoClient) DeleteVulnerabilityMetadata(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, vulnMetadataStr, id)
+}
+
+func (c *arangoClient) RetractEvidence(ctx context.Context, originValue string) (int, error) {
+	total := 0
+	for coll := range evidenceEdges {
+		selectIDs := fmt.Sprintf("(FOR doc IN %s FILTER doc.%s == @%s RETURN doc._id)", coll, origin, origin)
+		removed, err := c.removeEvidence(ctx, coll, selectIDs, map[string]any{origin: originValue}, "RetractEvidence")
+		if err != nil {
+			return total, err
+		}
+		total += removed
+	}
+	return total, nil
+}
+
+func (c *arangoClient) deleteEvidence(ctx context.Context, coll string, id string) (bool, error) {
+	if idColl, _, _ := strings.Cut(id, "/"); idColl != coll {
+		return false, fmt.Errorf("id %q is not in the %s collection", id, coll)
+	}
+	removed, err := c.removeEvidence(ctx, coll, "[@id]", map[string]any{"id": id}, "deleteEvidence")
+	if err != nil {
+		return false, err
+	}
+	return removed > 0, nil
+}
+
+// removeEvidence removes the documents of coll whose _id is returned by the
+// selectIDs expression, together with all the edges pointing to or from
+// them. It returns the number of documents removed.
+func (c *arangoClient) removeEvidence(ctx context.Context, coll string, selectIDs string, values map[string]any, executedFrom string) (int, error) {
+	var query strings.Builder
+	fmt.Fprintf(&query, "LET ids = %s\n", selectIDs)
+	for i, edgeColl := range evidenceEdges[coll] {
+		fmt.Fprintf(&query, "LET removedEdges%d = (FOR edge IN %s FILTER edge._from IN ids OR edge._to IN ids REMOVE edge IN %s)\n", i, edgeColl, edgeColl)
+	}
+	fmt.Fprintf(&query, "FOR doc IN %s FILTER doc._id IN ids REMOVE doc IN %s RETURN OLD._id", coll, coll)
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, query.String(), values, executedFrom)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove %s: %w", coll, err)
+	}
+	defer cursor.Close()
+
+	removed := 0
+	for {
+		var id string
+		if _, err := cursor.ReadDocument(ctx, &id); err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			return removed, fmt.Errorf("failed to read removed %s from cursor: %w", coll, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -162,6 +163,17 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 	}
 }
 
+// HasMetadataList returns a page of the HasMetadata query results, ordered by ID.
+func (c *arangoClient) HasMetadataList(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
+	p, err := listPage(ctx, "HasMetadata", after, first, func(n *model.HasMetadata) string { return n.ID }, func(ctx context.Context) ([]*model.HasMetadata, error) {
+		return c.HasMetadata(ctx, hasMetadataSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasMetadataConnection(p), nil
+}
+
 func getSrcHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.HasMetadata, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -181,10 +193,12 @@ func getSrcHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQuery
 		'timestamp': hasMetadata.timestamp,
 		'justification': hasMetadata.justification,
 		'collector': hasMetadata.collector,
+		'verified': hasMetadata.verified,
+		'signer': hasMetadata.signer,
 		'origin': hasMetadata.origin  
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasMetadata")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hasMetadata_id", "HasMetadata")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HasMetadata: %w", err)
 	}
@@ -207,10 +221,12 @@ func getArtHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQuery
 		'timestamp': hasMetadata.timestamp,
 		'justification': hasMetadata.justification,
 		'collector': hasMetadata.collector,
+		'verified': hasMetadata.verified,
+		'signer': hasMetadata.signer,
 		'origin': hasMetadata.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasMetadata")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hasMetadata_id", "HasMetadata")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HasMetadata: %w", err)
 	}
@@ -241,6 +257,8 @@ func getPkgHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQuery
 			'timestamp': hasMetadata.timestamp,
 			'justification': hasMetadata.justification,
 			'collector': hasMetadata.collector,
+			'verified': hasMetadata.verified,
+			'signer': hasMetadata.signer,
 			'origin': hasMetadata.origin
 		  }`)
 	} else {
@@ -260,11 +278,13 @@ func getPkgHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQuery
 			'timestamp': hasMetadata.timestamp,
 			'justification': hasMetadata.justification,
 			'collector': hasMetadata.collector,
+			'verified': hasMetadata.verified,
+			'signer': hasMetadata.signer,
 			'origin': hasMetadata.origin
 		  }`)
 	}
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasMetadata")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hasMetadata_id", "HasMetadata")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HasMetadata: %w", err)
 	}
@@ -328,6 +348,8 @@ func getHasMetadataQueryValues(pkg *model.PkgInputSpec, pkgMatchType *model.Matc
 	values[justification] = hasMetadata.Justification
 	values[origin] = hasMetadata.Origin
 	values[collector] = hasMetadata.Collector
+	values["verified"] = hasMetadata.Verified
+	values["signer"] = hasMetadata.Signer
 
 	return values
 }
@@ -349,7 +371,7 @@ func (c *arangoClient) IngestHasMetadata(ctx context.Context, subject model.Pack
 		  
 		  LET hasMetadata = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
-				  INSERT {  packageID:firstPkg.version_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
+				  INSERT {  packageID:firstPkg.version_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				  UPDATE {} IN hasMetadataCollection
 				  RETURN {
 					'_id': NEW._id,
@@ -382,7 +404,7 @@ func (c *arangoClient) IngestHasMetadata(ctx context.Context, subject model.Pack
 			  
 			  LET hasMetadata = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
-					  INSERT {  packageID:firstPkg.name_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
+					  INSERT {  packageID:firstPkg.name_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 					  UPDATE {} IN hasMetadataCollection
 					  RETURN {
 						'_id': NEW._id,
@@ -407,7 +429,7 @@ func (c *arangoClient) IngestHasMetadata(ctx context.Context, subject model.Pack
 		  
 		LET hasMetadata = FIRST(
 			UPSERT { artifactID:artifact._id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
-				INSERT { artifactID:artifact._id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
+				INSERT { artifactID:artifact._id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				UPDATE {} IN hasMetadataCollection
 				RETURN {
 					'_id': NEW._id,
@@ -439,7 +461,7 @@ func (c *arangoClient) IngestHasMetadata(ctx context.Context, subject model.Pack
 		  
 		LET hasMetadata = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
-				INSERT { sourceID:firstSrc.name_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, origin:@origin } 
+				INSERT { sourceID:firstSrc.name_id, key:@key, value:@value, timestamp:@timestamp, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				UPDATE {} IN hasMetadataCollection
 				RETURN {
 					'_id': NEW._id,
@@ -519,7 +541,7 @@ func (c *arangoClient) IngestBulkHasMetadata(ctx context.Context, subjects model
 			  
 			  LET hasMetadata = FIRST(
 				  UPSERT {  packageID:firstPkg.version_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-					  INSERT {  packageID:firstPkg.version_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+					  INSERT {  packageID:firstPkg.version_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 					  UPDATE {} IN hasMetadataCollection
 					  RETURN {
 						'_id': NEW._id,
@@ -554,7 +576,7 @@ func (c *arangoClient) IngestBulkHasMetadata(ctx context.Context, subjects model
 			  
 			  LET hasMetadata = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-					  INSERT {  packageID:firstPkg.name_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+					  INSERT {  packageID:firstPkg.name_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 					  UPDATE {} IN hasMetadataCollection
 					  RETURN {
 						'_id': NEW._id,
@@ -609,7 +631,7 @@ func (c *arangoClient) IngestBulkHasMetadata(ctx context.Context, subjects model
 		  
 		LET hasMetadata = FIRST(
 			UPSERT { artifactID:artifact._id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				INSERT { artifactID:artifact._id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				INSERT { artifactID:artifact._id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				UPDATE {} IN hasMetadataCollection
 				RETURN {
 					'_id': NEW._id,
@@ -671,7 +693,7 @@ func (c *arangoClient) IngestBulkHasMetadata(ctx context.Context, subjects model
 		  
 		LET hasMetadata = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				INSERT { sourceID:firstSrc.name_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				INSERT { sourceID:firstSrc.name_id, key:doc.key, value:doc.value, timestamp:doc.timestamp, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				UPDATE {} IN hasMetadataCollection
 				RETURN {
 					'_id': NEW._id,
@@ -720,6 +742,8 @@ func getHasMetadataFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 		Timestamp     time.Time       `json:"timestamp"`
 		Justification string          `json:"justification"`
 		Collector     string          `json:"collector"`
+		Verified      *bool           `json:"verified"`
+		Signer        *string         `json:"signer"`
 		Origin        string          `json:"origin"`
 	}
 
@@ -758,6 +782,8 @@ func getHasMetadataFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 			Justification: createdValue.Justification,
 			Origin:        createdValue.Origin,
 			Collector:     createdValue.Collector,
+			Verified:      createdValue.Verified,
+			Signer:        createdValue.Signer,
 		}
 
 		if pkg != nil {
@@ -825,6 +851,8 @@ func (c *arangoClient) queryHasMetadataNodeByID(ctx context.Context, filter *mod
 		Timestamp     time.Time `json:"timestamp"`
 		Justification string    `json:"justification"`
 		Collector     string    `json:"collector"`
+		Verified      *bool     `json:"verified"`
+		Signer        *string   `json:"signer"`
 		Origin        string    `json:"origin"`
 	}
 
@@ -855,6 +883,8 @@ func (c *arangoClient) queryHasMetadataNodeByID(ctx context.Context, filter *mod
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}
 
 	if collectedValues[0].PackageID != nil {
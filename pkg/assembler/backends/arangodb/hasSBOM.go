@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -99,6 +100,17 @@ func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSp
 	}
 }
 
+// HasSBOMList returns a page of the HasSBOM query results, ordered by ID.
+func (c *arangoClient) HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	p, err := listPage(ctx, "HasSBOM", after, first, func(n *model.HasSbom) string { return n.ID }, func(ctx context.Context) ([]*model.HasSbom, error) {
+		return c.HasSBOM(ctx, hasSBOMSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSBOMConnection(p), nil
+}
+
 func getPkgHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.HasSbom, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -120,11 +132,13 @@ func getPkgHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQueryBuil
 		'digest': hasSBOM.digest,
 		'downloadLocation': hasSBOM.downloadLocation,
 		'collector': hasSBOM.collector,
+		'verified': hasSBOM.verified,
+		'signer': hasSBOM.signer,
 		'knownSince': hasSBOM.knownSince,
 		'origin': hasSBOM.origin  
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasSBOM")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hasSBOM_id", "HasSBOM")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HasSBOM: %w", err)
 	}
@@ -147,11 +161,13 @@ func getArtifactHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQuer
 		'digest': hasSBOM.digest,
 		'downloadLocation': hasSBOM.downloadLocation,
 		'collector': hasSBOM.collector,
+		'verified': hasSBOM.verified,
+		'signer': hasSBOM.signer,
 		'knownSince': hasSBOM.knownSince,
 		'origin': hasSBOM.origin  
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasSBOM")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hasSBOM_id", "HasSBOM")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HasSBOM: %w", err)
 	}
@@ -213,6 +229,8 @@ func getHasSBOMQueryValues(pkg *model.PkgInputSpec, artifact *model.ArtifactInpu
 	values["downloadLocation"] = hasSbom.DownloadLocation
 	values["origin"] = hasSbom.Origin
 	values["collector"] = hasSbom.Collector
+	values["verified"] = hasSbom.Verified
+	values["signer"] = hasSbom.Signer
 	values[knownSince] = hasSbom.KnownSince.UTC()
 
 	return values
@@ -263,7 +281,7 @@ func (c *arangoClient) IngestHasSBOMs(ctx context.Context, subjects model.Packag
 		  
 		  LET hasSBOM = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, uri:doc.uri, algorithm:doc.algorithm, digest:doc.digest, downloadLocation:doc.downloadLocation, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				  INSERT {  packageID:firstPkg.version_id, uri:doc.uri, algorithm:doc.algorithm, digest:doc.digest, downloadLocation:doc.downloadLocation, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				  INSERT {  packageID:firstPkg.version_id, uri:doc.uri, algorithm:doc.algorithm, digest:doc.digest, downloadLocation:doc.downloadLocation, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				  UPDATE {} IN hasSBOMs
 				  RETURN {
 					'_id': NEW._id,
@@ -318,7 +336,7 @@ func (c *arangoClient) IngestHasSBOMs(ctx context.Context, subjects model.Packag
 		  
 		LET hasSBOM = FIRST(
 			UPSERT { artifactID:artifact._id, uri:doc.uri, algorithm:doc.algorithm, digest:doc.digest, downloadLocation:doc.downloadLocation, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
-				INSERT { artifactID:artifact._id, uri:doc.uri, algorithm:doc.algorithm, digest:doc.digest, downloadLocation:doc.downloadLocation, collector:doc.collector, origin:doc.origin, knownSince:doc.knownSince } 
+				INSERT { artifactID:artifact._id, uri:doc.uri, algorithm:doc.algorithm, digest:doc.digest, downloadLocation:doc.downloadLocation, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin, knownSince:doc.knownSince } 
 				UPDATE {} IN hasSBOMs
 				RETURN {
 					'_id': NEW._id,
@@ -365,7 +383,7 @@ func (c *arangoClient) IngestHasSbom(ctx context.Context, subject model.PackageO
 		  
 		  LET hasSBOM = FIRST(
 			  UPSERT { artifactID:artifact._id, uri:@uri, algorithm:@algorithm, digest:@digest, downloadLocation:@downloadLocation, collector:@collector, origin:@origin, knownSince:@knownSince } 
-				  INSERT { artifactID:artifact._id, uri:@uri, algorithm:@algorithm, digest:@digest, downloadLocation:@downloadLocation, collector:@collector, origin:@origin, knownSince:@knownSince } 
+				  INSERT { artifactID:artifact._id, uri:@uri, algorithm:@algorithm, digest:@digest, downloadLocation:@downloadLocation, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince } 
 				  UPDATE {} IN hasSBOMs
 				  RETURN {
 					'_id': NEW._id,
@@ -407,7 +425,7 @@ func (c *arangoClient) IngestHasSbom(ctx context.Context, subject model.PackageO
 		  
 		LET hasSBOM = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, uri:@uri, algorithm:@algorithm, digest:@digest, downloadLocation:@downloadLocation, collector:@collector, origin:@origin, knownSince:@knownSince } 
-				  INSERT {  packageID:firstPkg.version_id, uri:@uri, algorithm:@algorithm, digest:@digest, downloadLocation:@downloadLocation, collector:@collector, origin:@origin, knownSince:@knownSince } 
+				  INSERT {  packageID:firstPkg.version_id, uri:@uri, algorithm:@algorithm, digest:@digest, downloadLocation:@downloadLocation, collector:@collector, verified:@verified, signer:@signer, origin:@origin, knownSince:@knownSince } 
 				  UPDATE {} IN hasSBOMs
 				  RETURN {
 					'_id': NEW._id,
@@ -450,6 +468,8 @@ func getHasSBOMFromCursor(ctx context.Context, cursor driver.Cursor, ingestion b
 		Digest           string          `json:"digest"`
 		DownloadLocation string          `json:"downloadLocation"`
 		Collector        string          `json:"collector"`
+		Verified         *bool           `json:"verified"`
+		Signer           *string         `json:"signer"`
 		Origin           string          `json:"origin"`
 		KnownSince       time.Time       `json:"knownSince"`
 	}
@@ -485,6 +505,8 @@ func getHasSBOMFromCursor(ctx context.Context, cursor driver.Cursor, ingestion b
 			DownloadLocation: createdValue.DownloadLocation,
 			Origin:           createdValue.Collector,
 			Collector:        createdValue.Origin,
+			Verified:         createdValue.Verified,
+			Signer:           createdValue.Signer,
 			KnownSince:       createdValue.KnownSince,
 		}
 		if pkg != nil {
@@ -549,6 +571,8 @@ func (c *arangoClient) queryHasSbomNodeByID(ctx context.Context, filter *model.H
 		Digest           string  `json:"digest"`
 		DownloadLocation string  `json:"downloadLocation"`
 		Collector        string  `json:"collector"`
+		Verified         *bool   `json:"verified"`
+		Signer           *string `json:"signer"`
 		Origin           string  `json:"origin"`
 	}
 
@@ -579,6 +603,8 @@ func (c *arangoClient) queryHasSbomNodeByID(ctx context.Context, filter *model.H
 		DownloadLocation: collectedValues[0].DownloadLocation,
 		Origin:           collectedValues[0].Origin,
 		Collector:        collectedValues[0].Collector,
+		Verified:         collectedValues[0].Verified,
+		Signer:           collectedValues[0].Signer,
 	}
 
 	if collectedValues[0].PackageID != nil {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -81,10 +82,12 @@ func (c *arangoClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASp
 		'startedOn': hasSLSA.startedOn,
 		'finishedOn': hasSLSA.finishedOn,
 		'collector': hasSLSA.collector,
+		'verified': hasSLSA.verified,
+		'signer': hasSLSA.signer,
 		'origin': hasSLSA.origin
 	}`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasSlsa")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hasSLSA_id", "HasSlsa")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HasSlsa: %w", err)
 	}
@@ -93,6 +96,17 @@ func (c *arangoClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASp
 	return getHasSLSAFromCursor(c, ctx, cursor, map[string][]*model.Artifact{}, hasSLSASpec.BuiltFrom, false)
 }
 
+// HasSLSAList returns a page of the HasSLSA query results, ordered by ID.
+func (c *arangoClient) HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	p, err := listPage(ctx, "HasSlsa", after, first, func(n *model.HasSlsa) string { return n.ID }, func(ctx context.Context) ([]*model.HasSlsa, error) {
+		return c.HasSlsa(ctx, hasSLSASpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSLSAConnection(p), nil
+}
+
 func setHasSLSAMatchValues(arangoQueryBuilder *arangoQueryBuilder, hasSLSASpec *model.HasSLSASpec, queryValues map[string]any) {
 	if hasSLSASpec.ID != nil {
 		arangoQueryBuilder.filter("hasSLSA", "_id", "==", "@id")
@@ -191,6 +205,8 @@ func getSLSAValues(subject model.ArtifactInputSpec, builtFrom []*model.Artifact,
 	}
 	values[origin] = slsa.Origin
 	values[collector] = slsa.Collector
+	values["verified"] = slsa.Verified
+	values["signer"] = slsa.Signer
 
 	return values
 }
@@ -236,7 +252,7 @@ func (c *arangoClient) IngestSLSAs(ctx context.Context, subjects []*model.Artifa
 	LET builtBy = FIRST(FOR builder IN builders FILTER builder.uri == doc.uri RETURN builder)
 	LET hasSLSA = FIRST(
 		UPSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:doc.builtFrom, buildType:doc.buildType, slsaPredicate:doc.slsaPredicate, slsaVersion:doc.slsaVersion, startedOn:doc.startedOn, finishedOn:doc.finishedOn, collector:doc.collector, origin:doc.origin } 
-		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:doc.builtFrom, buildType:doc.buildType, slsaPredicate:doc.slsaPredicate, slsaVersion:doc.slsaVersion, startedOn:doc.startedOn, finishedOn:doc.finishedOn, collector:doc.collector, origin:doc.origin } 
+		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:doc.builtFrom, buildType:doc.buildType, slsaPredicate:doc.slsaPredicate, slsaVersion:doc.slsaVersion, startedOn:doc.startedOn, finishedOn:doc.finishedOn, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 		UPDATE {} IN hasSLSAs
 		RETURN {
 			'_id': NEW._id,
@@ -285,7 +301,7 @@ func (c *arangoClient) IngestSLSA(ctx context.Context, subject model.ArtifactInp
 	LET builtBy = FIRST(FOR builder IN builders FILTER builder.uri == @uri RETURN builder)
 	LET hasSLSA = FIRST(
 		UPSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:@builtFrom, buildType:@buildType, slsaPredicate:@slsaPredicate, slsaVersion:@slsaVersion, startedOn:@startedOn, finishedOn:@finishedOn, collector:@collector, origin:@origin } 
-		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:@builtFrom, buildType:@buildType, slsaPredicate:@slsaPredicate, slsaVersion:@slsaVersion, startedOn:@startedOn, finishedOn:@finishedOn, collector:@collector, origin:@origin } 
+		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:@builtFrom, buildType:@buildType, slsaPredicate:@slsaPredicate, slsaVersion:@slsaVersion, startedOn:@startedOn, finishedOn:@finishedOn, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 		UPDATE {} IN hasSLSAs
 		RETURN {
 			'_id': NEW._id,
@@ -338,6 +354,8 @@ func getHasSLSAFromCursor(c *arangoClient, ctx context.Context, cursor driver.Cu
 		StartedOn     *time.Time      `json:"startedOn"`
 		FinishedOn    *time.Time      `json:"finishedOn"`
 		Collector     string          `json:"collector"`
+		Verified      *bool           `json:"verified"`
+		Signer        *string         `json:"signer"`
 		Origin        string          `json:"origin"`
 	}
 
@@ -394,6 +412,8 @@ func getHasSLSAFromCursor(c *arangoClient, ctx context.Context, cursor driver.Cu
 					SlsaVersion:   createdValue.SlsaVersion,
 					Origin:        createdValue.Origin,
 					Collector:     createdValue.Collector,
+					Verified:      createdValue.Verified,
+					Signer:        createdValue.Signer,
 				}
 
 				if !createdValue.StartedOn.Equal(time.Unix(0, 0).UTC()) {
@@ -524,6 +544,8 @@ func (c *arangoClient) queryHasSlsaNodeByID(ctx context.Context, filter *model.H
 		StartedOn     *time.Time `json:"startedOn"`
 		FinishedOn    *time.Time `json:"finishedOn"`
 		Collector     string     `json:"collector"`
+		Verified      *bool      `json:"verified"`
+		Signer        *string    `json:"signer"`
 		Origin        string     `json:"origin"`
 	}
 
@@ -552,6 +574,8 @@ func (c *arangoClient) queryHasSlsaNodeByID(ctx context.Context, filter *model.H
 		SlsaVersion:   collectedValues[0].SlsaVersion,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}
 
 	if !collectedValues[0].StartedOn.Equal(time.Unix(0, 0).UTC()) {
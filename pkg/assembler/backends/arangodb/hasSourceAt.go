@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -105,6 +106,17 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 	}
 }
 
+// HasSourceAtList returns a page of the HasSourceAt query results, ordered by ID.
+func (c *arangoClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	p, err := listPage(ctx, "HasSourceAt", after, first, func(n *model.HasSourceAt) string { return n.ID }, func(ctx context.Context) ([]*model.HasSourceAt, error) {
+		return c.HasSourceAt(ctx, hasSourceAtSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSourceAtConnection(p), nil
+}
+
 func getPkgHasSourceAtForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, includeDepPkgVersion bool) ([]*model.HasSourceAt, error) {
 	if includeDepPkgVersion {
 		arangoQueryBuilder.query.WriteString("\n")
@@ -135,6 +147,8 @@ func getPkgHasSourceAtForQuery(ctx context.Context, c *arangoClient, arangoQuery
 			'knownSince': hasSourceAt.knownSince,
 			'justification': hasSourceAt.justification,
 			'collector': hasSourceAt.collector,
+			'verified': hasSourceAt.verified,
+			'signer': hasSourceAt.signer,
 			'origin': hasSourceAt.origin
 		  }`)
 	} else {
@@ -162,11 +176,13 @@ func getPkgHasSourceAtForQuery(ctx context.Context, c *arangoClient, arangoQuery
 			'knownSince': hasSourceAt.knownSince,
 			'justification': hasSourceAt.justification,
 			'collector': hasSourceAt.collector,
+			'verified': hasSourceAt.verified,
+			'signer': hasSourceAt.signer,
 			'origin': hasSourceAt.origin
 		  }`)
 	}
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasSourceAt")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hasSourceAt_id", "HasSourceAt")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HasSourceAt: %w", err)
 	}
@@ -252,6 +268,8 @@ func getHasSourceAtQueryValues(pkg *model.PkgInputSpec, pkgMatchType *model.Matc
 	values[justification] = hasSourceAt.Justification
 	values[origin] = hasSourceAt.Origin
 	values[collector] = hasSourceAt.Collector
+	values["verified"] = hasSourceAt.Verified
+	values["signer"] = hasSourceAt.Signer
 
 	return values
 }
@@ -281,7 +299,7 @@ func (c *arangoClient) IngestHasSourceAt(ctx context.Context, pkg model.PkgInput
 		  
 		  LET hasSourceAt = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, sourceID:firstSrc.name_id, knownSince:@knownSince, justification:@justification, collector:@collector, origin:@origin } 
-				  INSERT {  packageID:firstPkg.version_id, sourceID:firstSrc.name_id, knownSince:@knownSince, justification:@justification, collector:@collector, origin:@origin } 
+				  INSERT {  packageID:firstPkg.version_id, sourceID:firstSrc.name_id, knownSince:@knownSince, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				  UPDATE {} IN hasSourceAts
 				  RETURN {
 					'_id': NEW._id,
@@ -321,7 +339,7 @@ func (c *arangoClient) IngestHasSourceAt(ctx context.Context, pkg model.PkgInput
 			  
 			  LET hasSourceAt = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, sourceID:firstSrc.name_id, knownSince:@knownSince, justification:@justification, collector:@collector, origin:@origin } 
-					  INSERT {  packageID:firstPkg.name_id, sourceID:firstSrc.name_id, knownSince:@knownSince, justification:@justification, collector:@collector, origin:@origin } 
+					  INSERT {  packageID:firstPkg.name_id, sourceID:firstSrc.name_id, knownSince:@knownSince, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 					  UPDATE {} IN hasSourceAts
 					  RETURN {
 						'_id': NEW._id,
@@ -405,7 +423,7 @@ func (c *arangoClient) IngestHasSourceAts(ctx context.Context, pkgs []*model.Pkg
 		  
 		  LET hasSourceAt = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, sourceID:firstSrc.name_id, knownSince:doc.knownSince, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				  INSERT {  packageID:firstPkg.version_id, sourceID:firstSrc.name_id, knownSince:doc.knownSince, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				  INSERT {  packageID:firstPkg.version_id, sourceID:firstSrc.name_id, knownSince:doc.knownSince, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				  UPDATE {} IN hasSourceAts
 				  RETURN {
 					'_id': NEW._id,
@@ -448,7 +466,7 @@ func (c *arangoClient) IngestHasSourceAts(ctx context.Context, pkgs []*model.Pkg
 		  
 		  LET hasSourceAt = FIRST(
 			  UPSERT {  packageID:firstPkg.name_id, sourceID:firstSrc.name_id, knownSince:doc.knownSince, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				  INSERT {  packageID:firstPkg.name_id, sourceID:firstSrc.name_id, knownSince:doc.knownSince, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				  INSERT {  packageID:firstPkg.name_id, sourceID:firstSrc.name_id, knownSince:doc.knownSince, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				  UPDATE {} IN hasSourceAts
 				  RETURN {
 					'_id': NEW._id,
@@ -490,6 +508,8 @@ func getHasSourceAtFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 		KnownSince    time.Time     `json:"knownSince"`
 		Justification string        `json:"justification"`
 		Collector     string        `json:"collector"`
+		Verified      *bool         `json:"verified"`
+		Signer        *string       `json:"signer"`
 		Origin        string        `json:"origin"`
 	}
 
@@ -525,6 +545,8 @@ func getHasSourceAtFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 				Justification: createdValue.Justification,
 				Origin:        createdValue.Origin,
 				Collector:     createdValue.Collector,
+				Verified:      createdValue.Verified,
+				Signer:        createdValue.Signer,
 			}
 		} else {
 			hasSourceAt = &model.HasSourceAt{ID: createdValue.HasSourceAtID}
@@ -580,6 +602,8 @@ func (c *arangoClient) queryHasSourceAtNodeByID(ctx context.Context, filter *mod
 		KnownSince    time.Time `json:"knownSince"`
 		Justification string    `json:"justification"`
 		Collector     string    `json:"collector"`
+		Verified      *bool     `json:"verified"`
+		Signer        *string   `json:"signer"`
 		Origin        string    `json:"origin"`
 	}
 
@@ -619,6 +643,9 @@ func (c *arangoClient) queryHasSourceAtNodeByID(ctx context.Context, filter *mod
 		KnownSince:    collectedValues[0].KnownSince,
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Origin,
+		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}, nil
 }
 
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -53,6 +54,17 @@ func (c *arangoClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashE
 	}
 }
 
+// HashEqualList returns a page of the HashEqual query results, ordered by ID.
+func (c *arangoClient) HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	p, err := listPage(ctx, "HashEqual", after, first, func(n *model.HashEqual) string { return n.ID }, func(ctx context.Context) ([]*model.HashEqual, error) {
+		return c.HashEqual(ctx, hashEqualSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HashEqualConnection(p), nil
+}
+
 func matchHashEqualByInput(ctx context.Context, c *arangoClient, hashEqualSpec *model.HashEqualSpec, firstArtifact *model.ArtifactSpec,
 	secondArtifact *model.ArtifactSpec, values map[string]any) ([]*model.HashEqual, error) {
 
@@ -127,10 +139,12 @@ func getHashEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuild
 				'hashEqual_id': hashEqual._id,
 				'justification': hashEqual.justification,
 				'collector': hashEqual.collector,
+				'verified': hashEqual.verified,
+				'signer': hashEqual.signer,
 				'origin': hashEqual.origin
 			}`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HashEqual")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "hashEqual_id", "HashEqual")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for HashEqual: %w", err)
 	}
@@ -172,6 +186,8 @@ func getHashEqualQueryValues(artifact *model.ArtifactInputSpec, equalArtifact *m
 	values["equal_digest"] = strings.ToLower(artifacts[1].Digest)
 	values["justification"] = strings.ToLower(hashEqual.Justification)
 	values["collector"] = strings.ToLower(hashEqual.Collector)
+	values["verified"] = hashEqual.Verified
+	values["signer"] = hashEqual.Signer
 	values["origin"] = strings.ToLower(hashEqual.Origin)
 
 	return values
@@ -211,7 +227,7 @@ func (c *arangoClient) IngestHashEquals(ctx context.Context, artifacts []*model.
 	LET equalArtifact = FIRST(FOR art IN artifacts FILTER art.algorithm == doc.equal_algorithm FILTER art.digest == doc.equal_digest RETURN art)
 	LET hashEqual = FIRST(
 		UPSERT { artifactID:artifact._id, equalArtifactID:equalArtifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-			INSERT { artifactID:artifact._id, equalArtifactID:equalArtifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+			INSERT { artifactID:artifact._id, equalArtifactID:equalArtifact._id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 			UPDATE {} IN hashEquals
 			RETURN {
 				'_id': NEW._id,
@@ -251,7 +267,7 @@ LET artifact = FIRST(FOR art IN artifacts FILTER art.algorithm == @art_algorithm
 LET equalArtifact = FIRST(FOR art IN artifacts FILTER art.algorithm == @equal_algorithm FILTER art.digest == @equal_digest RETURN art)
 LET hashEqual = FIRST(
 	UPSERT { artifactID:artifact._id, equalArtifactID:equalArtifact._id, justification:@justification, collector:@collector, origin:@origin } 
-		INSERT { artifactID:artifact._id, equalArtifactID:equalArtifact._id, justification:@justification, collector:@collector, origin:@origin } 
+		INSERT { artifactID:artifact._id, equalArtifactID:equalArtifact._id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 		UPDATE {} IN hashEquals
 		RETURN {
 			'_id': NEW._id,
@@ -289,6 +305,8 @@ func getHashEqualFromCursor(ctx context.Context, cursor driver.Cursor) ([]*model
 		HashEqualId   string          `json:"hashEqual_id"`
 		Justification string          `json:"justification"`
 		Collector     string          `json:"collector"`
+		Verified      *bool           `json:"verified"`
+		Signer        *string         `json:"signer"`
 		Origin        string          `json:"origin"`
 	}
 
@@ -315,6 +333,8 @@ func getHashEqualFromCursor(ctx context.Context, cursor driver.Cursor) ([]*model
 			Justification: createdValue.Justification,
 			Origin:        createdValue.Origin,
 			Collector:     createdValue.Collector,
+			Verified:      createdValue.Verified,
+			Signer:        createdValue.Signer,
 		}
 		hashEqualList = append(hashEqualList, hashEqual)
 	}
@@ -361,12 +381,14 @@ func (c *arangoClient) queryHashEqualNodeByID(ctx context.Context, filter *model
 	defer cursor.Close()
 
 	type dbHashEqual struct {
-		HashEqualID     string `json:"_id"`
-		ArtifactID      string `json:"artifactID"`
-		EqualArtifactID string `json:"equalArtifactID"`
-		Justification   string `json:"justification"`
-		Collector       string `json:"collector"`
-		Origin          string `json:"origin"`
+		HashEqualID     string  `json:"_id"`
+		ArtifactID      string  `json:"artifactID"`
+		EqualArtifactID string  `json:"equalArtifactID"`
+		Justification   string  `json:"justification"`
+		Collector       string  `json:"collector"`
+		Verified        *bool   `json:"verified"`
+		Signer          *string `json:"signer"`
+		Origin          string  `json:"origin"`
 	}
 
 	var collectedValues []dbHashEqual
@@ -393,6 +415,8 @@ func (c *arangoClient) queryHashEqualNodeByID(ctx context.Context, filter *model
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}
 
 	builtArtifact, err := c.buildArtifactResponseByID(ctx, collectedValues[0].ArtifactID, nil)
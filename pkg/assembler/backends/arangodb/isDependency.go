@@ -22,12 +22,15 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 const (
-	versionRangeStr   string = "versionRange"
-	dependencyTypeStr string = "dependencyType"
+	versionRangeStr            string = "versionRange"
+	dependencyTypeStr          string = "dependencyType"
+	dependencyScopeStr         string = "dependencyScope"
+	excludeDependencyScopesStr string = "excludeDependencyScopes"
 )
 
 var dependencyTypeToEnum = map[string]model.DependencyType{
@@ -37,6 +40,25 @@ var dependencyTypeToEnum = map[string]model.DependencyType{
 	"":                                    "",
 }
 
+// dependencyScopeFromDB returns the scope stored on the isDependency, which
+// is not set on the ones ingested before the scopes were recorded.
+func dependencyScopeFromDB(scope string) (model.DependencyScope, error) {
+	if scope == "" {
+		return model.DependencyScopeUnknown, nil
+	}
+	if depScope := model.DependencyScope(scope); depScope.IsValid() {
+		return depScope, nil
+	}
+	return "", fmt.Errorf("DependencyScope %s failed to match", scope)
+}
+
+// dependencyScopeAQL returns the AQL expression of the scope of the
+// isDependency document named counterName, which is UNKNOWN when it is not
+// set, so that filters on the scope match the ones without it.
+func dependencyScopeAQL(counterName string) string {
+	return fmt.Sprintf("(%s.%s || %q)", counterName, dependencyScopeStr, model.DependencyScopeUnknown)
+}
+
 func checkPkgNameDependency(isDependencySpec *model.IsDependencySpec) bool {
 	if isDependencySpec.DependencyPackage != nil {
 		if isDependencySpec.DependencyPackage.ID != nil ||
@@ -136,6 +158,17 @@ func (c *arangoClient) IsDependency(ctx context.Context, isDependencySpec *model
 	}
 }
 
+// IsDependencyList returns a page of the IsDependency query results, ordered by ID.
+func (c *arangoClient) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	p, err := listPage(ctx, "IsDependency", after, first, func(n *model.IsDependency) string { return n.ID }, func(ctx context.Context) ([]*model.IsDependency, error) {
+		return c.IsDependency(ctx, isDependencySpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsDependencyConnection(p), nil
+}
+
 func getDependencyForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, includeDepPkgVersion bool) ([]*model.IsDependency, error) {
 	if includeDepPkgVersion {
 		arangoQueryBuilder.query.WriteString("\n")
@@ -167,8 +200,11 @@ func getDependencyForQuery(ctx context.Context, c *arangoClient, arangoQueryBuil
 			'isDependency_id': isDependency._id,
 			'versionRange': isDependency.versionRange,
 			'dependencyType': isDependency.dependencyType,
+			'dependencyScope': isDependency.dependencyScope,
 			'justification': isDependency.justification,
 			'collector': isDependency.collector,
+			'verified': isDependency.verified,
+			'signer': isDependency.signer,
 			'origin': isDependency.origin
 		}`)
 	} else {
@@ -197,13 +233,16 @@ func getDependencyForQuery(ctx context.Context, c *arangoClient, arangoQueryBuil
 			'isDependency_id': isDependency._id,
 			'versionRange': isDependency.versionRange,
 			'dependencyType': isDependency.dependencyType,
+			'dependencyScope': isDependency.dependencyScope,
 			'justification': isDependency.justification,
 			'collector': isDependency.collector,
+			'verified': isDependency.verified,
+			'signer': isDependency.signer,
 			'origin': isDependency.origin
 		}`)
 	}
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "IsDependency")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "isDependency_id", "IsDependency")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for IsDependency: %w", err)
 	}
@@ -225,6 +264,14 @@ func queryIsDependencyBasedOnFilter(arangoQueryBuilder *arangoQueryBuilder, isDe
 		arangoQueryBuilder.filter("isDependency", dependencyTypeStr, "==", "@"+dependencyTypeStr)
 		queryValues[dependencyTypeStr] = *isDependencySpec.DependencyType
 	}
+	if isDependencySpec.DependencyScope != nil {
+		arangoQueryBuilder.query.WriteString(fmt.Sprintf(" FILTER %s == @%s", dependencyScopeAQL("isDependency"), dependencyScopeStr))
+		queryValues[dependencyScopeStr] = *isDependencySpec.DependencyScope
+	}
+	if len(isDependencySpec.ExcludeDependencyScopes) > 0 {
+		arangoQueryBuilder.query.WriteString(fmt.Sprintf(" FILTER %s NOT IN @%s", dependencyScopeAQL("isDependency"), excludeDependencyScopesStr))
+		queryValues[excludeDependencyScopesStr] = isDependencySpec.ExcludeDependencyScopes
+	}
 	if isDependencySpec.Justification != nil {
 		arangoQueryBuilder.filter("isDependency", justification, "==", "@"+justification)
 		queryValues[justification] = *isDependencySpec.Justification
@@ -337,9 +384,12 @@ func getDependencyQueryValues(pkg *model.PkgInputSpec, depPkg *model.PkgInputSpe
 
 	values[versionRangeStr] = dependency.VersionRange
 	values[dependencyTypeStr] = dependency.DependencyType.String()
+	values[dependencyScopeStr] = helper.DependencyScopeOrUnknown(dependency.DependencyScope).String()
 	values[justification] = dependency.Justification
 	values[origin] = dependency.Origin
 	values[collector] = dependency.Collector
+	values["verified"] = dependency.Verified
+	values["signer"] = dependency.Signer
 
 	return values
 }
@@ -397,8 +447,8 @@ func (c *arangoClient) IngestDependencies(ctx context.Context, pkgs []*model.Pkg
     )
 		
 	LET isDependency = FIRST(
-		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-			INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, justification:doc.justification, collector:doc.collector, origin:doc.origin }
+		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, dependencyScope:doc.dependencyScope, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+			INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, dependencyScope:doc.dependencyScope, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin }
 			UPDATE {} IN isDependencies
 			RETURN {
 			   '_id': NEW._id,
@@ -433,8 +483,8 @@ func (c *arangoClient) IngestDependencies(ctx context.Context, pkgs []*model.Pkg
     )
 		
 	LET isDependency = FIRST(
-		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-			INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, justification:doc.justification, collector:doc.collector, origin:doc.origin }
+		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, dependencyScope:doc.dependencyScope, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+			INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:doc.versionRange, dependencyType:doc.dependencyType, dependencyScope:doc.dependencyScope, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin }
 			UPDATE {} IN isDependencies
 			RETURN {
 				'_id': NEW._id,
@@ -492,8 +542,8 @@ func (c *arangoClient) IngestDependency(ctx context.Context, pkg model.PkgInputS
     )
 	  
 	  LET isDependency = FIRST(
-		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:@versionRange, dependencyType:@dependencyType, justification:@justification, collector:@collector, origin:@origin } 
-			  INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:@versionRange, dependencyType:@dependencyType, justification:@justification, collector:@collector, origin:@origin } 
+		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:@versionRange, dependencyType:@dependencyType, dependencyScope:@dependencyScope, justification:@justification, collector:@collector, origin:@origin } 
+			  INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.name_id, versionRange:@versionRange, dependencyType:@dependencyType, dependencyScope:@dependencyScope, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			  UPDATE {} IN isDependencies
 			  RETURN {
 				'_id': NEW._id,
@@ -529,8 +579,8 @@ func (c *arangoClient) IngestDependency(ctx context.Context, pkg model.PkgInputS
 
 	  
 	  LET isDependency = FIRST(
-		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:@versionRange, dependencyType:@dependencyType, justification:@justification, collector:@collector, origin:@origin } 
-			  INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:@versionRange, dependencyType:@dependencyType, justification:@justification, collector:@collector, origin:@origin } 
+		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:@versionRange, dependencyType:@dependencyType, dependencyScope:@dependencyScope, justification:@justification, collector:@collector, origin:@origin } 
+			  INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, versionRange:@versionRange, dependencyType:@dependencyType, dependencyScope:@dependencyScope, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			  UPDATE {} IN isDependencies
 			  RETURN {
 				'_id': NEW._id,
@@ -563,14 +613,17 @@ func (c *arangoClient) IngestDependency(ctx context.Context, pkg model.PkgInputS
 
 func getIsDependencyFromCursor(ctx context.Context, cursor driver.Cursor, ingestion bool) ([]*model.IsDependency, error) {
 	type collectedData struct {
-		PkgVersion     *dbPkgVersion `json:"pkgVersion"`
-		DepPkg         *dbPkgVersion `json:"depPkg"`
-		IsDependencyID string        `json:"isDependency_id"`
-		VersionRange   string        `json:"versionRange"`
-		DependencyType string        `json:"dependencyType"`
-		Justification  string        `json:"justification"`
-		Collector      string        `json:"collector"`
-		Origin         string        `json:"origin"`
+		PkgVersion      *dbPkgVersion `json:"pkgVersion"`
+		DepPkg          *dbPkgVersion `json:"depPkg"`
+		IsDependencyID  string        `json:"isDependency_id"`
+		VersionRange    string        `json:"versionRange"`
+		DependencyType  string        `json:"dependencyType"`
+		DependencyScope string        `json:"dependencyScope"`
+		Justification   string        `json:"justification"`
+		Collector       string        `json:"collector"`
+		Verified        *bool         `json:"verified"`
+		Signer          *string       `json:"signer"`
+		Origin          string        `json:"origin"`
 	}
 
 	var createdValues []collectedData
@@ -606,6 +659,8 @@ func getIsDependencyFromCursor(ctx context.Context, cursor driver.Cursor, ingest
 				Justification:     createdValue.Justification,
 				Origin:            createdValue.Collector,
 				Collector:         createdValue.Origin,
+				Verified:          createdValue.Verified,
+				Signer:            createdValue.Signer,
 			}
 
 			if depType, ok := dependencyTypeToEnum[createdValue.DependencyType]; ok {
@@ -613,6 +668,11 @@ func getIsDependencyFromCursor(ctx context.Context, cursor driver.Cursor, ingest
 			} else {
 				return nil, fmt.Errorf("DependencyType %s failed to match", createdValue.DependencyType)
 			}
+			depScope, err := dependencyScopeFromDB(createdValue.DependencyScope)
+			if err != nil {
+				return nil, err
+			}
+			isDependency.DependencyScope = depScope
 		} else {
 			isDependency = &model.IsDependency{ID: createdValue.IsDependencyID}
 		}
@@ -662,14 +722,17 @@ func (c *arangoClient) queryIsDependencyNodeByID(ctx context.Context, filter *mo
 	defer cursor.Close()
 
 	type dbIsDependency struct {
-		IsDependencyID string `json:"_id"`
-		PackageID      string `json:"packageID"`
-		DepPackageID   string `json:"depPackageID"`
-		VersionRange   string `json:"versionRange"`
-		DependencyType string `json:"dependencyType"`
-		Justification  string `json:"justification"`
-		Collector      string `json:"collector"`
-		Origin         string `json:"origin"`
+		IsDependencyID  string  `json:"_id"`
+		PackageID       string  `json:"packageID"`
+		DepPackageID    string  `json:"depPackageID"`
+		VersionRange    string  `json:"versionRange"`
+		DependencyType  string  `json:"dependencyType"`
+		DependencyScope string  `json:"dependencyScope"`
+		Justification   string  `json:"justification"`
+		Collector       string  `json:"collector"`
+		Verified        *bool   `json:"verified"`
+		Signer          *string `json:"signer"`
+		Origin          string  `json:"origin"`
 	}
 
 	var collectedValues []dbIsDependency
@@ -697,6 +760,10 @@ func (c *arangoClient) queryIsDependencyNodeByID(ctx context.Context, filter *mo
 	} else {
 		return nil, fmt.Errorf("DependencyType %s failed to match", collectedValues[0].DependencyType)
 	}
+	depScope, err := dependencyScopeFromDB(collectedValues[0].DependencyScope)
+	if err != nil {
+		return nil, err
+	}
 
 	builtPackage, err := c.buildPackageResponseFromID(ctx, collectedValues[0].PackageID, filter.Package)
 	if err != nil {
@@ -714,9 +781,12 @@ func (c *arangoClient) queryIsDependencyNodeByID(ctx context.Context, filter *mo
 		DependencyPackage: builtDepPackage,
 		VersionRange:      collectedValues[0].VersionRange,
 		DependencyType:    depType,
+		DependencyScope:   depScope,
 		Justification:     collectedValues[0].Justification,
 		Origin:            collectedValues[0].Collector,
 		Collector:         collectedValues[0].Origin,
+		Verified:          collectedValues[0].Verified,
+		Signer:            collectedValues[0].Signer,
 	}, nil
 }
 
@@ -82,6 +82,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -114,6 +115,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -146,6 +148,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -178,6 +181,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification one",
 				},
 			},
@@ -209,6 +213,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -238,6 +243,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -267,6 +273,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -296,6 +303,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -325,6 +333,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P4out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -354,6 +363,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P3out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -383,10 +393,12 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P4out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P3out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -422,6 +434,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P5out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -456,6 +469,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P5out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -491,6 +505,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P5out,
 					DependencyPackage: testdata.P2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -521,6 +536,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -553,6 +569,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -614,6 +631,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -645,6 +663,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					VersionRange:      "1-3",
 				},
 			},
@@ -677,6 +696,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeIndirect,
 				},
 			},
@@ -732,15 +752,18 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -767,6 +790,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification name only",
 				},
 			},
@@ -799,11 +823,13 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification return specific",
 				},
 				{
 					Package:           testdata.P3out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification return specific",
 				},
 			},
@@ -830,6 +856,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P4out,
 					DependencyPackage: testdata.P2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -855,6 +882,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           testdata.P2out,
 					DependencyPackage: testdata.P4out,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -957,6 +985,7 @@ func TestIsDependencies(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -981,6 +1010,7 @@ func TestIsDependencies(t *testing.T) {
 				{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -1065,6 +1095,7 @@ func Test_buildIsDependencyByID(t *testing.T) {
 			ExpID: &model.IsDependency{
 				Package:           testdata.P2out,
 				DependencyPackage: testdata.P2outName,
+				DependencyScope:   model.DependencyScopeUnknown,
 			},
 		},
 		{
@@ -1086,6 +1117,7 @@ func Test_buildIsDependencyByID(t *testing.T) {
 			ExpID: &model.IsDependency{
 				Package:           testdata.P2out,
 				DependencyPackage: testdata.P4outName,
+				DependencyScope:   model.DependencyScopeUnknown,
 			},
 		},
 		{
@@ -1102,6 +1134,7 @@ func Test_buildIsDependencyByID(t *testing.T) {
 			ExpID: &model.IsDependency{
 				Package:           testdata.P1out,
 				DependencyPackage: testdata.P2outName,
+				DependencyScope:   model.DependencyScopeUnknown,
 			},
 		},
 		{
@@ -1134,6 +1167,7 @@ func Test_buildIsDependencyByID(t *testing.T) {
 			ExpID: &model.IsDependency{
 				Package:           testdata.P4out,
 				DependencyPackage: testdata.P2out,
+				DependencyScope:   model.DependencyScopeUnknown,
 			},
 		},
 		{
@@ -1150,6 +1184,7 @@ func Test_buildIsDependencyByID(t *testing.T) {
 			ExpID: &model.IsDependency{
 				Package:           testdata.P2out,
 				DependencyPackage: testdata.P4out,
+				DependencyScope:   model.DependencyScopeUnknown,
 			},
 		},
 	}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -104,6 +105,17 @@ func (c *arangoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model
 	}
 }
 
+// IsOccurrenceList returns a page of the IsOccurrence query results, ordered by ID.
+func (c *arangoClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	p, err := listPage(ctx, "IsOccurrence", after, first, func(n *model.IsOccurrence) string { return n.ID }, func(ctx context.Context) ([]*model.IsOccurrence, error) {
+		return c.IsOccurrence(ctx, isOccurrenceSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsOccurrenceConnection(p), nil
+}
+
 func getSrcOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.IsOccurrence, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -125,10 +137,12 @@ func getSrcOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQuery
 		'isOccurrence_id': isOccurrence._id,
 		'justification': isOccurrence.justification,
 		'collector': isOccurrence.collector,
+		'verified': isOccurrence.verified,
+		'signer': isOccurrence.signer,
 		'origin': isOccurrence.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "IsOccurrence")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "isOccurrence_id", "IsOccurrence")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for IsOccurrence: %w", err)
 	}
@@ -160,10 +174,12 @@ func getPkgOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQuery
 		'isOccurrence_id': isOccurrence._id,
 		'justification': isOccurrence.justification,
 		'collector': isOccurrence.collector,
+		'verified': isOccurrence.verified,
+		'signer': isOccurrence.signer,
 		'origin': isOccurrence.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "IsOccurrence")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "isOccurrence_id", "IsOccurrence")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for IsOccurrence: %w", err)
 	}
@@ -228,6 +244,8 @@ func getOccurrenceQueryValues(pkg *model.PkgInputSpec, src *model.SourceInputSpe
 	values[justification] = occurrence.Justification
 	values[origin] = occurrence.Origin
 	values[collector] = occurrence.Collector
+	values["verified"] = occurrence.Verified
+	values["signer"] = occurrence.Signer
 
 	return values
 }
@@ -278,7 +296,7 @@ func (c *arangoClient) IngestOccurrences(ctx context.Context, subjects model.Pac
 		  
 		  LET isOccurrence = FIRST(
 			  UPSERT { packageID:firstPkg.version_id, artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				  INSERT { packageID:firstPkg.version_id, artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				  INSERT { packageID:firstPkg.version_id, artifactID:artifact._id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				  UPDATE {} IN isOccurrences
 				  RETURN {
 					'_id': NEW._id,
@@ -341,7 +359,7 @@ func (c *arangoClient) IngestOccurrences(ctx context.Context, subjects model.Pac
 		  
 		  LET isOccurrence = FIRST(
 			  UPSERT { sourceID:firstSrc.name_id, artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				  INSERT { sourceID:firstSrc.name_id, artifactID:artifact._id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				  INSERT { sourceID:firstSrc.name_id, artifactID:artifact._id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				  UPDATE {} IN isOccurrences
 				  RETURN {
 					'_id': NEW._id,
@@ -394,7 +412,7 @@ func (c *arangoClient) IngestOccurrence(ctx context.Context, subject model.Packa
 	  
 	LET isOccurrence = FIRST(
 		  UPSERT { packageID:firstPkg.version_id, artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin } 
-			  INSERT { packageID:firstPkg.version_id, artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin } 
+			  INSERT { packageID:firstPkg.version_id, artifactID:artifact._id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			  UPDATE {} IN isOccurrences
 			  RETURN {
 				'_id': NEW._id,
@@ -427,7 +445,7 @@ func (c *arangoClient) IngestOccurrence(ctx context.Context, subject model.Packa
 		  
 		LET isOccurrence = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin } 
-			INSERT { sourceID:firstSrc.name_id, artifactID:artifact._id, justification:@justification, collector:@collector, origin:@origin } 
+			INSERT { sourceID:firstSrc.name_id, artifactID:artifact._id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			UPDATE {} IN isOccurrences
 			RETURN {
 				'_id': NEW._id,
@@ -468,6 +486,8 @@ func getIsOccurrenceFromCursor(ctx context.Context, cursor driver.Cursor, ingest
 		IsOccurrenceID string          `json:"isOccurrence_id"`
 		Justification  string          `json:"justification"`
 		Collector      string          `json:"collector"`
+		Verified       *bool           `json:"verified"`
+		Signer         *string         `json:"signer"`
 		Origin         string          `json:"origin"`
 	}
 
@@ -508,6 +528,8 @@ func getIsOccurrenceFromCursor(ctx context.Context, cursor driver.Cursor, ingest
 				Justification: createdValue.Justification,
 				Origin:        createdValue.Origin,
 				Collector:     createdValue.Collector,
+				Verified:      createdValue.Verified,
+				Signer:        createdValue.Signer,
 			}
 			if pkg != nil {
 				isOccurrence.Subject = pkg
@@ -570,6 +592,8 @@ func (c *arangoClient) queryIsOccurrenceNodeByID(ctx context.Context, filter *mo
 		ArtifactID     string  `json:"artifactID"`
 		Justification  string  `json:"justification"`
 		Collector      string  `json:"collector"`
+		Verified       *bool   `json:"verified"`
+		Signer         *string `json:"signer"`
 		Origin         string  `json:"origin"`
 	}
 
@@ -597,6 +621,8 @@ func (c *arangoClient) queryIsOccurrenceNodeByID(ctx context.Context, filter *mo
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}
 
 	builtArtifact, err := c.buildArtifactResponseByID(ctx, collectedValues[0].ArtifactID, filter.Artifact)
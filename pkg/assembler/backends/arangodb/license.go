@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -36,7 +37,7 @@ func (c *arangoClient) Licenses(ctx context.Context, licenseSpec *model.LicenseS
   "listversion": license.listversion,
 }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, aqb.string(), values, "Licenses")
+	cursor, err := executePagedQuery(ctx, c.db, aqb.string(), values, "id", "Licenses")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for license: %w", err)
 	}
@@ -45,6 +46,17 @@ func (c *arangoClient) Licenses(ctx context.Context, licenseSpec *model.LicenseS
 	return getLicenses(ctx, cursor)
 }
 
+// LicensesList returns a page of the licenses query results, ordered by ID.
+func (c *arangoClient) LicensesList(ctx context.Context, licenseSpec *model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
+	p, err := listPage(ctx, "Licenses", after, first, func(n *model.License) string { return n.ID }, func(ctx context.Context) ([]*model.License, error) {
+		return c.Licenses(ctx, licenseSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.LicenseConnection(p), nil
+}
+
 func setLicenseMatchValues(licenseSpec *model.LicenseSpec, queryValues map[string]any) *arangoQueryBuilder {
 	aqb := newForQuery(licensesStr, "license")
 	if licenseSpec != nil {
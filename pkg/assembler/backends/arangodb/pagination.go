@@ -0,0 +1,145 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Paginated queries use keyset pagination on the document IDs: the cursor of
+// an edge is the _id of the document behind it, and a page is the set of
+// documents with an _id greater than the after cursor, in ascending order.
+//
+// The query of a type may be made of several AQL queries (one per kind of
+// subject, for instance), so the page is requested through the context: each
+// of those queries that is run with executePagedQuery under the name the
+// request was made for only returns the documents of the page, plus one to
+// find out whether there is a next page, and the list merges them. Queries
+// run under other names, such as the lookups of the nodes a document points
+// to, are not paged.
+
+type pageRequestKey struct{}
+
+// pageRequest is the page a list query asks for, and the number of documents
+// the queries run on its behalf have matched.
+type pageRequest struct {
+	executedFrom string
+	after        *string
+	limit        *int
+	total        int
+	paged        bool
+}
+
+func withPageRequest(ctx context.Context, executedFrom string, after *string, first *int) (context.Context, *pageRequest) {
+	req := &pageRequest{executedFrom: executedFrom, after: after}
+	if first != nil {
+		limit := *first + 1
+		req.limit = &limit
+	}
+	return context.WithValue(ctx, pageRequestKey{}, req), req
+}
+
+// executePagedQuery runs query like executeQueryWithRetry, but if the context
+// carries a page request for executedFrom, only the documents of the page are returned and all
+// the matching documents are counted. idField is the field of the documents
+// returned by query that holds their _id.
+func executePagedQuery(ctx context.Context, db driver.Database, query string, values map[string]any, idField string, executedFrom string) (driver.Cursor, error) {
+	req, ok := ctx.Value(pageRequestKey{}).(*pageRequest)
+	if !ok || req.executedFrom != executedFrom {
+		return executeQueryWithRetry(ctx, db, query, values, executedFrom)
+	}
+	req.paged = true
+
+	countCursor, err := executeQueryWithRetry(ctx, db, fmt.Sprintf("RETURN LENGTH(\n%s\n)", query), values, executedFrom+" - count")
+	if err != nil {
+		return nil, err
+	}
+	defer countCursor.Close()
+	var count int
+	if _, err := countCursor.ReadDocument(ctx, &count); err != nil {
+		return nil, fmt.Errorf("failed to count the results of %s: %w", executedFrom, err)
+	}
+	req.total += count
+
+	pageValues := make(map[string]any, len(values)+2)
+	for k, v := range values {
+		pageValues[k] = v
+	}
+	var sb strings.Builder
+	sb.WriteString("FOR doc IN (\n")
+	sb.WriteString(query)
+	sb.WriteString("\n)")
+	if req.after != nil {
+		sb.WriteString(fmt.Sprintf("\nFILTER doc.%s > @pageAfter", idField))
+		pageValues["pageAfter"] = *req.after
+	}
+	sb.WriteString(fmt.Sprintf("\nSORT doc.%s", idField))
+	if req.limit != nil {
+		sb.WriteString("\nLIMIT @pageLimit")
+		pageValues["pageLimit"] = *req.limit
+	}
+	sb.WriteString("\nRETURN doc")
+	return executeQueryWithRetry(ctx, db, sb.String(), pageValues, executedFrom)
+}
+
+// listPage runs query, a query of a type, for the page that follows the after
+// cursor, paging the AQL queries it runs under the name executedFrom. If query
+// runs no paged AQL query, as is the case for the lookups by
+// ID and the types that are built from several documents, the page is taken
+// from all its results instead.
+func listPage[T any](ctx context.Context, executedFrom string, after *string, first *int, nodeID func(T) string, query func(context.Context) ([]T, error)) (*helper.Page[T], error) {
+	if err := helper.ValidatePaginationFirst(first); err != nil {
+		return nil, err
+	}
+	ctx, req := withPageRequest(ctx, executedFrom, after, first)
+	nodes, err := query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !req.paged {
+		return helper.Paginate(nodes, nodeID, after, first)
+	}
+
+	// Each of the AQL queries returned its own page, so the merged nodes
+	// still need to be cut to the size of one.
+	slices.SortStableFunc(nodes, func(a, b T) int {
+		return strings.Compare(nodeID(a), nodeID(b))
+	})
+	nodes = slices.CompactFunc(nodes, func(a, b T) bool {
+		return nodeID(a) == nodeID(b)
+	})
+	p := &helper.Page[T]{
+		PageInfo:   &model.PageInfo{},
+		TotalCount: req.total,
+	}
+	if first != nil && len(nodes) > *first {
+		nodes = nodes[:*first]
+		p.PageInfo.HasNextPage = true
+	}
+	p.Nodes = nodes
+	for _, n := range nodes {
+		p.Cursors = append(p.Cursors, nodeID(n))
+	}
+	p.SetCursors()
+	return p, nil
+}
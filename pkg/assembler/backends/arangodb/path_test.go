@@ -166,6 +166,7 @@ func Test_Path(t *testing.T) {
 				&model.IsDependency{
 					Package:           testdata.P1out,
 					DependencyPackage: testdata.P2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 				testdata.P2outName,
 			},
@@ -628,6 +629,7 @@ func Test_Nodes(t *testing.T) {
 		want: []model.Node{&model.IsDependency{
 			Package:           testdata.P1out,
 			DependencyPackage: testdata.P2outName,
+			DependencyScope:   model.DependencyScopeUnknown,
 		}},
 	}, {
 		name:  "isOccurrence",
@@ -2527,6 +2529,7 @@ func Test_Neighbors(t *testing.T) {
 			&model.IsDependency{
 				Package:           testdata.P1out,
 				DependencyPackage: testdata.P2outName,
+				DependencyScope:   model.DependencyScopeUnknown,
 			}},
 	}, {
 		name:  "isDependency - pkgVersion",
@@ -2550,6 +2553,7 @@ func Test_Neighbors(t *testing.T) {
 			&model.IsDependency{
 				Package:           testdata.P1out,
 				DependencyPackage: testdata.P2out,
+				DependencyScope:   model.DependencyScopeUnknown,
 			}},
 	}, {
 		name:  "isDependency - isDependencyID - pkgName",
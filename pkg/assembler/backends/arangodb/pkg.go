@@ -23,6 +23,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -487,6 +488,21 @@ func (c *arangoClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]
 	return getPackages(ctx, cursor)
 }
 
+// PackagesList returns a page of the packages query results, ordered by ID.
+// The packages are built from the documents of every level of their trie, so
+// the page is taken from all the results of the query.
+func (c *arangoClient) PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	nodes, err := c.Packages(ctx, pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := helper.Paginate(nodes, func(n *model.Package) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.PackageConnection(p), nil
+}
+
 func (c *arangoClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
 
 	values := map[string]any{}
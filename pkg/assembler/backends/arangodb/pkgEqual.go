@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	purl "github.com/package-url/packageurl-go"
 )
@@ -61,6 +62,17 @@ func (c *arangoClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqua
 	}
 }
 
+// PkgEqualList returns a page of the PkgEqual query results, ordered by ID.
+func (c *arangoClient) PkgEqualList(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
+	p, err := listPage(ctx, "pkgEqual", after, first, func(n *model.PkgEqual) string { return n.ID }, func(ctx context.Context) ([]*model.PkgEqual, error) {
+		return c.PkgEqual(ctx, pkgEqualSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.PkgEqualConnection(p), nil
+}
+
 func matchPkgEqualByInput(ctx context.Context, c *arangoClient, pkgEqualSpec *model.PkgEqualSpec, firstPkg *model.PkgSpec,
 	secondPkg *model.PkgSpec, values map[string]any) ([]*model.PkgEqual, error) {
 
@@ -221,10 +233,12 @@ func getPkgEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilde
 		'pkgEqual_id': pkgEqual._id,
 		'justification': pkgEqual.justification,
 		'collector': pkgEqual.collector,
+		'verified': pkgEqual.verified,
+		'signer': pkgEqual.signer,
 		'origin': pkgEqual.origin
 	}`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "pkgEqual")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "pkgEqual_id", "pkgEqual")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for pkgEqual: %w", err)
 	}
@@ -333,6 +347,8 @@ func getPkgEqualQueryValues(currentPkg *model.PkgInputSpec, otherPkg *model.PkgI
 	values[justification] = pkgEqual.Justification
 	values[origin] = pkgEqual.Origin
 	values[collector] = pkgEqual.Collector
+	values["verified"] = pkgEqual.Verified
+	values["signer"] = pkgEqual.Signer
 
 	return values
 }
@@ -387,7 +403,7 @@ func (c *arangoClient) IngestPkgEquals(ctx context.Context, pkgs []*model.PkgInp
 	
 	LET pkgEqual = FIRST(
 		UPSERT { packageID:firstPkg.version_id, equalPackageID:equalPkg.version_id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-			INSERT { packageID:firstPkg.version_id, equalPackageID:equalPkg.version_id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+			INSERT { packageID:firstPkg.version_id, equalPackageID:equalPkg.version_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 			UPDATE {} IN pkgEquals
 			RETURN {
 				'_id': NEW._id,
@@ -443,7 +459,7 @@ func (c *arangoClient) IngestPkgEqual(ctx context.Context, pkg model.PkgInputSpe
 	
 	LET pkgEqual = FIRST(
 		UPSERT { packageID:firstPkg.version_id, equalPackageID:equalPkg.version_id, justification:@justification, collector:@collector, origin:@origin } 
-			INSERT { packageID:firstPkg.version_id, equalPackageID:equalPkg.version_id, justification:@justification, collector:@collector, origin:@origin } 
+			INSERT { packageID:firstPkg.version_id, equalPackageID:equalPkg.version_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			UPDATE {} IN pkgEquals
 			RETURN {
 				'_id': NEW._id,
@@ -481,6 +497,8 @@ func getPkgEqualFromCursor(ctx context.Context, cursor driver.Cursor, ingestion
 		PkgEqualId      string        `json:"pkgEqual_id"`
 		Justification   string        `json:"justification"`
 		Collector       string        `json:"collector"`
+		Verified        *bool         `json:"verified"`
+		Signer          *string       `json:"signer"`
 		Origin          string        `json:"origin"`
 	}
 
@@ -515,6 +533,8 @@ func getPkgEqualFromCursor(ctx context.Context, cursor driver.Cursor, ingestion
 				Justification: createdValue.Justification,
 				Origin:        createdValue.Origin,
 				Collector:     createdValue.Collector,
+				Verified:      createdValue.Verified,
+				Signer:        createdValue.Signer,
 			}
 		} else {
 			pkgEqual = &model.PkgEqual{ID: createdValue.PkgEqualId}
@@ -565,12 +585,14 @@ func (c *arangoClient) queryPkgEqualNodeByID(ctx context.Context, filter *model.
 	defer cursor.Close()
 
 	type dbPkgEqual struct {
-		PkgEqualID     string `json:"_id"`
-		PackageID      string `json:"packageID"`
-		EqualPackageID string `json:"equalPackageID"`
-		Justification  string `json:"justification"`
-		Collector      string `json:"collector"`
-		Origin         string `json:"origin"`
+		PkgEqualID     string  `json:"_id"`
+		PackageID      string  `json:"packageID"`
+		EqualPackageID string  `json:"equalPackageID"`
+		Justification  string  `json:"justification"`
+		Collector      string  `json:"collector"`
+		Verified       *bool   `json:"verified"`
+		Signer         *string `json:"signer"`
+		Origin         string  `json:"origin"`
 	}
 
 	var collectedValues []dbPkgEqual
@@ -608,6 +630,8 @@ func (c *arangoClient) queryPkgEqualNodeByID(ctx context.Context, filter *model.
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Collector,
 		Collector:     collectedValues[0].Origin,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}, nil
 }
 
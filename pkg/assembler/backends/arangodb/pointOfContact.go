@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -163,6 +164,17 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 	}
 }
 
+// PointOfContactList returns a page of the PointOfContact query results, ordered by ID.
+func (c *arangoClient) PointOfContactList(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
+	p, err := listPage(ctx, "PointOfContact", after, first, func(n *model.PointOfContact) string { return n.ID }, func(ctx context.Context) ([]*model.PointOfContact, error) {
+		return c.PointOfContact(ctx, pointOfContactSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.PointOfContactConnection(p), nil
+}
+
 func getSrcPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.PointOfContact, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -182,10 +194,12 @@ func getSrcPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQu
 		'since': pointOfContact.since,
 		'justification': pointOfContact.justification,
 		'collector': pointOfContact.collector,
+		'verified': pointOfContact.verified,
+		'signer': pointOfContact.signer,
 		'origin': pointOfContact.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "PointOfContact")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "pointOfContact_id", "PointOfContact")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for PointOfContact: %w", err)
 	}
@@ -208,10 +222,12 @@ func getArtPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQu
 		'since': pointOfContact.since,
 		'justification': pointOfContact.justification,
 		'collector': pointOfContact.collector,
+		'verified': pointOfContact.verified,
+		'signer': pointOfContact.signer,
 		'origin': pointOfContact.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "PointOfContact")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "pointOfContact_id", "PointOfContact")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for PointOfContact: %w", err)
 	}
@@ -242,6 +258,8 @@ func getPkgPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQu
 			'since': pointOfContact.since,
 			'justification': pointOfContact.justification,
 			'collector': pointOfContact.collector,
+			'verified': pointOfContact.verified,
+			'signer': pointOfContact.signer,
 			'origin': pointOfContact.origin
 		  }`)
 	} else {
@@ -261,11 +279,13 @@ func getPkgPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQu
 			'since': pointOfContact.since,
 			'justification': pointOfContact.justification,
 			'collector': pointOfContact.collector,
+			'verified': pointOfContact.verified,
+			'signer': pointOfContact.signer,
 			'origin': pointOfContact.origin
 		  }`)
 	}
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "PointOfContact")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "pointOfContact_id", "PointOfContact")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for PointOfContact: %w", err)
 	}
@@ -329,6 +349,8 @@ func getPointOfContactQueryValues(pkg *model.PkgInputSpec, pkgMatchType *model.M
 	values[justification] = pointOfContact.Justification
 	values[origin] = pointOfContact.Origin
 	values[collector] = pointOfContact.Collector
+	values["verified"] = pointOfContact.Verified
+	values["signer"] = pointOfContact.Signer
 
 	return values
 }
@@ -350,7 +372,7 @@ func (c *arangoClient) IngestPointOfContact(ctx context.Context, subject model.P
 		  
 		  LET pointOfContact = FIRST(
 			  UPSERT {  packageID:firstPkg.version_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
-				  INSERT {  packageID:firstPkg.version_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
+				  INSERT {  packageID:firstPkg.version_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				  UPDATE {} IN pointOfContacts
 				  RETURN {
 					'_id': NEW._id,
@@ -382,7 +404,7 @@ func (c *arangoClient) IngestPointOfContact(ctx context.Context, subject model.P
 			  
 			  LET pointOfContact = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
-					  INSERT {  packageID:firstPkg.name_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
+					  INSERT {  packageID:firstPkg.name_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 					  UPDATE {} IN pointOfContacts
 					  RETURN {
 						'_id': NEW._id,
@@ -408,7 +430,7 @@ func (c *arangoClient) IngestPointOfContact(ctx context.Context, subject model.P
 		  
 		LET pointOfContact = FIRST(
 			UPSERT { artifactID:artifact._id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
-				INSERT { artifactID:artifact._id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
+				INSERT { artifactID:artifact._id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				UPDATE {} IN pointOfContacts
 				RETURN {
 					'_id': NEW._id,
@@ -440,7 +462,7 @@ func (c *arangoClient) IngestPointOfContact(ctx context.Context, subject model.P
 		  
 		LET pointOfContact = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
-				INSERT { sourceID:firstSrc.name_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, origin:@origin } 
+				INSERT { sourceID:firstSrc.name_id, email:@email, info:@info, since:@since, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 				UPDATE {} IN pointOfContacts
 				RETURN {
 					'_id': NEW._id,
@@ -519,7 +541,7 @@ func (c *arangoClient) IngestPointOfContacts(ctx context.Context, subjects model
 			  
 			  LET pointOfContact = FIRST(
 				  UPSERT {  packageID:firstPkg.version_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-					  INSERT {  packageID:firstPkg.version_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+					  INSERT {  packageID:firstPkg.version_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 					  UPDATE {} IN pointOfContacts
 					  RETURN {
 						'_id': NEW._id,
@@ -553,7 +575,7 @@ func (c *arangoClient) IngestPointOfContacts(ctx context.Context, subjects model
 			  
 			  LET pointOfContact = FIRST(
 				  UPSERT {  packageID:firstPkg.name_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-					  INSERT {  packageID:firstPkg.name_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+					  INSERT {  packageID:firstPkg.name_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 					  UPDATE {} IN pointOfContacts
 					  RETURN {
 						'_id': NEW._id,
@@ -609,7 +631,7 @@ func (c *arangoClient) IngestPointOfContacts(ctx context.Context, subjects model
 		  
 		LET pointOfContact = FIRST(
 			UPSERT { artifactID:artifact._id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				INSERT { artifactID:artifact._id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				INSERT { artifactID:artifact._id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				UPDATE {} IN pointOfContacts
 				RETURN {
 					'_id': NEW._id,
@@ -671,7 +693,7 @@ func (c *arangoClient) IngestPointOfContacts(ctx context.Context, subjects model
 		  
 		LET pointOfContact = FIRST(
 			UPSERT { sourceID:firstSrc.name_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-				INSERT { sourceID:firstSrc.name_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+				INSERT { sourceID:firstSrc.name_id, email:doc.email, info:doc.info, since:doc.since, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 				UPDATE {} IN pointOfContacts
 				RETURN {
 					'_id': NEW._id,
@@ -719,6 +741,8 @@ func getPointOfContactFromCursor(ctx context.Context, cursor driver.Cursor, inge
 		Since            time.Time       `json:"since"`
 		Justification    string          `json:"justification"`
 		Collector        string          `json:"collector"`
+		Verified         *bool           `json:"verified"`
+		Signer           *string         `json:"signer"`
 		Origin           string          `json:"origin"`
 	}
 
@@ -757,6 +781,8 @@ func getPointOfContactFromCursor(ctx context.Context, cursor driver.Cursor, inge
 			Justification: createdValue.Justification,
 			Origin:        createdValue.Origin,
 			Collector:     createdValue.Collector,
+			Verified:      createdValue.Verified,
+			Signer:        createdValue.Signer,
 		}
 
 		if pkg != nil {
@@ -824,6 +850,8 @@ func (c *arangoClient) queryPointOfContactNodeByID(ctx context.Context, filter *
 		Since            time.Time `json:"since"`
 		Justification    string    `json:"justification"`
 		Collector        string    `json:"collector"`
+		Verified         *bool     `json:"verified"`
+		Signer           *string   `json:"signer"`
 		Origin           string    `json:"origin"`
 	}
 
@@ -854,6 +882,8 @@ func (c *arangoClient) queryPointOfContactNodeByID(ctx context.Context, filter *
 		Justification: collectedValues[0].Justification,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}
 
 	if collectedValues[0].PackageID != nil {
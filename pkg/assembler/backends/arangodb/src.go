@@ -22,6 +22,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -364,6 +365,21 @@ func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec
 	return getSources(ctx, cursor)
 }
 
+// SourcesList returns a page of the sources query results, ordered by ID.
+// The sources are built from the documents of every level of their trie, so
+// the page is taken from all the results of the query.
+func (c *arangoClient) SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	nodes, err := c.Sources(ctx, sourceSpec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := helper.Paginate(nodes, func(n *model.Source) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.SourceConnection(p), nil
+}
+
 func (c *arangoClient) sourcesType(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
 
 	values := map[string]any{}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -56,6 +57,17 @@ func (c *arangoClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnE
 	}
 }
 
+// VulnEqualList returns a page of the vulnEqual query results, ordered by ID.
+func (c *arangoClient) VulnEqualList(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
+	p, err := listPage(ctx, "vulnEqual", after, first, func(n *model.VulnEqual) string { return n.ID }, func(ctx context.Context) ([]*model.VulnEqual, error) {
+		return c.VulnEqual(ctx, vulnEqualSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnEqualConnection(p), nil
+}
+
 func matchVulnEqualByInput(ctx context.Context, c *arangoClient, vulnEqualSpec *model.VulnEqualSpec, firstVulnerability *model.VulnerabilitySpec,
 	secondVulnerability *model.VulnerabilitySpec, values map[string]any) ([]*model.VulnEqual, error) {
 
@@ -152,10 +164,12 @@ func getVulnEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuild
 		'vulnEqual_id': vulnEqual._id,
 		'justification': vulnEqual.justification,
 		'collector': vulnEqual.collector,
+		'verified': vulnEqual.verified,
+		'signer': vulnEqual.signer,
 		'origin': vulnEqual.origin
 	}`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "vulnEqual")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "vulnEqual_id", "vulnEqual")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for vulnEqual: %w", err)
 	}
@@ -200,6 +214,8 @@ func getVulnEqualQueryValues(vulnerability *model.VulnerabilityInputSpec, otherV
 	values[justification] = vulnEqual.Justification
 	values[origin] = vulnEqual.Origin
 	values[collector] = vulnEqual.Collector
+	values["verified"] = vulnEqual.Verified
+	values["signer"] = vulnEqual.Signer
 
 	return values
 }
@@ -255,7 +271,7 @@ func (c *arangoClient) IngestVulnEquals(ctx context.Context, vulnerabilities []*
 	
 	LET vulnEqual = FIRST(
 		UPSERT { vulnerabilityID:firstVuln.vuln_id, equalVulnerabilityID:equalVuln.vuln_id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
-			INSERT { vulnerabilityID:firstVuln.vuln_id, equalVulnerabilityID:equalVuln.vuln_id, justification:doc.justification, collector:doc.collector, origin:doc.origin } 
+			INSERT { vulnerabilityID:firstVuln.vuln_id, equalVulnerabilityID:equalVuln.vuln_id, justification:doc.justification, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 			UPDATE {} IN vulnEquals
 			RETURN {
 				'_id': NEW._id,
@@ -311,7 +327,7 @@ func (c *arangoClient) IngestVulnEqual(ctx context.Context, vulnerability model.
 	
 	LET vulnEqual = FIRST(
 		UPSERT { vulnerabilityID:firstVuln.vuln_id, equalVulnerabilityID:equalVuln.vuln_id, justification:@justification, collector:@collector, origin:@origin } 
-			INSERT { vulnerabilityID:firstVuln.vuln_id, equalVulnerabilityID:equalVuln.vuln_id, justification:@justification, collector:@collector, origin:@origin } 
+			INSERT { vulnerabilityID:firstVuln.vuln_id, equalVulnerabilityID:equalVuln.vuln_id, justification:@justification, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			UPDATE {} IN vulnEquals
 			RETURN {
 				'_id': NEW._id,
@@ -349,6 +365,8 @@ func getVulnEqualFromCursor(ctx context.Context, cursor driver.Cursor, ingestion
 		VulnEqualId        string    `json:"vulnEqual_id"`
 		Justification      string    `json:"justification"`
 		Collector          string    `json:"collector"`
+		Verified           *bool     `json:"verified"`
+		Signer             *string   `json:"signer"`
 		Origin             string    `json:"origin"`
 	}
 
@@ -399,6 +417,8 @@ func getVulnEqualFromCursor(ctx context.Context, cursor driver.Cursor, ingestion
 				Justification:   createdValue.Justification,
 				Origin:          createdValue.Origin,
 				Collector:       createdValue.Collector,
+				Verified:        createdValue.Verified,
+				Signer:          createdValue.Signer,
 			}
 		} else {
 			vulnEqual = &model.VulnEqual{ID: createdValue.VulnEqualId}
@@ -448,12 +468,14 @@ func (c *arangoClient) queryVulnEqualNodeByID(ctx context.Context, filter *model
 	defer cursor.Close()
 
 	type dbVulnEqual struct {
-		VulnEqualID          string `json:"_id"`
-		VulnerabilityID      string `json:"vulnerabilityID"`
-		EqualVulnerabilityID string `json:"equalVulnerabilityID"`
-		Justification        string `json:"justification"`
-		Collector            string `json:"collector"`
-		Origin               string `json:"origin"`
+		VulnEqualID          string  `json:"_id"`
+		VulnerabilityID      string  `json:"vulnerabilityID"`
+		EqualVulnerabilityID string  `json:"equalVulnerabilityID"`
+		Justification        string  `json:"justification"`
+		Collector            string  `json:"collector"`
+		Verified             *bool   `json:"verified"`
+		Signer               *string `json:"signer"`
+		Origin               string  `json:"origin"`
 	}
 
 	var collectedValues []dbVulnEqual
@@ -490,6 +512,8 @@ func (c *arangoClient) queryVulnEqualNodeByID(ctx context.Context, filter *model
 		Justification:   collectedValues[0].Justification,
 		Origin:          collectedValues[0].Origin,
 		Collector:       collectedValues[0].Collector,
+		Verified:        collectedValues[0].Verified,
+		Signer:          collectedValues[0].Signer,
 	}, nil
 }
 
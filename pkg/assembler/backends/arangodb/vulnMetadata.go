@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -67,6 +68,17 @@ func (c *arangoClient) VulnerabilityMetadata(ctx context.Context, vulnerabilityM
 	}
 }
 
+// VulnerabilityMetadataList returns a page of the vulnerabilityMetadata query results, ordered by ID.
+func (c *arangoClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+	p, err := listPage(ctx, "VulnerabilityMetadata", after, first, func(n *model.VulnerabilityMetadata) string { return n.ID }, func(ctx context.Context) ([]*model.VulnerabilityMetadata, error) {
+		return c.VulnerabilityMetadata(ctx, vulnerabilityMetadataSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnerabilityMetadataConnection(p), nil
+}
+
 func getVulnMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.VulnerabilityMetadata, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -81,10 +93,12 @@ func getVulnMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBu
 		'scoreValue': vulnMetadata.scoreValue,
 		'timestamp': vulnMetadata.timestamp,
 		'collector': vulnMetadata.collector,
+		'verified': vulnMetadata.verified,
+		'signer': vulnMetadata.signer,
 		'origin': vulnMetadata.origin
 	  }`)
 
-	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "VulnerabilityMetadata")
+	cursor, err := executePagedQuery(ctx, c.db, arangoQueryBuilder.string(), values, "vulnMetadata_id", "VulnerabilityMetadata")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query for VulnerabilityMetadata: %w", err)
 	}
@@ -158,6 +172,8 @@ func getVulnMetadataQueryValues(vulnerability *model.VulnerabilityInputSpec, vul
 	values[timeStampStr] = vulnerabilityMetadata.Timestamp.UTC()
 	values[origin] = vulnerabilityMetadata.Origin
 	values[collector] = vulnerabilityMetadata.Collector
+	values["verified"] = vulnerabilityMetadata.Verified
+	values["signer"] = vulnerabilityMetadata.Signer
 
 	return values
 }
@@ -175,7 +191,7 @@ func (c *arangoClient) IngestVulnerabilityMetadata(ctx context.Context, vulnerab
 	  
 	  LET vulnMetadata = FIRST(
 		  UPSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:@scoreType, scoreValue:@scoreValue, timestamp:@timestamp, collector:@collector, origin:@origin } 
-			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:@scoreType, scoreValue:@scoreValue, timestamp:@timestamp, collector:@collector, origin:@origin } 
+			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:@scoreType, scoreValue:@scoreValue, timestamp:@timestamp, collector:@collector, verified:@verified, signer:@signer, origin:@origin } 
 			  UPDATE {} IN vulnMetadataCollection
 			  RETURN {
 				'_id': NEW._id,
@@ -246,7 +262,7 @@ func (c *arangoClient) IngestBulkVulnerabilityMetadata(ctx context.Context, vuln
 	  
 	  LET vulnMetadata = FIRST(
 		  UPSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:doc.scoreType, scoreValue:doc.scoreValue, timestamp:doc.timestamp, collector:doc.collector, origin:doc.origin } 
-			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:doc.scoreType, scoreValue:doc.scoreValue, timestamp:doc.timestamp, collector:doc.collector, origin:doc.origin } 
+			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:doc.scoreType, scoreValue:doc.scoreValue, timestamp:doc.timestamp, collector:doc.collector, verified:doc.verified, signer:doc.signer, origin:doc.origin } 
 			  UPDATE {} IN vulnMetadataCollection
 			  RETURN {
 				'_id': NEW._id,
@@ -286,6 +302,8 @@ func geVulnMetadataFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 		ScoreValue     float64                      `json:"scoreValue"`
 		Timestamp      time.Time                    `json:"timestamp"`
 		Collector      string                       `json:"collector"`
+		Verified       *bool                        `json:"verified"`
+		Signer         *string                      `json:"signer"`
 		Origin         string                       `json:"origin"`
 	}
 
@@ -327,6 +345,8 @@ func geVulnMetadataFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 				Timestamp:     createdValue.Timestamp,
 				Origin:        createdValue.Origin,
 				Collector:     createdValue.Collector,
+				Verified:      createdValue.Verified,
+				Signer:        createdValue.Signer,
 			}
 		} else {
 			vulnMetadata = &model.VulnerabilityMetadata{ID: createdValue.VulnMetadataID}
@@ -385,6 +405,8 @@ func (c *arangoClient) queryVulnerabilityMetadataNodeByID(ctx context.Context, f
 		ScoreValue      float64                      `json:"scoreValue"`
 		Timestamp       time.Time                    `json:"timestamp"`
 		Collector       string                       `json:"collector"`
+		Verified        *bool                        `json:"verified"`
+		Signer          *string                      `json:"signer"`
 		Origin          string                       `json:"origin"`
 	}
 
@@ -420,6 +442,8 @@ func (c *arangoClient) queryVulnerabilityMetadataNodeByID(ctx context.Context, f
 		Timestamp:     collectedValues[0].Timestamp,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
+		Verified:      collectedValues[0].Verified,
+		Signer:        collectedValues[0].Signer,
 	}, nil
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -100,6 +101,21 @@ func (c *arangoClient) Vulnerabilities(ctx context.Context, vulnSpec *model.Vuln
 	return getVulnerabilities(ctx, cursor)
 }
 
+// VulnerabilitiesList returns a page of the vulnerabilities query results, ordered by ID.
+// The vulnerabilities are built from the documents of every level of their trie, so
+// the page is taken from all the results of the query.
+func (c *arangoClient) VulnerabilitiesList(ctx context.Context, vulnSpec *model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
+	nodes, err := c.Vulnerabilities(ctx, vulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := helper.Paginate(nodes, func(n *model.Vulnerability) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnerabilityConnection(p), nil
+}
+
 func (c *arangoClient) vulnerabilityType(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
 
 	if vulnSpec != nil && vulnSpec.NoVuln != nil && *vulnSpec.NoVuln {
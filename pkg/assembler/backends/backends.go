@@ -52,6 +52,33 @@ type Backend interface {
 	VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error)
 	VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error)
 
+	// Paginated retrieval read-only queries for software trees
+	ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error)
+	BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error)
+	LicensesList(ctx context.Context, licenseSpec *model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error)
+	PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error)
+	SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error)
+	VulnerabilitiesList(ctx context.Context, vulnSpec *model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error)
+
+	// Paginated retrieval read-only queries for evidence trees
+	CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error)
+	CertifyGoodList(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error)
+	CertifyLegalList(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error)
+	ScorecardsList(ctx context.Context, scorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error)
+	CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error)
+	CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error)
+	PointOfContactList(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error)
+	HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error)
+	HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error)
+	HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error)
+	HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error)
+	IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error)
+	IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error)
+	HasMetadataList(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error)
+	PkgEqualList(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error)
+	VulnEqualList(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error)
+	VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error)
+
 	// Mutations for software trees (read-write queries)
 	IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (string, error)
 	IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]string, error)
@@ -102,6 +129,30 @@ type Backend interface {
 	IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.VulnerabilityInputSpec, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error)
 	IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.VulnerabilityInputSpec, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error)
 
+	// Deletions for evidence trees. Each Delete<Verb> method removes the node
+	// with the given ID together with the edges connecting it to the rest of
+	// the graph, and returns false if there is no such node. RetractEvidence
+	// removes all evidence nodes ingested from the document given as origin
+	// and returns how many were removed.
+	DeleteCertifyBad(ctx context.Context, id string) (bool, error)
+	DeleteCertifyGood(ctx context.Context, id string) (bool, error)
+	DeleteCertifyLegal(ctx context.Context, id string) (bool, error)
+	DeleteCertifyScorecard(ctx context.Context, id string) (bool, error)
+	DeleteCertifyVEXStatement(ctx context.Context, id string) (bool, error)
+	DeleteCertifyVuln(ctx context.Context, id string) (bool, error)
+	DeleteHasMetadata(ctx context.Context, id string) (bool, error)
+	DeleteHasSbom(ctx context.Context, id string) (bool, error)
+	DeleteHasSlsa(ctx context.Context, id string) (bool, error)
+	DeleteHasSourceAt(ctx context.Context, id string) (bool, error)
+	DeleteHashEqual(ctx context.Context, id string) (bool, error)
+	DeleteIsDependency(ctx context.Context, id string) (bool, error)
+	DeleteIsOccurrence(ctx context.Context, id string) (bool, error)
+	DeletePkgEqual(ctx context.Context, id string) (bool, error)
+	DeletePointOfContact(ctx context.Context, id string) (bool, error)
+	DeleteVulnEqual(ctx context.Context, id string) (bool, error)
+	DeleteVulnerabilityMetadata(ctx context.Context, id string) (bool, error)
+	RetractEvidence(ctx context.Context, origin string) (int, error)
+
 	// Topological queries: queries where node connectivity matters more than node type
 	Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error)
 	Node(ctx context.Context, node string) (model.Node, error)
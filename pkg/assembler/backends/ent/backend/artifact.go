@@ -40,6 +40,35 @@ func (b *EntBackend) Artifacts(ctx context.Context, artifactSpec *model.Artifact
 	return collect(artifacts, toModelArtifact), nil
 }
 
+func (b *EntBackend) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := b.client.Artifact.Query().
+		Where(artifactQueryPredicates(artifactSpec))
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	artifacts, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(artifact.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	artifacts, pageInfo := pageOf(artifacts, first, func(a *ent.Artifact) int { return a.ID })
+
+	edges := make([]*model.ArtifactEdge, 0, len(artifacts))
+	for _, a := range artifacts {
+		edges = append(edges, &model.ArtifactEdge{Cursor: nodeID(a.ID), Node: toModelArtifact(a)})
+	}
+	return &model.ArtifactConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
 func artifactQueryInputPredicates(spec model.ArtifactInputSpec) predicate.Artifact {
 	return artifact.And(
 		artifact.AlgorithmEqualFold(strings.ToLower(spec.Algorithm)),
@@ -60,3 +60,73 @@ func (s *Suite) Test_IngestArtifacts() {
 		})
 	}
 }
+
+func (s *Suite) Test_ArtifactsList() {
+	artifactInputs := []*model.ArtifactInputSpec{{
+		Algorithm: "sha256",
+		Digest:    "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+	}, {
+		Algorithm: "sha1",
+		Digest:    "7a8f47318e4676dacb0142afa0b83029cd7befd9",
+	}, {
+		Algorithm: "sha512",
+		Digest:    "374ab8f711235830769aa5f0b31ce9b72c5670074b34cb302cdafe3b606233ee92ee01e298e5701f15cc7087714cd9abd7ddb838a6e1206b3642de16d9fc9dd7",
+	}}
+	tests := []struct {
+		name      string
+		first     *int
+		wantPages [][]string
+		wantErr   bool
+	}{{
+		name:      "one page",
+		wantPages: [][]string{{"sha256", "sha1", "sha512"}},
+	}, {
+		name:      "page size 2",
+		first:     ptr(2),
+		wantPages: [][]string{{"sha256", "sha1"}, {"sha512"}},
+	}, {
+		name:      "page size 3",
+		first:     ptr(3),
+		wantPages: [][]string{{"sha256", "sha1", "sha512"}},
+	}, {
+		name:    "negative page size",
+		first:   ptr(-1),
+		wantErr: true,
+	}}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			be, err := GetBackend(s.Client)
+			s.NoError(err)
+
+			_, err = be.IngestArtifacts(s.Ctx, artifactInputs)
+			s.NoError(err)
+
+			var gotPages [][]string
+			var after *string
+			for {
+				got, err := be.ArtifactsList(s.Ctx, &model.ArtifactSpec{}, after, tt.first)
+				if (err != nil) != tt.wantErr {
+					s.T().Fatalf("ArtifactsList() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+				s.Equal(len(artifactInputs), got.TotalCount)
+				var page []string
+				for _, edge := range got.Edges {
+					s.Equal(edge.Node.ID, edge.Cursor)
+					page = append(page, edge.Node.Algorithm)
+				}
+				gotPages = append(gotPages, page)
+				if !got.PageInfo.HasNextPage {
+					break
+				}
+				after = got.PageInfo.EndCursor
+			}
+			if diff := cmp.Diff(tt.wantPages, gotPages); diff != "" {
+				s.T().Errorf("Unexpected pages. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
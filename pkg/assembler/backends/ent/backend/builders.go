@@ -41,6 +41,35 @@ func (b *EntBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpe
 	return collect(builders, toModelBuilder), nil
 }
 
+func (b *EntBackend) BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := b.client.Builder.Query().
+		Where(builderQueryPredicate(builderSpec))
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	builders, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(builder.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	builders, pageInfo := pageOf(builders, first, func(b *ent.Builder) int { return b.ID })
+
+	edges := make([]*model.BuilderEdge, 0, len(builders))
+	for _, b := range builders {
+		edges = append(edges, &model.BuilderEdge{Cursor: nodeID(b.ID), Node: toModelBuilder(b)})
+	}
+	return &model.BuilderConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
 func builderQueryPredicate(spec *model.BuilderSpec) predicate.Builder {
 	if spec == nil {
 		return NoOpSelector()
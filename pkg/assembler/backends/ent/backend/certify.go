@@ -53,6 +53,40 @@ func (b *EntBackend) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 	return collect(records, toModelCertifyGood), nil
 }
 
+func (b *EntBackend) CertifyBadList(ctx context.Context, filter *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	records, totalCount, pageInfo, err := queryCertificationsPage(ctx, b.client, certification.TypeBAD, filter, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.CertifyBadEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.CertifyBadEdge{Cursor: nodeID(r.ID), Node: toModelCertifyBad(r)})
+	}
+	return &model.CertifyBadConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func (b *EntBackend) CertifyGoodList(ctx context.Context, filter *model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
+	records, totalCount, pageInfo, err := queryCertificationsPage(ctx, b.client, certification.TypeGOOD, (*model.CertifyBadSpec)(filter), after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.CertifyGoodEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.CertifyGoodEdge{Cursor: nodeID(r.ID), Node: toModelCertifyGood(r)})
+	}
+	return &model.CertifyGoodConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
 func (b *EntBackend) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, spec model.CertifyBadInputSpec) (string, error) {
 
 	certRecord, err := WithinTX(ctx, b.client, func(ctx context.Context) (*ent.Certification, error) {
@@ -120,7 +154,31 @@ func (b *EntBackend) IngestCertifyGoods(ctx context.Context, subjects model.Pack
 }
 
 func queryCertifications(ctx context.Context, client *ent.Client, typ certification.Type, filter *model.CertifyBadSpec) ([]*ent.Certification, error) {
+	return certificationQuery(client, typ, filter).
+		Limit(MaxPageSize).
+		All(ctx)
+}
+
+func queryCertificationsPage(ctx context.Context, client *ent.Client, typ certification.Type, filter *model.CertifyBadSpec, after *string, first *int) ([]*ent.Certification, int, *model.PageInfo, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	query := certificationQuery(client, typ, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(certification.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.Certification) int { return r.ID })
+	return records, totalCount, pageInfo, nil
+}
 
+func certificationQuery(client *ent.Client, typ certification.Type, filter *model.CertifyBadSpec) *ent.CertificationQuery {
 	query := []predicate.Certification{
 		certification.TypeEQ(typ),
 		optionalPredicate(filter.ID, IDEQ),
@@ -146,12 +204,10 @@ func queryCertifications(ctx context.Context, client *ent.Client, typ certificat
 
 	return client.Certification.Query().
 		Where(query...).
-		Limit(MaxPageSize).
 		WithSource(withSourceNameTreeQuery()).
 		WithArtifact().
 		WithPackageVersion(withPackageVersionTree()).
-		WithAllVersions(withPackageNameTree()).
-		All(ctx)
+		WithAllVersions(withPackageNameTree())
 }
 
 func upsertCertification[T certificationInputSpec](ctx context.Context, client *ent.Tx, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, spec T) (*ent.Certification, error) {
@@ -164,6 +220,8 @@ func upsertCertification[T certificationInputSpec](ctx context.Context, client *
 			SetJustification(v.Justification).
 			SetOrigin(v.Origin).
 			SetCollector(v.Collector).
+			SetNillableVerified(v.Verified).
+			SetNillableSigner(v.Signer).
 			SetKnownSince(v.KnownSince)
 	case model.CertifyGoodInputSpec:
 		insert.
@@ -171,6 +229,8 @@ func upsertCertification[T certificationInputSpec](ctx context.Context, client *
 			SetJustification(v.Justification).
 			SetOrigin(v.Origin).
 			SetCollector(v.Collector).
+			SetNillableVerified(v.Verified).
+			SetNillableSigner(v.Signer).
 			SetKnownSince(v.KnownSince.UTC())
 	default:
 		log.Printf("Unknown spec: %+T", v)
@@ -285,6 +345,8 @@ func toModelCertifyBad(v *ent.Certification) *model.CertifyBad {
 		Justification: v.Justification,
 		Origin:        v.Origin,
 		Collector:     v.Collector,
+		Verified:      v.Verified,
+		Signer:        v.Signer,
 		Subject:       sub,
 		KnownSince:    v.KnownSince,
 	}
@@ -312,6 +374,8 @@ func toModelCertifyGood(v *ent.Certification) *model.CertifyGood {
 		Justification: v.Justification,
 		Origin:        v.Origin,
 		Collector:     v.Collector,
+		Verified:      v.Verified,
+		Signer:        v.Signer,
 		Subject:       sub,
 		KnownSince:    v.KnownSince,
 	}
@@ -43,8 +43,47 @@ import (
 
 func (b *EntBackend) CertifyLegal(ctx context.Context, spec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
 
-	records, err := b.client.CertifyLegal.Query().
-		Where(certifyLegalQuery(*spec)).
+	records, err := certifyLegalRecordsQuery(b.client, *spec).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(records, toModelCertifyLegal), nil
+}
+
+func (b *EntBackend) CertifyLegalList(ctx context.Context, spec *model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := certifyLegalRecordsQuery(b.client, *spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(certifylegal.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.CertifyLegal) int { return r.ID })
+
+	edges := make([]*model.CertifyLegalEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.CertifyLegalEdge{Cursor: nodeID(r.ID), Node: toModelCertifyLegal(r)})
+	}
+	return &model.CertifyLegalConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func certifyLegalRecordsQuery(client *ent.Client, spec model.CertifyLegalSpec) *ent.CertifyLegalQuery {
+	return client.CertifyLegal.Query().
+		Where(certifyLegalQuery(spec)).
 		WithPackage(func(q *ent.PackageVersionQuery) {
 			q.WithName(func(q *ent.PackageNameQuery) {
 				q.WithNamespace(func(q *ent.PackageNamespaceQuery) {
@@ -58,14 +97,7 @@ func (b *EntBackend) CertifyLegal(ctx context.Context, spec *model.CertifyLegalS
 			})
 		}).
 		WithDeclaredLicenses().
-		WithDiscoveredLicenses().
-		Limit(MaxPageSize).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(records, toModelCertifyLegal), nil
+		WithDiscoveredLicenses()
 }
 
 func (b *EntBackend) IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.LicenseInputSpec, discoveredLicensesList [][]*model.LicenseInputSpec, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error) {
@@ -99,7 +131,9 @@ func (b *EntBackend) IngestCertifyLegal(ctx context.Context, subject model.Packa
 			SetJustification(spec.Justification).
 			SetTimeScanned(spec.TimeScanned).
 			SetOrigin(spec.Origin).
-			SetCollector(spec.Collector)
+			SetCollector(spec.Collector).
+			SetNillableVerified(spec.Verified).
+			SetNillableSigner(spec.Signer)
 
 		certifyLegalConflictColumns := []string{
 			certifylegal.FieldDeclaredLicense,
@@ -99,7 +99,9 @@ func (b *EntBackend) IngestVEXStatement(ctx context.Context, subject model.Packa
 			SetStatusNotes(vexStatement.StatusNotes).
 			SetJustification(vexStatement.VexJustification.String()).
 			SetOrigin(vexStatement.Origin).
-			SetCollector(vexStatement.Collector)
+			SetCollector(vexStatement.Collector).
+			SetNillableVerified(vexStatement.Verified).
+			SetNillableSigner(vexStatement.Signer)
 
 		id, err := insert.
 			OnConflict(
@@ -171,9 +173,49 @@ func (b *EntBackend) IngestVEXStatements(ctx context.Context, subjects model.Pac
 func (b *EntBackend) CertifyVEXStatement(ctx context.Context, spec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
 	funcName := "CertifyVEXStatement"
 
-	query := b.client.CertifyVex.Query()
-	records, err := query.
-		Where(certifyVexPredicate(*spec)).
+	records, err := certifyVexQuery(b.client, *spec).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+
+	return collect(records, toModelCertifyVEXStatement), nil
+}
+
+func (b *EntBackend) CertifyVEXStatementList(ctx context.Context, spec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	funcName := "CertifyVEXStatementList"
+
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	query := certifyVexQuery(b.client, *spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(certifyvex.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.CertifyVex) int { return r.ID })
+
+	edges := make([]*model.CertifyVEXStatementEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.CertifyVEXStatementEdge{Cursor: nodeID(r.ID), Node: toModelCertifyVEXStatement(r)})
+	}
+	return &model.CertifyVEXStatementConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func certifyVexQuery(client *ent.Client, spec model.CertifyVEXStatementSpec) *ent.CertifyVexQuery {
+	return client.CertifyVex.Query().
+		Where(certifyVexPredicate(spec)).
 		WithVulnerability(func(q *ent.VulnerabilityIDQuery) {
 			q.WithType()
 		}).
@@ -184,14 +226,7 @@ func (b *EntBackend) CertifyVEXStatement(ctx context.Context, spec *model.Certif
 				})
 			})
 		}).
-		WithArtifact().
-		Limit(MaxPageSize).
-		All(ctx)
-	if err != nil {
-		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-	}
-
-	return collect(records, toModelCertifyVEXStatement), nil
+		WithArtifact()
 }
 
 func toModelCertifyVEXStatement(record *ent.CertifyVex) *model.CertifyVEXStatement {
@@ -206,6 +241,8 @@ func toModelCertifyVEXStatement(record *ent.CertifyVex) *model.CertifyVEXStateme
 		VexJustification: model.VexJustification(record.Justification),
 		Origin:           record.Origin,
 		Collector:        record.Collector,
+		Verified:         record.Verified,
+		Signer:           record.Signer,
 	}
 
 }
@@ -64,6 +64,8 @@ func (b *EntBackend) IngestCertifyVuln(ctx context.Context, pkg model.PkgInputSp
 		insert.
 			SetPackage(pv).
 			SetCollector(certifyVuln.Collector).
+			SetNillableVerified(certifyVuln.Verified).
+			SetNillableSigner(certifyVuln.Signer).
 			SetDbURI(certifyVuln.DbURI).
 			SetDbVersion(certifyVuln.DbVersion).
 			SetOrigin(certifyVuln.Origin).
@@ -144,6 +146,43 @@ func getVulnerabilityFromInput(ctx context.Context, client *ent.Client, spec mod
 }
 
 func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	records, err := certifyVulnQuery(b.client, spec).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(records, toModelCertifyVulnerability), nil
+}
+
+func (b *EntBackend) CertifyVulnList(ctx context.Context, spec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := certifyVulnQuery(b.client, spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(certifyvuln.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.CertifyVuln) int { return r.ID })
+
+	edges := make([]*model.CertifyVulnEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.CertifyVulnEdge{Cursor: nodeID(r.ID), Node: toModelCertifyVulnerability(r)})
+	}
+	return &model.CertifyVulnConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func certifyVulnQuery(client *ent.Client, spec *model.CertifyVulnSpec) *ent.CertifyVulnQuery {
 	predicates := []predicate.CertifyVuln{
 		optionalPredicate(spec.ID, IDEQ),
 		optionalPredicate(spec.Collector, certifyvuln.CollectorEQ),
@@ -199,7 +238,7 @@ func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpe
 		}
 	}
 
-	records, err := b.client.CertifyVuln.Query().
+	return client.CertifyVuln.Query().
 		Where(predicates...).
 		WithPackage(func(q *ent.PackageVersionQuery) {
 			q.WithName(func(q *ent.PackageNameQuery) {
@@ -210,13 +249,7 @@ func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpe
 		}).
 		WithVulnerability(func(query *ent.VulnerabilityIDQuery) {
 			query.WithType()
-		}).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(records, toModelCertifyVulnerability), nil
+		})
 }
 
 func toModelCertifyVulnerability(record *ent.CertifyVuln) *model.CertifyVuln {
@@ -232,6 +265,8 @@ func toModelCertifyVulnerability(record *ent.CertifyVuln) *model.CertifyVuln {
 			ScannerVersion: record.ScannerVersion,
 			Origin:         record.Origin,
 			Collector:      record.Collector,
+			Verified:       record.Verified,
+			Signer:         record.Signer,
 		},
 	}
 
@@ -0,0 +1,224 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/scorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+)
+
+// Evidence records only reference the software trees, and the join tables of
+// their many-to-many edges cascade on delete, so removing a record also
+// removes all its edges.
+
+func (b *EntBackend) DeleteCertifyBad(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteCertifyBad", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.Certification.Delete().Where(certification.ID(id), certification.TypeEQ(certification.TypeBAD)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteCertifyGood(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteCertifyGood", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.Certification.Delete().Where(certification.ID(id), certification.TypeEQ(certification.TypeGOOD)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteCertifyLegal(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteCertifyLegal", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.CertifyLegal.Delete().Where(certifylegal.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteCertifyScorecard(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteCertifyScorecard", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		deleted, err := tx.CertifyScorecard.Delete().Where(certifyscorecard.ID(id)).Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+		// The scorecard itself is shared by the certifications with the
+		// same results, only drop it once nothing points at it anymore.
+		if _, err := tx.Scorecard.Delete().Where(scorecard.Not(scorecard.HasCertifications())).Exec(ctx); err != nil {
+			return 0, err
+		}
+		return deleted, nil
+	})
+}
+
+func (b *EntBackend) DeleteCertifyVEXStatement(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteCertifyVEXStatement", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.CertifyVex.Delete().Where(certifyvex.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteCertifyVuln(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteCertifyVuln", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.CertifyVuln.Delete().Where(certifyvuln.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteHasMetadata(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteHasMetadata", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.HasMetadata.Delete().Where(hasmetadata.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteHasSbom(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteHasSbom", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.BillOfMaterials.Delete().Where(billofmaterials.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteHasSlsa(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteHasSlsa", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.SLSAAttestation.Delete().Where(slsaattestation.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteHasSourceAt(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteHasSourceAt", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.HasSourceAt.Delete().Where(hassourceat.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteHashEqual(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteHashEqual", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.HashEqual.Delete().Where(hashequal.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteIsDependency(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteIsDependency", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.Dependency.Delete().Where(dependency.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteIsOccurrence(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteIsOccurrence", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.Occurrence.Delete().Where(occurrence.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeletePkgEqual(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeletePkgEqual", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.PkgEqual.Delete().Where(pkgequal.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeletePointOfContact(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeletePointOfContact", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.PointOfContact.Delete().Where(pointofcontact.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteVulnEqual(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteVulnEqual", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.VulnEqual.Delete().Where(vulnequal.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) DeleteVulnerabilityMetadata(ctx context.Context, id string) (bool, error) {
+	return deleteByID(ctx, b.client, "DeleteVulnerabilityMetadata", id, func(ctx context.Context, tx *ent.Tx, id int) (int, error) {
+		return tx.VulnerabilityMetadata.Delete().Where(vulnerabilitymetadata.ID(id)).Exec(ctx)
+	})
+}
+
+func (b *EntBackend) RetractEvidence(ctx context.Context, origin string) (int, error) {
+	funcName := "RetractEvidence"
+	deleted, err := WithinTX(ctx, b.client, func(ctx context.Context) (*int, error) {
+		tx := ent.TxFromContext(ctx)
+		deletes := []func() (int, error){
+			func() (int, error) { return tx.Certification.Delete().Where(certification.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.CertifyLegal.Delete().Where(certifylegal.Origin(origin)).Exec(ctx) },
+			func() (int, error) {
+				return tx.CertifyScorecard.Delete().Where(certifyscorecard.HasScorecardWith(scorecard.Origin(origin))).Exec(ctx)
+			},
+			func() (int, error) { return tx.CertifyVex.Delete().Where(certifyvex.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.CertifyVuln.Delete().Where(certifyvuln.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.HasMetadata.Delete().Where(hasmetadata.Origin(origin)).Exec(ctx) },
+			func() (int, error) {
+				return tx.BillOfMaterials.Delete().Where(billofmaterials.Origin(origin)).Exec(ctx)
+			},
+			func() (int, error) {
+				return tx.SLSAAttestation.Delete().Where(slsaattestation.Origin(origin)).Exec(ctx)
+			},
+			func() (int, error) { return tx.HasSourceAt.Delete().Where(hassourceat.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.HashEqual.Delete().Where(hashequal.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.Dependency.Delete().Where(dependency.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.Occurrence.Delete().Where(occurrence.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.PkgEqual.Delete().Where(pkgequal.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.PointOfContact.Delete().Where(pointofcontact.Origin(origin)).Exec(ctx) },
+			func() (int, error) { return tx.VulnEqual.Delete().Where(vulnequal.Origin(origin)).Exec(ctx) },
+			func() (int, error) {
+				return tx.VulnerabilityMetadata.Delete().Where(vulnerabilitymetadata.Origin(origin)).Exec(ctx)
+			},
+		}
+		total := 0
+		for _, del := range deletes {
+			n, err := del()
+			if err != nil {
+				return nil, err
+			}
+			total += n
+		}
+		if _, err := tx.Scorecard.Delete().Where(scorecard.Not(scorecard.HasCertifications())).Exec(ctx); err != nil {
+			return nil, err
+		}
+		return &total, nil
+	})
+	if err != nil {
+		return 0, Errorf("%v :: %s", funcName, err)
+	}
+	return *deleted, nil
+}
+
+// deleteByID parses the ID of an evidence node and runs del inside a
+// transaction. It reports whether a record was deleted.
+func deleteByID(ctx context.Context, client *ent.Client, funcName string, id string, del func(ctx context.Context, tx *ent.Tx, id int) (int, error)) (bool, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return false, Errorf("%v :: invalid ID %q: %s", funcName, id, err)
+	}
+	deleted, err := WithinTX(ctx, client, func(ctx context.Context) (*int, error) {
+		n, err := del(ctx, ent.TxFromContext(ctx), intID)
+		if err != nil {
+			return nil, err
+		}
+		return &n, nil
+	})
+	if err != nil {
+		return false, Errorf("%v :: %s", funcName, err)
+	}
+	return *deleted > 0, nil
+}
@@ -0,0 +1,80 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend
+
+import (
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (s *Suite) Test_DeleteCertifyBad() {
+	be, err := GetBackend(s.Client)
+	s.Require().NoError(err)
+
+	_, err = be.IngestPackage(s.Ctx, *p1)
+	s.Require().NoError(err)
+	sub := model.PackageSourceOrArtifactInput{Package: p1}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	id, err := be.IngestCertifyBad(s.Ctx, sub, match, model.CertifyBadInputSpec{Justification: "test justification"})
+	s.Require().NoError(err)
+
+	deleted, err := be.DeleteCertifyGood(s.Ctx, id)
+	s.NoError(err)
+	s.False(deleted, "a CertifyBad must not be deleted as a CertifyGood")
+
+	deleted, err = be.DeleteCertifyBad(s.Ctx, id)
+	s.NoError(err)
+	s.True(deleted)
+
+	got, err := be.CertifyBad(s.Ctx, &model.CertifyBadSpec{})
+	s.NoError(err)
+	s.Empty(got)
+
+	deleted, err = be.DeleteCertifyBad(s.Ctx, id)
+	s.NoError(err)
+	s.False(deleted)
+}
+
+func (s *Suite) Test_RetractEvidence() {
+	be, err := GetBackend(s.Client)
+	s.Require().NoError(err)
+
+	for _, p := range []*model.PkgInputSpec{p1, p2} {
+		_, err := be.IngestPackage(s.Ctx, *p)
+		s.Require().NoError(err)
+	}
+	match := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	_, err = be.IngestDependency(s.Ctx, *p1, *p2, match, model.IsDependencyInputSpec{Justification: "dep", Origin: "sbom.json"})
+	s.Require().NoError(err)
+	_, err = be.IngestCertifyBad(s.Ctx, model.PackageSourceOrArtifactInput{Package: p1}, &match, model.CertifyBadInputSpec{Justification: "bad", Origin: "sbom.json"})
+	s.Require().NoError(err)
+	goodID, err := be.IngestCertifyGood(s.Ctx, model.PackageSourceOrArtifactInput{Package: p1}, &match, model.CertifyGoodInputSpec{Justification: "good", Origin: "other.json"})
+	s.Require().NoError(err)
+
+	retracted, err := be.RetractEvidence(s.Ctx, "sbom.json")
+	s.NoError(err)
+	s.Equal(2, retracted)
+
+	deps, err := be.IsDependency(s.Ctx, &model.IsDependencySpec{})
+	s.NoError(err)
+	s.Empty(deps)
+	goods, err := be.CertifyGood(s.Ctx, &model.CertifyGoodSpec{})
+	s.NoError(err)
+	if s.Len(goods, 1) {
+		s.Equal(goodID, goods[0].ID)
+	}
+}
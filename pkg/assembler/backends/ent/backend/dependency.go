@@ -21,6 +21,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
@@ -32,7 +33,49 @@ func (b *EntBackend) IsDependency(ctx context.Context, spec *model.IsDependencyS
 		return nil, nil
 	}
 
-	query := b.client.Dependency.Query().Order(ent.Asc(dependency.FieldID)).Limit(MaxPageSize)
+	deps, err := dependencyQuery(b.client, spec).
+		Order(ent.Asc(dependency.FieldID)).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	return collect(deps, toModelIsDependencyWithBackrefs), nil
+}
+
+func (b *EntBackend) IsDependencyList(ctx context.Context, spec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	funcName := "IsDependencyList"
+
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	query := dependencyQuery(b.client, spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(dependency.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.Dependency) int { return r.ID })
+
+	edges := make([]*model.IsDependencyEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.IsDependencyEdge{Cursor: nodeID(r.ID), Node: toModelIsDependencyWithBackrefs(r)})
+	}
+	return &model.IsDependencyConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func dependencyQuery(client *ent.Client, spec *model.IsDependencySpec) *ent.DependencyQuery {
+	query := client.Dependency.Query()
 	query.Where(
 		optionalPredicate(spec.ID, IDEQ),
 		optionalPredicate(spec.VersionRange, dependency.VersionRange),
@@ -59,17 +102,21 @@ func (b *EntBackend) IsDependency(ctx context.Context, spec *model.IsDependencyS
 	if spec.DependencyType != nil {
 		query.Where(dependency.DependencyTypeEQ(dependencyTypeToEnum(*spec.DependencyType)))
 	}
+	if spec.DependencyScope != nil {
+		query.Where(dependency.DependencyScopeEQ(dependencyScopeToEnum(*spec.DependencyScope)))
+	}
+	if len(spec.ExcludeDependencyScopes) > 0 {
+		excluded := make([]dependency.DependencyScope, 0, len(spec.ExcludeDependencyScopes))
+		for _, scope := range spec.ExcludeDependencyScopes {
+			excluded = append(excluded, dependencyScopeToEnum(scope))
+		}
+		query.Where(dependency.DependencyScopeNotIn(excluded...))
+	}
 
-	deps, err := query.
+	return query.
 		WithPackage(withPackageVersionTree()).
 		WithDependentPackageName(withPackageNameTree()).
-		WithDependentPackageVersion(withPackageVersionTree()).
-		All(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, funcName)
-	}
-
-	return collect(deps, toModelIsDependencyWithBackrefs), nil
+		WithDependentPackageVersion(withPackageVersionTree())
 }
 
 func (b *EntBackend) IngestDependencies(ctx context.Context, pkgs []*model.PkgInputSpec, depPkgs []*model.PkgInputSpec, depPkgMatchType model.MatchFlags, dependencies []*model.IsDependencyInputSpec) ([]string, error) {
@@ -110,14 +157,18 @@ func (b *EntBackend) IngestDependency(ctx context.Context, pkg model.PkgInputSpe
 			SetPackage(p).
 			SetVersionRange(dep.VersionRange).
 			SetDependencyType(dependencyTypeToEnum(dep.DependencyType)).
+			SetDependencyScope(dependencyScopeToEnum(helper.DependencyScopeOrUnknown(dep.DependencyScope))).
 			SetJustification(dep.Justification).
 			SetOrigin(dep.Origin).
-			SetCollector(dep.Collector)
+			SetCollector(dep.Collector).
+			SetNillableVerified(dep.Verified).
+			SetNillableSigner(dep.Signer)
 
 		conflictColumns := []string{
 			dependency.FieldPackageID,
 			dependency.FieldVersionRange,
 			dependency.FieldDependencyType,
+			dependency.FieldDependencyScope,
 			dependency.FieldJustification,
 			dependency.FieldOrigin,
 			dependency.FieldCollector,
@@ -178,3 +229,22 @@ func dependencyTypeToEnum(t model.DependencyType) dependency.DependencyType {
 		return dependency.DependencyTypeUNKNOWN
 	}
 }
+
+func dependencyScopeToEnum(s model.DependencyScope) dependency.DependencyScope {
+	switch s {
+	case model.DependencyScopeRuntime:
+		return dependency.DependencyScopeRUNTIME
+	case model.DependencyScopeDev:
+		return dependency.DependencyScopeDEV
+	case model.DependencyScopeBuild:
+		return dependency.DependencyScopeBUILD
+	case model.DependencyScopeTest:
+		return dependency.DependencyScopeTEST
+	case model.DependencyScopeOptional:
+		return dependency.DependencyScopeOPTIONAL
+	case model.DependencyScopeProvided:
+		return dependency.DependencyScopePROVIDED
+	default:
+		return dependency.DependencyScopeUNKNOWN
+	}
+}
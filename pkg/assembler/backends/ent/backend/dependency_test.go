@@ -67,6 +67,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -100,6 +101,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -133,6 +135,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -166,6 +169,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification one",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -197,6 +201,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 			},
@@ -227,6 +232,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p2out,
 					DependencyPackage: p4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 			},
@@ -257,11 +263,13 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p1out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 				{
 					Package:           p3out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 			},
@@ -295,6 +303,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p3out,
 					DependencyPackage: p4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 			},
@@ -359,6 +368,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p2out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 			},
@@ -391,6 +401,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p1out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					VersionRange:      "1-3",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -424,6 +435,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p2out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeIndirect,
 				},
 			},
@@ -502,6 +514,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p3out,
 					DependencyPackage: p2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -527,6 +540,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p3out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -560,12 +574,14 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p3out,
 					DependencyPackage: p2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 				{
 					Package:           p3out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -600,6 +616,7 @@ func (s *Suite) TestIsDependency() {
 				{
 					Package:           p2out,
 					DependencyPackage: p4out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 					VersionRange:      "v3.0.3",
@@ -714,12 +731,14 @@ func (s *Suite) TestIngestDependencies() {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
 				{
 					Package:           p2out,
 					DependencyPackage: p4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 					DependencyType:    model.DependencyTypeUnknown,
 				},
@@ -32,13 +32,8 @@ import (
 )
 
 func (b *EntBackend) HasMetadata(ctx context.Context, filter *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
-	records, err := b.client.HasMetadata.Query().
-		Where(hasMetadataPredicate(filter)).
+	records, err := hasMetadataQuery(b.client, filter).
 		Limit(MaxPageSize).
-		WithSource(withSourceNameTreeQuery()).
-		WithArtifact().
-		WithPackageVersion(withPackageVersionTree()).
-		WithAllVersions(withPackageNameTree()).
 		All(ctx)
 
 	if err != nil {
@@ -48,6 +43,43 @@ func (b *EntBackend) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 	return collect(records, toModelHasMetadata), nil
 }
 
+func (b *EntBackend) HasMetadataList(ctx context.Context, filter *model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve HasMetadataList :: %s", err)
+	}
+	query := hasMetadataQuery(b.client, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve HasMetadataList :: %s", err)
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(hasmetadata.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve HasMetadataList :: %s", err)
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.HasMetadata) int { return r.ID })
+
+	edges := make([]*model.HasMetadataEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.HasMetadataEdge{Cursor: nodeID(r.ID), Node: toModelHasMetadata(r)})
+	}
+	return &model.HasMetadataConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func hasMetadataQuery(client *ent.Client, filter *model.HasMetadataSpec) *ent.HasMetadataQuery {
+	return client.HasMetadata.Query().
+		Where(hasMetadataPredicate(filter)).
+		WithSource(withSourceNameTreeQuery()).
+		WithArtifact().
+		WithPackageVersion(withPackageVersionTree()).
+		WithAllVersions(withPackageNameTree())
+}
+
 func (b *EntBackend) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
 	recordID, err := WithinTX(ctx, b.client, func(ctx context.Context) (*int, error) {
 		return upsertHasMetadata(ctx, ent.TxFromContext(ctx), subject, pkgMatchType, hasMetadata)
@@ -123,7 +155,9 @@ func upsertHasMetadata(ctx context.Context, client *ent.Tx, subject model.Packag
 		SetTimestamp(spec.Timestamp.UTC()).
 		SetJustification(spec.Justification).
 		SetOrigin(spec.Origin).
-		SetCollector(spec.Collector)
+		SetCollector(spec.Collector).
+		SetNillableVerified(spec.Verified).
+		SetNillableSigner(spec.Signer)
 
 	conflictColumns := []string{
 		hasmetadata.FieldKey,
@@ -240,6 +274,8 @@ func toModelHasMetadata(v *ent.HasMetadata) *model.HasMetadata {
 		Justification: v.Justification,
 		Origin:        v.Origin,
 		Collector:     v.Collector,
+		Verified:      v.Verified,
+		Signer:        v.Signer,
 	}
 }
 
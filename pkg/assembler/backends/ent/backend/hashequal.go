@@ -29,7 +29,44 @@ func (b *EntBackend) HashEqual(ctx context.Context, spec *model.HashEqualSpec) (
 		return nil, nil
 	}
 
-	query := b.client.HashEqual.Query().Where(
+	records, err := hashEqualQuery(b.client, spec).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(records, toModelHashEqual), nil
+}
+
+func (b *EntBackend) HashEqualList(ctx context.Context, spec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := hashEqualQuery(b.client, spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(hashequal.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.HashEqual) int { return r.ID })
+
+	edges := make([]*model.HashEqualEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.HashEqualEdge{Cursor: nodeID(r.ID), Node: toModelHashEqual(r)})
+	}
+	return &model.HashEqualConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func hashEqualQuery(client *ent.Client, spec *model.HashEqualSpec) *ent.HashEqualQuery {
+	query := client.HashEqual.Query().Where(
 		optionalPredicate(spec.ID, IDEQ),
 		optionalPredicate(spec.Origin, hashequal.OriginEQ),
 		optionalPredicate(spec.Collector, hashequal.CollectorEQ),
@@ -40,14 +77,7 @@ func (b *EntBackend) HashEqual(ctx context.Context, spec *model.HashEqualSpec) (
 		query.Where(hashequal.HasArtifactsWith(artifactQueryPredicates(art)))
 	}
 
-	records, err := query.
-		WithArtifacts().
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(records, toModelHashEqual), nil
+	return query.WithArtifacts()
 }
 
 func (b *EntBackend) IngestHashEqual(ctx context.Context, artifact model.ArtifactInputSpec, equalArtifact model.ArtifactInputSpec, spec model.HashEqualInputSpec) (string, error) {
@@ -96,6 +126,8 @@ func upsertHashEqual(ctx context.Context, client *ent.Tx, artifactA model.Artifa
 			SetJustification(spec.Justification).
 			SetOrigin(spec.Origin).
 			SetCollector(spec.Collector).
+			SetNillableVerified(spec.Verified).
+			SetNillableSigner(spec.Signer).
 			Save(ctx)
 		if err != nil {
 			return nil, err
@@ -111,6 +143,8 @@ func toModelHashEqual(record *ent.HashEqual) *model.HashEqual {
 		Artifacts:     collect(record.Edges.Artifacts, toModelArtifact),
 		Justification: record.Justification,
 		Collector:     record.Collector,
+		Verified:      record.Verified,
+		Signer:        record.Signer,
 		Origin:        record.Origin,
 	}
 }
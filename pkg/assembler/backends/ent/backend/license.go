@@ -65,6 +65,35 @@ func (b *EntBackend) Licenses(ctx context.Context, filter *model.LicenseSpec) ([
 	return collect(records, toModelLicense), nil
 }
 
+func (b *EntBackend) LicensesList(ctx context.Context, filter *model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := b.client.License.Query().
+		Where(licenseQuery(*filter))
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(license.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(l *ent.License) int { return l.ID })
+
+	edges := make([]*model.LicenseEdge, 0, len(records))
+	for _, l := range records {
+		edges = append(edges, &model.LicenseEdge{Cursor: nodeID(l.ID), Node: toModelLicense(l)})
+	}
+	return &model.LicenseConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
 func getLicenses(ctx context.Context, client *ent.Client, filter model.LicenseSpec) ([]*ent.License, error) {
 	results, err := client.License.Query().
 		Where(licenseQuery(filter)).
@@ -20,7 +20,9 @@ import (
 	"database/sql"
 	"fmt"
 
+	"ariga.io/atlas/sql/schema"
 	"entgo.io/ent/dialect"
+	entschema "entgo.io/ent/dialect/sql/schema"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/migrate"
 	"github.com/guacsec/guac/pkg/logging"
@@ -63,10 +65,14 @@ func SetupBackend(ctx context.Context, options *BackendOptions) (*ent.Client, er
 			migrate.WithGlobalUniqueID(true),
 			migrate.WithDropIndex(true),
 			migrate.WithDropColumn(true),
+			entschema.WithDiffHook(keepTextSearchIndexes),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error creating ent schema: %w", err)
 		}
+		if err := createTextSearchIndexes(ctx, db); err != nil {
+			return nil, err
+		}
 
 		logger.Infof("ent migrations complete")
 	} else {
@@ -75,3 +81,46 @@ func SetupBackend(ctx context.Context, options *BackendOptions) (*ent.Client, er
 
 	return client, nil
 }
+
+// createTextSearchIndexes creates the indexes used by the text search of
+// FindSoftware, which are not part of the ent schema.
+func createTextSearchIndexes(ctx context.Context, db *sql.DB) error {
+	for _, idx := range textSearchIndexes {
+		stmt := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (to_tsvector('simple', %s))", idx.name, idx.table, idx.column)
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("error creating text search index %s: %w", idx.name, err)
+		}
+	}
+	return nil
+}
+
+// keepTextSearchIndexes keeps the migration from dropping the text search
+// indexes, which it would otherwise do with WithDropIndex as they are not
+// part of the ent schema.
+func keepTextSearchIndexes(next entschema.Differ) entschema.Differ {
+	keep := map[string]bool{}
+	for _, idx := range textSearchIndexes {
+		keep[idx.name] = true
+	}
+	return entschema.DiffFunc(func(current, desired *schema.Schema) ([]schema.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			modify, ok := c.(*schema.ModifyTable)
+			if !ok {
+				continue
+			}
+			kept := modify.Changes[:0]
+			for _, tc := range modify.Changes {
+				if drop, ok := tc.(*schema.DropIndex); ok && keep[drop.I.Name] {
+					continue
+				}
+				kept = append(kept, tc)
+			}
+			modify.Changes = kept
+		}
+		return changes, nil
+	})
+}
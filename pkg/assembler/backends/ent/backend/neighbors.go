@@ -17,22 +17,222 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/license"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagenamespace"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagetype"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcenamespace"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcetype"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
 
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (b *EntBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
-	return nil, nil
+	id, err := strconv.Atoi(node)
+	if err != nil {
+		return nil, err
+	}
+	neighbors, err := b.neighborsFromID(ctx, id, processUsingOnly(usingOnly))
+	if err != nil {
+		return nil, err
+	}
+	return b.Nodes(ctx, collect(neighbors, nodeID))
+}
+
+// neighborIDs collects the IDs of the neighbors of a node, over the edges
+// that are allowed.
+type neighborIDs struct {
+	allowedEdges edgeMap
+	ids          []int
+	err          error
+}
+
+// add adds the IDs of the nodes at the other end of edge. Zero IDs are unset
+// optional edges and are skipped.
+func (n *neighborIDs) add(edge model.Edge, ids ...int) {
+	if !n.allowedEdges[edge] {
+		return
+	}
+	for _, id := range ids {
+		if id != 0 {
+			n.ids = append(n.ids, id)
+		}
+	}
+}
+
+// query adds the IDs returned by query, for edges whose other end does not
+// hold a reference back to the node.
+func (n *neighborIDs) query(ctx context.Context, edge model.Edge, query func(context.Context) ([]int, error)) {
+	if n.err != nil || !n.allowedEdges[edge] {
+		return
+	}
+	ids, err := query(ctx)
+	if err != nil {
+		n.err = err
+		return
+	}
+	n.add(edge, ids...)
+}
+
+func (b *EntBackend) neighborsFromID(ctx context.Context, id int, allowedEdges edgeMap) ([]int, error) {
+	record, err := b.client.Noder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &neighborIDs{allowedEdges: allowedEdges}
+	client := b.client
+	switch v := record.(type) {
+	case *ent.Artifact:
+		n.query(ctx, model.EdgeArtifactCertifyBad, client.Certification.Query().Where(certification.ArtifactID(id), certification.TypeEQ(certification.TypeBAD)).IDs)
+		n.query(ctx, model.EdgeArtifactCertifyGood, client.Certification.Query().Where(certification.ArtifactID(id), certification.TypeEQ(certification.TypeGOOD)).IDs)
+		n.query(ctx, model.EdgeArtifactCertifyVexStatement, client.CertifyVex.Query().Where(certifyvex.ArtifactID(id)).IDs)
+		n.query(ctx, model.EdgeArtifactHashEqual, client.HashEqual.Query().Where(hashequal.HasArtifactsWith(artifact.ID(id))).IDs)
+		n.query(ctx, model.EdgeArtifactHasMetadata, client.HasMetadata.Query().Where(hasmetadata.ArtifactID(id)).IDs)
+		n.query(ctx, model.EdgeArtifactHasSbom, client.BillOfMaterials.Query().Where(billofmaterials.ArtifactID(id)).IDs)
+		n.query(ctx, model.EdgeArtifactHasSlsa, client.SLSAAttestation.Query().Where(slsaattestation.Or(slsaattestation.SubjectID(id), slsaattestation.HasBuiltFromWith(artifact.ID(id)))).IDs)
+		n.query(ctx, model.EdgeArtifactIsOccurrence, client.Occurrence.Query().Where(occurrence.ArtifactID(id)).IDs)
+		n.query(ctx, model.EdgeArtifactPointOfContact, client.PointOfContact.Query().Where(pointofcontact.ArtifactID(id)).IDs)
+	case *ent.Builder:
+		n.query(ctx, model.EdgeBuilderHasSlsa, client.SLSAAttestation.Query().Where(slsaattestation.BuiltByID(id)).IDs)
+	case *ent.License:
+		n.query(ctx, model.EdgeLicenseCertifyLegal, client.CertifyLegal.Query().Where(certifylegal.Or(certifylegal.HasDeclaredLicensesWith(license.ID(id)), certifylegal.HasDiscoveredLicensesWith(license.ID(id)))).IDs)
+	case *ent.PackageType:
+		n.query(ctx, model.EdgePackageTypePackageNamespace, client.PackageNamespace.Query().Where(packagenamespace.PackageID(id)).IDs)
+	case *ent.PackageNamespace:
+		n.add(model.EdgePackageNamespacePackageType, v.PackageID)
+		n.query(ctx, model.EdgePackageNamespacePackageName, client.PackageName.Query().Where(packagename.NamespaceID(id)).IDs)
+	case *ent.PackageName:
+		n.add(model.EdgePackageNamePackageNamespace, v.NamespaceID)
+		n.query(ctx, model.EdgePackageNamePackageVersion, client.PackageVersion.Query().Where(packageversion.NameID(id)).IDs)
+		n.query(ctx, model.EdgePackageCertifyBad, client.Certification.Query().Where(certification.PackageNameID(id), certification.TypeEQ(certification.TypeBAD)).IDs)
+		n.query(ctx, model.EdgePackageCertifyGood, client.Certification.Query().Where(certification.PackageNameID(id), certification.TypeEQ(certification.TypeGOOD)).IDs)
+		n.query(ctx, model.EdgePackageHasMetadata, client.HasMetadata.Query().Where(hasmetadata.PackageNameID(id)).IDs)
+		n.query(ctx, model.EdgePackageHasSourceAt, client.HasSourceAt.Query().Where(hassourceat.PackageNameID(id)).IDs)
+		n.query(ctx, model.EdgePackageIsDependency, client.Dependency.Query().Where(dependency.DependentPackageNameID(id)).IDs)
+		n.query(ctx, model.EdgePackagePointOfContact, client.PointOfContact.Query().Where(pointofcontact.PackageNameID(id)).IDs)
+	case *ent.PackageVersion:
+		n.add(model.EdgePackageVersionPackageName, v.NameID)
+		n.query(ctx, model.EdgePackageCertifyBad, client.Certification.Query().Where(certification.PackageVersionID(id), certification.TypeEQ(certification.TypeBAD)).IDs)
+		n.query(ctx, model.EdgePackageCertifyGood, client.Certification.Query().Where(certification.PackageVersionID(id), certification.TypeEQ(certification.TypeGOOD)).IDs)
+		n.query(ctx, model.EdgePackageCertifyLegal, client.CertifyLegal.Query().Where(certifylegal.PackageID(id)).IDs)
+		n.query(ctx, model.EdgePackageCertifyVexStatement, client.CertifyVex.Query().Where(certifyvex.PackageID(id)).IDs)
+		n.query(ctx, model.EdgePackageCertifyVuln, client.CertifyVuln.Query().Where(certifyvuln.PackageID(id)).IDs)
+		n.query(ctx, model.EdgePackageHasMetadata, client.HasMetadata.Query().Where(hasmetadata.PackageVersionID(id)).IDs)
+		n.query(ctx, model.EdgePackageHasSbom, client.BillOfMaterials.Query().Where(billofmaterials.PackageID(id)).IDs)
+		n.query(ctx, model.EdgePackageHasSourceAt, client.HasSourceAt.Query().Where(hassourceat.PackageVersionID(id)).IDs)
+		n.query(ctx, model.EdgePackageIsDependency, client.Dependency.Query().Where(dependency.Or(dependency.PackageID(id), dependency.DependentPackageVersionID(id))).IDs)
+		n.query(ctx, model.EdgePackageIsOccurrence, client.Occurrence.Query().Where(occurrence.PackageID(id)).IDs)
+		n.query(ctx, model.EdgePackagePkgEqual, client.PkgEqual.Query().Where(pkgequal.HasPackagesWith(packageversion.ID(id))).IDs)
+		n.query(ctx, model.EdgePackagePointOfContact, client.PointOfContact.Query().Where(pointofcontact.PackageVersionID(id)).IDs)
+	case *ent.SourceType:
+		n.query(ctx, model.EdgeSourceTypeSourceNamespace, client.SourceNamespace.Query().Where(sourcenamespace.SourceID(id)).IDs)
+	case *ent.SourceNamespace:
+		n.add(model.EdgeSourceNamespaceSourceType, v.SourceID)
+		n.query(ctx, model.EdgeSourceNamespaceSourceName, client.SourceName.Query().Where(sourcename.NamespaceID(id)).IDs)
+	case *ent.SourceName:
+		n.add(model.EdgeSourceNameSourceNamespace, v.NamespaceID)
+		n.query(ctx, model.EdgeSourceCertifyBad, client.Certification.Query().Where(certification.SourceID(id), certification.TypeEQ(certification.TypeBAD)).IDs)
+		n.query(ctx, model.EdgeSourceCertifyGood, client.Certification.Query().Where(certification.SourceID(id), certification.TypeEQ(certification.TypeGOOD)).IDs)
+		n.query(ctx, model.EdgeSourceCertifyLegal, client.CertifyLegal.Query().Where(certifylegal.SourceID(id)).IDs)
+		n.query(ctx, model.EdgeSourceCertifyScorecard, client.CertifyScorecard.Query().Where(certifyscorecard.SourceID(id)).IDs)
+		n.query(ctx, model.EdgeSourceHasMetadata, client.HasMetadata.Query().Where(hasmetadata.SourceID(id)).IDs)
+		n.query(ctx, model.EdgeSourceHasSourceAt, client.HasSourceAt.Query().Where(hassourceat.SourceID(id)).IDs)
+		n.query(ctx, model.EdgeSourceIsOccurrence, client.Occurrence.Query().Where(occurrence.SourceID(id)).IDs)
+		n.query(ctx, model.EdgeSourcePointOfContact, client.PointOfContact.Query().Where(pointofcontact.SourceID(id)).IDs)
+	case *ent.VulnerabilityType:
+		n.query(ctx, model.EdgeVulnerabilityTypeVulnerabilityID, client.VulnerabilityID.Query().Where(vulnerabilityid.TypeID(id)).IDs)
+	case *ent.VulnerabilityID:
+		n.add(model.EdgeVulnerabilityIDVulnerabilityType, v.TypeID)
+		n.query(ctx, model.EdgeVulnerabilityCertifyVexStatement, client.CertifyVex.Query().Where(certifyvex.VulnerabilityID(id)).IDs)
+		n.query(ctx, model.EdgeVulnerabilityCertifyVuln, client.CertifyVuln.Query().Where(certifyvuln.VulnerabilityID(id)).IDs)
+		n.query(ctx, model.EdgeVulnerabilityVulnEqual, client.VulnEqual.Query().Where(vulnequal.HasVulnerabilityIdsWith(vulnerabilityid.ID(id))).IDs)
+		n.query(ctx, model.EdgeVulnerabilityVulnMetadata, client.VulnerabilityMetadata.Query().Where(vulnerabilitymetadata.VulnerabilityIDID(id)).IDs)
+	case *ent.Certification:
+		if v.Type == certification.TypeBAD {
+			n.add(model.EdgeCertifyBadArtifact, valueOrDefault(v.ArtifactID, 0))
+			n.add(model.EdgeCertifyBadPackage, valueOrDefault(v.PackageVersionID, 0), valueOrDefault(v.PackageNameID, 0))
+			n.add(model.EdgeCertifyBadSource, valueOrDefault(v.SourceID, 0))
+		} else {
+			n.add(model.EdgeCertifyGoodArtifact, valueOrDefault(v.ArtifactID, 0))
+			n.add(model.EdgeCertifyGoodPackage, valueOrDefault(v.PackageVersionID, 0), valueOrDefault(v.PackageNameID, 0))
+			n.add(model.EdgeCertifyGoodSource, valueOrDefault(v.SourceID, 0))
+		}
+	case *ent.CertifyLegal:
+		n.add(model.EdgeCertifyLegalPackage, valueOrDefault(v.PackageID, 0))
+		n.add(model.EdgeCertifyLegalSource, valueOrDefault(v.SourceID, 0))
+		n.query(ctx, model.EdgeCertifyLegalLicense, v.QueryDeclaredLicenses().IDs)
+		n.query(ctx, model.EdgeCertifyLegalLicense, v.QueryDiscoveredLicenses().IDs)
+	case *ent.CertifyScorecard:
+		n.add(model.EdgeCertifyScorecardSource, v.SourceID)
+	case *ent.CertifyVex:
+		n.add(model.EdgeCertifyVexStatementArtifact, valueOrDefault(v.ArtifactID, 0))
+		n.add(model.EdgeCertifyVexStatementPackage, valueOrDefault(v.PackageID, 0))
+		n.add(model.EdgeCertifyVexStatementVulnerability, v.VulnerabilityID)
+	case *ent.CertifyVuln:
+		n.add(model.EdgeCertifyVulnPackage, v.PackageID)
+		n.add(model.EdgeCertifyVulnVulnerability, v.VulnerabilityID)
+	case *ent.Dependency:
+		n.add(model.EdgeIsDependencyPackage, v.PackageID, v.DependentPackageNameID, v.DependentPackageVersionID)
+	case *ent.HashEqual:
+		n.query(ctx, model.EdgeHashEqualArtifact, v.QueryArtifacts().IDs)
+	case *ent.HasMetadata:
+		n.add(model.EdgeHasMetadataArtifact, valueOrDefault(v.ArtifactID, 0))
+		n.add(model.EdgeHasMetadataPackage, valueOrDefault(v.PackageVersionID, 0), valueOrDefault(v.PackageNameID, 0))
+		n.add(model.EdgeHasMetadataSource, valueOrDefault(v.SourceID, 0))
+	case *ent.BillOfMaterials:
+		n.add(model.EdgeHasSbomArtifact, valueOrDefault(v.ArtifactID, 0))
+		n.add(model.EdgeHasSbomPackage, valueOrDefault(v.PackageID, 0))
+	case *ent.HasSourceAt:
+		n.add(model.EdgeHasSourceAtPackage, valueOrDefault(v.PackageVersionID, 0), valueOrDefault(v.PackageNameID, 0))
+		n.add(model.EdgeHasSourceAtSource, v.SourceID)
+	case *ent.Occurrence:
+		n.add(model.EdgeIsOccurrenceArtifact, v.ArtifactID)
+		n.add(model.EdgeIsOccurrencePackage, valueOrDefault(v.PackageID, 0))
+		n.add(model.EdgeIsOccurrenceSource, valueOrDefault(v.SourceID, 0))
+	case *ent.PkgEqual:
+		n.query(ctx, model.EdgePkgEqualPackage, v.QueryPackages().IDs)
+	case *ent.PointOfContact:
+		n.add(model.EdgePointOfContactArtifact, valueOrDefault(v.ArtifactID, 0))
+		n.add(model.EdgePointOfContactPackage, valueOrDefault(v.PackageVersionID, 0), valueOrDefault(v.PackageNameID, 0))
+		n.add(model.EdgePointOfContactSource, valueOrDefault(v.SourceID, 0))
+	case *ent.SLSAAttestation:
+		n.add(model.EdgeHasSlsaBuiltBy, v.BuiltByID)
+		n.add(model.EdgeHasSlsaSubject, v.SubjectID)
+		n.query(ctx, model.EdgeHasSlsaMaterials, v.QueryBuiltFrom().IDs)
+	case *ent.VulnEqual:
+		n.query(ctx, model.EdgeVulnEqualVulnerability, v.QueryVulnerabilityIds().IDs)
+	case *ent.VulnerabilityMetadata:
+		n.add(model.EdgeVulnMetadataVulnerability, v.VulnerabilityIDID)
+	default:
+		log.Printf("Unknown node type: %T", v)
+	}
+	return n.ids, n.err
 }
 
 func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error) {
@@ -119,6 +319,70 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 		return toModelBuilder(v), nil
 	case *ent.VulnerabilityType:
 		return toModelVulnerability(v), nil
+	case *ent.SourceNamespace:
+		sns, err := b.client.SourceNamespace.Query().
+			Where(sourcenamespace.ID(v.ID)).
+			WithSourceType().
+			WithNames().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		st := sns.Edges.SourceType
+		st.Edges.Namespaces = []*ent.SourceNamespace{sns}
+		return toModelSource(st), nil
+	case *ent.VulnerabilityID:
+		vid, err := b.client.VulnerabilityID.Query().
+			Where(vulnerabilityid.ID(v.ID)).
+			WithType().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return toModelVulnerabilityFromVulnerabilityID(vid), nil
+	case *ent.License:
+		return onlyNode(b.Licenses(ctx, &model.LicenseSpec{ID: &node}))
+	case *ent.Certification:
+		if v.Type == certification.TypeBAD {
+			return onlyNode(b.CertifyBad(ctx, &model.CertifyBadSpec{ID: &node}))
+		}
+		return onlyNode(b.CertifyGood(ctx, &model.CertifyGoodSpec{ID: &node}))
+	case *ent.CertifyLegal:
+		return onlyNode(b.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: &node}))
+	case *ent.CertifyScorecard:
+		csc, err := scorecardQuery(b.client, &model.CertifyScorecardSpec{}).
+			Where(certifyscorecard.ID(v.ID)).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return toModelCertifyScorecard(csc), nil
+	case *ent.CertifyVex:
+		return onlyNode(b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &node}))
+	case *ent.CertifyVuln:
+		return onlyNode(b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &node}))
+	case *ent.Dependency:
+		return onlyNode(b.IsDependency(ctx, &model.IsDependencySpec{ID: &node}))
+	case *ent.HashEqual:
+		return onlyNode(b.HashEqual(ctx, &model.HashEqualSpec{ID: &node}))
+	case *ent.HasMetadata:
+		return onlyNode(b.HasMetadata(ctx, &model.HasMetadataSpec{ID: &node}))
+	case *ent.BillOfMaterials:
+		return onlyNode(b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &node}))
+	case *ent.HasSourceAt:
+		return onlyNode(b.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: &node}))
+	case *ent.Occurrence:
+		return onlyNode(b.IsOccurrence(ctx, &model.IsOccurrenceSpec{ID: &node}))
+	case *ent.PkgEqual:
+		return onlyNode(b.PkgEqual(ctx, &model.PkgEqualSpec{ID: &node}))
+	case *ent.PointOfContact:
+		return onlyNode(b.PointOfContact(ctx, &model.PointOfContactSpec{ID: &node}))
+	case *ent.SLSAAttestation:
+		return onlyNode(b.HasSlsa(ctx, &model.HasSLSASpec{ID: &node}))
+	case *ent.VulnEqual:
+		return onlyNode(b.VulnEqual(ctx, &model.VulnEqualSpec{ID: &node}))
+	case *ent.VulnerabilityMetadata:
+		return onlyNode(b.VulnerabilityMetadata(ctx, &model.VulnerabilityMetadataSpec{ID: &node}))
 	default:
 		log.Printf("Unknown node type: %T", v)
 	}
@@ -137,3 +401,14 @@ func (b *EntBackend) Nodes(ctx context.Context, nodes []string) ([]model.Node, e
 	}
 	return rv, nil
 }
+
+// onlyNode returns the single result of a query for a node by its ID.
+func onlyNode[T model.Node](results []T, err error) (model.Node, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected a single node, found %d", len(results))
+	}
+	return results[0], nil
+}
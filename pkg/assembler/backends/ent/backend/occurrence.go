@@ -32,7 +32,48 @@ import (
 )
 
 func (b *EntBackend) IsOccurrence(ctx context.Context, query *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	records, err := occurrenceQuery(b.client, query).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.IsOccurrence, len(records))
+	for i, record := range records {
+		models[i] = toModelIsOccurrenceWithSubject(record)
+	}
+
+	return models, nil
+}
+
+func (b *EntBackend) IsOccurrenceList(ctx context.Context, spec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := occurrenceQuery(b.client, spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(occurrence.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.Occurrence) int { return r.ID })
 
+	edges := make([]*model.IsOccurrenceEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.IsOccurrenceEdge{Cursor: nodeID(r.ID), Node: toModelIsOccurrenceWithSubject(r)})
+	}
+	return &model.IsOccurrenceConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func occurrenceQuery(client *ent.Client, query *model.IsOccurrenceSpec) *ent.OccurrenceQuery {
 	predicates := []predicate.Occurrence{
 		optionalPredicate(query.ID, IDEQ),
 		optionalPredicate(query.Justification, occurrence.JustificationEQ),
@@ -70,7 +111,7 @@ func (b *EntBackend) IsOccurrence(ctx context.Context, query *model.IsOccurrence
 		}
 	}
 
-	records, err := b.client.Occurrence.Query().
+	return client.Occurrence.Query().
 		Where(predicates...).
 		WithArtifact().
 		WithPackage(func(q *ent.PackageVersionQuery) {
@@ -84,18 +125,7 @@ func (b *EntBackend) IsOccurrence(ctx context.Context, query *model.IsOccurrence
 			q.WithNamespace(func(q *ent.SourceNamespaceQuery) {
 				q.WithSourceType()
 			})
-		}).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	models := make([]*model.IsOccurrence, len(records))
-	for i, record := range records {
-		models[i] = toModelIsOccurrenceWithSubject(record)
-	}
-
-	return models, nil
+		})
 }
 
 func (b *EntBackend) IngestOccurrences(ctx context.Context, subjects model.PackageOrSourceInputs, artifacts []*model.ArtifactInputSpec, occurrences []*model.IsOccurrenceInputSpec) ([]string, error) {
@@ -140,7 +170,9 @@ func (b *EntBackend) IngestOccurrence(ctx context.Context,
 			SetArtifact(artRecord).
 			SetJustification(spec.Justification).
 			SetOrigin(spec.Origin).
-			SetCollector(spec.Collector)
+			SetCollector(spec.Collector).
+			SetNillableVerified(spec.Verified).
+			SetNillableSigner(spec.Signer)
 
 		occurrenceConflictColumns := []string{
 			occurrence.FieldArtifactID,
@@ -187,6 +219,11 @@ func (b *EntBackend) IngestOccurrence(ctx context.Context,
 				sql.ConflictWhere(conflictWhere),
 			).
 			UpdateNewValues().
+			Update(func(u *ent.OccurrenceUpsert) {
+				// the trust of the first document is kept
+				u.SetIgnore(occurrence.FieldVerified)
+				u.SetIgnore(occurrence.FieldSigner)
+			}).
 			ID(ctx)
 		if err != nil {
 			return nil, err
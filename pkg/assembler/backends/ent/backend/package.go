@@ -38,13 +38,58 @@ import (
 )
 
 func (b *EntBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
-	if pkgSpec == nil {
-		pkgSpec = &model.PkgSpec{}
+	paths, isGQL := getPreloads(ctx)
+	query := packageTypeQuery(b.client, pkgSpec, func(path string) bool {
+		return !isGQL || slices.Contains(paths, path)
+	})
+
+	pkgs, err := query.Limit(MaxPageSize).All(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	query := b.client.PackageType.Query().Limit(MaxPageSize)
+	return collect(pkgs, toModelPackage), nil
+}
 
+func (b *EntBackend) PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
 	paths, isGQL := getPreloads(ctx)
+	query := packageTypeQuery(b.client, pkgSpec, func(path string) bool {
+		return !isGQL || slices.Contains(paths, "edges.node."+path)
+	})
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pkgs, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(packagetype.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pkgs, pageInfo := pageOf(pkgs, first, func(p *ent.PackageType) int { return p.ID })
+
+	edges := make([]*model.PackageEdge, 0, len(pkgs))
+	for _, p := range pkgs {
+		edges = append(edges, &model.PackageEdge{Cursor: nodeID(p.ID), Node: toModelPackage(p)})
+	}
+	return &model.PackageConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+// packageTypeQuery builds the query for the package trees matching pkgSpec.
+// Only the tree levels for which preload returns true are loaded.
+func packageTypeQuery(client *ent.Client, pkgSpec *model.PkgSpec, preload func(path string) bool) *ent.PackageTypeQuery {
+	if pkgSpec == nil {
+		pkgSpec = &model.PkgSpec{}
+	}
+
+	query := client.PackageType.Query()
 
 	query.Where(
 		optionalPredicate(pkgSpec.Type, packagetype.TypeEQ),
@@ -62,15 +107,15 @@ func (b *EntBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*m
 		),
 	)
 
-	if slices.Contains(paths, "namespaces") || !isGQL {
+	if preload("namespaces") {
 		query.WithNamespaces(func(q *ent.PackageNamespaceQuery) {
 			q.Where(optionalPredicate(pkgSpec.Namespace, packagenamespace.NamespaceEQ))
 
-			if slices.Contains(paths, "namespaces.names") || !isGQL {
+			if preload("namespaces.names") {
 				q.WithNames(func(q *ent.PackageNameQuery) {
 					q.Where(optionalPredicate(pkgSpec.Name, packagename.NameEQ))
 
-					if slices.Contains(paths, "namespaces.names.versions") || !isGQL {
+					if preload("namespaces.names.versions") {
 						q.WithVersions(func(q *ent.PackageVersionQuery) {
 							q.Where(
 								optionalPredicate(pkgSpec.ID, IDEQ),
@@ -85,12 +130,7 @@ func (b *EntBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*m
 		})
 	}
 
-	pkgs, err := query.All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(pkgs, toModelPackage), nil
+	return query
 }
 
 func (b *EntBackend) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.PackageIDs, error) {
@@ -0,0 +1,71 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"fmt"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Paginated queries use keyset pagination: the cursor of an edge is the ID of
+// the database record behind it, and a page is the set of records with an ID
+// greater than the `after` cursor, in ascending ID order.
+
+// cursorPredicate selects the records that follow the after cursor.
+func cursorPredicate(after *string, first *int) (func(*sql.Selector), error) {
+	if err := helper.ValidatePaginationFirst(first); err != nil {
+		return nil, err
+	}
+	if after == nil {
+		return NoOpSelector(), nil
+	}
+	id, err := strconv.Atoi(*after)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q: %w", *after, err)
+	}
+	return sql.FieldGT("id", id), nil
+}
+
+// withPageLimit limits the query to a page of first records, plus one more to
+// find out whether there is a next page. If first is nil, all records after
+// the cursor are returned.
+func withPageLimit[Q interface{ Limit(int) Q }](query Q, first *int) Q {
+	if first == nil {
+		return query
+	}
+	return query.Limit(*first + 1)
+}
+
+// pageOf drops the lookahead record fetched because of withPageLimit and
+// returns the PageInfo describing the remaining records.
+func pageOf[T any](records []T, first *int, recordID func(T) int) ([]T, *model.PageInfo) {
+	pageInfo := &model.PageInfo{}
+	if first != nil && len(records) > *first {
+		records = records[:*first]
+		pageInfo.HasNextPage = true
+	}
+	if len(records) > 0 {
+		startCursor := nodeID(recordID(records[0]))
+		endCursor := nodeID(recordID(records[len(records)-1]))
+		pageInfo.StartCursor = &startCursor
+		pageInfo.EndCursor = &endCursor
+	}
+	return records, pageInfo
+}
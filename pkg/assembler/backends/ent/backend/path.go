@@ -0,0 +1,118 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+type edgeMap map[model.Edge]bool
+
+func processUsingOnly(usingOnly []model.Edge) edgeMap {
+	m := edgeMap{}
+	allowedEdges := usingOnly
+	if len(usingOnly) == 0 {
+		allowedEdges = model.AllEdge
+	}
+	for _, edge := range allowedEdges {
+		m[edge] = true
+	}
+	return m
+}
+
+// Path returns the shortest path from subject to target, of at most
+// maxPathLength edges, that only follows the edges in usingOnly.
+func (b *EntBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	from, err := strconv.Atoi(subject)
+	if err != nil {
+		return nil, err
+	}
+	to, err := strconv.Atoi(target)
+	if err != nil {
+		return nil, err
+	}
+	path, err := b.bfs(ctx, from, to, maxPathLength, processUsingOnly(usingOnly))
+	if err != nil {
+		return nil, err
+	}
+	return b.Nodes(ctx, collect(path, nodeID))
+}
+
+// bfs searches the graph breadth first, one neighbor query per node, and
+// returns the IDs of the nodes on the first path found from one node to the
+// other.
+func (b *EntBackend) bfs(ctx context.Context, from, to int, maxLength int, allowedEdges edgeMap) ([]int, error) {
+	type bfsNode struct {
+		parent int
+		depth  int
+	}
+	nodeMap := map[int]bfsNode{from: {}}
+	queue := []int{from}
+
+	// the target is checked when it is discovered, not when it is dequeued,
+	// to save querying the neighbors of the rest of the level before it
+	found := from == to
+	for len(queue) > 0 && !found {
+		now := queue[0]
+		queue = queue[1:]
+		nowNode := nodeMap[now]
+
+		if nowNode.depth >= maxLength {
+			continue
+		}
+
+		neighbors, err := b.neighborsFromID(ctx, now, allowedEdges)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, next := range neighbors {
+			if _, seen := nodeMap[next]; seen {
+				continue
+			}
+			nodeMap[next] = bfsNode{
+				parent: now,
+				depth:  nowNode.depth + 1,
+			}
+			if next == to {
+				found = true
+				break
+			}
+			queue = append(queue, next)
+		}
+	}
+
+	if !found {
+		return nil, gqlerror.Errorf("No path found up to specified length")
+	}
+
+	var path []int
+	for now := to; now != from; now = nodeMap[now].parent {
+		path = append(path, now)
+	}
+	path = append(path, from)
+
+	// reverse path
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, nil
+}
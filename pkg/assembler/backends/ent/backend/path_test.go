@@ -0,0 +1,67 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (s *Suite) TestPath() {
+	b, err := GetBackend(s.Client)
+	s.Require().NoError(err)
+
+	from, err := b.IngestPackage(s.Ctx, *p1)
+	s.Require().NoError(err)
+	to, err := b.IngestPackage(s.Ctx, *p2)
+	s.Require().NoError(err)
+	match := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	depID, err := b.IngestDependency(s.Ctx, *p1, *p2, match, model.IsDependencyInputSpec{Justification: "dep", Origin: "sbom.json"})
+	s.Require().NoError(err)
+
+	s.Run("Shortest", func() {
+		// both versions share a package name
+		path, err := b.Path(s.Ctx, from.PackageVersionID, to.PackageVersionID, 2, nil)
+		s.Require().NoError(err)
+		if diff := cmp.Diff([]model.Node{p1out, p1outName, p2out}, path, ignoreID, ignoreEmptySlices); diff != "" {
+			s.T().Errorf("Unexpected results. (-want +got):\n%s", diff)
+		}
+	})
+
+	s.Run("UsingOnly", func() {
+		path, err := b.Path(s.Ctx, from.PackageVersionID, to.PackageVersionID, 2, []model.Edge{model.EdgePackageIsDependency, model.EdgeIsDependencyPackage})
+		s.Require().NoError(err)
+		s.Require().Len(path, 3)
+		dep, ok := path[1].(*model.IsDependency)
+		s.Require().True(ok, "got %T, want an IsDependency", path[1])
+		s.Equal(depID, dep.ID)
+	})
+
+	s.Run("TooLong", func() {
+		_, err := b.Path(s.Ctx, from.PackageVersionID, to.PackageVersionID, 1, nil)
+		s.Error(err)
+	})
+
+	s.Run("Neighbors", func() {
+		neighbors, err := b.Neighbors(s.Ctx, depID, nil)
+		s.Require().NoError(err)
+		if diff := cmp.Diff([]model.Node{p1out, p2out}, neighbors, ignoreID, ignoreEmptySlices); diff != "" {
+			s.T().Errorf("Unexpected results. (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -34,10 +34,7 @@ import (
 )
 
 func (b *EntBackend) PkgEqual(ctx context.Context, spec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
-	records, err := b.client.PkgEqual.Query().
-		Where(pkgEqualQueryPredicates(spec)).
-		WithPackages(withPackageVersionTree()).
-		All(ctx)
+	records, err := pkgEqualQuery(b.client, spec).All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +42,40 @@ func (b *EntBackend) PkgEqual(ctx context.Context, spec *model.PkgEqualSpec) ([]
 	return collect(records, toModelPkgEqual), nil
 }
 
+func (b *EntBackend) PkgEqualList(ctx context.Context, spec *model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := pkgEqualQuery(b.client, spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(pkgequal.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.PkgEqual) int { return r.ID })
+
+	edges := make([]*model.PkgEqualEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.PkgEqualEdge{Cursor: nodeID(r.ID), Node: toModelPkgEqual(r)})
+	}
+	return &model.PkgEqualConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func pkgEqualQuery(client *ent.Client, spec *model.PkgEqualSpec) *ent.PkgEqualQuery {
+	return client.PkgEqual.Query().
+		Where(pkgEqualQueryPredicates(spec)).
+		WithPackages(withPackageVersionTree())
+}
+
 func (b *EntBackend) IngestPkgEqual(ctx context.Context, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, pkgEqual model.PkgEqualInputSpec) (string, error) {
 	id, err := WithinTX(ctx, b.client, func(ctx context.Context) (*int, error) {
 		return upsertPackageEqual(ctx, ent.TxFromContext(ctx), pkg, depPkg, pkgEqual)
@@ -91,6 +122,8 @@ func upsertPackageEqual(ctx context.Context, client *ent.Tx, pkgA model.PkgInput
 		AddPackages(sortedPackages...).
 		SetPackagesHash(hashPackages(sortedPackages)).
 		SetCollector(spec.Collector).
+		SetNillableVerified(spec.Verified).
+		SetNillableSigner(spec.Signer).
 		SetJustification(spec.Justification).
 		SetOrigin(spec.Origin).
 		OnConflict(
@@ -159,6 +192,8 @@ func toModelPkgEqual(record *ent.PkgEqual) *model.PkgEqual {
 		ID:            nodeID(record.ID),
 		Origin:        record.Origin,
 		Collector:     record.Collector,
+		Verified:      record.Verified,
+		Signer:        record.Signer,
 		Justification: record.Justification,
 		Packages:      collect(packages, toModelPackage),
 		// Packages: collect(packages, func(record *ent.PackageVersion) *model.Package {
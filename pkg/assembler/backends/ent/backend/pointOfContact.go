@@ -31,13 +31,8 @@ import (
 )
 
 func (b *EntBackend) PointOfContact(ctx context.Context, filter *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
-	records, err := b.client.PointOfContact.Query().
-		Where(pointOfContactPredicate(filter)).
+	records, err := pointOfContactQuery(b.client, filter).
 		Limit(MaxPageSize).
-		WithSource(withSourceNameTreeQuery()).
-		WithArtifact().
-		WithPackageVersion(withPackageVersionTree()).
-		WithAllVersions(withPackageNameTree()).
 		All(ctx)
 
 	if err != nil {
@@ -47,6 +42,43 @@ func (b *EntBackend) PointOfContact(ctx context.Context, filter *model.PointOfCo
 	return collect(records, toModelPointOfContact), nil
 }
 
+func (b *EntBackend) PointOfContactList(ctx context.Context, filter *model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve PointOfContactList :: %s", err)
+	}
+	query := pointOfContactQuery(b.client, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve PointOfContactList :: %s", err)
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(pointofcontact.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve PointOfContactList :: %s", err)
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.PointOfContact) int { return r.ID })
+
+	edges := make([]*model.PointOfContactEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.PointOfContactEdge{Cursor: nodeID(r.ID), Node: toModelPointOfContact(r)})
+	}
+	return &model.PointOfContactConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func pointOfContactQuery(client *ent.Client, filter *model.PointOfContactSpec) *ent.PointOfContactQuery {
+	return client.PointOfContact.Query().
+		Where(pointOfContactPredicate(filter)).
+		WithSource(withSourceNameTreeQuery()).
+		WithArtifact().
+		WithPackageVersion(withPackageVersionTree()).
+		WithAllVersions(withPackageNameTree())
+}
+
 func (b *EntBackend) IngestPointOfContact(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, pointOfContact model.PointOfContactInputSpec) (string, error) {
 	recordID, err := WithinTX(ctx, b.client, func(ctx context.Context) (*int, error) {
 		return upsertPointOfContact(ctx, ent.TxFromContext(ctx), subject, pkgMatchType, pointOfContact)
@@ -112,7 +144,9 @@ func upsertPointOfContact(ctx context.Context, client *ent.Tx, subject model.Pac
 		SetSince(spec.Since.UTC()).
 		SetJustification(spec.Justification).
 		SetOrigin(spec.Origin).
-		SetCollector(spec.Collector)
+		SetCollector(spec.Collector).
+		SetNillableVerified(spec.Verified).
+		SetNillableSigner(spec.Signer)
 
 	conflictColumns := []string{
 		pointofcontact.FieldEmail,
@@ -230,6 +264,8 @@ func toModelPointOfContact(v *ent.PointOfContact) *model.PointOfContact {
 		Justification: v.Justification,
 		Origin:        v.Origin,
 		Collector:     v.Collector,
+		Verified:      v.Verified,
+		Signer:        v.Signer,
 	}
 }
 
@@ -32,6 +32,48 @@ import (
 
 func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
 	funcName := "HasSBOM"
+
+	records, err := hasSBOMQuery(b.client, spec).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	return collect(records, toModelHasSBOM), nil
+}
+
+func (b *EntBackend) HasSBOMList(ctx context.Context, spec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	funcName := "HasSBOMList"
+
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	query := hasSBOMQuery(b.client, spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(billofmaterials.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.BillOfMaterials) int { return r.ID })
+
+	edges := make([]*model.HasSBOMEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.HasSBOMEdge{Cursor: nodeID(r.ID), Node: toModelHasSBOM(r)})
+	}
+	return &model.HasSBOMConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func hasSBOMQuery(client *ent.Client, spec *model.HasSBOMSpec) *ent.BillOfMaterialsQuery {
 	predicates := []predicate.BillOfMaterials{
 		optionalPredicate(spec.ID, IDEQ),
 		optionalPredicate(toLowerPtr(spec.Algorithm), billofmaterials.AlgorithmEQ),
@@ -52,7 +94,7 @@ func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec) ([]*m
 		}
 	}
 
-	records, err := b.client.BillOfMaterials.Query().
+	return client.BillOfMaterials.Query().
 		Where(predicates...).
 		WithPackage(func(q *ent.PackageVersionQuery) {
 			q.WithName(func(q *ent.PackageNameQuery) {
@@ -61,14 +103,7 @@ func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec) ([]*m
 				})
 			})
 		}).
-		WithArtifact().
-		Limit(MaxPageSize).
-		All(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, funcName)
-	}
-
-	return collect(records, toModelHasSBOM), nil
+		WithArtifact()
 }
 
 func (b *EntBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, spec model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error) {
@@ -84,6 +119,8 @@ func (b *EntBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrA
 			SetDownloadLocation(spec.DownloadLocation).
 			SetOrigin(spec.Origin).
 			SetCollector(spec.Collector).
+			SetNillableVerified(spec.Verified).
+			SetNillableSigner(spec.Signer).
 			SetKnownSince(spec.KnownSince.UTC())
 
 		// If a new column is included in the conflict columns, it must be added to the Indexes() function in the schema
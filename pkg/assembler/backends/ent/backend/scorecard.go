@@ -37,7 +37,46 @@ func (b *EntBackend) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		return nil, nil
 	}
 
-	query := b.client.CertifyScorecard.Query()
+	records, err := scorecardQuery(b.client, filter).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(records, toModelCertifyScorecard), nil
+}
+
+func (b *EntBackend) ScorecardsList(ctx context.Context, filter *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := scorecardQuery(b.client, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(certifyscorecard.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.CertifyScorecard) int { return r.ID })
+
+	edges := make([]*model.CertifyScorecardEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.CertifyScorecardEdge{Cursor: nodeID(r.ID), Node: toModelCertifyScorecard(r)})
+	}
+	return &model.CertifyScorecardConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func scorecardQuery(client *ent.Client, filter *model.CertifyScorecardSpec) *ent.CertifyScorecardQuery {
+	query := client.CertifyScorecard.Query()
 	query.Where(
 		certifyscorecard.HasScorecardWith(func(s *sql.Selector) {
 			// optionalPredicate(filter.Checks, scorecard.ChecksContains)(s)
@@ -75,16 +114,9 @@ func (b *EntBackend) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		}
 	}
 
-	records, err := query.
+	return query.
 		WithScorecard().
-		WithSource(withSourceNameTreeQuery()).
-		Limit(MaxPageSize).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(records, toModelCertifyScorecard), nil
+		WithSource(withSourceNameTreeQuery())
 }
 
 // Mutations for evidence trees (read-write queries, assume software trees ingested)
@@ -129,6 +161,8 @@ func upsertScorecard(ctx context.Context, client *ent.Tx, source model.SourceInp
 		SetScorecardCommit(scorecardInput.ScorecardCommit).
 		SetOrigin(scorecardInput.Origin).
 		SetCollector(scorecardInput.Collector).
+		SetNillableVerified(scorecardInput.Verified).
+		SetNillableSigner(scorecardInput.Signer).
 		OnConflict(
 			sql.ConflictColumns(scorecard.FieldOrigin, scorecard.FieldCollector, scorecard.FieldScorecardCommit, scorecard.FieldScorecardVersion, scorecard.FieldAggregateScore),
 		).
@@ -206,5 +240,7 @@ func toModelScorecard(record *ent.Scorecard) *model.Scorecard {
 		ScorecardCommit:  record.ScorecardCommit,
 		Origin:           record.Origin,
 		Collector:        record.Collector,
+		Verified:         record.Verified,
+		Signer:           record.Signer,
 	}
 }
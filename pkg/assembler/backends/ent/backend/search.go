@@ -17,11 +17,16 @@ package backend
 
 import (
 	"context"
+	"strings"
+	"unicode"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagenamespace"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcename"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcenamespace"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -52,18 +57,26 @@ func (b *EntBackend) FindSoftware(ctx context.Context, searchText string) ([]mod
 		return results, nil
 	}
 
+	query := textSearchQuery(searchText)
+
 	// Search by Package Name
-	packages, err := b.client.PackageVersion.Query().Where(
-		packageversion.HasNameWith(
-			packagename.NameContainsFold(searchText),
-		),
-	).WithName(func(q *ent.PackageNameQuery) {
-		q.WithNamespace(func(q *ent.PackageNamespaceQuery) {
-			q.WithPackage()
-		})
-	}).
-		Limit(MaxPageSize).
-		All(ctx)
+	packages, err := findEach(func(v *ent.PackageVersion) int { return v.ID },
+		func(p predicate.PackageName) ([]*ent.PackageVersion, error) {
+			return b.client.PackageVersion.Query().
+				Where(packageversion.HasNameWith(p)).
+				WithName(func(q *ent.PackageNameQuery) {
+					q.WithNamespace(func(q *ent.PackageNamespaceQuery) {
+						q.WithPackage()
+					})
+				}).
+				Limit(MaxPageSize).
+				All(ctx)
+		},
+		textSearch(packagename.FieldName, query),
+		packagename.HasNamespaceWith(textSearch(packagenamespace.FieldNamespace, query)),
+		packagename.NameContainsFold(searchText),
+		packagename.HasNamespaceWith(packagenamespace.NamespaceContainsFold(searchText)),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -73,18 +86,21 @@ func (b *EntBackend) FindSoftware(ctx context.Context, searchText string) ([]mod
 	})...)
 
 	// Search Sources
-	sources, err := b.client.SourceName.Query().Where(
-		sourcename.Or(
-			sourcename.NameContainsFold(searchText),
-			sourcename.HasNamespaceWith(
-				sourcenamespace.NamespaceContainsFold(searchText),
-			),
-		),
-	).WithNamespace(func(q *ent.SourceNamespaceQuery) {
-		q.WithSourceType()
-	}).
-		Limit(MaxPageSize).
-		All(ctx)
+	sources, err := findEach(func(v *ent.SourceName) int { return v.ID },
+		func(p predicate.SourceName) ([]*ent.SourceName, error) {
+			return b.client.SourceName.Query().
+				Where(p).
+				WithNamespace(func(q *ent.SourceNamespaceQuery) {
+					q.WithSourceType()
+				}).
+				Limit(MaxPageSize).
+				All(ctx)
+		},
+		textSearch(sourcename.FieldName, query),
+		sourcename.HasNamespaceWith(textSearch(sourcenamespace.FieldNamespace, query)),
+		sourcename.NameContainsFold(searchText),
+		sourcename.HasNamespaceWith(sourcenamespace.NamespaceContainsFold(searchText)),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -107,3 +123,73 @@ func (b *EntBackend) FindSoftware(ctx context.Context, searchText string) ([]mod
 
 	return results, nil
 }
+
+// findEach runs a query for each of the predicates, rather than a single one
+// for their disjunction, which would keep Postgres from using the text search
+// indexes and scan the whole tables. It returns the distinct results in the
+// order of the predicates, up to MaxPageSize.
+func findEach[T any, P any](id func(T) int, find func(P) ([]T, error), predicates ...P) ([]T, error) {
+	var results []T
+	seen := map[int]bool{}
+	for _, p := range predicates {
+		found, err := find(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range found {
+			if seen[id(r)] {
+				continue
+			}
+			seen[id(r)] = true
+			results = append(results, r)
+			if len(results) == MaxPageSize {
+				return results, nil
+			}
+		}
+	}
+	return results, nil
+}
+
+// textSearchQuery returns a Postgres text search query that matches text
+// containing all the words of searchText, each word also matching as a
+// prefix, so that "tensor" finds "tensorflow". Words are split at anything
+// but letters and digits, so the query needs no escaping.
+func textSearchQuery(searchText string) string {
+	words := strings.FieldsFunc(strings.ToLower(searchText), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i := range words {
+		words[i] += ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// textSearchIndexes are the GIN indexes on the text search vectors of the
+// columns searched by textSearch, created by SetupBackend with the schema.
+// ent can not declare indexes on expressions, and they must match the
+// expression of textSearch to be used.
+var textSearchIndexes = []struct{ name, table, column string }{
+	{"packagename_name_text_search", packagename.Table, packagename.FieldName},
+	{"packagenamespace_namespace_text_search", packagenamespace.Table, packagenamespace.FieldNamespace},
+	{"sourcename_name_text_search", sourcename.Table, sourcename.FieldName},
+	{"sourcenamespace_namespace_text_search", sourcenamespace.Table, sourcenamespace.FieldNamespace},
+}
+
+// textSearch matches the rows whose column matches a query returned by
+// textSearchQuery. An empty query matches nothing.
+// textSearchIndexes must be kept in sync with the expression.
+func textSearch(column, query string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if query == "" {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("to_tsvector('simple', ").
+				WriteString(s.C(column)).
+				WriteString(") @@ to_tsquery('simple', ").
+				Arg(query).
+				WriteString(")")
+		}))
+	}
+}
@@ -61,6 +61,19 @@ func (s *Suite) Test_FindSoftware() {
 			s.T().Errorf("Unexpected results. (-want +got):\n%s", diff)
 		}
 
+		// Find a package by the words of its name, in any order
+		_, err = b.IngestPackage(s.Ctx, model.PkgInputSpec{Type: "golang", Name: "guac-collector"})
+		s.NoError(err)
+		results, err = b.FindSoftware(s.Ctx, "collector guac")
+		s.NoError(err)
+
+		if s.Len(results, 1) {
+			p, ok := results[0].(*model.Package)
+			if s.True(ok, "got %T, want a package", results[0]) {
+				s.Equal("guac-collector", p.Namespaces[0].Names[0].Name)
+			}
+		}
+
 		// Find an artifact
 		results, err = b.FindSoftware(s.Ctx, "6bbb0da")
 		s.NoError(err)
@@ -32,6 +32,45 @@ import (
 )
 
 func (b *EntBackend) HasSlsa(ctx context.Context, spec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	records, err := hasSLSAQuery(b.client, spec).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(records, toModelHasSLSA), nil
+}
+
+func (b *EntBackend) HasSLSAList(ctx context.Context, spec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := hasSLSAQuery(b.client, spec)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(slsaattestation.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.SLSAAttestation) int { return r.ID })
+
+	edges := make([]*model.HasSLSAEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.HasSLSAEdge{Cursor: nodeID(r.ID), Node: toModelHasSLSA(r)})
+	}
+	return &model.HasSLSAConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func hasSLSAQuery(client *ent.Client, spec *model.HasSLSASpec) *ent.SLSAAttestationQuery {
 	query := []predicate.SLSAAttestation{
 		optionalPredicate(spec.ID, IDEQ),
 		optionalPredicate(spec.BuildType, slsaattestation.BuildTypeEQ),
@@ -54,18 +93,11 @@ func (b *EntBackend) HasSlsa(ctx context.Context, spec *model.HasSLSASpec) ([]*m
 		query = append(query, slsaattestation.HasBuiltFromWith(artifactQueryPredicates(art)))
 	}
 
-	records, err := b.client.SLSAAttestation.Query().
+	return client.SLSAAttestation.Query().
 		Where(query...).
 		WithSubject().
 		WithBuiltBy().
-		WithBuiltFrom().
-		Limit(MaxPageSize).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(records, toModelHasSLSA), nil
+		WithBuiltFrom()
 }
 
 func (b *EntBackend) IngestSLSA(ctx context.Context, subject model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy model.BuilderInputSpec, slsa model.SLSAInputSpec) (string, error) {
@@ -121,6 +153,8 @@ func upsertSLSA(ctx context.Context, client *ent.Tx, subject model.ArtifactInput
 		SetBuildType(slsa.BuildType).
 		SetBuiltBy(builder).
 		SetCollector(slsa.Collector).
+		SetNillableVerified(slsa.Verified).
+		SetNillableSigner(slsa.Signer).
 		SetOrigin(slsa.Origin).
 		SetSlsaVersion(slsa.SlsaVersion).
 		SetSlsaPredicate(toSLSAInputPredicate(slsa.SlsaPredicate)).
@@ -139,6 +173,11 @@ func upsertSLSA(ctx context.Context, client *ent.Tx, subject model.ArtifactInput
 			),
 		).
 		UpdateNewValues().
+		Update(func(u *ent.SLSAAttestationUpsert) {
+			// the trust of the first document is kept
+			u.SetIgnore(slsaattestation.FieldVerified)
+			u.SetIgnore(slsaattestation.FieldSigner)
+		}).
 		ID(ctx)
 	if err != nil {
 		return nil, err
@@ -182,6 +221,8 @@ func toModelHasSLSA(att *ent.SLSAAttestation) *model.HasSlsa {
 			FinishedOn:    att.FinishedOn,
 			Origin:        att.Origin,
 			Collector:     att.Collector,
+			Verified:      att.Verified,
+			Signer:        att.Signer,
 		},
 	}
 }
@@ -33,6 +33,45 @@ import (
 )
 
 func (b *EntBackend) HasSourceAt(ctx context.Context, filter *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
+	records, err := hasSourceAtQuery(b.client, filter).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(records, toModelHasSourceAt), nil
+}
+
+func (b *EntBackend) HasSourceAtList(ctx context.Context, filter *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := hasSourceAtQuery(b.client, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(hassourceat.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.HasSourceAt) int { return r.ID })
+
+	edges := make([]*model.HasSourceAtEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.HasSourceAtEdge{Cursor: nodeID(r.ID), Node: toModelHasSourceAt(r)})
+	}
+	return &model.HasSourceAtConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func hasSourceAtQuery(client *ent.Client, filter *model.HasSourceAtSpec) *ent.HasSourceAtQuery {
 	query := []predicate.HasSourceAt{
 		optionalPredicate(filter.ID, IDEQ),
 		optionalPredicate(filter.Collector, hassourceat.CollectorEQ),
@@ -54,18 +93,11 @@ func (b *EntBackend) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 		query = append(query, hassourceat.HasSourceWith(sourceQuery(filter.Source)))
 	}
 
-	records, err := b.client.HasSourceAt.Query().
+	return client.HasSourceAt.Query().
 		Where(query...).
 		WithAllVersions(withPackageNameTree()).
 		WithPackageVersion(withPackageVersionTree()).
-		WithSource(withSourceNameTreeQuery()).
-		Limit(MaxPageSize).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(records, toModelHasSourceAt), nil
+		WithSource(withSourceNameTreeQuery())
 }
 
 func (b *EntBackend) IngestHasSourceAt(ctx context.Context, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) (string, error) {
@@ -104,6 +136,8 @@ func upsertHasSourceAt(ctx context.Context, client *ent.Tx, pkg model.PkgInputSp
 
 	insert := client.HasSourceAt.Create().
 		SetCollector(spec.Collector).
+		SetNillableVerified(spec.Verified).
+		SetNillableSigner(spec.Signer).
 		SetOrigin(spec.Origin).
 		SetJustification(spec.Justification).
 		SetKnownSince(spec.KnownSince).
@@ -132,6 +166,11 @@ func upsertHasSourceAt(ctx context.Context, client *ent.Tx, pkg model.PkgInputSp
 		sql.ConflictWhere(conflictWhere),
 	).
 		UpdateNewValues().
+		Update(func(u *ent.HasSourceAtUpsert) {
+			// the trust of the first document is kept
+			u.SetIgnore(hassourceat.FieldVerified)
+			u.SetIgnore(hassourceat.FieldSigner)
+		}).
 		ID(ctx)
 	if err != nil {
 		return nil, err
@@ -146,12 +185,8 @@ func upsertHasSourceAt(ctx context.Context, client *ent.Tx, pkg model.PkgInputSp
 }
 
 func (b *EntBackend) Sources(ctx context.Context, filter *model.SourceSpec) ([]*model.Source, error) {
-	records, err := b.client.SourceName.Query().
-		Where(sourceQuery(filter)).
+	records, err := sourceNameQuery(b.client, filter).
 		Limit(MaxPageSize).
-		WithNamespace(func(q *ent.SourceNamespaceQuery) {
-			q.WithSourceType()
-		}).
 		All(ctx)
 	if err != nil {
 		return nil, err
@@ -160,6 +195,42 @@ func (b *EntBackend) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 	return collect(records, toModelSourceName), nil
 }
 
+func (b *EntBackend) SourcesList(ctx context.Context, filter *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := sourceNameQuery(b.client, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(sourcename.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.SourceName) int { return r.ID })
+
+	edges := make([]*model.SourceEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.SourceEdge{Cursor: nodeID(r.ID), Node: toModelSourceName(r)})
+	}
+	return &model.SourceConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func sourceNameQuery(client *ent.Client, filter *model.SourceSpec) *ent.SourceNameQuery {
+	return client.SourceName.Query().
+		Where(sourceQuery(filter)).
+		WithNamespace(func(q *ent.SourceNamespaceQuery) {
+			q.WithSourceType()
+		})
+}
+
 func (b *EntBackend) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.SourceIDs, error) {
 	ids := make([]*model.SourceIDs, len(sources))
 	for i, src := range sources {
@@ -325,6 +396,8 @@ func toModelHasSourceAt(record *ent.HasSourceAt) *model.HasSourceAt {
 		Justification: record.Justification,
 		Origin:        record.Origin,
 		Collector:     record.Collector,
+		Verified:      record.Verified,
+		Signer:        record.Signer,
 	}
 }
 
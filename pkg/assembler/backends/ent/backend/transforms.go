@@ -120,6 +120,8 @@ func toModelIsOccurrenceWithSubject(o *ent.Occurrence) *model.IsOccurrence {
 		Justification: o.Justification,
 		Origin:        o.Origin,
 		Collector:     o.Collector,
+		Verified:      o.Verified,
+		Signer:        o.Signer,
 	}
 }
 
@@ -185,6 +187,7 @@ func toModelIsDependency(id *ent.Dependency, backrefs bool) *model.IsDependency
 		DependencyPackage: depPkg,
 		VersionRange:      id.VersionRange,
 		DependencyType:    dependencyTypeFromEnum(id.DependencyType),
+		DependencyScope:   dependencyScopeFromEnum(id.DependencyScope),
 		Justification:     id.Justification,
 		Origin:            id.Origin,
 		Collector:         id.Collector,
@@ -202,6 +205,25 @@ func dependencyTypeFromEnum(t dependency.DependencyType) model.DependencyType {
 	}
 }
 
+func dependencyScopeFromEnum(s dependency.DependencyScope) model.DependencyScope {
+	switch s {
+	case dependency.DependencyScopeRUNTIME:
+		return model.DependencyScopeRuntime
+	case dependency.DependencyScopeDEV:
+		return model.DependencyScopeDev
+	case dependency.DependencyScopeBUILD:
+		return model.DependencyScopeBuild
+	case dependency.DependencyScopeTEST:
+		return model.DependencyScopeTest
+	case dependency.DependencyScopeOPTIONAL:
+		return model.DependencyScopeOptional
+	case dependency.DependencyScopePROVIDED:
+		return model.DependencyScopeProvided
+	default:
+		return model.DependencyScopeUnknown
+	}
+}
+
 func toModelHasSBOM(sbom *ent.BillOfMaterials) *model.HasSbom {
 	return &model.HasSbom{
 		ID:               nodeID(sbom.ID),
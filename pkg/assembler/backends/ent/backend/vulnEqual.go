@@ -28,7 +28,43 @@ import (
 )
 
 func (b *EntBackend) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	results, err := vulnEqualQuery(b.client, filter).Limit(MaxPageSize).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(results, toModelVulnEqual), nil
+}
+
+func (b *EntBackend) VulnEqualList(ctx context.Context, filter *model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := vulnEqualQuery(b.client, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(vulnequal.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.VulnEqual) int { return r.ID })
+
+	edges := make([]*model.VulnEqualEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.VulnEqualEdge{Cursor: nodeID(r.ID), Node: toModelVulnEqual(r)})
+	}
+	return &model.VulnEqualConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
 
+func vulnEqualQuery(client *ent.Client, filter *model.VulnEqualSpec) *ent.VulnEqualQuery {
 	var where = []predicate.VulnEqual{
 		optionalPredicate(filter.ID, IDEQ),
 		optionalPredicate(filter.Justification, vulnequal.JustificationEQ),
@@ -47,18 +83,11 @@ func (b *EntBackend) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec)
 		}
 	}
 
-	query := b.client.VulnEqual.Query().
+	return client.VulnEqual.Query().
 		Where(where...).
 		WithVulnerabilityIds(func(query *ent.VulnerabilityIDQuery) {
 			query.WithType().Order(vulnerabilityid.ByID())
 		})
-
-	results, err := query.Limit(MaxPageSize).All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	return collect(results, toModelVulnEqual), nil
 }
 
 func (b *EntBackend) IngestVulnEquals(ctx context.Context, vulnerabilities []*model.VulnerabilityInputSpec, otherVulnerabilities []*model.VulnerabilityInputSpec, vulnEquals []*model.VulnEqualInputSpec) ([]string, error) {
@@ -125,6 +154,8 @@ func upsertVulnEquals(ctx context.Context, client *ent.Tx, vulnerability model.V
 			SetJustification(vulnEqual.Justification).
 			SetOrigin(vulnEqual.Origin).
 			SetCollector(vulnEqual.Collector).
+			SetNillableVerified(vulnEqual.Verified).
+			SetNillableSigner(vulnEqual.Signer).
 			Save(ctx)
 		if err != nil {
 			return nil, err
@@ -139,6 +170,8 @@ func toModelVulnEqual(record *ent.VulnEqual) *model.VulnEqual {
 		Justification:   record.Justification,
 		Origin:          record.Origin,
 		Collector:       record.Collector,
+		Verified:        record.Verified,
+		Signer:          record.Signer,
 	}
 }
 
@@ -34,13 +34,8 @@ import (
 )
 
 func (b *EntBackend) VulnerabilityMetadata(ctx context.Context, filter *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
-
-	records, err := b.client.VulnerabilityMetadata.Query().
-		Where(vulnerabilityMetadataPredicate(filter)).
+	records, err := vulnerabilityMetadataQuery(b.client, filter).
 		Limit(MaxPageSize).
-		WithVulnerabilityID(func(q *ent.VulnerabilityIDQuery) {
-			q.WithType()
-		}).
 		All(ctx)
 
 	if err != nil {
@@ -50,6 +45,42 @@ func (b *EntBackend) VulnerabilityMetadata(ctx context.Context, filter *model.Vu
 	return collect(records, toModelVulnerabilityMetadata), nil
 }
 
+func (b *EntBackend) VulnerabilityMetadataList(ctx context.Context, filter *model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve VulnerabilityMetadataList :: %s", err)
+	}
+	query := vulnerabilityMetadataQuery(b.client, filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve VulnerabilityMetadataList :: %s", err)
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(vulnerabilitymetadata.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve VulnerabilityMetadataList :: %s", err)
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.VulnerabilityMetadata) int { return r.ID })
+
+	edges := make([]*model.VulnerabilityMetadataEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.VulnerabilityMetadataEdge{Cursor: nodeID(r.ID), Node: toModelVulnerabilityMetadata(r)})
+	}
+	return &model.VulnerabilityMetadataConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func vulnerabilityMetadataQuery(client *ent.Client, filter *model.VulnerabilityMetadataSpec) *ent.VulnerabilityMetadataQuery {
+	return client.VulnerabilityMetadata.Query().
+		Where(vulnerabilityMetadataPredicate(filter)).
+		WithVulnerabilityID(func(q *ent.VulnerabilityIDQuery) {
+			q.WithType()
+		})
+}
+
 func (b *EntBackend) IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.VulnerabilityInputSpec, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error) {
 	recordID, err := WithinTX(ctx, b.client, func(ctx context.Context) (*int, error) {
 		return upsertVulnerabilityMetadata(ctx, ent.TxFromContext(ctx), vulnerability, vulnerabilityMetadata)
@@ -144,7 +175,9 @@ func upsertVulnerabilityMetadata(ctx context.Context, client *ent.Tx, vulnerabil
 		SetScoreValue(spec.ScoreValue).
 		SetTimestamp(spec.Timestamp.UTC()).
 		SetOrigin(spec.Origin).
-		SetCollector(spec.Collector)
+		SetCollector(spec.Collector).
+		SetNillableVerified(spec.Verified).
+		SetNillableSigner(spec.Signer)
 
 	conflictColumns := []string{
 		vulnerabilitymetadata.FieldVulnerabilityIDID,
@@ -195,6 +228,8 @@ func toModelVulnerabilityMetadata(v *ent.VulnerabilityMetadata) *model.Vulnerabi
 		Timestamp:  v.Timestamp,
 		Origin:     v.Origin,
 		Collector:  v.Collector,
+		Verified:   v.Verified,
+		Signer:     v.Signer,
 	}
 }
 
@@ -63,7 +63,45 @@ func (b *EntBackend) Vulnerabilities(ctx context.Context, filter *model.Vulnerab
 	return collect(records, toModelVulnerability), nil
 }
 
+func (b *EntBackend) VulnerabilitiesList(ctx context.Context, filter *model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
+	afterCursor, err := cursorPredicate(after, first)
+	if err != nil {
+		return nil, err
+	}
+	query := vulnerabilityTypeQuery(b.client, *filter)
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := withPageLimit(query.Where(afterCursor).Order(ent.Asc(vulnerabilitytype.FieldID)), first).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, pageInfo := pageOf(records, first, func(r *ent.VulnerabilityType) int { return r.ID })
+
+	edges := make([]*model.VulnerabilityEdge, 0, len(records))
+	for _, r := range records {
+		edges = append(edges, &model.VulnerabilityEdge{Cursor: nodeID(r.ID), Node: toModelVulnerability(r)})
+	}
+	return &model.VulnerabilityConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
 func getVulnerabilities(ctx context.Context, client *ent.Client, filter model.VulnerabilitySpec) (ent.VulnerabilityTypes, error) {
+	results, err := vulnerabilityTypeQuery(client, filter).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func vulnerabilityTypeQuery(client *ent.Client, filter model.VulnerabilitySpec) *ent.VulnerabilityTypeQuery {
 	var where = []predicate.VulnerabilityType{
 		optionalPredicate(filter.ID, IDEQ),
 		optionalPredicate(filter.Type, vulnerabilitytype.TypeEqualFold),
@@ -74,17 +112,11 @@ func getVulnerabilities(ctx context.Context, client *ent.Client, filter model.Vu
 		))
 	}
 
-	results, err := client.VulnerabilityType.Query().
+	return client.VulnerabilityType.Query().
 		Where(where...).
 		WithVulnerabilityIds(func(query *ent.VulnerabilityIDQuery) {
 			query.Where(optionalPredicate(filter.VulnerabilityID, vulnerabilityid.VulnerabilityIDEqualFold))
-		}).
-		Limit(MaxPageSize).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return results, nil
+		})
 }
 
 func upsertVulnerability(ctx context.Context, client *ent.Tx, spec model.VulnerabilityInputSpec) (*model.VulnerabilityIDs, error) {
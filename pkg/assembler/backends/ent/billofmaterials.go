@@ -35,6 +35,10 @@ type BillOfMaterials struct {
 	Origin string `json:"origin,omitempty"`
 	// GUAC collector for the document
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// KnownSince holds the value of the "known_since" field.
 	KnownSince time.Time `json:"known_since,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -87,9 +91,11 @@ func (*BillOfMaterials) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case billofmaterials.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case billofmaterials.FieldID, billofmaterials.FieldPackageID, billofmaterials.FieldArtifactID:
 			values[i] = new(sql.NullInt64)
-		case billofmaterials.FieldURI, billofmaterials.FieldAlgorithm, billofmaterials.FieldDigest, billofmaterials.FieldDownloadLocation, billofmaterials.FieldOrigin, billofmaterials.FieldCollector:
+		case billofmaterials.FieldURI, billofmaterials.FieldAlgorithm, billofmaterials.FieldDigest, billofmaterials.FieldDownloadLocation, billofmaterials.FieldOrigin, billofmaterials.FieldCollector, billofmaterials.FieldSigner:
 			values[i] = new(sql.NullString)
 		case billofmaterials.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -164,6 +170,20 @@ func (bom *BillOfMaterials) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				bom.Collector = value.String
 			}
+		case billofmaterials.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				bom.Verified = new(bool)
+				*bom.Verified = value.Bool
+			}
+		case billofmaterials.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				bom.Signer = new(string)
+				*bom.Signer = value.String
+			}
 		case billofmaterials.FieldKnownSince:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field known_since", values[i])
@@ -244,6 +264,16 @@ func (bom *BillOfMaterials) String() string {
 	builder.WriteString("collector=")
 	builder.WriteString(bom.Collector)
 	builder.WriteString(", ")
+	if v := bom.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := bom.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("known_since=")
 	builder.WriteString(bom.KnownSince.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -28,6 +28,10 @@ const (
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
 	FieldCollector = "collector"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldSigner holds the string denoting the signer field in the database.
+	FieldSigner = "signer"
 	// FieldKnownSince holds the string denoting the known_since field in the database.
 	FieldKnownSince = "known_since"
 	// EdgePackage holds the string denoting the package edge name in mutations.
@@ -63,6 +67,8 @@ var Columns = []string{
 	FieldDownloadLocation,
 	FieldOrigin,
 	FieldCollector,
+	FieldVerified,
+	FieldSigner,
 	FieldKnownSince,
 }
 
@@ -124,6 +130,16 @@ func ByCollector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollector, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// BySigner orders the results by the signer field.
+func BySigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigner, opts...).ToFunc()
+}
+
 // ByKnownSince orders the results by the known_since field.
 func ByKnownSince(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKnownSince, opts...).ToFunc()
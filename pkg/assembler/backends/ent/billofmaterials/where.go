@@ -95,6 +95,16 @@ func Collector(v string) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEQ(FieldSigner, v))
+}
+
 // KnownSince applies equality check predicate on the "known_since" field. It's identical to KnownSinceEQ.
 func KnownSince(v time.Time) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldEQ(FieldKnownSince, v))
@@ -550,6 +560,101 @@ func CollectorContainsFold(v string) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // KnownSinceEQ applies the EQ predicate on the "known_since" field.
 func KnownSinceEQ(v time.Time) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldEQ(FieldKnownSince, v))
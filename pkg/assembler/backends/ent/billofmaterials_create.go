@@ -88,6 +88,34 @@ func (bomc *BillOfMaterialsCreate) SetCollector(s string) *BillOfMaterialsCreate
 	return bomc
 }
 
+// SetVerified sets the "verified" field.
+func (bomc *BillOfMaterialsCreate) SetVerified(b bool) *BillOfMaterialsCreate {
+	bomc.mutation.SetVerified(b)
+	return bomc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (bomc *BillOfMaterialsCreate) SetNillableVerified(b *bool) *BillOfMaterialsCreate {
+	if b != nil {
+		bomc.SetVerified(*b)
+	}
+	return bomc
+}
+
+// SetSigner sets the "signer" field.
+func (bomc *BillOfMaterialsCreate) SetSigner(s string) *BillOfMaterialsCreate {
+	bomc.mutation.SetSigner(s)
+	return bomc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (bomc *BillOfMaterialsCreate) SetNillableSigner(s *string) *BillOfMaterialsCreate {
+	if s != nil {
+		bomc.SetSigner(*s)
+	}
+	return bomc
+}
+
 // SetKnownSince sets the "known_since" field.
 func (bomc *BillOfMaterialsCreate) SetKnownSince(t time.Time) *BillOfMaterialsCreate {
 	bomc.mutation.SetKnownSince(t)
@@ -210,6 +238,14 @@ func (bomc *BillOfMaterialsCreate) createSpec() (*BillOfMaterials, *sqlgraph.Cre
 		_spec.SetField(billofmaterials.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := bomc.mutation.Verified(); ok {
+		_spec.SetField(billofmaterials.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := bomc.mutation.Signer(); ok {
+		_spec.SetField(billofmaterials.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if value, ok := bomc.mutation.KnownSince(); ok {
 		_spec.SetField(billofmaterials.FieldKnownSince, field.TypeTime, value)
 		_node.KnownSince = value
@@ -408,6 +444,42 @@ func (u *BillOfMaterialsUpsert) UpdateCollector() *BillOfMaterialsUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *BillOfMaterialsUpsert) SetVerified(v bool) *BillOfMaterialsUpsert {
+	u.Set(billofmaterials.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *BillOfMaterialsUpsert) UpdateVerified() *BillOfMaterialsUpsert {
+	u.SetExcluded(billofmaterials.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *BillOfMaterialsUpsert) ClearVerified() *BillOfMaterialsUpsert {
+	u.SetNull(billofmaterials.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *BillOfMaterialsUpsert) SetSigner(v string) *BillOfMaterialsUpsert {
+	u.Set(billofmaterials.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *BillOfMaterialsUpsert) UpdateSigner() *BillOfMaterialsUpsert {
+	u.SetExcluded(billofmaterials.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *BillOfMaterialsUpsert) ClearSigner() *BillOfMaterialsUpsert {
+	u.SetNull(billofmaterials.FieldSigner)
+	return u
+}
+
 // SetKnownSince sets the "known_since" field.
 func (u *BillOfMaterialsUpsert) SetKnownSince(v time.Time) *BillOfMaterialsUpsert {
 	u.Set(billofmaterials.FieldKnownSince, v)
@@ -586,6 +658,48 @@ func (u *BillOfMaterialsUpsertOne) UpdateCollector() *BillOfMaterialsUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *BillOfMaterialsUpsertOne) SetVerified(v bool) *BillOfMaterialsUpsertOne {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *BillOfMaterialsUpsertOne) UpdateVerified() *BillOfMaterialsUpsertOne {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *BillOfMaterialsUpsertOne) ClearVerified() *BillOfMaterialsUpsertOne {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *BillOfMaterialsUpsertOne) SetSigner(v string) *BillOfMaterialsUpsertOne {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *BillOfMaterialsUpsertOne) UpdateSigner() *BillOfMaterialsUpsertOne {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *BillOfMaterialsUpsertOne) ClearSigner() *BillOfMaterialsUpsertOne {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetKnownSince sets the "known_since" field.
 func (u *BillOfMaterialsUpsertOne) SetKnownSince(v time.Time) *BillOfMaterialsUpsertOne {
 	return u.Update(func(s *BillOfMaterialsUpsert) {
@@ -929,6 +1043,48 @@ func (u *BillOfMaterialsUpsertBulk) UpdateCollector() *BillOfMaterialsUpsertBulk
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *BillOfMaterialsUpsertBulk) SetVerified(v bool) *BillOfMaterialsUpsertBulk {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *BillOfMaterialsUpsertBulk) UpdateVerified() *BillOfMaterialsUpsertBulk {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *BillOfMaterialsUpsertBulk) ClearVerified() *BillOfMaterialsUpsertBulk {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *BillOfMaterialsUpsertBulk) SetSigner(v string) *BillOfMaterialsUpsertBulk {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *BillOfMaterialsUpsertBulk) UpdateSigner() *BillOfMaterialsUpsertBulk {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *BillOfMaterialsUpsertBulk) ClearSigner() *BillOfMaterialsUpsertBulk {
+	return u.Update(func(s *BillOfMaterialsUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetKnownSince sets the "known_since" field.
 func (u *BillOfMaterialsUpsertBulk) SetKnownSince(v time.Time) *BillOfMaterialsUpsertBulk {
 	return u.Update(func(s *BillOfMaterialsUpsert) {
@@ -154,6 +154,46 @@ func (bomu *BillOfMaterialsUpdate) SetNillableCollector(s *string) *BillOfMateri
 	return bomu
 }
 
+// SetVerified sets the "verified" field.
+func (bomu *BillOfMaterialsUpdate) SetVerified(b bool) *BillOfMaterialsUpdate {
+	bomu.mutation.SetVerified(b)
+	return bomu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (bomu *BillOfMaterialsUpdate) SetNillableVerified(b *bool) *BillOfMaterialsUpdate {
+	if b != nil {
+		bomu.SetVerified(*b)
+	}
+	return bomu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (bomu *BillOfMaterialsUpdate) ClearVerified() *BillOfMaterialsUpdate {
+	bomu.mutation.ClearVerified()
+	return bomu
+}
+
+// SetSigner sets the "signer" field.
+func (bomu *BillOfMaterialsUpdate) SetSigner(s string) *BillOfMaterialsUpdate {
+	bomu.mutation.SetSigner(s)
+	return bomu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (bomu *BillOfMaterialsUpdate) SetNillableSigner(s *string) *BillOfMaterialsUpdate {
+	if s != nil {
+		bomu.SetSigner(*s)
+	}
+	return bomu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (bomu *BillOfMaterialsUpdate) ClearSigner() *BillOfMaterialsUpdate {
+	bomu.mutation.ClearSigner()
+	return bomu
+}
+
 // SetKnownSince sets the "known_since" field.
 func (bomu *BillOfMaterialsUpdate) SetKnownSince(t time.Time) *BillOfMaterialsUpdate {
 	bomu.mutation.SetKnownSince(t)
@@ -249,6 +289,18 @@ func (bomu *BillOfMaterialsUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := bomu.mutation.Collector(); ok {
 		_spec.SetField(billofmaterials.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := bomu.mutation.Verified(); ok {
+		_spec.SetField(billofmaterials.FieldVerified, field.TypeBool, value)
+	}
+	if bomu.mutation.VerifiedCleared() {
+		_spec.ClearField(billofmaterials.FieldVerified, field.TypeBool)
+	}
+	if value, ok := bomu.mutation.Signer(); ok {
+		_spec.SetField(billofmaterials.FieldSigner, field.TypeString, value)
+	}
+	if bomu.mutation.SignerCleared() {
+		_spec.ClearField(billofmaterials.FieldSigner, field.TypeString)
+	}
 	if value, ok := bomu.mutation.KnownSince(); ok {
 		_spec.SetField(billofmaterials.FieldKnownSince, field.TypeTime, value)
 	}
@@ -454,6 +506,46 @@ func (bomuo *BillOfMaterialsUpdateOne) SetNillableCollector(s *string) *BillOfMa
 	return bomuo
 }
 
+// SetVerified sets the "verified" field.
+func (bomuo *BillOfMaterialsUpdateOne) SetVerified(b bool) *BillOfMaterialsUpdateOne {
+	bomuo.mutation.SetVerified(b)
+	return bomuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (bomuo *BillOfMaterialsUpdateOne) SetNillableVerified(b *bool) *BillOfMaterialsUpdateOne {
+	if b != nil {
+		bomuo.SetVerified(*b)
+	}
+	return bomuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (bomuo *BillOfMaterialsUpdateOne) ClearVerified() *BillOfMaterialsUpdateOne {
+	bomuo.mutation.ClearVerified()
+	return bomuo
+}
+
+// SetSigner sets the "signer" field.
+func (bomuo *BillOfMaterialsUpdateOne) SetSigner(s string) *BillOfMaterialsUpdateOne {
+	bomuo.mutation.SetSigner(s)
+	return bomuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (bomuo *BillOfMaterialsUpdateOne) SetNillableSigner(s *string) *BillOfMaterialsUpdateOne {
+	if s != nil {
+		bomuo.SetSigner(*s)
+	}
+	return bomuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (bomuo *BillOfMaterialsUpdateOne) ClearSigner() *BillOfMaterialsUpdateOne {
+	bomuo.mutation.ClearSigner()
+	return bomuo
+}
+
 // SetKnownSince sets the "known_since" field.
 func (bomuo *BillOfMaterialsUpdateOne) SetKnownSince(t time.Time) *BillOfMaterialsUpdateOne {
 	bomuo.mutation.SetKnownSince(t)
@@ -579,6 +671,18 @@ func (bomuo *BillOfMaterialsUpdateOne) sqlSave(ctx context.Context) (_node *Bill
 	if value, ok := bomuo.mutation.Collector(); ok {
 		_spec.SetField(billofmaterials.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := bomuo.mutation.Verified(); ok {
+		_spec.SetField(billofmaterials.FieldVerified, field.TypeBool, value)
+	}
+	if bomuo.mutation.VerifiedCleared() {
+		_spec.ClearField(billofmaterials.FieldVerified, field.TypeBool)
+	}
+	if value, ok := bomuo.mutation.Signer(); ok {
+		_spec.SetField(billofmaterials.FieldSigner, field.TypeString, value)
+	}
+	if bomuo.mutation.SignerCleared() {
+		_spec.ClearField(billofmaterials.FieldSigner, field.TypeString)
+	}
 	if value, ok := bomuo.mutation.KnownSince(); ok {
 		_spec.SetField(billofmaterials.FieldKnownSince, field.TypeTime, value)
 	}
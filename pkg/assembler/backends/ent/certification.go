@@ -37,6 +37,10 @@ type Certification struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// KnownSince holds the value of the "known_since" field.
 	KnownSince time.Time `json:"known_since,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -119,9 +123,11 @@ func (*Certification) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case certification.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case certification.FieldID, certification.FieldSourceID, certification.FieldPackageVersionID, certification.FieldPackageNameID, certification.FieldArtifactID:
 			values[i] = new(sql.NullInt64)
-		case certification.FieldType, certification.FieldJustification, certification.FieldOrigin, certification.FieldCollector:
+		case certification.FieldType, certification.FieldJustification, certification.FieldOrigin, certification.FieldCollector, certification.FieldSigner:
 			values[i] = new(sql.NullString)
 		case certification.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -198,6 +204,20 @@ func (c *Certification) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Collector = value.String
 			}
+		case certification.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				c.Verified = new(bool)
+				*c.Verified = value.Bool
+			}
+		case certification.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				c.Signer = new(string)
+				*c.Signer = value.String
+			}
 		case certification.FieldKnownSince:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field known_since", values[i])
@@ -292,6 +312,16 @@ func (c *Certification) String() string {
 	builder.WriteString("collector=")
 	builder.WriteString(c.Collector)
 	builder.WriteString(", ")
+	if v := c.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("known_since=")
 	builder.WriteString(c.KnownSince.Format(time.ANSIC))
 	builder.WriteByte(')')
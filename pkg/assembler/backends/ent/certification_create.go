@@ -114,6 +114,34 @@ func (cc *CertificationCreate) SetCollector(s string) *CertificationCreate {
 	return cc
 }
 
+// SetVerified sets the "verified" field.
+func (cc *CertificationCreate) SetVerified(b bool) *CertificationCreate {
+	cc.mutation.SetVerified(b)
+	return cc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cc *CertificationCreate) SetNillableVerified(b *bool) *CertificationCreate {
+	if b != nil {
+		cc.SetVerified(*b)
+	}
+	return cc
+}
+
+// SetSigner sets the "signer" field.
+func (cc *CertificationCreate) SetSigner(s string) *CertificationCreate {
+	cc.mutation.SetSigner(s)
+	return cc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cc *CertificationCreate) SetNillableSigner(s *string) *CertificationCreate {
+	if s != nil {
+		cc.SetSigner(*s)
+	}
+	return cc
+}
+
 // SetKnownSince sets the "known_since" field.
 func (cc *CertificationCreate) SetKnownSince(t time.Time) *CertificationCreate {
 	cc.mutation.SetKnownSince(t)
@@ -260,6 +288,14 @@ func (cc *CertificationCreate) createSpec() (*Certification, *sqlgraph.CreateSpe
 		_spec.SetField(certification.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := cc.mutation.Verified(); ok {
+		_spec.SetField(certification.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := cc.mutation.Signer(); ok {
+		_spec.SetField(certification.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if value, ok := cc.mutation.KnownSince(); ok {
 		_spec.SetField(certification.FieldKnownSince, field.TypeTime, value)
 		_node.KnownSince = value
@@ -504,6 +540,42 @@ func (u *CertificationUpsert) UpdateCollector() *CertificationUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertificationUpsert) SetVerified(v bool) *CertificationUpsert {
+	u.Set(certification.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertificationUpsert) UpdateVerified() *CertificationUpsert {
+	u.SetExcluded(certification.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertificationUpsert) ClearVerified() *CertificationUpsert {
+	u.SetNull(certification.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertificationUpsert) SetSigner(v string) *CertificationUpsert {
+	u.Set(certification.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertificationUpsert) UpdateSigner() *CertificationUpsert {
+	u.SetExcluded(certification.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertificationUpsert) ClearSigner() *CertificationUpsert {
+	u.SetNull(certification.FieldSigner)
+	return u
+}
+
 // SetKnownSince sets the "known_since" field.
 func (u *CertificationUpsert) SetKnownSince(v time.Time) *CertificationUpsert {
 	u.Set(certification.FieldKnownSince, v)
@@ -696,6 +768,48 @@ func (u *CertificationUpsertOne) UpdateCollector() *CertificationUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertificationUpsertOne) SetVerified(v bool) *CertificationUpsertOne {
+	return u.Update(func(s *CertificationUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertificationUpsertOne) UpdateVerified() *CertificationUpsertOne {
+	return u.Update(func(s *CertificationUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertificationUpsertOne) ClearVerified() *CertificationUpsertOne {
+	return u.Update(func(s *CertificationUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertificationUpsertOne) SetSigner(v string) *CertificationUpsertOne {
+	return u.Update(func(s *CertificationUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertificationUpsertOne) UpdateSigner() *CertificationUpsertOne {
+	return u.Update(func(s *CertificationUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertificationUpsertOne) ClearSigner() *CertificationUpsertOne {
+	return u.Update(func(s *CertificationUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetKnownSince sets the "known_since" field.
 func (u *CertificationUpsertOne) SetKnownSince(v time.Time) *CertificationUpsertOne {
 	return u.Update(func(s *CertificationUpsert) {
@@ -1054,6 +1168,48 @@ func (u *CertificationUpsertBulk) UpdateCollector() *CertificationUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertificationUpsertBulk) SetVerified(v bool) *CertificationUpsertBulk {
+	return u.Update(func(s *CertificationUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertificationUpsertBulk) UpdateVerified() *CertificationUpsertBulk {
+	return u.Update(func(s *CertificationUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertificationUpsertBulk) ClearVerified() *CertificationUpsertBulk {
+	return u.Update(func(s *CertificationUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertificationUpsertBulk) SetSigner(v string) *CertificationUpsertBulk {
+	return u.Update(func(s *CertificationUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertificationUpsertBulk) UpdateSigner() *CertificationUpsertBulk {
+	return u.Update(func(s *CertificationUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertificationUpsertBulk) ClearSigner() *CertificationUpsertBulk {
+	return u.Update(func(s *CertificationUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetKnownSince sets the "known_since" field.
 func (u *CertificationUpsertBulk) SetKnownSince(v time.Time) *CertificationUpsertBulk {
 	return u.Update(func(s *CertificationUpsert) {
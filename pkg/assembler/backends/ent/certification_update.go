@@ -168,6 +168,46 @@ func (cu *CertificationUpdate) SetNillableCollector(s *string) *CertificationUpd
 	return cu
 }
 
+// SetVerified sets the "verified" field.
+func (cu *CertificationUpdate) SetVerified(b bool) *CertificationUpdate {
+	cu.mutation.SetVerified(b)
+	return cu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cu *CertificationUpdate) SetNillableVerified(b *bool) *CertificationUpdate {
+	if b != nil {
+		cu.SetVerified(*b)
+	}
+	return cu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (cu *CertificationUpdate) ClearVerified() *CertificationUpdate {
+	cu.mutation.ClearVerified()
+	return cu
+}
+
+// SetSigner sets the "signer" field.
+func (cu *CertificationUpdate) SetSigner(s string) *CertificationUpdate {
+	cu.mutation.SetSigner(s)
+	return cu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cu *CertificationUpdate) SetNillableSigner(s *string) *CertificationUpdate {
+	if s != nil {
+		cu.SetSigner(*s)
+	}
+	return cu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (cu *CertificationUpdate) ClearSigner() *CertificationUpdate {
+	cu.mutation.ClearSigner()
+	return cu
+}
+
 // SetKnownSince sets the "known_since" field.
 func (cu *CertificationUpdate) SetKnownSince(t time.Time) *CertificationUpdate {
 	cu.mutation.SetKnownSince(t)
@@ -306,6 +346,18 @@ func (cu *CertificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.Collector(); ok {
 		_spec.SetField(certification.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := cu.mutation.Verified(); ok {
+		_spec.SetField(certification.FieldVerified, field.TypeBool, value)
+	}
+	if cu.mutation.VerifiedCleared() {
+		_spec.ClearField(certification.FieldVerified, field.TypeBool)
+	}
+	if value, ok := cu.mutation.Signer(); ok {
+		_spec.SetField(certification.FieldSigner, field.TypeString, value)
+	}
+	if cu.mutation.SignerCleared() {
+		_spec.ClearField(certification.FieldSigner, field.TypeString)
+	}
 	if value, ok := cu.mutation.KnownSince(); ok {
 		_spec.SetField(certification.FieldKnownSince, field.TypeTime, value)
 	}
@@ -581,6 +633,46 @@ func (cuo *CertificationUpdateOne) SetNillableCollector(s *string) *Certificatio
 	return cuo
 }
 
+// SetVerified sets the "verified" field.
+func (cuo *CertificationUpdateOne) SetVerified(b bool) *CertificationUpdateOne {
+	cuo.mutation.SetVerified(b)
+	return cuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cuo *CertificationUpdateOne) SetNillableVerified(b *bool) *CertificationUpdateOne {
+	if b != nil {
+		cuo.SetVerified(*b)
+	}
+	return cuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (cuo *CertificationUpdateOne) ClearVerified() *CertificationUpdateOne {
+	cuo.mutation.ClearVerified()
+	return cuo
+}
+
+// SetSigner sets the "signer" field.
+func (cuo *CertificationUpdateOne) SetSigner(s string) *CertificationUpdateOne {
+	cuo.mutation.SetSigner(s)
+	return cuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cuo *CertificationUpdateOne) SetNillableSigner(s *string) *CertificationUpdateOne {
+	if s != nil {
+		cuo.SetSigner(*s)
+	}
+	return cuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (cuo *CertificationUpdateOne) ClearSigner() *CertificationUpdateOne {
+	cuo.mutation.ClearSigner()
+	return cuo
+}
+
 // SetKnownSince sets the "known_since" field.
 func (cuo *CertificationUpdateOne) SetKnownSince(t time.Time) *CertificationUpdateOne {
 	cuo.mutation.SetKnownSince(t)
@@ -749,6 +841,18 @@ func (cuo *CertificationUpdateOne) sqlSave(ctx context.Context) (_node *Certific
 	if value, ok := cuo.mutation.Collector(); ok {
 		_spec.SetField(certification.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := cuo.mutation.Verified(); ok {
+		_spec.SetField(certification.FieldVerified, field.TypeBool, value)
+	}
+	if cuo.mutation.VerifiedCleared() {
+		_spec.ClearField(certification.FieldVerified, field.TypeBool)
+	}
+	if value, ok := cuo.mutation.Signer(); ok {
+		_spec.SetField(certification.FieldSigner, field.TypeString, value)
+	}
+	if cuo.mutation.SignerCleared() {
+		_spec.ClearField(certification.FieldSigner, field.TypeString)
+	}
 	if value, ok := cuo.mutation.KnownSince(); ok {
 		_spec.SetField(certification.FieldKnownSince, field.TypeTime, value)
 	}
@@ -37,6 +37,10 @@ type CertifyLegal struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// An opaque hash of the declared license IDs to ensure uniqueness
 	DeclaredLicensesHash string `json:"declared_licenses_hash,omitempty"`
 	// An opaque hash of the discovered license IDs to ensure uniqueness
@@ -116,9 +120,11 @@ func (*CertifyLegal) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case certifylegal.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case certifylegal.FieldID, certifylegal.FieldPackageID, certifylegal.FieldSourceID:
 			values[i] = new(sql.NullInt64)
-		case certifylegal.FieldDeclaredLicense, certifylegal.FieldDiscoveredLicense, certifylegal.FieldAttribution, certifylegal.FieldJustification, certifylegal.FieldOrigin, certifylegal.FieldCollector, certifylegal.FieldDeclaredLicensesHash, certifylegal.FieldDiscoveredLicensesHash:
+		case certifylegal.FieldDeclaredLicense, certifylegal.FieldDiscoveredLicense, certifylegal.FieldAttribution, certifylegal.FieldJustification, certifylegal.FieldOrigin, certifylegal.FieldCollector, certifylegal.FieldSigner, certifylegal.FieldDeclaredLicensesHash, certifylegal.FieldDiscoveredLicensesHash:
 			values[i] = new(sql.NullString)
 		case certifylegal.FieldTimeScanned:
 			values[i] = new(sql.NullTime)
@@ -199,6 +205,20 @@ func (cl *CertifyLegal) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cl.Collector = value.String
 			}
+		case certifylegal.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				cl.Verified = new(bool)
+				*cl.Verified = value.Bool
+			}
+		case certifylegal.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				cl.Signer = new(string)
+				*cl.Signer = value.String
+			}
 		case certifylegal.FieldDeclaredLicensesHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field declared_licenses_hash", values[i])
@@ -298,6 +318,16 @@ func (cl *CertifyLegal) String() string {
 	builder.WriteString("collector=")
 	builder.WriteString(cl.Collector)
 	builder.WriteString(", ")
+	if v := cl.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cl.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("declared_licenses_hash=")
 	builder.WriteString(cl.DeclaredLicensesHash)
 	builder.WriteString(", ")
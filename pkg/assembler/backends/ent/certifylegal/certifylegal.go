@@ -30,6 +30,10 @@ const (
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
 	FieldCollector = "collector"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldSigner holds the string denoting the signer field in the database.
+	FieldSigner = "signer"
 	// FieldDeclaredLicensesHash holds the string denoting the declared_licenses_hash field in the database.
 	FieldDeclaredLicensesHash = "declared_licenses_hash"
 	// FieldDiscoveredLicensesHash holds the string denoting the discovered_licenses_hash field in the database.
@@ -82,6 +86,8 @@ var Columns = []string{
 	FieldTimeScanned,
 	FieldOrigin,
 	FieldCollector,
+	FieldVerified,
+	FieldSigner,
 	FieldDeclaredLicensesHash,
 	FieldDiscoveredLicensesHash,
 }
@@ -158,6 +164,16 @@ func ByCollector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollector, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// BySigner orders the results by the signer field.
+func BySigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigner, opts...).ToFunc()
+}
+
 // ByDeclaredLicensesHash orders the results by the declared_licenses_hash field.
 func ByDeclaredLicensesHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeclaredLicensesHash, opts...).ToFunc()
@@ -100,6 +100,16 @@ func Collector(v string) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEQ(FieldSigner, v))
+}
+
 // DeclaredLicensesHash applies equality check predicate on the "declared_licenses_hash" field. It's identical to DeclaredLicensesHashEQ.
 func DeclaredLicensesHash(v string) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldEQ(FieldDeclaredLicensesHash, v))
@@ -600,6 +610,101 @@ func CollectorContainsFold(v string) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // DeclaredLicensesHashEQ applies the EQ predicate on the "declared_licenses_hash" field.
 func DeclaredLicensesHashEQ(v string) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldEQ(FieldDeclaredLicensesHash, v))
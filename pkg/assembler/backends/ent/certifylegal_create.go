@@ -95,6 +95,34 @@ func (clc *CertifyLegalCreate) SetCollector(s string) *CertifyLegalCreate {
 	return clc
 }
 
+// SetVerified sets the "verified" field.
+func (clc *CertifyLegalCreate) SetVerified(b bool) *CertifyLegalCreate {
+	clc.mutation.SetVerified(b)
+	return clc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (clc *CertifyLegalCreate) SetNillableVerified(b *bool) *CertifyLegalCreate {
+	if b != nil {
+		clc.SetVerified(*b)
+	}
+	return clc
+}
+
+// SetSigner sets the "signer" field.
+func (clc *CertifyLegalCreate) SetSigner(s string) *CertifyLegalCreate {
+	clc.mutation.SetSigner(s)
+	return clc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (clc *CertifyLegalCreate) SetNillableSigner(s *string) *CertifyLegalCreate {
+	if s != nil {
+		clc.SetSigner(*s)
+	}
+	return clc
+}
+
 // SetDeclaredLicensesHash sets the "declared_licenses_hash" field.
 func (clc *CertifyLegalCreate) SetDeclaredLicensesHash(s string) *CertifyLegalCreate {
 	clc.mutation.SetDeclaredLicensesHash(s)
@@ -263,6 +291,14 @@ func (clc *CertifyLegalCreate) createSpec() (*CertifyLegal, *sqlgraph.CreateSpec
 		_spec.SetField(certifylegal.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := clc.mutation.Verified(); ok {
+		_spec.SetField(certifylegal.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := clc.mutation.Signer(); ok {
+		_spec.SetField(certifylegal.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if value, ok := clc.mutation.DeclaredLicensesHash(); ok {
 		_spec.SetField(certifylegal.FieldDeclaredLicensesHash, field.TypeString, value)
 		_node.DeclaredLicensesHash = value
@@ -509,6 +545,42 @@ func (u *CertifyLegalUpsert) UpdateCollector() *CertifyLegalUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyLegalUpsert) SetVerified(v bool) *CertifyLegalUpsert {
+	u.Set(certifylegal.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyLegalUpsert) UpdateVerified() *CertifyLegalUpsert {
+	u.SetExcluded(certifylegal.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyLegalUpsert) ClearVerified() *CertifyLegalUpsert {
+	u.SetNull(certifylegal.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyLegalUpsert) SetSigner(v string) *CertifyLegalUpsert {
+	u.Set(certifylegal.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyLegalUpsert) UpdateSigner() *CertifyLegalUpsert {
+	u.SetExcluded(certifylegal.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyLegalUpsert) ClearSigner() *CertifyLegalUpsert {
+	u.SetNull(certifylegal.FieldSigner)
+	return u
+}
+
 // SetDeclaredLicensesHash sets the "declared_licenses_hash" field.
 func (u *CertifyLegalUpsert) SetDeclaredLicensesHash(v string) *CertifyLegalUpsert {
 	u.Set(certifylegal.FieldDeclaredLicensesHash, v)
@@ -713,6 +785,48 @@ func (u *CertifyLegalUpsertOne) UpdateCollector() *CertifyLegalUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyLegalUpsertOne) SetVerified(v bool) *CertifyLegalUpsertOne {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyLegalUpsertOne) UpdateVerified() *CertifyLegalUpsertOne {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyLegalUpsertOne) ClearVerified() *CertifyLegalUpsertOne {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyLegalUpsertOne) SetSigner(v string) *CertifyLegalUpsertOne {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyLegalUpsertOne) UpdateSigner() *CertifyLegalUpsertOne {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyLegalUpsertOne) ClearSigner() *CertifyLegalUpsertOne {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetDeclaredLicensesHash sets the "declared_licenses_hash" field.
 func (u *CertifyLegalUpsertOne) SetDeclaredLicensesHash(v string) *CertifyLegalUpsertOne {
 	return u.Update(func(s *CertifyLegalUpsert) {
@@ -1084,6 +1198,48 @@ func (u *CertifyLegalUpsertBulk) UpdateCollector() *CertifyLegalUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyLegalUpsertBulk) SetVerified(v bool) *CertifyLegalUpsertBulk {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyLegalUpsertBulk) UpdateVerified() *CertifyLegalUpsertBulk {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyLegalUpsertBulk) ClearVerified() *CertifyLegalUpsertBulk {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyLegalUpsertBulk) SetSigner(v string) *CertifyLegalUpsertBulk {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyLegalUpsertBulk) UpdateSigner() *CertifyLegalUpsertBulk {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyLegalUpsertBulk) ClearSigner() *CertifyLegalUpsertBulk {
+	return u.Update(func(s *CertifyLegalUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetDeclaredLicensesHash sets the "declared_licenses_hash" field.
 func (u *CertifyLegalUpsertBulk) SetDeclaredLicensesHash(v string) *CertifyLegalUpsertBulk {
 	return u.Update(func(s *CertifyLegalUpsert) {
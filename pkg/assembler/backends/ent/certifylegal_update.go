@@ -169,6 +169,46 @@ func (clu *CertifyLegalUpdate) SetNillableCollector(s *string) *CertifyLegalUpda
 	return clu
 }
 
+// SetVerified sets the "verified" field.
+func (clu *CertifyLegalUpdate) SetVerified(b bool) *CertifyLegalUpdate {
+	clu.mutation.SetVerified(b)
+	return clu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (clu *CertifyLegalUpdate) SetNillableVerified(b *bool) *CertifyLegalUpdate {
+	if b != nil {
+		clu.SetVerified(*b)
+	}
+	return clu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (clu *CertifyLegalUpdate) ClearVerified() *CertifyLegalUpdate {
+	clu.mutation.ClearVerified()
+	return clu
+}
+
+// SetSigner sets the "signer" field.
+func (clu *CertifyLegalUpdate) SetSigner(s string) *CertifyLegalUpdate {
+	clu.mutation.SetSigner(s)
+	return clu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (clu *CertifyLegalUpdate) SetNillableSigner(s *string) *CertifyLegalUpdate {
+	if s != nil {
+		clu.SetSigner(*s)
+	}
+	return clu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (clu *CertifyLegalUpdate) ClearSigner() *CertifyLegalUpdate {
+	clu.mutation.ClearSigner()
+	return clu
+}
+
 // SetDeclaredLicensesHash sets the "declared_licenses_hash" field.
 func (clu *CertifyLegalUpdate) SetDeclaredLicensesHash(s string) *CertifyLegalUpdate {
 	clu.mutation.SetDeclaredLicensesHash(s)
@@ -353,6 +393,18 @@ func (clu *CertifyLegalUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := clu.mutation.Collector(); ok {
 		_spec.SetField(certifylegal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := clu.mutation.Verified(); ok {
+		_spec.SetField(certifylegal.FieldVerified, field.TypeBool, value)
+	}
+	if clu.mutation.VerifiedCleared() {
+		_spec.ClearField(certifylegal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := clu.mutation.Signer(); ok {
+		_spec.SetField(certifylegal.FieldSigner, field.TypeString, value)
+	}
+	if clu.mutation.SignerCleared() {
+		_spec.ClearField(certifylegal.FieldSigner, field.TypeString)
+	}
 	if value, ok := clu.mutation.DeclaredLicensesHash(); ok {
 		_spec.SetField(certifylegal.FieldDeclaredLicensesHash, field.TypeString, value)
 	}
@@ -665,6 +717,46 @@ func (cluo *CertifyLegalUpdateOne) SetNillableCollector(s *string) *CertifyLegal
 	return cluo
 }
 
+// SetVerified sets the "verified" field.
+func (cluo *CertifyLegalUpdateOne) SetVerified(b bool) *CertifyLegalUpdateOne {
+	cluo.mutation.SetVerified(b)
+	return cluo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cluo *CertifyLegalUpdateOne) SetNillableVerified(b *bool) *CertifyLegalUpdateOne {
+	if b != nil {
+		cluo.SetVerified(*b)
+	}
+	return cluo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (cluo *CertifyLegalUpdateOne) ClearVerified() *CertifyLegalUpdateOne {
+	cluo.mutation.ClearVerified()
+	return cluo
+}
+
+// SetSigner sets the "signer" field.
+func (cluo *CertifyLegalUpdateOne) SetSigner(s string) *CertifyLegalUpdateOne {
+	cluo.mutation.SetSigner(s)
+	return cluo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cluo *CertifyLegalUpdateOne) SetNillableSigner(s *string) *CertifyLegalUpdateOne {
+	if s != nil {
+		cluo.SetSigner(*s)
+	}
+	return cluo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (cluo *CertifyLegalUpdateOne) ClearSigner() *CertifyLegalUpdateOne {
+	cluo.mutation.ClearSigner()
+	return cluo
+}
+
 // SetDeclaredLicensesHash sets the "declared_licenses_hash" field.
 func (cluo *CertifyLegalUpdateOne) SetDeclaredLicensesHash(s string) *CertifyLegalUpdateOne {
 	cluo.mutation.SetDeclaredLicensesHash(s)
@@ -879,6 +971,18 @@ func (cluo *CertifyLegalUpdateOne) sqlSave(ctx context.Context) (_node *CertifyL
 	if value, ok := cluo.mutation.Collector(); ok {
 		_spec.SetField(certifylegal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := cluo.mutation.Verified(); ok {
+		_spec.SetField(certifylegal.FieldVerified, field.TypeBool, value)
+	}
+	if cluo.mutation.VerifiedCleared() {
+		_spec.ClearField(certifylegal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := cluo.mutation.Signer(); ok {
+		_spec.SetField(certifylegal.FieldSigner, field.TypeString, value)
+	}
+	if cluo.mutation.SignerCleared() {
+		_spec.ClearField(certifylegal.FieldSigner, field.TypeString)
+	}
 	if value, ok := cluo.mutation.DeclaredLicensesHash(); ok {
 		_spec.SetField(certifylegal.FieldDeclaredLicensesHash, field.TypeString, value)
 	}
@@ -40,6 +40,10 @@ type CertifyVex struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CertifyVexQuery when eager-loading is set.
 	Edges        CertifyVexEdges `json:"edges"`
@@ -105,9 +109,11 @@ func (*CertifyVex) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case certifyvex.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case certifyvex.FieldID, certifyvex.FieldPackageID, certifyvex.FieldArtifactID, certifyvex.FieldVulnerabilityID:
 			values[i] = new(sql.NullInt64)
-		case certifyvex.FieldStatus, certifyvex.FieldStatement, certifyvex.FieldStatusNotes, certifyvex.FieldJustification, certifyvex.FieldOrigin, certifyvex.FieldCollector:
+		case certifyvex.FieldStatus, certifyvex.FieldStatement, certifyvex.FieldStatusNotes, certifyvex.FieldJustification, certifyvex.FieldOrigin, certifyvex.FieldCollector, certifyvex.FieldSigner:
 			values[i] = new(sql.NullString)
 		case certifyvex.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -194,6 +200,20 @@ func (cv *CertifyVex) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cv.Collector = value.String
 			}
+		case certifyvex.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				cv.Verified = new(bool)
+				*cv.Verified = value.Bool
+			}
+		case certifyvex.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				cv.Signer = new(string)
+				*cv.Signer = value.String
+			}
 		default:
 			cv.selectValues.Set(columns[i], values[i])
 		}
@@ -278,6 +298,16 @@ func (cv *CertifyVex) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(cv.Collector)
+	builder.WriteString(", ")
+	if v := cv.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cv.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
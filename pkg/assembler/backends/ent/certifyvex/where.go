@@ -105,6 +105,16 @@ func Collector(v string) predicate.CertifyVex {
 	return predicate.CertifyVex(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldEQ(FieldSigner, v))
+}
+
 // PackageIDEQ applies the EQ predicate on the "package_id" field.
 func PackageIDEQ(v int) predicate.CertifyVex {
 	return predicate.CertifyVex(sql.FieldEQ(FieldPackageID, v))
@@ -615,6 +625,101 @@ func CollectorContainsFold(v string) predicate.CertifyVex {
 	return predicate.CertifyVex(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.CertifyVex {
+	return predicate.CertifyVex(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasPackage applies the HasEdge predicate on the "package" edge.
 func HasPackage() predicate.CertifyVex {
 	return predicate.CertifyVex(func(s *sql.Selector) {
@@ -101,6 +101,34 @@ func (cvc *CertifyVexCreate) SetCollector(s string) *CertifyVexCreate {
 	return cvc
 }
 
+// SetVerified sets the "verified" field.
+func (cvc *CertifyVexCreate) SetVerified(b bool) *CertifyVexCreate {
+	cvc.mutation.SetVerified(b)
+	return cvc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cvc *CertifyVexCreate) SetNillableVerified(b *bool) *CertifyVexCreate {
+	if b != nil {
+		cvc.SetVerified(*b)
+	}
+	return cvc
+}
+
+// SetSigner sets the "signer" field.
+func (cvc *CertifyVexCreate) SetSigner(s string) *CertifyVexCreate {
+	cvc.mutation.SetSigner(s)
+	return cvc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cvc *CertifyVexCreate) SetNillableSigner(s *string) *CertifyVexCreate {
+	if s != nil {
+		cvc.SetSigner(*s)
+	}
+	return cvc
+}
+
 // SetPackage sets the "package" edge to the PackageVersion entity.
 func (cvc *CertifyVexCreate) SetPackage(p *PackageVersion) *CertifyVexCreate {
 	return cvc.SetPackageID(p.ID)
@@ -232,6 +260,14 @@ func (cvc *CertifyVexCreate) createSpec() (*CertifyVex, *sqlgraph.CreateSpec) {
 		_spec.SetField(certifyvex.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := cvc.mutation.Verified(); ok {
+		_spec.SetField(certifyvex.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := cvc.mutation.Signer(); ok {
+		_spec.SetField(certifyvex.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := cvc.mutation.PackageIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -467,6 +503,42 @@ func (u *CertifyVexUpsert) UpdateCollector() *CertifyVexUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyVexUpsert) SetVerified(v bool) *CertifyVexUpsert {
+	u.Set(certifyvex.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyVexUpsert) UpdateVerified() *CertifyVexUpsert {
+	u.SetExcluded(certifyvex.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyVexUpsert) ClearVerified() *CertifyVexUpsert {
+	u.SetNull(certifyvex.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyVexUpsert) SetSigner(v string) *CertifyVexUpsert {
+	u.Set(certifyvex.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyVexUpsert) UpdateSigner() *CertifyVexUpsert {
+	u.SetExcluded(certifyvex.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyVexUpsert) ClearSigner() *CertifyVexUpsert {
+	u.SetNull(certifyvex.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -661,6 +733,48 @@ func (u *CertifyVexUpsertOne) UpdateCollector() *CertifyVexUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyVexUpsertOne) SetVerified(v bool) *CertifyVexUpsertOne {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyVexUpsertOne) UpdateVerified() *CertifyVexUpsertOne {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyVexUpsertOne) ClearVerified() *CertifyVexUpsertOne {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyVexUpsertOne) SetSigner(v string) *CertifyVexUpsertOne {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyVexUpsertOne) UpdateSigner() *CertifyVexUpsertOne {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyVexUpsertOne) ClearSigner() *CertifyVexUpsertOne {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *CertifyVexUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1018,6 +1132,48 @@ func (u *CertifyVexUpsertBulk) UpdateCollector() *CertifyVexUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyVexUpsertBulk) SetVerified(v bool) *CertifyVexUpsertBulk {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyVexUpsertBulk) UpdateVerified() *CertifyVexUpsertBulk {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyVexUpsertBulk) ClearVerified() *CertifyVexUpsertBulk {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyVexUpsertBulk) SetSigner(v string) *CertifyVexUpsertBulk {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyVexUpsertBulk) UpdateSigner() *CertifyVexUpsertBulk {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyVexUpsertBulk) ClearSigner() *CertifyVexUpsertBulk {
+	return u.Update(func(s *CertifyVexUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *CertifyVexUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
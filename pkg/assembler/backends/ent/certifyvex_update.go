@@ -183,6 +183,46 @@ func (cvu *CertifyVexUpdate) SetNillableCollector(s *string) *CertifyVexUpdate {
 	return cvu
 }
 
+// SetVerified sets the "verified" field.
+func (cvu *CertifyVexUpdate) SetVerified(b bool) *CertifyVexUpdate {
+	cvu.mutation.SetVerified(b)
+	return cvu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cvu *CertifyVexUpdate) SetNillableVerified(b *bool) *CertifyVexUpdate {
+	if b != nil {
+		cvu.SetVerified(*b)
+	}
+	return cvu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (cvu *CertifyVexUpdate) ClearVerified() *CertifyVexUpdate {
+	cvu.mutation.ClearVerified()
+	return cvu
+}
+
+// SetSigner sets the "signer" field.
+func (cvu *CertifyVexUpdate) SetSigner(s string) *CertifyVexUpdate {
+	cvu.mutation.SetSigner(s)
+	return cvu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cvu *CertifyVexUpdate) SetNillableSigner(s *string) *CertifyVexUpdate {
+	if s != nil {
+		cvu.SetSigner(*s)
+	}
+	return cvu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (cvu *CertifyVexUpdate) ClearSigner() *CertifyVexUpdate {
+	cvu.mutation.ClearSigner()
+	return cvu
+}
+
 // SetPackage sets the "package" edge to the PackageVersion entity.
 func (cvu *CertifyVexUpdate) SetPackage(p *PackageVersion) *CertifyVexUpdate {
 	return cvu.SetPackageID(p.ID)
@@ -289,6 +329,18 @@ func (cvu *CertifyVexUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cvu.mutation.Collector(); ok {
 		_spec.SetField(certifyvex.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := cvu.mutation.Verified(); ok {
+		_spec.SetField(certifyvex.FieldVerified, field.TypeBool, value)
+	}
+	if cvu.mutation.VerifiedCleared() {
+		_spec.ClearField(certifyvex.FieldVerified, field.TypeBool)
+	}
+	if value, ok := cvu.mutation.Signer(); ok {
+		_spec.SetField(certifyvex.FieldSigner, field.TypeString, value)
+	}
+	if cvu.mutation.SignerCleared() {
+		_spec.ClearField(certifyvex.FieldSigner, field.TypeString)
+	}
 	if cvu.mutation.PackageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -548,6 +600,46 @@ func (cvuo *CertifyVexUpdateOne) SetNillableCollector(s *string) *CertifyVexUpda
 	return cvuo
 }
 
+// SetVerified sets the "verified" field.
+func (cvuo *CertifyVexUpdateOne) SetVerified(b bool) *CertifyVexUpdateOne {
+	cvuo.mutation.SetVerified(b)
+	return cvuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cvuo *CertifyVexUpdateOne) SetNillableVerified(b *bool) *CertifyVexUpdateOne {
+	if b != nil {
+		cvuo.SetVerified(*b)
+	}
+	return cvuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (cvuo *CertifyVexUpdateOne) ClearVerified() *CertifyVexUpdateOne {
+	cvuo.mutation.ClearVerified()
+	return cvuo
+}
+
+// SetSigner sets the "signer" field.
+func (cvuo *CertifyVexUpdateOne) SetSigner(s string) *CertifyVexUpdateOne {
+	cvuo.mutation.SetSigner(s)
+	return cvuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cvuo *CertifyVexUpdateOne) SetNillableSigner(s *string) *CertifyVexUpdateOne {
+	if s != nil {
+		cvuo.SetSigner(*s)
+	}
+	return cvuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (cvuo *CertifyVexUpdateOne) ClearSigner() *CertifyVexUpdateOne {
+	cvuo.mutation.ClearSigner()
+	return cvuo
+}
+
 // SetPackage sets the "package" edge to the PackageVersion entity.
 func (cvuo *CertifyVexUpdateOne) SetPackage(p *PackageVersion) *CertifyVexUpdateOne {
 	return cvuo.SetPackageID(p.ID)
@@ -684,6 +776,18 @@ func (cvuo *CertifyVexUpdateOne) sqlSave(ctx context.Context) (_node *CertifyVex
 	if value, ok := cvuo.mutation.Collector(); ok {
 		_spec.SetField(certifyvex.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := cvuo.mutation.Verified(); ok {
+		_spec.SetField(certifyvex.FieldVerified, field.TypeBool, value)
+	}
+	if cvuo.mutation.VerifiedCleared() {
+		_spec.ClearField(certifyvex.FieldVerified, field.TypeBool)
+	}
+	if value, ok := cvuo.mutation.Signer(); ok {
+		_spec.SetField(certifyvex.FieldSigner, field.TypeString, value)
+	}
+	if cvuo.mutation.SignerCleared() {
+		_spec.ClearField(certifyvex.FieldSigner, field.TypeString)
+	}
 	if cvuo.mutation.PackageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
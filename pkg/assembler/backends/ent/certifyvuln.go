@@ -37,6 +37,10 @@ type CertifyVuln struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CertifyVulnQuery when eager-loading is set.
 	Edges        CertifyVulnEdges `json:"edges"`
@@ -87,9 +91,11 @@ func (*CertifyVuln) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case certifyvuln.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case certifyvuln.FieldID, certifyvuln.FieldVulnerabilityID, certifyvuln.FieldPackageID:
 			values[i] = new(sql.NullInt64)
-		case certifyvuln.FieldDbURI, certifyvuln.FieldDbVersion, certifyvuln.FieldScannerURI, certifyvuln.FieldScannerVersion, certifyvuln.FieldOrigin, certifyvuln.FieldCollector:
+		case certifyvuln.FieldDbURI, certifyvuln.FieldDbVersion, certifyvuln.FieldScannerURI, certifyvuln.FieldScannerVersion, certifyvuln.FieldOrigin, certifyvuln.FieldCollector, certifyvuln.FieldSigner:
 			values[i] = new(sql.NullString)
 		case certifyvuln.FieldTimeScanned:
 			values[i] = new(sql.NullTime)
@@ -168,6 +174,20 @@ func (cv *CertifyVuln) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cv.Collector = value.String
 			}
+		case certifyvuln.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				cv.Verified = new(bool)
+				*cv.Verified = value.Bool
+			}
+		case certifyvuln.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				cv.Signer = new(string)
+				*cv.Signer = value.String
+			}
 		default:
 			cv.selectValues.Set(columns[i], values[i])
 		}
@@ -240,6 +260,16 @@ func (cv *CertifyVuln) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(cv.Collector)
+	builder.WriteString(", ")
+	if v := cv.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cv.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -30,6 +30,10 @@ const (
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
 	FieldCollector = "collector"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldSigner holds the string denoting the signer field in the database.
+	FieldSigner = "signer"
 	// EdgeVulnerability holds the string denoting the vulnerability edge name in mutations.
 	EdgeVulnerability = "vulnerability"
 	// EdgePackage holds the string denoting the package edge name in mutations.
@@ -64,6 +68,8 @@ var Columns = []string{
 	FieldScannerVersion,
 	FieldOrigin,
 	FieldCollector,
+	FieldVerified,
+	FieldSigner,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -129,6 +135,16 @@ func ByCollector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollector, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// BySigner orders the results by the signer field.
+func BySigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigner, opts...).ToFunc()
+}
+
 // ByVulnerabilityField orders the results by vulnerability field.
 func ByVulnerabilityField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
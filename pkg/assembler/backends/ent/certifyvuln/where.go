@@ -100,6 +100,16 @@ func Collector(v string) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldSigner, v))
+}
+
 // VulnerabilityIDEQ applies the EQ predicate on the "vulnerability_id" field.
 func VulnerabilityIDEQ(v int) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldEQ(FieldVulnerabilityID, v))
@@ -570,6 +580,101 @@ func CollectorContainsFold(v string) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasVulnerability applies the HasEdge predicate on the "vulnerability" edge.
 func HasVulnerability() predicate.CertifyVuln {
 	return predicate.CertifyVuln(func(s *sql.Selector) {
@@ -78,6 +78,34 @@ func (cvc *CertifyVulnCreate) SetCollector(s string) *CertifyVulnCreate {
 	return cvc
 }
 
+// SetVerified sets the "verified" field.
+func (cvc *CertifyVulnCreate) SetVerified(b bool) *CertifyVulnCreate {
+	cvc.mutation.SetVerified(b)
+	return cvc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cvc *CertifyVulnCreate) SetNillableVerified(b *bool) *CertifyVulnCreate {
+	if b != nil {
+		cvc.SetVerified(*b)
+	}
+	return cvc
+}
+
+// SetSigner sets the "signer" field.
+func (cvc *CertifyVulnCreate) SetSigner(s string) *CertifyVulnCreate {
+	cvc.mutation.SetSigner(s)
+	return cvc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cvc *CertifyVulnCreate) SetNillableSigner(s *string) *CertifyVulnCreate {
+	if s != nil {
+		cvc.SetSigner(*s)
+	}
+	return cvc
+}
+
 // SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
 func (cvc *CertifyVulnCreate) SetVulnerability(v *VulnerabilityID) *CertifyVulnCreate {
 	return cvc.SetVulnerabilityID(v.ID)
@@ -210,6 +238,14 @@ func (cvc *CertifyVulnCreate) createSpec() (*CertifyVuln, *sqlgraph.CreateSpec)
 		_spec.SetField(certifyvuln.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := cvc.mutation.Verified(); ok {
+		_spec.SetField(certifyvuln.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := cvc.mutation.Signer(); ok {
+		_spec.SetField(certifyvuln.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := cvc.mutation.VulnerabilityIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -404,6 +440,42 @@ func (u *CertifyVulnUpsert) UpdateCollector() *CertifyVulnUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyVulnUpsert) SetVerified(v bool) *CertifyVulnUpsert {
+	u.Set(certifyvuln.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyVulnUpsert) UpdateVerified() *CertifyVulnUpsert {
+	u.SetExcluded(certifyvuln.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyVulnUpsert) ClearVerified() *CertifyVulnUpsert {
+	u.SetNull(certifyvuln.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyVulnUpsert) SetSigner(v string) *CertifyVulnUpsert {
+	u.Set(certifyvuln.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyVulnUpsert) UpdateSigner() *CertifyVulnUpsert {
+	u.SetExcluded(certifyvuln.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyVulnUpsert) ClearSigner() *CertifyVulnUpsert {
+	u.SetNull(certifyvuln.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -570,6 +642,48 @@ func (u *CertifyVulnUpsertOne) UpdateCollector() *CertifyVulnUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyVulnUpsertOne) SetVerified(v bool) *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyVulnUpsertOne) UpdateVerified() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyVulnUpsertOne) ClearVerified() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyVulnUpsertOne) SetSigner(v string) *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyVulnUpsertOne) UpdateSigner() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyVulnUpsertOne) ClearSigner() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *CertifyVulnUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -899,6 +1013,48 @@ func (u *CertifyVulnUpsertBulk) UpdateCollector() *CertifyVulnUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *CertifyVulnUpsertBulk) SetVerified(v bool) *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *CertifyVulnUpsertBulk) UpdateVerified() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *CertifyVulnUpsertBulk) ClearVerified() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *CertifyVulnUpsertBulk) SetSigner(v string) *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *CertifyVulnUpsertBulk) UpdateSigner() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *CertifyVulnUpsertBulk) ClearSigner() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *CertifyVulnUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -156,6 +156,46 @@ func (cvu *CertifyVulnUpdate) SetNillableCollector(s *string) *CertifyVulnUpdate
 	return cvu
 }
 
+// SetVerified sets the "verified" field.
+func (cvu *CertifyVulnUpdate) SetVerified(b bool) *CertifyVulnUpdate {
+	cvu.mutation.SetVerified(b)
+	return cvu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cvu *CertifyVulnUpdate) SetNillableVerified(b *bool) *CertifyVulnUpdate {
+	if b != nil {
+		cvu.SetVerified(*b)
+	}
+	return cvu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (cvu *CertifyVulnUpdate) ClearVerified() *CertifyVulnUpdate {
+	cvu.mutation.ClearVerified()
+	return cvu
+}
+
+// SetSigner sets the "signer" field.
+func (cvu *CertifyVulnUpdate) SetSigner(s string) *CertifyVulnUpdate {
+	cvu.mutation.SetSigner(s)
+	return cvu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cvu *CertifyVulnUpdate) SetNillableSigner(s *string) *CertifyVulnUpdate {
+	if s != nil {
+		cvu.SetSigner(*s)
+	}
+	return cvu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (cvu *CertifyVulnUpdate) ClearSigner() *CertifyVulnUpdate {
+	cvu.mutation.ClearSigner()
+	return cvu
+}
+
 // SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
 func (cvu *CertifyVulnUpdate) SetVulnerability(v *VulnerabilityID) *CertifyVulnUpdate {
 	return cvu.SetVulnerabilityID(v.ID)
@@ -254,6 +294,18 @@ func (cvu *CertifyVulnUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cvu.mutation.Collector(); ok {
 		_spec.SetField(certifyvuln.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := cvu.mutation.Verified(); ok {
+		_spec.SetField(certifyvuln.FieldVerified, field.TypeBool, value)
+	}
+	if cvu.mutation.VerifiedCleared() {
+		_spec.ClearField(certifyvuln.FieldVerified, field.TypeBool)
+	}
+	if value, ok := cvu.mutation.Signer(); ok {
+		_spec.SetField(certifyvuln.FieldSigner, field.TypeString, value)
+	}
+	if cvu.mutation.SignerCleared() {
+		_spec.ClearField(certifyvuln.FieldSigner, field.TypeString)
+	}
 	if cvu.mutation.VulnerabilityCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -458,6 +510,46 @@ func (cvuo *CertifyVulnUpdateOne) SetNillableCollector(s *string) *CertifyVulnUp
 	return cvuo
 }
 
+// SetVerified sets the "verified" field.
+func (cvuo *CertifyVulnUpdateOne) SetVerified(b bool) *CertifyVulnUpdateOne {
+	cvuo.mutation.SetVerified(b)
+	return cvuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (cvuo *CertifyVulnUpdateOne) SetNillableVerified(b *bool) *CertifyVulnUpdateOne {
+	if b != nil {
+		cvuo.SetVerified(*b)
+	}
+	return cvuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (cvuo *CertifyVulnUpdateOne) ClearVerified() *CertifyVulnUpdateOne {
+	cvuo.mutation.ClearVerified()
+	return cvuo
+}
+
+// SetSigner sets the "signer" field.
+func (cvuo *CertifyVulnUpdateOne) SetSigner(s string) *CertifyVulnUpdateOne {
+	cvuo.mutation.SetSigner(s)
+	return cvuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (cvuo *CertifyVulnUpdateOne) SetNillableSigner(s *string) *CertifyVulnUpdateOne {
+	if s != nil {
+		cvuo.SetSigner(*s)
+	}
+	return cvuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (cvuo *CertifyVulnUpdateOne) ClearSigner() *CertifyVulnUpdateOne {
+	cvuo.mutation.ClearSigner()
+	return cvuo
+}
+
 // SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
 func (cvuo *CertifyVulnUpdateOne) SetVulnerability(v *VulnerabilityID) *CertifyVulnUpdateOne {
 	return cvuo.SetVulnerabilityID(v.ID)
@@ -586,6 +678,18 @@ func (cvuo *CertifyVulnUpdateOne) sqlSave(ctx context.Context) (_node *CertifyVu
 	if value, ok := cvuo.mutation.Collector(); ok {
 		_spec.SetField(certifyvuln.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := cvuo.mutation.Verified(); ok {
+		_spec.SetField(certifyvuln.FieldVerified, field.TypeBool, value)
+	}
+	if cvuo.mutation.VerifiedCleared() {
+		_spec.ClearField(certifyvuln.FieldVerified, field.TypeBool)
+	}
+	if value, ok := cvuo.mutation.Signer(); ok {
+		_spec.SetField(certifyvuln.FieldSigner, field.TypeString, value)
+	}
+	if cvuo.mutation.SignerCleared() {
+		_spec.ClearField(certifyvuln.FieldSigner, field.TypeString)
+	}
 	if cvuo.mutation.VulnerabilityCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -28,12 +28,18 @@ type Dependency struct {
 	VersionRange string `json:"version_range,omitempty"`
 	// DependencyType holds the value of the "dependency_type" field.
 	DependencyType dependency.DependencyType `json:"dependency_type,omitempty"`
+	// DependencyScope holds the value of the "dependency_scope" field.
+	DependencyScope dependency.DependencyScope `json:"dependency_scope,omitempty"`
 	// Justification holds the value of the "justification" field.
 	Justification string `json:"justification,omitempty"`
 	// Origin holds the value of the "origin" field.
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DependencyQuery when eager-loading is set.
 	Edges        DependencyEdges `json:"edges"`
@@ -99,9 +105,11 @@ func (*Dependency) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case dependency.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case dependency.FieldID, dependency.FieldPackageID, dependency.FieldDependentPackageNameID, dependency.FieldDependentPackageVersionID:
 			values[i] = new(sql.NullInt64)
-		case dependency.FieldVersionRange, dependency.FieldDependencyType, dependency.FieldJustification, dependency.FieldOrigin, dependency.FieldCollector:
+		case dependency.FieldVersionRange, dependency.FieldDependencyType, dependency.FieldDependencyScope, dependency.FieldJustification, dependency.FieldOrigin, dependency.FieldCollector, dependency.FieldSigner:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -154,6 +162,12 @@ func (d *Dependency) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.DependencyType = dependency.DependencyType(value.String)
 			}
+		case dependency.FieldDependencyScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dependency_scope", values[i])
+			} else if value.Valid {
+				d.DependencyScope = dependency.DependencyScope(value.String)
+			}
 		case dependency.FieldJustification:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field justification", values[i])
@@ -172,6 +186,20 @@ func (d *Dependency) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.Collector = value.String
 			}
+		case dependency.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				d.Verified = new(bool)
+				*d.Verified = value.Bool
+			}
+		case dependency.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				d.Signer = new(string)
+				*d.Signer = value.String
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -238,6 +266,9 @@ func (d *Dependency) String() string {
 	builder.WriteString("dependency_type=")
 	builder.WriteString(fmt.Sprintf("%v", d.DependencyType))
 	builder.WriteString(", ")
+	builder.WriteString("dependency_scope=")
+	builder.WriteString(fmt.Sprintf("%v", d.DependencyScope))
+	builder.WriteString(", ")
 	builder.WriteString("justification=")
 	builder.WriteString(d.Justification)
 	builder.WriteString(", ")
@@ -246,6 +277,16 @@ func (d *Dependency) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(d.Collector)
+	builder.WriteString(", ")
+	if v := d.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := d.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
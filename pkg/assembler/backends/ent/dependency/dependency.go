@@ -26,12 +26,18 @@ const (
 	FieldVersionRange = "version_range"
 	// FieldDependencyType holds the string denoting the dependency_type field in the database.
 	FieldDependencyType = "dependency_type"
+	// FieldDependencyScope holds the string denoting the dependency_scope field in the database.
+	FieldDependencyScope = "dependency_scope"
 	// FieldJustification holds the string denoting the justification field in the database.
 	FieldJustification = "justification"
 	// FieldOrigin holds the string denoting the origin field in the database.
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
 	FieldCollector = "collector"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldSigner holds the string denoting the signer field in the database.
+	FieldSigner = "signer"
 	// EdgePackage holds the string denoting the package edge name in mutations.
 	EdgePackage = "package"
 	// EdgeDependentPackageName holds the string denoting the dependent_package_name edge name in mutations.
@@ -71,9 +77,12 @@ var Columns = []string{
 	FieldDependentPackageVersionID,
 	FieldVersionRange,
 	FieldDependencyType,
+	FieldDependencyScope,
 	FieldJustification,
 	FieldOrigin,
 	FieldCollector,
+	FieldVerified,
+	FieldSigner,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -110,6 +119,37 @@ func DependencyTypeValidator(dt DependencyType) error {
 	}
 }
 
+// DependencyScope defines the type for the "dependency_scope" enum field.
+type DependencyScope string
+
+// DependencyScopeUNKNOWN is the default value of the DependencyScope enum.
+const DefaultDependencyScope = DependencyScopeUNKNOWN
+
+// DependencyScope values.
+const (
+	DependencyScopeRUNTIME  DependencyScope = "RUNTIME"
+	DependencyScopeDEV      DependencyScope = "DEV"
+	DependencyScopeBUILD    DependencyScope = "BUILD"
+	DependencyScopeTEST     DependencyScope = "TEST"
+	DependencyScopeOPTIONAL DependencyScope = "OPTIONAL"
+	DependencyScopePROVIDED DependencyScope = "PROVIDED"
+	DependencyScopeUNKNOWN  DependencyScope = "UNKNOWN"
+)
+
+func (ds DependencyScope) String() string {
+	return string(ds)
+}
+
+// DependencyScopeValidator is a validator for the "dependency_scope" field enum values. It is called by the builders before save.
+func DependencyScopeValidator(ds DependencyScope) error {
+	switch ds {
+	case DependencyScopeRUNTIME, DependencyScopeDEV, DependencyScopeBUILD, DependencyScopeTEST, DependencyScopeOPTIONAL, DependencyScopePROVIDED, DependencyScopeUNKNOWN:
+		return nil
+	default:
+		return fmt.Errorf("dependency: invalid enum value for dependency_scope field: %q", ds)
+	}
+}
+
 // OrderOption defines the ordering options for the Dependency queries.
 type OrderOption func(*sql.Selector)
 
@@ -143,6 +183,11 @@ func ByDependencyType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDependencyType, opts...).ToFunc()
 }
 
+// ByDependencyScope orders the results by the dependency_scope field.
+func ByDependencyScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDependencyScope, opts...).ToFunc()
+}
+
 // ByJustification orders the results by the justification field.
 func ByJustification(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldJustification, opts...).ToFunc()
@@ -158,6 +203,16 @@ func ByCollector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollector, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// BySigner orders the results by the signer field.
+func BySigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigner, opts...).ToFunc()
+}
+
 // ByPackageField orders the results by package field.
 func ByPackageField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -217,3 +272,21 @@ func (e *DependencyType) UnmarshalGQL(val interface{}) error {
 	}
 	return nil
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e DependencyScope) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *DependencyScope) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = DependencyScope(str)
+	if err := DependencyScopeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid DependencyScope", str)
+	}
+	return nil
+}
@@ -88,6 +88,16 @@ func Collector(v string) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldSigner, v))
+}
+
 // PackageIDEQ applies the EQ predicate on the "package_id" field.
 func PackageIDEQ(v int) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldPackageID, v))
@@ -253,6 +263,26 @@ func DependencyTypeNotIn(vs ...DependencyType) predicate.Dependency {
 	return predicate.Dependency(sql.FieldNotIn(FieldDependencyType, vs...))
 }
 
+// DependencyScopeEQ applies the EQ predicate on the "dependency_scope" field.
+func DependencyScopeEQ(v DependencyScope) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldDependencyScope, v))
+}
+
+// DependencyScopeNEQ applies the NEQ predicate on the "dependency_scope" field.
+func DependencyScopeNEQ(v DependencyScope) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNEQ(FieldDependencyScope, v))
+}
+
+// DependencyScopeIn applies the In predicate on the "dependency_scope" field.
+func DependencyScopeIn(vs ...DependencyScope) predicate.Dependency {
+	return predicate.Dependency(sql.FieldIn(FieldDependencyScope, vs...))
+}
+
+// DependencyScopeNotIn applies the NotIn predicate on the "dependency_scope" field.
+func DependencyScopeNotIn(vs ...DependencyScope) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNotIn(FieldDependencyScope, vs...))
+}
+
 // JustificationEQ applies the EQ predicate on the "justification" field.
 func JustificationEQ(v string) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldJustification, v))
@@ -448,6 +478,101 @@ func CollectorContainsFold(v string) predicate.Dependency {
 	return predicate.Dependency(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.Dependency {
+	return predicate.Dependency(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.Dependency {
+	return predicate.Dependency(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.Dependency {
+	return predicate.Dependency(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.Dependency {
+	return predicate.Dependency(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasPackage applies the HasEdge predicate on the "package" edge.
 func HasPackage() predicate.Dependency {
 	return predicate.Dependency(func(s *sql.Selector) {
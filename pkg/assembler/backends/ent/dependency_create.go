@@ -69,6 +69,20 @@ func (dc *DependencyCreate) SetDependencyType(dt dependency.DependencyType) *Dep
 	return dc
 }
 
+// SetDependencyScope sets the "dependency_scope" field.
+func (dc *DependencyCreate) SetDependencyScope(ds dependency.DependencyScope) *DependencyCreate {
+	dc.mutation.SetDependencyScope(ds)
+	return dc
+}
+
+// SetNillableDependencyScope sets the "dependency_scope" field if the given value is not nil.
+func (dc *DependencyCreate) SetNillableDependencyScope(ds *dependency.DependencyScope) *DependencyCreate {
+	if ds != nil {
+		dc.SetDependencyScope(*ds)
+	}
+	return dc
+}
+
 // SetJustification sets the "justification" field.
 func (dc *DependencyCreate) SetJustification(s string) *DependencyCreate {
 	dc.mutation.SetJustification(s)
@@ -87,6 +101,34 @@ func (dc *DependencyCreate) SetCollector(s string) *DependencyCreate {
 	return dc
 }
 
+// SetVerified sets the "verified" field.
+func (dc *DependencyCreate) SetVerified(b bool) *DependencyCreate {
+	dc.mutation.SetVerified(b)
+	return dc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (dc *DependencyCreate) SetNillableVerified(b *bool) *DependencyCreate {
+	if b != nil {
+		dc.SetVerified(*b)
+	}
+	return dc
+}
+
+// SetSigner sets the "signer" field.
+func (dc *DependencyCreate) SetSigner(s string) *DependencyCreate {
+	dc.mutation.SetSigner(s)
+	return dc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (dc *DependencyCreate) SetNillableSigner(s *string) *DependencyCreate {
+	if s != nil {
+		dc.SetSigner(*s)
+	}
+	return dc
+}
+
 // SetPackage sets the "package" edge to the PackageVersion entity.
 func (dc *DependencyCreate) SetPackage(p *PackageVersion) *DependencyCreate {
 	return dc.SetPackageID(p.ID)
@@ -109,6 +151,7 @@ func (dc *DependencyCreate) Mutation() *DependencyMutation {
 
 // Save creates the Dependency in the database.
 func (dc *DependencyCreate) Save(ctx context.Context) (*Dependency, error) {
+	dc.defaults()
 	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
 }
 
@@ -134,6 +177,14 @@ func (dc *DependencyCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (dc *DependencyCreate) defaults() {
+	if _, ok := dc.mutation.DependencyScope(); !ok {
+		v := dependency.DefaultDependencyScope
+		dc.mutation.SetDependencyScope(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (dc *DependencyCreate) check() error {
 	if _, ok := dc.mutation.PackageID(); !ok {
@@ -150,6 +201,14 @@ func (dc *DependencyCreate) check() error {
 			return &ValidationError{Name: "dependency_type", err: fmt.Errorf(`ent: validator failed for field "Dependency.dependency_type": %w`, err)}
 		}
 	}
+	if _, ok := dc.mutation.DependencyScope(); !ok {
+		return &ValidationError{Name: "dependency_scope", err: errors.New(`ent: missing required field "Dependency.dependency_scope"`)}
+	}
+	if v, ok := dc.mutation.DependencyScope(); ok {
+		if err := dependency.DependencyScopeValidator(v); err != nil {
+			return &ValidationError{Name: "dependency_scope", err: fmt.Errorf(`ent: validator failed for field "Dependency.dependency_scope": %w`, err)}
+		}
+	}
 	if _, ok := dc.mutation.Justification(); !ok {
 		return &ValidationError{Name: "justification", err: errors.New(`ent: missing required field "Dependency.justification"`)}
 	}
@@ -197,6 +256,10 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 		_spec.SetField(dependency.FieldDependencyType, field.TypeEnum, value)
 		_node.DependencyType = value
 	}
+	if value, ok := dc.mutation.DependencyScope(); ok {
+		_spec.SetField(dependency.FieldDependencyScope, field.TypeEnum, value)
+		_node.DependencyScope = value
+	}
 	if value, ok := dc.mutation.Justification(); ok {
 		_spec.SetField(dependency.FieldJustification, field.TypeString, value)
 		_node.Justification = value
@@ -209,6 +272,14 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 		_spec.SetField(dependency.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := dc.mutation.Verified(); ok {
+		_spec.SetField(dependency.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := dc.mutation.Signer(); ok {
+		_spec.SetField(dependency.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := dc.mutation.PackageIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -384,6 +455,18 @@ func (u *DependencyUpsert) UpdateDependencyType() *DependencyUpsert {
 	return u
 }
 
+// SetDependencyScope sets the "dependency_scope" field.
+func (u *DependencyUpsert) SetDependencyScope(v dependency.DependencyScope) *DependencyUpsert {
+	u.Set(dependency.FieldDependencyScope, v)
+	return u
+}
+
+// UpdateDependencyScope sets the "dependency_scope" field to the value that was provided on create.
+func (u *DependencyUpsert) UpdateDependencyScope() *DependencyUpsert {
+	u.SetExcluded(dependency.FieldDependencyScope)
+	return u
+}
+
 // SetJustification sets the "justification" field.
 func (u *DependencyUpsert) SetJustification(v string) *DependencyUpsert {
 	u.Set(dependency.FieldJustification, v)
@@ -420,6 +503,42 @@ func (u *DependencyUpsert) UpdateCollector() *DependencyUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *DependencyUpsert) SetVerified(v bool) *DependencyUpsert {
+	u.Set(dependency.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *DependencyUpsert) UpdateVerified() *DependencyUpsert {
+	u.SetExcluded(dependency.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *DependencyUpsert) ClearVerified() *DependencyUpsert {
+	u.SetNull(dependency.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *DependencyUpsert) SetSigner(v string) *DependencyUpsert {
+	u.Set(dependency.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *DependencyUpsert) UpdateSigner() *DependencyUpsert {
+	u.SetExcluded(dependency.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *DependencyUpsert) ClearSigner() *DependencyUpsert {
+	u.SetNull(dependency.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -544,6 +663,20 @@ func (u *DependencyUpsertOne) UpdateDependencyType() *DependencyUpsertOne {
 	})
 }
 
+// SetDependencyScope sets the "dependency_scope" field.
+func (u *DependencyUpsertOne) SetDependencyScope(v dependency.DependencyScope) *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetDependencyScope(v)
+	})
+}
+
+// UpdateDependencyScope sets the "dependency_scope" field to the value that was provided on create.
+func (u *DependencyUpsertOne) UpdateDependencyScope() *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateDependencyScope()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *DependencyUpsertOne) SetJustification(v string) *DependencyUpsertOne {
 	return u.Update(func(s *DependencyUpsert) {
@@ -586,6 +719,48 @@ func (u *DependencyUpsertOne) UpdateCollector() *DependencyUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *DependencyUpsertOne) SetVerified(v bool) *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *DependencyUpsertOne) UpdateVerified() *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *DependencyUpsertOne) ClearVerified() *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *DependencyUpsertOne) SetSigner(v string) *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *DependencyUpsertOne) UpdateSigner() *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *DependencyUpsertOne) ClearSigner() *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *DependencyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -638,6 +813,7 @@ func (dcb *DependencyCreateBulk) Save(ctx context.Context) ([]*Dependency, error
 	for i := range dcb.builders {
 		func(i int, root context.Context) {
 			builder := dcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*DependencyMutation)
 				if !ok {
@@ -873,6 +1049,20 @@ func (u *DependencyUpsertBulk) UpdateDependencyType() *DependencyUpsertBulk {
 	})
 }
 
+// SetDependencyScope sets the "dependency_scope" field.
+func (u *DependencyUpsertBulk) SetDependencyScope(v dependency.DependencyScope) *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetDependencyScope(v)
+	})
+}
+
+// UpdateDependencyScope sets the "dependency_scope" field to the value that was provided on create.
+func (u *DependencyUpsertBulk) UpdateDependencyScope() *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateDependencyScope()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *DependencyUpsertBulk) SetJustification(v string) *DependencyUpsertBulk {
 	return u.Update(func(s *DependencyUpsert) {
@@ -915,6 +1105,48 @@ func (u *DependencyUpsertBulk) UpdateCollector() *DependencyUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *DependencyUpsertBulk) SetVerified(v bool) *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *DependencyUpsertBulk) UpdateVerified() *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *DependencyUpsertBulk) ClearVerified() *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *DependencyUpsertBulk) SetSigner(v string) *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *DependencyUpsertBulk) UpdateSigner() *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *DependencyUpsertBulk) ClearSigner() *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *DependencyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
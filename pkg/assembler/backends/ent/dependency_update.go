@@ -111,6 +111,20 @@ func (du *DependencyUpdate) SetNillableDependencyType(dt *dependency.DependencyT
 	return du
 }
 
+// SetDependencyScope sets the "dependency_scope" field.
+func (du *DependencyUpdate) SetDependencyScope(ds dependency.DependencyScope) *DependencyUpdate {
+	du.mutation.SetDependencyScope(ds)
+	return du
+}
+
+// SetNillableDependencyScope sets the "dependency_scope" field if the given value is not nil.
+func (du *DependencyUpdate) SetNillableDependencyScope(ds *dependency.DependencyScope) *DependencyUpdate {
+	if ds != nil {
+		du.SetDependencyScope(*ds)
+	}
+	return du
+}
+
 // SetJustification sets the "justification" field.
 func (du *DependencyUpdate) SetJustification(s string) *DependencyUpdate {
 	du.mutation.SetJustification(s)
@@ -153,6 +167,46 @@ func (du *DependencyUpdate) SetNillableCollector(s *string) *DependencyUpdate {
 	return du
 }
 
+// SetVerified sets the "verified" field.
+func (du *DependencyUpdate) SetVerified(b bool) *DependencyUpdate {
+	du.mutation.SetVerified(b)
+	return du
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (du *DependencyUpdate) SetNillableVerified(b *bool) *DependencyUpdate {
+	if b != nil {
+		du.SetVerified(*b)
+	}
+	return du
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (du *DependencyUpdate) ClearVerified() *DependencyUpdate {
+	du.mutation.ClearVerified()
+	return du
+}
+
+// SetSigner sets the "signer" field.
+func (du *DependencyUpdate) SetSigner(s string) *DependencyUpdate {
+	du.mutation.SetSigner(s)
+	return du
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (du *DependencyUpdate) SetNillableSigner(s *string) *DependencyUpdate {
+	if s != nil {
+		du.SetSigner(*s)
+	}
+	return du
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (du *DependencyUpdate) ClearSigner() *DependencyUpdate {
+	du.mutation.ClearSigner()
+	return du
+}
+
 // SetPackage sets the "package" edge to the PackageVersion entity.
 func (du *DependencyUpdate) SetPackage(p *PackageVersion) *DependencyUpdate {
 	return du.SetPackageID(p.ID)
@@ -225,6 +279,11 @@ func (du *DependencyUpdate) check() error {
 			return &ValidationError{Name: "dependency_type", err: fmt.Errorf(`ent: validator failed for field "Dependency.dependency_type": %w`, err)}
 		}
 	}
+	if v, ok := du.mutation.DependencyScope(); ok {
+		if err := dependency.DependencyScopeValidator(v); err != nil {
+			return &ValidationError{Name: "dependency_scope", err: fmt.Errorf(`ent: validator failed for field "Dependency.dependency_scope": %w`, err)}
+		}
+	}
 	if _, ok := du.mutation.PackageID(); du.mutation.PackageCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Dependency.package"`)
 	}
@@ -249,6 +308,9 @@ func (du *DependencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.DependencyType(); ok {
 		_spec.SetField(dependency.FieldDependencyType, field.TypeEnum, value)
 	}
+	if value, ok := du.mutation.DependencyScope(); ok {
+		_spec.SetField(dependency.FieldDependencyScope, field.TypeEnum, value)
+	}
 	if value, ok := du.mutation.Justification(); ok {
 		_spec.SetField(dependency.FieldJustification, field.TypeString, value)
 	}
@@ -258,6 +320,18 @@ func (du *DependencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.Collector(); ok {
 		_spec.SetField(dependency.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := du.mutation.Verified(); ok {
+		_spec.SetField(dependency.FieldVerified, field.TypeBool, value)
+	}
+	if du.mutation.VerifiedCleared() {
+		_spec.ClearField(dependency.FieldVerified, field.TypeBool)
+	}
+	if value, ok := du.mutation.Signer(); ok {
+		_spec.SetField(dependency.FieldSigner, field.TypeString, value)
+	}
+	if du.mutation.SignerCleared() {
+		_spec.ClearField(dependency.FieldSigner, field.TypeString)
+	}
 	if du.mutation.PackageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -447,6 +521,20 @@ func (duo *DependencyUpdateOne) SetNillableDependencyType(dt *dependency.Depende
 	return duo
 }
 
+// SetDependencyScope sets the "dependency_scope" field.
+func (duo *DependencyUpdateOne) SetDependencyScope(ds dependency.DependencyScope) *DependencyUpdateOne {
+	duo.mutation.SetDependencyScope(ds)
+	return duo
+}
+
+// SetNillableDependencyScope sets the "dependency_scope" field if the given value is not nil.
+func (duo *DependencyUpdateOne) SetNillableDependencyScope(ds *dependency.DependencyScope) *DependencyUpdateOne {
+	if ds != nil {
+		duo.SetDependencyScope(*ds)
+	}
+	return duo
+}
+
 // SetJustification sets the "justification" field.
 func (duo *DependencyUpdateOne) SetJustification(s string) *DependencyUpdateOne {
 	duo.mutation.SetJustification(s)
@@ -489,6 +577,46 @@ func (duo *DependencyUpdateOne) SetNillableCollector(s *string) *DependencyUpdat
 	return duo
 }
 
+// SetVerified sets the "verified" field.
+func (duo *DependencyUpdateOne) SetVerified(b bool) *DependencyUpdateOne {
+	duo.mutation.SetVerified(b)
+	return duo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (duo *DependencyUpdateOne) SetNillableVerified(b *bool) *DependencyUpdateOne {
+	if b != nil {
+		duo.SetVerified(*b)
+	}
+	return duo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (duo *DependencyUpdateOne) ClearVerified() *DependencyUpdateOne {
+	duo.mutation.ClearVerified()
+	return duo
+}
+
+// SetSigner sets the "signer" field.
+func (duo *DependencyUpdateOne) SetSigner(s string) *DependencyUpdateOne {
+	duo.mutation.SetSigner(s)
+	return duo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (duo *DependencyUpdateOne) SetNillableSigner(s *string) *DependencyUpdateOne {
+	if s != nil {
+		duo.SetSigner(*s)
+	}
+	return duo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (duo *DependencyUpdateOne) ClearSigner() *DependencyUpdateOne {
+	duo.mutation.ClearSigner()
+	return duo
+}
+
 // SetPackage sets the "package" edge to the PackageVersion entity.
 func (duo *DependencyUpdateOne) SetPackage(p *PackageVersion) *DependencyUpdateOne {
 	return duo.SetPackageID(p.ID)
@@ -574,6 +702,11 @@ func (duo *DependencyUpdateOne) check() error {
 			return &ValidationError{Name: "dependency_type", err: fmt.Errorf(`ent: validator failed for field "Dependency.dependency_type": %w`, err)}
 		}
 	}
+	if v, ok := duo.mutation.DependencyScope(); ok {
+		if err := dependency.DependencyScopeValidator(v); err != nil {
+			return &ValidationError{Name: "dependency_scope", err: fmt.Errorf(`ent: validator failed for field "Dependency.dependency_scope": %w`, err)}
+		}
+	}
 	if _, ok := duo.mutation.PackageID(); duo.mutation.PackageCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Dependency.package"`)
 	}
@@ -615,6 +748,9 @@ func (duo *DependencyUpdateOne) sqlSave(ctx context.Context) (_node *Dependency,
 	if value, ok := duo.mutation.DependencyType(); ok {
 		_spec.SetField(dependency.FieldDependencyType, field.TypeEnum, value)
 	}
+	if value, ok := duo.mutation.DependencyScope(); ok {
+		_spec.SetField(dependency.FieldDependencyScope, field.TypeEnum, value)
+	}
 	if value, ok := duo.mutation.Justification(); ok {
 		_spec.SetField(dependency.FieldJustification, field.TypeString, value)
 	}
@@ -624,6 +760,18 @@ func (duo *DependencyUpdateOne) sqlSave(ctx context.Context) (_node *Dependency,
 	if value, ok := duo.mutation.Collector(); ok {
 		_spec.SetField(dependency.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := duo.mutation.Verified(); ok {
+		_spec.SetField(dependency.FieldVerified, field.TypeBool, value)
+	}
+	if duo.mutation.VerifiedCleared() {
+		_spec.ClearField(dependency.FieldVerified, field.TypeBool)
+	}
+	if value, ok := duo.mutation.Signer(); ok {
+		_spec.SetField(dependency.FieldSigner, field.TypeString, value)
+	}
+	if duo.mutation.SignerCleared() {
+		_spec.ClearField(dependency.FieldSigner, field.TypeString)
+	}
 	if duo.mutation.PackageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
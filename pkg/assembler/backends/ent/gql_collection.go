@@ -245,6 +245,16 @@ func (bom *BillOfMaterialsQuery) collectField(ctx context.Context, opCtx *graphq
 				selectedFields = append(selectedFields, billofmaterials.FieldCollector)
 				fieldSeen[billofmaterials.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[billofmaterials.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, billofmaterials.FieldVerified)
+				fieldSeen[billofmaterials.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[billofmaterials.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, billofmaterials.FieldSigner)
+				fieldSeen[billofmaterials.FieldSigner] = struct{}{}
+			}
 		case "knownSince":
 			if _, ok := fieldSeen[billofmaterials.FieldKnownSince]; !ok {
 				selectedFields = append(selectedFields, billofmaterials.FieldKnownSince)
@@ -481,6 +491,16 @@ func (c *CertificationQuery) collectField(ctx context.Context, opCtx *graphql.Op
 				selectedFields = append(selectedFields, certification.FieldCollector)
 				fieldSeen[certification.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[certification.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, certification.FieldVerified)
+				fieldSeen[certification.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[certification.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, certification.FieldSigner)
+				fieldSeen[certification.FieldSigner] = struct{}{}
+			}
 		case "knownSince":
 			if _, ok := fieldSeen[certification.FieldKnownSince]; !ok {
 				selectedFields = append(selectedFields, certification.FieldKnownSince)
@@ -642,6 +662,16 @@ func (cl *CertifyLegalQuery) collectField(ctx context.Context, opCtx *graphql.Op
 				selectedFields = append(selectedFields, certifylegal.FieldCollector)
 				fieldSeen[certifylegal.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[certifylegal.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, certifylegal.FieldVerified)
+				fieldSeen[certifylegal.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[certifylegal.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, certifylegal.FieldSigner)
+				fieldSeen[certifylegal.FieldSigner] = struct{}{}
+			}
 		case "declaredLicensesHash":
 			if _, ok := fieldSeen[certifylegal.FieldDeclaredLicensesHash]; !ok {
 				selectedFields = append(selectedFields, certifylegal.FieldDeclaredLicensesHash)
@@ -900,6 +930,16 @@ func (cv *CertifyVexQuery) collectField(ctx context.Context, opCtx *graphql.Oper
 				selectedFields = append(selectedFields, certifyvex.FieldCollector)
 				fieldSeen[certifyvex.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[certifyvex.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, certifyvex.FieldVerified)
+				fieldSeen[certifyvex.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[certifyvex.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, certifyvex.FieldSigner)
+				fieldSeen[certifyvex.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1032,6 +1072,16 @@ func (cv *CertifyVulnQuery) collectField(ctx context.Context, opCtx *graphql.Ope
 				selectedFields = append(selectedFields, certifyvuln.FieldCollector)
 				fieldSeen[certifyvuln.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[certifyvuln.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldVerified)
+				fieldSeen[certifyvuln.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[certifyvuln.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldSigner)
+				fieldSeen[certifyvuln.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1158,6 +1208,11 @@ func (d *DependencyQuery) collectField(ctx context.Context, opCtx *graphql.Opera
 				selectedFields = append(selectedFields, dependency.FieldDependencyType)
 				fieldSeen[dependency.FieldDependencyType] = struct{}{}
 			}
+		case "dependencyScope":
+			if _, ok := fieldSeen[dependency.FieldDependencyScope]; !ok {
+				selectedFields = append(selectedFields, dependency.FieldDependencyScope)
+				fieldSeen[dependency.FieldDependencyScope] = struct{}{}
+			}
 		case "justification":
 			if _, ok := fieldSeen[dependency.FieldJustification]; !ok {
 				selectedFields = append(selectedFields, dependency.FieldJustification)
@@ -1173,6 +1228,16 @@ func (d *DependencyQuery) collectField(ctx context.Context, opCtx *graphql.Opera
 				selectedFields = append(selectedFields, dependency.FieldCollector)
 				fieldSeen[dependency.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[dependency.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, dependency.FieldVerified)
+				fieldSeen[dependency.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[dependency.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, dependency.FieldSigner)
+				fieldSeen[dependency.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1338,6 +1403,16 @@ func (hm *HasMetadataQuery) collectField(ctx context.Context, opCtx *graphql.Ope
 				selectedFields = append(selectedFields, hasmetadata.FieldCollector)
 				fieldSeen[hasmetadata.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[hasmetadata.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, hasmetadata.FieldVerified)
+				fieldSeen[hasmetadata.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[hasmetadata.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, hasmetadata.FieldSigner)
+				fieldSeen[hasmetadata.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1474,6 +1549,16 @@ func (hsa *HasSourceAtQuery) collectField(ctx context.Context, opCtx *graphql.Op
 				selectedFields = append(selectedFields, hassourceat.FieldCollector)
 				fieldSeen[hassourceat.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[hassourceat.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, hassourceat.FieldVerified)
+				fieldSeen[hassourceat.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[hassourceat.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, hassourceat.FieldSigner)
+				fieldSeen[hassourceat.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1555,6 +1640,16 @@ func (he *HashEqualQuery) collectField(ctx context.Context, opCtx *graphql.Opera
 				selectedFields = append(selectedFields, hashequal.FieldCollector)
 				fieldSeen[hashequal.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[hashequal.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, hashequal.FieldVerified)
+				fieldSeen[hashequal.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[hashequal.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, hashequal.FieldSigner)
+				fieldSeen[hashequal.FieldSigner] = struct{}{}
+			}
 		case "justification":
 			if _, ok := fieldSeen[hashequal.FieldJustification]; !ok {
 				selectedFields = append(selectedFields, hashequal.FieldJustification)
@@ -1891,6 +1986,16 @@ func (o *OccurrenceQuery) collectField(ctx context.Context, opCtx *graphql.Opera
 				selectedFields = append(selectedFields, occurrence.FieldCollector)
 				fieldSeen[occurrence.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[occurrence.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, occurrence.FieldVerified)
+				fieldSeen[occurrence.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[occurrence.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, occurrence.FieldSigner)
+				fieldSeen[occurrence.FieldSigner] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[occurrence.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, occurrence.FieldSourceID)
@@ -2382,6 +2487,16 @@ func (pe *PkgEqualQuery) collectField(ctx context.Context, opCtx *graphql.Operat
 				selectedFields = append(selectedFields, pkgequal.FieldCollector)
 				fieldSeen[pkgequal.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[pkgequal.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, pkgequal.FieldVerified)
+				fieldSeen[pkgequal.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[pkgequal.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, pkgequal.FieldSigner)
+				fieldSeen[pkgequal.FieldSigner] = struct{}{}
+			}
 		case "justification":
 			if _, ok := fieldSeen[pkgequal.FieldJustification]; !ok {
 				selectedFields = append(selectedFields, pkgequal.FieldJustification)
@@ -2557,6 +2672,16 @@ func (poc *PointOfContactQuery) collectField(ctx context.Context, opCtx *graphql
 				selectedFields = append(selectedFields, pointofcontact.FieldCollector)
 				fieldSeen[pointofcontact.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[pointofcontact.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, pointofcontact.FieldVerified)
+				fieldSeen[pointofcontact.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[pointofcontact.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, pointofcontact.FieldSigner)
+				fieldSeen[pointofcontact.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -2701,6 +2826,16 @@ func (sa *SLSAAttestationQuery) collectField(ctx context.Context, opCtx *graphql
 				selectedFields = append(selectedFields, slsaattestation.FieldCollector)
 				fieldSeen[slsaattestation.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[slsaattestation.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, slsaattestation.FieldVerified)
+				fieldSeen[slsaattestation.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[slsaattestation.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, slsaattestation.FieldSigner)
+				fieldSeen[slsaattestation.FieldSigner] = struct{}{}
+			}
 		case "builtFromHash":
 			if _, ok := fieldSeen[slsaattestation.FieldBuiltFromHash]; !ok {
 				selectedFields = append(selectedFields, slsaattestation.FieldBuiltFromHash)
@@ -2812,6 +2947,16 @@ func (s *ScorecardQuery) collectField(ctx context.Context, opCtx *graphql.Operat
 				selectedFields = append(selectedFields, scorecard.FieldCollector)
 				fieldSeen[scorecard.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[scorecard.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, scorecard.FieldVerified)
+				fieldSeen[scorecard.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[scorecard.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, scorecard.FieldSigner)
+				fieldSeen[scorecard.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -3174,6 +3319,16 @@ func (ve *VulnEqualQuery) collectField(ctx context.Context, opCtx *graphql.Opera
 				selectedFields = append(selectedFields, vulnequal.FieldCollector)
 				fieldSeen[vulnequal.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[vulnequal.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, vulnequal.FieldVerified)
+				fieldSeen[vulnequal.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[vulnequal.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, vulnequal.FieldSigner)
+				fieldSeen[vulnequal.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -3384,6 +3539,16 @@ func (vm *VulnerabilityMetadataQuery) collectField(ctx context.Context, opCtx *g
 				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldCollector)
 				fieldSeen[vulnerabilitymetadata.FieldCollector] = struct{}{}
 			}
+		case "verified":
+			if _, ok := fieldSeen[vulnerabilitymetadata.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldVerified)
+				fieldSeen[vulnerabilitymetadata.FieldVerified] = struct{}{}
+			}
+		case "signer":
+			if _, ok := fieldSeen[vulnerabilitymetadata.FieldSigner]; !ok {
+				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldSigner)
+				fieldSeen[vulnerabilitymetadata.FieldSigner] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
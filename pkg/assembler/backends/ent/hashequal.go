@@ -20,6 +20,10 @@ type HashEqual struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Justification holds the value of the "justification" field.
 	Justification string `json:"justification,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -55,9 +59,11 @@ func (*HashEqual) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case hashequal.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case hashequal.FieldID:
 			values[i] = new(sql.NullInt64)
-		case hashequal.FieldOrigin, hashequal.FieldCollector, hashequal.FieldJustification:
+		case hashequal.FieldOrigin, hashequal.FieldCollector, hashequal.FieldSigner, hashequal.FieldJustification:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -92,6 +98,20 @@ func (he *HashEqual) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				he.Collector = value.String
 			}
+		case hashequal.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				he.Verified = new(bool)
+				*he.Verified = value.Bool
+			}
+		case hashequal.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				he.Signer = new(string)
+				*he.Signer = value.String
+			}
 		case hashequal.FieldJustification:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field justification", values[i])
@@ -145,6 +165,16 @@ func (he *HashEqual) String() string {
 	builder.WriteString("collector=")
 	builder.WriteString(he.Collector)
 	builder.WriteString(", ")
+	if v := he.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := he.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("justification=")
 	builder.WriteString(he.Justification)
 	builder.WriteByte(')')
@@ -34,6 +34,34 @@ func (hec *HashEqualCreate) SetCollector(s string) *HashEqualCreate {
 	return hec
 }
 
+// SetVerified sets the "verified" field.
+func (hec *HashEqualCreate) SetVerified(b bool) *HashEqualCreate {
+	hec.mutation.SetVerified(b)
+	return hec
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (hec *HashEqualCreate) SetNillableVerified(b *bool) *HashEqualCreate {
+	if b != nil {
+		hec.SetVerified(*b)
+	}
+	return hec
+}
+
+// SetSigner sets the "signer" field.
+func (hec *HashEqualCreate) SetSigner(s string) *HashEqualCreate {
+	hec.mutation.SetSigner(s)
+	return hec
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (hec *HashEqualCreate) SetNillableSigner(s *string) *HashEqualCreate {
+	if s != nil {
+		hec.SetSigner(*s)
+	}
+	return hec
+}
+
 // SetJustification sets the "justification" field.
 func (hec *HashEqualCreate) SetJustification(s string) *HashEqualCreate {
 	hec.mutation.SetJustification(s)
@@ -136,6 +164,14 @@ func (hec *HashEqualCreate) createSpec() (*HashEqual, *sqlgraph.CreateSpec) {
 		_spec.SetField(hashequal.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := hec.mutation.Verified(); ok {
+		_spec.SetField(hashequal.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := hec.mutation.Signer(); ok {
+		_spec.SetField(hashequal.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if value, ok := hec.mutation.Justification(); ok {
 		_spec.SetField(hashequal.FieldJustification, field.TypeString, value)
 		_node.Justification = value
@@ -232,6 +268,42 @@ func (u *HashEqualUpsert) UpdateCollector() *HashEqualUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *HashEqualUpsert) SetVerified(v bool) *HashEqualUpsert {
+	u.Set(hashequal.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HashEqualUpsert) UpdateVerified() *HashEqualUpsert {
+	u.SetExcluded(hashequal.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HashEqualUpsert) ClearVerified() *HashEqualUpsert {
+	u.SetNull(hashequal.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *HashEqualUpsert) SetSigner(v string) *HashEqualUpsert {
+	u.Set(hashequal.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HashEqualUpsert) UpdateSigner() *HashEqualUpsert {
+	u.SetExcluded(hashequal.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HashEqualUpsert) ClearSigner() *HashEqualUpsert {
+	u.SetNull(hashequal.FieldSigner)
+	return u
+}
+
 // SetJustification sets the "justification" field.
 func (u *HashEqualUpsert) SetJustification(v string) *HashEqualUpsert {
 	u.Set(hashequal.FieldJustification, v)
@@ -312,6 +384,48 @@ func (u *HashEqualUpsertOne) UpdateCollector() *HashEqualUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *HashEqualUpsertOne) SetVerified(v bool) *HashEqualUpsertOne {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HashEqualUpsertOne) UpdateVerified() *HashEqualUpsertOne {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HashEqualUpsertOne) ClearVerified() *HashEqualUpsertOne {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *HashEqualUpsertOne) SetSigner(v string) *HashEqualUpsertOne {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HashEqualUpsertOne) UpdateSigner() *HashEqualUpsertOne {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HashEqualUpsertOne) ClearSigner() *HashEqualUpsertOne {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *HashEqualUpsertOne) SetJustification(v string) *HashEqualUpsertOne {
 	return u.Update(func(s *HashEqualUpsert) {
@@ -557,6 +671,48 @@ func (u *HashEqualUpsertBulk) UpdateCollector() *HashEqualUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *HashEqualUpsertBulk) SetVerified(v bool) *HashEqualUpsertBulk {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HashEqualUpsertBulk) UpdateVerified() *HashEqualUpsertBulk {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HashEqualUpsertBulk) ClearVerified() *HashEqualUpsertBulk {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *HashEqualUpsertBulk) SetSigner(v string) *HashEqualUpsertBulk {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HashEqualUpsertBulk) UpdateSigner() *HashEqualUpsertBulk {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HashEqualUpsertBulk) ClearSigner() *HashEqualUpsertBulk {
+	return u.Update(func(s *HashEqualUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *HashEqualUpsertBulk) SetJustification(v string) *HashEqualUpsertBulk {
 	return u.Update(func(s *HashEqualUpsert) {
@@ -56,6 +56,46 @@ func (heu *HashEqualUpdate) SetNillableCollector(s *string) *HashEqualUpdate {
 	return heu
 }
 
+// SetVerified sets the "verified" field.
+func (heu *HashEqualUpdate) SetVerified(b bool) *HashEqualUpdate {
+	heu.mutation.SetVerified(b)
+	return heu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (heu *HashEqualUpdate) SetNillableVerified(b *bool) *HashEqualUpdate {
+	if b != nil {
+		heu.SetVerified(*b)
+	}
+	return heu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (heu *HashEqualUpdate) ClearVerified() *HashEqualUpdate {
+	heu.mutation.ClearVerified()
+	return heu
+}
+
+// SetSigner sets the "signer" field.
+func (heu *HashEqualUpdate) SetSigner(s string) *HashEqualUpdate {
+	heu.mutation.SetSigner(s)
+	return heu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (heu *HashEqualUpdate) SetNillableSigner(s *string) *HashEqualUpdate {
+	if s != nil {
+		heu.SetSigner(*s)
+	}
+	return heu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (heu *HashEqualUpdate) ClearSigner() *HashEqualUpdate {
+	heu.mutation.ClearSigner()
+	return heu
+}
+
 // SetJustification sets the "justification" field.
 func (heu *HashEqualUpdate) SetJustification(s string) *HashEqualUpdate {
 	heu.mutation.SetJustification(s)
@@ -153,6 +193,18 @@ func (heu *HashEqualUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := heu.mutation.Collector(); ok {
 		_spec.SetField(hashequal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := heu.mutation.Verified(); ok {
+		_spec.SetField(hashequal.FieldVerified, field.TypeBool, value)
+	}
+	if heu.mutation.VerifiedCleared() {
+		_spec.ClearField(hashequal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := heu.mutation.Signer(); ok {
+		_spec.SetField(hashequal.FieldSigner, field.TypeString, value)
+	}
+	if heu.mutation.SignerCleared() {
+		_spec.ClearField(hashequal.FieldSigner, field.TypeString)
+	}
 	if value, ok := heu.mutation.Justification(); ok {
 		_spec.SetField(hashequal.FieldJustification, field.TypeString, value)
 	}
@@ -249,6 +301,46 @@ func (heuo *HashEqualUpdateOne) SetNillableCollector(s *string) *HashEqualUpdate
 	return heuo
 }
 
+// SetVerified sets the "verified" field.
+func (heuo *HashEqualUpdateOne) SetVerified(b bool) *HashEqualUpdateOne {
+	heuo.mutation.SetVerified(b)
+	return heuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (heuo *HashEqualUpdateOne) SetNillableVerified(b *bool) *HashEqualUpdateOne {
+	if b != nil {
+		heuo.SetVerified(*b)
+	}
+	return heuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (heuo *HashEqualUpdateOne) ClearVerified() *HashEqualUpdateOne {
+	heuo.mutation.ClearVerified()
+	return heuo
+}
+
+// SetSigner sets the "signer" field.
+func (heuo *HashEqualUpdateOne) SetSigner(s string) *HashEqualUpdateOne {
+	heuo.mutation.SetSigner(s)
+	return heuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (heuo *HashEqualUpdateOne) SetNillableSigner(s *string) *HashEqualUpdateOne {
+	if s != nil {
+		heuo.SetSigner(*s)
+	}
+	return heuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (heuo *HashEqualUpdateOne) ClearSigner() *HashEqualUpdateOne {
+	heuo.mutation.ClearSigner()
+	return heuo
+}
+
 // SetJustification sets the "justification" field.
 func (heuo *HashEqualUpdateOne) SetJustification(s string) *HashEqualUpdateOne {
 	heuo.mutation.SetJustification(s)
@@ -376,6 +468,18 @@ func (heuo *HashEqualUpdateOne) sqlSave(ctx context.Context) (_node *HashEqual,
 	if value, ok := heuo.mutation.Collector(); ok {
 		_spec.SetField(hashequal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := heuo.mutation.Verified(); ok {
+		_spec.SetField(hashequal.FieldVerified, field.TypeBool, value)
+	}
+	if heuo.mutation.VerifiedCleared() {
+		_spec.ClearField(hashequal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := heuo.mutation.Signer(); ok {
+		_spec.SetField(hashequal.FieldSigner, field.TypeString, value)
+	}
+	if heuo.mutation.SignerCleared() {
+		_spec.ClearField(hashequal.FieldSigner, field.TypeString)
+	}
 	if value, ok := heuo.mutation.Justification(); ok {
 		_spec.SetField(hashequal.FieldJustification, field.TypeString, value)
 	}
@@ -41,6 +41,10 @@ type HasMetadata struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HasMetadataQuery when eager-loading is set.
 	Edges        HasMetadataEdges `json:"edges"`
@@ -121,9 +125,11 @@ func (*HasMetadata) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case hasmetadata.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case hasmetadata.FieldID, hasmetadata.FieldSourceID, hasmetadata.FieldPackageVersionID, hasmetadata.FieldPackageNameID, hasmetadata.FieldArtifactID:
 			values[i] = new(sql.NullInt64)
-		case hasmetadata.FieldKey, hasmetadata.FieldValue, hasmetadata.FieldJustification, hasmetadata.FieldOrigin, hasmetadata.FieldCollector:
+		case hasmetadata.FieldKey, hasmetadata.FieldValue, hasmetadata.FieldJustification, hasmetadata.FieldOrigin, hasmetadata.FieldCollector, hasmetadata.FieldSigner:
 			values[i] = new(sql.NullString)
 		case hasmetadata.FieldTimestamp:
 			values[i] = new(sql.NullTime)
@@ -212,6 +218,20 @@ func (hm *HasMetadata) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				hm.Collector = value.String
 			}
+		case hasmetadata.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				hm.Verified = new(bool)
+				*hm.Verified = value.Bool
+			}
+		case hasmetadata.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				hm.Signer = new(string)
+				*hm.Signer = value.String
+			}
 		default:
 			hm.selectValues.Set(columns[i], values[i])
 		}
@@ -305,6 +325,16 @@ func (hm *HasMetadata) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(hm.Collector)
+	builder.WriteString(", ")
+	if v := hm.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := hm.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -105,6 +105,16 @@ func Collector(v string) predicate.HasMetadata {
 	return predicate.HasMetadata(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEQ(FieldSigner, v))
+}
+
 // SourceIDEQ applies the EQ predicate on the "source_id" field.
 func SourceIDEQ(v int) predicate.HasMetadata {
 	return predicate.HasMetadata(sql.FieldEQ(FieldSourceID, v))
@@ -590,6 +600,101 @@ func CollectorContainsFold(v string) predicate.HasMetadata {
 	return predicate.HasMetadata(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasSource applies the HasEdge predicate on the "source" edge.
 func HasSource() predicate.HasMetadata {
 	return predicate.HasMetadata(func(s *sql.Selector) {
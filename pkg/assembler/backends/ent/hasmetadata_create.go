@@ -118,6 +118,34 @@ func (hmc *HasMetadataCreate) SetCollector(s string) *HasMetadataCreate {
 	return hmc
 }
 
+// SetVerified sets the "verified" field.
+func (hmc *HasMetadataCreate) SetVerified(b bool) *HasMetadataCreate {
+	hmc.mutation.SetVerified(b)
+	return hmc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (hmc *HasMetadataCreate) SetNillableVerified(b *bool) *HasMetadataCreate {
+	if b != nil {
+		hmc.SetVerified(*b)
+	}
+	return hmc
+}
+
+// SetSigner sets the "signer" field.
+func (hmc *HasMetadataCreate) SetSigner(s string) *HasMetadataCreate {
+	hmc.mutation.SetSigner(s)
+	return hmc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (hmc *HasMetadataCreate) SetNillableSigner(s *string) *HasMetadataCreate {
+	if s != nil {
+		hmc.SetSigner(*s)
+	}
+	return hmc
+}
+
 // SetSource sets the "source" edge to the SourceName entity.
 func (hmc *HasMetadataCreate) SetSource(s *SourceName) *HasMetadataCreate {
 	return hmc.SetSourceID(s.ID)
@@ -255,6 +283,14 @@ func (hmc *HasMetadataCreate) createSpec() (*HasMetadata, *sqlgraph.CreateSpec)
 		_spec.SetField(hasmetadata.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := hmc.mutation.Verified(); ok {
+		_spec.SetField(hasmetadata.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := hmc.mutation.Signer(); ok {
+		_spec.SetField(hasmetadata.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := hmc.mutation.SourceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -519,6 +555,42 @@ func (u *HasMetadataUpsert) UpdateCollector() *HasMetadataUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *HasMetadataUpsert) SetVerified(v bool) *HasMetadataUpsert {
+	u.Set(hasmetadata.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HasMetadataUpsert) UpdateVerified() *HasMetadataUpsert {
+	u.SetExcluded(hasmetadata.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HasMetadataUpsert) ClearVerified() *HasMetadataUpsert {
+	u.SetNull(hasmetadata.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *HasMetadataUpsert) SetSigner(v string) *HasMetadataUpsert {
+	u.Set(hasmetadata.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HasMetadataUpsert) UpdateSigner() *HasMetadataUpsert {
+	u.SetExcluded(hasmetadata.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HasMetadataUpsert) ClearSigner() *HasMetadataUpsert {
+	u.SetNull(hasmetadata.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -727,6 +799,48 @@ func (u *HasMetadataUpsertOne) UpdateCollector() *HasMetadataUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *HasMetadataUpsertOne) SetVerified(v bool) *HasMetadataUpsertOne {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HasMetadataUpsertOne) UpdateVerified() *HasMetadataUpsertOne {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HasMetadataUpsertOne) ClearVerified() *HasMetadataUpsertOne {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *HasMetadataUpsertOne) SetSigner(v string) *HasMetadataUpsertOne {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HasMetadataUpsertOne) UpdateSigner() *HasMetadataUpsertOne {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HasMetadataUpsertOne) ClearSigner() *HasMetadataUpsertOne {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *HasMetadataUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1098,6 +1212,48 @@ func (u *HasMetadataUpsertBulk) UpdateCollector() *HasMetadataUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *HasMetadataUpsertBulk) SetVerified(v bool) *HasMetadataUpsertBulk {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HasMetadataUpsertBulk) UpdateVerified() *HasMetadataUpsertBulk {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HasMetadataUpsertBulk) ClearVerified() *HasMetadataUpsertBulk {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *HasMetadataUpsertBulk) SetSigner(v string) *HasMetadataUpsertBulk {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HasMetadataUpsertBulk) UpdateSigner() *HasMetadataUpsertBulk {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HasMetadataUpsertBulk) ClearSigner() *HasMetadataUpsertBulk {
+	return u.Update(func(s *HasMetadataUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *HasMetadataUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
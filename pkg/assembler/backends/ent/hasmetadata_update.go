@@ -196,6 +196,46 @@ func (hmu *HasMetadataUpdate) SetNillableCollector(s *string) *HasMetadataUpdate
 	return hmu
 }
 
+// SetVerified sets the "verified" field.
+func (hmu *HasMetadataUpdate) SetVerified(b bool) *HasMetadataUpdate {
+	hmu.mutation.SetVerified(b)
+	return hmu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (hmu *HasMetadataUpdate) SetNillableVerified(b *bool) *HasMetadataUpdate {
+	if b != nil {
+		hmu.SetVerified(*b)
+	}
+	return hmu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (hmu *HasMetadataUpdate) ClearVerified() *HasMetadataUpdate {
+	hmu.mutation.ClearVerified()
+	return hmu
+}
+
+// SetSigner sets the "signer" field.
+func (hmu *HasMetadataUpdate) SetSigner(s string) *HasMetadataUpdate {
+	hmu.mutation.SetSigner(s)
+	return hmu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (hmu *HasMetadataUpdate) SetNillableSigner(s *string) *HasMetadataUpdate {
+	if s != nil {
+		hmu.SetSigner(*s)
+	}
+	return hmu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (hmu *HasMetadataUpdate) ClearSigner() *HasMetadataUpdate {
+	hmu.mutation.ClearSigner()
+	return hmu
+}
+
 // SetSource sets the "source" edge to the SourceName entity.
 func (hmu *HasMetadataUpdate) SetSource(s *SourceName) *HasMetadataUpdate {
 	return hmu.SetSourceID(s.ID)
@@ -313,6 +353,18 @@ func (hmu *HasMetadataUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := hmu.mutation.Collector(); ok {
 		_spec.SetField(hasmetadata.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := hmu.mutation.Verified(); ok {
+		_spec.SetField(hasmetadata.FieldVerified, field.TypeBool, value)
+	}
+	if hmu.mutation.VerifiedCleared() {
+		_spec.ClearField(hasmetadata.FieldVerified, field.TypeBool)
+	}
+	if value, ok := hmu.mutation.Signer(); ok {
+		_spec.SetField(hasmetadata.FieldSigner, field.TypeString, value)
+	}
+	if hmu.mutation.SignerCleared() {
+		_spec.ClearField(hasmetadata.FieldSigner, field.TypeString)
+	}
 	if hmu.mutation.SourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -613,6 +665,46 @@ func (hmuo *HasMetadataUpdateOne) SetNillableCollector(s *string) *HasMetadataUp
 	return hmuo
 }
 
+// SetVerified sets the "verified" field.
+func (hmuo *HasMetadataUpdateOne) SetVerified(b bool) *HasMetadataUpdateOne {
+	hmuo.mutation.SetVerified(b)
+	return hmuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (hmuo *HasMetadataUpdateOne) SetNillableVerified(b *bool) *HasMetadataUpdateOne {
+	if b != nil {
+		hmuo.SetVerified(*b)
+	}
+	return hmuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (hmuo *HasMetadataUpdateOne) ClearVerified() *HasMetadataUpdateOne {
+	hmuo.mutation.ClearVerified()
+	return hmuo
+}
+
+// SetSigner sets the "signer" field.
+func (hmuo *HasMetadataUpdateOne) SetSigner(s string) *HasMetadataUpdateOne {
+	hmuo.mutation.SetSigner(s)
+	return hmuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (hmuo *HasMetadataUpdateOne) SetNillableSigner(s *string) *HasMetadataUpdateOne {
+	if s != nil {
+		hmuo.SetSigner(*s)
+	}
+	return hmuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (hmuo *HasMetadataUpdateOne) ClearSigner() *HasMetadataUpdateOne {
+	hmuo.mutation.ClearSigner()
+	return hmuo
+}
+
 // SetSource sets the "source" edge to the SourceName entity.
 func (hmuo *HasMetadataUpdateOne) SetSource(s *SourceName) *HasMetadataUpdateOne {
 	return hmuo.SetSourceID(s.ID)
@@ -760,6 +852,18 @@ func (hmuo *HasMetadataUpdateOne) sqlSave(ctx context.Context) (_node *HasMetada
 	if value, ok := hmuo.mutation.Collector(); ok {
 		_spec.SetField(hasmetadata.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := hmuo.mutation.Verified(); ok {
+		_spec.SetField(hasmetadata.FieldVerified, field.TypeBool, value)
+	}
+	if hmuo.mutation.VerifiedCleared() {
+		_spec.ClearField(hasmetadata.FieldVerified, field.TypeBool)
+	}
+	if value, ok := hmuo.mutation.Signer(); ok {
+		_spec.SetField(hasmetadata.FieldSigner, field.TypeString, value)
+	}
+	if hmuo.mutation.SignerCleared() {
+		_spec.ClearField(hasmetadata.FieldSigner, field.TypeString)
+	}
 	if hmuo.mutation.SourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
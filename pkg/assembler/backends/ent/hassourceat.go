@@ -34,6 +34,10 @@ type HasSourceAt struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HasSourceAtQuery when eager-loading is set.
 	Edges        HasSourceAtEdges `json:"edges"`
@@ -99,9 +103,11 @@ func (*HasSourceAt) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case hassourceat.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case hassourceat.FieldID, hassourceat.FieldPackageVersionID, hassourceat.FieldPackageNameID, hassourceat.FieldSourceID:
 			values[i] = new(sql.NullInt64)
-		case hassourceat.FieldJustification, hassourceat.FieldOrigin, hassourceat.FieldCollector:
+		case hassourceat.FieldJustification, hassourceat.FieldOrigin, hassourceat.FieldCollector, hassourceat.FieldSigner:
 			values[i] = new(sql.NullString)
 		case hassourceat.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -170,6 +176,20 @@ func (hsa *HasSourceAt) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				hsa.Collector = value.String
 			}
+		case hassourceat.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				hsa.Verified = new(bool)
+				*hsa.Verified = value.Bool
+			}
+		case hassourceat.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				hsa.Signer = new(string)
+				*hsa.Signer = value.String
+			}
 		default:
 			hsa.selectValues.Set(columns[i], values[i])
 		}
@@ -245,6 +265,16 @@ func (hsa *HasSourceAt) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(hsa.Collector)
+	builder.WriteString(", ")
+	if v := hsa.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := hsa.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -90,6 +90,16 @@ func Collector(v string) predicate.HasSourceAt {
 	return predicate.HasSourceAt(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEQ(FieldSigner, v))
+}
+
 // PackageVersionIDEQ applies the EQ predicate on the "package_version_id" field.
 func PackageVersionIDEQ(v int) predicate.HasSourceAt {
 	return predicate.HasSourceAt(sql.FieldEQ(FieldPackageVersionID, v))
@@ -405,6 +415,101 @@ func CollectorContainsFold(v string) predicate.HasSourceAt {
 	return predicate.HasSourceAt(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasPackageVersion applies the HasEdge predicate on the "package_version" edge.
 func HasPackageVersion() predicate.HasSourceAt {
 	return predicate.HasSourceAt(func(s *sql.Selector) {
@@ -83,6 +83,34 @@ func (hsac *HasSourceAtCreate) SetCollector(s string) *HasSourceAtCreate {
 	return hsac
 }
 
+// SetVerified sets the "verified" field.
+func (hsac *HasSourceAtCreate) SetVerified(b bool) *HasSourceAtCreate {
+	hsac.mutation.SetVerified(b)
+	return hsac
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (hsac *HasSourceAtCreate) SetNillableVerified(b *bool) *HasSourceAtCreate {
+	if b != nil {
+		hsac.SetVerified(*b)
+	}
+	return hsac
+}
+
+// SetSigner sets the "signer" field.
+func (hsac *HasSourceAtCreate) SetSigner(s string) *HasSourceAtCreate {
+	hsac.mutation.SetSigner(s)
+	return hsac
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (hsac *HasSourceAtCreate) SetNillableSigner(s *string) *HasSourceAtCreate {
+	if s != nil {
+		hsac.SetSigner(*s)
+	}
+	return hsac
+}
+
 // SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
 func (hsac *HasSourceAtCreate) SetPackageVersion(p *PackageVersion) *HasSourceAtCreate {
 	return hsac.SetPackageVersionID(p.ID)
@@ -207,6 +235,14 @@ func (hsac *HasSourceAtCreate) createSpec() (*HasSourceAt, *sqlgraph.CreateSpec)
 		_spec.SetField(hassourceat.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := hsac.mutation.Verified(); ok {
+		_spec.SetField(hassourceat.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := hsac.mutation.Signer(); ok {
+		_spec.SetField(hassourceat.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := hsac.mutation.PackageVersionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -406,6 +442,42 @@ func (u *HasSourceAtUpsert) UpdateCollector() *HasSourceAtUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *HasSourceAtUpsert) SetVerified(v bool) *HasSourceAtUpsert {
+	u.Set(hassourceat.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HasSourceAtUpsert) UpdateVerified() *HasSourceAtUpsert {
+	u.SetExcluded(hassourceat.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HasSourceAtUpsert) ClearVerified() *HasSourceAtUpsert {
+	u.SetNull(hassourceat.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *HasSourceAtUpsert) SetSigner(v string) *HasSourceAtUpsert {
+	u.Set(hassourceat.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HasSourceAtUpsert) UpdateSigner() *HasSourceAtUpsert {
+	u.SetExcluded(hassourceat.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HasSourceAtUpsert) ClearSigner() *HasSourceAtUpsert {
+	u.SetNull(hassourceat.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -558,6 +630,48 @@ func (u *HasSourceAtUpsertOne) UpdateCollector() *HasSourceAtUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *HasSourceAtUpsertOne) SetVerified(v bool) *HasSourceAtUpsertOne {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HasSourceAtUpsertOne) UpdateVerified() *HasSourceAtUpsertOne {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HasSourceAtUpsertOne) ClearVerified() *HasSourceAtUpsertOne {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *HasSourceAtUpsertOne) SetSigner(v string) *HasSourceAtUpsertOne {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HasSourceAtUpsertOne) UpdateSigner() *HasSourceAtUpsertOne {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HasSourceAtUpsertOne) ClearSigner() *HasSourceAtUpsertOne {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *HasSourceAtUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -873,6 +987,48 @@ func (u *HasSourceAtUpsertBulk) UpdateCollector() *HasSourceAtUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *HasSourceAtUpsertBulk) SetVerified(v bool) *HasSourceAtUpsertBulk {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *HasSourceAtUpsertBulk) UpdateVerified() *HasSourceAtUpsertBulk {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *HasSourceAtUpsertBulk) ClearVerified() *HasSourceAtUpsertBulk {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *HasSourceAtUpsertBulk) SetSigner(v string) *HasSourceAtUpsertBulk {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *HasSourceAtUpsertBulk) UpdateSigner() *HasSourceAtUpsertBulk {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *HasSourceAtUpsertBulk) ClearSigner() *HasSourceAtUpsertBulk {
+	return u.Update(func(s *HasSourceAtUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *HasSourceAtUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -141,6 +141,46 @@ func (hsau *HasSourceAtUpdate) SetNillableCollector(s *string) *HasSourceAtUpdat
 	return hsau
 }
 
+// SetVerified sets the "verified" field.
+func (hsau *HasSourceAtUpdate) SetVerified(b bool) *HasSourceAtUpdate {
+	hsau.mutation.SetVerified(b)
+	return hsau
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (hsau *HasSourceAtUpdate) SetNillableVerified(b *bool) *HasSourceAtUpdate {
+	if b != nil {
+		hsau.SetVerified(*b)
+	}
+	return hsau
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (hsau *HasSourceAtUpdate) ClearVerified() *HasSourceAtUpdate {
+	hsau.mutation.ClearVerified()
+	return hsau
+}
+
+// SetSigner sets the "signer" field.
+func (hsau *HasSourceAtUpdate) SetSigner(s string) *HasSourceAtUpdate {
+	hsau.mutation.SetSigner(s)
+	return hsau
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (hsau *HasSourceAtUpdate) SetNillableSigner(s *string) *HasSourceAtUpdate {
+	if s != nil {
+		hsau.SetSigner(*s)
+	}
+	return hsau
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (hsau *HasSourceAtUpdate) ClearSigner() *HasSourceAtUpdate {
+	hsau.mutation.ClearSigner()
+	return hsau
+}
+
 // SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
 func (hsau *HasSourceAtUpdate) SetPackageVersion(p *PackageVersion) *HasSourceAtUpdate {
 	return hsau.SetPackageVersionID(p.ID)
@@ -252,6 +292,18 @@ func (hsau *HasSourceAtUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := hsau.mutation.Collector(); ok {
 		_spec.SetField(hassourceat.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := hsau.mutation.Verified(); ok {
+		_spec.SetField(hassourceat.FieldVerified, field.TypeBool, value)
+	}
+	if hsau.mutation.VerifiedCleared() {
+		_spec.ClearField(hassourceat.FieldVerified, field.TypeBool)
+	}
+	if value, ok := hsau.mutation.Signer(); ok {
+		_spec.SetField(hassourceat.FieldSigner, field.TypeString, value)
+	}
+	if hsau.mutation.SignerCleared() {
+		_spec.ClearField(hassourceat.FieldSigner, field.TypeString)
+	}
 	if hsau.mutation.PackageVersionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -469,6 +521,46 @@ func (hsauo *HasSourceAtUpdateOne) SetNillableCollector(s *string) *HasSourceAtU
 	return hsauo
 }
 
+// SetVerified sets the "verified" field.
+func (hsauo *HasSourceAtUpdateOne) SetVerified(b bool) *HasSourceAtUpdateOne {
+	hsauo.mutation.SetVerified(b)
+	return hsauo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (hsauo *HasSourceAtUpdateOne) SetNillableVerified(b *bool) *HasSourceAtUpdateOne {
+	if b != nil {
+		hsauo.SetVerified(*b)
+	}
+	return hsauo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (hsauo *HasSourceAtUpdateOne) ClearVerified() *HasSourceAtUpdateOne {
+	hsauo.mutation.ClearVerified()
+	return hsauo
+}
+
+// SetSigner sets the "signer" field.
+func (hsauo *HasSourceAtUpdateOne) SetSigner(s string) *HasSourceAtUpdateOne {
+	hsauo.mutation.SetSigner(s)
+	return hsauo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (hsauo *HasSourceAtUpdateOne) SetNillableSigner(s *string) *HasSourceAtUpdateOne {
+	if s != nil {
+		hsauo.SetSigner(*s)
+	}
+	return hsauo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (hsauo *HasSourceAtUpdateOne) ClearSigner() *HasSourceAtUpdateOne {
+	hsauo.mutation.ClearSigner()
+	return hsauo
+}
+
 // SetPackageVersion sets the "package_version" edge to the PackageVersion entity.
 func (hsauo *HasSourceAtUpdateOne) SetPackageVersion(p *PackageVersion) *HasSourceAtUpdateOne {
 	return hsauo.SetPackageVersionID(p.ID)
@@ -610,6 +702,18 @@ func (hsauo *HasSourceAtUpdateOne) sqlSave(ctx context.Context) (_node *HasSourc
 	if value, ok := hsauo.mutation.Collector(); ok {
 		_spec.SetField(hassourceat.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := hsauo.mutation.Verified(); ok {
+		_spec.SetField(hassourceat.FieldVerified, field.TypeBool, value)
+	}
+	if hsauo.mutation.VerifiedCleared() {
+		_spec.ClearField(hassourceat.FieldVerified, field.TypeBool)
+	}
+	if value, ok := hsauo.mutation.Signer(); ok {
+		_spec.SetField(hassourceat.FieldSigner, field.TypeString, value)
+	}
+	if hsauo.mutation.SignerCleared() {
+		_spec.ClearField(hassourceat.FieldSigner, field.TypeString)
+	}
 	if hsauo.mutation.PackageVersionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -42,6 +42,8 @@ var (
 		{Name: "download_location", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "known_since", Type: field.TypeTime},
 		{Name: "package_id", Type: field.TypeInt, Nullable: true},
 		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
@@ -54,13 +56,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bill_of_materials_package_versions_package",
-				Columns:    []*schema.Column{BillOfMaterialsColumns[8]},
+				Columns:    []*schema.Column{BillOfMaterialsColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "bill_of_materials_artifacts_artifact",
-				Columns:    []*schema.Column{BillOfMaterialsColumns[9]},
+				Columns:    []*schema.Column{BillOfMaterialsColumns[11]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -69,7 +71,7 @@ var (
 			{
 				Name:    "sbom_unique_package",
 				Unique:  true,
-				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[7], BillOfMaterialsColumns[8]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -77,7 +79,7 @@ var (
 			{
 				Name:    "sbom_unique_artifact",
 				Unique:  true,
-				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[7], BillOfMaterialsColumns[9]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[9], BillOfMaterialsColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -109,6 +111,8 @@ var (
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "known_since", Type: field.TypeTime},
 		{Name: "source_id", Type: field.TypeInt, Nullable: true},
 		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
@@ -123,25 +127,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certifications_source_names_source",
-				Columns:    []*schema.Column{CertificationsColumns[6]},
+				Columns:    []*schema.Column{CertificationsColumns[8]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_package_versions_package_version",
-				Columns:    []*schema.Column{CertificationsColumns[7]},
+				Columns:    []*schema.Column{CertificationsColumns[9]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_package_names_all_versions",
-				Columns:    []*schema.Column{CertificationsColumns[8]},
+				Columns:    []*schema.Column{CertificationsColumns[10]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_artifacts_artifact",
-				Columns:    []*schema.Column{CertificationsColumns[9]},
+				Columns:    []*schema.Column{CertificationsColumns[11]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -150,7 +154,7 @@ var (
 			{
 				Name:    "certification_type_justification_origin_collector_source_id_known_since",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[6], CertificationsColumns[5]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[8], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -158,7 +162,7 @@ var (
 			{
 				Name:    "certification_type_justification_origin_collector_package_version_id_known_since",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[7], CertificationsColumns[5]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[9], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -166,7 +170,7 @@ var (
 			{
 				Name:    "certification_type_justification_origin_collector_package_name_id_known_since",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[8], CertificationsColumns[5]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[10], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -174,7 +178,7 @@ var (
 			{
 				Name:    "certification_type_justification_origin_collector_artifact_id_known_since",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[9], CertificationsColumns[5]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[4], CertificationsColumns[11], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -191,6 +195,8 @@ var (
 		{Name: "time_scanned", Type: field.TypeTime},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "declared_licenses_hash", Type: field.TypeString},
 		{Name: "discovered_licenses_hash", Type: field.TypeString},
 		{Name: "package_id", Type: field.TypeInt, Nullable: true},
@@ -204,13 +210,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_legals_package_versions_package",
-				Columns:    []*schema.Column{CertifyLegalsColumns[10]},
+				Columns:    []*schema.Column{CertifyLegalsColumns[12]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_legals_source_names_source",
-				Columns:    []*schema.Column{CertifyLegalsColumns[11]},
+				Columns:    []*schema.Column{CertifyLegalsColumns[13]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -219,7 +225,7 @@ var (
 			{
 				Name:    "certifylegal_source_id_declared_license_discovered_license_attribution_justification_time_scanned_origin_collector_declared_licenses_hash_discovered_licenses_hash",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyLegalsColumns[11], CertifyLegalsColumns[1], CertifyLegalsColumns[2], CertifyLegalsColumns[3], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[9]},
+				Columns: []*schema.Column{CertifyLegalsColumns[13], CertifyLegalsColumns[1], CertifyLegalsColumns[2], CertifyLegalsColumns[3], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[10], CertifyLegalsColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND source_id IS NOT NULL",
 				},
@@ -227,7 +233,7 @@ var (
 			{
 				Name:    "certifylegal_package_id_declared_license_discovered_license_attribution_justification_time_scanned_origin_collector_declared_licenses_hash_discovered_licenses_hash",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyLegalsColumns[10], CertifyLegalsColumns[1], CertifyLegalsColumns[2], CertifyLegalsColumns[3], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[9]},
+				Columns: []*schema.Column{CertifyLegalsColumns[12], CertifyLegalsColumns[1], CertifyLegalsColumns[2], CertifyLegalsColumns[3], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[10], CertifyLegalsColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -277,6 +283,8 @@ var (
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "package_id", Type: field.TypeInt, Nullable: true},
 		{Name: "artifact_id", Type: field.TypeInt, Nullable: true},
 		{Name: "vulnerability_id", Type: field.TypeInt},
@@ -289,19 +297,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_vexes_package_versions_package",
-				Columns:    []*schema.Column{CertifyVexesColumns[8]},
+				Columns:    []*schema.Column{CertifyVexesColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_vexes_artifacts_artifact",
-				Columns:    []*schema.Column{CertifyVexesColumns[9]},
+				Columns:    []*schema.Column{CertifyVexesColumns[11]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_vexes_vulnerability_ids_vulnerability",
-				Columns:    []*schema.Column{CertifyVexesColumns[10]},
+				Columns:    []*schema.Column{CertifyVexesColumns[12]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -310,7 +318,7 @@ var (
 			{
 				Name:    "certifyvex_known_since_justification_status_statement_status_notes_origin_collector_vulnerability_id_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[3], CertifyVexesColumns[4], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[10], CertifyVexesColumns[8]},
+				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[3], CertifyVexesColumns[4], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[12], CertifyVexesColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "artifact_id IS NULL",
 				},
@@ -318,7 +326,7 @@ var (
 			{
 				Name:    "certifyvex_known_since_justification_status_statement_status_notes_origin_collector_vulnerability_id_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[3], CertifyVexesColumns[4], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[10], CertifyVexesColumns[9]},
+				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[3], CertifyVexesColumns[4], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[12], CertifyVexesColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL",
 				},
@@ -335,6 +343,8 @@ var (
 		{Name: "scanner_version", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "vulnerability_id", Type: field.TypeInt},
 		{Name: "package_id", Type: field.TypeInt},
 	}
@@ -346,13 +356,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_vulns_vulnerability_ids_vulnerability",
-				Columns:    []*schema.Column{CertifyVulnsColumns[8]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[10]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "certify_vulns_package_versions_package",
-				Columns:    []*schema.Column{CertifyVulnsColumns[9]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[11]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -361,7 +371,7 @@ var (
 			{
 				Name:    "certifyvuln_db_uri_db_version_scanner_uri_scanner_version_origin_collector_vulnerability_id_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVulnsColumns[2], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7], CertifyVulnsColumns[8], CertifyVulnsColumns[9]},
+				Columns: []*schema.Column{CertifyVulnsColumns[2], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7], CertifyVulnsColumns[10], CertifyVulnsColumns[11]},
 			},
 		},
 	}
@@ -370,9 +380,12 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "version_range", Type: field.TypeString},
 		{Name: "dependency_type", Type: field.TypeEnum, Enums: []string{"DIRECT", "INDIRECT", "UNKNOWN"}},
+		{Name: "dependency_scope", Type: field.TypeEnum, Enums: []string{"RUNTIME", "DEV", "BUILD", "TEST", "OPTIONAL", "PROVIDED", "UNKNOWN"}, Default: "UNKNOWN"},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "package_id", Type: field.TypeInt},
 		{Name: "dependent_package_name_id", Type: field.TypeInt, Nullable: true},
 		{Name: "dependent_package_version_id", Type: field.TypeInt, Nullable: true},
@@ -385,19 +398,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "dependencies_package_versions_package",
-				Columns:    []*schema.Column{DependenciesColumns[6]},
+				Columns:    []*schema.Column{DependenciesColumns[9]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "dependencies_package_names_dependent_package_name",
-				Columns:    []*schema.Column{DependenciesColumns[7]},
+				Columns:    []*schema.Column{DependenciesColumns[10]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "dependencies_package_versions_dependent_package_version",
-				Columns:    []*schema.Column{DependenciesColumns[8]},
+				Columns:    []*schema.Column{DependenciesColumns[11]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -406,7 +419,7 @@ var (
 			{
 				Name:    "dep_package_name",
 				Unique:  true,
-				Columns: []*schema.Column{DependenciesColumns[1], DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[7]},
+				Columns: []*schema.Column{DependenciesColumns[1], DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[9], DependenciesColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "dependent_package_name_id IS NOT NULL AND dependent_package_version_id IS NULL",
 				},
@@ -414,7 +427,7 @@ var (
 			{
 				Name:    "dep_package_version",
 				Unique:  true,
-				Columns: []*schema.Column{DependenciesColumns[1], DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[8]},
+				Columns: []*schema.Column{DependenciesColumns[1], DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[9], DependenciesColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "dependent_package_name_id IS NULL AND dependent_package_version_id IS NOT NULL",
 				},
@@ -430,6 +443,8 @@ var (
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "source_id", Type: field.TypeInt, Nullable: true},
 		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
 		{Name: "package_name_id", Type: field.TypeInt, Nullable: true},
@@ -443,25 +458,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "has_metadata_source_names_source",
-				Columns:    []*schema.Column{HasMetadataColumns[7]},
+				Columns:    []*schema.Column{HasMetadataColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_package_versions_package_version",
-				Columns:    []*schema.Column{HasMetadataColumns[8]},
+				Columns:    []*schema.Column{HasMetadataColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_package_names_all_versions",
-				Columns:    []*schema.Column{HasMetadataColumns[9]},
+				Columns:    []*schema.Column{HasMetadataColumns[11]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_artifacts_artifact",
-				Columns:    []*schema.Column{HasMetadataColumns[10]},
+				Columns:    []*schema.Column{HasMetadataColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -470,7 +485,7 @@ var (
 			{
 				Name:    "hasmetadata_key_value_justification_origin_collector_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7]},
+				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[9]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -478,7 +493,7 @@ var (
 			{
 				Name:    "hasmetadata_key_value_justification_origin_collector_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[8]},
+				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -486,7 +501,7 @@ var (
 			{
 				Name:    "hasmetadata_key_value_justification_origin_collector_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[9]},
+				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -494,7 +509,7 @@ var (
 			{
 				Name:    "hasmetadata_key_value_justification_origin_collector_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[10]},
+				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -508,6 +523,8 @@ var (
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
 		{Name: "package_name_id", Type: field.TypeInt, Nullable: true},
 		{Name: "source_id", Type: field.TypeInt},
@@ -520,19 +537,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "has_source_ats_package_versions_package_version",
-				Columns:    []*schema.Column{HasSourceAtsColumns[5]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_source_ats_package_names_all_versions",
-				Columns:    []*schema.Column{HasSourceAtsColumns[6]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[8]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_source_ats_source_names_source",
-				Columns:    []*schema.Column{HasSourceAtsColumns[7]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -541,7 +558,7 @@ var (
 			{
 				Name:    "hassourceat_source_id_package_version_id_justification",
 				Unique:  true,
-				Columns: []*schema.Column{HasSourceAtsColumns[7], HasSourceAtsColumns[5], HasSourceAtsColumns[2]},
+				Columns: []*schema.Column{HasSourceAtsColumns[9], HasSourceAtsColumns[7], HasSourceAtsColumns[2]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_version_id IS NOT NULL AND package_name_id IS NULL",
 				},
@@ -549,7 +566,7 @@ var (
 			{
 				Name:    "hassourceat_source_id_package_name_id_justification",
 				Unique:  true,
-				Columns: []*schema.Column{HasSourceAtsColumns[7], HasSourceAtsColumns[6], HasSourceAtsColumns[2]},
+				Columns: []*schema.Column{HasSourceAtsColumns[9], HasSourceAtsColumns[8], HasSourceAtsColumns[2]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_name_id IS NOT NULL AND package_version_id IS NULL",
 				},
@@ -566,6 +583,8 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "justification", Type: field.TypeString},
 	}
 	// HashEqualsTable holds the schema information for the "hash_equals" table.
@@ -655,6 +674,8 @@ var (
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "artifact_id", Type: field.TypeInt},
 		{Name: "package_id", Type: field.TypeInt, Nullable: true},
 		{Name: "source_id", Type: field.TypeInt, Nullable: true},
@@ -667,19 +688,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "occurrences_artifacts_artifact",
-				Columns:    []*schema.Column{OccurrencesColumns[4]},
+				Columns:    []*schema.Column{OccurrencesColumns[6]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "occurrences_package_versions_package",
-				Columns:    []*schema.Column{OccurrencesColumns[5]},
+				Columns:    []*schema.Column{OccurrencesColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "occurrences_source_names_source",
-				Columns:    []*schema.Column{OccurrencesColumns[6]},
+				Columns:    []*schema.Column{OccurrencesColumns[8]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -688,7 +709,7 @@ var (
 			{
 				Name:    "occurrence_unique_package",
 				Unique:  true,
-				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5]},
+				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[6], OccurrencesColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -696,7 +717,7 @@ var (
 			{
 				Name:    "occurrence_unique_source",
 				Unique:  true,
-				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[6]},
+				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[6], OccurrencesColumns[8]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND source_id IS NOT NULL",
 				},
@@ -818,6 +839,8 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "packages_hash", Type: field.TypeString},
 	}
@@ -830,7 +853,7 @@ var (
 			{
 				Name:    "pkgequal_packages_hash_origin_justification_collector",
 				Unique:  true,
-				Columns: []*schema.Column{PkgEqualsColumns[4], PkgEqualsColumns[1], PkgEqualsColumns[3], PkgEqualsColumns[2]},
+				Columns: []*schema.Column{PkgEqualsColumns[6], PkgEqualsColumns[1], PkgEqualsColumns[5], PkgEqualsColumns[2]},
 			},
 		},
 	}
@@ -843,6 +866,8 @@ var (
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "source_id", Type: field.TypeInt, Nullable: true},
 		{Name: "package_version_id", Type: field.TypeInt, Nullable: true},
 		{Name: "package_name_id", Type: field.TypeInt, Nullable: true},
@@ -856,25 +881,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "point_of_contacts_source_names_source",
-				Columns:    []*schema.Column{PointOfContactsColumns[7]},
+				Columns:    []*schema.Column{PointOfContactsColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_package_versions_package_version",
-				Columns:    []*schema.Column{PointOfContactsColumns[8]},
+				Columns:    []*schema.Column{PointOfContactsColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_package_names_all_versions",
-				Columns:    []*schema.Column{PointOfContactsColumns[9]},
+				Columns:    []*schema.Column{PointOfContactsColumns[11]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_artifacts_artifact",
-				Columns:    []*schema.Column{PointOfContactsColumns[10]},
+				Columns:    []*schema.Column{PointOfContactsColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -883,7 +908,7 @@ var (
 			{
 				Name:    "pointofcontact_since_email_info_justification_origin_collector_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7]},
+				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[9]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -891,7 +916,7 @@ var (
 			{
 				Name:    "pointofcontact_since_email_info_justification_origin_collector_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[8]},
+				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -899,7 +924,7 @@ var (
 			{
 				Name:    "pointofcontact_since_email_info_justification_origin_collector_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[9]},
+				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -907,7 +932,7 @@ var (
 			{
 				Name:    "pointofcontact_since_email_info_justification_origin_collector_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[10]},
+				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -924,6 +949,8 @@ var (
 		{Name: "finished_on", Type: field.TypeTime, Nullable: true},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "built_from_hash", Type: field.TypeString},
 		{Name: "built_by_id", Type: field.TypeInt},
 		{Name: "subject_id", Type: field.TypeInt},
@@ -936,13 +963,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "slsa_attestations_builders_built_by",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[9]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[11]},
 				RefColumns: []*schema.Column{BuildersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "slsa_attestations_artifacts_subject",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[10]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -951,7 +978,7 @@ var (
 			{
 				Name:    "slsaattestation_subject_id_origin_collector_build_type_slsa_version_built_by_id_built_from_hash",
 				Unique:  true,
-				Columns: []*schema.Column{SlsaAttestationsColumns[10], SlsaAttestationsColumns[6], SlsaAttestationsColumns[7], SlsaAttestationsColumns[1], SlsaAttestationsColumns[3], SlsaAttestationsColumns[9], SlsaAttestationsColumns[8]},
+				Columns: []*schema.Column{SlsaAttestationsColumns[12], SlsaAttestationsColumns[6], SlsaAttestationsColumns[7], SlsaAttestationsColumns[1], SlsaAttestationsColumns[3], SlsaAttestationsColumns[11], SlsaAttestationsColumns[10]},
 			},
 			{
 				Name:    "slsaattestation_started_on",
@@ -975,6 +1002,8 @@ var (
 		{Name: "scorecard_commit", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 	}
 	// ScorecardsTable holds the schema information for the "scorecards" table.
 	ScorecardsTable = &schema.Table{
@@ -1062,6 +1091,8 @@ var (
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 	}
 	// VulnEqualsTable holds the schema information for the "vuln_equals" table.
 	VulnEqualsTable = &schema.Table{
@@ -1104,6 +1135,8 @@ var (
 		{Name: "timestamp", Type: field.TypeTime},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool, Nullable: true},
+		{Name: "signer", Type: field.TypeString, Nullable: true},
 		{Name: "vulnerability_id_id", Type: field.TypeInt},
 	}
 	// VulnerabilityMetadataTable holds the schema information for the "vulnerability_metadata" table.
@@ -1114,7 +1147,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vulnerability_metadata_vulnerability_ids_vulnerability_id",
-				Columns:    []*schema.Column{VulnerabilityMetadataColumns[6]},
+				Columns:    []*schema.Column{VulnerabilityMetadataColumns[8]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1123,7 +1156,7 @@ var (
 			{
 				Name:    "vulnerabilitymetadata_vulnerability_id_id_score_type_score_value_timestamp_origin_collector",
 				Unique:  true,
-				Columns: []*schema.Column{VulnerabilityMetadataColumns[6], VulnerabilityMetadataColumns[1], VulnerabilityMetadataColumns[2], VulnerabilityMetadataColumns[3], VulnerabilityMetadataColumns[4], VulnerabilityMetadataColumns[5]},
+				Columns: []*schema.Column{VulnerabilityMetadataColumns[8], VulnerabilityMetadataColumns[1], VulnerabilityMetadataColumns[2], VulnerabilityMetadataColumns[3], VulnerabilityMetadataColumns[4], VulnerabilityMetadataColumns[5]},
 			},
 		},
 	}
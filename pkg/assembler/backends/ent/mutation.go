@@ -820,6 +820,8 @@ type BillOfMaterialsMutation struct {
 	download_location *string
 	origin            *string
 	collector         *string
+	verified          *bool
+	signer            *string
 	known_since       *time.Time
 	clearedFields     map[string]struct{}
 	_package          *int
@@ -1243,6 +1245,104 @@ func (m *BillOfMaterialsMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *BillOfMaterialsMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *BillOfMaterialsMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the BillOfMaterials entity.
+// If the BillOfMaterials object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BillOfMaterialsMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *BillOfMaterialsMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[billofmaterials.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *BillOfMaterialsMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[billofmaterials.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *BillOfMaterialsMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, billofmaterials.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *BillOfMaterialsMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *BillOfMaterialsMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the BillOfMaterials entity.
+// If the BillOfMaterials object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BillOfMaterialsMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *BillOfMaterialsMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[billofmaterials.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *BillOfMaterialsMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[billofmaterials.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *BillOfMaterialsMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, billofmaterials.FieldSigner)
+}
+
 // SetKnownSince sets the "known_since" field.
 func (m *BillOfMaterialsMutation) SetKnownSince(t time.Time) {
 	m.known_since = &t
@@ -1367,7 +1467,7 @@ func (m *BillOfMaterialsMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BillOfMaterialsMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 11)
 	if m._package != nil {
 		fields = append(fields, billofmaterials.FieldPackageID)
 	}
@@ -1392,6 +1492,12 @@ func (m *BillOfMaterialsMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, billofmaterials.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, billofmaterials.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, billofmaterials.FieldSigner)
+	}
 	if m.known_since != nil {
 		fields = append(fields, billofmaterials.FieldKnownSince)
 	}
@@ -1419,6 +1525,10 @@ func (m *BillOfMaterialsMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case billofmaterials.FieldCollector:
 		return m.Collector()
+	case billofmaterials.FieldVerified:
+		return m.Verified()
+	case billofmaterials.FieldSigner:
+		return m.Signer()
 	case billofmaterials.FieldKnownSince:
 		return m.KnownSince()
 	}
@@ -1446,6 +1556,10 @@ func (m *BillOfMaterialsMutation) OldField(ctx context.Context, name string) (en
 		return m.OldOrigin(ctx)
 	case billofmaterials.FieldCollector:
 		return m.OldCollector(ctx)
+	case billofmaterials.FieldVerified:
+		return m.OldVerified(ctx)
+	case billofmaterials.FieldSigner:
+		return m.OldSigner(ctx)
 	case billofmaterials.FieldKnownSince:
 		return m.OldKnownSince(ctx)
 	}
@@ -1513,6 +1627,20 @@ func (m *BillOfMaterialsMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case billofmaterials.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case billofmaterials.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	case billofmaterials.FieldKnownSince:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -1559,6 +1687,12 @@ func (m *BillOfMaterialsMutation) ClearedFields() []string {
 	if m.FieldCleared(billofmaterials.FieldArtifactID) {
 		fields = append(fields, billofmaterials.FieldArtifactID)
 	}
+	if m.FieldCleared(billofmaterials.FieldVerified) {
+		fields = append(fields, billofmaterials.FieldVerified)
+	}
+	if m.FieldCleared(billofmaterials.FieldSigner) {
+		fields = append(fields, billofmaterials.FieldSigner)
+	}
 	return fields
 }
 
@@ -1579,6 +1713,12 @@ func (m *BillOfMaterialsMutation) ClearField(name string) error {
 	case billofmaterials.FieldArtifactID:
 		m.ClearArtifactID()
 		return nil
+	case billofmaterials.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case billofmaterials.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown BillOfMaterials nullable field %s", name)
 }
@@ -1611,6 +1751,12 @@ func (m *BillOfMaterialsMutation) ResetField(name string) error {
 	case billofmaterials.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case billofmaterials.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case billofmaterials.FieldSigner:
+		m.ResetSigner()
+		return nil
 	case billofmaterials.FieldKnownSince:
 		m.ResetKnownSince()
 		return nil
@@ -2139,6 +2285,8 @@ type CertificationMutation struct {
 	justification          *string
 	origin                 *string
 	collector              *string
+	verified               *bool
+	signer                 *string
 	known_since            *time.Time
 	clearedFields          map[string]struct{}
 	source                 *int
@@ -2592,6 +2740,104 @@ func (m *CertificationMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *CertificationMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *CertificationMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the Certification entity.
+// If the Certification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificationMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *CertificationMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[certification.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *CertificationMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[certification.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *CertificationMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, certification.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *CertificationMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *CertificationMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the Certification entity.
+// If the Certification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificationMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *CertificationMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[certification.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *CertificationMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[certification.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *CertificationMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, certification.FieldSigner)
+}
+
 // SetKnownSince sets the "known_since" field.
 func (m *CertificationMutation) SetKnownSince(t time.Time) {
 	m.known_since = &t
@@ -2783,7 +3029,7 @@ func (m *CertificationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertificationMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 11)
 	if m.source != nil {
 		fields = append(fields, certification.FieldSourceID)
 	}
@@ -2808,6 +3054,12 @@ func (m *CertificationMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, certification.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, certification.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, certification.FieldSigner)
+	}
 	if m.known_since != nil {
 		fields = append(fields, certification.FieldKnownSince)
 	}
@@ -2835,6 +3087,10 @@ func (m *CertificationMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case certification.FieldCollector:
 		return m.Collector()
+	case certification.FieldVerified:
+		return m.Verified()
+	case certification.FieldSigner:
+		return m.Signer()
 	case certification.FieldKnownSince:
 		return m.KnownSince()
 	}
@@ -2862,6 +3118,10 @@ func (m *CertificationMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldOrigin(ctx)
 	case certification.FieldCollector:
 		return m.OldCollector(ctx)
+	case certification.FieldVerified:
+		return m.OldVerified(ctx)
+	case certification.FieldSigner:
+		return m.OldSigner(ctx)
 	case certification.FieldKnownSince:
 		return m.OldKnownSince(ctx)
 	}
@@ -2929,6 +3189,20 @@ func (m *CertificationMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case certification.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case certification.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	case certification.FieldKnownSince:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -2981,6 +3255,12 @@ func (m *CertificationMutation) ClearedFields() []string {
 	if m.FieldCleared(certification.FieldArtifactID) {
 		fields = append(fields, certification.FieldArtifactID)
 	}
+	if m.FieldCleared(certification.FieldVerified) {
+		fields = append(fields, certification.FieldVerified)
+	}
+	if m.FieldCleared(certification.FieldSigner) {
+		fields = append(fields, certification.FieldSigner)
+	}
 	return fields
 }
 
@@ -3007,6 +3287,12 @@ func (m *CertificationMutation) ClearField(name string) error {
 	case certification.FieldArtifactID:
 		m.ClearArtifactID()
 		return nil
+	case certification.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case certification.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown Certification nullable field %s", name)
 }
@@ -3039,6 +3325,12 @@ func (m *CertificationMutation) ResetField(name string) error {
 	case certification.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case certification.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case certification.FieldSigner:
+		m.ResetSigner()
+		return nil
 	case certification.FieldKnownSince:
 		m.ResetKnownSince()
 		return nil
@@ -3187,6 +3479,8 @@ type CertifyLegalMutation struct {
 	time_scanned               *time.Time
 	origin                     *string
 	collector                  *string
+	verified                   *bool
+	signer                     *string
 	declared_licenses_hash     *string
 	discovered_licenses_hash   *string
 	clearedFields              map[string]struct{}
@@ -3653,6 +3947,104 @@ func (m *CertifyLegalMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *CertifyLegalMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *CertifyLegalMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *CertifyLegalMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[certifylegal.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *CertifyLegalMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[certifylegal.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *CertifyLegalMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, certifylegal.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *CertifyLegalMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *CertifyLegalMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *CertifyLegalMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[certifylegal.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *CertifyLegalMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[certifylegal.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *CertifyLegalMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, certifylegal.FieldSigner)
+}
+
 // SetDeclaredLicensesHash sets the "declared_licenses_hash" field.
 func (m *CertifyLegalMutation) SetDeclaredLicensesHash(s string) {
 	m.declared_licenses_hash = &s
@@ -3921,7 +4313,7 @@ func (m *CertifyLegalMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyLegalMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 13)
 	if m._package != nil {
 		fields = append(fields, certifylegal.FieldPackageID)
 	}
@@ -3949,6 +4341,12 @@ func (m *CertifyLegalMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, certifylegal.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, certifylegal.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, certifylegal.FieldSigner)
+	}
 	if m.declared_licenses_hash != nil {
 		fields = append(fields, certifylegal.FieldDeclaredLicensesHash)
 	}
@@ -3981,6 +4379,10 @@ func (m *CertifyLegalMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case certifylegal.FieldCollector:
 		return m.Collector()
+	case certifylegal.FieldVerified:
+		return m.Verified()
+	case certifylegal.FieldSigner:
+		return m.Signer()
 	case certifylegal.FieldDeclaredLicensesHash:
 		return m.DeclaredLicensesHash()
 	case certifylegal.FieldDiscoveredLicensesHash:
@@ -4012,6 +4414,10 @@ func (m *CertifyLegalMutation) OldField(ctx context.Context, name string) (ent.V
 		return m.OldOrigin(ctx)
 	case certifylegal.FieldCollector:
 		return m.OldCollector(ctx)
+	case certifylegal.FieldVerified:
+		return m.OldVerified(ctx)
+	case certifylegal.FieldSigner:
+		return m.OldSigner(ctx)
 	case certifylegal.FieldDeclaredLicensesHash:
 		return m.OldDeclaredLicensesHash(ctx)
 	case certifylegal.FieldDiscoveredLicensesHash:
@@ -4088,6 +4494,20 @@ func (m *CertifyLegalMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case certifylegal.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case certifylegal.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	case certifylegal.FieldDeclaredLicensesHash:
 		v, ok := value.(string)
 		if !ok {
@@ -4141,6 +4561,12 @@ func (m *CertifyLegalMutation) ClearedFields() []string {
 	if m.FieldCleared(certifylegal.FieldSourceID) {
 		fields = append(fields, certifylegal.FieldSourceID)
 	}
+	if m.FieldCleared(certifylegal.FieldVerified) {
+		fields = append(fields, certifylegal.FieldVerified)
+	}
+	if m.FieldCleared(certifylegal.FieldSigner) {
+		fields = append(fields, certifylegal.FieldSigner)
+	}
 	return fields
 }
 
@@ -4161,6 +4587,12 @@ func (m *CertifyLegalMutation) ClearField(name string) error {
 	case certifylegal.FieldSourceID:
 		m.ClearSourceID()
 		return nil
+	case certifylegal.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case certifylegal.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyLegal nullable field %s", name)
 }
@@ -4196,6 +4628,12 @@ func (m *CertifyLegalMutation) ResetField(name string) error {
 	case certifylegal.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case certifylegal.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case certifylegal.FieldSigner:
+		m.ResetSigner()
+		return nil
 	case certifylegal.FieldDeclaredLicensesHash:
 		m.ResetDeclaredLicensesHash()
 		return nil
@@ -4848,6 +5286,8 @@ type CertifyVexMutation struct {
 	justification        *string
 	origin               *string
 	collector            *string
+	verified             *bool
+	signer               *string
 	clearedFields        map[string]struct{}
 	_package             *int
 	cleared_package      bool
@@ -5344,50 +5784,148 @@ func (m *CertifyVexMutation) ResetCollector() {
 	m.collector = nil
 }
 
-// ClearPackage clears the "package" edge to the PackageVersion entity.
-func (m *CertifyVexMutation) ClearPackage() {
-	m.cleared_package = true
-	m.clearedFields[certifyvex.FieldPackageID] = struct{}{}
+// SetVerified sets the "verified" field.
+func (m *CertifyVexMutation) SetVerified(b bool) {
+	m.verified = &b
 }
 
-// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
-func (m *CertifyVexMutation) PackageCleared() bool {
-	return m.PackageIDCleared() || m.cleared_package
+// Verified returns the value of the "verified" field in the mutation.
+func (m *CertifyVexMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PackageIDs returns the "package" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PackageID instead. It exists only for internal usage by the builders.
-func (m *CertifyVexMutation) PackageIDs() (ids []int) {
-	if id := m._package; id != nil {
-		ids = append(ids, *id)
+// OldVerified returns the old "verified" field's value of the CertifyVex entity.
+// If the CertifyVex object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVexMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
 }
 
-// ResetPackage resets all changes to the "package" edge.
-func (m *CertifyVexMutation) ResetPackage() {
-	m._package = nil
-	m.cleared_package = false
+// ClearVerified clears the value of the "verified" field.
+func (m *CertifyVexMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[certifyvex.FieldVerified] = struct{}{}
 }
 
-// ClearArtifact clears the "artifact" edge to the Artifact entity.
-func (m *CertifyVexMutation) ClearArtifact() {
-	m.clearedartifact = true
-	m.clearedFields[certifyvex.FieldArtifactID] = struct{}{}
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *CertifyVexMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[certifyvex.FieldVerified]
+	return ok
 }
 
-// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
-func (m *CertifyVexMutation) ArtifactCleared() bool {
-	return m.ArtifactIDCleared() || m.clearedartifact
+// ResetVerified resets all changes to the "verified" field.
+func (m *CertifyVexMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, certifyvex.FieldVerified)
 }
 
-// ArtifactIDs returns the "artifact" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ArtifactID instead. It exists only for internal usage by the builders.
-func (m *CertifyVexMutation) ArtifactIDs() (ids []int) {
-	if id := m.artifact; id != nil {
-		ids = append(ids, *id)
+// SetSigner sets the "signer" field.
+func (m *CertifyVexMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *CertifyVexMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the CertifyVex entity.
+// If the CertifyVex object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVexMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *CertifyVexMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[certifyvex.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *CertifyVexMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[certifyvex.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *CertifyVexMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, certifyvex.FieldSigner)
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *CertifyVexMutation) ClearPackage() {
+	m.cleared_package = true
+	m.clearedFields[certifyvex.FieldPackageID] = struct{}{}
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *CertifyVexMutation) PackageCleared() bool {
+	return m.PackageIDCleared() || m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *CertifyVexMutation) PackageIDs() (ids []int) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *CertifyVexMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *CertifyVexMutation) ClearArtifact() {
+	m.clearedartifact = true
+	m.clearedFields[certifyvex.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *CertifyVexMutation) ArtifactCleared() bool {
+	return m.ArtifactIDCleared() || m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *CertifyVexMutation) ArtifactIDs() (ids []int) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
@@ -5459,7 +5997,7 @@ func (m *CertifyVexMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyVexMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m._package != nil {
 		fields = append(fields, certifyvex.FieldPackageID)
 	}
@@ -5490,6 +6028,12 @@ func (m *CertifyVexMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, certifyvex.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, certifyvex.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, certifyvex.FieldSigner)
+	}
 	return fields
 }
 
@@ -5518,6 +6062,10 @@ func (m *CertifyVexMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case certifyvex.FieldCollector:
 		return m.Collector()
+	case certifyvex.FieldVerified:
+		return m.Verified()
+	case certifyvex.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -5547,6 +6095,10 @@ func (m *CertifyVexMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldOrigin(ctx)
 	case certifyvex.FieldCollector:
 		return m.OldCollector(ctx)
+	case certifyvex.FieldVerified:
+		return m.OldVerified(ctx)
+	case certifyvex.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown CertifyVex field %s", name)
 }
@@ -5626,6 +6178,20 @@ func (m *CertifyVexMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case certifyvex.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case certifyvex.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVex field %s", name)
 }
@@ -5665,6 +6231,12 @@ func (m *CertifyVexMutation) ClearedFields() []string {
 	if m.FieldCleared(certifyvex.FieldArtifactID) {
 		fields = append(fields, certifyvex.FieldArtifactID)
 	}
+	if m.FieldCleared(certifyvex.FieldVerified) {
+		fields = append(fields, certifyvex.FieldVerified)
+	}
+	if m.FieldCleared(certifyvex.FieldSigner) {
+		fields = append(fields, certifyvex.FieldSigner)
+	}
 	return fields
 }
 
@@ -5685,6 +6257,12 @@ func (m *CertifyVexMutation) ClearField(name string) error {
 	case certifyvex.FieldArtifactID:
 		m.ClearArtifactID()
 		return nil
+	case certifyvex.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case certifyvex.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVex nullable field %s", name)
 }
@@ -5723,6 +6301,12 @@ func (m *CertifyVexMutation) ResetField(name string) error {
 	case certifyvex.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case certifyvex.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case certifyvex.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVex field %s", name)
 }
@@ -5850,6 +6434,8 @@ type CertifyVulnMutation struct {
 	scanner_version      *string
 	origin               *string
 	collector            *string
+	verified             *bool
+	signer               *string
 	clearedFields        map[string]struct{}
 	vulnerability        *int
 	clearedvulnerability bool
@@ -6282,6 +6868,104 @@ func (m *CertifyVulnMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *CertifyVulnMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *CertifyVulnMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *CertifyVulnMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[certifyvuln.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *CertifyVulnMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[certifyvuln.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *CertifyVulnMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, certifyvuln.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *CertifyVulnMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *CertifyVulnMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *CertifyVulnMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[certifyvuln.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *CertifyVulnMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[certifyvuln.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *CertifyVulnMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, certifyvuln.FieldSigner)
+}
+
 // ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
 func (m *CertifyVulnMutation) ClearVulnerability() {
 	m.clearedvulnerability = true
@@ -6370,7 +7054,7 @@ func (m *CertifyVulnMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyVulnMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 11)
 	if m.vulnerability != nil {
 		fields = append(fields, certifyvuln.FieldVulnerabilityID)
 	}
@@ -6398,6 +7082,12 @@ func (m *CertifyVulnMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, certifyvuln.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, certifyvuln.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, certifyvuln.FieldSigner)
+	}
 	return fields
 }
 
@@ -6424,6 +7114,10 @@ func (m *CertifyVulnMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case certifyvuln.FieldCollector:
 		return m.Collector()
+	case certifyvuln.FieldVerified:
+		return m.Verified()
+	case certifyvuln.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -6451,6 +7145,10 @@ func (m *CertifyVulnMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldOrigin(ctx)
 	case certifyvuln.FieldCollector:
 		return m.OldCollector(ctx)
+	case certifyvuln.FieldVerified:
+		return m.OldVerified(ctx)
+	case certifyvuln.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown CertifyVuln field %s", name)
 }
@@ -6523,6 +7221,20 @@ func (m *CertifyVulnMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case certifyvuln.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case certifyvuln.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVuln field %s", name)
 }
@@ -6555,7 +7267,14 @@ func (m *CertifyVulnMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *CertifyVulnMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(certifyvuln.FieldVerified) {
+		fields = append(fields, certifyvuln.FieldVerified)
+	}
+	if m.FieldCleared(certifyvuln.FieldSigner) {
+		fields = append(fields, certifyvuln.FieldSigner)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -6568,6 +7287,14 @@ func (m *CertifyVulnMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *CertifyVulnMutation) ClearField(name string) error {
+	switch name {
+	case certifyvuln.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case certifyvuln.FieldSigner:
+		m.ClearSigner()
+		return nil
+	}
 	return fmt.Errorf("unknown CertifyVuln nullable field %s", name)
 }
 
@@ -6602,6 +7329,12 @@ func (m *CertifyVulnMutation) ResetField(name string) error {
 	case certifyvuln.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case certifyvuln.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case certifyvuln.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVuln field %s", name)
 }
@@ -6706,9 +7439,12 @@ type DependencyMutation struct {
 	id                               *int
 	version_range                    *string
 	dependency_type                  *dependency.DependencyType
+	dependency_scope                 *dependency.DependencyScope
 	justification                    *string
 	origin                           *string
 	collector                        *string
+	verified                         *bool
+	signer                           *string
 	clearedFields                    map[string]struct{}
 	_package                         *int
 	cleared_package                  bool
@@ -7025,6 +7761,42 @@ func (m *DependencyMutation) ResetDependencyType() {
 	m.dependency_type = nil
 }
 
+// SetDependencyScope sets the "dependency_scope" field.
+func (m *DependencyMutation) SetDependencyScope(ds dependency.DependencyScope) {
+	m.dependency_scope = &ds
+}
+
+// DependencyScope returns the value of the "dependency_scope" field in the mutation.
+func (m *DependencyMutation) DependencyScope() (r dependency.DependencyScope, exists bool) {
+	v := m.dependency_scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDependencyScope returns the old "dependency_scope" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldDependencyScope(ctx context.Context) (v dependency.DependencyScope, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDependencyScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDependencyScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDependencyScope: %w", err)
+	}
+	return oldValue.DependencyScope, nil
+}
+
+// ResetDependencyScope resets all changes to the "dependency_scope" field.
+func (m *DependencyMutation) ResetDependencyScope() {
+	m.dependency_scope = nil
+}
+
 // SetJustification sets the "justification" field.
 func (m *DependencyMutation) SetJustification(s string) {
 	m.justification = &s
@@ -7133,6 +7905,104 @@ func (m *DependencyMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *DependencyMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *DependencyMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *DependencyMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[dependency.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *DependencyMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[dependency.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *DependencyMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, dependency.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *DependencyMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *DependencyMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *DependencyMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[dependency.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *DependencyMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[dependency.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *DependencyMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, dependency.FieldSigner)
+}
+
 // ClearPackage clears the "package" edge to the PackageVersion entity.
 func (m *DependencyMutation) ClearPackage() {
 	m.cleared_package = true
@@ -7248,7 +8118,7 @@ func (m *DependencyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DependencyMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 11)
 	if m._package != nil {
 		fields = append(fields, dependency.FieldPackageID)
 	}
@@ -7264,6 +8134,9 @@ func (m *DependencyMutation) Fields() []string {
 	if m.dependency_type != nil {
 		fields = append(fields, dependency.FieldDependencyType)
 	}
+	if m.dependency_scope != nil {
+		fields = append(fields, dependency.FieldDependencyScope)
+	}
 	if m.justification != nil {
 		fields = append(fields, dependency.FieldJustification)
 	}
@@ -7273,6 +8146,12 @@ func (m *DependencyMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, dependency.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, dependency.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, dependency.FieldSigner)
+	}
 	return fields
 }
 
@@ -7291,12 +8170,18 @@ func (m *DependencyMutation) Field(name string) (ent.Value, bool) {
 		return m.VersionRange()
 	case dependency.FieldDependencyType:
 		return m.DependencyType()
+	case dependency.FieldDependencyScope:
+		return m.DependencyScope()
 	case dependency.FieldJustification:
 		return m.Justification()
 	case dependency.FieldOrigin:
 		return m.Origin()
 	case dependency.FieldCollector:
 		return m.Collector()
+	case dependency.FieldVerified:
+		return m.Verified()
+	case dependency.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -7316,12 +8201,18 @@ func (m *DependencyMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldVersionRange(ctx)
 	case dependency.FieldDependencyType:
 		return m.OldDependencyType(ctx)
+	case dependency.FieldDependencyScope:
+		return m.OldDependencyScope(ctx)
 	case dependency.FieldJustification:
 		return m.OldJustification(ctx)
 	case dependency.FieldOrigin:
 		return m.OldOrigin(ctx)
 	case dependency.FieldCollector:
 		return m.OldCollector(ctx)
+	case dependency.FieldVerified:
+		return m.OldVerified(ctx)
+	case dependency.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown Dependency field %s", name)
 }
@@ -7366,6 +8257,13 @@ func (m *DependencyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDependencyType(v)
 		return nil
+	case dependency.FieldDependencyScope:
+		v, ok := value.(dependency.DependencyScope)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDependencyScope(v)
+		return nil
 	case dependency.FieldJustification:
 		v, ok := value.(string)
 		if !ok {
@@ -7387,6 +8285,20 @@ func (m *DependencyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case dependency.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case dependency.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Dependency field %s", name)
 }
@@ -7426,6 +8338,12 @@ func (m *DependencyMutation) ClearedFields() []string {
 	if m.FieldCleared(dependency.FieldDependentPackageVersionID) {
 		fields = append(fields, dependency.FieldDependentPackageVersionID)
 	}
+	if m.FieldCleared(dependency.FieldVerified) {
+		fields = append(fields, dependency.FieldVerified)
+	}
+	if m.FieldCleared(dependency.FieldSigner) {
+		fields = append(fields, dependency.FieldSigner)
+	}
 	return fields
 }
 
@@ -7446,6 +8364,12 @@ func (m *DependencyMutation) ClearField(name string) error {
 	case dependency.FieldDependentPackageVersionID:
 		m.ClearDependentPackageVersionID()
 		return nil
+	case dependency.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case dependency.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown Dependency nullable field %s", name)
 }
@@ -7469,6 +8393,9 @@ func (m *DependencyMutation) ResetField(name string) error {
 	case dependency.FieldDependencyType:
 		m.ResetDependencyType()
 		return nil
+	case dependency.FieldDependencyScope:
+		m.ResetDependencyScope()
+		return nil
 	case dependency.FieldJustification:
 		m.ResetJustification()
 		return nil
@@ -7478,6 +8405,12 @@ func (m *DependencyMutation) ResetField(name string) error {
 	case dependency.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case dependency.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case dependency.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown Dependency field %s", name)
 }
@@ -7604,6 +8537,8 @@ type HasMetadataMutation struct {
 	justification          *string
 	origin                 *string
 	collector              *string
+	verified               *bool
+	signer                 *string
 	clearedFields          map[string]struct{}
 	source                 *int
 	clearedsource          bool
@@ -8087,45 +9022,143 @@ func (m *HasMetadataMutation) OldOrigin(ctx context.Context) (v string, err erro
 	return oldValue.Origin, nil
 }
 
-// ResetOrigin resets all changes to the "origin" field.
-func (m *HasMetadataMutation) ResetOrigin() {
-	m.origin = nil
+// ResetOrigin resets all changes to the "origin" field.
+func (m *HasMetadataMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *HasMetadataMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *HasMetadataMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *HasMetadataMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetVerified sets the "verified" field.
+func (m *HasMetadataMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *HasMetadataMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *HasMetadataMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[hasmetadata.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *HasMetadataMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[hasmetadata.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *HasMetadataMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, hasmetadata.FieldVerified)
 }
 
-// SetCollector sets the "collector" field.
-func (m *HasMetadataMutation) SetCollector(s string) {
-	m.collector = &s
+// SetSigner sets the "signer" field.
+func (m *HasMetadataMutation) SetSigner(s string) {
+	m.signer = &s
 }
 
-// Collector returns the value of the "collector" field in the mutation.
-func (m *HasMetadataMutation) Collector() (r string, exists bool) {
-	v := m.collector
+// Signer returns the value of the "signer" field in the mutation.
+func (m *HasMetadataMutation) Signer() (r string, exists bool) {
+	v := m.signer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCollector returns the old "collector" field's value of the HasMetadata entity.
+// OldSigner returns the old "signer" field's value of the HasMetadata entity.
 // If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HasMetadataMutation) OldCollector(ctx context.Context) (v string, err error) {
+func (m *HasMetadataMutation) OldSigner(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCollector requires an ID field in the mutation")
+		return v, errors.New("OldSigner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
 	}
-	return oldValue.Collector, nil
+	return oldValue.Signer, nil
 }
 
-// ResetCollector resets all changes to the "collector" field.
-func (m *HasMetadataMutation) ResetCollector() {
-	m.collector = nil
+// ClearSigner clears the value of the "signer" field.
+func (m *HasMetadataMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[hasmetadata.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *HasMetadataMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[hasmetadata.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *HasMetadataMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, hasmetadata.FieldSigner)
 }
 
 // ClearSource clears the "source" edge to the SourceName entity.
@@ -8283,7 +9316,7 @@ func (m *HasMetadataMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *HasMetadataMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m.source != nil {
 		fields = append(fields, hasmetadata.FieldSourceID)
 	}
@@ -8314,6 +9347,12 @@ func (m *HasMetadataMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, hasmetadata.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, hasmetadata.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, hasmetadata.FieldSigner)
+	}
 	return fields
 }
 
@@ -8342,6 +9381,10 @@ func (m *HasMetadataMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case hasmetadata.FieldCollector:
 		return m.Collector()
+	case hasmetadata.FieldVerified:
+		return m.Verified()
+	case hasmetadata.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -8371,6 +9414,10 @@ func (m *HasMetadataMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldOrigin(ctx)
 	case hasmetadata.FieldCollector:
 		return m.OldCollector(ctx)
+	case hasmetadata.FieldVerified:
+		return m.OldVerified(ctx)
+	case hasmetadata.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown HasMetadata field %s", name)
 }
@@ -8450,6 +9497,20 @@ func (m *HasMetadataMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case hasmetadata.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case hasmetadata.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown HasMetadata field %s", name)
 }
@@ -8495,6 +9556,12 @@ func (m *HasMetadataMutation) ClearedFields() []string {
 	if m.FieldCleared(hasmetadata.FieldArtifactID) {
 		fields = append(fields, hasmetadata.FieldArtifactID)
 	}
+	if m.FieldCleared(hasmetadata.FieldVerified) {
+		fields = append(fields, hasmetadata.FieldVerified)
+	}
+	if m.FieldCleared(hasmetadata.FieldSigner) {
+		fields = append(fields, hasmetadata.FieldSigner)
+	}
 	return fields
 }
 
@@ -8521,6 +9588,12 @@ func (m *HasMetadataMutation) ClearField(name string) error {
 	case hasmetadata.FieldArtifactID:
 		m.ClearArtifactID()
 		return nil
+	case hasmetadata.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case hasmetadata.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown HasMetadata nullable field %s", name)
 }
@@ -8559,6 +9632,12 @@ func (m *HasMetadataMutation) ResetField(name string) error {
 	case hasmetadata.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case hasmetadata.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case hasmetadata.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown HasMetadata field %s", name)
 }
@@ -8701,6 +9780,8 @@ type HasSourceAtMutation struct {
 	justification          *string
 	origin                 *string
 	collector              *string
+	verified               *bool
+	signer                 *string
 	clearedFields          map[string]struct{}
 	package_version        *int
 	clearedpackage_version bool
@@ -9089,6 +10170,104 @@ func (m *HasSourceAtMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *HasSourceAtMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *HasSourceAtMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the HasSourceAt entity.
+// If the HasSourceAt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSourceAtMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *HasSourceAtMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[hassourceat.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *HasSourceAtMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[hassourceat.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *HasSourceAtMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, hassourceat.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *HasSourceAtMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *HasSourceAtMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the HasSourceAt entity.
+// If the HasSourceAt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSourceAtMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *HasSourceAtMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[hassourceat.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *HasSourceAtMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[hassourceat.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *HasSourceAtMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, hassourceat.FieldSigner)
+}
+
 // ClearPackageVersion clears the "package_version" edge to the PackageVersion entity.
 func (m *HasSourceAtMutation) ClearPackageVersion() {
 	m.clearedpackage_version = true
@@ -9217,7 +10396,7 @@ func (m *HasSourceAtMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *HasSourceAtMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 9)
 	if m.package_version != nil {
 		fields = append(fields, hassourceat.FieldPackageVersionID)
 	}
@@ -9239,6 +10418,12 @@ func (m *HasSourceAtMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, hassourceat.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, hassourceat.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, hassourceat.FieldSigner)
+	}
 	return fields
 }
 
@@ -9261,6 +10446,10 @@ func (m *HasSourceAtMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case hassourceat.FieldCollector:
 		return m.Collector()
+	case hassourceat.FieldVerified:
+		return m.Verified()
+	case hassourceat.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -9284,6 +10473,10 @@ func (m *HasSourceAtMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldOrigin(ctx)
 	case hassourceat.FieldCollector:
 		return m.OldCollector(ctx)
+	case hassourceat.FieldVerified:
+		return m.OldVerified(ctx)
+	case hassourceat.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown HasSourceAt field %s", name)
 }
@@ -9342,6 +10535,20 @@ func (m *HasSourceAtMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case hassourceat.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case hassourceat.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown HasSourceAt field %s", name)
 }
@@ -9381,6 +10588,12 @@ func (m *HasSourceAtMutation) ClearedFields() []string {
 	if m.FieldCleared(hassourceat.FieldPackageNameID) {
 		fields = append(fields, hassourceat.FieldPackageNameID)
 	}
+	if m.FieldCleared(hassourceat.FieldVerified) {
+		fields = append(fields, hassourceat.FieldVerified)
+	}
+	if m.FieldCleared(hassourceat.FieldSigner) {
+		fields = append(fields, hassourceat.FieldSigner)
+	}
 	return fields
 }
 
@@ -9401,6 +10614,12 @@ func (m *HasSourceAtMutation) ClearField(name string) error {
 	case hassourceat.FieldPackageNameID:
 		m.ClearPackageNameID()
 		return nil
+	case hassourceat.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case hassourceat.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown HasSourceAt nullable field %s", name)
 }
@@ -9430,6 +10649,12 @@ func (m *HasSourceAtMutation) ResetField(name string) error {
 	case hassourceat.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case hassourceat.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case hassourceat.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown HasSourceAt field %s", name)
 }
@@ -9552,6 +10777,8 @@ type HashEqualMutation struct {
 	id               *int
 	origin           *string
 	collector        *string
+	verified         *bool
+	signer           *string
 	justification    *string
 	clearedFields    map[string]struct{}
 	artifacts        map[int]struct{}
@@ -9732,6 +10959,104 @@ func (m *HashEqualMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *HashEqualMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *HashEqualMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *HashEqualMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[hashequal.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *HashEqualMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[hashequal.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *HashEqualMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, hashequal.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *HashEqualMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *HashEqualMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *HashEqualMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[hashequal.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *HashEqualMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[hashequal.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *HashEqualMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, hashequal.FieldSigner)
+}
+
 // SetJustification sets the "justification" field.
 func (m *HashEqualMutation) SetJustification(s string) {
 	m.justification = &s
@@ -9856,13 +11181,19 @@ func (m *HashEqualMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *HashEqualMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 5)
 	if m.origin != nil {
 		fields = append(fields, hashequal.FieldOrigin)
 	}
 	if m.collector != nil {
 		fields = append(fields, hashequal.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, hashequal.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, hashequal.FieldSigner)
+	}
 	if m.justification != nil {
 		fields = append(fields, hashequal.FieldJustification)
 	}
@@ -9878,6 +11209,10 @@ func (m *HashEqualMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case hashequal.FieldCollector:
 		return m.Collector()
+	case hashequal.FieldVerified:
+		return m.Verified()
+	case hashequal.FieldSigner:
+		return m.Signer()
 	case hashequal.FieldJustification:
 		return m.Justification()
 	}
@@ -9893,6 +11228,10 @@ func (m *HashEqualMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldOrigin(ctx)
 	case hashequal.FieldCollector:
 		return m.OldCollector(ctx)
+	case hashequal.FieldVerified:
+		return m.OldVerified(ctx)
+	case hashequal.FieldSigner:
+		return m.OldSigner(ctx)
 	case hashequal.FieldJustification:
 		return m.OldJustification(ctx)
 	}
@@ -9918,6 +11257,20 @@ func (m *HashEqualMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case hashequal.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case hashequal.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	case hashequal.FieldJustification:
 		v, ok := value.(string)
 		if !ok {
@@ -9954,7 +11307,14 @@ func (m *HashEqualMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *HashEqualMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(hashequal.FieldVerified) {
+		fields = append(fields, hashequal.FieldVerified)
+	}
+	if m.FieldCleared(hashequal.FieldSigner) {
+		fields = append(fields, hashequal.FieldSigner)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -9967,6 +11327,14 @@ func (m *HashEqualMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *HashEqualMutation) ClearField(name string) error {
+	switch name {
+	case hashequal.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case hashequal.FieldSigner:
+		m.ClearSigner()
+		return nil
+	}
 	return fmt.Errorf("unknown HashEqual nullable field %s", name)
 }
 
@@ -9980,6 +11348,12 @@ func (m *HashEqualMutation) ResetField(name string) error {
 	case hashequal.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case hashequal.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case hashequal.FieldSigner:
+		m.ResetSigner()
+		return nil
 	case hashequal.FieldJustification:
 		m.ResetJustification()
 		return nil
@@ -11376,6 +12750,8 @@ type OccurrenceMutation struct {
 	justification   *string
 	origin          *string
 	collector       *string
+	verified        *bool
+	signer          *string
 	clearedFields   map[string]struct{}
 	artifact        *int
 	clearedartifact bool
@@ -11563,71 +12939,169 @@ func (m *OccurrenceMutation) SetOrigin(s string) {
 	m.origin = &s
 }
 
-// Origin returns the value of the "origin" field in the mutation.
-func (m *OccurrenceMutation) Origin() (r string, exists bool) {
-	v := m.origin
+// Origin returns the value of the "origin" field in the mutation.
+func (m *OccurrenceMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the Occurrence entity.
+// If the Occurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccurrenceMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *OccurrenceMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *OccurrenceMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *OccurrenceMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the Occurrence entity.
+// If the Occurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccurrenceMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *OccurrenceMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetVerified sets the "verified" field.
+func (m *OccurrenceMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *OccurrenceMutation) Verified() (r bool, exists bool) {
+	v := m.verified
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrigin returns the old "origin" field's value of the Occurrence entity.
+// OldVerified returns the old "verified" field's value of the Occurrence entity.
 // If the Occurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OccurrenceMutation) OldOrigin(ctx context.Context) (v string, err error) {
+func (m *OccurrenceMutation) OldVerified(ctx context.Context) (v *bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrigin requires an ID field in the mutation")
+		return v, errors.New("OldVerified requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
 	}
-	return oldValue.Origin, nil
+	return oldValue.Verified, nil
 }
 
-// ResetOrigin resets all changes to the "origin" field.
-func (m *OccurrenceMutation) ResetOrigin() {
-	m.origin = nil
+// ClearVerified clears the value of the "verified" field.
+func (m *OccurrenceMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[occurrence.FieldVerified] = struct{}{}
 }
 
-// SetCollector sets the "collector" field.
-func (m *OccurrenceMutation) SetCollector(s string) {
-	m.collector = &s
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *OccurrenceMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[occurrence.FieldVerified]
+	return ok
 }
 
-// Collector returns the value of the "collector" field in the mutation.
-func (m *OccurrenceMutation) Collector() (r string, exists bool) {
-	v := m.collector
+// ResetVerified resets all changes to the "verified" field.
+func (m *OccurrenceMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, occurrence.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *OccurrenceMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *OccurrenceMutation) Signer() (r string, exists bool) {
+	v := m.signer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCollector returns the old "collector" field's value of the Occurrence entity.
+// OldSigner returns the old "signer" field's value of the Occurrence entity.
 // If the Occurrence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OccurrenceMutation) OldCollector(ctx context.Context) (v string, err error) {
+func (m *OccurrenceMutation) OldSigner(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCollector requires an ID field in the mutation")
+		return v, errors.New("OldSigner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
 	}
-	return oldValue.Collector, nil
+	return oldValue.Signer, nil
 }
 
-// ResetCollector resets all changes to the "collector" field.
-func (m *OccurrenceMutation) ResetCollector() {
-	m.collector = nil
+// ClearSigner clears the value of the "signer" field.
+func (m *OccurrenceMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[occurrence.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *OccurrenceMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[occurrence.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *OccurrenceMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, occurrence.FieldSigner)
 }
 
 // SetSourceID sets the "source_id" field.
@@ -11843,7 +13317,7 @@ func (m *OccurrenceMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *OccurrenceMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.artifact != nil {
 		fields = append(fields, occurrence.FieldArtifactID)
 	}
@@ -11856,6 +13330,12 @@ func (m *OccurrenceMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, occurrence.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, occurrence.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, occurrence.FieldSigner)
+	}
 	if m.source != nil {
 		fields = append(fields, occurrence.FieldSourceID)
 	}
@@ -11878,6 +13358,10 @@ func (m *OccurrenceMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case occurrence.FieldCollector:
 		return m.Collector()
+	case occurrence.FieldVerified:
+		return m.Verified()
+	case occurrence.FieldSigner:
+		return m.Signer()
 	case occurrence.FieldSourceID:
 		return m.SourceID()
 	case occurrence.FieldPackageID:
@@ -11899,6 +13383,10 @@ func (m *OccurrenceMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldOrigin(ctx)
 	case occurrence.FieldCollector:
 		return m.OldCollector(ctx)
+	case occurrence.FieldVerified:
+		return m.OldVerified(ctx)
+	case occurrence.FieldSigner:
+		return m.OldSigner(ctx)
 	case occurrence.FieldSourceID:
 		return m.OldSourceID(ctx)
 	case occurrence.FieldPackageID:
@@ -11940,6 +13428,20 @@ func (m *OccurrenceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case occurrence.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case occurrence.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	case occurrence.FieldSourceID:
 		v, ok := value.(int)
 		if !ok {
@@ -11987,6 +13489,12 @@ func (m *OccurrenceMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *OccurrenceMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(occurrence.FieldVerified) {
+		fields = append(fields, occurrence.FieldVerified)
+	}
+	if m.FieldCleared(occurrence.FieldSigner) {
+		fields = append(fields, occurrence.FieldSigner)
+	}
 	if m.FieldCleared(occurrence.FieldSourceID) {
 		fields = append(fields, occurrence.FieldSourceID)
 	}
@@ -12007,6 +13515,12 @@ func (m *OccurrenceMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *OccurrenceMutation) ClearField(name string) error {
 	switch name {
+	case occurrence.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case occurrence.FieldSigner:
+		m.ClearSigner()
+		return nil
 	case occurrence.FieldSourceID:
 		m.ClearSourceID()
 		return nil
@@ -12033,6 +13547,12 @@ func (m *OccurrenceMutation) ResetField(name string) error {
 	case occurrence.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case occurrence.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case occurrence.FieldSigner:
+		m.ResetSigner()
+		return nil
 	case occurrence.FieldSourceID:
 		m.ResetSourceID()
 		return nil
@@ -14513,6 +16033,8 @@ type PkgEqualMutation struct {
 	id              *int
 	origin          *string
 	collector       *string
+	verified        *bool
+	signer          *string
 	justification   *string
 	packages_hash   *string
 	clearedFields   map[string]struct{}
@@ -14694,6 +16216,104 @@ func (m *PkgEqualMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *PkgEqualMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *PkgEqualMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *PkgEqualMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[pkgequal.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *PkgEqualMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[pkgequal.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *PkgEqualMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, pkgequal.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *PkgEqualMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *PkgEqualMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *PkgEqualMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[pkgequal.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *PkgEqualMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[pkgequal.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *PkgEqualMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, pkgequal.FieldSigner)
+}
+
 // SetJustification sets the "justification" field.
 func (m *PkgEqualMutation) SetJustification(s string) {
 	m.justification = &s
@@ -14854,13 +16474,19 @@ func (m *PkgEqualMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PkgEqualMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 6)
 	if m.origin != nil {
 		fields = append(fields, pkgequal.FieldOrigin)
 	}
 	if m.collector != nil {
 		fields = append(fields, pkgequal.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, pkgequal.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, pkgequal.FieldSigner)
+	}
 	if m.justification != nil {
 		fields = append(fields, pkgequal.FieldJustification)
 	}
@@ -14879,6 +16505,10 @@ func (m *PkgEqualMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case pkgequal.FieldCollector:
 		return m.Collector()
+	case pkgequal.FieldVerified:
+		return m.Verified()
+	case pkgequal.FieldSigner:
+		return m.Signer()
 	case pkgequal.FieldJustification:
 		return m.Justification()
 	case pkgequal.FieldPackagesHash:
@@ -14896,6 +16526,10 @@ func (m *PkgEqualMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldOrigin(ctx)
 	case pkgequal.FieldCollector:
 		return m.OldCollector(ctx)
+	case pkgequal.FieldVerified:
+		return m.OldVerified(ctx)
+	case pkgequal.FieldSigner:
+		return m.OldSigner(ctx)
 	case pkgequal.FieldJustification:
 		return m.OldJustification(ctx)
 	case pkgequal.FieldPackagesHash:
@@ -14923,6 +16557,20 @@ func (m *PkgEqualMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case pkgequal.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case pkgequal.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	case pkgequal.FieldJustification:
 		v, ok := value.(string)
 		if !ok {
@@ -14966,7 +16614,14 @@ func (m *PkgEqualMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *PkgEqualMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(pkgequal.FieldVerified) {
+		fields = append(fields, pkgequal.FieldVerified)
+	}
+	if m.FieldCleared(pkgequal.FieldSigner) {
+		fields = append(fields, pkgequal.FieldSigner)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -14979,6 +16634,14 @@ func (m *PkgEqualMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *PkgEqualMutation) ClearField(name string) error {
+	switch name {
+	case pkgequal.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case pkgequal.FieldSigner:
+		m.ClearSigner()
+		return nil
+	}
 	return fmt.Errorf("unknown PkgEqual nullable field %s", name)
 }
 
@@ -14992,6 +16655,12 @@ func (m *PkgEqualMutation) ResetField(name string) error {
 	case pkgequal.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case pkgequal.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case pkgequal.FieldSigner:
+		m.ResetSigner()
+		return nil
 	case pkgequal.FieldJustification:
 		m.ResetJustification()
 		return nil
@@ -15098,6 +16767,8 @@ type PointOfContactMutation struct {
 	justification          *string
 	origin                 *string
 	collector              *string
+	verified               *bool
+	signer                 *string
 	clearedFields          map[string]struct{}
 	source                 *int
 	clearedsource          bool
@@ -15622,6 +17293,104 @@ func (m *PointOfContactMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *PointOfContactMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *PointOfContactMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *PointOfContactMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[pointofcontact.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *PointOfContactMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[pointofcontact.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *PointOfContactMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, pointofcontact.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *PointOfContactMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *PointOfContactMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *PointOfContactMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[pointofcontact.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *PointOfContactMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[pointofcontact.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *PointOfContactMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, pointofcontact.FieldSigner)
+}
+
 // ClearSource clears the "source" edge to the SourceName entity.
 func (m *PointOfContactMutation) ClearSource() {
 	m.clearedsource = true
@@ -15777,7 +17546,7 @@ func (m *PointOfContactMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PointOfContactMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m.source != nil {
 		fields = append(fields, pointofcontact.FieldSourceID)
 	}
@@ -15808,6 +17577,12 @@ func (m *PointOfContactMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, pointofcontact.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, pointofcontact.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, pointofcontact.FieldSigner)
+	}
 	return fields
 }
 
@@ -15836,6 +17611,10 @@ func (m *PointOfContactMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case pointofcontact.FieldCollector:
 		return m.Collector()
+	case pointofcontact.FieldVerified:
+		return m.Verified()
+	case pointofcontact.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -15865,6 +17644,10 @@ func (m *PointOfContactMutation) OldField(ctx context.Context, name string) (ent
 		return m.OldOrigin(ctx)
 	case pointofcontact.FieldCollector:
 		return m.OldCollector(ctx)
+	case pointofcontact.FieldVerified:
+		return m.OldVerified(ctx)
+	case pointofcontact.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown PointOfContact field %s", name)
 }
@@ -15944,6 +17727,20 @@ func (m *PointOfContactMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case pointofcontact.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case pointofcontact.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown PointOfContact field %s", name)
 }
@@ -15989,6 +17786,12 @@ func (m *PointOfContactMutation) ClearedFields() []string {
 	if m.FieldCleared(pointofcontact.FieldArtifactID) {
 		fields = append(fields, pointofcontact.FieldArtifactID)
 	}
+	if m.FieldCleared(pointofcontact.FieldVerified) {
+		fields = append(fields, pointofcontact.FieldVerified)
+	}
+	if m.FieldCleared(pointofcontact.FieldSigner) {
+		fields = append(fields, pointofcontact.FieldSigner)
+	}
 	return fields
 }
 
@@ -16015,6 +17818,12 @@ func (m *PointOfContactMutation) ClearField(name string) error {
 	case pointofcontact.FieldArtifactID:
 		m.ClearArtifactID()
 		return nil
+	case pointofcontact.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case pointofcontact.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown PointOfContact nullable field %s", name)
 }
@@ -16053,6 +17862,12 @@ func (m *PointOfContactMutation) ResetField(name string) error {
 	case pointofcontact.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case pointofcontact.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case pointofcontact.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown PointOfContact field %s", name)
 }
@@ -16199,6 +18014,8 @@ type SLSAAttestationMutation struct {
 	finished_on          *time.Time
 	origin               *string
 	collector            *string
+	verified             *bool
+	signer               *string
 	built_from_hash      *string
 	clearedFields        map[string]struct{}
 	built_from           map[int]struct{}
@@ -16673,21 +18490,119 @@ func (m *SLSAAttestationMutation) Collector() (r string, exists bool) {
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
 func (m *SLSAAttestationMutation) OldCollector(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *SLSAAttestationMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetVerified sets the "verified" field.
+func (m *SLSAAttestationMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *SLSAAttestationMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the SLSAAttestation entity.
+// If the SLSAAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SLSAAttestationMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *SLSAAttestationMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[slsaattestation.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *SLSAAttestationMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[slsaattestation.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *SLSAAttestationMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, slsaattestation.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *SLSAAttestationMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *SLSAAttestationMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the SLSAAttestation entity.
+// If the SLSAAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SLSAAttestationMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCollector requires an ID field in the mutation")
+		return v, errors.New("OldSigner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
 	}
-	return oldValue.Collector, nil
+	return oldValue.Signer, nil
 }
 
-// ResetCollector resets all changes to the "collector" field.
-func (m *SLSAAttestationMutation) ResetCollector() {
-	m.collector = nil
+// ClearSigner clears the value of the "signer" field.
+func (m *SLSAAttestationMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[slsaattestation.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *SLSAAttestationMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[slsaattestation.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *SLSAAttestationMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, slsaattestation.FieldSigner)
 }
 
 // SetBuiltFromHash sets the "built_from_hash" field.
@@ -16868,7 +18783,7 @@ func (m *SLSAAttestationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SLSAAttestationMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m.build_type != nil {
 		fields = append(fields, slsaattestation.FieldBuildType)
 	}
@@ -16896,6 +18811,12 @@ func (m *SLSAAttestationMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, slsaattestation.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, slsaattestation.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, slsaattestation.FieldSigner)
+	}
 	if m.built_from_hash != nil {
 		fields = append(fields, slsaattestation.FieldBuiltFromHash)
 	}
@@ -16925,6 +18846,10 @@ func (m *SLSAAttestationMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case slsaattestation.FieldCollector:
 		return m.Collector()
+	case slsaattestation.FieldVerified:
+		return m.Verified()
+	case slsaattestation.FieldSigner:
+		return m.Signer()
 	case slsaattestation.FieldBuiltFromHash:
 		return m.BuiltFromHash()
 	}
@@ -16954,6 +18879,10 @@ func (m *SLSAAttestationMutation) OldField(ctx context.Context, name string) (en
 		return m.OldOrigin(ctx)
 	case slsaattestation.FieldCollector:
 		return m.OldCollector(ctx)
+	case slsaattestation.FieldVerified:
+		return m.OldVerified(ctx)
+	case slsaattestation.FieldSigner:
+		return m.OldSigner(ctx)
 	case slsaattestation.FieldBuiltFromHash:
 		return m.OldBuiltFromHash(ctx)
 	}
@@ -17028,6 +18957,20 @@ func (m *SLSAAttestationMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case slsaattestation.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case slsaattestation.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	case slsaattestation.FieldBuiltFromHash:
 		v, ok := value.(string)
 		if !ok {
@@ -17077,6 +19020,12 @@ func (m *SLSAAttestationMutation) ClearedFields() []string {
 	if m.FieldCleared(slsaattestation.FieldFinishedOn) {
 		fields = append(fields, slsaattestation.FieldFinishedOn)
 	}
+	if m.FieldCleared(slsaattestation.FieldVerified) {
+		fields = append(fields, slsaattestation.FieldVerified)
+	}
+	if m.FieldCleared(slsaattestation.FieldSigner) {
+		fields = append(fields, slsaattestation.FieldSigner)
+	}
 	return fields
 }
 
@@ -17100,6 +19049,12 @@ func (m *SLSAAttestationMutation) ClearField(name string) error {
 	case slsaattestation.FieldFinishedOn:
 		m.ClearFinishedOn()
 		return nil
+	case slsaattestation.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case slsaattestation.FieldSigner:
+		m.ClearSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown SLSAAttestation nullable field %s", name)
 }
@@ -17135,6 +19090,12 @@ func (m *SLSAAttestationMutation) ResetField(name string) error {
 	case slsaattestation.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case slsaattestation.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case slsaattestation.FieldSigner:
+		m.ResetSigner()
+		return nil
 	case slsaattestation.FieldBuiltFromHash:
 		m.ResetBuiltFromHash()
 		return nil
@@ -17277,6 +19238,8 @@ type ScorecardMutation struct {
 	scorecard_commit      *string
 	origin                *string
 	collector             *string
+	verified              *bool
+	signer                *string
 	clearedFields         map[string]struct{}
 	certifications        map[int]struct{}
 	removedcertifications map[int]struct{}
@@ -17671,6 +19634,104 @@ func (m *ScorecardMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *ScorecardMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *ScorecardMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *ScorecardMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[scorecard.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *ScorecardMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[scorecard.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *ScorecardMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, scorecard.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *ScorecardMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *ScorecardMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the Scorecard entity.
+// If the Scorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScorecardMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *ScorecardMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[scorecard.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *ScorecardMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[scorecard.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *ScorecardMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, scorecard.FieldSigner)
+}
+
 // AddCertificationIDs adds the "certifications" edge to the CertifyScorecard entity by ids.
 func (m *ScorecardMutation) AddCertificationIDs(ids ...int) {
 	if m.certifications == nil {
@@ -17759,7 +19820,7 @@ func (m *ScorecardMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ScorecardMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 9)
 	if m.checks != nil {
 		fields = append(fields, scorecard.FieldChecks)
 	}
@@ -17781,6 +19842,12 @@ func (m *ScorecardMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, scorecard.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, scorecard.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, scorecard.FieldSigner)
+	}
 	return fields
 }
 
@@ -17803,6 +19870,10 @@ func (m *ScorecardMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case scorecard.FieldCollector:
 		return m.Collector()
+	case scorecard.FieldVerified:
+		return m.Verified()
+	case scorecard.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -17826,6 +19897,10 @@ func (m *ScorecardMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldOrigin(ctx)
 	case scorecard.FieldCollector:
 		return m.OldCollector(ctx)
+	case scorecard.FieldVerified:
+		return m.OldVerified(ctx)
+	case scorecard.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown Scorecard field %s", name)
 }
@@ -17884,6 +19959,20 @@ func (m *ScorecardMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case scorecard.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case scorecard.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Scorecard field %s", name)
 }
@@ -17928,7 +20017,14 @@ func (m *ScorecardMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *ScorecardMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(scorecard.FieldVerified) {
+		fields = append(fields, scorecard.FieldVerified)
+	}
+	if m.FieldCleared(scorecard.FieldSigner) {
+		fields = append(fields, scorecard.FieldSigner)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -17941,6 +20037,14 @@ func (m *ScorecardMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *ScorecardMutation) ClearField(name string) error {
+	switch name {
+	case scorecard.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case scorecard.FieldSigner:
+		m.ClearSigner()
+		return nil
+	}
 	return fmt.Errorf("unknown Scorecard nullable field %s", name)
 }
 
@@ -17969,6 +20073,12 @@ func (m *ScorecardMutation) ResetField(name string) error {
 	case scorecard.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case scorecard.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case scorecard.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown Scorecard field %s", name)
 }
@@ -19691,6 +21801,8 @@ type VulnEqualMutation struct {
 	justification            *string
 	origin                   *string
 	collector                *string
+	verified                 *bool
+	signer                   *string
 	clearedFields            map[string]struct{}
 	vulnerability_ids        map[int]struct{}
 	removedvulnerability_ids map[int]struct{}
@@ -19906,6 +22018,104 @@ func (m *VulnEqualMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *VulnEqualMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *VulnEqualMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *VulnEqualMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[vulnequal.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *VulnEqualMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[vulnequal.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *VulnEqualMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, vulnequal.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *VulnEqualMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *VulnEqualMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *VulnEqualMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[vulnequal.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *VulnEqualMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[vulnequal.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *VulnEqualMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, vulnequal.FieldSigner)
+}
+
 // AddVulnerabilityIDIDs adds the "vulnerability_ids" edge to the VulnerabilityID entity by ids.
 func (m *VulnEqualMutation) AddVulnerabilityIDIDs(ids ...int) {
 	if m.vulnerability_ids == nil {
@@ -19994,7 +22204,7 @@ func (m *VulnEqualMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VulnEqualMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 5)
 	if m.justification != nil {
 		fields = append(fields, vulnequal.FieldJustification)
 	}
@@ -20004,6 +22214,12 @@ func (m *VulnEqualMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, vulnequal.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, vulnequal.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, vulnequal.FieldSigner)
+	}
 	return fields
 }
 
@@ -20018,6 +22234,10 @@ func (m *VulnEqualMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case vulnequal.FieldCollector:
 		return m.Collector()
+	case vulnequal.FieldVerified:
+		return m.Verified()
+	case vulnequal.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -20033,6 +22253,10 @@ func (m *VulnEqualMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldOrigin(ctx)
 	case vulnequal.FieldCollector:
 		return m.OldCollector(ctx)
+	case vulnequal.FieldVerified:
+		return m.OldVerified(ctx)
+	case vulnequal.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown VulnEqual field %s", name)
 }
@@ -20063,6 +22287,20 @@ func (m *VulnEqualMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCollector(v)
 		return nil
+	case vulnequal.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case vulnequal.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown VulnEqual field %s", name)
 }
@@ -20092,7 +22330,14 @@ func (m *VulnEqualMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *VulnEqualMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(vulnequal.FieldVerified) {
+		fields = append(fields, vulnequal.FieldVerified)
+	}
+	if m.FieldCleared(vulnequal.FieldSigner) {
+		fields = append(fields, vulnequal.FieldSigner)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -20105,6 +22350,14 @@ func (m *VulnEqualMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *VulnEqualMutation) ClearField(name string) error {
+	switch name {
+	case vulnequal.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case vulnequal.FieldSigner:
+		m.ClearSigner()
+		return nil
+	}
 	return fmt.Errorf("unknown VulnEqual nullable field %s", name)
 }
 
@@ -20121,6 +22374,12 @@ func (m *VulnEqualMutation) ResetField(name string) error {
 	case vulnequal.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case vulnequal.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case vulnequal.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown VulnEqual field %s", name)
 }
@@ -20826,6 +23085,8 @@ type VulnerabilityMetadataMutation struct {
 	timestamp               *time.Time
 	origin                  *string
 	collector               *string
+	verified                *bool
+	signer                  *string
 	clearedFields           map[string]struct{}
 	vulnerability_id        *int
 	clearedvulnerability_id bool
@@ -21168,6 +23429,104 @@ func (m *VulnerabilityMetadataMutation) ResetCollector() {
 	m.collector = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *VulnerabilityMetadataMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *VulnerabilityMetadataMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the VulnerabilityMetadata entity.
+// If the VulnerabilityMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityMetadataMutation) OldVerified(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (m *VulnerabilityMetadataMutation) ClearVerified() {
+	m.verified = nil
+	m.clearedFields[vulnerabilitymetadata.FieldVerified] = struct{}{}
+}
+
+// VerifiedCleared returns if the "verified" field was cleared in this mutation.
+func (m *VulnerabilityMetadataMutation) VerifiedCleared() bool {
+	_, ok := m.clearedFields[vulnerabilitymetadata.FieldVerified]
+	return ok
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *VulnerabilityMetadataMutation) ResetVerified() {
+	m.verified = nil
+	delete(m.clearedFields, vulnerabilitymetadata.FieldVerified)
+}
+
+// SetSigner sets the "signer" field.
+func (m *VulnerabilityMetadataMutation) SetSigner(s string) {
+	m.signer = &s
+}
+
+// Signer returns the value of the "signer" field in the mutation.
+func (m *VulnerabilityMetadataMutation) Signer() (r string, exists bool) {
+	v := m.signer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigner returns the old "signer" field's value of the VulnerabilityMetadata entity.
+// If the VulnerabilityMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityMetadataMutation) OldSigner(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigner: %w", err)
+	}
+	return oldValue.Signer, nil
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (m *VulnerabilityMetadataMutation) ClearSigner() {
+	m.signer = nil
+	m.clearedFields[vulnerabilitymetadata.FieldSigner] = struct{}{}
+}
+
+// SignerCleared returns if the "signer" field was cleared in this mutation.
+func (m *VulnerabilityMetadataMutation) SignerCleared() bool {
+	_, ok := m.clearedFields[vulnerabilitymetadata.FieldSigner]
+	return ok
+}
+
+// ResetSigner resets all changes to the "signer" field.
+func (m *VulnerabilityMetadataMutation) ResetSigner() {
+	m.signer = nil
+	delete(m.clearedFields, vulnerabilitymetadata.FieldSigner)
+}
+
 // ClearVulnerabilityID clears the "vulnerability_id" edge to the VulnerabilityID entity.
 func (m *VulnerabilityMetadataMutation) ClearVulnerabilityID() {
 	m.clearedvulnerability_id = true
@@ -21229,7 +23588,7 @@ func (m *VulnerabilityMetadataMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VulnerabilityMetadataMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.vulnerability_id != nil {
 		fields = append(fields, vulnerabilitymetadata.FieldVulnerabilityIDID)
 	}
@@ -21248,6 +23607,12 @@ func (m *VulnerabilityMetadataMutation) Fields() []string {
 	if m.collector != nil {
 		fields = append(fields, vulnerabilitymetadata.FieldCollector)
 	}
+	if m.verified != nil {
+		fields = append(fields, vulnerabilitymetadata.FieldVerified)
+	}
+	if m.signer != nil {
+		fields = append(fields, vulnerabilitymetadata.FieldSigner)
+	}
 	return fields
 }
 
@@ -21268,6 +23633,10 @@ func (m *VulnerabilityMetadataMutation) Field(name string) (ent.Value, bool) {
 		return m.Origin()
 	case vulnerabilitymetadata.FieldCollector:
 		return m.Collector()
+	case vulnerabilitymetadata.FieldVerified:
+		return m.Verified()
+	case vulnerabilitymetadata.FieldSigner:
+		return m.Signer()
 	}
 	return nil, false
 }
@@ -21289,6 +23658,10 @@ func (m *VulnerabilityMetadataMutation) OldField(ctx context.Context, name strin
 		return m.OldOrigin(ctx)
 	case vulnerabilitymetadata.FieldCollector:
 		return m.OldCollector(ctx)
+	case vulnerabilitymetadata.FieldVerified:
+		return m.OldVerified(ctx)
+	case vulnerabilitymetadata.FieldSigner:
+		return m.OldSigner(ctx)
 	}
 	return nil, fmt.Errorf("unknown VulnerabilityMetadata field %s", name)
 }
@@ -21340,6 +23713,20 @@ func (m *VulnerabilityMetadataMutation) SetField(name string, value ent.Value) e
 		}
 		m.SetCollector(v)
 		return nil
+	case vulnerabilitymetadata.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case vulnerabilitymetadata.FieldSigner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown VulnerabilityMetadata field %s", name)
 }
@@ -21384,7 +23771,14 @@ func (m *VulnerabilityMetadataMutation) AddField(name string, value ent.Value) e
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *VulnerabilityMetadataMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(vulnerabilitymetadata.FieldVerified) {
+		fields = append(fields, vulnerabilitymetadata.FieldVerified)
+	}
+	if m.FieldCleared(vulnerabilitymetadata.FieldSigner) {
+		fields = append(fields, vulnerabilitymetadata.FieldSigner)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -21397,6 +23791,14 @@ func (m *VulnerabilityMetadataMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *VulnerabilityMetadataMutation) ClearField(name string) error {
+	switch name {
+	case vulnerabilitymetadata.FieldVerified:
+		m.ClearVerified()
+		return nil
+	case vulnerabilitymetadata.FieldSigner:
+		m.ClearSigner()
+		return nil
+	}
 	return fmt.Errorf("unknown VulnerabilityMetadata nullable field %s", name)
 }
 
@@ -21422,6 +23824,12 @@ func (m *VulnerabilityMetadataMutation) ResetField(name string) error {
 	case vulnerabilitymetadata.FieldCollector:
 		m.ResetCollector()
 		return nil
+	case vulnerabilitymetadata.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case vulnerabilitymetadata.FieldSigner:
+		m.ResetSigner()
+		return nil
 	}
 	return fmt.Errorf("unknown VulnerabilityMetadata field %s", name)
 }
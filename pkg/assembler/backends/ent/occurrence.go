@@ -27,6 +27,10 @@ type Occurrence struct {
 	Origin string `json:"origin,omitempty"`
 	// GUAC collector for the document
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// SourceID holds the value of the "source_id" field.
 	SourceID *int `json:"source_id,omitempty"`
 	// PackageID holds the value of the "package_id" field.
@@ -96,9 +100,11 @@ func (*Occurrence) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case occurrence.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case occurrence.FieldID, occurrence.FieldArtifactID, occurrence.FieldSourceID, occurrence.FieldPackageID:
 			values[i] = new(sql.NullInt64)
-		case occurrence.FieldJustification, occurrence.FieldOrigin, occurrence.FieldCollector:
+		case occurrence.FieldJustification, occurrence.FieldOrigin, occurrence.FieldCollector, occurrence.FieldSigner:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -145,6 +151,20 @@ func (o *Occurrence) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.Collector = value.String
 			}
+		case occurrence.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				o.Verified = new(bool)
+				*o.Verified = value.Bool
+			}
+		case occurrence.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				o.Signer = new(string)
+				*o.Signer = value.String
+			}
 		case occurrence.FieldSourceID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field source_id", values[i])
@@ -222,6 +242,16 @@ func (o *Occurrence) String() string {
 	builder.WriteString("collector=")
 	builder.WriteString(o.Collector)
 	builder.WriteString(", ")
+	if v := o.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := o.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := o.SourceID; v != nil {
 		builder.WriteString("source_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -48,6 +48,34 @@ func (oc *OccurrenceCreate) SetCollector(s string) *OccurrenceCreate {
 	return oc
 }
 
+// SetVerified sets the "verified" field.
+func (oc *OccurrenceCreate) SetVerified(b bool) *OccurrenceCreate {
+	oc.mutation.SetVerified(b)
+	return oc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (oc *OccurrenceCreate) SetNillableVerified(b *bool) *OccurrenceCreate {
+	if b != nil {
+		oc.SetVerified(*b)
+	}
+	return oc
+}
+
+// SetSigner sets the "signer" field.
+func (oc *OccurrenceCreate) SetSigner(s string) *OccurrenceCreate {
+	oc.mutation.SetSigner(s)
+	return oc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (oc *OccurrenceCreate) SetNillableSigner(s *string) *OccurrenceCreate {
+	if s != nil {
+		oc.SetSigner(*s)
+	}
+	return oc
+}
+
 // SetSourceID sets the "source_id" field.
 func (oc *OccurrenceCreate) SetSourceID(i int) *OccurrenceCreate {
 	oc.mutation.SetSourceID(i)
@@ -179,6 +207,14 @@ func (oc *OccurrenceCreate) createSpec() (*Occurrence, *sqlgraph.CreateSpec) {
 		_spec.SetField(occurrence.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := oc.mutation.Verified(); ok {
+		_spec.SetField(occurrence.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := oc.mutation.Signer(); ok {
+		_spec.SetField(occurrence.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := oc.mutation.ArtifactIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -330,6 +366,42 @@ func (u *OccurrenceUpsert) UpdateCollector() *OccurrenceUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *OccurrenceUpsert) SetVerified(v bool) *OccurrenceUpsert {
+	u.Set(occurrence.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *OccurrenceUpsert) UpdateVerified() *OccurrenceUpsert {
+	u.SetExcluded(occurrence.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *OccurrenceUpsert) ClearVerified() *OccurrenceUpsert {
+	u.SetNull(occurrence.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *OccurrenceUpsert) SetSigner(v string) *OccurrenceUpsert {
+	u.Set(occurrence.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *OccurrenceUpsert) UpdateSigner() *OccurrenceUpsert {
+	u.SetExcluded(occurrence.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *OccurrenceUpsert) ClearSigner() *OccurrenceUpsert {
+	u.SetNull(occurrence.FieldSigner)
+	return u
+}
+
 // SetSourceID sets the "source_id" field.
 func (u *OccurrenceUpsert) SetSourceID(v int) *OccurrenceUpsert {
 	u.Set(occurrence.FieldSourceID, v)
@@ -462,6 +534,48 @@ func (u *OccurrenceUpsertOne) UpdateCollector() *OccurrenceUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *OccurrenceUpsertOne) SetVerified(v bool) *OccurrenceUpsertOne {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *OccurrenceUpsertOne) UpdateVerified() *OccurrenceUpsertOne {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *OccurrenceUpsertOne) ClearVerified() *OccurrenceUpsertOne {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *OccurrenceUpsertOne) SetSigner(v string) *OccurrenceUpsertOne {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *OccurrenceUpsertOne) UpdateSigner() *OccurrenceUpsertOne {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *OccurrenceUpsertOne) ClearSigner() *OccurrenceUpsertOne {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetSourceID sets the "source_id" field.
 func (u *OccurrenceUpsertOne) SetSourceID(v int) *OccurrenceUpsertOne {
 	return u.Update(func(s *OccurrenceUpsert) {
@@ -763,6 +877,48 @@ func (u *OccurrenceUpsertBulk) UpdateCollector() *OccurrenceUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *OccurrenceUpsertBulk) SetVerified(v bool) *OccurrenceUpsertBulk {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *OccurrenceUpsertBulk) UpdateVerified() *OccurrenceUpsertBulk {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *OccurrenceUpsertBulk) ClearVerified() *OccurrenceUpsertBulk {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *OccurrenceUpsertBulk) SetSigner(v string) *OccurrenceUpsertBulk {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *OccurrenceUpsertBulk) UpdateSigner() *OccurrenceUpsertBulk {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *OccurrenceUpsertBulk) ClearSigner() *OccurrenceUpsertBulk {
+	return u.Update(func(s *OccurrenceUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetSourceID sets the "source_id" field.
 func (u *OccurrenceUpsertBulk) SetSourceID(v int) *OccurrenceUpsertBulk {
 	return u.Update(func(s *OccurrenceUpsert) {
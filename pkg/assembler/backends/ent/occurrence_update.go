@@ -86,6 +86,46 @@ func (ou *OccurrenceUpdate) SetNillableCollector(s *string) *OccurrenceUpdate {
 	return ou
 }
 
+// SetVerified sets the "verified" field.
+func (ou *OccurrenceUpdate) SetVerified(b bool) *OccurrenceUpdate {
+	ou.mutation.SetVerified(b)
+	return ou
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (ou *OccurrenceUpdate) SetNillableVerified(b *bool) *OccurrenceUpdate {
+	if b != nil {
+		ou.SetVerified(*b)
+	}
+	return ou
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (ou *OccurrenceUpdate) ClearVerified() *OccurrenceUpdate {
+	ou.mutation.ClearVerified()
+	return ou
+}
+
+// SetSigner sets the "signer" field.
+func (ou *OccurrenceUpdate) SetSigner(s string) *OccurrenceUpdate {
+	ou.mutation.SetSigner(s)
+	return ou
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (ou *OccurrenceUpdate) SetNillableSigner(s *string) *OccurrenceUpdate {
+	if s != nil {
+		ou.SetSigner(*s)
+	}
+	return ou
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (ou *OccurrenceUpdate) ClearSigner() *OccurrenceUpdate {
+	ou.mutation.ClearSigner()
+	return ou
+}
+
 // SetSourceID sets the "source_id" field.
 func (ou *OccurrenceUpdate) SetSourceID(i int) *OccurrenceUpdate {
 	ou.mutation.SetSourceID(i)
@@ -220,6 +260,18 @@ func (ou *OccurrenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.Collector(); ok {
 		_spec.SetField(occurrence.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := ou.mutation.Verified(); ok {
+		_spec.SetField(occurrence.FieldVerified, field.TypeBool, value)
+	}
+	if ou.mutation.VerifiedCleared() {
+		_spec.ClearField(occurrence.FieldVerified, field.TypeBool)
+	}
+	if value, ok := ou.mutation.Signer(); ok {
+		_spec.SetField(occurrence.FieldSigner, field.TypeString, value)
+	}
+	if ou.mutation.SignerCleared() {
+		_spec.ClearField(occurrence.FieldSigner, field.TypeString)
+	}
 	if ou.mutation.ArtifactCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -383,6 +435,46 @@ func (ouo *OccurrenceUpdateOne) SetNillableCollector(s *string) *OccurrenceUpdat
 	return ouo
 }
 
+// SetVerified sets the "verified" field.
+func (ouo *OccurrenceUpdateOne) SetVerified(b bool) *OccurrenceUpdateOne {
+	ouo.mutation.SetVerified(b)
+	return ouo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (ouo *OccurrenceUpdateOne) SetNillableVerified(b *bool) *OccurrenceUpdateOne {
+	if b != nil {
+		ouo.SetVerified(*b)
+	}
+	return ouo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (ouo *OccurrenceUpdateOne) ClearVerified() *OccurrenceUpdateOne {
+	ouo.mutation.ClearVerified()
+	return ouo
+}
+
+// SetSigner sets the "signer" field.
+func (ouo *OccurrenceUpdateOne) SetSigner(s string) *OccurrenceUpdateOne {
+	ouo.mutation.SetSigner(s)
+	return ouo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (ouo *OccurrenceUpdateOne) SetNillableSigner(s *string) *OccurrenceUpdateOne {
+	if s != nil {
+		ouo.SetSigner(*s)
+	}
+	return ouo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (ouo *OccurrenceUpdateOne) ClearSigner() *OccurrenceUpdateOne {
+	ouo.mutation.ClearSigner()
+	return ouo
+}
+
 // SetSourceID sets the "source_id" field.
 func (ouo *OccurrenceUpdateOne) SetSourceID(i int) *OccurrenceUpdateOne {
 	ouo.mutation.SetSourceID(i)
@@ -547,6 +639,18 @@ func (ouo *OccurrenceUpdateOne) sqlSave(ctx context.Context) (_node *Occurrence,
 	if value, ok := ouo.mutation.Collector(); ok {
 		_spec.SetField(occurrence.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := ouo.mutation.Verified(); ok {
+		_spec.SetField(occurrence.FieldVerified, field.TypeBool, value)
+	}
+	if ouo.mutation.VerifiedCleared() {
+		_spec.ClearField(occurrence.FieldVerified, field.TypeBool)
+	}
+	if value, ok := ouo.mutation.Signer(); ok {
+		_spec.SetField(occurrence.FieldSigner, field.TypeString, value)
+	}
+	if ouo.mutation.SignerCleared() {
+		_spec.ClearField(occurrence.FieldSigner, field.TypeString)
+	}
 	if ouo.mutation.ArtifactCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
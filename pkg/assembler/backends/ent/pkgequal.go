@@ -20,6 +20,10 @@ type PkgEqual struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Justification holds the value of the "justification" field.
 	Justification string `json:"justification,omitempty"`
 	// An opaque hash of the packages that are equal
@@ -57,9 +61,11 @@ func (*PkgEqual) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case pkgequal.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case pkgequal.FieldID:
 			values[i] = new(sql.NullInt64)
-		case pkgequal.FieldOrigin, pkgequal.FieldCollector, pkgequal.FieldJustification, pkgequal.FieldPackagesHash:
+		case pkgequal.FieldOrigin, pkgequal.FieldCollector, pkgequal.FieldSigner, pkgequal.FieldJustification, pkgequal.FieldPackagesHash:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -94,6 +100,20 @@ func (pe *PkgEqual) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pe.Collector = value.String
 			}
+		case pkgequal.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				pe.Verified = new(bool)
+				*pe.Verified = value.Bool
+			}
+		case pkgequal.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				pe.Signer = new(string)
+				*pe.Signer = value.String
+			}
 		case pkgequal.FieldJustification:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field justification", values[i])
@@ -153,6 +173,16 @@ func (pe *PkgEqual) String() string {
 	builder.WriteString("collector=")
 	builder.WriteString(pe.Collector)
 	builder.WriteString(", ")
+	if v := pe.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pe.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("justification=")
 	builder.WriteString(pe.Justification)
 	builder.WriteString(", ")
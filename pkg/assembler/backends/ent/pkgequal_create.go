@@ -34,6 +34,34 @@ func (pec *PkgEqualCreate) SetCollector(s string) *PkgEqualCreate {
 	return pec
 }
 
+// SetVerified sets the "verified" field.
+func (pec *PkgEqualCreate) SetVerified(b bool) *PkgEqualCreate {
+	pec.mutation.SetVerified(b)
+	return pec
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (pec *PkgEqualCreate) SetNillableVerified(b *bool) *PkgEqualCreate {
+	if b != nil {
+		pec.SetVerified(*b)
+	}
+	return pec
+}
+
+// SetSigner sets the "signer" field.
+func (pec *PkgEqualCreate) SetSigner(s string) *PkgEqualCreate {
+	pec.mutation.SetSigner(s)
+	return pec
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (pec *PkgEqualCreate) SetNillableSigner(s *string) *PkgEqualCreate {
+	if s != nil {
+		pec.SetSigner(*s)
+	}
+	return pec
+}
+
 // SetJustification sets the "justification" field.
 func (pec *PkgEqualCreate) SetJustification(s string) *PkgEqualCreate {
 	pec.mutation.SetJustification(s)
@@ -145,6 +173,14 @@ func (pec *PkgEqualCreate) createSpec() (*PkgEqual, *sqlgraph.CreateSpec) {
 		_spec.SetField(pkgequal.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := pec.mutation.Verified(); ok {
+		_spec.SetField(pkgequal.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := pec.mutation.Signer(); ok {
+		_spec.SetField(pkgequal.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if value, ok := pec.mutation.Justification(); ok {
 		_spec.SetField(pkgequal.FieldJustification, field.TypeString, value)
 		_node.Justification = value
@@ -245,6 +281,42 @@ func (u *PkgEqualUpsert) UpdateCollector() *PkgEqualUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *PkgEqualUpsert) SetVerified(v bool) *PkgEqualUpsert {
+	u.Set(pkgequal.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *PkgEqualUpsert) UpdateVerified() *PkgEqualUpsert {
+	u.SetExcluded(pkgequal.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *PkgEqualUpsert) ClearVerified() *PkgEqualUpsert {
+	u.SetNull(pkgequal.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *PkgEqualUpsert) SetSigner(v string) *PkgEqualUpsert {
+	u.Set(pkgequal.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *PkgEqualUpsert) UpdateSigner() *PkgEqualUpsert {
+	u.SetExcluded(pkgequal.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *PkgEqualUpsert) ClearSigner() *PkgEqualUpsert {
+	u.SetNull(pkgequal.FieldSigner)
+	return u
+}
+
 // SetJustification sets the "justification" field.
 func (u *PkgEqualUpsert) SetJustification(v string) *PkgEqualUpsert {
 	u.Set(pkgequal.FieldJustification, v)
@@ -337,6 +409,48 @@ func (u *PkgEqualUpsertOne) UpdateCollector() *PkgEqualUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *PkgEqualUpsertOne) SetVerified(v bool) *PkgEqualUpsertOne {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *PkgEqualUpsertOne) UpdateVerified() *PkgEqualUpsertOne {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *PkgEqualUpsertOne) ClearVerified() *PkgEqualUpsertOne {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *PkgEqualUpsertOne) SetSigner(v string) *PkgEqualUpsertOne {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *PkgEqualUpsertOne) UpdateSigner() *PkgEqualUpsertOne {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *PkgEqualUpsertOne) ClearSigner() *PkgEqualUpsertOne {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *PkgEqualUpsertOne) SetJustification(v string) *PkgEqualUpsertOne {
 	return u.Update(func(s *PkgEqualUpsert) {
@@ -596,6 +710,48 @@ func (u *PkgEqualUpsertBulk) UpdateCollector() *PkgEqualUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *PkgEqualUpsertBulk) SetVerified(v bool) *PkgEqualUpsertBulk {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *PkgEqualUpsertBulk) UpdateVerified() *PkgEqualUpsertBulk {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *PkgEqualUpsertBulk) ClearVerified() *PkgEqualUpsertBulk {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *PkgEqualUpsertBulk) SetSigner(v string) *PkgEqualUpsertBulk {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *PkgEqualUpsertBulk) UpdateSigner() *PkgEqualUpsertBulk {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *PkgEqualUpsertBulk) ClearSigner() *PkgEqualUpsertBulk {
+	return u.Update(func(s *PkgEqualUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *PkgEqualUpsertBulk) SetJustification(v string) *PkgEqualUpsertBulk {
 	return u.Update(func(s *PkgEqualUpsert) {
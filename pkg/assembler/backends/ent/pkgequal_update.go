@@ -56,6 +56,46 @@ func (peu *PkgEqualUpdate) SetNillableCollector(s *string) *PkgEqualUpdate {
 	return peu
 }
 
+// SetVerified sets the "verified" field.
+func (peu *PkgEqualUpdate) SetVerified(b bool) *PkgEqualUpdate {
+	peu.mutation.SetVerified(b)
+	return peu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (peu *PkgEqualUpdate) SetNillableVerified(b *bool) *PkgEqualUpdate {
+	if b != nil {
+		peu.SetVerified(*b)
+	}
+	return peu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (peu *PkgEqualUpdate) ClearVerified() *PkgEqualUpdate {
+	peu.mutation.ClearVerified()
+	return peu
+}
+
+// SetSigner sets the "signer" field.
+func (peu *PkgEqualUpdate) SetSigner(s string) *PkgEqualUpdate {
+	peu.mutation.SetSigner(s)
+	return peu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (peu *PkgEqualUpdate) SetNillableSigner(s *string) *PkgEqualUpdate {
+	if s != nil {
+		peu.SetSigner(*s)
+	}
+	return peu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (peu *PkgEqualUpdate) ClearSigner() *PkgEqualUpdate {
+	peu.mutation.ClearSigner()
+	return peu
+}
+
 // SetJustification sets the "justification" field.
 func (peu *PkgEqualUpdate) SetJustification(s string) *PkgEqualUpdate {
 	peu.mutation.SetJustification(s)
@@ -167,6 +207,18 @@ func (peu *PkgEqualUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := peu.mutation.Collector(); ok {
 		_spec.SetField(pkgequal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := peu.mutation.Verified(); ok {
+		_spec.SetField(pkgequal.FieldVerified, field.TypeBool, value)
+	}
+	if peu.mutation.VerifiedCleared() {
+		_spec.ClearField(pkgequal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := peu.mutation.Signer(); ok {
+		_spec.SetField(pkgequal.FieldSigner, field.TypeString, value)
+	}
+	if peu.mutation.SignerCleared() {
+		_spec.ClearField(pkgequal.FieldSigner, field.TypeString)
+	}
 	if value, ok := peu.mutation.Justification(); ok {
 		_spec.SetField(pkgequal.FieldJustification, field.TypeString, value)
 	}
@@ -266,6 +318,46 @@ func (peuo *PkgEqualUpdateOne) SetNillableCollector(s *string) *PkgEqualUpdateOn
 	return peuo
 }
 
+// SetVerified sets the "verified" field.
+func (peuo *PkgEqualUpdateOne) SetVerified(b bool) *PkgEqualUpdateOne {
+	peuo.mutation.SetVerified(b)
+	return peuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (peuo *PkgEqualUpdateOne) SetNillableVerified(b *bool) *PkgEqualUpdateOne {
+	if b != nil {
+		peuo.SetVerified(*b)
+	}
+	return peuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (peuo *PkgEqualUpdateOne) ClearVerified() *PkgEqualUpdateOne {
+	peuo.mutation.ClearVerified()
+	return peuo
+}
+
+// SetSigner sets the "signer" field.
+func (peuo *PkgEqualUpdateOne) SetSigner(s string) *PkgEqualUpdateOne {
+	peuo.mutation.SetSigner(s)
+	return peuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (peuo *PkgEqualUpdateOne) SetNillableSigner(s *string) *PkgEqualUpdateOne {
+	if s != nil {
+		peuo.SetSigner(*s)
+	}
+	return peuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (peuo *PkgEqualUpdateOne) ClearSigner() *PkgEqualUpdateOne {
+	peuo.mutation.ClearSigner()
+	return peuo
+}
+
 // SetJustification sets the "justification" field.
 func (peuo *PkgEqualUpdateOne) SetJustification(s string) *PkgEqualUpdateOne {
 	peuo.mutation.SetJustification(s)
@@ -407,6 +499,18 @@ func (peuo *PkgEqualUpdateOne) sqlSave(ctx context.Context) (_node *PkgEqual, er
 	if value, ok := peuo.mutation.Collector(); ok {
 		_spec.SetField(pkgequal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := peuo.mutation.Verified(); ok {
+		_spec.SetField(pkgequal.FieldVerified, field.TypeBool, value)
+	}
+	if peuo.mutation.VerifiedCleared() {
+		_spec.ClearField(pkgequal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := peuo.mutation.Signer(); ok {
+		_spec.SetField(pkgequal.FieldSigner, field.TypeString, value)
+	}
+	if peuo.mutation.SignerCleared() {
+		_spec.ClearField(pkgequal.FieldSigner, field.TypeString)
+	}
 	if value, ok := peuo.mutation.Justification(); ok {
 		_spec.SetField(pkgequal.FieldJustification, field.TypeString, value)
 	}
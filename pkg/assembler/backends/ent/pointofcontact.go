@@ -41,6 +41,10 @@ type PointOfContact struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PointOfContactQuery when eager-loading is set.
 	Edges        PointOfContactEdges `json:"edges"`
@@ -121,9 +125,11 @@ func (*PointOfContact) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case pointofcontact.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case pointofcontact.FieldID, pointofcontact.FieldSourceID, pointofcontact.FieldPackageVersionID, pointofcontact.FieldPackageNameID, pointofcontact.FieldArtifactID:
 			values[i] = new(sql.NullInt64)
-		case pointofcontact.FieldEmail, pointofcontact.FieldInfo, pointofcontact.FieldJustification, pointofcontact.FieldOrigin, pointofcontact.FieldCollector:
+		case pointofcontact.FieldEmail, pointofcontact.FieldInfo, pointofcontact.FieldJustification, pointofcontact.FieldOrigin, pointofcontact.FieldCollector, pointofcontact.FieldSigner:
 			values[i] = new(sql.NullString)
 		case pointofcontact.FieldSince:
 			values[i] = new(sql.NullTime)
@@ -212,6 +218,20 @@ func (poc *PointOfContact) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				poc.Collector = value.String
 			}
+		case pointofcontact.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				poc.Verified = new(bool)
+				*poc.Verified = value.Bool
+			}
+		case pointofcontact.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				poc.Signer = new(string)
+				*poc.Signer = value.String
+			}
 		default:
 			poc.selectValues.Set(columns[i], values[i])
 		}
@@ -305,6 +325,16 @@ func (poc *PointOfContact) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(poc.Collector)
+	builder.WriteString(", ")
+	if v := poc.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := poc.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -118,6 +118,34 @@ func (pocc *PointOfContactCreate) SetCollector(s string) *PointOfContactCreate {
 	return pocc
 }
 
+// SetVerified sets the "verified" field.
+func (pocc *PointOfContactCreate) SetVerified(b bool) *PointOfContactCreate {
+	pocc.mutation.SetVerified(b)
+	return pocc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (pocc *PointOfContactCreate) SetNillableVerified(b *bool) *PointOfContactCreate {
+	if b != nil {
+		pocc.SetVerified(*b)
+	}
+	return pocc
+}
+
+// SetSigner sets the "signer" field.
+func (pocc *PointOfContactCreate) SetSigner(s string) *PointOfContactCreate {
+	pocc.mutation.SetSigner(s)
+	return pocc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (pocc *PointOfContactCreate) SetNillableSigner(s *string) *PointOfContactCreate {
+	if s != nil {
+		pocc.SetSigner(*s)
+	}
+	return pocc
+}
+
 // SetSource sets the "source" edge to the SourceName entity.
 func (pocc *PointOfContactCreate) SetSource(s *SourceName) *PointOfContactCreate {
 	return pocc.SetSourceID(s.ID)
@@ -255,6 +283,14 @@ func (pocc *PointOfContactCreate) createSpec() (*PointOfContact, *sqlgraph.Creat
 		_spec.SetField(pointofcontact.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := pocc.mutation.Verified(); ok {
+		_spec.SetField(pointofcontact.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := pocc.mutation.Signer(); ok {
+		_spec.SetField(pointofcontact.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := pocc.mutation.SourceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -519,6 +555,42 @@ func (u *PointOfContactUpsert) UpdateCollector() *PointOfContactUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *PointOfContactUpsert) SetVerified(v bool) *PointOfContactUpsert {
+	u.Set(pointofcontact.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *PointOfContactUpsert) UpdateVerified() *PointOfContactUpsert {
+	u.SetExcluded(pointofcontact.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *PointOfContactUpsert) ClearVerified() *PointOfContactUpsert {
+	u.SetNull(pointofcontact.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *PointOfContactUpsert) SetSigner(v string) *PointOfContactUpsert {
+	u.Set(pointofcontact.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *PointOfContactUpsert) UpdateSigner() *PointOfContactUpsert {
+	u.SetExcluded(pointofcontact.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *PointOfContactUpsert) ClearSigner() *PointOfContactUpsert {
+	u.SetNull(pointofcontact.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -727,6 +799,48 @@ func (u *PointOfContactUpsertOne) UpdateCollector() *PointOfContactUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *PointOfContactUpsertOne) SetVerified(v bool) *PointOfContactUpsertOne {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *PointOfContactUpsertOne) UpdateVerified() *PointOfContactUpsertOne {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *PointOfContactUpsertOne) ClearVerified() *PointOfContactUpsertOne {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *PointOfContactUpsertOne) SetSigner(v string) *PointOfContactUpsertOne {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *PointOfContactUpsertOne) UpdateSigner() *PointOfContactUpsertOne {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *PointOfContactUpsertOne) ClearSigner() *PointOfContactUpsertOne {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *PointOfContactUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1098,6 +1212,48 @@ func (u *PointOfContactUpsertBulk) UpdateCollector() *PointOfContactUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *PointOfContactUpsertBulk) SetVerified(v bool) *PointOfContactUpsertBulk {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *PointOfContactUpsertBulk) UpdateVerified() *PointOfContactUpsertBulk {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *PointOfContactUpsertBulk) ClearVerified() *PointOfContactUpsertBulk {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *PointOfContactUpsertBulk) SetSigner(v string) *PointOfContactUpsertBulk {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *PointOfContactUpsertBulk) UpdateSigner() *PointOfContactUpsertBulk {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *PointOfContactUpsertBulk) ClearSigner() *PointOfContactUpsertBulk {
+	return u.Update(func(s *PointOfContactUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *PointOfContactUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -196,6 +196,46 @@ func (pocu *PointOfContactUpdate) SetNillableCollector(s *string) *PointOfContac
 	return pocu
 }
 
+// SetVerified sets the "verified" field.
+func (pocu *PointOfContactUpdate) SetVerified(b bool) *PointOfContactUpdate {
+	pocu.mutation.SetVerified(b)
+	return pocu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (pocu *PointOfContactUpdate) SetNillableVerified(b *bool) *PointOfContactUpdate {
+	if b != nil {
+		pocu.SetVerified(*b)
+	}
+	return pocu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (pocu *PointOfContactUpdate) ClearVerified() *PointOfContactUpdate {
+	pocu.mutation.ClearVerified()
+	return pocu
+}
+
+// SetSigner sets the "signer" field.
+func (pocu *PointOfContactUpdate) SetSigner(s string) *PointOfContactUpdate {
+	pocu.mutation.SetSigner(s)
+	return pocu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (pocu *PointOfContactUpdate) SetNillableSigner(s *string) *PointOfContactUpdate {
+	if s != nil {
+		pocu.SetSigner(*s)
+	}
+	return pocu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (pocu *PointOfContactUpdate) ClearSigner() *PointOfContactUpdate {
+	pocu.mutation.ClearSigner()
+	return pocu
+}
+
 // SetSource sets the "source" edge to the SourceName entity.
 func (pocu *PointOfContactUpdate) SetSource(s *SourceName) *PointOfContactUpdate {
 	return pocu.SetSourceID(s.ID)
@@ -313,6 +353,18 @@ func (pocu *PointOfContactUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := pocu.mutation.Collector(); ok {
 		_spec.SetField(pointofcontact.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := pocu.mutation.Verified(); ok {
+		_spec.SetField(pointofcontact.FieldVerified, field.TypeBool, value)
+	}
+	if pocu.mutation.VerifiedCleared() {
+		_spec.ClearField(pointofcontact.FieldVerified, field.TypeBool)
+	}
+	if value, ok := pocu.mutation.Signer(); ok {
+		_spec.SetField(pointofcontact.FieldSigner, field.TypeString, value)
+	}
+	if pocu.mutation.SignerCleared() {
+		_spec.ClearField(pointofcontact.FieldSigner, field.TypeString)
+	}
 	if pocu.mutation.SourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -613,6 +665,46 @@ func (pocuo *PointOfContactUpdateOne) SetNillableCollector(s *string) *PointOfCo
 	return pocuo
 }
 
+// SetVerified sets the "verified" field.
+func (pocuo *PointOfContactUpdateOne) SetVerified(b bool) *PointOfContactUpdateOne {
+	pocuo.mutation.SetVerified(b)
+	return pocuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (pocuo *PointOfContactUpdateOne) SetNillableVerified(b *bool) *PointOfContactUpdateOne {
+	if b != nil {
+		pocuo.SetVerified(*b)
+	}
+	return pocuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (pocuo *PointOfContactUpdateOne) ClearVerified() *PointOfContactUpdateOne {
+	pocuo.mutation.ClearVerified()
+	return pocuo
+}
+
+// SetSigner sets the "signer" field.
+func (pocuo *PointOfContactUpdateOne) SetSigner(s string) *PointOfContactUpdateOne {
+	pocuo.mutation.SetSigner(s)
+	return pocuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (pocuo *PointOfContactUpdateOne) SetNillableSigner(s *string) *PointOfContactUpdateOne {
+	if s != nil {
+		pocuo.SetSigner(*s)
+	}
+	return pocuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (pocuo *PointOfContactUpdateOne) ClearSigner() *PointOfContactUpdateOne {
+	pocuo.mutation.ClearSigner()
+	return pocuo
+}
+
 // SetSource sets the "source" edge to the SourceName entity.
 func (pocuo *PointOfContactUpdateOne) SetSource(s *SourceName) *PointOfContactUpdateOne {
 	return pocuo.SetSourceID(s.ID)
@@ -760,6 +852,18 @@ func (pocuo *PointOfContactUpdateOne) sqlSave(ctx context.Context) (_node *Point
 	if value, ok := pocuo.mutation.Collector(); ok {
 		_spec.SetField(pointofcontact.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := pocuo.mutation.Verified(); ok {
+		_spec.SetField(pointofcontact.FieldVerified, field.TypeBool, value)
+	}
+	if pocuo.mutation.VerifiedCleared() {
+		_spec.ClearField(pointofcontact.FieldVerified, field.TypeBool)
+	}
+	if value, ok := pocuo.mutation.Signer(); ok {
+		_spec.SetField(pointofcontact.FieldSigner, field.TypeString, value)
+	}
+	if pocuo.mutation.SignerCleared() {
+		_spec.ClearField(pointofcontact.FieldSigner, field.TypeString)
+	}
 	if pocuo.mutation.SourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
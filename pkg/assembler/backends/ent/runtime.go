@@ -20,6 +20,8 @@ import (
 func init() {
 	certificationFields := schema.Certification{}.Fields()
 	_ = certificationFields
+	dependencyFields := schema.Dependency{}.Fields()
+	_ = dependencyFields
 	licenseFields := schema.License{}.Fields()
 	_ = licenseFields
 	// licenseDescName is the schema descriptor for name field.
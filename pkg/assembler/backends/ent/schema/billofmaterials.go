@@ -39,6 +39,8 @@ func (BillOfMaterials) Fields() []ent.Field {
 		field.String("download_location"),
 		field.String("origin"),
 		field.String("collector").Comment("GUAC collector for the document"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 		field.Time("known_since"),
 	}
 }
@@ -40,6 +40,8 @@ func (CertifyLegal) Fields() []ent.Field {
 		field.Time("time_scanned"),
 		field.String("origin"),
 		field.String("collector"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 		field.String("declared_licenses_hash").Comment("An opaque hash of the declared license IDs to ensure uniqueness"),
 		field.String("discovered_licenses_hash").Comment("An opaque hash of the discovered license IDs to ensure uniqueness"),
 	}
@@ -41,6 +41,8 @@ func (CertifyVex) Fields() []ent.Field {
 		field.String("justification"),
 		field.String("origin"),
 		field.String("collector"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 	}
 }
 
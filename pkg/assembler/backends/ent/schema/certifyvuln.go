@@ -39,6 +39,8 @@ func (CertifyVuln) Fields() []ent.Field {
 		field.String("scanner_version"),
 		field.String("origin"),
 		field.String("collector"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 	}
 }
 
@@ -45,9 +45,14 @@ func (Dependency) Fields() []ent.Field {
 		field.Int("dependent_package_version_id").Optional(),
 		field.String("version_range"),
 		field.Enum("dependency_type").Values(model.DependencyTypeDirect.String(), model.DependencyTypeIndirect.String(), model.DependencyTypeUnknown.String()),
+		field.Enum("dependency_scope").Values(model.DependencyScopeRuntime.String(), model.DependencyScopeDev.String(), model.DependencyScopeBuild.String(),
+			model.DependencyScopeTest.String(), model.DependencyScopeOptional.String(), model.DependencyScopeProvided.String(), model.DependencyScopeUnknown.String()).
+			Default(model.DependencyScopeUnknown.String()),
 		field.String("justification"),
 		field.String("origin"),
 		field.String("collector"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 	}
 }
 
@@ -70,11 +75,11 @@ func (Dependency) Edges() []ent.Edge {
 // Indexes of the Dependency.
 func (Dependency) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("version_range", "dependency_type", "justification", "origin", "collector").
+		index.Fields("version_range", "dependency_type", "dependency_scope", "justification", "origin", "collector").
 			Edges("package", "dependent_package_name").
 			Unique().
 			Annotations(entsql.IndexWhere("dependent_package_name_id IS NOT NULL AND dependent_package_version_id IS NULL")).StorageKey("dep_package_name"),
-		index.Fields("version_range", "dependency_type", "justification", "origin", "collector").
+		index.Fields("version_range", "dependency_type", "dependency_scope", "justification", "origin", "collector").
 			Edges("package", "dependent_package_version").
 			Unique().
 			Annotations(entsql.IndexWhere("dependent_package_name_id IS NULL AND dependent_package_version_id IS NOT NULL")).StorageKey("dep_package_version"),
@@ -31,6 +31,8 @@ func (HashEqual) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("origin"),
 		field.String("collector"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 		field.String("justification"),
 	}
 }
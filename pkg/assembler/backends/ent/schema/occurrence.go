@@ -41,6 +41,8 @@ func (Occurrence) Fields() []ent.Field {
 		field.String("justification").Comment("Justification for the attested relationship"),
 		field.String("origin").Comment("Document from which this attestation is generated from"),
 		field.String("collector").Comment("GUAC collector for the document"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 		field.Int("source_id").Optional().Nillable(),
 		field.Int("package_id").Optional().Nillable(),
 	}
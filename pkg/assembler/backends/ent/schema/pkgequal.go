@@ -40,6 +40,8 @@ func (PkgEqual) Fields() []ent.Field {
 		// field.Int("equal_package_id"),
 		field.String("origin"),
 		field.String("collector"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 		field.String("justification"),
 		field.String("packages_hash").Comment("An opaque hash of the packages that are equal"),
 	}
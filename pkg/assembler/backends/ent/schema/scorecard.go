@@ -40,6 +40,8 @@ func (Scorecard) Fields() []ent.Field {
 		field.String("scorecard_commit"),
 		field.String("origin"),
 		field.String("collector"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 	}
 }
 
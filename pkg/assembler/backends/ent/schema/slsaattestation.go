@@ -49,6 +49,8 @@ func (SLSAAttestation) Fields() []ent.Field {
 		field.Time("finished_on").Optional().Nillable().Comment("Timestamp of build end time"),
 		field.String("origin").Comment("Document from which this attestation is generated from"),
 		field.String("collector").Comment("GUAC collector for the document"),
+		field.Bool("verified").Optional().Nillable().Comment("Whether the trust policy trusts a verified signer of the document"),
+		field.String("signer").Optional().Nillable().Comment("Identity that signed the document"),
 		field.String("built_from_hash").Comment("Hash of the artifacts that was built"),
 	}
 }
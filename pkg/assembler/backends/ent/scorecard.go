@@ -33,6 +33,10 @@ type Scorecard struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ScorecardQuery when eager-loading is set.
 	Edges        ScorecardEdges `json:"edges"`
@@ -68,11 +72,13 @@ func (*Scorecard) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case scorecard.FieldChecks:
 			values[i] = new([]byte)
+		case scorecard.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case scorecard.FieldAggregateScore:
 			values[i] = new(sql.NullFloat64)
 		case scorecard.FieldID:
 			values[i] = new(sql.NullInt64)
-		case scorecard.FieldScorecardVersion, scorecard.FieldScorecardCommit, scorecard.FieldOrigin, scorecard.FieldCollector:
+		case scorecard.FieldScorecardVersion, scorecard.FieldScorecardCommit, scorecard.FieldOrigin, scorecard.FieldCollector, scorecard.FieldSigner:
 			values[i] = new(sql.NullString)
 		case scorecard.FieldTimeScanned:
 			values[i] = new(sql.NullTime)
@@ -141,6 +147,20 @@ func (s *Scorecard) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Collector = value.String
 			}
+		case scorecard.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				s.Verified = new(bool)
+				*s.Verified = value.Bool
+			}
+		case scorecard.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				s.Signer = new(string)
+				*s.Signer = value.String
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -202,6 +222,16 @@ func (s *Scorecard) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(s.Collector)
+	builder.WriteString(", ")
+	if v := s.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
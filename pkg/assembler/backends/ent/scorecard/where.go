@@ -85,6 +85,16 @@ func Collector(v string) predicate.Scorecard {
 	return predicate.Scorecard(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldEQ(FieldSigner, v))
+}
+
 // AggregateScoreEQ applies the EQ predicate on the "aggregate_score" field.
 func AggregateScoreEQ(v float64) predicate.Scorecard {
 	return predicate.Scorecard(sql.FieldEQ(FieldAggregateScore, v))
@@ -425,6 +435,101 @@ func CollectorContainsFold(v string) predicate.Scorecard {
 	return predicate.Scorecard(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.Scorecard {
+	return predicate.Scorecard(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasCertifications applies the HasEdge predicate on the "certifications" edge.
 func HasCertifications() predicate.Scorecard {
 	return predicate.Scorecard(func(s *sql.Selector) {
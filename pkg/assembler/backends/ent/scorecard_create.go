@@ -82,6 +82,34 @@ func (sc *ScorecardCreate) SetCollector(s string) *ScorecardCreate {
 	return sc
 }
 
+// SetVerified sets the "verified" field.
+func (sc *ScorecardCreate) SetVerified(b bool) *ScorecardCreate {
+	sc.mutation.SetVerified(b)
+	return sc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (sc *ScorecardCreate) SetNillableVerified(b *bool) *ScorecardCreate {
+	if b != nil {
+		sc.SetVerified(*b)
+	}
+	return sc
+}
+
+// SetSigner sets the "signer" field.
+func (sc *ScorecardCreate) SetSigner(s string) *ScorecardCreate {
+	sc.mutation.SetSigner(s)
+	return sc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (sc *ScorecardCreate) SetNillableSigner(s *string) *ScorecardCreate {
+	if s != nil {
+		sc.SetSigner(*s)
+	}
+	return sc
+}
+
 // AddCertificationIDs adds the "certifications" edge to the CertifyScorecard entity by IDs.
 func (sc *ScorecardCreate) AddCertificationIDs(ids ...int) *ScorecardCreate {
 	sc.mutation.AddCertificationIDs(ids...)
@@ -220,6 +248,14 @@ func (sc *ScorecardCreate) createSpec() (*Scorecard, *sqlgraph.CreateSpec) {
 		_spec.SetField(scorecard.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := sc.mutation.Verified(); ok {
+		_spec.SetField(scorecard.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := sc.mutation.Signer(); ok {
+		_spec.SetField(scorecard.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := sc.mutation.CertificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -378,6 +414,42 @@ func (u *ScorecardUpsert) UpdateCollector() *ScorecardUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *ScorecardUpsert) SetVerified(v bool) *ScorecardUpsert {
+	u.Set(scorecard.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *ScorecardUpsert) UpdateVerified() *ScorecardUpsert {
+	u.SetExcluded(scorecard.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *ScorecardUpsert) ClearVerified() *ScorecardUpsert {
+	u.SetNull(scorecard.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *ScorecardUpsert) SetSigner(v string) *ScorecardUpsert {
+	u.Set(scorecard.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *ScorecardUpsert) UpdateSigner() *ScorecardUpsert {
+	u.SetExcluded(scorecard.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *ScorecardUpsert) ClearSigner() *ScorecardUpsert {
+	u.SetNull(scorecard.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -523,6 +595,48 @@ func (u *ScorecardUpsertOne) UpdateCollector() *ScorecardUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *ScorecardUpsertOne) SetVerified(v bool) *ScorecardUpsertOne {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *ScorecardUpsertOne) UpdateVerified() *ScorecardUpsertOne {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *ScorecardUpsertOne) ClearVerified() *ScorecardUpsertOne {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *ScorecardUpsertOne) SetSigner(v string) *ScorecardUpsertOne {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *ScorecardUpsertOne) UpdateSigner() *ScorecardUpsertOne {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *ScorecardUpsertOne) ClearSigner() *ScorecardUpsertOne {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *ScorecardUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -832,6 +946,48 @@ func (u *ScorecardUpsertBulk) UpdateCollector() *ScorecardUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *ScorecardUpsertBulk) SetVerified(v bool) *ScorecardUpsertBulk {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *ScorecardUpsertBulk) UpdateVerified() *ScorecardUpsertBulk {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *ScorecardUpsertBulk) ClearVerified() *ScorecardUpsertBulk {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *ScorecardUpsertBulk) SetSigner(v string) *ScorecardUpsertBulk {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *ScorecardUpsertBulk) UpdateSigner() *ScorecardUpsertBulk {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *ScorecardUpsertBulk) ClearSigner() *ScorecardUpsertBulk {
+	return u.Update(func(s *ScorecardUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *ScorecardUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -134,6 +134,46 @@ func (su *ScorecardUpdate) SetNillableCollector(s *string) *ScorecardUpdate {
 	return su
 }
 
+// SetVerified sets the "verified" field.
+func (su *ScorecardUpdate) SetVerified(b bool) *ScorecardUpdate {
+	su.mutation.SetVerified(b)
+	return su
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (su *ScorecardUpdate) SetNillableVerified(b *bool) *ScorecardUpdate {
+	if b != nil {
+		su.SetVerified(*b)
+	}
+	return su
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (su *ScorecardUpdate) ClearVerified() *ScorecardUpdate {
+	su.mutation.ClearVerified()
+	return su
+}
+
+// SetSigner sets the "signer" field.
+func (su *ScorecardUpdate) SetSigner(s string) *ScorecardUpdate {
+	su.mutation.SetSigner(s)
+	return su
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (su *ScorecardUpdate) SetNillableSigner(s *string) *ScorecardUpdate {
+	if s != nil {
+		su.SetSigner(*s)
+	}
+	return su
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (su *ScorecardUpdate) ClearSigner() *ScorecardUpdate {
+	su.mutation.ClearSigner()
+	return su
+}
+
 // AddCertificationIDs adds the "certifications" edge to the CertifyScorecard entity by IDs.
 func (su *ScorecardUpdate) AddCertificationIDs(ids ...int) *ScorecardUpdate {
 	su.mutation.AddCertificationIDs(ids...)
@@ -240,6 +280,18 @@ func (su *ScorecardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.Collector(); ok {
 		_spec.SetField(scorecard.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := su.mutation.Verified(); ok {
+		_spec.SetField(scorecard.FieldVerified, field.TypeBool, value)
+	}
+	if su.mutation.VerifiedCleared() {
+		_spec.ClearField(scorecard.FieldVerified, field.TypeBool)
+	}
+	if value, ok := su.mutation.Signer(); ok {
+		_spec.SetField(scorecard.FieldSigner, field.TypeString, value)
+	}
+	if su.mutation.SignerCleared() {
+		_spec.ClearField(scorecard.FieldSigner, field.TypeString)
+	}
 	if su.mutation.CertificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -408,6 +460,46 @@ func (suo *ScorecardUpdateOne) SetNillableCollector(s *string) *ScorecardUpdateO
 	return suo
 }
 
+// SetVerified sets the "verified" field.
+func (suo *ScorecardUpdateOne) SetVerified(b bool) *ScorecardUpdateOne {
+	suo.mutation.SetVerified(b)
+	return suo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (suo *ScorecardUpdateOne) SetNillableVerified(b *bool) *ScorecardUpdateOne {
+	if b != nil {
+		suo.SetVerified(*b)
+	}
+	return suo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (suo *ScorecardUpdateOne) ClearVerified() *ScorecardUpdateOne {
+	suo.mutation.ClearVerified()
+	return suo
+}
+
+// SetSigner sets the "signer" field.
+func (suo *ScorecardUpdateOne) SetSigner(s string) *ScorecardUpdateOne {
+	suo.mutation.SetSigner(s)
+	return suo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (suo *ScorecardUpdateOne) SetNillableSigner(s *string) *ScorecardUpdateOne {
+	if s != nil {
+		suo.SetSigner(*s)
+	}
+	return suo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (suo *ScorecardUpdateOne) ClearSigner() *ScorecardUpdateOne {
+	suo.mutation.ClearSigner()
+	return suo
+}
+
 // AddCertificationIDs adds the "certifications" edge to the CertifyScorecard entity by IDs.
 func (suo *ScorecardUpdateOne) AddCertificationIDs(ids ...int) *ScorecardUpdateOne {
 	suo.mutation.AddCertificationIDs(ids...)
@@ -544,6 +636,18 @@ func (suo *ScorecardUpdateOne) sqlSave(ctx context.Context) (_node *Scorecard, e
 	if value, ok := suo.mutation.Collector(); ok {
 		_spec.SetField(scorecard.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.Verified(); ok {
+		_spec.SetField(scorecard.FieldVerified, field.TypeBool, value)
+	}
+	if suo.mutation.VerifiedCleared() {
+		_spec.ClearField(scorecard.FieldVerified, field.TypeBool)
+	}
+	if value, ok := suo.mutation.Signer(); ok {
+		_spec.SetField(scorecard.FieldSigner, field.TypeString, value)
+	}
+	if suo.mutation.SignerCleared() {
+		_spec.ClearField(scorecard.FieldSigner, field.TypeString)
+	}
 	if suo.mutation.CertificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -39,6 +39,10 @@ type SLSAAttestation struct {
 	Origin string `json:"origin,omitempty"`
 	// GUAC collector for the document
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Hash of the artifacts that was built
 	BuiltFromHash string `json:"built_from_hash,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -106,9 +110,11 @@ func (*SLSAAttestation) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case slsaattestation.FieldSlsaPredicate:
 			values[i] = new([]byte)
+		case slsaattestation.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case slsaattestation.FieldID, slsaattestation.FieldBuiltByID, slsaattestation.FieldSubjectID:
 			values[i] = new(sql.NullInt64)
-		case slsaattestation.FieldBuildType, slsaattestation.FieldSlsaVersion, slsaattestation.FieldOrigin, slsaattestation.FieldCollector, slsaattestation.FieldBuiltFromHash:
+		case slsaattestation.FieldBuildType, slsaattestation.FieldSlsaVersion, slsaattestation.FieldOrigin, slsaattestation.FieldCollector, slsaattestation.FieldSigner, slsaattestation.FieldBuiltFromHash:
 			values[i] = new(sql.NullString)
 		case slsaattestation.FieldStartedOn, slsaattestation.FieldFinishedOn:
 			values[i] = new(sql.NullTime)
@@ -191,6 +197,20 @@ func (sa *SLSAAttestation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sa.Collector = value.String
 			}
+		case slsaattestation.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				sa.Verified = new(bool)
+				*sa.Verified = value.Bool
+			}
+		case slsaattestation.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				sa.Signer = new(string)
+				*sa.Signer = value.String
+			}
 		case slsaattestation.FieldBuiltFromHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field built_from_hash", values[i])
@@ -279,6 +299,16 @@ func (sa *SLSAAttestation) String() string {
 	builder.WriteString("collector=")
 	builder.WriteString(sa.Collector)
 	builder.WriteString(", ")
+	if v := sa.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sa.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("built_from_hash=")
 	builder.WriteString(sa.BuiltFromHash)
 	builder.WriteByte(')')
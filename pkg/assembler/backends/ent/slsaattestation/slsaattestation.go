@@ -30,6 +30,10 @@ const (
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
 	FieldCollector = "collector"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldSigner holds the string denoting the signer field in the database.
+	FieldSigner = "signer"
 	// FieldBuiltFromHash holds the string denoting the built_from_hash field in the database.
 	FieldBuiltFromHash = "built_from_hash"
 	// EdgeBuiltFrom holds the string denoting the built_from edge name in mutations.
@@ -73,6 +77,8 @@ var Columns = []string{
 	FieldFinishedOn,
 	FieldOrigin,
 	FieldCollector,
+	FieldVerified,
+	FieldSigner,
 	FieldBuiltFromHash,
 }
 
@@ -140,6 +146,16 @@ func ByCollector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollector, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// BySigner orders the results by the signer field.
+func BySigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigner, opts...).ToFunc()
+}
+
 // ByBuiltFromHash orders the results by the built_from_hash field.
 func ByBuiltFromHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBuiltFromHash, opts...).ToFunc()
@@ -95,6 +95,34 @@ func (sac *SLSAAttestationCreate) SetCollector(s string) *SLSAAttestationCreate
 	return sac
 }
 
+// SetVerified sets the "verified" field.
+func (sac *SLSAAttestationCreate) SetVerified(b bool) *SLSAAttestationCreate {
+	sac.mutation.SetVerified(b)
+	return sac
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (sac *SLSAAttestationCreate) SetNillableVerified(b *bool) *SLSAAttestationCreate {
+	if b != nil {
+		sac.SetVerified(*b)
+	}
+	return sac
+}
+
+// SetSigner sets the "signer" field.
+func (sac *SLSAAttestationCreate) SetSigner(s string) *SLSAAttestationCreate {
+	sac.mutation.SetSigner(s)
+	return sac
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (sac *SLSAAttestationCreate) SetNillableSigner(s *string) *SLSAAttestationCreate {
+	if s != nil {
+		sac.SetSigner(*s)
+	}
+	return sac
+}
+
 // SetBuiltFromHash sets the "built_from_hash" field.
 func (sac *SLSAAttestationCreate) SetBuiltFromHash(s string) *SLSAAttestationCreate {
 	sac.mutation.SetBuiltFromHash(s)
@@ -242,6 +270,14 @@ func (sac *SLSAAttestationCreate) createSpec() (*SLSAAttestation, *sqlgraph.Crea
 		_spec.SetField(slsaattestation.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := sac.mutation.Verified(); ok {
+		_spec.SetField(slsaattestation.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := sac.mutation.Signer(); ok {
+		_spec.SetField(slsaattestation.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if value, ok := sac.mutation.BuiltFromHash(); ok {
 		_spec.SetField(slsaattestation.FieldBuiltFromHash, field.TypeString, value)
 		_node.BuiltFromHash = value
@@ -474,6 +510,42 @@ func (u *SLSAAttestationUpsert) UpdateCollector() *SLSAAttestationUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *SLSAAttestationUpsert) SetVerified(v bool) *SLSAAttestationUpsert {
+	u.Set(slsaattestation.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *SLSAAttestationUpsert) UpdateVerified() *SLSAAttestationUpsert {
+	u.SetExcluded(slsaattestation.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *SLSAAttestationUpsert) ClearVerified() *SLSAAttestationUpsert {
+	u.SetNull(slsaattestation.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *SLSAAttestationUpsert) SetSigner(v string) *SLSAAttestationUpsert {
+	u.Set(slsaattestation.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *SLSAAttestationUpsert) UpdateSigner() *SLSAAttestationUpsert {
+	u.SetExcluded(slsaattestation.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *SLSAAttestationUpsert) ClearSigner() *SLSAAttestationUpsert {
+	u.SetNull(slsaattestation.FieldSigner)
+	return u
+}
+
 // SetBuiltFromHash sets the "built_from_hash" field.
 func (u *SLSAAttestationUpsert) SetBuiltFromHash(v string) *SLSAAttestationUpsert {
 	u.Set(slsaattestation.FieldBuiltFromHash, v)
@@ -673,6 +745,48 @@ func (u *SLSAAttestationUpsertOne) UpdateCollector() *SLSAAttestationUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *SLSAAttestationUpsertOne) SetVerified(v bool) *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *SLSAAttestationUpsertOne) UpdateVerified() *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *SLSAAttestationUpsertOne) ClearVerified() *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *SLSAAttestationUpsertOne) SetSigner(v string) *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *SLSAAttestationUpsertOne) UpdateSigner() *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *SLSAAttestationUpsertOne) ClearSigner() *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetBuiltFromHash sets the "built_from_hash" field.
 func (u *SLSAAttestationUpsertOne) SetBuiltFromHash(v string) *SLSAAttestationUpsertOne {
 	return u.Update(func(s *SLSAAttestationUpsert) {
@@ -1037,6 +1151,48 @@ func (u *SLSAAttestationUpsertBulk) UpdateCollector() *SLSAAttestationUpsertBulk
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *SLSAAttestationUpsertBulk) SetVerified(v bool) *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *SLSAAttestationUpsertBulk) UpdateVerified() *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *SLSAAttestationUpsertBulk) ClearVerified() *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *SLSAAttestationUpsertBulk) SetSigner(v string) *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *SLSAAttestationUpsertBulk) UpdateSigner() *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *SLSAAttestationUpsertBulk) ClearSigner() *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // SetBuiltFromHash sets the "built_from_hash" field.
 func (u *SLSAAttestationUpsertBulk) SetBuiltFromHash(v string) *SLSAAttestationUpsertBulk {
 	return u.Update(func(s *SLSAAttestationUpsert) {
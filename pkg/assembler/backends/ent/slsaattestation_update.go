@@ -174,6 +174,46 @@ func (sau *SLSAAttestationUpdate) SetNillableCollector(s *string) *SLSAAttestati
 	return sau
 }
 
+// SetVerified sets the "verified" field.
+func (sau *SLSAAttestationUpdate) SetVerified(b bool) *SLSAAttestationUpdate {
+	sau.mutation.SetVerified(b)
+	return sau
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (sau *SLSAAttestationUpdate) SetNillableVerified(b *bool) *SLSAAttestationUpdate {
+	if b != nil {
+		sau.SetVerified(*b)
+	}
+	return sau
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (sau *SLSAAttestationUpdate) ClearVerified() *SLSAAttestationUpdate {
+	sau.mutation.ClearVerified()
+	return sau
+}
+
+// SetSigner sets the "signer" field.
+func (sau *SLSAAttestationUpdate) SetSigner(s string) *SLSAAttestationUpdate {
+	sau.mutation.SetSigner(s)
+	return sau
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (sau *SLSAAttestationUpdate) SetNillableSigner(s *string) *SLSAAttestationUpdate {
+	if s != nil {
+		sau.SetSigner(*s)
+	}
+	return sau
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (sau *SLSAAttestationUpdate) ClearSigner() *SLSAAttestationUpdate {
+	sau.mutation.ClearSigner()
+	return sau
+}
+
 // SetBuiltFromHash sets the "built_from_hash" field.
 func (sau *SLSAAttestationUpdate) SetBuiltFromHash(s string) *SLSAAttestationUpdate {
 	sau.mutation.SetBuiltFromHash(s)
@@ -336,6 +376,18 @@ func (sau *SLSAAttestationUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := sau.mutation.Collector(); ok {
 		_spec.SetField(slsaattestation.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := sau.mutation.Verified(); ok {
+		_spec.SetField(slsaattestation.FieldVerified, field.TypeBool, value)
+	}
+	if sau.mutation.VerifiedCleared() {
+		_spec.ClearField(slsaattestation.FieldVerified, field.TypeBool)
+	}
+	if value, ok := sau.mutation.Signer(); ok {
+		_spec.SetField(slsaattestation.FieldSigner, field.TypeString, value)
+	}
+	if sau.mutation.SignerCleared() {
+		_spec.ClearField(slsaattestation.FieldSigner, field.TypeString)
+	}
 	if value, ok := sau.mutation.BuiltFromHash(); ok {
 		_spec.SetField(slsaattestation.FieldBuiltFromHash, field.TypeString, value)
 	}
@@ -604,6 +656,46 @@ func (sauo *SLSAAttestationUpdateOne) SetNillableCollector(s *string) *SLSAAttes
 	return sauo
 }
 
+// SetVerified sets the "verified" field.
+func (sauo *SLSAAttestationUpdateOne) SetVerified(b bool) *SLSAAttestationUpdateOne {
+	sauo.mutation.SetVerified(b)
+	return sauo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (sauo *SLSAAttestationUpdateOne) SetNillableVerified(b *bool) *SLSAAttestationUpdateOne {
+	if b != nil {
+		sauo.SetVerified(*b)
+	}
+	return sauo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (sauo *SLSAAttestationUpdateOne) ClearVerified() *SLSAAttestationUpdateOne {
+	sauo.mutation.ClearVerified()
+	return sauo
+}
+
+// SetSigner sets the "signer" field.
+func (sauo *SLSAAttestationUpdateOne) SetSigner(s string) *SLSAAttestationUpdateOne {
+	sauo.mutation.SetSigner(s)
+	return sauo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (sauo *SLSAAttestationUpdateOne) SetNillableSigner(s *string) *SLSAAttestationUpdateOne {
+	if s != nil {
+		sauo.SetSigner(*s)
+	}
+	return sauo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (sauo *SLSAAttestationUpdateOne) ClearSigner() *SLSAAttestationUpdateOne {
+	sauo.mutation.ClearSigner()
+	return sauo
+}
+
 // SetBuiltFromHash sets the "built_from_hash" field.
 func (sauo *SLSAAttestationUpdateOne) SetBuiltFromHash(s string) *SLSAAttestationUpdateOne {
 	sauo.mutation.SetBuiltFromHash(s)
@@ -796,6 +888,18 @@ func (sauo *SLSAAttestationUpdateOne) sqlSave(ctx context.Context) (_node *SLSAA
 	if value, ok := sauo.mutation.Collector(); ok {
 		_spec.SetField(slsaattestation.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := sauo.mutation.Verified(); ok {
+		_spec.SetField(slsaattestation.FieldVerified, field.TypeBool, value)
+	}
+	if sauo.mutation.VerifiedCleared() {
+		_spec.ClearField(slsaattestation.FieldVerified, field.TypeBool)
+	}
+	if value, ok := sauo.mutation.Signer(); ok {
+		_spec.SetField(slsaattestation.FieldSigner, field.TypeString, value)
+	}
+	if sauo.mutation.SignerCleared() {
+		_spec.ClearField(slsaattestation.FieldSigner, field.TypeString)
+	}
 	if value, ok := sauo.mutation.BuiltFromHash(); ok {
 		_spec.SetField(slsaattestation.FieldBuiltFromHash, field.TypeString, value)
 	}
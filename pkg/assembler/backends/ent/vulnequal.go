@@ -22,6 +22,10 @@ type VulnEqual struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VulnEqualQuery when eager-loading is set.
 	Edges        VulnEqualEdges `json:"edges"`
@@ -55,9 +59,11 @@ func (*VulnEqual) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case vulnequal.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case vulnequal.FieldID:
 			values[i] = new(sql.NullInt64)
-		case vulnequal.FieldJustification, vulnequal.FieldOrigin, vulnequal.FieldCollector:
+		case vulnequal.FieldJustification, vulnequal.FieldOrigin, vulnequal.FieldCollector, vulnequal.FieldSigner:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -98,6 +104,20 @@ func (ve *VulnEqual) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ve.Collector = value.String
 			}
+		case vulnequal.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				ve.Verified = new(bool)
+				*ve.Verified = value.Bool
+			}
+		case vulnequal.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				ve.Signer = new(string)
+				*ve.Signer = value.String
+			}
 		default:
 			ve.selectValues.Set(columns[i], values[i])
 		}
@@ -147,6 +167,16 @@ func (ve *VulnEqual) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(ve.Collector)
+	builder.WriteString(", ")
+	if v := ve.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ve.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
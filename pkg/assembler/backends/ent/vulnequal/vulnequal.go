@@ -18,6 +18,10 @@ const (
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
 	FieldCollector = "collector"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldSigner holds the string denoting the signer field in the database.
+	FieldSigner = "signer"
 	// EdgeVulnerabilityIds holds the string denoting the vulnerability_ids edge name in mutations.
 	EdgeVulnerabilityIds = "vulnerability_ids"
 	// Table holds the table name of the vulnequal in the database.
@@ -35,6 +39,8 @@ var Columns = []string{
 	FieldJustification,
 	FieldOrigin,
 	FieldCollector,
+	FieldVerified,
+	FieldSigner,
 }
 
 var (
@@ -76,6 +82,16 @@ func ByCollector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollector, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// BySigner orders the results by the signer field.
+func BySigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigner, opts...).ToFunc()
+}
+
 // ByVulnerabilityIdsCount orders the results by vulnerability_ids count.
 func ByVulnerabilityIdsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
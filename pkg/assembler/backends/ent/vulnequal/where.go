@@ -68,6 +68,16 @@ func Collector(v string) predicate.VulnEqual {
 	return predicate.VulnEqual(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEQ(FieldSigner, v))
+}
+
 // JustificationEQ applies the EQ predicate on the "justification" field.
 func JustificationEQ(v string) predicate.VulnEqual {
 	return predicate.VulnEqual(sql.FieldEQ(FieldJustification, v))
@@ -263,6 +273,101 @@ func CollectorContainsFold(v string) predicate.VulnEqual {
 	return predicate.VulnEqual(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasVulnerabilityIds applies the HasEdge predicate on the "vulnerability_ids" edge.
 func HasVulnerabilityIds() predicate.VulnEqual {
 	return predicate.VulnEqual(func(s *sql.Selector) {
@@ -40,6 +40,34 @@ func (vec *VulnEqualCreate) SetCollector(s string) *VulnEqualCreate {
 	return vec
 }
 
+// SetVerified sets the "verified" field.
+func (vec *VulnEqualCreate) SetVerified(b bool) *VulnEqualCreate {
+	vec.mutation.SetVerified(b)
+	return vec
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (vec *VulnEqualCreate) SetNillableVerified(b *bool) *VulnEqualCreate {
+	if b != nil {
+		vec.SetVerified(*b)
+	}
+	return vec
+}
+
+// SetSigner sets the "signer" field.
+func (vec *VulnEqualCreate) SetSigner(s string) *VulnEqualCreate {
+	vec.mutation.SetSigner(s)
+	return vec
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (vec *VulnEqualCreate) SetNillableSigner(s *string) *VulnEqualCreate {
+	if s != nil {
+		vec.SetSigner(*s)
+	}
+	return vec
+}
+
 // AddVulnerabilityIDIDs adds the "vulnerability_ids" edge to the VulnerabilityID entity by IDs.
 func (vec *VulnEqualCreate) AddVulnerabilityIDIDs(ids ...int) *VulnEqualCreate {
 	vec.mutation.AddVulnerabilityIDIDs(ids...)
@@ -140,6 +168,14 @@ func (vec *VulnEqualCreate) createSpec() (*VulnEqual, *sqlgraph.CreateSpec) {
 		_spec.SetField(vulnequal.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := vec.mutation.Verified(); ok {
+		_spec.SetField(vulnequal.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := vec.mutation.Signer(); ok {
+		_spec.SetField(vulnequal.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := vec.mutation.VulnerabilityIdsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -244,6 +280,42 @@ func (u *VulnEqualUpsert) UpdateCollector() *VulnEqualUpsert {
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *VulnEqualUpsert) SetVerified(v bool) *VulnEqualUpsert {
+	u.Set(vulnequal.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *VulnEqualUpsert) UpdateVerified() *VulnEqualUpsert {
+	u.SetExcluded(vulnequal.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *VulnEqualUpsert) ClearVerified() *VulnEqualUpsert {
+	u.SetNull(vulnequal.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *VulnEqualUpsert) SetSigner(v string) *VulnEqualUpsert {
+	u.Set(vulnequal.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *VulnEqualUpsert) UpdateSigner() *VulnEqualUpsert {
+	u.SetExcluded(vulnequal.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *VulnEqualUpsert) ClearSigner() *VulnEqualUpsert {
+	u.SetNull(vulnequal.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -326,6 +398,48 @@ func (u *VulnEqualUpsertOne) UpdateCollector() *VulnEqualUpsertOne {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *VulnEqualUpsertOne) SetVerified(v bool) *VulnEqualUpsertOne {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *VulnEqualUpsertOne) UpdateVerified() *VulnEqualUpsertOne {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *VulnEqualUpsertOne) ClearVerified() *VulnEqualUpsertOne {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *VulnEqualUpsertOne) SetSigner(v string) *VulnEqualUpsertOne {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *VulnEqualUpsertOne) UpdateSigner() *VulnEqualUpsertOne {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *VulnEqualUpsertOne) ClearSigner() *VulnEqualUpsertOne {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *VulnEqualUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -571,6 +685,48 @@ func (u *VulnEqualUpsertBulk) UpdateCollector() *VulnEqualUpsertBulk {
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *VulnEqualUpsertBulk) SetVerified(v bool) *VulnEqualUpsertBulk {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *VulnEqualUpsertBulk) UpdateVerified() *VulnEqualUpsertBulk {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *VulnEqualUpsertBulk) ClearVerified() *VulnEqualUpsertBulk {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *VulnEqualUpsertBulk) SetSigner(v string) *VulnEqualUpsertBulk {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *VulnEqualUpsertBulk) UpdateSigner() *VulnEqualUpsertBulk {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *VulnEqualUpsertBulk) ClearSigner() *VulnEqualUpsertBulk {
+	return u.Update(func(s *VulnEqualUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *VulnEqualUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -70,6 +70,46 @@ func (veu *VulnEqualUpdate) SetNillableCollector(s *string) *VulnEqualUpdate {
 	return veu
 }
 
+// SetVerified sets the "verified" field.
+func (veu *VulnEqualUpdate) SetVerified(b bool) *VulnEqualUpdate {
+	veu.mutation.SetVerified(b)
+	return veu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (veu *VulnEqualUpdate) SetNillableVerified(b *bool) *VulnEqualUpdate {
+	if b != nil {
+		veu.SetVerified(*b)
+	}
+	return veu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (veu *VulnEqualUpdate) ClearVerified() *VulnEqualUpdate {
+	veu.mutation.ClearVerified()
+	return veu
+}
+
+// SetSigner sets the "signer" field.
+func (veu *VulnEqualUpdate) SetSigner(s string) *VulnEqualUpdate {
+	veu.mutation.SetSigner(s)
+	return veu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (veu *VulnEqualUpdate) SetNillableSigner(s *string) *VulnEqualUpdate {
+	if s != nil {
+		veu.SetSigner(*s)
+	}
+	return veu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (veu *VulnEqualUpdate) ClearSigner() *VulnEqualUpdate {
+	veu.mutation.ClearSigner()
+	return veu
+}
+
 // AddVulnerabilityIDIDs adds the "vulnerability_ids" edge to the VulnerabilityID entity by IDs.
 func (veu *VulnEqualUpdate) AddVulnerabilityIDIDs(ids ...int) *VulnEqualUpdate {
 	veu.mutation.AddVulnerabilityIDIDs(ids...)
@@ -156,6 +196,18 @@ func (veu *VulnEqualUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := veu.mutation.Collector(); ok {
 		_spec.SetField(vulnequal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := veu.mutation.Verified(); ok {
+		_spec.SetField(vulnequal.FieldVerified, field.TypeBool, value)
+	}
+	if veu.mutation.VerifiedCleared() {
+		_spec.ClearField(vulnequal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := veu.mutation.Signer(); ok {
+		_spec.SetField(vulnequal.FieldSigner, field.TypeString, value)
+	}
+	if veu.mutation.SignerCleared() {
+		_spec.ClearField(vulnequal.FieldSigner, field.TypeString)
+	}
 	if veu.mutation.VulnerabilityIdsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -263,6 +315,46 @@ func (veuo *VulnEqualUpdateOne) SetNillableCollector(s *string) *VulnEqualUpdate
 	return veuo
 }
 
+// SetVerified sets the "verified" field.
+func (veuo *VulnEqualUpdateOne) SetVerified(b bool) *VulnEqualUpdateOne {
+	veuo.mutation.SetVerified(b)
+	return veuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (veuo *VulnEqualUpdateOne) SetNillableVerified(b *bool) *VulnEqualUpdateOne {
+	if b != nil {
+		veuo.SetVerified(*b)
+	}
+	return veuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (veuo *VulnEqualUpdateOne) ClearVerified() *VulnEqualUpdateOne {
+	veuo.mutation.ClearVerified()
+	return veuo
+}
+
+// SetSigner sets the "signer" field.
+func (veuo *VulnEqualUpdateOne) SetSigner(s string) *VulnEqualUpdateOne {
+	veuo.mutation.SetSigner(s)
+	return veuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (veuo *VulnEqualUpdateOne) SetNillableSigner(s *string) *VulnEqualUpdateOne {
+	if s != nil {
+		veuo.SetSigner(*s)
+	}
+	return veuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (veuo *VulnEqualUpdateOne) ClearSigner() *VulnEqualUpdateOne {
+	veuo.mutation.ClearSigner()
+	return veuo
+}
+
 // AddVulnerabilityIDIDs adds the "vulnerability_ids" edge to the VulnerabilityID entity by IDs.
 func (veuo *VulnEqualUpdateOne) AddVulnerabilityIDIDs(ids ...int) *VulnEqualUpdateOne {
 	veuo.mutation.AddVulnerabilityIDIDs(ids...)
@@ -379,6 +471,18 @@ func (veuo *VulnEqualUpdateOne) sqlSave(ctx context.Context) (_node *VulnEqual,
 	if value, ok := veuo.mutation.Collector(); ok {
 		_spec.SetField(vulnequal.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := veuo.mutation.Verified(); ok {
+		_spec.SetField(vulnequal.FieldVerified, field.TypeBool, value)
+	}
+	if veuo.mutation.VerifiedCleared() {
+		_spec.ClearField(vulnequal.FieldVerified, field.TypeBool)
+	}
+	if value, ok := veuo.mutation.Signer(); ok {
+		_spec.SetField(vulnequal.FieldSigner, field.TypeString, value)
+	}
+	if veuo.mutation.SignerCleared() {
+		_spec.ClearField(vulnequal.FieldSigner, field.TypeString)
+	}
 	if veuo.mutation.VulnerabilityIdsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
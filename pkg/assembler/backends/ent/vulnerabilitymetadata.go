@@ -30,6 +30,10 @@ type VulnerabilityMetadata struct {
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
 	Collector string `json:"collector,omitempty"`
+	// Whether the trust policy trusts a verified signer of the document
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document
+	Signer *string `json:"signer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VulnerabilityMetadataQuery when eager-loading is set.
 	Edges        VulnerabilityMetadataEdges `json:"edges"`
@@ -65,11 +69,13 @@ func (*VulnerabilityMetadata) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case vulnerabilitymetadata.FieldVerified:
+			values[i] = new(sql.NullBool)
 		case vulnerabilitymetadata.FieldScoreValue:
 			values[i] = new(sql.NullFloat64)
 		case vulnerabilitymetadata.FieldID, vulnerabilitymetadata.FieldVulnerabilityIDID:
 			values[i] = new(sql.NullInt64)
-		case vulnerabilitymetadata.FieldScoreType, vulnerabilitymetadata.FieldOrigin, vulnerabilitymetadata.FieldCollector:
+		case vulnerabilitymetadata.FieldScoreType, vulnerabilitymetadata.FieldOrigin, vulnerabilitymetadata.FieldCollector, vulnerabilitymetadata.FieldSigner:
 			values[i] = new(sql.NullString)
 		case vulnerabilitymetadata.FieldTimestamp:
 			values[i] = new(sql.NullTime)
@@ -130,6 +136,20 @@ func (vm *VulnerabilityMetadata) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				vm.Collector = value.String
 			}
+		case vulnerabilitymetadata.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				vm.Verified = new(bool)
+				*vm.Verified = value.Bool
+			}
+		case vulnerabilitymetadata.FieldSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signer", values[i])
+			} else if value.Valid {
+				vm.Signer = new(string)
+				*vm.Signer = value.String
+			}
 		default:
 			vm.selectValues.Set(columns[i], values[i])
 		}
@@ -188,6 +208,16 @@ func (vm *VulnerabilityMetadata) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("collector=")
 	builder.WriteString(vm.Collector)
+	builder.WriteString(", ")
+	if v := vm.Verified; v != nil {
+		builder.WriteString("verified=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := vm.Signer; v != nil {
+		builder.WriteString("signer=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,10 @@ const (
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
 	FieldCollector = "collector"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldSigner holds the string denoting the signer field in the database.
+	FieldSigner = "signer"
 	// EdgeVulnerabilityID holds the string denoting the vulnerability_id edge name in mutations.
 	EdgeVulnerabilityID = "vulnerability_id"
 	// Table holds the table name of the vulnerabilitymetadata in the database.
@@ -50,6 +54,8 @@ var Columns = []string{
 	FieldTimestamp,
 	FieldOrigin,
 	FieldCollector,
+	FieldVerified,
+	FieldSigner,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -129,6 +135,16 @@ func ByCollector(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollector, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// BySigner orders the results by the signer field.
+func BySigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigner, opts...).ToFunc()
+}
+
 // ByVulnerabilityIDField orders the results by vulnerability_id field.
 func ByVulnerabilityIDField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -80,6 +80,16 @@ func Collector(v string) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldCollector, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldVerified, v))
+}
+
+// Signer applies equality check predicate on the "signer" field. It's identical to SignerEQ.
+func Signer(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldSigner, v))
+}
+
 // VulnerabilityIDIDEQ applies the EQ predicate on the "vulnerability_id_id" field.
 func VulnerabilityIDIDEQ(v int) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldVulnerabilityIDID, v))
@@ -330,6 +340,101 @@ func CollectorContainsFold(v string) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldContainsFold(FieldCollector, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNEQ(FieldVerified, v))
+}
+
+// VerifiedIsNil applies the IsNil predicate on the "verified" field.
+func VerifiedIsNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIsNull(FieldVerified))
+}
+
+// VerifiedNotNil applies the NotNil predicate on the "verified" field.
+func VerifiedNotNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotNull(FieldVerified))
+}
+
+// SignerEQ applies the EQ predicate on the "signer" field.
+func SignerEQ(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldSigner, v))
+}
+
+// SignerNEQ applies the NEQ predicate on the "signer" field.
+func SignerNEQ(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNEQ(FieldSigner, v))
+}
+
+// SignerIn applies the In predicate on the "signer" field.
+func SignerIn(vs ...string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIn(FieldSigner, vs...))
+}
+
+// SignerNotIn applies the NotIn predicate on the "signer" field.
+func SignerNotIn(vs ...string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotIn(FieldSigner, vs...))
+}
+
+// SignerGT applies the GT predicate on the "signer" field.
+func SignerGT(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGT(FieldSigner, v))
+}
+
+// SignerGTE applies the GTE predicate on the "signer" field.
+func SignerGTE(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGTE(FieldSigner, v))
+}
+
+// SignerLT applies the LT predicate on the "signer" field.
+func SignerLT(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLT(FieldSigner, v))
+}
+
+// SignerLTE applies the LTE predicate on the "signer" field.
+func SignerLTE(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLTE(FieldSigner, v))
+}
+
+// SignerContains applies the Contains predicate on the "signer" field.
+func SignerContains(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldContains(FieldSigner, v))
+}
+
+// SignerHasPrefix applies the HasPrefix predicate on the "signer" field.
+func SignerHasPrefix(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldHasPrefix(FieldSigner, v))
+}
+
+// SignerHasSuffix applies the HasSuffix predicate on the "signer" field.
+func SignerHasSuffix(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldHasSuffix(FieldSigner, v))
+}
+
+// SignerIsNil applies the IsNil predicate on the "signer" field.
+func SignerIsNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIsNull(FieldSigner))
+}
+
+// SignerNotNil applies the NotNil predicate on the "signer" field.
+func SignerNotNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotNull(FieldSigner))
+}
+
+// SignerEqualFold applies the EqualFold predicate on the "signer" field.
+func SignerEqualFold(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEqualFold(FieldSigner, v))
+}
+
+// SignerContainsFold applies the ContainsFold predicate on the "signer" field.
+func SignerContainsFold(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldContainsFold(FieldSigner, v))
+}
+
 // HasVulnerabilityID applies the HasEdge predicate on the "vulnerability_id" edge.
 func HasVulnerabilityID() predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(func(s *sql.Selector) {
@@ -59,6 +59,34 @@ func (vmc *VulnerabilityMetadataCreate) SetCollector(s string) *VulnerabilityMet
 	return vmc
 }
 
+// SetVerified sets the "verified" field.
+func (vmc *VulnerabilityMetadataCreate) SetVerified(b bool) *VulnerabilityMetadataCreate {
+	vmc.mutation.SetVerified(b)
+	return vmc
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (vmc *VulnerabilityMetadataCreate) SetNillableVerified(b *bool) *VulnerabilityMetadataCreate {
+	if b != nil {
+		vmc.SetVerified(*b)
+	}
+	return vmc
+}
+
+// SetSigner sets the "signer" field.
+func (vmc *VulnerabilityMetadataCreate) SetSigner(s string) *VulnerabilityMetadataCreate {
+	vmc.mutation.SetSigner(s)
+	return vmc
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (vmc *VulnerabilityMetadataCreate) SetNillableSigner(s *string) *VulnerabilityMetadataCreate {
+	if s != nil {
+		vmc.SetSigner(*s)
+	}
+	return vmc
+}
+
 // SetVulnerabilityID sets the "vulnerability_id" edge to the VulnerabilityID entity.
 func (vmc *VulnerabilityMetadataCreate) SetVulnerabilityID(v *VulnerabilityID) *VulnerabilityMetadataCreate {
 	return vmc.SetVulnerabilityIDID(v.ID)
@@ -171,6 +199,14 @@ func (vmc *VulnerabilityMetadataCreate) createSpec() (*VulnerabilityMetadata, *s
 		_spec.SetField(vulnerabilitymetadata.FieldCollector, field.TypeString, value)
 		_node.Collector = value
 	}
+	if value, ok := vmc.mutation.Verified(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldVerified, field.TypeBool, value)
+		_node.Verified = &value
+	}
+	if value, ok := vmc.mutation.Signer(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldSigner, field.TypeString, value)
+		_node.Signer = &value
+	}
 	if nodes := vmc.mutation.VulnerabilityIDIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -318,6 +354,42 @@ func (u *VulnerabilityMetadataUpsert) UpdateCollector() *VulnerabilityMetadataUp
 	return u
 }
 
+// SetVerified sets the "verified" field.
+func (u *VulnerabilityMetadataUpsert) SetVerified(v bool) *VulnerabilityMetadataUpsert {
+	u.Set(vulnerabilitymetadata.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsert) UpdateVerified() *VulnerabilityMetadataUpsert {
+	u.SetExcluded(vulnerabilitymetadata.FieldVerified)
+	return u
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *VulnerabilityMetadataUpsert) ClearVerified() *VulnerabilityMetadataUpsert {
+	u.SetNull(vulnerabilitymetadata.FieldVerified)
+	return u
+}
+
+// SetSigner sets the "signer" field.
+func (u *VulnerabilityMetadataUpsert) SetSigner(v string) *VulnerabilityMetadataUpsert {
+	u.Set(vulnerabilitymetadata.FieldSigner, v)
+	return u
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsert) UpdateSigner() *VulnerabilityMetadataUpsert {
+	u.SetExcluded(vulnerabilitymetadata.FieldSigner)
+	return u
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *VulnerabilityMetadataUpsert) ClearSigner() *VulnerabilityMetadataUpsert {
+	u.SetNull(vulnerabilitymetadata.FieldSigner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -449,6 +521,48 @@ func (u *VulnerabilityMetadataUpsertOne) UpdateCollector() *VulnerabilityMetadat
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *VulnerabilityMetadataUpsertOne) SetVerified(v bool) *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertOne) UpdateVerified() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *VulnerabilityMetadataUpsertOne) ClearVerified() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *VulnerabilityMetadataUpsertOne) SetSigner(v string) *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertOne) UpdateSigner() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *VulnerabilityMetadataUpsertOne) ClearSigner() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *VulnerabilityMetadataUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -743,6 +857,48 @@ func (u *VulnerabilityMetadataUpsertBulk) UpdateCollector() *VulnerabilityMetada
 	})
 }
 
+// SetVerified sets the "verified" field.
+func (u *VulnerabilityMetadataUpsertBulk) SetVerified(v bool) *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertBulk) UpdateVerified() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (u *VulnerabilityMetadataUpsertBulk) ClearVerified() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearVerified()
+	})
+}
+
+// SetSigner sets the "signer" field.
+func (u *VulnerabilityMetadataUpsertBulk) SetSigner(v string) *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetSigner(v)
+	})
+}
+
+// UpdateSigner sets the "signer" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertBulk) UpdateSigner() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateSigner()
+	})
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (u *VulnerabilityMetadataUpsertBulk) ClearSigner() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearSigner()
+	})
+}
+
 // Exec executes the query.
 func (u *VulnerabilityMetadataUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -120,6 +120,46 @@ func (vmu *VulnerabilityMetadataUpdate) SetNillableCollector(s *string) *Vulnera
 	return vmu
 }
 
+// SetVerified sets the "verified" field.
+func (vmu *VulnerabilityMetadataUpdate) SetVerified(b bool) *VulnerabilityMetadataUpdate {
+	vmu.mutation.SetVerified(b)
+	return vmu
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (vmu *VulnerabilityMetadataUpdate) SetNillableVerified(b *bool) *VulnerabilityMetadataUpdate {
+	if b != nil {
+		vmu.SetVerified(*b)
+	}
+	return vmu
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (vmu *VulnerabilityMetadataUpdate) ClearVerified() *VulnerabilityMetadataUpdate {
+	vmu.mutation.ClearVerified()
+	return vmu
+}
+
+// SetSigner sets the "signer" field.
+func (vmu *VulnerabilityMetadataUpdate) SetSigner(s string) *VulnerabilityMetadataUpdate {
+	vmu.mutation.SetSigner(s)
+	return vmu
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (vmu *VulnerabilityMetadataUpdate) SetNillableSigner(s *string) *VulnerabilityMetadataUpdate {
+	if s != nil {
+		vmu.SetSigner(*s)
+	}
+	return vmu
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (vmu *VulnerabilityMetadataUpdate) ClearSigner() *VulnerabilityMetadataUpdate {
+	vmu.mutation.ClearSigner()
+	return vmu
+}
+
 // SetVulnerabilityID sets the "vulnerability_id" edge to the VulnerabilityID entity.
 func (vmu *VulnerabilityMetadataUpdate) SetVulnerabilityID(v *VulnerabilityID) *VulnerabilityMetadataUpdate {
 	return vmu.SetVulnerabilityIDID(v.ID)
@@ -206,6 +246,18 @@ func (vmu *VulnerabilityMetadataUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := vmu.mutation.Collector(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := vmu.mutation.Verified(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldVerified, field.TypeBool, value)
+	}
+	if vmu.mutation.VerifiedCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldVerified, field.TypeBool)
+	}
+	if value, ok := vmu.mutation.Signer(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldSigner, field.TypeString, value)
+	}
+	if vmu.mutation.SignerCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldSigner, field.TypeString)
+	}
 	if vmu.mutation.VulnerabilityIDCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -346,6 +398,46 @@ func (vmuo *VulnerabilityMetadataUpdateOne) SetNillableCollector(s *string) *Vul
 	return vmuo
 }
 
+// SetVerified sets the "verified" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetVerified(b bool) *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.SetVerified(b)
+	return vmuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetNillableVerified(b *bool) *VulnerabilityMetadataUpdateOne {
+	if b != nil {
+		vmuo.SetVerified(*b)
+	}
+	return vmuo
+}
+
+// ClearVerified clears the value of the "verified" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) ClearVerified() *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.ClearVerified()
+	return vmuo
+}
+
+// SetSigner sets the "signer" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetSigner(s string) *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.SetSigner(s)
+	return vmuo
+}
+
+// SetNillableSigner sets the "signer" field if the given value is not nil.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetNillableSigner(s *string) *VulnerabilityMetadataUpdateOne {
+	if s != nil {
+		vmuo.SetSigner(*s)
+	}
+	return vmuo
+}
+
+// ClearSigner clears the value of the "signer" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) ClearSigner() *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.ClearSigner()
+	return vmuo
+}
+
 // SetVulnerabilityID sets the "vulnerability_id" edge to the VulnerabilityID entity.
 func (vmuo *VulnerabilityMetadataUpdateOne) SetVulnerabilityID(v *VulnerabilityID) *VulnerabilityMetadataUpdateOne {
 	return vmuo.SetVulnerabilityIDID(v.ID)
@@ -462,6 +554,18 @@ func (vmuo *VulnerabilityMetadataUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := vmuo.mutation.Collector(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldCollector, field.TypeString, value)
 	}
+	if value, ok := vmuo.mutation.Verified(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldVerified, field.TypeBool, value)
+	}
+	if vmuo.mutation.VerifiedCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldVerified, field.TypeBool)
+	}
+	if value, ok := vmuo.mutation.Signer(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldSigner, field.TypeString, value)
+	}
+	if vmuo.mutation.SignerCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldSigner, field.TypeString)
+	}
 	if vmuo.mutation.VulnerabilityIDCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
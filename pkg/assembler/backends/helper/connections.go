@@ -0,0 +1,284 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import "github.com/guacsec/guac/pkg/assembler/graphql/model"
+
+// The functions below turn the page returned by the list query of a backend
+// into the connection of its type, so that backends only implement the
+// paging itself.
+
+// edges pairs each node of the page with its cursor.
+func edges[T, E any](p *Page[T], edge func(cursor string, node T) E) []E {
+	es := make([]E, len(p.Nodes))
+	for i, n := range p.Nodes {
+		es[i] = edge(p.Cursors[i], n)
+	}
+	return es
+}
+
+// ArtifactConnection returns the connection of a page of Artifact nodes.
+func ArtifactConnection(p *Page[*model.Artifact]) *model.ArtifactConnection {
+	return &model.ArtifactConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.Artifact) *model.ArtifactEdge {
+			return &model.ArtifactEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// BuilderConnection returns the connection of a page of Builder nodes.
+func BuilderConnection(p *Page[*model.Builder]) *model.BuilderConnection {
+	return &model.BuilderConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.Builder) *model.BuilderEdge {
+			return &model.BuilderEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// LicenseConnection returns the connection of a page of License nodes.
+func LicenseConnection(p *Page[*model.License]) *model.LicenseConnection {
+	return &model.LicenseConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.License) *model.LicenseEdge {
+			return &model.LicenseEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// PackageConnection returns the connection of a page of Package nodes.
+func PackageConnection(p *Page[*model.Package]) *model.PackageConnection {
+	return &model.PackageConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.Package) *model.PackageEdge {
+			return &model.PackageEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// SourceConnection returns the connection of a page of Source nodes.
+func SourceConnection(p *Page[*model.Source]) *model.SourceConnection {
+	return &model.SourceConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.Source) *model.SourceEdge {
+			return &model.SourceEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// VulnerabilityConnection returns the connection of a page of Vulnerability nodes.
+func VulnerabilityConnection(p *Page[*model.Vulnerability]) *model.VulnerabilityConnection {
+	return &model.VulnerabilityConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.Vulnerability) *model.VulnerabilityEdge {
+			return &model.VulnerabilityEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// CertifyBadConnection returns the connection of a page of CertifyBad nodes.
+func CertifyBadConnection(p *Page[*model.CertifyBad]) *model.CertifyBadConnection {
+	return &model.CertifyBadConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.CertifyBad) *model.CertifyBadEdge {
+			return &model.CertifyBadEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// CertifyGoodConnection returns the connection of a page of CertifyGood nodes.
+func CertifyGoodConnection(p *Page[*model.CertifyGood]) *model.CertifyGoodConnection {
+	return &model.CertifyGoodConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.CertifyGood) *model.CertifyGoodEdge {
+			return &model.CertifyGoodEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// CertifyLegalConnection returns the connection of a page of CertifyLegal nodes.
+func CertifyLegalConnection(p *Page[*model.CertifyLegal]) *model.CertifyLegalConnection {
+	return &model.CertifyLegalConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.CertifyLegal) *model.CertifyLegalEdge {
+			return &model.CertifyLegalEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// CertifyScorecardConnection returns the connection of a page of CertifyScorecard nodes.
+func CertifyScorecardConnection(p *Page[*model.CertifyScorecard]) *model.CertifyScorecardConnection {
+	return &model.CertifyScorecardConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.CertifyScorecard) *model.CertifyScorecardEdge {
+			return &model.CertifyScorecardEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// CertifyVEXStatementConnection returns the connection of a page of CertifyVEXStatement nodes.
+func CertifyVEXStatementConnection(p *Page[*model.CertifyVEXStatement]) *model.CertifyVEXStatementConnection {
+	return &model.CertifyVEXStatementConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.CertifyVEXStatement) *model.CertifyVEXStatementEdge {
+			return &model.CertifyVEXStatementEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// CertifyVulnConnection returns the connection of a page of CertifyVuln nodes.
+func CertifyVulnConnection(p *Page[*model.CertifyVuln]) *model.CertifyVulnConnection {
+	return &model.CertifyVulnConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.CertifyVuln) *model.CertifyVulnEdge {
+			return &model.CertifyVulnEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// PointOfContactConnection returns the connection of a page of PointOfContact nodes.
+func PointOfContactConnection(p *Page[*model.PointOfContact]) *model.PointOfContactConnection {
+	return &model.PointOfContactConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.PointOfContact) *model.PointOfContactEdge {
+			return &model.PointOfContactEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// HasSBOMConnection returns the connection of a page of HasSbom nodes.
+func HasSBOMConnection(p *Page[*model.HasSbom]) *model.HasSBOMConnection {
+	return &model.HasSBOMConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.HasSbom) *model.HasSBOMEdge {
+			return &model.HasSBOMEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// HasSLSAConnection returns the connection of a page of HasSlsa nodes.
+func HasSLSAConnection(p *Page[*model.HasSlsa]) *model.HasSLSAConnection {
+	return &model.HasSLSAConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.HasSlsa) *model.HasSLSAEdge {
+			return &model.HasSLSAEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// HasSourceAtConnection returns the connection of a page of HasSourceAt nodes.
+func HasSourceAtConnection(p *Page[*model.HasSourceAt]) *model.HasSourceAtConnection {
+	return &model.HasSourceAtConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.HasSourceAt) *model.HasSourceAtEdge {
+			return &model.HasSourceAtEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// HashEqualConnection returns the connection of a page of HashEqual nodes.
+func HashEqualConnection(p *Page[*model.HashEqual]) *model.HashEqualConnection {
+	return &model.HashEqualConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.HashEqual) *model.HashEqualEdge {
+			return &model.HashEqualEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// IsDependencyConnection returns the connection of a page of IsDependency nodes.
+func IsDependencyConnection(p *Page[*model.IsDependency]) *model.IsDependencyConnection {
+	return &model.IsDependencyConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.IsDependency) *model.IsDependencyEdge {
+			return &model.IsDependencyEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// IsOccurrenceConnection returns the connection of a page of IsOccurrence nodes.
+func IsOccurrenceConnection(p *Page[*model.IsOccurrence]) *model.IsOccurrenceConnection {
+	return &model.IsOccurrenceConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.IsOccurrence) *model.IsOccurrenceEdge {
+			return &model.IsOccurrenceEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// HasMetadataConnection returns the connection of a page of HasMetadata nodes.
+func HasMetadataConnection(p *Page[*model.HasMetadata]) *model.HasMetadataConnection {
+	return &model.HasMetadataConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.HasMetadata) *model.HasMetadataEdge {
+			return &model.HasMetadataEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// PkgEqualConnection returns the connection of a page of PkgEqual nodes.
+func PkgEqualConnection(p *Page[*model.PkgEqual]) *model.PkgEqualConnection {
+	return &model.PkgEqualConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.PkgEqual) *model.PkgEqualEdge {
+			return &model.PkgEqualEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// VulnEqualConnection returns the connection of a page of VulnEqual nodes.
+func VulnEqualConnection(p *Page[*model.VulnEqual]) *model.VulnEqualConnection {
+	return &model.VulnEqualConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.VulnEqual) *model.VulnEqualEdge {
+			return &model.VulnEqualEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
+
+// VulnerabilityMetadataConnection returns the connection of a page of VulnerabilityMetadata nodes.
+func VulnerabilityMetadataConnection(p *Page[*model.VulnerabilityMetadata]) *model.VulnerabilityMetadataConnection {
+	return &model.VulnerabilityMetadataConnection{
+		TotalCount: p.TotalCount,
+		PageInfo:   p.PageInfo,
+		Edges: edges(p, func(cursor string, n *model.VulnerabilityMetadata) *model.VulnerabilityMetadataEdge {
+			return &model.VulnerabilityMetadataEdge{Cursor: cursor, Node: n}
+		}),
+	}
+}
@@ -102,3 +102,12 @@ func ConvertLicenseInputSpecToLicenseSpec(licenseInput *model.LicenseInputSpec)
 		ListVersion: licenseInput.ListVersion,
 	}
 }
+
+// DependencyScopeOrUnknown returns the scope of an ingested dependency, which
+// is UNKNOWN when the input does not specify it.
+func DependencyScopeOrUnknown(scope *model.DependencyScope) model.DependencyScope {
+	if scope == nil {
+		return model.DependencyScopeUnknown
+	}
+	return *scope
+}
@@ -0,0 +1,112 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ValidatePaginationFirst checks that the requested page size is not negative.
+func ValidatePaginationFirst(first *int) error {
+	if first != nil && *first < 0 {
+		return gqlerror.Errorf("first must not be negative, got %d", *first)
+	}
+	return nil
+}
+
+// Page is a page of the results of a list query, with the cursor of each of
+// its nodes and the number of results of the whole query.
+type Page[T any] struct {
+	Nodes      []T
+	Cursors    []string
+	PageInfo   *model.PageInfo
+	TotalCount int
+}
+
+// Paginate returns the page of nodes that follows the after cursor, for
+// queries whose results can only be paged once they are all in memory.
+//
+// Cursors are node IDs and nodes are ordered by CompareIDs, so the cursor does
+// not need to point to a node that is still present. At most first nodes are
+// returned, or all the remaining nodes if first is nil. The input slice is not
+// modified.
+func Paginate[T any](nodes []T, nodeID func(T) string, after *string, first *int) (*Page[T], error) {
+	if err := ValidatePaginationFirst(first); err != nil {
+		return nil, err
+	}
+
+	sorted := slices.Clone(nodes)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return CompareIDs(nodeID(a), nodeID(b))
+	})
+
+	start := 0
+	if after != nil {
+		start = sort.Search(len(sorted), func(i int) bool {
+			return CompareIDs(nodeID(sorted[i]), *after) > 0
+		})
+	}
+	end := len(sorted)
+	if first != nil && start+*first < end {
+		end = start + *first
+	}
+
+	p := &Page[T]{
+		Nodes:      sorted[start:end],
+		PageInfo:   &model.PageInfo{HasNextPage: end < len(sorted)},
+		TotalCount: len(nodes),
+	}
+	for _, n := range p.Nodes {
+		p.Cursors = append(p.Cursors, nodeID(n))
+	}
+	p.SetCursors()
+	return p, nil
+}
+
+// SetCursors sets the start and end cursors of the PageInfo of the page.
+func (p *Page[T]) SetCursors() {
+	if len(p.Cursors) > 0 {
+		startCursor := p.Cursors[0]
+		endCursor := p.Cursors[len(p.Cursors)-1]
+		p.PageInfo.StartCursor = &startCursor
+		p.PageInfo.EndCursor = &endCursor
+	}
+}
+
+// CompareIDs orders node IDs. IDs that are both unsigned integers (as
+// generated by most backends) are compared numerically, everything else is
+// compared lexicographically.
+func CompareIDs(a, b string) int {
+	na, errA := strconv.ParseUint(a, 10, 64)
+	nb, errB := strconv.ParseUint(b, 10, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
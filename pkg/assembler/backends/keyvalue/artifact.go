@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -83,40 +84,49 @@ func (n *artStruct) BuildModelNode(ctx context.Context, c *demoClient) (model.No
 }
 
 func (n *artStruct) setOccurrences(ctx context.Context, ID string, c *demoClient) error {
-	n.Occurrences = append(n.Occurrences, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.Occurrences = append(n.Occurrences, ID)
+	})
 }
 func (n *artStruct) setHashEquals(ctx context.Context, ID string, c *demoClient) error {
-	n.HashEquals = append(n.HashEquals, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.HashEquals = append(n.HashEquals, ID)
+	})
 }
 func (n *artStruct) setHasSBOMs(ctx context.Context, ID string, c *demoClient) error {
-	n.HasSBOMs = append(n.HasSBOMs, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.HasSBOMs = append(n.HasSBOMs, ID)
+	})
 }
 func (n *artStruct) setHasSLSAs(ctx context.Context, ID string, c *demoClient) error {
-	n.HasSLSAs = append(n.HasSLSAs, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.HasSLSAs = append(n.HasSLSAs, ID)
+	})
 }
 func (n *artStruct) setVexLinks(ctx context.Context, ID string, c *demoClient) error {
-	n.VexLinks = append(n.VexLinks, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.VexLinks = append(n.VexLinks, ID)
+	})
 }
 func (n *artStruct) setCertifyBadLinks(ctx context.Context, ID string, c *demoClient) error {
-	n.BadLinks = append(n.BadLinks, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.BadLinks = append(n.BadLinks, ID)
+	})
 }
 func (n *artStruct) setCertifyGoodLinks(ctx context.Context, ID string, c *demoClient) error {
-	n.GoodLinks = append(n.GoodLinks, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.GoodLinks = append(n.GoodLinks, ID)
+	})
 }
 func (n *artStruct) setHasMetadataLinks(ctx context.Context, ID string, c *demoClient) error {
-	n.HasMetadataLinks = append(n.HasMetadataLinks, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.HasMetadataLinks = append(n.HasMetadataLinks, ID)
+	})
 }
 func (n *artStruct) setPointOfContactLinks(ctx context.Context, ID string, c *demoClient) error {
-	n.PointOfContactLinks = append(n.PointOfContactLinks, ID)
-	return setkv(ctx, artCol, n, c)
+	return updatekv(ctx, artCol, n, c, func(n *artStruct) {
+		n.PointOfContactLinks = append(n.PointOfContactLinks, ID)
+	})
 }
 
 func (n *artStruct) Key() string {
@@ -166,9 +176,13 @@ func (c *demoClient) ingestArtifact(ctx context.Context, artifact *model.Artifac
 		Digest:    digest,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
+	if !readOnly {
+		unlock := c.keys.lock(artCol, inA.Key())
+		defer unlock()
+	}
 	outA, err := byKeykv[*artStruct](ctx, artCol, inA.Key(), c)
 
 	if err != nil {
@@ -182,7 +196,9 @@ func (c *demoClient) ingestArtifact(ctx context.Context, artifact *model.Artifac
 			c.m.RLock() // relock so that defer unlock does not panic
 			return a, err
 		}
-		inA.ThisID = c.getNextID()
+		if inA.ThisID, err = c.getNextID(ctx); err != nil {
+			return "", err
+		}
 		if err := c.addToIndex(ctx, artCol, inA); err != nil {
 			return "", err
 		}
@@ -228,42 +244,59 @@ func (c *demoClient) artifactExact(ctx context.Context, artifactSpec *model.Arti
 func (c *demoClient) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
-	a, err := c.artifactExact(ctx, artifactSpec)
+	q, err := c.artifactsQuery(ctx, artifactSpec)
 	if err != nil {
 		return nil, gqlerror.Errorf("Artifacts :: invalid spec %s", err)
 	}
-	if a != nil {
-		return []*model.Artifact{c.convArtifact(a)}, nil
-	}
+	return q.all(ctx, c)
+}
 
-	algorithm := strings.ToLower(nilToEmpty(artifactSpec.Algorithm))
-	digest := strings.ToLower(nilToEmpty(artifactSpec.Digest))
-	var rv []*model.Artifact
-	artKeys, err := c.kv.Keys(ctx, artCol)
+// artifactsQuery returns the artifacts query, narrowed to the artifact the
+// spec names exactly, if any.
+func (c *demoClient) artifactsQuery(ctx context.Context, artifactSpec *model.ArtifactSpec) (*nodeQuery[*artStruct, *model.ArtifactSpec, *model.Artifact], error) {
+	a, err := c.artifactExact(ctx, artifactSpec)
 	if err != nil {
 		return nil, err
 	}
-	for _, ak := range artKeys {
-		a, err := byKeykv[*artStruct](ctx, artCol, ak, c)
-		if err != nil {
-			return nil, err
-		}
-
-		matchAlgorithm := false
-		if algorithm == "" || algorithm == a.Algorithm {
-			matchAlgorithm = true
-		}
+	if a != nil {
+		// an exact match is returned whatever the rest of the spec
+		return newNodeQuery(artCol, nil, c.addArtifactIfMatch).withID(a.ThisID), nil
+	}
+	q := newNodeQuery(artCol, artifactSpec, c.addArtifactIfMatch)
+	if artifactSpec != nil && artifactSpec.Algorithm != nil {
+		// keys start with the algorithm
+		q.withPrefix(strings.ToLower(*artifactSpec.Algorithm) + ":")
+	}
+	return q, nil
+}
 
-		matchDigest := false
-		if digest == "" || digest == a.Digest {
-			matchDigest = true
+func (c *demoClient) addArtifactIfMatch(_ context.Context, out []*model.Artifact,
+	artifactSpec *model.ArtifactSpec, a *artStruct) ([]*model.Artifact, error) {
+	if artifactSpec != nil {
+		algorithm := strings.ToLower(nilToEmpty(artifactSpec.Algorithm))
+		digest := strings.ToLower(nilToEmpty(artifactSpec.Digest))
+		if algorithm != "" && algorithm != a.Algorithm ||
+			digest != "" && digest != a.Digest {
+			return out, nil
 		}
+	}
+	return append(out, c.convArtifact(a)), nil
+}
 
-		if matchDigest && matchAlgorithm {
-			rv = append(rv, c.convArtifact(a))
-		}
+// ArtifactsList returns a page of the artifacts query results, in the order of
+// their keys.
+func (c *demoClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.artifactsQuery(ctx, artifactSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("ArtifactsList :: invalid spec %s", err)
+	}
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("ArtifactsList :: %v", err)
 	}
-	return rv, nil
+	return helper.ArtifactConnection(p), nil
 }
 
 func (c *demoClient) convArtifact(a *artStruct) *model.Artifact {
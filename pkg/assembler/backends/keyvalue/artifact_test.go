@@ -357,6 +357,78 @@ func Test_demoClient_Artifacts(t *testing.T) {
 	}
 }
 
+func Test_demoClient_ArtifactsList(t *testing.T) {
+	ctx := context.Background()
+	artifacts := []*model.ArtifactInputSpec{{
+		Algorithm: "sha256",
+		Digest:    "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+	}, {
+		Algorithm: "sha1",
+		Digest:    "7a8f47318e4676dacb0142afa0b83029cd7befd9",
+	}, {
+		Algorithm: "sha512",
+		Digest:    "374ab8f711235830769aa5f0b31ce9b72c5670074b34cb302cdafe3b606233ee92ee01e298e5701f15cc7087714cd9abd7ddb838a6e1206b3642de16d9fc9dd7",
+	}}
+	tests := []struct {
+		name      string
+		first     *int
+		wantPages [][]string
+		wantErr   bool
+	}{{
+		name:      "one page",
+		wantPages: [][]string{{"sha1", "sha256", "sha512"}},
+	}, {
+		name:      "page size 2",
+		first:     ptrfrom.Int(2),
+		wantPages: [][]string{{"sha1", "sha256"}, {"sha512"}},
+	}, {
+		name:      "page size 3",
+		first:     ptrfrom.Int(3),
+		wantPages: [][]string{{"sha1", "sha256", "sha512"}},
+	}, {
+		name:    "negative page size",
+		first:   ptrfrom.Int(-1),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := getBackend(ctx, nil)
+			if _, err := c.IngestArtifacts(ctx, artifacts); err != nil {
+				t.Fatalf("demoClient.IngestArtifacts() error = %v", err)
+			}
+			var gotPages [][]string
+			var after *string
+			for {
+				got, err := c.ArtifactsList(ctx, &model.ArtifactSpec{}, after, tt.first)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("demoClient.ArtifactsList() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+				if got.TotalCount != len(artifacts) {
+					t.Errorf("TotalCount = %d, want %d", got.TotalCount, len(artifacts))
+				}
+				var page []string
+				for _, edge := range got.Edges {
+					page = append(page, edge.Node.Algorithm)
+				}
+				if n := len(got.Edges); n > 0 && *got.PageInfo.EndCursor != got.Edges[n-1].Cursor {
+					t.Errorf("EndCursor = %s, want cursor of last edge %s", *got.PageInfo.EndCursor, got.Edges[n-1].Cursor)
+				}
+				gotPages = append(gotPages, page)
+				if !got.PageInfo.HasNextPage {
+					break
+				}
+				after = got.PageInfo.EndCursor
+			}
+			if diff := cmp.Diff(tt.wantPages, gotPages); diff != "" {
+				t.Errorf("Unexpected pages. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_demoClient_buildArtifactResponse(t *testing.T) {
 	ctx := context.Background()
 	tests := []struct {
@@ -24,7 +24,6 @@ import (
 	"slices"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
@@ -109,6 +108,16 @@ const (
 	cVulnCol    = "certifyVulns"
 )
 
+// nodeCols are the collections of all node types, the ones handled by
+// typeColMap.
+var nodeCols = []string{
+	artCol, occCol, pkgTypeCol, pkgNSCol, pkgNameCol, pkgVerCol, isDepCol,
+	hasMDCol, hasSBOMCol, srcTypeCol, srcNSCol, srcNameCol, cgCol, cbCol,
+	builderCol, licenseCol, clCol, cscCol, slsaCol, hsaCol, hashEqCol,
+	pkgEqCol, pocCol, vulnTypeCol, vulnIDCol, vulnEqCol, vulnMDCol, cVEXCol,
+	cVulnCol,
+}
+
 func typeColMap(col string) node {
 	switch col {
 	case artCol:
@@ -173,16 +182,11 @@ func typeColMap(col string) node {
 	return &artStruct{}
 }
 
-// atomic add to ensure ID is not duplicated
-func (c *demoClient) getNextID() string {
-	atomic.AddUint32(&c.id, 1)
-	return fmt.Sprintf("%d", c.id)
-}
-
 type demoClient struct {
-	id uint32
-	m  sync.RWMutex
-	kv kv.Store
+	ids  idAllocator
+	m    sync.RWMutex
+	keys keyLocks
+	kv   kv.Store
 }
 
 func getBackend(ctx context.Context, opts backends.BackendArgs) (backends.Backend, error) {
@@ -191,7 +195,13 @@ func getBackend(ctx context.Context, opts backends.BackendArgs) (backends.Backen
 	if !ok {
 		store = memmap.GetStore()
 	}
-	return &demoClient{kv: store}, nil
+	c := &demoClient{kv: store}
+	collisions, err := c.migrateIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate keyvalue IDs: %w", err)
+	}
+	logIDCollisions(ctx, collisions)
+	return c, nil
 }
 
 func noMatch(filter *string, value string) bool {
@@ -245,6 +255,46 @@ func byKeykv[E node](ctx context.Context, coll string, k string, c *demoClient)
 	return nl, err
 }
 
+// scanKV calls f with every node of a collection, reading the collection a
+// page at a time instead of loading it whole.
+func scanKV[E node](ctx context.Context, coll string, c *demoClient, f func(E) error) error {
+	return scanKVPrefix(ctx, coll, "", c, f)
+}
+
+// scanKVPrefix is scanKV for the nodes whose keys start with prefix.
+func scanKVPrefix[E node](ctx context.Context, coll, prefix string, c *demoClient, f func(E) error) error {
+	var nl E
+	if err := validateType(nl, coll); err != nil {
+		return err
+	}
+	return c.scanNodes(ctx, coll, prefix, func(n node) error {
+		return f(n.(E))
+	})
+}
+
+// scanNodes is scanKVPrefix for callers that iterate over several
+// collections, and so only know the type of the nodes at run time.
+func (c *demoClient) scanNodes(ctx context.Context, coll, prefix string, f func(node) error) error {
+	sc := kv.NewScanner(c.kv, coll, prefix, 0)
+	for sc.Next(ctx) {
+		nodes := reflect.New(reflect.SliceOf(reflect.TypeOf(typeColMap(coll))))
+		if err := c.kv.MultiGet(ctx, coll, sc.Keys(), nodes.Interface()); err != nil {
+			return err
+		}
+		for i := 0; i < nodes.Elem().Len(); i++ {
+			n := nodes.Elem().Index(i)
+			// removed since the page was scanned
+			if n.IsNil() {
+				continue
+			}
+			if err := f(n.Interface().(node)); err != nil {
+				return err
+			}
+		}
+	}
+	return sc.Err()
+}
+
 func setkv(ctx context.Context, coll string, n node, c *demoClient) error {
 	// validate type?
 	return c.kv.Set(ctx, coll, n.Key(), n)
@@ -266,22 +316,6 @@ func validateType[E node](n E, c string) error {
 		reflect.TypeOf(typeColMap(c)), reflect.TypeOf(n))
 }
 
-func lock(m *sync.RWMutex, readOnly bool) {
-	if readOnly {
-		m.RLock()
-	} else {
-		m.Lock()
-	}
-}
-
-func unlock(m *sync.RWMutex, readOnly bool) {
-	if readOnly {
-		m.RUnlock()
-	} else {
-		m.Unlock()
-	}
-}
-
 func timeKey(t time.Time) string {
 	return fmt.Sprint(t.Unix())
 }
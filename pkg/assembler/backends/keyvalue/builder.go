@@ -21,6 +21,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -49,8 +50,9 @@ func (b *builderStruct) BuildModelNode(ctx context.Context, c *demoClient) (mode
 }
 
 func (n *builderStruct) setHasSLSAs(ctx context.Context, id string, c *demoClient) error {
-	n.HasSLSAs = append(n.HasSLSAs, id)
-	return setkv(ctx, builderCol, n, c)
+	return updatekv(ctx, builderCol, n, c, func(n *builderStruct) {
+		n.HasSLSAs = append(n.HasSLSAs, id)
+	})
 }
 
 func (c *demoClient) builderByInput(ctx context.Context, b *model.BuilderInputSpec) (*builderStruct, error) {
@@ -85,9 +87,13 @@ func (c *demoClient) ingestBuilder(ctx context.Context, builder *model.BuilderIn
 		URI: builder.URI,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
+	if !readOnly {
+		unlock := c.keys.lock(builderCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*builderStruct](ctx, builderCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -101,7 +107,9 @@ func (c *demoClient) ingestBuilder(ctx context.Context, builder *model.BuilderIn
 		c.m.RLock() // relock so that defer unlock does not panic
 		return b, err
 	}
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, builderCol, in); err != nil {
 		return "", err
 	}
@@ -116,26 +124,45 @@ func (c *demoClient) ingestBuilder(ctx context.Context, builder *model.BuilderIn
 func (c *demoClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
+	q, err := c.buildersQuery(ctx, builderSpec)
+	if err != nil {
+		return nil, err
+	}
+	return q.all(ctx, c)
+}
+
+// buildersQuery returns the builders query, narrowed to the builder the spec
+// names exactly, if any.
+func (c *demoClient) buildersQuery(ctx context.Context, builderSpec *model.BuilderSpec) (*nodeQuery[*builderStruct, *model.BuilderSpec, *model.Builder], error) {
+	q := newNodeQuery(builderCol, builderSpec, c.addBuilder)
 	b, err := c.exactBuilder(ctx, builderSpec)
 	if err != nil {
 		return nil, err
 	}
 	if b != nil {
-		return []*model.Builder{c.convBuilder(b)}, nil
+		return q.withID(b.ThisID), nil
 	}
-	var builders []*model.Builder
-	bKeys, err := c.kv.Keys(ctx, builderCol)
+	return q, nil
+}
+
+func (c *demoClient) addBuilder(_ context.Context, out []*model.Builder, _ *model.BuilderSpec, b *builderStruct) ([]*model.Builder, error) {
+	return append(out, c.convBuilder(b)), nil
+}
+
+// BuildersList returns a page of the builders query results, in the order of
+// their keys.
+func (c *demoClient) BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.buildersQuery(ctx, builderSpec)
 	if err != nil {
 		return nil, err
 	}
-	for _, bk := range bKeys {
-		b, err := byKeykv[*builderStruct](ctx, builderCol, bk, c)
-		if err != nil {
-			return nil, err
-		}
-		builders = append(builders, c.convBuilder(b))
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("BuildersList :: %v", err)
 	}
-	return builders, nil
+	return helper.BuilderConnection(p), nil
 }
 
 func (c *demoClient) convBuilder(b *builderStruct) *model.Builder {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -38,6 +39,8 @@ type badLink struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 	KnownSince    time.Time
 }
 
@@ -114,11 +117,13 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 		Justification: certifyBad.Justification,
 		Origin:        certifyBad.Origin,
 		Collector:     certifyBad.Collector,
+		Verified:      certifyBad.Verified,
+		Signer:        certifyBad.Signer,
 		KnownSince:    certifyBad.KnownSince.UTC(),
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	var foundPkgNameorVersionNode pkgNameOrVersion
 	var foundArtStruct *artStruct
@@ -147,6 +152,10 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 		in.SourceID = foundSrcName.ThisID
 	}
 
+	if !readOnly {
+		unlock := c.keys.lock(cbCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*badLink](ctx, cbCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -161,11 +170,16 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 		c.m.RLock() // relock so that defer unlock does not panic
 		return b, err
 	}
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 
 	if err := c.addToIndex(ctx, cbCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, cbCol, in, c); err != nil {
+		return "", err
+	}
 	if foundPkgNameorVersionNode != nil {
 		if err := foundPkgNameorVersionNode.setCertifyBadLinks(ctx, in.ThisID, c); err != nil {
 			return "", err
@@ -179,9 +193,6 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 			return "", err
 		}
 	}
-	if err := setkv(ctx, cbCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -206,60 +217,64 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 		return []*model.CertifyBad{foundCertifyBad}, nil
 	}
 
+	q, err := c.certifyBadQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// certifyBadQuery returns the CertifyBad query, narrowed to the links of the
+// nodes the filter names, if any.
+func (c *demoClient) certifyBadQuery(ctx context.Context, filter *model.CertifyBadSpec) (*nodeQuery[*badLink, *model.CertifyBadSpec, *model.CertifyBad], error) {
+	q := newNodeQuery(cbCol, filter, c.addCBIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	// Cant really search for an exact Pkg, as these can be linked to either
 	// names or versions, and version could be empty.
-	var search []string
-	foundOne := false
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactArtifact != nil {
-			search = append(search, exactArtifact.BadLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactArtifact.BadLinks...)
+			q.searched = true
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = append(search, exactSource.BadLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactSource.BadLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.CertifyBad
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*badLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addCBIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		cgKeys, err := c.kv.Keys(ctx, cbCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, cgk := range cgKeys {
-			link, err := byKeykv[*badLink](ctx, cbCol, cgk, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addCBIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// CertifyBadList returns a page of the CertifyBad query results, in the
+// order of their keys.
+func (c *demoClient) CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.certifyBadQuery(ctx, certifyBadSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyBadList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyBadList :: %v", err)
+	}
+	return helper.CertifyBadConnection(p), nil
 }
 
 func (c *demoClient) addCBIfMatch(ctx context.Context, out []*model.CertifyBad,
@@ -362,6 +377,8 @@ func (c *demoClient) buildCertifyBad(ctx context.Context, link *badLink, filter
 		Justification: link.Justification,
 		Origin:        link.Origin,
 		Collector:     link.Collector,
+		Verified:      link.Verified,
+		Signer:        link.Signer,
 		KnownSince:    link.KnownSince.UTC(),
 	}
 	return &certifyBad, nil
@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -36,6 +37,8 @@ type goodLink struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 	KnownSince    time.Time
 }
 
@@ -113,11 +116,13 @@ func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.Packag
 		Justification: certifyGood.Justification,
 		Origin:        certifyGood.Origin,
 		Collector:     certifyGood.Collector,
+		Verified:      certifyGood.Verified,
+		Signer:        certifyGood.Signer,
 		KnownSince:    certifyGood.KnownSince.UTC(),
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	var foundPkgNameorVersionNode pkgNameOrVersion
 	var foundArtStrct *artStruct
@@ -146,6 +151,10 @@ func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.Packag
 		in.SourceID = foundSrcName.ThisID
 	}
 
+	if !readOnly {
+		unlock := c.keys.lock(cgCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*goodLink](ctx, cgCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -160,11 +169,16 @@ func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.Packag
 		c.m.RLock() // relock so that defer unlock does not panic
 		return b, err
 	}
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 
 	if err := c.addToIndex(ctx, cgCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, cgCol, in, c); err != nil {
+		return "", err
+	}
 	if foundPkgNameorVersionNode != nil {
 		if err := foundPkgNameorVersionNode.setCertifyGoodLinks(ctx, in.ThisID, c); err != nil {
 			return "", err
@@ -178,9 +192,6 @@ func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.Packag
 			return "", err
 		}
 	}
-	if err := setkv(ctx, cgCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -205,60 +216,64 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 		return []*model.CertifyGood{foundCertifyGood}, nil
 	}
 
+	q, err := c.certifyGoodQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// certifyGoodQuery returns the CertifyGood query, narrowed to the links of
+// the nodes the filter names, if any.
+func (c *demoClient) certifyGoodQuery(ctx context.Context, filter *model.CertifyGoodSpec) (*nodeQuery[*goodLink, *model.CertifyGoodSpec, *model.CertifyGood], error) {
+	q := newNodeQuery(cgCol, filter, c.addCGIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	// Cant really search for an exact Pkg, as these can be linked to either
 	// names or versions, and version could be empty.
-	var search []string
-	foundOne := false
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactArtifact != nil {
-			search = append(search, exactArtifact.GoodLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactArtifact.GoodLinks...)
+			q.searched = true
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = append(search, exactSource.GoodLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactSource.GoodLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.CertifyGood
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*goodLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addCGIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		cgKeys, err := c.kv.Keys(ctx, cgCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, cgk := range cgKeys {
-			link, err := byKeykv[*goodLink](ctx, cgCol, cgk, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addCGIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// CertifyGoodList returns a page of the CertifyGood query results, in the
+// order of their keys.
+func (c *demoClient) CertifyGoodList(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.certifyGoodQuery(ctx, certifyGoodSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyGoodList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyGoodList :: %v", err)
+	}
+	return helper.CertifyGoodConnection(p), nil
 }
 
 func (c *demoClient) addCGIfMatch(ctx context.Context, out []*model.CertifyGood,
@@ -361,6 +376,8 @@ func (c *demoClient) buildCertifyGood(ctx context.Context, link *goodLink, filte
 		Justification: link.Justification,
 		Origin:        link.Origin,
 		Collector:     link.Collector,
+		Verified:      link.Verified,
+		Signer:        link.Signer,
 		KnownSince:    link.KnownSince.UTC(),
 	}
 	return &certifyGood, nil
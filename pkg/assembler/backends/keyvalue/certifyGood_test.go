@@ -137,6 +137,49 @@ func TestCertifyGood(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Trust kept from first ingestion",
+			InPkg: []*model.PkgInputSpec{p1},
+			Calls: []call{
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: p1,
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					CG: &model.CertifyGoodInputSpec{
+						Justification: "test justification",
+						Verified:      ptrfrom.Bool(true),
+						Signer:        ptrfrom.String("release"),
+					},
+				},
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: p1,
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					CG: &model.CertifyGoodInputSpec{
+						Justification: "test justification",
+						Verified:      ptrfrom.Bool(false),
+						Signer:        ptrfrom.String("someone"),
+					},
+				},
+			},
+			Query: &model.CertifyGoodSpec{
+				Justification: ptrfrom.String("test justification"),
+			},
+			ExpCG: []*model.CertifyGood{
+				{
+					Subject:       p1out,
+					Justification: "test justification",
+					Verified:      ptrfrom.Bool(true),
+					Signer:        ptrfrom.String("release"),
+				},
+			},
+		},
 		{
 			Name:  "Query on Justification",
 			InPkg: []*model.PkgInputSpec{p1},
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -43,6 +44,8 @@ type certifyLegalStruct struct {
 	TimeScanned        time.Time
 	Origin             string
 	Collector          string
+	Verified           *bool
+	Signer             *string
 }
 
 func (n *certifyLegalStruct) ID() string { return n.ThisID }
@@ -120,10 +123,12 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 		Justification:     certifyLegal.Justification,
 		Origin:            certifyLegal.Origin,
 		Collector:         certifyLegal.Collector,
+		Verified:          certifyLegal.Verified,
+		Signer:            certifyLegal.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	var dec []string
 	for _, lis := range declaredLicenses {
@@ -167,6 +172,10 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 		in.Source = src.ThisID
 	}
 
+	if !readOnly {
+		unlock := c.keys.lock(clCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*certifyLegalStruct](ctx, clCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -181,11 +190,16 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 		c.m.RLock() // relock so that defer unlock does not panic
 		return o, err
 	}
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 
 	if err := c.addToIndex(ctx, clCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, clCol, in, c); err != nil {
+		return "", err
+	}
 	if pkg != nil {
 		if err := pkg.setCertifyLegals(ctx, in.ThisID, c); err != nil {
 			return "", err
@@ -213,9 +227,6 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 			return "", err
 		}
 	}
-	if err := setkv(ctx, clCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -230,6 +241,8 @@ func (c *demoClient) convLegal(ctx context.Context, in *certifyLegalStruct) (*mo
 		TimeScanned:       in.TimeScanned,
 		Origin:            in.Origin,
 		Collector:         in.Collector,
+		Verified:          in.Verified,
+		Signer:            in.Signer,
 	}
 	for _, lid := range in.DeclaredLicenses {
 		l, err := byIDkv[*licStruct](ctx, lid, c)
@@ -281,84 +294,88 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 		return []*model.CertifyLegal{o}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.certifyLegalQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// certifyLegalQuery returns the CertifyLegal query, narrowed to the links of
+// the nodes the filter names, if any.
+func (c *demoClient) certifyLegalQuery(ctx context.Context, filter *model.CertifyLegalSpec) (*nodeQuery[*certifyLegalStruct, *model.CertifyLegalSpec, *model.CertifyLegal], error) {
+	q := newNodeQuery(clCol, filter, c.addLegalIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	if filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
-		foundOne = len(pkgs) > 0
+		q.searched = len(pkgs) > 0
 		for _, pkg := range pkgs {
-			search = append(search, pkg.CertifyLegals...)
+			q.ids = append(q.ids, pkg.CertifyLegals...)
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = append(search, exactSource.CertifyLegals...)
-			foundOne = true
+			q.ids = append(q.ids, exactSource.CertifyLegals...)
+			q.searched = true
 		}
 	}
-	if !foundOne && filter != nil {
+	if !q.searched && filter != nil {
 		for _, lSpec := range filter.DeclaredLicenses {
 			exactLicense, err := c.licenseExact(ctx, lSpec)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, err
 			}
 			if exactLicense != nil {
-				search = append(search, exactLicense.CertifyLegals...)
-				foundOne = true
+				q.ids = append(q.ids, exactLicense.CertifyLegals...)
+				q.searched = true
 				break
 			}
 		}
 	}
-	if !foundOne && filter != nil {
+	if !q.searched && filter != nil {
 		for _, lSpec := range filter.DiscoveredLicenses {
 			exactLicense, err := c.licenseExact(ctx, lSpec)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, err
 			}
 			if exactLicense != nil {
-				search = append(search, exactLicense.CertifyLegals...)
-				foundOne = true
+				q.ids = append(q.ids, exactLicense.CertifyLegals...)
+				q.searched = true
 				break
 			}
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.CertifyLegal
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*certifyLegalStruct](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addLegalIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		clKeys, err := c.kv.Keys(ctx, clCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, clk := range clKeys {
-			link, err := byKeykv[*certifyLegalStruct](ctx, clCol, clk, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addLegalIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// CertifyLegalList returns a page of the CertifyLegal query results, in the
+// order of their keys.
+func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.certifyLegalQuery(ctx, certifyLegalSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyLegalList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyLegalList :: %v", err)
+	}
+	return helper.CertifyLegalConnection(p), nil
 }
 
 func (c *demoClient) addLegalIfMatch(ctx context.Context, out []*model.CertifyLegal,
@@ -25,6 +25,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -40,6 +41,8 @@ type scorecardLink struct {
 	ScorecardCommit  string
 	Origin           string
 	Collector        string
+	Verified         *bool
+	Signer           *string
 }
 
 func (n *scorecardLink) ID() string { return n.ThisID }
@@ -98,10 +101,12 @@ func (c *demoClient) certifyScorecard(ctx context.Context, source model.SourceIn
 		ScorecardCommit:  scorecard.ScorecardCommit,
 		Origin:           scorecard.Origin,
 		Collector:        scorecard.Collector,
+		Verified:         scorecard.Verified,
+		Signer:           scorecard.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	srcName, err := c.getSourceNameFromInput(ctx, source)
 	if err != nil {
@@ -109,6 +114,10 @@ func (c *demoClient) certifyScorecard(ctx context.Context, source model.SourceIn
 	}
 	in.SourceID = srcName.ThisID
 
+	if !readOnly {
+		unlock := c.keys.lock(cscCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*scorecardLink](ctx, cscCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -124,16 +133,18 @@ func (c *demoClient) certifyScorecard(ctx context.Context, source model.SourceIn
 		return s, err
 	}
 
-	in.ThisID = c.getNextID()
-	if err := c.addToIndex(ctx, cscCol, in); err != nil {
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
 		return "", err
 	}
-	if err := srcName.setScorecardLinks(ctx, in.ThisID, c); err != nil {
+	if err := c.addToIndex(ctx, cscCol, in); err != nil {
 		return "", err
 	}
 	if err := setkv(ctx, cscCol, in, c); err != nil {
 		return "", err
 	}
+	if err := srcName.setScorecardLinks(ctx, in.ThisID, c); err != nil {
+		return "", err
+	}
 
 	return in.ThisID, nil
 }
@@ -157,49 +168,52 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		return []*model.CertifyScorecard{foundCertifyScorecard}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.scorecardsQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// scorecardsQuery returns the Scorecards query, narrowed to the links of the
+// nodes the filter names, if any.
+func (c *demoClient) scorecardsQuery(ctx context.Context, filter *model.CertifyScorecardSpec) (*nodeQuery[*scorecardLink, *model.CertifyScorecardSpec, *model.CertifyScorecard], error) {
+	q := newNodeQuery(cscCol, filter, c.addSCIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	if filter != nil && filter.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = exactSource.ScorecardLinks
-			foundOne = true
+			q.ids = exactSource.ScorecardLinks
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.CertifyScorecard
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*scorecardLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addSCIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		cscKeys, err := c.kv.Keys(ctx, cscCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, csck := range cscKeys {
-			link, err := byKeykv[*scorecardLink](ctx, cscCol, csck, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addSCIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// ScorecardsList returns a page of the Scorecards query results, in the
+// order of their keys.
+func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.scorecardsQuery(ctx, scorecardSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("ScorecardsList :: %v", err)
 	}
-
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("ScorecardsList :: %v", err)
+	}
+	return helper.CertifyScorecardConnection(p), nil
 }
 
 func (c *demoClient) addSCIfMatch(ctx context.Context, out []*model.CertifyScorecard,
@@ -270,6 +284,8 @@ func (c *demoClient) buildScorecard(ctx context.Context, link *scorecardLink, fi
 			ScorecardCommit:  link.ScorecardCommit,
 			Origin:           link.Origin,
 			Collector:        link.Collector,
+			Verified:         link.Verified,
+			Signer:           link.Signer,
 		},
 	}
 	return &newScorecard, nil
@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -41,6 +42,8 @@ type vexLink struct {
 	Justification   model.VexJustification
 	Origin          string
 	Collector       string
+	Verified        *bool
+	Signer          *string
 }
 
 func (n *vexLink) ID() string { return n.ThisID }
@@ -119,10 +122,12 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 		Justification: vexStatement.VexJustification,
 		Origin:        vexStatement.Origin,
 		Collector:     vexStatement.Collector,
+		Verified:      vexStatement.Verified,
+		Signer:        vexStatement.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	var foundPkgVersionNode *pkgVersion
 	var foundArtStrct *artStruct
@@ -148,6 +153,10 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 	}
 	in.VulnerabilityID = foundVulnNode.ThisID
 
+	if !readOnly {
+		unlock := c.keys.lock(cVEXCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*vexLink](ctx, cVEXCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -163,10 +172,15 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 		return v, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, cVEXCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, cVEXCol, in, c); err != nil {
+		return "", err
+	}
 	// set the backlinks
 	if foundPkgVersionNode != nil {
 		if err := foundPkgVersionNode.setVexLinks(ctx, in.ThisID, c); err != nil {
@@ -180,9 +194,6 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 	if err := foundVulnNode.setVexLinks(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
-	if err := setkv(ctx, cVEXCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -207,69 +218,72 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 		return []*model.CertifyVEXStatement{foundCertifyVex}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.certifyVEXStatementQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// certifyVEXStatementQuery returns the CertifyVEXStatement query, narrowed
+// to the links of the nodes the filter names, if any.
+func (c *demoClient) certifyVEXStatementQuery(ctx context.Context, filter *model.CertifyVEXStatementSpec) (*nodeQuery[*vexLink, *model.CertifyVEXStatementSpec, *model.CertifyVEXStatement], error) {
+	q := newNodeQuery(cVEXCol, filter, c.addVexIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
 
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactArtifact != nil {
-			search = append(search, exactArtifact.VexLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactArtifact.VexLinks...)
+			q.searched = true
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
-		foundOne = len(pkgs) > 0
+		q.searched = len(pkgs) > 0
 		for _, pkg := range pkgs {
-			search = append(search, pkg.VexLinks...)
+			q.ids = append(q.ids, pkg.VexLinks...)
 		}
 	}
-	if !foundOne && filter != nil && filter.Vulnerability != nil {
+	if !q.searched && filter != nil && filter.Vulnerability != nil {
 		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactVuln != nil {
-			search = append(search, exactVuln.VexLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactVuln.VexLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.CertifyVEXStatement
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*vexLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addVexIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		keys, err := c.kv.Keys(ctx, cVEXCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, key := range keys {
-			link, err := byKeykv[*vexLink](ctx, cVEXCol, key, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addVexIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// CertifyVEXStatementList returns a page of the CertifyVEXStatement query
+// results, in the order of their keys.
+func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.certifyVEXStatementQuery(ctx, certifyVEXStatementSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyVEXStatementList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyVEXStatementList :: %v", err)
+	}
+	return helper.CertifyVEXStatementConnection(p), nil
 }
 
 func (c *demoClient) addVexIfMatch(ctx context.Context, out []*model.CertifyVEXStatement,
@@ -395,5 +409,7 @@ func (c *demoClient) buildCertifyVEXStatement(ctx context.Context, link *vexLink
 		KnownSince:       link.KnownSince,
 		Origin:           link.Origin,
 		Collector:        link.Collector,
+		Verified:         link.Verified,
+		Signer:           link.Signer,
 	}, nil
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -41,6 +42,8 @@ type certifyVulnerabilityLink struct {
 	ScannerVersion  string
 	Origin          string
 	Collector       string
+	Verified        *bool
+	Signer          *string
 }
 
 func (n *certifyVulnerabilityLink) ID() string { return n.ThisID }
@@ -101,10 +104,12 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.P
 		ScannerVersion: certifyVuln.ScannerVersion,
 		Origin:         certifyVuln.Origin,
 		Collector:      certifyVuln.Collector,
+		Verified:       certifyVuln.Verified,
+		Signer:         certifyVuln.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	foundPackage, err := c.getPackageVerFromInput(ctx, packageArg)
 	if err != nil {
@@ -118,6 +123,10 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.P
 	}
 	in.VulnerabilityID = foundVulnNode.ThisID
 
+	if !readOnly {
+		unlock := c.keys.lock(cVulnCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*certifyVulnerabilityLink](ctx, cVulnCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -133,10 +142,15 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.P
 		return cv, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, cVulnCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, cVulnCol, in, c); err != nil {
+		return "", err
+	}
 	// set the backlinks
 	if err := foundPackage.setVulnerabilityLinks(ctx, in.ThisID, c); err != nil {
 		return "", err
@@ -144,9 +158,6 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.P
 	if err := foundVulnNode.setVulnerabilityLinks(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
-	if err := setkv(ctx, cVulnCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -171,79 +182,81 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 		return []*model.CertifyVuln{foundCertifyVuln}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.certifyVulnQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// certifyVulnQuery returns the CertifyVuln query, narrowed to the links of
+// the nodes the filter names, if any.
+func (c *demoClient) certifyVulnQuery(ctx context.Context, filter *model.CertifyVulnSpec) (*nodeQuery[*certifyVulnerabilityLink, *model.CertifyVulnSpec, *model.CertifyVuln], error) {
+	q := newNodeQuery(cVulnCol, filter, c.addCVIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
 
 	if filter != nil && filter.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
-		foundOne = len(pkgs) > 0
+		q.searched = len(pkgs) > 0
 		for _, pkg := range pkgs {
-			search = append(search, pkg.CertifyVulnLinks...)
+			q.ids = append(q.ids, pkg.CertifyVulnLinks...)
 		}
 	}
 
-	if !foundOne && filter != nil && filter.Vulnerability != nil &&
+	if !q.searched && filter != nil && filter.Vulnerability != nil &&
 		filter.Vulnerability.NoVuln != nil && *filter.Vulnerability.NoVuln {
 		exactVuln, err := c.exactVulnerability(ctx, &model.VulnerabilitySpec{
 			Type:            ptrfrom.String(noVulnType),
 			VulnerabilityID: ptrfrom.String(""),
 		})
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactVuln != nil {
-			search = append(search, exactVuln.CertifyVulnLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactVuln.CertifyVulnLinks...)
+			q.searched = true
 		}
-	} else if !foundOne && filter != nil && filter.Vulnerability != nil {
+	} else if !q.searched && filter != nil && filter.Vulnerability != nil {
 		if filter.Vulnerability.NoVuln != nil && !*filter.Vulnerability.NoVuln {
 			if filter.Vulnerability.Type != nil && *filter.Vulnerability.Type == noVulnType {
-				return []*model.CertifyVuln{}, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
+				return nil, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
 			}
 		}
 		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactVuln != nil {
-			search = append(search, exactVuln.CertifyVulnLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactVuln.CertifyVulnLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.CertifyVuln
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*certifyVulnerabilityLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addCVIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		keys, err := c.kv.Keys(ctx, cVulnCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, key := range keys {
-			link, err := byKeykv[*certifyVulnerabilityLink](ctx, cVulnCol, key, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addCVIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// CertifyVulnList returns a page of the CertifyVuln query results, in the
+// order of their keys.
+func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.certifyVulnQuery(ctx, certifyVulnSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyVulnList :: %v", err)
 	}
-
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("CertifyVulnList :: %v", err)
+	}
+	return helper.CertifyVulnConnection(p), nil
 }
 
 func (c *demoClient) addCVIfMatch(ctx context.Context, out []*model.CertifyVuln,
@@ -347,6 +360,8 @@ func (c *demoClient) buildCertifyVulnerability(ctx context.Context, link *certif
 			ScannerVersion: link.ScannerVersion,
 			Origin:         link.Origin,
 			Collector:      link.Collector,
+			Verified:       link.Verified,
+			Signer:         link.Signer,
 		},
 	}, nil
 }
@@ -0,0 +1,213 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+// dependencyChain returns n package versions of a single package name, and
+// the dependencies of each of them on the next one.
+func dependencyChain(name string, n int) ([]*model.PkgInputSpec, []*model.PkgInputSpec, []*model.IsDependencyInputSpec) {
+	var pkgs, depPkgs []*model.PkgInputSpec
+	var deps []*model.IsDependencyInputSpec
+	for i := 0; i < n; i++ {
+		pkgs = append(pkgs, &model.PkgInputSpec{Type: "golang", Name: name, Version: ptrfrom.String(fmt.Sprintf("v%d", i))})
+		depPkgs = append(depPkgs, &model.PkgInputSpec{Type: "golang", Name: name, Version: ptrfrom.String(fmt.Sprintf("v%d", i+1))})
+		deps = append(deps, &model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Justification: "test", Origin: "test", Collector: "test"})
+	}
+	return pkgs, depPkgs, deps
+}
+
+func TestConcurrentIngest(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, memmap.GetStore())
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	const workers, chain = 8, 20
+	pkgs, depPkgs, deps := dependencyChain("concurrent", chain)
+	versions := append(slices.Clone(pkgs), depPkgs[chain-1])
+
+	var wg sync.WaitGroup
+	ids := make([][]string, workers)
+	errs := make([]error, workers)
+	for w := 0; w < workers; w++ {
+		w := w
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := b.IngestPackages(ctx, versions); err != nil {
+				errs[w] = err
+				return
+			}
+			ids[w], errs[w] = b.IngestDependencies(ctx, pkgs, depPkgs, mSpecific, deps)
+			if errs[w] != nil {
+				return
+			}
+			// queries run alongside the ingestion of the other workers
+			_, errs[w] = b.IsDependency(ctx, &model.IsDependencySpec{Package: &model.PkgSpec{Name: ptrfrom.String("concurrent")}})
+		}()
+	}
+	wg.Wait()
+	for w := 0; w < workers; w++ {
+		if errs[w] != nil {
+			t.Fatalf("worker %d failed: %v", w, errs[w])
+		}
+		if fmt.Sprint(ids[w]) != fmt.Sprint(ids[0]) {
+			t.Errorf("worker %d got IDs %v, worker 0 got %v", w, ids[w], ids[0])
+		}
+	}
+
+	got, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+	if err != nil {
+		t.Fatalf("Could not query IsDependency: %v", err)
+	}
+	if len(got) != chain {
+		t.Errorf("got %d IsDependency nodes, want %d", len(got), chain)
+	}
+	names, err := b.Packages(ctx, &model.PkgSpec{Name: ptrfrom.String("concurrent")})
+	if err != nil {
+		t.Fatalf("Could not query packages: %v", err)
+	}
+	if len(names) != 1 || len(names[0].Namespaces) != 1 || len(names[0].Namespaces[0].Names) != 1 {
+		t.Fatalf("got packages %v, want a single package name", names)
+	}
+	// every worker ingested every version, each must be linked only once
+	if n := len(names[0].Namespaces[0].Names[0].Versions); n != chain+1 {
+		t.Errorf("got %d versions, want %d", n, chain+1)
+	}
+	for _, pkg := range pkgs {
+		deps, err := b.IsDependency(ctx, &model.IsDependencySpec{Package: &model.PkgSpec{Name: ptrfrom.String("concurrent"), Version: pkg.Version}})
+		if err != nil {
+			t.Fatalf("Could not query IsDependency: %v", err)
+		}
+		if len(deps) != 1 {
+			t.Errorf("version %s has %d IsDependency nodes, want 1", *pkg.Version, len(deps))
+		}
+	}
+}
+
+// The benchmarks run each workload serialized by a single mutex, as the
+// backend used to be, and with the locking of the backend alone. Run them
+// with -cpu to see how throughput scales with the number of ingestors.
+
+type benchLock interface {
+	Lock()
+	Unlock()
+}
+
+type noLock struct{}
+
+func (noLock) Lock()   {}
+func (noLock) Unlock() {}
+
+var benchLocks = []struct {
+	name string
+	lock func() benchLock
+}{
+	{"global", func() benchLock { return &sync.Mutex{} }},
+	{"keys", func() benchLock { return noLock{} }},
+}
+
+// ingestDependency ingests a dependency the way guacingest does, ingesting
+// its packages first.
+func ingestDependency(ctx context.Context, be backends.Backend, pkg, depPkg *model.PkgInputSpec, dep *model.IsDependencyInputSpec) error {
+	if _, err := be.IngestPackages(ctx, []*model.PkgInputSpec{pkg, depPkg}); err != nil {
+		return err
+	}
+	_, err := be.IngestDependency(ctx, *pkg, *depPkg, mSpecific, *dep)
+	return err
+}
+
+func BenchmarkIngestDependencies(b *testing.B) {
+	ctx := context.Background()
+	for _, bl := range benchLocks {
+		b.Run(bl.name, func(b *testing.B) {
+			be, err := backends.Get("keyvalue", nil, memmap.GetStore())
+			if err != nil {
+				b.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			pkgs, depPkgs, deps := dependencyChain("ingest-"+bl.name, b.N)
+			lock := bl.lock()
+			var next atomic.Int64
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := next.Add(1) - 1
+					lock.Lock()
+					err := ingestDependency(ctx, be, pkgs[i], depPkgs[i], deps[i])
+					lock.Unlock()
+					if err != nil {
+						b.Errorf("Could not ingest dependency: %v", err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkQueryDuringIngest(b *testing.B) {
+	ctx := context.Background()
+	for _, bl := range benchLocks {
+		b.Run(bl.name, func(b *testing.B) {
+			be, err := backends.Get("keyvalue", nil, memmap.GetStore())
+			if err != nil {
+				b.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			const chain = 100
+			pkgs, depPkgs, deps := dependencyChain("query-"+bl.name, chain)
+			for i := range pkgs {
+				if err := ingestDependency(ctx, be, pkgs[i], depPkgs[i], deps[i]); err != nil {
+					b.Fatalf("Could not ingest dependency: %v", err)
+				}
+			}
+			more, moreDeps, moreIn := dependencyChain("query-"+bl.name+"-ingested", b.N)
+			lock := bl.lock()
+			var next atomic.Int64
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := next.Add(1) - 1
+					lock.Lock()
+					var err error
+					// one in ten operations is an ingestion
+					if i%10 == 0 {
+						err = ingestDependency(ctx, be, more[i], moreDeps[i], moreIn[i])
+					} else {
+						_, err = be.IsDependency(ctx, &model.IsDependencySpec{Package: &model.PkgSpec{Version: pkgs[i%chain].Version}})
+					}
+					lock.Unlock()
+					if err != nil {
+						b.Errorf("operation failed: %v", err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,506 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// evidenceCols are the collections holding evidence nodes, which can be
+// deleted, along with the origin of their nodes and the list of back edges
+// their neighbors keep to them. Nodes in the software trees are never
+// removed.
+var evidenceCols = []struct {
+	coll   string
+	origin func(node) string
+	links  func(neighbor node) *[]string
+}{
+	{cbCol, func(n node) string { return n.(*badLink).Origin }, badLinks},
+	{cgCol, func(n node) string { return n.(*goodLink).Origin }, goodLinks},
+	{clCol, func(n node) string { return n.(*certifyLegalStruct).Origin }, certifyLegalLinks},
+	{cscCol, func(n node) string { return n.(*scorecardLink).Origin }, scorecardLinks},
+	{cVEXCol, func(n node) string { return n.(*vexLink).Origin }, vexLinks},
+	{cVulnCol, func(n node) string { return n.(*certifyVulnerabilityLink).Origin }, certifyVulnLinks},
+	{hasMDCol, func(n node) string { return n.(*hasMetadataLink).Origin }, hasMetadataLinks},
+	{hasSBOMCol, func(n node) string { return n.(*hasSBOMStruct).Origin }, hasSBOMLinks},
+	{slsaCol, func(n node) string { return n.(*hasSLSAStruct).Origin }, hasSLSALinks},
+	{hsaCol, func(n node) string { return n.(*srcMapLink).Origin }, srcMapLinks},
+	{hashEqCol, func(n node) string { return n.(*hashEqualStruct).Origin }, hashEqualLinks},
+	{isDepCol, func(n node) string { return n.(*isDependencyLink).Origin }, isDependencyLinks},
+	{occCol, func(n node) string { return n.(*isOccurrenceStruct).Origin }, occurrenceLinks},
+	{pkgEqCol, func(n node) string { return n.(*pkgEqualStruct).Origin }, pkgEqualLinks},
+	{pocCol, func(n node) string { return n.(*pointOfContactLink).Origin }, pointOfContactLinks},
+	{vulnEqCol, func(n node) string { return n.(*vulnerabilityEqualLink).Origin }, vulnEqualLinks},
+	{vulnMDCol, func(n node) string { return n.(*vulnerabilityMetadataLink).Origin }, vulnMetadataLinks},
+}
+
+// The functions below return the list of back edges a software tree node
+// keeps to the evidence of one collection, or nil if it keeps none.
+
+func badLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgName:
+		return &n.BadLinks
+	case *pkgVersion:
+		return &n.BadLinks
+	case *srcNameNode:
+		return &n.BadLinks
+	case *artStruct:
+		return &n.BadLinks
+	}
+	return nil
+}
+
+func goodLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgName:
+		return &n.GoodLinks
+	case *pkgVersion:
+		return &n.GoodLinks
+	case *srcNameNode:
+		return &n.GoodLinks
+	case *artStruct:
+		return &n.GoodLinks
+	}
+	return nil
+}
+
+func certifyLegalLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgVersion:
+		return &n.CertifyLegals
+	case *srcNameNode:
+		return &n.CertifyLegals
+	case *licStruct:
+		return &n.CertifyLegals
+	}
+	return nil
+}
+
+func scorecardLinks(n node) *[]string {
+	if n, ok := n.(*srcNameNode); ok {
+		return &n.ScorecardLinks
+	}
+	return nil
+}
+
+func vexLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgVersion:
+		return &n.VexLinks
+	case *artStruct:
+		return &n.VexLinks
+	case *vulnIDNode:
+		return &n.VexLinks
+	}
+	return nil
+}
+
+func certifyVulnLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgVersion:
+		return &n.CertifyVulnLinks
+	case *vulnIDNode:
+		return &n.CertifyVulnLinks
+	}
+	return nil
+}
+
+func hasMetadataLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgName:
+		return &n.HasMetadataLinks
+	case *pkgVersion:
+		return &n.HasMetadataLinks
+	case *srcNameNode:
+		return &n.HasMetadataLinks
+	case *artStruct:
+		return &n.HasMetadataLinks
+	}
+	return nil
+}
+
+func hasSBOMLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgVersion:
+		return &n.HasSBOMs
+	case *artStruct:
+		return &n.HasSBOMs
+	}
+	return nil
+}
+
+func hasSLSALinks(n node) *[]string {
+	switch n := n.(type) {
+	case *artStruct:
+		return &n.HasSLSAs
+	case *builderStruct:
+		return &n.HasSLSAs
+	}
+	return nil
+}
+
+func srcMapLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgName:
+		return &n.SrcMapLinks
+	case *pkgVersion:
+		return &n.SrcMapLinks
+	case *srcNameNode:
+		return &n.SrcMapLinks
+	}
+	return nil
+}
+
+func hashEqualLinks(n node) *[]string {
+	if n, ok := n.(*artStruct); ok {
+		return &n.HashEquals
+	}
+	return nil
+}
+
+func isDependencyLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgName:
+		return &n.IsDependencyLinks
+	case *pkgVersion:
+		return &n.IsDependencyLinks
+	}
+	return nil
+}
+
+func occurrenceLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgVersion:
+		return &n.Occurrences
+	case *srcNameNode:
+		return &n.Occurrences
+	case *artStruct:
+		return &n.Occurrences
+	}
+	return nil
+}
+
+func pkgEqualLinks(n node) *[]string {
+	if n, ok := n.(*pkgVersion); ok {
+		return &n.PkgEquals
+	}
+	return nil
+}
+
+func pointOfContactLinks(n node) *[]string {
+	switch n := n.(type) {
+	case *pkgName:
+		return &n.PointOfContactLinks
+	case *pkgVersion:
+		return &n.PointOfContactLinks
+	case *srcNameNode:
+		return &n.PointOfContactLinks
+	case *artStruct:
+		return &n.PointOfContactLinks
+	}
+	return nil
+}
+
+func vulnEqualLinks(n node) *[]string {
+	if n, ok := n.(*vulnIDNode); ok {
+		return &n.VulnEqualLinks
+	}
+	return nil
+}
+
+func vulnMetadataLinks(n node) *[]string {
+	if n, ok := n.(*vulnIDNode); ok {
+		return &n.VulnMetadataLinks
+	}
+	return nil
+}
+
+// linksOf returns the accessor of the back edges to the evidence in coll.
+func linksOf(coll string) func(node) *[]string {
+	for _, ec := range evidenceCols {
+		if ec.coll == coll {
+			return ec.links
+		}
+	}
+	return func(node) *[]string { return nil }
+}
+
+func (c *demoClient) DeleteCertifyBad(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, cbCol)
+}
+
+func (c *demoClient) DeleteCertifyGood(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, cgCol)
+}
+
+func (c *demoClient) DeleteCertifyLegal(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, clCol)
+}
+
+func (c *demoClient) DeleteCertifyScorecard(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, cscCol)
+}
+
+func (c *demoClient) DeleteCertifyVEXStatement(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, cVEXCol)
+}
+
+func (c *demoClient) DeleteCertifyVuln(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, cVulnCol)
+}
+
+func (c *demoClient) DeleteHasMetadata(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, hasMDCol)
+}
+
+func (c *demoClient) DeleteHasSbom(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, hasSBOMCol)
+}
+
+func (c *demoClient) DeleteHasSlsa(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, slsaCol)
+}
+
+func (c *demoClient) DeleteHasSourceAt(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, hsaCol)
+}
+
+func (c *demoClient) DeleteHashEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, hashEqCol)
+}
+
+func (c *demoClient) DeleteIsDependency(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, isDepCol)
+}
+
+func (c *demoClient) DeleteIsOccurrence(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, occCol)
+}
+
+func (c *demoClient) DeletePkgEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, pkgEqCol)
+}
+
+func (c *demoClient) DeletePointOfContact(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, pocCol)
+}
+
+func (c *demoClient) DeleteVulnEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, vulnEqCol)
+}
+
+func (c *demoClient) DeleteVulnerabilityMetadata(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, id, vulnMDCol)
+}
+
+func (c *demoClient) RetractEvidence(ctx context.Context, origin string) (int, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	// Collect the IDs first, deleting nodes can change the keys of the
+	// HasSBOM nodes including them.
+	type evidence struct{ id, coll string }
+	var matches []evidence
+	for _, ec := range evidenceCols {
+		if err := c.scanNodes(ctx, ec.coll, "", func(n node) error {
+			if ec.origin(n) == origin {
+				matches = append(matches, evidence{id: n.ID(), coll: ec.coll})
+			}
+			return nil
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	deleted := 0
+	included := map[string]bool{}
+	for _, m := range matches {
+		found, err := c.removeEvidence(ctx, m.id, m.coll)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to retract %s %q: %w", m.coll, m.id, err)
+		}
+		if found {
+			deleted++
+			if m.coll == isDepCol || m.coll == occCol {
+				included[m.id] = true
+			}
+		}
+	}
+	if err := c.removeFromSBOMs(ctx, included); err != nil {
+		return deleted, fmt.Errorf("failed to retract from HasSBOM nodes: %w", err)
+	}
+	return deleted, nil
+}
+
+func (c *demoClient) deleteEvidence(ctx context.Context, id, coll string) (bool, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	found, err := c.removeEvidence(ctx, id, coll)
+	if err != nil || !found {
+		return found, err
+	}
+	if coll == isDepCol || coll == occCol {
+		if err := c.removeFromSBOMs(ctx, map[string]bool{id: true}); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// removeEvidence removes the node with the given id from coll, the index and
+// the backlinks of its neighbors. It returns false if there is no node with
+// that id. Removed IsDependency and IsOccurrence nodes are left in the
+// included lists of the HasSBOM nodes, for removeFromSBOMs. Callers must hold
+// the write lock.
+func (c *demoClient) removeEvidence(ctx context.Context, id, coll string) (bool, error) {
+	var k string
+	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
+		if errors.Is(err, kv.NotFoundError) {
+			return false, nil
+		}
+		return false, err
+	}
+	sub := strings.SplitN(k, ":", 2)
+	if len(sub) != 2 {
+		return false, fmt.Errorf("Bad value was stored in index map: %v", k)
+	}
+	if sub[0] != coll {
+		return false, fmt.Errorf("%w : id %q is stored in %q, not %q", errTypeNotMatch, id, sub[0], coll)
+	}
+
+	n := typeColMap(coll)
+	if err := c.kv.Get(ctx, coll, sub[1], &n); err != nil {
+		return false, err
+	}
+	for _, neighbor := range n.Neighbors(processUsingOnly(nil)) {
+		if err := c.removeBacklink(ctx, coll, neighbor, id); err != nil {
+			return false, err
+		}
+	}
+	if err := c.kv.Delete(ctx, coll, sub[1]); err != nil {
+		return false, err
+	}
+	if err := c.kv.Delete(ctx, indexCol, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// removeBacklink drops id from the back edges to the evidence in coll kept by
+// the node neighborID. Only software tree nodes keep back edges, and their
+// keys do not depend on them, so the node is stored back under the same key.
+// As in updatekv, the stored value is not modified, a copy is stored instead.
+func (c *demoClient) removeBacklink(ctx context.Context, coll, neighborID, id string) error {
+	var k string
+	if err := c.kv.Get(ctx, indexCol, neighborID, &k); err != nil {
+		return fmt.Errorf("%w : id not found in index %q", err, neighborID)
+	}
+	sub := strings.SplitN(k, ":", 2)
+	if len(sub) != 2 {
+		return fmt.Errorf("Bad value was stored in index map: %v", k)
+	}
+	n := typeColMap(sub[0])
+	if err := c.kv.Get(ctx, sub[0], sub[1], &n); err != nil {
+		return err
+	}
+	if links := linksOf(coll)(n); links == nil || !slices.Contains(*links, id) {
+		return nil
+	}
+	n = copyNode(n)
+	links := linksOf(coll)(n)
+	*links = slices.DeleteFunc(slices.Clone(*links), func(s string) bool { return s == id })
+	return setkv(ctx, sub[0], n, c)
+}
+
+// removeFromSBOMs drops the deleted IsDependency and IsOccurrence nodes with
+// the given IDs from the included lists of the HasSBOM nodes. These lists are
+// part of the HasSBOM key, so each changed node is stored again, once, under
+// its new key. A node whose new key is already taken, by an unchanged node or
+// by another changed one, now duplicates that node and is removed instead.
+func (c *demoClient) removeFromSBOMs(ctx context.Context, ids map[string]bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	deleted := func(id string) bool { return ids[id] }
+	oldKeys := map[string]bool{}
+	var changed []*hasSBOMStruct
+	if err := scanKV(ctx, hasSBOMCol, c, func(sbom *hasSBOMStruct) error {
+		if !slices.ContainsFunc(sbom.IncludedDependencies, deleted) && !slices.ContainsFunc(sbom.IncludedOccurrences, deleted) {
+			return nil
+		}
+		oldKeys[sbom.Key()] = true
+		sbom = copyNode(sbom)
+		sbom.IncludedDependencies = slices.DeleteFunc(slices.Clone(sbom.IncludedDependencies), deleted)
+		sbom.IncludedOccurrences = slices.DeleteFunc(slices.Clone(sbom.IncludedOccurrences), deleted)
+		changed = append(changed, sbom)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	sboms := map[string]any{}
+	index := map[string]any{}
+	var duplicates []*hasSBOMStruct
+	for _, sbom := range changed {
+		if _, ok := sboms[sbom.Key()]; ok {
+			duplicates = append(duplicates, sbom)
+			continue
+		}
+		if !oldKeys[sbom.Key()] {
+			var existing *hasSBOMStruct
+			err := c.kv.Get(ctx, hasSBOMCol, sbom.Key(), &existing)
+			if err == nil {
+				duplicates = append(duplicates, sbom)
+				continue
+			}
+			if !errors.Is(err, kv.NotFoundError) {
+				return err
+			}
+		}
+		sboms[sbom.Key()] = sbom
+		index[sbom.ID()] = strings.Join([]string{hasSBOMCol, sbom.Key()}, ":")
+	}
+
+	for k := range oldKeys {
+		if err := c.kv.Delete(ctx, hasSBOMCol, k); err != nil {
+			return err
+		}
+	}
+	if err := c.kv.BatchSet(ctx, hasSBOMCol, sboms); err != nil {
+		return err
+	}
+	if err := c.kv.BatchSet(ctx, indexCol, index); err != nil {
+		return err
+	}
+	for _, sbom := range duplicates {
+		for _, neighbor := range sbom.Neighbors(processUsingOnly(nil)) {
+			if err := c.removeBacklink(ctx, hasSBOMCol, neighbor, sbom.ID()); err != nil {
+				return err
+			}
+		}
+		if err := c.kv.Delete(ctx, indexCol, sbom.ID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
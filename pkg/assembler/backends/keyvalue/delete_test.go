@@ -0,0 +1,208 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestDeleteCertifyBad(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p1); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	sub := model.PackageSourceOrArtifactInput{Package: p1}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	cbID, err := b.IngestCertifyBad(ctx, sub, match, model.CertifyBadInputSpec{Justification: "test justification"})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyBad: %v", err)
+	}
+
+	if _, err := b.DeleteCertifyGood(ctx, cbID); err == nil {
+		t.Errorf("DeleteCertifyGood of a CertifyBad ID did not return an error")
+	}
+
+	deleted, err := b.DeleteCertifyBad(ctx, cbID)
+	if err != nil {
+		t.Fatalf("DeleteCertifyBad failed: %v", err)
+	}
+	if !deleted {
+		t.Errorf("DeleteCertifyBad returned false for an existing node")
+	}
+
+	got, err := b.CertifyBad(ctx, &model.CertifyBadSpec{})
+	if err != nil {
+		t.Fatalf("Could not query CertifyBad: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("CertifyBad still returned after deletion: %v", got)
+	}
+	// package version "4" must no longer link to the deleted node
+	neighbors, err := b.Neighbors(ctx, "4", nil)
+	if err != nil {
+		t.Fatalf("Could not query neighbors: %v", err)
+	}
+	if diff := cmp.Diff([]string{"1"}, convNodes(neighbors)); diff != "" {
+		t.Errorf("Unexpected neighbors. (-want +got):\n%s", diff)
+	}
+
+	deleted, err = b.DeleteCertifyBad(ctx, cbID)
+	if err != nil {
+		t.Fatalf("DeleteCertifyBad of a deleted node failed: %v", err)
+	}
+	if deleted {
+		t.Errorf("DeleteCertifyBad returned true for a deleted node")
+	}
+}
+
+func TestRetractEvidence(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p1, p2} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	depID, err := b.IngestDependency(ctx, *p1, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.IsDependencyInputSpec{Justification: "dep", Origin: "sbom.json"})
+	if err != nil {
+		t.Fatalf("Could not ingest IsDependency: %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Package: p1},
+		&model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.CertifyBadInputSpec{Justification: "bad", Origin: "sbom.json"}); err != nil {
+		t.Fatalf("Could not ingest CertifyBad: %v", err)
+	}
+	goodID, err := b.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Package: p1},
+		&model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.CertifyGoodInputSpec{Justification: "good", Origin: "other.json"})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyGood: %v", err)
+	}
+	sbomID, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: p1},
+		model.HasSBOMInputSpec{URI: "test uri", Origin: "other.json"},
+		model.HasSBOMIncludesInputSpec{Dependencies: []string{depID}})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSBOM: %v", err)
+	}
+
+	retracted, err := b.RetractEvidence(ctx, "sbom.json")
+	if err != nil {
+		t.Fatalf("RetractEvidence failed: %v", err)
+	}
+	if retracted != 2 {
+		t.Errorf("RetractEvidence removed %d nodes, want 2", retracted)
+	}
+
+	deps, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+	if err != nil {
+		t.Fatalf("Could not query IsDependency: %v", err)
+	}
+	if len(deps) != 0 {
+		t.Errorf("IsDependency still returned after retraction: %v", deps)
+	}
+	goods, err := b.CertifyGood(ctx, &model.CertifyGoodSpec{})
+	if err != nil {
+		t.Fatalf("Could not query CertifyGood: %v", err)
+	}
+	if len(goods) != 1 || goods[0].ID != goodID {
+		t.Errorf("CertifyGood from another origin was not kept: %v", goods)
+	}
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("Could not query HasSBOM: %v", err)
+	}
+	if len(sboms) != 1 || sboms[0].ID != sbomID {
+		t.Fatalf("HasSBOM from another origin was not kept: %v", sboms)
+	}
+	if len(sboms[0].IncludedDependencies) != 0 {
+		t.Errorf("HasSBOM still includes retracted dependencies: %v", sboms[0].IncludedDependencies)
+	}
+	// the HasSBOM must still be reachable by ID under its new key
+	neighbors, err := b.Neighbors(ctx, sbomID, nil)
+	if err != nil {
+		t.Fatalf("Could not query neighbors: %v", err)
+	}
+	if slices.Contains(convNodes(neighbors), depID) {
+		t.Errorf("HasSBOM still links to the retracted dependency")
+	}
+}
+
+func TestDeleteMergesHasSBOMs(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p1, p2} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	depID, err := b.IngestDependency(ctx, *p1, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.IsDependencyInputSpec{Justification: "dep"})
+	if err != nil {
+		t.Fatalf("Could not ingest IsDependency: %v", err)
+	}
+	keptID, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: p1},
+		model.HasSBOMInputSpec{URI: "test uri"}, model.HasSBOMIncludesInputSpec{})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSBOM: %v", err)
+	}
+	mergedID, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: p1},
+		model.HasSBOMInputSpec{URI: "test uri"}, model.HasSBOMIncludesInputSpec{Dependencies: []string{depID}})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSBOM: %v", err)
+	}
+
+	// without the dependency, the second HasSBOM is the same as the first
+	if _, err := b.DeleteIsDependency(ctx, depID); err != nil {
+		t.Fatalf("DeleteIsDependency failed: %v", err)
+	}
+
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("Could not query HasSBOM: %v", err)
+	}
+	if len(sboms) != 1 || sboms[0].ID != keptID {
+		t.Fatalf("Unexpected HasSBOM nodes after the deletion: %v", sboms)
+	}
+	if _, err := b.Node(ctx, mergedID); err == nil {
+		t.Errorf("Merged HasSBOM %s is still indexed", mergedID)
+	}
+	// package version "4" must only link to the kept node
+	neighbors, err := b.Neighbors(ctx, "4", []model.Edge{model.EdgePackageHasSbom})
+	if err != nil {
+		t.Fatalf("Could not query neighbors: %v", err)
+	}
+	if diff := cmp.Diff([]string{keptID}, convNodes(neighbors)); diff != "" {
+		t.Errorf("Unexpected HasSBOM neighbors of the package. (-want +got):\n%s", diff)
+	}
+}
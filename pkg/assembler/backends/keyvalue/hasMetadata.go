@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -38,6 +39,8 @@ type hasMetadataLink struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 }
 
 func (n *hasMetadataLink) ID() string { return n.ThisID }
@@ -119,10 +122,12 @@ func (c *demoClient) ingestHasMetadata(ctx context.Context, subject model.Packag
 		Justification: hasMetadata.Justification,
 		Origin:        hasMetadata.Origin,
 		Collector:     hasMetadata.Collector,
+		Verified:      hasMetadata.Verified,
+		Signer:        hasMetadata.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	var foundPkgNameorVersionNode pkgNameOrVersion
 	var foundArtStrct *artStruct
@@ -150,6 +155,10 @@ func (c *demoClient) ingestHasMetadata(ctx context.Context, subject model.Packag
 		in.SourceID = srcName.ThisID
 	}
 
+	if !readOnly {
+		unlock := c.keys.lock(hasMDCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*hasMetadataLink](ctx, hasMDCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -165,10 +174,15 @@ func (c *demoClient) ingestHasMetadata(ctx context.Context, subject model.Packag
 		return b, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, hasMDCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, hasMDCol, in, c); err != nil {
+		return "", err
+	}
 
 	// set the backlinks
 	if foundPkgNameorVersionNode != nil {
@@ -187,10 +201,6 @@ func (c *demoClient) ingestHasMetadata(ctx context.Context, subject model.Packag
 		}
 	}
 
-	if err := setkv(ctx, hasMDCol, in, c); err != nil {
-		return "", err
-	}
-
 	// build return GraphQL type
 	return in.ThisID, nil
 }
@@ -215,60 +225,64 @@ func (c *demoClient) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 		return []*model.HasMetadata{found}, nil
 	}
 
+	q, err := c.hasMetadataQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// hasMetadataQuery returns the HasMetadata query, narrowed to the links of
+// the nodes the filter names, if any.
+func (c *demoClient) hasMetadataQuery(ctx context.Context, filter *model.HasMetadataSpec) (*nodeQuery[*hasMetadataLink, *model.HasMetadataSpec, *model.HasMetadata], error) {
+	q := newNodeQuery(hasMDCol, filter, c.addHMIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	// Cant really search for an exact Pkg, as these can be linked to either
 	// names or versions, and version could be empty.
-	var search []string
-	foundOne := false
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactArtifact != nil {
-			search = append(search, exactArtifact.HasMetadataLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactArtifact.HasMetadataLinks...)
+			q.searched = true
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = append(search, exactSource.HasMetadataLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactSource.HasMetadataLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.HasMetadata
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*hasMetadataLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addHMIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		hmk, err := c.kv.Keys(ctx, hasMDCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, hk := range hmk {
-			link, err := byKeykv[*hasMetadataLink](ctx, hasMDCol, hk, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addHMIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// HasMetadataList returns a page of the HasMetadata query results, in the
+// order of their keys.
+func (c *demoClient) HasMetadataList(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.hasMetadataQuery(ctx, hasMetadataSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasMetadataList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasMetadataList :: %v", err)
+	}
+	return helper.HasMetadataConnection(p), nil
 }
 
 func (c *demoClient) addHMIfMatch(ctx context.Context, out []*model.HasMetadata, filter *model.HasMetadataSpec, link *hasMetadataLink) (
@@ -384,6 +398,8 @@ func (c *demoClient) buildHasMetadata(ctx context.Context, link *hasMetadataLink
 		Justification: link.Justification,
 		Origin:        link.Origin,
 		Collector:     link.Collector,
+		Verified:      link.Verified,
+		Signer:        link.Signer,
 	}
 	return &hasMetadata, nil
 }
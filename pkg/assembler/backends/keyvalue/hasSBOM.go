@@ -24,6 +24,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -38,6 +39,8 @@ type hasSBOMStruct struct {
 	DownloadLocation     string
 	Origin               string
 	Collector            string
+	Verified             *bool
+	Signer               *string
 	KnownSince           time.Time
 	IncludedSoftware     []string
 	IncludedDependencies []string
@@ -163,14 +166,16 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 		DownloadLocation:     input.DownloadLocation,
 		Origin:               input.Origin,
 		Collector:            input.Collector,
+		Verified:             input.Verified,
+		Signer:               input.Signer,
 		KnownSince:           input.KnownSince.UTC(),
 		IncludedSoftware:     includedSoftware,
 		IncludedDependencies: includedDependencies,
 		IncludedOccurrences:  includedOccurrences,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	var pkg *pkgVersion
 	var art *artStruct
@@ -191,6 +196,10 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 		in.Artifact = art.ThisID
 	}
 
+	if !readOnly {
+		unlock := c.keys.lock(hasSBOMCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*hasSBOMStruct](ctx, hasSBOMCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -206,11 +215,16 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 		return b, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 
 	if err := c.addToIndex(ctx, hasSBOMCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, hasSBOMCol, in, c); err != nil {
+		return "", err
+	}
 
 	if pkg != nil {
 		if err := pkg.setHasSBOM(ctx, in.ThisID, c); err != nil {
@@ -222,10 +236,6 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 		}
 	}
 
-	if err := setkv(ctx, hasSBOMCol, in, c); err != nil {
-		return "", err
-	}
-
 	return in.ThisID, nil
 }
 
@@ -238,6 +248,8 @@ func (c *demoClient) convHasSBOM(ctx context.Context, in *hasSBOMStruct) (*model
 		DownloadLocation: in.DownloadLocation,
 		Origin:           in.Origin,
 		Collector:        in.Collector,
+		Verified:         in.Verified,
+		Signer:           in.Signer,
 		KnownSince:       in.KnownSince.UTC(),
 	}
 	if in.Pkg != "" {
@@ -320,59 +332,62 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 		return []*model.HasSbom{sb}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.hasSBOMQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// hasSBOMQuery returns the HasSBOM query, narrowed to the links of the nodes
+// the filter names, if any.
+func (c *demoClient) hasSBOMQuery(ctx context.Context, filter *model.HasSBOMSpec) (*nodeQuery[*hasSBOMStruct, *model.HasSBOMSpec, *model.HasSbom], error) {
+	q := newNodeQuery(hasSBOMCol, filter, c.addHasSBOMIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	if filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
-		foundOne = len(pkgs) > 0
+		q.searched = len(pkgs) > 0
 		for _, pkg := range pkgs {
-			search = append(search, pkg.HasSBOMs...)
+			q.ids = append(q.ids, pkg.HasSBOMs...)
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArt, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactArt != nil {
-			search = exactArt.HasSBOMs
-			foundOne = true
+			q.ids = exactArt.HasSBOMs
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.HasSbom
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*hasSBOMStruct](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addHasSBOMIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		hsks, err := c.kv.Keys(ctx, hasSBOMCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, hsk := range hsks {
-			link, err := byKeykv[*hasSBOMStruct](ctx, hasSBOMCol, hsk, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addHasSBOMIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// HasSBOMList returns a page of the HasSBOM query results, in the order of
+// their keys.
+func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.hasSBOMQuery(ctx, hasSBOMSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasSBOMList :: %v", err)
 	}
-
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasSBOMList :: %v", err)
+	}
+	return helper.HasSBOMConnection(p), nil
 }
 
 func (c *demoClient) addHasSBOMIfMatch(ctx context.Context, out []*model.HasSbom,
@@ -262,6 +262,7 @@ var includedTestExpectedSBOM = &model.HasSbom{
 	IncludedDependencies: []*model.IsDependency{{
 		Package:           includedTestExpectedPackage1,
 		DependencyPackage: includedTestExpectedPackage2,
+		DependencyScope:   model.DependencyScopeUnknown,
 		VersionRange:      "dep1_range",
 		DependencyType:    model.DependencyTypeDirect,
 		Justification:     "dep1_justification",
@@ -270,6 +271,7 @@ var includedTestExpectedSBOM = &model.HasSbom{
 	}, {
 		Package:           includedTestExpectedPackage1,
 		DependencyPackage: includedTestExpectedPackage3,
+		DependencyScope:   model.DependencyScopeUnknown,
 		VersionRange:      "dep2_range",
 		DependencyType:    model.DependencyTypeIndirect,
 		Justification:     "dep2_justification",
@@ -547,6 +549,7 @@ func TestHasSBOM(t *testing.T) {
 					IncludedDependencies: []*model.IsDependency{{
 						Package:           p2out,
 						DependencyPackage: p4out,
+						DependencyScope:   model.DependencyScopeUnknown,
 						Justification:     "test justification",
 					}},
 					IncludedOccurrences: []*model.IsOccurrence{{
@@ -690,6 +693,7 @@ func TestHasSBOM(t *testing.T) {
 					IncludedDependencies: []*model.IsDependency{{
 						Package:           p2out,
 						DependencyPackage: p4out,
+						DependencyScope:   model.DependencyScopeUnknown,
 						Justification:     "test justification",
 					}},
 					IncludedOccurrences: []*model.IsOccurrence{{
@@ -2855,6 +2859,7 @@ func TestIngestHasSBOMs(t *testing.T) {
 					IncludedDependencies: []*model.IsDependency{{
 						Package:           p2out,
 						DependencyPackage: p4out,
+						DependencyScope:   model.DependencyScopeUnknown,
 						Justification:     "test justification",
 					}},
 					IncludedOccurrences: []*model.IsOccurrence{{
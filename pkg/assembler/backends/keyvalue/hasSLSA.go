@@ -24,6 +24,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -42,6 +43,8 @@ type (
 		Finish     *time.Time
 		Origin     string
 		Collector  string
+		Verified   *bool
+		Signer     *string
 	}
 )
 
@@ -107,67 +110,70 @@ func (c *demoClient) HasSlsa(ctx context.Context, filter *model.HasSLSASpec) ([]
 		return []*model.HasSlsa{hs}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.hasSLSAQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// hasSLSAQuery returns the HasSLSA query, narrowed to the links of the nodes
+// the filter names, if any.
+func (c *demoClient) hasSLSAQuery(ctx context.Context, filter *model.HasSLSASpec) (*nodeQuery[*hasSLSAStruct, *model.HasSLSASpec, *model.HasSlsa], error) {
+	q := newNodeQuery(slsaCol, filter, c.addSLSAIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	var arts []*model.ArtifactSpec
 	if filter != nil {
 		arts = append(arts, filter.Subject)
 		arts = append(arts, filter.BuiltFrom...)
 	}
 	for _, a := range arts {
-		if !foundOne && a != nil {
+		if !q.searched && a != nil {
 			exactArtifact, err := c.artifactExact(ctx, a)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, err
 			}
 			if exactArtifact != nil {
-				search = append(search, exactArtifact.HasSLSAs...)
-				foundOne = true
+				q.ids = append(q.ids, exactArtifact.HasSLSAs...)
+				q.searched = true
 				break
 			}
 		}
 	}
-	if !foundOne && filter != nil && filter.BuiltBy != nil {
+	if !q.searched && filter != nil && filter.BuiltBy != nil {
 		exactBuilder, err := c.exactBuilder(ctx, filter.BuiltBy)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactBuilder != nil {
-			search = append(search, exactBuilder.HasSLSAs...)
-			foundOne = true
+			q.ids = append(q.ids, exactBuilder.HasSLSAs...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.HasSlsa
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*hasSLSAStruct](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addSLSAIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		slsaKeys, err := c.kv.Keys(ctx, slsaCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, slsak := range slsaKeys {
-			link, err := byKeykv[*hasSLSAStruct](ctx, slsaCol, slsak, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addSLSAIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// HasSLSAList returns a page of the HasSlsa query results, in the order of
+// their keys.
+func (c *demoClient) HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.hasSLSAQuery(ctx, hasSLSASpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasSLSAList :: %v", err)
 	}
-
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasSLSAList :: %v", err)
+	}
+	return helper.HasSLSAConnection(p), nil
 }
 
 func matchSLSAPreds(haves []*model.SLSAPredicate, wants []*model.SLSAPredicateSpec) bool {
@@ -214,6 +220,8 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 		Version:    slsa.SlsaVersion,
 		Origin:     slsa.Origin,
 		Collector:  slsa.Collector,
+		Verified:   slsa.Verified,
+		Signer:     slsa.Signer,
 	}
 	if slsa.StartedOn != nil {
 		t := slsa.StartedOn.UTC()
@@ -224,8 +232,8 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 		in.Finish = &t
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	s, err := c.artifactByInput(ctx, &subject)
 	if err != nil {
@@ -252,6 +260,10 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 	}
 	in.BuiltBy = b.ThisID
 
+	if !readOnly {
+		unlock := c.keys.lock(slsaCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*hasSLSAStruct](ctx, slsaCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -267,11 +279,16 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 		return s, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 
 	if err := c.addToIndex(ctx, slsaCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, slsaCol, in, c); err != nil {
+		return "", err
+	}
 	if err := s.setHasSLSAs(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
@@ -283,9 +300,6 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 	if err := b.setHasSLSAs(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
-	if err := setkv(ctx, slsaCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -333,6 +347,8 @@ func (c *demoClient) convSLSA(ctx context.Context, in *hasSLSAStruct) (*model.Ha
 			FinishedOn:    in.Finish,
 			Origin:        in.Origin,
 			Collector:     in.Collector,
+			Verified:      in.Verified,
+			Signer:        in.Signer,
 		},
 	}, nil
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -36,6 +37,8 @@ type srcMapLink struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 }
 
 func (n *srcMapLink) ID() string { return n.ThisID }
@@ -92,10 +95,12 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.Pkg
 		Justification: hasSourceAt.Justification,
 		Origin:        hasSourceAt.Origin,
 		Collector:     hasSourceAt.Collector,
+		Verified:      hasSourceAt.Verified,
+		Signer:        hasSourceAt.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	srcName, err := c.getSourceNameFromInput(ctx, source)
 	if err != nil {
@@ -109,6 +114,10 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.Pkg
 	}
 	in.PackageID = pkgNameOrVersionNode.ID()
 
+	if !readOnly {
+		unlock := c.keys.lock(hsaCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*srcMapLink](ctx, hsaCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -124,11 +133,16 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.Pkg
 		return s, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 
 	if err := c.addToIndex(ctx, hsaCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, hsaCol, in, c); err != nil {
+		return "", err
+	}
 	// set the backlinks
 	if err := pkgNameOrVersionNode.setSrcMapLinks(ctx, in.ThisID, c); err != nil {
 		return "", err
@@ -136,9 +150,6 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.Pkg
 	if err := srcName.setSrcMapLinks(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
-	if err := setkv(ctx, hsaCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -161,51 +172,54 @@ func (c *demoClient) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 		return []*model.HasSourceAt{foundHasSourceAt}, nil
 	}
 
+	q, err := c.hasSourceAtQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// hasSourceAtQuery returns the HasSourceAt query, narrowed to the links of
+// the nodes the filter names, if any.
+func (c *demoClient) hasSourceAtQuery(ctx context.Context, filter *model.HasSourceAtSpec) (*nodeQuery[*srcMapLink, *model.HasSourceAtSpec, *model.HasSourceAt], error) {
+	q := newNodeQuery(hsaCol, filter, c.addSrcIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	// Cant really search for an exact Pkg, as these can be linked to either
 	// names or versions, only search Source backedges.
-	var search []string
-	foundOne := false
 	if filter != nil && filter.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = append(search, exactSource.SrcMapLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactSource.SrcMapLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.HasSourceAt
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*srcMapLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addSrcIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		hsaKeys, err := c.kv.Keys(ctx, hsaCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, hsak := range hsaKeys {
-			link, err := byKeykv[*srcMapLink](ctx, hsaCol, hsak, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addSrcIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// HasSourceAtList returns a page of the HasSourceAt query results, in the
+// order of their keys.
+func (c *demoClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.hasSourceAtQuery(ctx, hasSourceAtSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasSourceAtList :: %v", err)
 	}
-
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("HasSourceAtList :: %v", err)
+	}
+	return helper.HasSourceAtConnection(p), nil
 }
 
 func (c *demoClient) buildHasSourceAt(ctx context.Context, link *srcMapLink, filter *model.HasSourceAtSpec, ingestOrIDProvided bool) (*model.HasSourceAt, error) {
@@ -252,6 +266,8 @@ func (c *demoClient) buildHasSourceAt(ctx context.Context, link *srcMapLink, fil
 		Justification: link.Justification,
 		Origin:        link.Origin,
 		Collector:     link.Collector,
+		Verified:      link.Verified,
+		Signer:        link.Signer,
 	}
 	return &newHSA, nil
 }
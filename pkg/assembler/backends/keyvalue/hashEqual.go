@@ -22,6 +22,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -35,6 +36,8 @@ type hashEqualStruct struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 }
 
 func (n *hashEqualStruct) ID() string { return n.ThisID }
@@ -81,10 +84,12 @@ func (c *demoClient) ingestHashEqual(ctx context.Context, artifact model.Artifac
 		Justification: hashEqual.Justification,
 		Origin:        hashEqual.Origin,
 		Collector:     hashEqual.Collector,
+		Verified:      hashEqual.Verified,
+		Signer:        hashEqual.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	aInt1, err := c.artifactByInput(ctx, &artifact)
 	if err != nil {
@@ -98,6 +103,10 @@ func (c *demoClient) ingestHashEqual(ctx context.Context, artifact model.Artifac
 	slices.Sort(artIDs)
 	in.Artifacts = artIDs
 
+	if !readOnly {
+		unlock := c.keys.lock(hashEqCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*hashEqualStruct](ctx, hashEqCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -113,17 +122,19 @@ func (c *demoClient) ingestHashEqual(ctx context.Context, artifact model.Artifac
 		return he, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, hashEqCol, in); err != nil {
 		return "", err
 	}
-	if err := aInt1.setHashEquals(ctx, in.ThisID, c); err != nil {
+	if err := setkv(ctx, hashEqCol, in, c); err != nil {
 		return "", err
 	}
-	if err := aInt2.setHashEquals(ctx, in.ThisID, c); err != nil {
+	if err := aInt1.setHashEquals(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
-	if err := setkv(ctx, hashEqCol, in, c); err != nil {
+	if err := aInt2.setHashEquals(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
 
@@ -198,51 +209,55 @@ func (c *demoClient) HashEqual(ctx context.Context, filter *model.HashEqualSpec)
 		return []*model.HashEqual{he}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.hashEqualQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// hashEqualQuery returns the HashEqual query, narrowed to the links of the
+// nodes the filter names, if any.
+func (c *demoClient) hashEqualQuery(ctx context.Context, filter *model.HashEqualSpec) (*nodeQuery[*hashEqualStruct, *model.HashEqualSpec, *model.HashEqual], error) {
+	q := newNodeQuery(hashEqCol, filter, c.addHEIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	for _, a := range filter.Artifacts {
-		if !foundOne && a != nil {
+		if !q.searched && a != nil {
 			exactArtifact, err := c.artifactExact(ctx, a)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, err
 			}
 			if exactArtifact != nil {
-				search = append(search, exactArtifact.HashEquals...)
-				foundOne = true
+				q.ids = append(q.ids, exactArtifact.HashEquals...)
+				q.searched = true
 				break
 			}
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.HashEqual
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*hashEqualStruct](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addHEIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		heKeys, err := c.kv.Keys(ctx, hashEqCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, hek := range heKeys {
-			link, err := byKeykv[*hashEqualStruct](ctx, hashEqCol, hek, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addHEIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// HashEqualList returns a page of the HashEqual query results, in the order
+// of their keys.
+func (c *demoClient) HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.hashEqualQuery(ctx, hashEqualSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("HashEqualList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("HashEqualList :: %v", err)
+	}
+	return helper.HashEqualConnection(p), nil
 }
 
 func (c *demoClient) convHashEqual(ctx context.Context, h *hashEqualStruct) (*model.HashEqual, error) {
@@ -260,6 +275,8 @@ func (c *demoClient) convHashEqual(ctx context.Context, h *hashEqualStruct) (*mo
 		Artifacts:     artifacts,
 		Origin:        h.Origin,
 		Collector:     h.Collector,
+		Verified:      h.Verified,
+		Signer:        h.Signer,
 	}, nil
 }
 
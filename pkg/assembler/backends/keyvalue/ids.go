@@ -0,0 +1,155 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	// idCol holds the counter IDs are allocated from. Keeping it in the
+	// store means that a restarted backend, or several backends sharing a
+	// persistent store, never hand out the same ID twice.
+	idCol = "ids"
+	idKey = "next"
+
+	// IDs are reserved from the counter in blocks, to avoid a round trip to
+	// the store for every new node. The unused IDs of a block are lost when
+	// the backend stops.
+	idBlockSize = 100
+)
+
+// idAllocator hands out the IDs of the block last reserved from the store.
+type idAllocator struct {
+	mu   sync.Mutex
+	next uint64
+	last uint64
+}
+
+func (c *demoClient) getNextID(ctx context.Context) (string, error) {
+	c.ids.mu.Lock()
+	defer c.ids.mu.Unlock()
+	if c.ids.next == c.ids.last {
+		last, err := c.kv.Increment(ctx, idCol, idKey, idBlockSize)
+		if err != nil {
+			return "", fmt.Errorf("unable to allocate IDs: %w", err)
+		}
+		c.ids.next, c.ids.last = last-idBlockSize, last
+	}
+	c.ids.next++
+	return strconv.FormatUint(c.ids.next, 10), nil
+}
+
+// idCollision is an ID that was given to more than one node. Backends from
+// before IDs were allocated from the store restarted counting from one, so
+// their nodes can share IDs. The index maps the ID to a single one of them.
+type idCollision struct {
+	ID      string
+	Indexed string
+	Nodes   []string
+}
+
+// migrateIDs seeds the ID counter of a store written by an older backend,
+// which has nodes but no counter, past the largest ID in use. It returns the
+// IDs that such a backend already gave to several nodes. These can not be
+// repaired automatically, as other nodes refer to them.
+func (c *demoClient) migrateIDs(ctx context.Context) ([]idCollision, error) {
+	var n uint64
+	err := c.kv.Get(ctx, idCol, idKey, &n)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, kv.NotFoundError) {
+		return nil, err
+	}
+
+	var maxID uint64
+	sc := kv.NewScanner(c.kv, indexCol, "", 0)
+	for sc.Next(ctx) {
+		for _, k := range sc.Keys() {
+			if id, err := strconv.ParseUint(k, 10, 64); err == nil && id > maxID {
+				maxID = id
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if maxID == 0 {
+		return nil, nil
+	}
+	if _, err := c.kv.Increment(ctx, idCol, idKey, maxID); err != nil {
+		return nil, err
+	}
+	return c.findIDCollisions(ctx)
+}
+
+// findIDCollisions returns the IDs of nodes that the index maps to a
+// different node.
+func (c *demoClient) findIDCollisions(ctx context.Context) ([]idCollision, error) {
+	collisions := make(map[string]*idCollision)
+	var ids []string
+	for _, coll := range nodeCols {
+		err := c.scanNodes(ctx, coll, "", func(n node) error {
+			var indexed string
+			if err := c.kv.Get(ctx, indexCol, n.ID(), &indexed); err != nil {
+				if errors.Is(err, kv.NotFoundError) {
+					return nil
+				}
+				return err
+			}
+			val := strings.Join([]string{coll, n.Key()}, ":")
+			if indexed == val {
+				return nil
+			}
+			collision, ok := collisions[n.ID()]
+			if !ok {
+				collision = &idCollision{ID: n.ID(), Indexed: indexed}
+				collisions[n.ID()] = collision
+				ids = append(ids, n.ID())
+			}
+			collision.Nodes = append(collision.Nodes, val)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	var result []idCollision
+	for _, id := range ids {
+		result = append(result, *collisions[id])
+	}
+	return result, nil
+}
+
+func logIDCollisions(ctx context.Context, collisions []idCollision) {
+	if len(collisions) == 0 {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	logger.Warnf("keyvalue store has %d IDs shared by several nodes, only the indexed node can be looked up by ID, re-ingest into an empty store to repair", len(collisions))
+	for _, collision := range collisions {
+		logger.Warnf("ID %s is indexed as %q and is also used by %q", collision.ID, collision.Indexed, collision.Nodes)
+	}
+}
@@ -0,0 +1,135 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+func newTestClient(t *testing.T, store kv.Store) *demoClient {
+	b, err := getBackend(context.Background(), store)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	return b.(*demoClient)
+}
+
+func TestGetNextIDRestart(t *testing.T) {
+	ctx := context.Background()
+	store := memmap.GetStore()
+	first, err := newTestClient(t, store).IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "aaaa"})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if first != "1" {
+		t.Errorf("first ID = %q, want %q", first, "1")
+	}
+
+	// A new backend on the same store continues after the IDs reserved by
+	// the first one.
+	c := newTestClient(t, store)
+	second, err := c.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "bbbb"})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if second != strconv.Itoa(idBlockSize+1) {
+		t.Errorf("second ID = %q, want %d", second, idBlockSize+1)
+	}
+	for _, id := range []string{first, second} {
+		if _, err := c.Node(ctx, id); err != nil {
+			t.Errorf("Could not find node %s: %v", id, err)
+		}
+	}
+}
+
+func TestMigrateIDs(t *testing.T) {
+	ctx := context.Background()
+	store := memmap.GetStore()
+	c := newTestClient(t, store)
+	var ids []string
+	for _, digest := range []string{"aaaa", "bbbb", "cccc"} {
+		id, err := c.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: digest})
+		if err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+		ids = append(ids, id)
+	}
+
+	// Give the last artifact the ID of the first one, as a backend that
+	// restarted counting from one did, and drop the counter it did not have.
+	last, err := byIDkv[*artStruct](ctx, ids[2], c)
+	if err != nil {
+		t.Fatalf("Could not read artifact: %v", err)
+	}
+	last.ThisID = ids[0]
+	if err := setkv(ctx, artCol, last, c); err != nil {
+		t.Fatalf("Could not write artifact: %v", err)
+	}
+	if err := store.Delete(ctx, idCol, idKey); err != nil {
+		t.Fatalf("Could not delete ID counter: %v", err)
+	}
+
+	c = &demoClient{kv: store}
+	got, err := c.migrateIDs(ctx)
+	if err != nil {
+		t.Fatalf("migrateIDs() error = %v", err)
+	}
+	want := []idCollision{{
+		ID:      ids[0],
+		Indexed: artCol + ":sha256:aaaa",
+		Nodes:   []string{artCol + ":sha256:cccc"},
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("migrateIDs() unexpected results (-want +got):\n%s", diff)
+	}
+
+	id, err := c.getNextID(ctx)
+	if err != nil {
+		t.Fatalf("getNextID() error = %v", err)
+	}
+	if maxID, _ := strconv.Atoi(ids[2]); id != strconv.Itoa(maxID+1) {
+		t.Errorf("getNextID() after migration = %q, want %d", id, maxID+1)
+	}
+
+	// The counter is only missing once.
+	if got, err := c.migrateIDs(ctx); err != nil || got != nil {
+		t.Errorf("second migrateIDs() = %v, %v, want no collisions", got, err)
+	}
+}
+
+func TestIsDependencyKeyScope(t *testing.T) {
+	link := isDependencyLink{PackageID: "1", DepPackageID: "2", DependencyType: model.DependencyTypeDirect, Justification: "test", Origin: "test", Collector: "test"}
+	legacy := link.Key()
+	// the key of a dependency stored before scopes were recorded
+	if want := "1:2::DIRECT:test:test:test"; legacy != want {
+		t.Errorf("Key() without a scope = %q, want %q", legacy, want)
+	}
+	link.DependencyScope = model.DependencyScopeUnknown
+	if got := link.Key(); got != legacy {
+		t.Errorf("Key() of UNKNOWN scope = %q, want %q", got, legacy)
+	}
+	link.DependencyScope = model.DependencyScopeDev
+	if got, want := link.Key(), "1:2::DIRECT:DEV:test:test:test"; got != want {
+		t.Errorf("Key() of DEV scope = %q, want %q", got, want)
+	}
+}
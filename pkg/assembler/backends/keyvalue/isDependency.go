@@ -18,10 +18,12 @@ package keyvalue
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -33,22 +35,41 @@ type isDependencyLink struct {
 	DepPackageID   string
 	VersionRange   string
 	DependencyType model.DependencyType
-	Justification  string
-	Origin         string
-	Collector      string
+	// DependencyScope is empty for the dependencies stored before scopes
+	// were recorded, which are of UNKNOWN scope.
+	DependencyScope model.DependencyScope
+	Justification   string
+	Origin          string
+	Collector       string
+	Verified        *bool
+	Signer          *string
 }
 
 func (n *isDependencyLink) ID() string { return n.ThisID }
 func (n *isDependencyLink) Key() string {
-	return strings.Join([]string{
+	keys := []string{
 		n.PackageID,
 		n.DepPackageID,
 		n.VersionRange,
 		string(n.DependencyType),
+	}
+	// dependencies of UNKNOWN scope keep the key of the ones stored before
+	// scopes were recorded, so that they are not ingested again
+	if scope := n.scope(); scope != model.DependencyScopeUnknown {
+		keys = append(keys, string(scope))
+	}
+	return strings.Join(append(keys,
 		n.Justification,
 		n.Origin,
 		n.Collector,
-	}, ":")
+	), ":")
+}
+
+func (n *isDependencyLink) scope() model.DependencyScope {
+	if n.DependencyScope == "" {
+		return model.DependencyScopeUnknown
+	}
+	return n.DependencyScope
 }
 
 func (n *isDependencyLink) Neighbors(allowedEdges edgeMap) []string {
@@ -87,15 +108,18 @@ func (c *demoClient) ingestDependency(ctx context.Context, packageArg model.PkgI
 	funcName := "IngestDependency"
 
 	inLink := &isDependencyLink{
-		VersionRange:   dependency.VersionRange,
-		DependencyType: dependency.DependencyType,
-		Justification:  dependency.Justification,
-		Origin:         dependency.Origin,
-		Collector:      dependency.Collector,
+		VersionRange:    dependency.VersionRange,
+		DependencyType:  dependency.DependencyType,
+		DependencyScope: helper.DependencyScopeOrUnknown(dependency.DependencyScope),
+		Justification:   dependency.Justification,
+		Origin:          dependency.Origin,
+		Collector:       dependency.Collector,
+		Verified:        dependency.Verified,
+		Signer:          dependency.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	// for IsDependency the dependent package will return the ID at the
 	// packageName node. VersionRange will be used to specify the versions are
@@ -112,6 +136,10 @@ func (c *demoClient) ingestDependency(ctx context.Context, packageArg model.PkgI
 	}
 	inLink.DepPackageID = depPkg.ID()
 
+	if !readOnly {
+		unlock := c.keys.lock(isDepCol, inLink.Key())
+		defer unlock()
+	}
 	outLink, err := byKeykv[*isDependencyLink](ctx, isDepCol, inLink.Key(), c)
 	if err == nil {
 		return outLink.ThisID, nil
@@ -127,17 +155,19 @@ func (c *demoClient) ingestDependency(ctx context.Context, packageArg model.PkgI
 		return d, err
 	}
 
-	inLink.ThisID = c.getNextID()
+	if inLink.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, isDepCol, inLink); err != nil {
 		return "", err
 	}
-	if err := foundPkgVersion.setIsDependencyLinks(ctx, inLink.ThisID, c); err != nil {
+	if err := setkv(ctx, isDepCol, inLink, c); err != nil {
 		return "", err
 	}
-	if err := depPkg.setIsDependencyLinks(ctx, inLink.ThisID, c); err != nil {
+	if err := foundPkgVersion.setIsDependencyLinks(ctx, inLink.ThisID, c); err != nil {
 		return "", err
 	}
-	if err := setkv(ctx, isDepCol, inLink, c); err != nil {
+	if err := depPkg.setIsDependencyLinks(ctx, inLink.ThisID, c); err != nil {
 		return "", err
 	}
 	outLink = inLink
@@ -164,70 +194,73 @@ func (c *demoClient) IsDependency(ctx context.Context, filter *model.IsDependenc
 		return []*model.IsDependency{foundIsDependency}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.isDependencyQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// isDependencyQuery returns the IsDependency query, narrowed to the links of
+// the nodes the filter names, if any.
+func (c *demoClient) isDependencyQuery(ctx context.Context, filter *model.IsDependencySpec) (*nodeQuery[*isDependencyLink, *model.IsDependencySpec, *model.IsDependency], error) {
+	q := newNodeQuery(isDepCol, filter, c.addDepIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	if filter != nil && filter.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
-		foundOne = len(pkgs) > 0
+		q.searched = len(pkgs) > 0
 		for _, pkg := range pkgs {
-			search = append(search, pkg.IsDependencyLinks...)
+			q.ids = append(q.ids, pkg.IsDependencyLinks...)
 		}
 	}
-	if !foundOne && filter != nil && filter.DependencyPackage != nil {
+	if !q.searched && filter != nil && filter.DependencyPackage != nil {
 		if filter.DependencyPackage.Version == nil { // FIXME this logic isn't exactly correct
 			exactPackage, err := c.exactPackageName(ctx, filter.DependencyPackage)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, err
 			}
 			if exactPackage != nil {
-				search = append(search, exactPackage.IsDependencyLinks...)
-				foundOne = true
+				q.ids = append(q.ids, exactPackage.IsDependencyLinks...)
+				q.searched = true
 			}
 		} else {
 			pkgs, err := c.findPackageVersion(ctx, filter.DependencyPackage)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, err
 			}
-			foundOne = len(pkgs) > 0
+			q.searched = len(pkgs) > 0
 			for _, pkg := range pkgs {
-				search = append(search, pkg.IsDependencyLinks...)
+				q.ids = append(q.ids, pkg.IsDependencyLinks...)
 			}
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.IsDependency
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*isDependencyLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addDepIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		depKeys, err := c.kv.Keys(ctx, isDepCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, depKey := range depKeys {
-			link, err := byKeykv[*isDependencyLink](ctx, isDepCol, depKey, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addDepIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// IsDependencyList returns a page of the IsDependency query results, in the
+// order of their keys.
+func (c *demoClient) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.isDependencyQuery(ctx, isDependencySpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("IsDependencyList :: %v", err)
 	}
-
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("IsDependencyList :: %v", err)
+	}
+	return helper.IsDependencyConnection(p), nil
 }
 
 func (c *demoClient) buildIsDependency(ctx context.Context, link *isDependencyLink, filter *model.IsDependencySpec, ingestOrIDProvided bool) (*model.IsDependency, error) {
@@ -278,9 +311,12 @@ func (c *demoClient) buildIsDependency(ctx context.Context, link *isDependencyLi
 		DependencyPackage: dep,
 		VersionRange:      link.VersionRange,
 		DependencyType:    link.DependencyType,
+		DependencyScope:   link.scope(),
 		Justification:     link.Justification,
 		Origin:            link.Origin,
 		Collector:         link.Collector,
+		Verified:          link.Verified,
+		Signer:            link.Signer,
 	}
 	return &foundIsDependency, nil
 }
@@ -308,7 +344,9 @@ func noMatchIsDep(filter *model.IsDependencySpec, link *isDependencyLink) bool {
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.Collector, link.Collector) ||
 			noMatch(filter.VersionRange, link.VersionRange) ||
-			(filter.DependencyType != nil && *filter.DependencyType != link.DependencyType)
+			(filter.DependencyType != nil && *filter.DependencyType != link.DependencyType) ||
+			(filter.DependencyScope != nil && *filter.DependencyScope != link.scope()) ||
+			slices.Contains(filter.ExcludeDependencyScopes, link.scope())
 	} else {
 		return false
 	}
@@ -75,6 +75,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -107,6 +108,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -139,6 +141,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -171,6 +174,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification one",
 				},
 			},
@@ -201,6 +205,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p1out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -230,6 +235,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p2out,
 					DependencyPackage: p4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -259,10 +265,12 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p1out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 				{
 					Package:           p3out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -295,6 +303,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p3out,
 					DependencyPackage: p4outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -358,6 +367,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p2out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 				},
 			},
 		},
@@ -389,6 +399,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p1out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					VersionRange:      "1-3",
 				},
 			},
@@ -421,10 +432,81 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p2out,
 					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					DependencyType:    model.DependencyTypeIndirect,
 				},
 			},
 		},
+		{
+			Name:  "Query on DependencyScope",
+			InPkg: []*model.PkgInputSpec{p1, p2},
+			Calls: []call{
+				{
+					P1: p1,
+					P2: p1,
+					MF: mAll,
+					ID: &model.IsDependencyInputSpec{
+						DependencyScope: ptrfrom.Any(model.DependencyScopeRuntime),
+					},
+				},
+				{
+					P1: p2,
+					P2: p1,
+					MF: mAll,
+					ID: &model.IsDependencyInputSpec{
+						DependencyScope: ptrfrom.Any(model.DependencyScopeTest),
+					},
+				},
+			},
+			Query: &model.IsDependencySpec{
+				DependencyScope: ptrfrom.Any(model.DependencyScopeTest),
+			},
+			ExpID: []*model.IsDependency{
+				{
+					Package:           p2out,
+					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeTest,
+				},
+			},
+		},
+		{
+			Name:  "Query excluding DependencyScopes",
+			InPkg: []*model.PkgInputSpec{p1, p2, p3},
+			Calls: []call{
+				{
+					P1: p1,
+					P2: p2,
+					MF: mAll,
+					ID: &model.IsDependencyInputSpec{
+						DependencyScope: ptrfrom.Any(model.DependencyScopeDev),
+					},
+				},
+				{
+					P1: p1,
+					P2: p3,
+					MF: mAll,
+					ID: &model.IsDependencyInputSpec{
+						DependencyScope: ptrfrom.Any(model.DependencyScopeTest),
+					},
+				},
+				{
+					P1: p2,
+					P2: p1,
+					MF: mAll,
+					ID: &model.IsDependencyInputSpec{},
+				},
+			},
+			Query: &model.IsDependencySpec{
+				ExcludeDependencyScopes: []model.DependencyScope{model.DependencyScopeDev, model.DependencyScopeTest},
+			},
+			ExpID: []*model.IsDependency{
+				{
+					Package:           p2out,
+					DependencyPackage: p1outName,
+					DependencyScope:   model.DependencyScopeUnknown,
+				},
+			},
+		},
 		{
 			Name:  "Ingest no P1",
 			InPkg: []*model.PkgInputSpec{p2},
@@ -471,6 +553,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p3out,
 					DependencyPackage: p2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -495,6 +578,7 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p3out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -527,11 +611,13 @@ func TestIsDependency(t *testing.T) {
 				{
 					Package:           p3out,
 					DependencyPackage: p2outName,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 				{
 					Package:           p3out,
 					DependencyPackage: p2out,
+					DependencyScope:   model.DependencyScopeUnknown,
 					Justification:     "test justification",
 				},
 			},
@@ -22,6 +22,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -36,6 +37,8 @@ type isOccurrenceStruct struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 }
 
 func (n *isOccurrenceStruct) ID() string { return n.ThisID }
@@ -108,10 +111,12 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 		Justification: occurrence.Justification,
 		Origin:        occurrence.Origin,
 		Collector:     occurrence.Collector,
+		Verified:      occurrence.Verified,
+		Signer:        occurrence.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	a, err := c.artifactByInput(ctx, &artifact)
 	if err != nil {
@@ -139,6 +144,10 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 		in.Source = src.ThisID
 	}
 
+	if !readOnly {
+		unlock := c.keys.lock(occCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*isOccurrenceStruct](ctx, occCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -153,10 +162,15 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 		c.m.RLock() // relock so that defer unlock does not panic
 		return o, err
 	}
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, occCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, occCol, in, c); err != nil {
+		return "", err
+	}
 	if err := a.setOccurrences(ctx, in.ThisID, c); err != nil {
 		return "", err
 	}
@@ -169,9 +183,6 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 			return "", err
 		}
 	}
-	if err := setkv(ctx, occCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -187,6 +198,8 @@ func (c *demoClient) convOccurrence(ctx context.Context, in *isOccurrenceStruct)
 		Justification: in.Justification,
 		Origin:        in.Origin,
 		Collector:     in.Collector,
+		Verified:      in.Verified,
+		Signer:        in.Signer,
 	}
 	if in.Pkg != "" {
 		p, err := c.buildPackageResponse(ctx, in.Pkg, nil)
@@ -247,68 +260,72 @@ func (c *demoClient) IsOccurrence(ctx context.Context, filter *model.IsOccurrenc
 		return []*model.IsOccurrence{o}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.isOccurrenceQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// isOccurrenceQuery returns the IsOccurrence query, narrowed to the links of
+// the nodes the filter names, if any.
+func (c *demoClient) isOccurrenceQuery(ctx context.Context, filter *model.IsOccurrenceSpec) (*nodeQuery[*isOccurrenceStruct, *model.IsOccurrenceSpec, *model.IsOccurrence], error) {
+	q := newNodeQuery(occCol, filter, c.addOccIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	if filter != nil && filter.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactArtifact != nil {
-			search = append(search, exactArtifact.Occurrences...)
-			foundOne = true
+			q.ids = append(q.ids, exactArtifact.Occurrences...)
+			q.searched = true
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
-		foundOne = len(pkgs) > 0
+		q.searched = len(pkgs) > 0
 		for _, pkg := range pkgs {
-			search = append(search, pkg.Occurrences...)
+			q.ids = append(q.ids, pkg.Occurrences...)
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = append(search, exactSource.Occurrences...)
-			foundOne = true
+			q.ids = append(q.ids, exactSource.Occurrences...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.IsOccurrence
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*isOccurrenceStruct](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addOccIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		occKeys, err := c.kv.Keys(ctx, occCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, ok := range occKeys {
-			link, err := byKeykv[*isOccurrenceStruct](ctx, occCol, ok, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addOccIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// IsOccurrenceList returns a page of the IsOccurrence query results, in the
+// order of their keys.
+func (c *demoClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.isOccurrenceQuery(ctx, isOccurrenceSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("IsOccurrenceList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("IsOccurrenceList :: %v", err)
+	}
+	return helper.IsOccurrenceConnection(p), nil
 }
 
 func (c *demoClient) addOccIfMatch(ctx context.Context, out []*model.IsOccurrence,
@@ -22,6 +22,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -55,8 +56,9 @@ func (n *licStruct) BuildModelNode(ctx context.Context, c *demoClient) (model.No
 }
 
 func (n *licStruct) setCertifyLegals(ctx context.Context, id string, c *demoClient) error {
-	n.CertifyLegals = append(n.CertifyLegals, id)
-	return setkv(ctx, licenseCol, n, c)
+	return updatekv(ctx, licenseCol, n, c, func(n *licStruct) {
+		n.CertifyLegals = append(n.CertifyLegals, id)
+	})
 }
 
 func (c *demoClient) licenseByInput(ctx context.Context, b *model.LicenseInputSpec) (*licStruct, error) {
@@ -92,9 +94,13 @@ func (c *demoClient) ingestLicense(ctx context.Context, license *model.LicenseIn
 		ListVersion: nilToEmpty(license.ListVersion),
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
+	if !readOnly {
+		unlock := c.keys.lock(licenseCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*licStruct](ctx, licenseCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -108,7 +114,9 @@ func (c *demoClient) ingestLicense(ctx context.Context, license *model.LicenseIn
 		c.m.RLock() // relock so that defer unlock does not panic
 		return a, err
 	}
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 
 	if err := c.addToIndex(ctx, licenseCol, in); err != nil {
 		return "", err
@@ -160,32 +168,56 @@ func (c *demoClient) licenseExact(ctx context.Context, licenseSpec *model.Licens
 func (c *demoClient) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
-	a, err := c.licenseExact(ctx, licenseSpec)
+	q, err := c.licensesQuery(ctx, licenseSpec)
 	if err != nil {
 		return nil, gqlerror.Errorf("Licenses :: invalid spec %s", err)
 	}
+	return q.all(ctx, c)
+}
+
+// licensesQuery returns the licenses query, narrowed to the license the spec
+// names exactly, if any.
+func (c *demoClient) licensesQuery(ctx context.Context, licenseSpec *model.LicenseSpec) (*nodeQuery[*licStruct, *model.LicenseSpec, *model.License], error) {
+	a, err := c.licenseExact(ctx, licenseSpec)
+	if err != nil {
+		return nil, err
+	}
 	if a != nil {
-		return []*model.License{c.convLicense(a)}, nil
+		// an exact match is returned whatever the rest of the spec
+		return newNodeQuery(licenseCol, nil, c.addLicenseIfMatch).withID(a.ThisID), nil
+	}
+	q := newNodeQuery(licenseCol, licenseSpec, c.addLicenseIfMatch)
+	if licenseSpec != nil && licenseSpec.Name != nil {
+		// keys start with the name
+		q.withPrefix(*licenseSpec.Name + ":")
 	}
+	return q, nil
+}
+
+func (c *demoClient) addLicenseIfMatch(_ context.Context, out []*model.License,
+	licenseSpec *model.LicenseSpec, l *licStruct) ([]*model.License, error) {
+	if licenseSpec != nil && (noMatch(licenseSpec.Name, l.Name) ||
+		noMatch(licenseSpec.ListVersion, l.ListVersion) ||
+		noMatch(licenseSpec.Inline, l.Inline)) {
+		return out, nil
+	}
+	return append(out, c.convLicense(l)), nil
+}
 
-	var rv []*model.License
-	lKeys, err := c.kv.Keys(ctx, licenseCol)
+// LicensesList returns a page of the licenses query results, in the order of
+// their keys.
+func (c *demoClient) LicensesList(ctx context.Context, licenseSpec *model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.licensesQuery(ctx, licenseSpec)
 	if err != nil {
-		return nil, err
+		return nil, gqlerror.Errorf("LicensesList :: invalid spec %s", err)
 	}
-	for _, lk := range lKeys {
-		l, err := byKeykv[*licStruct](ctx, licenseCol, lk, c)
-		if err != nil {
-			return nil, err
-		}
-		if noMatch(licenseSpec.Name, l.Name) ||
-			noMatch(licenseSpec.ListVersion, l.ListVersion) ||
-			noMatch(licenseSpec.Inline, l.Inline) {
-			continue
-		}
-		rv = append(rv, c.convLicense(l))
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("LicensesList :: %v", err)
 	}
-	return rv, nil
+	return helper.LicenseConnection(p), nil
 }
 
 func (c *demoClient) convLicense(a *licStruct) *model.License {
@@ -0,0 +1,131 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// Locking
+//
+// Ingestions and queries share demoClient.m, only deletions, which rewrite
+// the links of many nodes, take it exclusively. Ingestions instead lock the
+// keys they write:
+//
+//   - A new node is stored while holding the lock of its key, so that
+//     concurrent ingestions of the same node agree on a single ID.
+//   - A back edge is added with updatekv, while holding the lock of the key
+//     of the node it is added to, so that concurrent updates are not lost.
+//
+// Nodes only ever hold the lock of a node they reference while holding their
+// own, and references are acyclic, so key locks can not deadlock.
+//
+// Stores may hand out the stored values themselves, so nodes are never
+// modified once stored, updatekv stores an updated copy instead. A new node
+// is indexed and stored before any back edge to it is added, so that queries
+// following back edges never miss it.
+//
+// All these locks live in the memory of the process: the kv.Store interface
+// has no compare-and-swap or transactions to build them on. Stores such as
+// Redis or TiKV can be shared by several processes, but only a single one
+// may write to a store at a time. Concurrent writers can store a node twice
+// under different IDs and lose back edges.
+
+// keyLocks holds a mutex for each key that is locked or waited on.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	waiters int
+}
+
+// lock locks the key of a collection, and returns the function to unlock it.
+func (l *keyLocks) lock(coll, key string) func() {
+	k := strings.Join([]string{coll, key}, ":")
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*keyLock)
+	}
+	kl, ok := l.locks[k]
+	if !ok {
+		kl = &keyLock{}
+		l.locks[k] = kl
+	}
+	kl.waiters++
+	l.mu.Unlock()
+
+	kl.Lock()
+	return func() {
+		kl.Unlock()
+		l.mu.Lock()
+		kl.waiters--
+		if kl.waiters == 0 {
+			delete(l.locks, k)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// updatekv applies update to a copy of the stored version of n and stores
+// it. Only the key of n is used, n itself is not modified.
+func updatekv[E node](ctx context.Context, coll string, n E, c *demoClient, update func(E)) error {
+	unlock := c.keys.lock(coll, n.Key())
+	defer unlock()
+	stored, err := byKeykv[E](ctx, coll, n.Key(), c)
+	if err != nil {
+		return err
+	}
+	updated := copyNode(stored)
+	update(updated)
+	return setkv(ctx, coll, updated, c)
+}
+
+// copyNode returns a shallow copy of n, for updates that must not modify the
+// stored value.
+func copyNode[E node](n E) E {
+	cp := reflect.New(reflect.TypeOf(n).Elem())
+	cp.Elem().Set(reflect.ValueOf(n).Elem())
+	return cp.Interface().(E)
+}
+
+// lookupOrStore returns the stored node with the key of in. If there is none,
+// it calls store to store in, while holding the lock of the key, and returns
+// in.
+func lookupOrStore[E node](ctx context.Context, coll string, in E, c *demoClient, store func() error) (E, error) {
+	out, err := byKeykv[E](ctx, coll, in.Key(), c)
+	if !errors.Is(err, kv.NotFoundError) {
+		return out, err
+	}
+	unlock := c.keys.lock(coll, in.Key())
+	defer unlock()
+	out, err = byKeykv[E](ctx, coll, in.Key(), c)
+	if !errors.Is(err, kv.NotFoundError) {
+		return out, err
+	}
+	if err := store(); err != nil {
+		return out, err
+	}
+	return in, nil
+}
@@ -0,0 +1,146 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Paginated queries page through the nodes of a collection in the order of
+// their keys in the store, which the store scans in, and the cursor of an
+// edge is the key of the node behind it.
+
+// nodeQuery is a query on the nodes of a collection, shared by the query of a
+// type and its paginated list. Unless it is narrowed to the nodes with the
+// IDs found from its filter, usually the links of the nodes the filter
+// names, the collection is scanned, only the keys starting with prefix if
+// the filter fixes the start of the key.
+type nodeQuery[E node, F, T any] struct {
+	coll   string
+	filter F
+	// add appends the node built from n to out if n matches the filter.
+	add func(ctx context.Context, out []T, filter F, n E) ([]T, error)
+
+	prefix   string
+	searched bool
+	ids      []string
+	// byID is set when ids holds the ID of the filter, which is no error
+	// if missing.
+	byID bool
+}
+
+func newNodeQuery[E node, F, T any](coll string, filter F, add func(context.Context, []T, F, E) ([]T, error)) *nodeQuery[E, F, T] {
+	return &nodeQuery[E, F, T]{
+		coll:   coll,
+		filter: filter,
+		add:    add,
+	}
+}
+
+// withID narrows the query to the node with the given ID.
+func (q *nodeQuery[E, F, T]) withID(id string) *nodeQuery[E, F, T] {
+	q.searched = true
+	q.ids = []string{id}
+	q.byID = true
+	return q
+}
+
+// withPrefix narrows the scan of the query to the keys starting with prefix.
+func (q *nodeQuery[E, F, T]) withPrefix(prefix string) *nodeQuery[E, F, T] {
+	q.prefix = prefix
+	return q
+}
+
+// all returns the nodes built from all the matching nodes.
+func (q *nodeQuery[E, F, T]) all(ctx context.Context, c *demoClient) ([]T, error) {
+	var out []T
+	err := q.visit(ctx, c, false, func(n E) error {
+		var err error
+		out, err = q.add(ctx, out, q.filter, n)
+		return err
+	})
+	return out, err
+}
+
+// page returns the page of the nodes built from the matching nodes that
+// follows the after cursor, and counts all of them. As the nodes are visited
+// in the order of their cursors, only the page is kept in memory.
+func (q *nodeQuery[E, F, T]) page(ctx context.Context, c *demoClient, after *string, first *int) (*helper.Page[T], error) {
+	if err := helper.ValidatePaginationFirst(first); err != nil {
+		return nil, err
+	}
+	p := &helper.Page[T]{PageInfo: &model.PageInfo{}}
+	if err := q.visit(ctx, c, true, func(n E) error {
+		out, err := q.add(ctx, nil, q.filter, n)
+		if err != nil || len(out) == 0 {
+			return err
+		}
+		p.TotalCount++
+		key := n.Key()
+		switch {
+		case after != nil && key <= *after:
+		case first != nil && len(p.Nodes) == *first:
+			p.PageInfo.HasNextPage = true
+		default:
+			p.Nodes = append(p.Nodes, out[0])
+			p.Cursors = append(p.Cursors, key)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	p.SetCursors()
+	return p, nil
+}
+
+// visit calls f with the nodes the query may match, in key order if ordered
+// is set.
+func (q *nodeQuery[E, F, T]) visit(ctx context.Context, c *demoClient, ordered bool, f func(E) error) error {
+	if !q.searched {
+		return scanKVPrefix(ctx, q.coll, q.prefix, c, f)
+	}
+	nodes := make([]E, 0, len(q.ids))
+	for _, id := range q.ids {
+		n, err := byIDkv[E](ctx, id, c)
+		if err != nil {
+			if q.byID {
+				return nil
+			}
+			return err
+		}
+		nodes = append(nodes, n)
+	}
+	if ordered {
+		keys := make(map[string]string, len(nodes))
+		for _, n := range nodes {
+			keys[n.ID()] = n.Key()
+		}
+		slices.SortFunc(nodes, func(a, b E) int {
+			return strings.Compare(keys[a.ID()], keys[b.ID()])
+		})
+	}
+	for _, n := range nodes {
+		if err := f(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
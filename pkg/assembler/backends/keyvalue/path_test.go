@@ -370,6 +370,7 @@ func Test_Nodes(t *testing.T) {
 			IncludedDependencies: []*model.IsDependency{{
 				Package:           testdata.P2out,
 				DependencyPackage: testdata.P4out,
+				DependencyScope:   model.DependencyScopeUnknown,
 				Justification:     "test justification",
 			}},
 			IncludedOccurrences: []*model.IsOccurrence{{
@@ -424,6 +425,7 @@ func Test_Nodes(t *testing.T) {
 		want: []model.Node{&model.IsDependency{
 			Package:           testdata.P1out,
 			DependencyPackage: testdata.P2outName,
+			DependencyScope:   model.DependencyScopeUnknown,
 		}},
 	}, {
 		name:  "isOccurrence",
@@ -25,6 +25,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -204,109 +205,127 @@ func (n *pkgVersion) BuildModelNode(ctx context.Context, c *demoClient) (model.N
 
 // hasSourceAt back edges
 func (p *pkgName) setSrcMapLinks(ctx context.Context, id string, c *demoClient) error {
-	p.SrcMapLinks = append(p.SrcMapLinks, id)
-	return setkv(ctx, pkgNameCol, p, c)
+	return updatekv(ctx, pkgNameCol, p, c, func(p *pkgName) {
+		p.SrcMapLinks = append(p.SrcMapLinks, id)
+	})
 }
 func (p *pkgVersion) setSrcMapLinks(ctx context.Context, id string, c *demoClient) error {
-	p.SrcMapLinks = append(p.SrcMapLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.SrcMapLinks = append(p.SrcMapLinks, id)
+	})
 }
 func (p *pkgName) getSrcMapLinks() []string    { return p.SrcMapLinks }
 func (p *pkgVersion) getSrcMapLinks() []string { return p.SrcMapLinks }
 
 // isDependency back edges
 func (p *pkgName) setIsDependencyLinks(ctx context.Context, id string, c *demoClient) error {
-	p.IsDependencyLinks = append(p.IsDependencyLinks, id)
-	return setkv(ctx, pkgNameCol, p, c)
+	return updatekv(ctx, pkgNameCol, p, c, func(p *pkgName) {
+		p.IsDependencyLinks = append(p.IsDependencyLinks, id)
+	})
 }
 func (p *pkgVersion) setIsDependencyLinks(ctx context.Context, id string, c *demoClient) error {
-	p.IsDependencyLinks = append(p.IsDependencyLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.IsDependencyLinks = append(p.IsDependencyLinks, id)
+	})
 }
 func (p *pkgName) getIsDependencyLinks() []string    { return p.IsDependencyLinks }
 func (p *pkgVersion) getIsDependencyLinks() []string { return p.IsDependencyLinks }
 
 // isOccurrence back edges
 func (p *pkgVersion) setOccurrenceLinks(ctx context.Context, id string, c *demoClient) error {
-	p.Occurrences = append(p.Occurrences, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.Occurrences = append(p.Occurrences, id)
+	})
 }
 
 // certifyVulnerability back edges
 func (p *pkgVersion) setVulnerabilityLinks(ctx context.Context, id string, c *demoClient) error {
-	p.CertifyVulnLinks = append(p.CertifyVulnLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.CertifyVulnLinks = append(p.CertifyVulnLinks, id)
+	})
 }
 
 // certifyVexStatement back edges
 func (p *pkgVersion) setVexLinks(ctx context.Context, id string, c *demoClient) error {
-	p.VexLinks = append(p.VexLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.VexLinks = append(p.VexLinks, id)
+	})
 }
 
 // hasSBOM back edges
 func (p *pkgVersion) setHasSBOM(ctx context.Context, id string, c *demoClient) error {
-	p.HasSBOMs = append(p.HasSBOMs, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.HasSBOMs = append(p.HasSBOMs, id)
+	})
 }
 
 // certifyBad back edges
 func (p *pkgName) setCertifyBadLinks(ctx context.Context, id string, c *demoClient) error {
-	p.BadLinks = append(p.BadLinks, id)
-	return setkv(ctx, pkgNameCol, p, c)
+	return updatekv(ctx, pkgNameCol, p, c, func(p *pkgName) {
+		p.BadLinks = append(p.BadLinks, id)
+	})
 }
 func (p *pkgVersion) setCertifyBadLinks(ctx context.Context, id string, c *demoClient) error {
-	p.BadLinks = append(p.BadLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.BadLinks = append(p.BadLinks, id)
+	})
 }
 func (p *pkgName) getCertifyBadLinks() []string    { return p.BadLinks }
 func (p *pkgVersion) getCertifyBadLinks() []string { return p.BadLinks }
 
 // certifyGood back edges
 func (p *pkgName) setCertifyGoodLinks(ctx context.Context, id string, c *demoClient) error {
-	p.GoodLinks = append(p.GoodLinks, id)
-	return setkv(ctx, pkgNameCol, p, c)
+	return updatekv(ctx, pkgNameCol, p, c, func(p *pkgName) {
+		p.GoodLinks = append(p.GoodLinks, id)
+	})
 }
 func (p *pkgVersion) setCertifyGoodLinks(ctx context.Context, id string, c *demoClient) error {
-	p.GoodLinks = append(p.GoodLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.GoodLinks = append(p.GoodLinks, id)
+	})
 }
 func (p *pkgName) getCertifyGoodLinks() []string    { return p.GoodLinks }
 func (p *pkgVersion) getCertifyGoodLinks() []string { return p.GoodLinks }
 
 // hasMetadata back edges
 func (p *pkgName) setHasMetadataLinks(ctx context.Context, id string, c *demoClient) error {
-	p.HasMetadataLinks = append(p.HasMetadataLinks, id)
-	return setkv(ctx, pkgNameCol, p, c)
+	return updatekv(ctx, pkgNameCol, p, c, func(p *pkgName) {
+		p.HasMetadataLinks = append(p.HasMetadataLinks, id)
+	})
 }
 func (p *pkgVersion) setHasMetadataLinks(ctx context.Context, id string, c *demoClient) error {
-	p.HasMetadataLinks = append(p.HasMetadataLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.HasMetadataLinks = append(p.HasMetadataLinks, id)
+	})
 }
 func (p *pkgName) getHasMetadataLinks() []string    { return p.HasMetadataLinks }
 func (p *pkgVersion) getHasMetadataLinks() []string { return p.HasMetadataLinks }
 
 // pointOfContact back edges
 func (p *pkgName) setPointOfContactLinks(ctx context.Context, id string, c *demoClient) error {
-	p.PointOfContactLinks = append(p.PointOfContactLinks, id)
-	return setkv(ctx, pkgNameCol, p, c)
+	return updatekv(ctx, pkgNameCol, p, c, func(p *pkgName) {
+		p.PointOfContactLinks = append(p.PointOfContactLinks, id)
+	})
 }
 func (p *pkgVersion) setPointOfContactLinks(ctx context.Context, id string, c *demoClient) error {
-	p.PointOfContactLinks = append(p.PointOfContactLinks, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.PointOfContactLinks = append(p.PointOfContactLinks, id)
+	})
 }
 func (p *pkgName) getPointOfContactLinks() []string    { return p.PointOfContactLinks }
 func (p *pkgVersion) getPointOfContactLinks() []string { return p.PointOfContactLinks }
 
 // pkgEqual back edges
 func (p *pkgVersion) setPkgEquals(ctx context.Context, id string, c *demoClient) error {
-	p.PkgEquals = append(p.PkgEquals, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.PkgEquals = append(p.PkgEquals, id)
+	})
 }
 
 func (p *pkgVersion) setCertifyLegals(ctx context.Context, id string, c *demoClient) error {
-	p.CertifyLegals = append(p.CertifyLegals, id)
-	return setkv(ctx, pkgVerCol, p, c)
+	return updatekv(ctx, pkgVerCol, p, c, func(p *pkgVersion) {
+		p.CertifyLegals = append(p.CertifyLegals, id)
+	})
 }
 
 func (n *pkgType) Key() string {
@@ -314,8 +333,9 @@ func (n *pkgType) Key() string {
 }
 
 func (n *pkgType) addNamespace(ctx context.Context, ns string, c *demoClient) error {
-	n.Namespaces = append(n.Namespaces, ns)
-	return setkv(ctx, pkgTypeCol, n, c)
+	return updatekv(ctx, pkgTypeCol, n, c, func(n *pkgType) {
+		n.Namespaces = append(n.Namespaces, ns)
+	})
 }
 
 func (n *pkgNamespace) Key() string {
@@ -326,8 +346,9 @@ func (n *pkgNamespace) Key() string {
 }
 
 func (n *pkgNamespace) addName(ctx context.Context, name string, c *demoClient) error {
-	n.Names = append(n.Names, name)
-	return setkv(ctx, pkgNSCol, n, c)
+	return updatekv(ctx, pkgNSCol, n, c, func(n *pkgNamespace) {
+		n.Names = append(n.Names, name)
+	})
 }
 
 func (n *pkgName) Key() string {
@@ -338,8 +359,9 @@ func (n *pkgName) Key() string {
 }
 
 func (n *pkgName) addVersion(ctx context.Context, ver string, c *demoClient) error {
-	n.Versions = append(n.Versions, ver)
-	return setkv(ctx, pkgNameCol, n, c)
+	return updatekv(ctx, pkgNameCol, n, c, func(n *pkgName) {
+		n.Versions = append(n.Versions, ver)
+	})
 }
 
 func (n *pkgVersion) Key() string {
@@ -368,80 +390,61 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.PkgInputSpec
 		Type: input.Type,
 	}
 	c.m.RLock()
-	outType, err := byKeykv[*pkgType](ctx, pkgTypeCol, inType.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		if !errors.Is(err, kv.NotFoundError) {
-			return nil, err
+	defer c.m.RUnlock()
+	outType, err := lookupOrStore(ctx, pkgTypeCol, inType, c, func() error {
+		var err error
+		if inType.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Lock()
-		outType, err = byKeykv[*pkgType](ctx, pkgTypeCol, inType.Key(), c)
-		if err != nil {
-			if !errors.Is(err, kv.NotFoundError) {
-				return nil, err
-			}
-			inType.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, pkgTypeCol, inType); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, pkgTypeCol, inType, c); err != nil {
-				return nil, err
-			}
-			outType = inType
+		if err := c.addToIndex(ctx, pkgTypeCol, inType); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		return setkv(ctx, pkgTypeCol, inType, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	inNamespace := &pkgNamespace{
 		Parent:    outType.ThisID,
 		Namespace: nilToEmpty(input.Namespace),
 	}
-	c.m.RLock()
-	outNamespace, err := byKeykv[*pkgNamespace](ctx, pkgNSCol, inNamespace.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		c.m.Lock()
-		outNamespace, err = byKeykv[*pkgNamespace](ctx, pkgNSCol, inNamespace.Key(), c)
-		if err != nil {
-			inNamespace.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, pkgNSCol, inNamespace); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, pkgNSCol, inNamespace, c); err != nil {
-				return nil, err
-			}
-			if err := outType.addNamespace(ctx, inNamespace.ThisID, c); err != nil {
-				return nil, err
-			}
-			outNamespace = inNamespace
+	outNamespace, err := lookupOrStore(ctx, pkgNSCol, inNamespace, c, func() error {
+		var err error
+		if inNamespace.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		if err := c.addToIndex(ctx, pkgNSCol, inNamespace); err != nil {
+			return err
+		}
+		if err := setkv(ctx, pkgNSCol, inNamespace, c); err != nil {
+			return err
+		}
+		return outType.addNamespace(ctx, inNamespace.ThisID, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	inName := &pkgName{
 		Parent: outNamespace.ThisID,
 		Name:   input.Name,
 	}
-	c.m.RLock()
-	outName, err := byKeykv[*pkgName](ctx, pkgNameCol, inName.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		c.m.Lock()
-		outName, err = byKeykv[*pkgName](ctx, pkgNameCol, inName.Key(), c)
-		if err != nil {
-			inName.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, pkgNameCol, inName); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, pkgNameCol, inName, c); err != nil {
-				return nil, err
-			}
-			if err := outNamespace.addName(ctx, inName.ThisID, c); err != nil {
-				return nil, err
-			}
-			outName = inName
+	outName, err := lookupOrStore(ctx, pkgNameCol, inName, c, func() error {
+		var err error
+		if inName.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
+		}
+		if err := c.addToIndex(ctx, pkgNameCol, inName); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		if err := setkv(ctx, pkgNameCol, inName, c); err != nil {
+			return err
+		}
+		return outNamespace.addName(ctx, inName.ThisID, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	inVersion := &pkgVersion{
@@ -450,26 +453,21 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.PkgInputSpec
 		Subpath:    nilToEmpty(input.Subpath),
 		Qualifiers: getQualifiersFromInput(input.Qualifiers),
 	}
-	c.m.RLock()
-	outVersion, err := byKeykv[*pkgVersion](ctx, pkgVerCol, inVersion.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		c.m.Lock()
-		outVersion, err = byKeykv[*pkgVersion](ctx, pkgVerCol, inVersion.Key(), c)
-		if err != nil {
-			inVersion.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, pkgVerCol, inVersion); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, pkgVerCol, inVersion, c); err != nil {
-				return nil, err
-			}
-			if err := outName.addVersion(ctx, inVersion.ThisID, c); err != nil {
-				return nil, err
-			}
-			outVersion = inVersion
+	outVersion, err := lookupOrStore(ctx, pkgVerCol, inVersion, c, func() error {
+		var err error
+		if inVersion.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		if err := c.addToIndex(ctx, pkgVerCol, inVersion); err != nil {
+			return err
+		}
+		if err := setkv(ctx, pkgVerCol, inVersion, c); err != nil {
+			return err
+		}
+		return outName.addVersion(ctx, inVersion.ThisID, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &model.PackageIDs{
@@ -511,43 +509,67 @@ func (c *demoClient) Packages(ctx context.Context, filter *model.PkgSpec) ([]*mo
 		return []*model.Package{p}, nil
 	}
 
-	out := []*model.Package{}
+	q := c.packagesQuery(ctx, filter)
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		out = []*model.Package{}
+	}
+	return out, nil
+}
+
+// packagesQuery returns the Packages query, narrowed to the type the filter
+// names, if any. Its nodes are the types, with the tries below them.
+func (c *demoClient) packagesQuery(ctx context.Context, filter *model.PkgSpec) *nodeQuery[*pkgType, *model.PkgSpec, *model.Package] {
+	q := newNodeQuery(pkgTypeCol, filter, c.addPackageIfMatch)
 	if filter != nil && filter.Type != nil {
+		q.searched = true
 		inType := &pkgType{
 			Type: *filter.Type,
 		}
-		pkgTypeNode, err := byKeykv[*pkgType](ctx, pkgTypeCol, inType.Key(), c)
-		if err == nil {
-			pNamespaces := c.buildPkgNamespace(ctx, pkgTypeNode, filter)
-			if len(pNamespaces) > 0 {
-				out = append(out, &model.Package{
-					ID:         pkgTypeNode.ThisID,
-					Type:       pkgTypeNode.Type,
-					Namespaces: pNamespaces,
-				})
-			}
+		if typeNode, err := byKeykv[*pkgType](ctx, pkgTypeCol, inType.Key(), c); err == nil {
+			q.ids = []string{typeNode.ThisID}
 		}
-	} else {
-		typeKeys, err := c.kv.Keys(ctx, pkgTypeCol)
+	}
+	return q
+}
+
+func (c *demoClient) addPackageIfMatch(ctx context.Context, out []*model.Package, filter *model.PkgSpec, typeNode *pkgType) ([]*model.Package, error) {
+	pNamespaces := c.buildPkgNamespace(ctx, typeNode, filter)
+	if len(pNamespaces) == 0 {
+		return out, nil
+	}
+	return append(out, &model.Package{
+		ID:         typeNode.ThisID,
+		Type:       typeNode.Type,
+		Namespaces: pNamespaces,
+	}), nil
+}
+
+// PackagesList returns a page of the Packages query results, in the order
+// of the keys of their types. A filter on an ID, which may be the ID of any
+// node of the tries, matches a single trie, which is paged by ID.
+func (c *demoClient) PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	if pkgSpec != nil && pkgSpec.ID != nil {
+		nodes, err := c.Packages(ctx, pkgSpec)
 		if err != nil {
 			return nil, err
 		}
-		for _, tk := range typeKeys {
-			pkgTypeNode, err := byKeykv[*pkgType](ctx, pkgTypeCol, tk, c)
-			if err != nil {
-				return nil, err
-			}
-			pNamespaces := c.buildPkgNamespace(ctx, pkgTypeNode, filter)
-			if len(pNamespaces) > 0 {
-				out = append(out, &model.Package{
-					ID:         pkgTypeNode.ThisID,
-					Type:       pkgTypeNode.Type,
-					Namespaces: pNamespaces,
-				})
-			}
+		p, err := helper.Paginate(nodes, func(n *model.Package) string { return n.ID }, after, first)
+		if err != nil {
+			return nil, err
 		}
+		return helper.PackageConnection(p), nil
 	}
-	return out, nil
+	c.m.RLock()
+	defer c.m.RUnlock()
+	p, err := c.packagesQuery(ctx, pkgSpec).page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("PackagesList :: %v", err)
+	}
+	return helper.PackageConnection(p), nil
 }
 
 func (c *demoClient) buildPkgNamespace(ctx context.Context, pkgTypeNode *pkgType, filter *model.PkgSpec) []*model.PackageNamespace {
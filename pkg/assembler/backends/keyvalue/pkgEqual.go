@@ -22,6 +22,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -33,6 +34,8 @@ type pkgEqualStruct struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 }
 
 func (n *pkgEqualStruct) ID() string { return n.ThisID }
@@ -76,6 +79,8 @@ func (c *demoClient) convPkgEqual(ctx context.Context, in *pkgEqualStruct) (*mod
 		Justification: in.Justification,
 		Origin:        in.Origin,
 		Collector:     in.Collector,
+		Verified:      in.Verified,
+		Signer:        in.Signer,
 	}
 	for _, id := range in.Pkgs {
 		p, err := c.buildPackageResponse(ctx, id, nil)
@@ -98,10 +103,12 @@ func (c *demoClient) ingestPkgEqual(ctx context.Context, pkg model.PkgInputSpec,
 		Justification: pkgEqual.Justification,
 		Origin:        pkgEqual.Origin,
 		Collector:     pkgEqual.Collector,
+		Verified:      pkgEqual.Verified,
+		Signer:        pkgEqual.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	pIDs := make([]string, 0, 2)
 	ps := make([]*pkgVersion, 0, 2)
@@ -116,6 +123,10 @@ func (c *demoClient) ingestPkgEqual(ctx context.Context, pkg model.PkgInputSpec,
 	slices.Sort(pIDs)
 	in.Pkgs = pIDs
 
+	if !readOnly {
+		unlock := c.keys.lock(pkgEqCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*pkgEqualStruct](ctx, pkgEqCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -131,18 +142,20 @@ func (c *demoClient) ingestPkgEqual(ctx context.Context, pkg model.PkgInputSpec,
 		return cp, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, pkgEqCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, pkgEqCol, in, c); err != nil {
+		return "", err
+	}
 	for _, p := range ps {
 		if err := p.setPkgEquals(ctx, in.ThisID, c); err != nil {
 			return "", err
 		}
 	}
-	if err := setkv(ctx, pkgEqCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -167,46 +180,52 @@ func (c *demoClient) PkgEqual(ctx context.Context, filter *model.PkgEqualSpec) (
 		return []*model.PkgEqual{pe}, nil
 	}
 
-	var search []string
+	q, err := c.pkgEqualQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// pkgEqualQuery returns the PkgEqual query, narrowed to the links of the
+// nodes the filter names, if any.
+func (c *demoClient) pkgEqualQuery(ctx context.Context, filter *model.PkgEqualSpec) (*nodeQuery[*pkgEqualStruct, *model.PkgEqualSpec, *model.PkgEqual], error) {
+	q := newNodeQuery(pkgEqCol, filter, c.addCPIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	for _, p := range filter.Packages {
 		pkgs, err := c.findPackageVersion(ctx, p)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		for _, pkg := range pkgs {
-			search = append(search, pkg.PkgEquals...)
+			q.ids = append(q.ids, pkg.PkgEquals...)
 		}
 	}
+	q.searched = len(q.ids) > 0
+	return q, nil
+}
 
-	var out []*model.PkgEqual
-	if len(search) > 0 {
-		for _, id := range search {
-			link, err := byIDkv[*pkgEqualStruct](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addCPIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		peKeys, err := c.kv.Keys(ctx, pkgEqCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, pek := range peKeys {
-			link, err := byKeykv[*pkgEqualStruct](ctx, pkgEqCol, pek, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addCPIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// PkgEqualList returns a page of the PkgEqual query results, in the order of
+// their keys.
+func (c *demoClient) PkgEqualList(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.pkgEqualQuery(ctx, pkgEqualSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("PkgEqualList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("PkgEqualList :: %v", err)
+	}
+	return helper.PkgEqualConnection(p), nil
 }
 
 func (c *demoClient) addCPIfMatch(ctx context.Context, out []*model.PkgEqual,
@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -39,6 +40,8 @@ type pointOfContactLink struct {
 	Justification string
 	Origin        string
 	Collector     string
+	Verified      *bool
+	Signer        *string
 }
 
 func (n *pointOfContactLink) ID() string { return n.ThisID }
@@ -120,10 +123,12 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 		Justification: pointOfContact.Justification,
 		Origin:        pointOfContact.Origin,
 		Collector:     pointOfContact.Collector,
+		Verified:      pointOfContact.Verified,
+		Signer:        pointOfContact.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	var foundPkgNameorVersionNode pkgNameOrVersion
 	var foundArtStrct *artStruct
@@ -151,6 +156,10 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 		in.SourceID = srcName.ThisID
 	}
 
+	if !readOnly {
+		unlock := c.keys.lock(pocCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*pointOfContactLink](ctx, pocCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -166,10 +175,15 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 		return b, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, pocCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, pocCol, in, c); err != nil {
+		return "", err
+	}
 
 	if foundPkgNameorVersionNode != nil {
 		if err := foundPkgNameorVersionNode.setPointOfContactLinks(ctx, in.ThisID, c); err != nil {
@@ -186,9 +200,6 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 			return "", err
 		}
 	}
-	if err := setkv(ctx, pocCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -213,60 +224,64 @@ func (c *demoClient) PointOfContact(ctx context.Context, filter *model.PointOfCo
 		return []*model.PointOfContact{found}, nil
 	}
 
+	q, err := c.pointOfContactQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// pointOfContactQuery returns the PointOfContact query, narrowed to the
+// links of the nodes the filter names, if any.
+func (c *demoClient) pointOfContactQuery(ctx context.Context, filter *model.PointOfContactSpec) (*nodeQuery[*pointOfContactLink, *model.PointOfContactSpec, *model.PointOfContact], error) {
+	q := newNodeQuery(pocCol, filter, c.addPOCIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	// Cant really search for an exact Pkg, as these can be linked to either
 	// names or versions, and version could be empty.
-	var search []string
-	foundOne := false
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactArtifact != nil {
-			search = append(search, exactArtifact.PointOfContactLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactArtifact.PointOfContactLinks...)
+			q.searched = true
 		}
 	}
-	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
+	if !q.searched && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, err
 		}
 		if exactSource != nil {
-			search = append(search, exactSource.PointOfContactLinks...)
-			foundOne = true
+			q.ids = append(q.ids, exactSource.PointOfContactLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.PointOfContact
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*pointOfContactLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addPOCIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		pocKeys, err := c.kv.Keys(ctx, pocCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, pk := range pocKeys {
-			link, err := byKeykv[*pointOfContactLink](ctx, pocCol, pk, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addPOCIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// PointOfContactList returns a page of the PointOfContact query results, in
+// the order of their keys.
+func (c *demoClient) PointOfContactList(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.pointOfContactQuery(ctx, pointOfContactSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("PointOfContactList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("PointOfContactList :: %v", err)
+	}
+	return helper.PointOfContactConnection(p), nil
 }
 
 func (c *demoClient) addPOCIfMatch(ctx context.Context, out []*model.PointOfContact, filter *model.PointOfContactSpec, link *pointOfContactLink) (
@@ -382,6 +397,8 @@ func (c *demoClient) buildPointOfContact(ctx context.Context, link *pointOfConta
 		Justification: link.Justification,
 		Origin:        link.Origin,
 		Collector:     link.Collector,
+		Verified:      link.Verified,
+		Signer:        link.Signer,
 	}
 	return &pointOfContact, nil
 }
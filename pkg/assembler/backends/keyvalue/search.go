@@ -17,11 +17,82 @@ package keyvalue
 
 import (
 	"context"
-	"fmt"
+	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
+// FindSoftware returns the package versions and sources whose name or
+// namespace contains searchText, and the artifacts whose digest does,
+// ignoring case. The store has no text index, so this scans all names.
 func (c *demoClient) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
-	return []model.PackageSourceOrArtifact{}, fmt.Errorf("not implemented: FindSoftware")
+	results := []model.PackageSourceOrArtifact{}
+	// Arbitrarily only search if the search text is longer than 2 characters
+	if len(searchText) <= 2 {
+		return results, nil
+	}
+	search := strings.ToLower(searchText)
+	matches := func(s string) bool {
+		return strings.Contains(strings.ToLower(s), search)
+	}
+
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	err := c.scanNodes(ctx, pkgNameCol, "", func(n node) error {
+		name := n.(*pkgName)
+		if !matches(name.Name) {
+			ns, err := byIDkv[*pkgNamespace](ctx, name.Parent, c)
+			if err != nil {
+				return err
+			}
+			if !matches(ns.Namespace) {
+				return nil
+			}
+		}
+		for _, id := range name.Versions {
+			p, err := c.buildPackageResponse(ctx, id, nil)
+			if err != nil {
+				return err
+			}
+			results = append(results, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.scanNodes(ctx, srcNameCol, "", func(n node) error {
+		name := n.(*srcNameNode)
+		if !matches(name.Name) {
+			ns, err := byIDkv[*srcNamespace](ctx, name.Parent, c)
+			if err != nil {
+				return err
+			}
+			if !matches(ns.Namespace) {
+				return nil
+			}
+		}
+		s, err := c.buildSourceResponse(ctx, name.ThisID, nil)
+		if err != nil {
+			return err
+		}
+		results = append(results, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.scanNodes(ctx, artCol, "", func(n node) error {
+		if a := n.(*artStruct); matches(a.Digest) {
+			results = append(results, c.convArtifact(a))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
 }
@@ -0,0 +1,97 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+func Test_FindSoftware(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, memmap.GetStore())
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackages(ctx, []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P3}); err != nil {
+		t.Fatalf("Could not ingest packages: %v", err)
+	}
+	if _, err := b.IngestSources(ctx, []*model.SourceInputSpec{testdata.S1, testdata.S2}); err != nil {
+		t.Fatalf("Could not ingest sources: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, testdata.A1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+
+	// the keyvalue backend leaves an empty tag and commit unset
+	s2out := &model.Source{
+		Type: "git",
+		Namespaces: []*model.SourceNamespace{{
+			Namespace: "github.com/bob",
+			Names:     []*model.SourceName{{Name: "bobsrepo"}},
+		}},
+	}
+	tests := []struct {
+		name       string
+		searchText string
+		want       []model.PackageSourceOrArtifact
+	}{{
+		name:       "package name",
+		searchText: "tensor",
+		want:       []model.PackageSourceOrArtifact{testdata.P1out, testdata.P2out, testdata.P3out},
+	}, {
+		name:       "source name",
+		searchText: "bobs",
+		want:       []model.PackageSourceOrArtifact{s2out},
+	}, {
+		name:       "source namespace, ignoring case",
+		searchText: "GITHUB.COM/BOB",
+		want:       []model.PackageSourceOrArtifact{s2out},
+	}, {
+		name:       "artifact digest",
+		searchText: "6bbb0da",
+		want:       []model.PackageSourceOrArtifact{testdata.A1out},
+	}, {
+		name:       "too short",
+		searchText: "te",
+		want:       []model.PackageSourceOrArtifact{},
+	}, {
+		name:       "no match",
+		searchText: "nothing",
+		want:       []model.PackageSourceOrArtifact{},
+	}}
+	ignoreID := cmp.FilterPath(func(p cmp.Path) bool {
+		return strings.Compare(".ID", p[len(p)-1].String()) == 0
+	}, cmp.Ignore())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.FindSoftware(ctx, tt.searchText)
+			if err != nil {
+				t.Fatalf("FindSoftware() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, ignoreID); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
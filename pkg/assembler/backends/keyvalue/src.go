@@ -23,6 +23,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -140,46 +141,56 @@ func (n *srcNameNode) BuildModelNode(ctx context.Context, c *demoClient) (model.
 }
 
 func (p *srcNameNode) setSrcMapLinks(ctx context.Context, id string, c *demoClient) error {
-	p.SrcMapLinks = append(p.SrcMapLinks, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.SrcMapLinks = append(p.SrcMapLinks, id)
+	})
 }
 func (p *srcNameNode) setScorecardLinks(ctx context.Context, id string, c *demoClient) error {
-	p.ScorecardLinks = append(p.ScorecardLinks, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.ScorecardLinks = append(p.ScorecardLinks, id)
+	})
 }
 func (p *srcNameNode) setOccurrenceLinks(ctx context.Context, id string, c *demoClient) error {
-	p.Occurrences = append(p.Occurrences, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.Occurrences = append(p.Occurrences, id)
+	})
 }
 func (p *srcNameNode) setCertifyBadLinks(ctx context.Context, id string, c *demoClient) error {
-	p.BadLinks = append(p.BadLinks, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.BadLinks = append(p.BadLinks, id)
+	})
 }
 func (p *srcNameNode) setCertifyGoodLinks(ctx context.Context, id string, c *demoClient) error {
-	p.GoodLinks = append(p.GoodLinks, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.GoodLinks = append(p.GoodLinks, id)
+	})
 }
 func (p *srcNameNode) setCertifyLegals(ctx context.Context, id string, c *demoClient) error {
-	p.CertifyLegals = append(p.CertifyLegals, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.CertifyLegals = append(p.CertifyLegals, id)
+	})
 }
 func (p *srcNameNode) setHasMetadataLinks(ctx context.Context, id string, c *demoClient) error {
-	p.HasMetadataLinks = append(p.HasMetadataLinks, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.HasMetadataLinks = append(p.HasMetadataLinks, id)
+	})
 }
 func (p *srcNameNode) setPointOfContactLinks(ctx context.Context, id string, c *demoClient) error {
-	p.PointOfContactLinks = append(p.PointOfContactLinks, id)
-	return setkv(ctx, srcNameCol, p, c)
+	return updatekv(ctx, srcNameCol, p, c, func(p *srcNameNode) {
+		p.PointOfContactLinks = append(p.PointOfContactLinks, id)
+	})
 }
 
 func (n *srcType) addNamespace(ctx context.Context, ns string, c *demoClient) error {
-	n.Namespaces = append(n.Namespaces, ns)
-	return setkv(ctx, srcTypeCol, n, c)
+	return updatekv(ctx, srcTypeCol, n, c, func(n *srcType) {
+		n.Namespaces = append(n.Namespaces, ns)
+	})
 }
 
 func (n *srcNamespace) addName(ctx context.Context, name string, c *demoClient) error {
-	n.Names = append(n.Names, name)
-	return setkv(ctx, srcNSCol, n, c)
+	return updatekv(ctx, srcNSCol, n, c, func(n *srcNamespace) {
+		n.Names = append(n.Names, name)
+	})
 }
 
 // Ingest Source
@@ -201,60 +212,40 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.SourceInputSp
 		Type: input.Type,
 	}
 	c.m.RLock()
-	outType, err := byKeykv[*srcType](ctx, srcTypeCol, inType.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		if !errors.Is(err, kv.NotFoundError) {
-			return nil, err
+	defer c.m.RUnlock()
+	outType, err := lookupOrStore(ctx, srcTypeCol, inType, c, func() error {
+		var err error
+		if inType.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Lock()
-		outType, err = byKeykv[*srcType](ctx, srcTypeCol, inType.Key(), c)
-		if err != nil {
-			if !errors.Is(err, kv.NotFoundError) {
-				return nil, err
-			}
-			inType.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, srcTypeCol, inType); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, srcTypeCol, inType, c); err != nil {
-				return nil, err
-			}
-			outType = inType
+		if err := c.addToIndex(ctx, srcTypeCol, inType); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		return setkv(ctx, srcTypeCol, inType, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	inNamespace := &srcNamespace{
 		Parent:    outType.ThisID,
 		Namespace: input.Namespace,
 	}
-	c.m.RLock()
-	outNamespace, err := byKeykv[*srcNamespace](ctx, srcNSCol, inNamespace.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		if !errors.Is(err, kv.NotFoundError) {
-			return nil, err
+	outNamespace, err := lookupOrStore(ctx, srcNSCol, inNamespace, c, func() error {
+		var err error
+		if inNamespace.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Lock()
-		outNamespace, err = byKeykv[*srcNamespace](ctx, srcNSCol, inNamespace.Key(), c)
-		if err != nil {
-			if !errors.Is(err, kv.NotFoundError) {
-				return nil, err
-			}
-			inNamespace.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, srcNSCol, inNamespace); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, srcNSCol, inNamespace, c); err != nil {
-				return nil, err
-			}
-			if err := outType.addNamespace(ctx, inNamespace.ThisID, c); err != nil {
-				return nil, err
-			}
-			outNamespace = inNamespace
+		if err := c.addToIndex(ctx, srcNSCol, inNamespace); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		if err := setkv(ctx, srcNSCol, inNamespace, c); err != nil {
+			return err
+		}
+		return outType.addNamespace(ctx, inNamespace.ThisID, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	inName := &srcNameNode{
@@ -263,32 +254,21 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.SourceInputSp
 		Tag:    nilToEmpty(input.Tag),
 		Commit: nilToEmpty(input.Commit),
 	}
-	c.m.RLock()
-	outName, err := byKeykv[*srcNameNode](ctx, srcNameCol, inName.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		if !errors.Is(err, kv.NotFoundError) {
-			return nil, err
+	outName, err := lookupOrStore(ctx, srcNameCol, inName, c, func() error {
+		var err error
+		if inName.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Lock()
-		outName, err = byKeykv[*srcNameNode](ctx, srcNameCol, inName.Key(), c)
-		if err != nil {
-			if !errors.Is(err, kv.NotFoundError) {
-				return nil, err
-			}
-			inName.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, srcNameCol, inName); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, srcNameCol, inName, c); err != nil {
-				return nil, err
-			}
-			if err := outNamespace.addName(ctx, inName.ThisID, c); err != nil {
-				return nil, err
-			}
-			outName = inName
+		if err := c.addToIndex(ctx, srcNameCol, inName); err != nil {
+			return err
+		}
+		if err := setkv(ctx, srcNameCol, inName, c); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		return outNamespace.addName(ctx, inName.ThisID, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &model.SourceIDs{
@@ -315,43 +295,67 @@ func (c *demoClient) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 		return []*model.Source{s}, nil
 	}
 
-	out := []*model.Source{}
+	q := c.sourcesQuery(ctx, filter)
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		out = []*model.Source{}
+	}
+	return out, nil
+}
+
+// sourcesQuery returns the Sources query, narrowed to the type the filter
+// names, if any. Its nodes are the types, with the tries below them.
+func (c *demoClient) sourcesQuery(ctx context.Context, filter *model.SourceSpec) *nodeQuery[*srcType, *model.SourceSpec, *model.Source] {
+	q := newNodeQuery(srcTypeCol, filter, c.addSourceIfMatch)
 	if filter != nil && filter.Type != nil {
+		q.searched = true
 		inType := &srcType{
 			Type: *filter.Type,
 		}
-		srcTypeNode, err := byKeykv[*srcType](ctx, srcTypeCol, inType.Key(), c)
-		if err == nil {
-			sNamespaces := c.buildSourceNamespace(ctx, srcTypeNode, filter)
-			if len(sNamespaces) > 0 {
-				out = append(out, &model.Source{
-					ID:         srcTypeNode.ThisID,
-					Type:       srcTypeNode.Type,
-					Namespaces: sNamespaces,
-				})
-			}
+		if typeNode, err := byKeykv[*srcType](ctx, srcTypeCol, inType.Key(), c); err == nil {
+			q.ids = []string{typeNode.ThisID}
 		}
-	} else {
-		typeKeys, err := c.kv.Keys(ctx, srcTypeCol)
+	}
+	return q
+}
+
+func (c *demoClient) addSourceIfMatch(ctx context.Context, out []*model.Source, filter *model.SourceSpec, typeNode *srcType) ([]*model.Source, error) {
+	sNamespaces := c.buildSourceNamespace(ctx, typeNode, filter)
+	if len(sNamespaces) == 0 {
+		return out, nil
+	}
+	return append(out, &model.Source{
+		ID:         typeNode.ThisID,
+		Type:       typeNode.Type,
+		Namespaces: sNamespaces,
+	}), nil
+}
+
+// SourcesList returns a page of the Sources query results, in the order
+// of the keys of their types. A filter on an ID, which may be the ID of any
+// node of the tries, matches a single trie, which is paged by ID.
+func (c *demoClient) SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	if sourceSpec != nil && sourceSpec.ID != nil {
+		nodes, err := c.Sources(ctx, sourceSpec)
 		if err != nil {
 			return nil, err
 		}
-		for _, tk := range typeKeys {
-			srcTypeNode, err := byKeykv[*srcType](ctx, srcTypeCol, tk, c)
-			if err != nil {
-				return nil, err
-			}
-			sNamespaces := c.buildSourceNamespace(ctx, srcTypeNode, filter)
-			if len(sNamespaces) > 0 {
-				out = append(out, &model.Source{
-					ID:         srcTypeNode.ThisID,
-					Type:       srcTypeNode.Type,
-					Namespaces: sNamespaces,
-				})
-			}
+		p, err := helper.Paginate(nodes, func(n *model.Source) string { return n.ID }, after, first)
+		if err != nil {
+			return nil, err
 		}
+		return helper.SourceConnection(p), nil
 	}
-	return out, nil
+	c.m.RLock()
+	defer c.m.RUnlock()
+	p, err := c.sourcesQuery(ctx, sourceSpec).page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("SourcesList :: %v", err)
+	}
+	return helper.SourceConnection(p), nil
 }
 
 func (c *demoClient) buildSourceNamespace(ctx context.Context, srcTypeNode *srcType, filter *model.SourceSpec) []*model.SourceNamespace {
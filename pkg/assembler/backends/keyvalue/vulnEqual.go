@@ -22,6 +22,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -34,6 +35,8 @@ type vulnerabilityEqualLink struct {
 	Justification   string
 	Origin          string
 	Collector       string
+	Verified        *bool
+	Signer          *string
 }
 
 func (n *vulnerabilityEqualLink) ID() string { return n.ThisID }
@@ -82,10 +85,12 @@ func (c *demoClient) ingestVulnEqual(ctx context.Context, vulnerability model.Vu
 		Justification: vulnEqual.Justification,
 		Origin:        vulnEqual.Origin,
 		Collector:     vulnEqual.Collector,
+		Verified:      vulnEqual.Verified,
+		Signer:        vulnEqual.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	vIDs := make([]string, 0, 2)
 	vs := make([]*vulnIDNode, 0, 2)
@@ -100,6 +105,10 @@ func (c *demoClient) ingestVulnEqual(ctx context.Context, vulnerability model.Vu
 	slices.Sort(vIDs)
 	in.Vulnerabilities = vIDs
 
+	if !readOnly {
+		unlock := c.keys.lock(vulnEqCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*vulnerabilityEqualLink](ctx, vulnEqCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -115,18 +124,20 @@ func (c *demoClient) ingestVulnEqual(ctx context.Context, vulnerability model.Vu
 		return cp, err
 	}
 
-	in.ThisID = c.getNextID()
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
+		return "", err
+	}
 	if err := c.addToIndex(ctx, vulnEqCol, in); err != nil {
 		return "", err
 	}
+	if err := setkv(ctx, vulnEqCol, in, c); err != nil {
+		return "", err
+	}
 	for _, v := range vs {
 		if err := v.setVulnEqualLinks(ctx, in.ThisID, c); err != nil {
 			return "", err
 		}
 	}
-	if err := setkv(ctx, vulnEqCol, in, c); err != nil {
-		return "", err
-	}
 
 	return in.ThisID, nil
 }
@@ -137,6 +148,8 @@ func (c *demoClient) convVulnEqual(ctx context.Context, in *vulnerabilityEqualLi
 		Justification: in.Justification,
 		Origin:        in.Origin,
 		Collector:     in.Collector,
+		Verified:      in.Verified,
+		Signer:        in.Signer,
 	}
 	for _, id := range in.Vulnerabilities {
 		v, err := c.buildVulnResponse(ctx, id, nil)
@@ -167,51 +180,55 @@ func (c *demoClient) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec)
 		return []*model.VulnEqual{ve}, nil
 	}
 
-	var search []string
-	foundOne := false
+	q, err := c.vulnEqualQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+// vulnEqualQuery returns the VulnEqual query, narrowed to the links of the
+// nodes the filter names, if any.
+func (c *demoClient) vulnEqualQuery(ctx context.Context, filter *model.VulnEqualSpec) (*nodeQuery[*vulnerabilityEqualLink, *model.VulnEqualSpec, *model.VulnEqual], error) {
+	q := newNodeQuery(vulnEqCol, filter, c.addVulnIfMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
+	}
+
 	for _, v := range filter.Vulnerabilities {
-		if !foundOne {
+		if !q.searched {
 			exactVuln, err := c.exactVulnerability(ctx, v)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, err
 			}
 			if exactVuln != nil {
-				search = append(search, exactVuln.VulnEqualLinks...)
-				foundOne = true
+				q.ids = append(q.ids, exactVuln.VulnEqualLinks...)
+				q.searched = true
 				break
 			}
 		}
 	}
+	return q, nil
+}
 
-	var out []*model.VulnEqual
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*vulnerabilityEqualLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addVulnIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		veKeys, err := c.kv.Keys(ctx, vulnEqCol)
-		if err != nil {
-			return nil, err
-		}
-		for _, vek := range veKeys {
-			link, err := byKeykv[*vulnerabilityEqualLink](ctx, vulnEqCol, vek, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addVulnIfMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
+// VulnEqualList returns a page of the VulnEqual query results, in the order
+// of their keys.
+func (c *demoClient) VulnEqualList(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.vulnEqualQuery(ctx, vulnEqualSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("VulnEqualList :: %v", err)
 	}
-	return out, nil
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("VulnEqualList :: %v", err)
+	}
+	return helper.VulnEqualConnection(p), nil
 }
 
 func (c *demoClient) addVulnIfMatch(ctx context.Context, out []*model.VulnEqual,
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -36,6 +37,8 @@ type vulnerabilityMetadataLink struct {
 	Timestamp       time.Time
 	Origin          string
 	Collector       string
+	Verified        *bool
+	Signer          *string
 }
 
 func (n *vulnerabilityMetadataLink) ID() string { return n.ThisID }
@@ -87,10 +90,12 @@ func (c *demoClient) ingestVulnerabilityMetadata(ctx context.Context, vulnerabil
 		ScoreValue: (vulnerabilityMetadata.ScoreValue),
 		Origin:     vulnerabilityMetadata.Origin,
 		Collector:  vulnerabilityMetadata.Collector,
+		Verified:   vulnerabilityMetadata.Verified,
+		Signer:     vulnerabilityMetadata.Signer,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.m.RLock()
+	defer c.m.RUnlock()
 
 	foundVulnNode, err := c.getVulnerabilityFromInput(ctx, vulnerability)
 	if err != nil {
@@ -98,6 +103,10 @@ func (c *demoClient) ingestVulnerabilityMetadata(ctx context.Context, vulnerabil
 	}
 	in.VulnerabilityID = foundVulnNode.ThisID
 
+	if !readOnly {
+		unlock := c.keys.lock(vulnMDCol, in.Key())
+		defer unlock()
+	}
 	out, err := byKeykv[*vulnerabilityMetadataLink](ctx, vulnMDCol, in.Key(), c)
 	if err == nil {
 		return out.ThisID, nil
@@ -113,16 +122,18 @@ func (c *demoClient) ingestVulnerabilityMetadata(ctx context.Context, vulnerabil
 		return cv, err
 	}
 
-	in.ThisID = c.getNextID()
-	if err := c.addToIndex(ctx, vulnMDCol, in); err != nil {
+	if in.ThisID, err = c.getNextID(ctx); err != nil {
 		return "", err
 	}
-	if err := foundVulnNode.setVulnMetadataLinks(ctx, in.ThisID, c); err != nil {
+	if err := c.addToIndex(ctx, vulnMDCol, in); err != nil {
 		return "", err
 	}
 	if err := setkv(ctx, vulnMDCol, in, c); err != nil {
 		return "", err
 	}
+	if err := foundVulnNode.setVulnMetadataLinks(ctx, in.ThisID, c); err != nil {
+		return "", err
+	}
 
 	return in.ThisID, nil
 }
@@ -147,50 +158,53 @@ func (c *demoClient) VulnerabilityMetadata(ctx context.Context, filter *model.Vu
 		return []*model.VulnerabilityMetadata{foundVulnMetadata}, nil
 	}
 
-	var search []string
-	foundOne := false
-	if !foundOne && filter != nil && filter.Vulnerability != nil {
+	q, err := c.vulnerabilityMetadataQuery(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
 
-		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
-		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-		}
-		if exactVuln != nil {
-			search = append(search, exactVuln.VulnMetadataLinks...)
-			foundOne = true
-		}
+// vulnerabilityMetadataQuery returns the VulnerabilityMetadata query,
+// narrowed to the links of the nodes the filter names, if any.
+func (c *demoClient) vulnerabilityMetadataQuery(ctx context.Context, filter *model.VulnerabilityMetadataSpec) (*nodeQuery[*vulnerabilityMetadataLink, *model.VulnerabilityMetadataSpec, *model.VulnerabilityMetadata], error) {
+	q := newNodeQuery(vulnMDCol, filter, c.addVulnMetadataMatch)
+	if filter != nil && filter.ID != nil {
+		return q.withID(*filter.ID), nil
 	}
 
-	var out []*model.VulnerabilityMetadata
-	if foundOne {
-		for _, id := range search {
-			link, err := byIDkv[*vulnerabilityMetadataLink](ctx, id, c)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			out, err = c.addVulnMetadataMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-		}
-	} else {
-		vmdKeys, err := c.kv.Keys(ctx, vulnMDCol)
+	if !q.searched && filter != nil && filter.Vulnerability != nil {
+
+		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
 		if err != nil {
 			return nil, err
 		}
-		for _, vmdk := range vmdKeys {
-			link, err := byKeykv[*vulnerabilityMetadataLink](ctx, vulnMDCol, vmdk, c)
-			if err != nil {
-				return nil, err
-			}
-			out, err = c.addVulnMetadataMatch(ctx, out, filter, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
+		if exactVuln != nil {
+			q.ids = append(q.ids, exactVuln.VulnMetadataLinks...)
+			q.searched = true
 		}
 	}
+	return q, nil
+}
 
-	return out, nil
+// VulnerabilityMetadataList returns a page of the VulnerabilityMetadata
+// query results, in the order of their keys.
+func (c *demoClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.vulnerabilityMetadataQuery(ctx, vulnerabilityMetadataSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("VulnerabilityMetadataList :: %v", err)
+	}
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("VulnerabilityMetadataList :: %v", err)
+	}
+	return helper.VulnerabilityMetadataConnection(p), nil
 }
 
 func (c *demoClient) addVulnMetadataMatch(ctx context.Context, out []*model.VulnerabilityMetadata,
@@ -286,6 +300,8 @@ func (c *demoClient) buildVulnerabilityMetadata(ctx context.Context, link *vulne
 		ScoreValue:    link.ScoreValue,
 		Origin:        link.Origin,
 		Collector:     link.Collector,
+		Verified:      link.Verified,
+		Signer:        link.Signer,
 	}
 
 	return vulnMetadata, nil
@@ -24,6 +24,7 @@ import (
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -97,31 +98,36 @@ func (n *vulnIDNode) BuildModelNode(ctx context.Context, c *demoClient) (model.N
 
 // certifyVulnerability back edges
 func (n *vulnIDNode) setVulnerabilityLinks(ctx context.Context, id string, c *demoClient) error {
-	n.CertifyVulnLinks = append(n.CertifyVulnLinks, id)
-	return setkv(ctx, vulnIDCol, n, c)
+	return updatekv(ctx, vulnIDCol, n, c, func(n *vulnIDNode) {
+		n.CertifyVulnLinks = append(n.CertifyVulnLinks, id)
+	})
 }
 
 // equalVulnerability back edges
 func (n *vulnIDNode) setVulnEqualLinks(ctx context.Context, id string, c *demoClient) error {
-	n.VulnEqualLinks = append(n.VulnEqualLinks, id)
-	return setkv(ctx, vulnIDCol, n, c)
+	return updatekv(ctx, vulnIDCol, n, c, func(n *vulnIDNode) {
+		n.VulnEqualLinks = append(n.VulnEqualLinks, id)
+	})
 }
 
 // certifyVexStatement back edges
 func (n *vulnIDNode) setVexLinks(ctx context.Context, id string, c *demoClient) error {
-	n.VexLinks = append(n.VexLinks, id)
-	return setkv(ctx, vulnIDCol, n, c)
+	return updatekv(ctx, vulnIDCol, n, c, func(n *vulnIDNode) {
+		n.VexLinks = append(n.VexLinks, id)
+	})
 }
 
 // vulnerability Metadata back edges
 func (n *vulnIDNode) setVulnMetadataLinks(ctx context.Context, id string, c *demoClient) error {
-	n.VulnMetadataLinks = append(n.VulnMetadataLinks, id)
-	return setkv(ctx, vulnIDCol, n, c)
+	return updatekv(ctx, vulnIDCol, n, c, func(n *vulnIDNode) {
+		n.VulnMetadataLinks = append(n.VulnMetadataLinks, id)
+	})
 }
 
 func (n *vulnTypeStruct) addVulnID(ctx context.Context, vulnID string, c *demoClient) error {
-	n.VulnIDs = append(n.VulnIDs, vulnID)
-	return setkv(ctx, vulnTypeCol, n, c)
+	return updatekv(ctx, vulnTypeCol, n, c, func(n *vulnTypeStruct) {
+		n.VulnIDs = append(n.VulnIDs, vulnID)
+	})
 }
 
 // Ingest Vulnerabilities
@@ -143,60 +149,40 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, input model.Vulner
 		Type: strings.ToLower(input.Type),
 	}
 	c.m.RLock()
-	outType, err := byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, inType.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		if !errors.Is(err, kv.NotFoundError) {
-			return nil, err
+	defer c.m.RUnlock()
+	outType, err := lookupOrStore(ctx, vulnTypeCol, inType, c, func() error {
+		var err error
+		if inType.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Lock()
-		outType, err = byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, inType.Key(), c)
-		if err != nil {
-			if !errors.Is(err, kv.NotFoundError) {
-				return nil, err
-			}
-			inType.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, vulnTypeCol, inType); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, vulnTypeCol, inType, c); err != nil {
-				return nil, err
-			}
-			outType = inType
+		if err := c.addToIndex(ctx, vulnTypeCol, inType); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		return setkv(ctx, vulnTypeCol, inType, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	inVulnID := &vulnIDNode{
 		Parent: outType.ThisID,
 		VulnID: strings.ToLower(input.VulnerabilityID),
 	}
-	c.m.RLock()
-	outVulnID, err := byKeykv[*vulnIDNode](ctx, vulnIDCol, inVulnID.Key(), c)
-	c.m.RUnlock()
-	if err != nil {
-		if !errors.Is(err, kv.NotFoundError) {
-			return nil, err
+	outVulnID, err := lookupOrStore(ctx, vulnIDCol, inVulnID, c, func() error {
+		var err error
+		if inVulnID.ThisID, err = c.getNextID(ctx); err != nil {
+			return err
 		}
-		c.m.Lock()
-		outVulnID, err = byKeykv[*vulnIDNode](ctx, vulnIDCol, inVulnID.Key(), c)
-		if err != nil {
-			if !errors.Is(err, kv.NotFoundError) {
-				return nil, err
-			}
-			inVulnID.ThisID = c.getNextID()
-			if err := c.addToIndex(ctx, vulnIDCol, inVulnID); err != nil {
-				return nil, err
-			}
-			if err := setkv(ctx, vulnIDCol, inVulnID, c); err != nil {
-				return nil, err
-			}
-			if err := outType.addVulnID(ctx, inVulnID.ThisID, c); err != nil {
-				return nil, err
-			}
-			outVulnID = inVulnID
+		if err := c.addToIndex(ctx, vulnIDCol, inVulnID); err != nil {
+			return err
+		}
+		if err := setkv(ctx, vulnIDCol, inVulnID, c); err != nil {
+			return err
 		}
-		c.m.Unlock()
+		return outType.addVulnID(ctx, inVulnID.ThisID, c)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &model.VulnerabilityIDs{
@@ -221,55 +207,88 @@ func (c *demoClient) Vulnerabilities(ctx context.Context, filter *model.Vulnerab
 		return []*model.Vulnerability{v}, nil
 	}
 
+	q, err := c.vulnerabilitiesQuery(ctx, filter)
+	if err != nil {
+		return []*model.Vulnerability{}, err
+	}
+	out, err := q.all(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		out = []*model.Vulnerability{}
+	}
+	return out, nil
+}
+
+// vulnerabilitiesQuery returns the Vulnerabilities query, narrowed to the
+// type the filter names, if any. Its nodes are the types, with the
+// vulnerability IDs below them.
+func (c *demoClient) vulnerabilitiesQuery(ctx context.Context, filter *model.VulnerabilitySpec) (*nodeQuery[*vulnTypeStruct, *model.VulnerabilitySpec, *model.Vulnerability], error) {
 	if filter.NoVuln != nil && !*filter.NoVuln {
 		if filter.Type != nil && *filter.Type == noVulnType {
-			return []*model.Vulnerability{}, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
+			return nil, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
 		}
 	}
 
-	out := []*model.Vulnerability{}
 	// if novuln is specified, retrieve all "novuln" type nodes
 	if filter != nil && filter.NoVuln != nil && *filter.NoVuln {
 		filter.Type = ptrfrom.String(noVulnType)
 		filter.VulnerabilityID = ptrfrom.String("")
 	}
 
+	q := newNodeQuery(vulnTypeCol, filter, c.addVulnerabilityIfMatch)
 	if filter != nil && filter.Type != nil {
+		q.searched = true
 		inType := &vulnTypeStruct{
 			Type: strings.ToLower(*filter.Type),
 		}
-		typeStruct, err := byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, inType.Key(), c)
-		if err == nil {
-			vulnIDs := c.buildVulnID(ctx, typeStruct, filter)
-			if len(vulnIDs) > 0 {
-				out = append(out, &model.Vulnerability{
-					ID:               typeStruct.ThisID,
-					Type:             typeStruct.Type,
-					VulnerabilityIDs: vulnIDs,
-				})
-			}
+		if typeStruct, err := byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, inType.Key(), c); err == nil {
+			q.ids = []string{typeStruct.ThisID}
 		}
-	} else {
-		typeKeys, err := c.kv.Keys(ctx, vulnTypeCol)
+	}
+	return q, nil
+}
+
+func (c *demoClient) addVulnerabilityIfMatch(ctx context.Context, out []*model.Vulnerability, filter *model.VulnerabilitySpec, typeStruct *vulnTypeStruct) ([]*model.Vulnerability, error) {
+	vulnIDs := c.buildVulnID(ctx, typeStruct, filter)
+	if len(vulnIDs) == 0 {
+		return out, nil
+	}
+	return append(out, &model.Vulnerability{
+		ID:               typeStruct.ThisID,
+		Type:             typeStruct.Type,
+		VulnerabilityIDs: vulnIDs,
+	}), nil
+}
+
+// VulnerabilitiesList returns a page of the vulnerabilities query results, in
+// the order of the keys of their types. A filter on an ID, which may be the ID
+// of a type or of a vulnerability ID, matches a single trie, which is paged
+// by ID.
+func (c *demoClient) VulnerabilitiesList(ctx context.Context, vulnSpec *model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
+	if vulnSpec != nil && vulnSpec.ID != nil {
+		nodes, err := c.Vulnerabilities(ctx, vulnSpec)
 		if err != nil {
 			return nil, err
 		}
-		for _, tk := range typeKeys {
-			typeStruct, err := byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, tk, c)
-			if err != nil {
-				return nil, err
-			}
-			vulnIDs := c.buildVulnID(ctx, typeStruct, filter)
-			if len(vulnIDs) > 0 {
-				out = append(out, &model.Vulnerability{
-					ID:               typeStruct.ThisID,
-					Type:             typeStruct.Type,
-					VulnerabilityIDs: vulnIDs,
-				})
-			}
+		p, err := helper.Paginate(nodes, func(n *model.Vulnerability) string { return n.ID }, after, first)
+		if err != nil {
+			return nil, err
 		}
+		return helper.VulnerabilityConnection(p), nil
 	}
-	return out, nil
+	c.m.RLock()
+	defer c.m.RUnlock()
+	q, err := c.vulnerabilitiesQuery(ctx, vulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := q.page(ctx, c, after, first)
+	if err != nil {
+		return nil, gqlerror.Errorf("VulnerabilitiesList :: %v", err)
+	}
+	return helper.VulnerabilityConnection(p), nil
 }
 
 func (c *demoClient) buildVulnID(ctx context.Context, typeStruct *vulnTypeStruct, filter *model.VulnerabilitySpec) []*model.VulnerabilityID {
@@ -18,8 +18,10 @@ package neo4j
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -36,11 +38,11 @@ func (c *neo4jClient) Artifacts(ctx context.Context, artifactSpec *model.Artifac
 
 	setArtifactMatchValues(&sb, artifactSpec, false, &firstMatch, queryValues)
 
-	sb.WriteString(" RETURN a.algorithm, a.digest")
+	returnPage(ctx, &sb, "a", " RETURN a.algorithm, a.digest, id(a)", queryValues)
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runPaged(ctx, tx, sb.String(), queryValues)
 			if err != nil {
 				return nil, err
 			}
@@ -50,6 +52,7 @@ func (c *neo4jClient) Artifacts(ctx context.Context, artifactSpec *model.Artifac
 				algorithm := result.Record().Values[0].(string)
 				digest := result.Record().Values[1].(string)
 				artifact := generateModelArtifact(algorithm, digest)
+				artifact.ID = strconv.FormatInt(result.Record().Values[2].(int64), 10)
 				artifacts = append(artifacts, artifact)
 			}
 			if err = result.Err(); err != nil {
@@ -65,6 +68,17 @@ func (c *neo4jClient) Artifacts(ctx context.Context, artifactSpec *model.Artifac
 	return result.([]*model.Artifact), nil
 }
 
+// ArtifactsList returns a page of the artifacts query results, ordered by ID.
+func (c *neo4jClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.Artifact) string { return n.ID }, func(ctx context.Context) ([]*model.Artifact, error) {
+		return c.Artifacts(ctx, artifactSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.ArtifactConnection(p), nil
+}
+
 func (c *neo4jClient) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestArtifacts")
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
 )
 
 const (
@@ -33,6 +34,8 @@ const (
 	cvdID         string = "cveId"
 	origin        string = "origin"
 	collector     string = "collector"
+	verified      string = "verified"
+	signer        string = "signer"
 	justification string = "justification"
 	status        string = "status"
 	statement     string = "statement"
@@ -117,6 +120,30 @@ func matchProperties(sb *strings.Builder, firstMatch bool, label, property strin
 	sb.WriteString(resolver)
 }
 
+// setTrust sets whether the document of the node labeled label was verified
+// and by whom when the node is created. Unlike the collector, they are not
+// part of what the node is merged on.
+func setTrust(sb *strings.Builder, label string) {
+	sb.WriteString("\nON CREATE SET " + label + "." + verified + " = $" + verified + ", " + label + "." + signer + " = $" + signer)
+}
+
+// nodeVerified returns whether the document of node was verified, which is
+// missing if its signatures were not checked.
+func nodeVerified(node dbtype.Node) *bool {
+	if v, ok := node.Props[verified].(bool); ok {
+		return &v
+	}
+	return nil
+}
+
+// nodeSigner returns the identity that signed the document of node, if any.
+func nodeSigner(node dbtype.Node) *string {
+	if s, ok := node.Props[signer].(string); ok {
+		return &s
+	}
+	return nil
+}
+
 // getPreloads get the specific graphQL query fields that are requested.
 // graphql.CollectAllFields only provides the top level fields and none of the nested fields below it.
 // getPreloads recursively goes through the fields and retrieves each nested field below it.
@@ -166,6 +193,9 @@ func getPreloadString(prefix, name string) string {
 func (c *neo4jClient) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
 	panic(fmt.Errorf("not implemented: Licenses"))
 }
+func (c *neo4jClient) LicensesList(ctx context.Context, licenseSpec *model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
+	panic(fmt.Errorf("not implemented: LicensesList"))
+}
 func (c *neo4jClient) IngestLicense(ctx context.Context, license *model.LicenseInputSpec) (string, error) {
 	panic(fmt.Errorf("not implemented: IngestLicense"))
 }
@@ -175,6 +205,9 @@ func (c *neo4jClient) IngestLicenses(ctx context.Context, licenses []*model.Lice
 func (c *neo4jClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
 	panic(fmt.Errorf("not implemented: CertifyLegal"))
 }
+func (c *neo4jClient) CertifyLegalList(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	panic(fmt.Errorf("not implemented: CertifyLegalList"))
+}
 func (c *neo4jClient) IngestCertifyLegal(ctx context.Context, subject model.PackageOrSourceInput, declaredLicenses []*model.LicenseInputSpec, discoveredLicenses []*model.LicenseInputSpec, certifyLegal *model.CertifyLegalInputSpec) (string, error) {
 	panic(fmt.Errorf("not implemented: IngestCertifyLegal"))
 }
@@ -18,7 +18,10 @@ package neo4j
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -27,18 +30,19 @@ func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSp
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
 
-	var query string
+	var sb strings.Builder
 	values := map[string]any{}
 	if builderSpec.URI != nil {
-		query = "MATCH (b:Builder) WHERE b.uri = $uri RETURN b.uri"
+		sb.WriteString("MATCH (b:Builder) WHERE b.uri = $uri")
 		values["uri"] = *builderSpec.URI
 	} else {
-		query = "MATCH (b:Builder) RETURN b.uri"
+		sb.WriteString("MATCH (b:Builder)")
 	}
+	returnPage(ctx, &sb, "b", " RETURN b.uri, id(b)", values)
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(query, values)
+			result, err := runPaged(ctx, tx, sb.String(), values)
 			if err != nil {
 				return nil, err
 			}
@@ -47,6 +51,7 @@ func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSp
 			for result.Next() {
 				uri := result.Record().Values[0].(string)
 				builder := generateModelBuilder(uri)
+				builder.ID = strconv.FormatInt(result.Record().Values[1].(int64), 10)
 				builders = append(builders, builder)
 			}
 			if err = result.Err(); err != nil {
@@ -62,6 +67,17 @@ func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSp
 	return result.([]*model.Builder), nil
 }
 
+// BuildersList returns a page of the builders query results, ordered by ID.
+func (c *neo4jClient) BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.Builder) string { return n.ID }, func(ctx context.Context) ([]*model.Builder, error) {
+		return c.Builders(ctx, builderSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.BuilderConnection(p), nil
+}
+
 func (c *neo4jClient) IngestBuilders(ctx context.Context, builders []*model.BuilderInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestBuilders")
 }
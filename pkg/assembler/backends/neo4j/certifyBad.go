@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -58,7 +59,7 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 			setPkgMatchValues(&sb, certifyBadSpec.Subject.Package, false, &firstMatch, queryValues)
 		}
 		setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		returnPage(ctx, &sb, "certifyBad", returnValue, queryValues)
 
 		if certifyBadSpec.Subject.Package == nil || certifyBadSpec.Subject.Package != nil && certifyBadSpec.Subject.Package.Version == nil &&
 			certifyBadSpec.Subject.Package.Subpath == nil && len(certifyBadSpec.Subject.Package.Qualifiers) == 0 &&
@@ -77,12 +78,12 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 				setPkgMatchValues(&sb, certifyBadSpec.Subject.Package, false, &firstMatch, queryValues)
 			}
 			setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-			sb.WriteString(returnValue)
+			returnPage(ctx, &sb, "certifyBad", returnValue, queryValues)
 		}
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -107,6 +108,8 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 					}
 
 					certifyBad := generateModelCertifyBad(pkg, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad.Verified, certifyBad.Signer = nodeVerified(certifyBadNode), nodeSigner(certifyBadNode)
+					certifyBad.ID = nodeID(certifyBadNode)
 
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
@@ -136,11 +139,11 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 			setSrcMatchValues(&sb, certifyBadSpec.Subject.Source, false, &firstMatch, queryValues)
 		}
 		setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyBad")
+		returnPage(ctx, &sb, "certifyBad", " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyBad", queryValues)
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -164,6 +167,8 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 					}
 
 					certifyBad := generateModelCertifyBad(src, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad.Verified, certifyBad.Signer = nodeVerified(certifyBadNode), nodeSigner(certifyBadNode)
+					certifyBad.ID = nodeID(certifyBadNode)
 
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
@@ -191,11 +196,11 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 			setArtifactMatchValues(&sb, certifyBadSpec.Subject.Artifact, false, &firstMatch, queryValues)
 		}
 		setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN a.algorithm, a.digest, certifyBad")
+		returnPage(ctx, &sb, "certifyBad", " RETURN a.algorithm, a.digest, certifyBad", queryValues)
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -215,6 +220,8 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 					}
 
 					certifyBad := generateModelCertifyBad(artifact, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad.Verified, certifyBad.Signer = nodeVerified(certifyBadNode), nodeSigner(certifyBadNode)
+					certifyBad.ID = nodeID(certifyBadNode)
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
 				if err = result.Err(); err != nil {
@@ -233,6 +240,17 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 
 }
 
+// CertifyBadList returns a page of the CertifyBad query results, ordered by ID.
+func (c *neo4jClient) CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.CertifyBad) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyBad, error) {
+		return c.CertifyBad(ctx, certifyBadSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyBadConnection(p), nil
+}
+
 func setCertifyBadValues(sb *strings.Builder, certifyBadSpec *model.CertifyBadSpec, firstMatch *bool, queryValues map[string]any) {
 	if certifyBadSpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "certifyBad", "justification", "$justification")
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -52,7 +53,7 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 			setPkgMatchValues(&sb, certifyGoodSpec.Subject.Package, false, &firstMatch, queryValues)
 		}
 		setCertifyGoodValues(&sb, certifyGoodSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		returnPage(ctx, &sb, "certifyGood", returnValue, queryValues)
 
 		if certifyGoodSpec.Subject.Package == nil || certifyGoodSpec.Subject.Package != nil && certifyGoodSpec.Subject.Package.Version == nil &&
 			certifyGoodSpec.Subject.Package.Subpath == nil && len(certifyGoodSpec.Subject.Package.Qualifiers) == 0 &&
@@ -71,12 +72,12 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 				setPkgMatchValues(&sb, certifyGoodSpec.Subject.Package, false, &firstMatch, queryValues)
 			}
 			setCertifyGoodValues(&sb, certifyGoodSpec, &firstMatch, queryValues)
-			sb.WriteString(returnValue)
+			returnPage(ctx, &sb, "certifyGood", returnValue, queryValues)
 		}
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -101,6 +102,8 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 					}
 
 					certifyGood := generateModelCertifyGood(pkg, certifyGoodNode.Props[justification].(string), certifyGoodNode.Props[origin].(string), certifyGoodNode.Props[collector].(string))
+					certifyGood.Verified, certifyGood.Signer = nodeVerified(certifyGoodNode), nodeSigner(certifyGoodNode)
+					certifyGood.ID = nodeID(certifyGoodNode)
 
 					collectedCertifyGood = append(collectedCertifyGood, certifyGood)
 				}
@@ -130,11 +133,11 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 			setSrcMatchValues(&sb, certifyGoodSpec.Subject.Source, false, &firstMatch, queryValues)
 		}
 		setCertifyGoodValues(&sb, certifyGoodSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyGood")
+		returnPage(ctx, &sb, "certifyGood", " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyGood", queryValues)
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -158,6 +161,8 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 					}
 
 					certifyGood := generateModelCertifyGood(src, certifyGoodNode.Props[justification].(string), certifyGoodNode.Props[origin].(string), certifyGoodNode.Props[collector].(string))
+					certifyGood.Verified, certifyGood.Signer = nodeVerified(certifyGoodNode), nodeSigner(certifyGoodNode)
+					certifyGood.ID = nodeID(certifyGoodNode)
 
 					collectedCertifyGood = append(collectedCertifyGood, certifyGood)
 				}
@@ -185,11 +190,11 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 			setArtifactMatchValues(&sb, certifyGoodSpec.Subject.Artifact, false, &firstMatch, queryValues)
 		}
 		setCertifyGoodValues(&sb, certifyGoodSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN a.algorithm, a.digest, certifyGood")
+		returnPage(ctx, &sb, "certifyGood", " RETURN a.algorithm, a.digest, certifyGood", queryValues)
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -209,6 +214,8 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 					}
 
 					certifyGood := generateModelCertifyGood(artifact, certifyGoodNode.Props[justification].(string), certifyGoodNode.Props[origin].(string), certifyGoodNode.Props[collector].(string))
+					certifyGood.Verified, certifyGood.Signer = nodeVerified(certifyGoodNode), nodeSigner(certifyGoodNode)
+					certifyGood.ID = nodeID(certifyGoodNode)
 					collectedCertifyGood = append(collectedCertifyGood, certifyGood)
 				}
 				if err = result.Err(); err != nil {
@@ -227,6 +234,17 @@ func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.Ce
 
 }
 
+// CertifyGoodList returns a page of the CertifyGood query results, ordered by ID.
+func (c *neo4jClient) CertifyGoodList(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.CertifyGood) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyGood, error) {
+		return c.CertifyGood(ctx, certifyGoodSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyGoodConnection(p), nil
+}
+
 func setCertifyGoodValues(sb *strings.Builder, certifyGoodSpec *model.CertifyGoodSpec, firstMatch *bool, queryValues map[string]any) {
 	if certifyGoodSpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "certifyGood", "justification", "$justification")
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -53,11 +54,11 @@ func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *mode
 
 	setSrcMatchValues(&sb, certifyScorecardSpec.Source, false, &firstMatch, queryValues)
 	setCertifyScorecardValues(&sb, certifyScorecardSpec, &firstMatch, queryValues)
-	sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyScorecard")
+	returnPage(ctx, &sb, "certifyScorecard", " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyScorecard", queryValues)
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runPaged(ctx, tx, sb.String(), queryValues)
 			if err != nil {
 				return nil, err
 			}
@@ -93,9 +94,12 @@ func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *mode
 					ScorecardCommit:  certifyScorecardNode.Props[scorecardCommit].(string),
 					Origin:           certifyScorecardNode.Props[origin].(string),
 					Collector:        certifyScorecardNode.Props[collector].(string),
+					Verified:         nodeVerified(certifyScorecardNode),
+					Signer:           nodeSigner(certifyScorecardNode),
 				}
 
 				certifyScorecard := &model.CertifyScorecard{
+					ID:        nodeID(certifyScorecardNode),
 					Source:    src,
 					Scorecard: &scorecard,
 				}
@@ -115,6 +119,17 @@ func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *mode
 	return result.([]*model.CertifyScorecard), nil
 }
 
+// ScorecardsList returns a page of the scorecards query results, ordered by ID.
+func (c *neo4jClient) ScorecardsList(ctx context.Context, scorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.CertifyScorecard) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyScorecard, error) {
+		return c.Scorecards(ctx, scorecardSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyScorecardConnection(p), nil
+}
+
 func getCollectedChecks(keyList []interface{}, valueList []interface{}) ([]*model.ScorecardCheck, error) {
 	if len(keyList) != len(valueList) {
 		return nil, gqlerror.Errorf("length of scorecard checks do not match")
@@ -248,6 +263,8 @@ func (c *neo4jClient) IngestScorecard(ctx context.Context, source model.SourceIn
 	// TODO(mihaimaruseac): Should we put origin/collector on the edge instead?
 	values["origin"] = scorecard.Origin
 	values["collector"] = scorecard.Collector
+	values[verified] = scorecard.Verified
+	values[signer] = scorecard.Signer
 
 	result, err := session.WriteTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
@@ -255,6 +272,7 @@ func (c *neo4jClient) IngestScorecard(ctx context.Context, source model.SourceIn
 MATCH (root:Src) -[:SrcHasType]-> (type:SrcType) -[:SrcHasNamespace]-> (ns:SrcNamespace) -[:SrcHasName] -> (name:SrcName)
 WHERE type.type = $sourceType AND ns.namespace = $namespace AND name.name = $name AND name.commit = $commit AND name.tag = $tag
 MERGE (name) <-[:subject]- (certifyScorecard:CertifyScorecard{timeScanned:$timeScanned,aggregateScore:$aggregateScore,scorecardVersion:$scorecardVersion,scorecardCommit:$scorecardCommit,checkKeys:$checkKeys,checkValues:$checkValues,origin:$origin,collector:$collector})
+ON CREATE SET certifyScorecard.verified = $verified, certifyScorecard.signer = $signer
 RETURN type.type, ns.namespace, name.name, name.commit, name.tag, certifyScorecard`
 			result, err := tx.Run(query, values)
 			if err != nil {
@@ -284,6 +302,8 @@ RETURN type.type, ns.namespace, name.name, name.commit, name.tag, certifyScoreca
 				ScorecardCommit:  certifyScorecardNode.Props[scorecardCommit].(string),
 				Origin:           certifyScorecardNode.Props[origin].(string),
 				Collector:        certifyScorecardNode.Props[collector].(string),
+				Verified:         nodeVerified(certifyScorecardNode),
+				Signer:           nodeSigner(certifyScorecardNode),
 			}
 
 			tag := record.Values[4]
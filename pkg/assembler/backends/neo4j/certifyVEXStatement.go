@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -526,6 +527,17 @@ func (c *neo4jClient) CertifyVEXStatement(ctx context.Context, certifyVEXStateme
 	return []*model.CertifyVEXStatement{}, fmt.Errorf("not implemented - CertifyVEXStatement")
 }
 
+// CertifyVEXStatementList returns a page of the CertifyVEXStatement query results, ordered by ID.
+func (c *neo4jClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.CertifyVEXStatement) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyVEXStatement, error) {
+		return c.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVEXStatementConnection(p), nil
+}
+
 // func setCertifyVEXStatementValues(sb *strings.Builder, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, firstMatch *bool, queryValues map[string]any) {
 // 	if certifyVEXStatementSpec.KnownSince != nil {
 // 		matchProperties(sb, *firstMatch, "certifyVEXStatement", knownSince, "$"+knownSince)
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -267,6 +268,17 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 	return []*model.CertifyVuln{}, fmt.Errorf("not implemented - CertifyVuln")
 }
 
+// CertifyVulnList returns a page of the CertifyVuln query results, ordered by ID.
+func (c *neo4jClient) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.CertifyVuln) string { return n.ID }, func(ctx context.Context) ([]*model.CertifyVuln, error) {
+		return c.CertifyVuln(ctx, certifyVulnSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVulnConnection(p), nil
+}
+
 // func setCertifyVulnValues(sb *strings.Builder, certifyVulnSpec *model.CertifyVulnSpec, firstMatch *bool, queryValues map[string]any) {
 // 	if certifyVulnSpec.TimeScanned != nil {
 // 		matchProperties(sb, *firstMatch, "certifyVuln", timeScanned, "$"+timeScanned)
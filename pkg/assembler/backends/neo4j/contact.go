@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -30,6 +31,17 @@ func (c *neo4jClient) PointOfContact(ctx context.Context, pointOfContactSpec *mo
 	return nil, fmt.Errorf("not implemented: PointOfContact")
 }
 
+// PointOfContactList returns a page of the PointOfContact query results, ordered by ID.
+func (c *neo4jClient) PointOfContactList(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.PointOfContact) string { return n.ID }, func(ctx context.Context) ([]*model.PointOfContact, error) {
+		return c.PointOfContact(ctx, pointOfContactSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.PointOfContactConnection(p), nil
+}
+
 func (c *neo4jClient) IngestPointOfContacts(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, pointOfContacts []*model.PointOfContactInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestPointOfContacts")
 }
@@ -0,0 +1,160 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// evidenceLabels are the labels of the evidence nodes this backend ingests.
+var evidenceLabels = []string{
+	"CertifyBad",
+	"CertifyGood",
+	"CertifyScorecard",
+	"CertifyVEXStatement",
+	"CertifyVuln",
+	"HasSBOM",
+	"HasSLSA",
+	"HasSourceAt",
+	"HashEqual",
+	"IsDependency",
+	"IsOccurrence",
+	"PkgEqual",
+}
+
+func (c *neo4jClient) DeleteCertifyBad(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "CertifyBad", id)
+}
+
+func (c *neo4jClient) DeleteCertifyGood(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "CertifyGood", id)
+}
+
+func (c *neo4jClient) DeleteCertifyLegal(ctx context.Context, id string) (bool, error) {
+	return false, fmt.Errorf("not implemented: DeleteCertifyLegal")
+}
+
+func (c *neo4jClient) DeleteCertifyScorecard(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "CertifyScorecard", id)
+}
+
+func (c *neo4jClient) DeleteCertifyVEXStatement(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "CertifyVEXStatement", id)
+}
+
+func (c *neo4jClient) DeleteCertifyVuln(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "CertifyVuln", id)
+}
+
+func (c *neo4jClient) DeleteHasMetadata(ctx context.Context, id string) (bool, error) {
+	return false, fmt.Errorf("not implemented: DeleteHasMetadata")
+}
+
+func (c *neo4jClient) DeleteHasSbom(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "HasSBOM", id)
+}
+
+func (c *neo4jClient) DeleteHasSlsa(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "HasSLSA", id)
+}
+
+func (c *neo4jClient) DeleteHasSourceAt(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "HasSourceAt", id)
+}
+
+func (c *neo4jClient) DeleteHashEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "HashEqual", id)
+}
+
+func (c *neo4jClient) DeleteIsDependency(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "IsDependency", id)
+}
+
+func (c *neo4jClient) DeleteIsOccurrence(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "IsOccurrence", id)
+}
+
+func (c *neo4jClient) DeletePkgEqual(ctx context.Context, id string) (bool, error) {
+	return c.deleteEvidence(ctx, "PkgEqual", id)
+}
+
+func (c *neo4jClient) DeletePointOfContact(ctx context.Context, id string) (bool, error) {
+	return false, fmt.Errorf("not implemented: DeletePointOfContact")
+}
+
+func (c *neo4jClient) DeleteVulnEqual(ctx context.Context, id string) (bool, error) {
+	return false, fmt.Errorf("not implemented: DeleteVulnEqual")
+}
+
+func (c *neo4jClient) DeleteVulnerabilityMetadata(ctx context.Context, id string) (bool, error) {
+	return false, fmt.Errorf("not implemented: DeleteVulnerabilityMetadata")
+}
+
+func (c *neo4jClient) RetractEvidence(ctx context.Context, originValue string) (int, error) {
+	total := 0
+	for _, label := range evidenceLabels {
+		query := fmt.Sprintf("MATCH (n:%s) WHERE n.%s = $origin DETACH DELETE n RETURN count(n)", label, origin)
+		deleted, err := c.detachDelete(query, map[string]any{"origin": originValue})
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+	}
+	return total, nil
+}
+
+// deleteEvidence removes the node with the given internal ID and label, along
+// with all its relationships.
+func (c *neo4jClient) deleteEvidence(ctx context.Context, label string, id string) (bool, error) {
+	nodeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s ID %q: %w", label, id, err)
+	}
+	query := fmt.Sprintf("MATCH (n:%s) WHERE id(n) = $id DETACH DELETE n RETURN count(n)", label)
+	deleted, err := c.detachDelete(query, map[string]any{"id": nodeID})
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+func (c *neo4jClient) detachDelete(query string, values map[string]any) (int, error) {
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close()
+
+	result, err := session.WriteTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(query, values)
+			if err != nil {
+				return nil, err
+			}
+
+			// query returns a single record
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+			return record.Values[0], nil
+		})
+	if err != nil {
+		return 0, err
+	}
+	return int(result.(int64)), nil
+}
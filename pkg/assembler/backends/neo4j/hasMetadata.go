@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -30,6 +31,17 @@ func (c *neo4jClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.Ha
 	return nil, fmt.Errorf("not implemented: HasMetadata")
 }
 
+// HasMetadataList returns a page of the HasMetadata query results, ordered by ID.
+func (c *neo4jClient) HasMetadataList(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.HasMetadata) string { return n.ID }, func(ctx context.Context) ([]*model.HasMetadata, error) {
+		return c.HasMetadata(ctx, hasMetadataSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasMetadataConnection(p), nil
+}
+
 func (c *neo4jClient) IngestBulkHasMetadata(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, hasMetadataList []*model.HasMetadataInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestBulkHasMetadata")
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -56,12 +57,12 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 			setPkgMatchValues(&sb, hasSBOMSpec.Subject.Package, false, &firstMatch, queryValues)
 		}
 		setHasSBOMValues(&sb, hasSBOMSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		returnPage(ctx, &sb, "hasSBOM", returnValue, queryValues)
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -86,6 +87,8 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 					}
 
 					hasSBOM := generateModelHasSBOM(pkg, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string))
+					hasSBOM.ID = nodeID(hasSBOMNode)
+					hasSBOM.Verified, hasSBOM.Signer = nodeVerified(hasSBOMNode), nodeSigner(hasSBOMNode)
 
 					collectedHasSBOM = append(collectedHasSBOM, hasSBOM)
 				}
@@ -115,12 +118,12 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 			setArtifactMatchValues(&sb, hasSBOMSpec.Subject.Artifact, false, &firstMatch, queryValues)
 		}
 		setHasSBOMValues(&sb, hasSBOMSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, hasSBOM")
+		returnPage(ctx, &sb, "hasSBOM", " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, hasSBOM", queryValues)
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -141,6 +144,8 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 					}
 
 					hasSBOM := generateModelHasSBOM(src, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string))
+					hasSBOM.ID = nodeID(hasSBOMNode)
+					hasSBOM.Verified, hasSBOM.Signer = nodeVerified(hasSBOMNode), nodeSigner(hasSBOMNode)
 
 					collectedHasSBOM = append(collectedHasSBOM, hasSBOM)
 				}
@@ -159,6 +164,17 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 	return aggregateHasSBOM, nil
 }
 
+// HasSBOMList returns a page of the HasSBOM query results, ordered by ID.
+func (c *neo4jClient) HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.HasSbom) string { return n.ID }, func(ctx context.Context) ([]*model.HasSbom, error) {
+		return c.HasSBOM(ctx, hasSBOMSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSBOMConnection(p), nil
+}
+
 func setHasSBOMValues(sb *strings.Builder, hasSBOMSpec *model.HasSBOMSpec, firstMatch *bool, queryValues map[string]any) {
 	if hasSBOMSpec.URI != nil {
 		matchProperties(sb, *firstMatch, "hasSBOM", uri, "$"+uri)
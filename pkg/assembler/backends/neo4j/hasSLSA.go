@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -487,6 +488,17 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 	return nil, nil
 }
 
+// HasSLSAList returns a page of the HasSLSA query results, ordered by ID.
+func (c *neo4jClient) HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.HasSlsa) string { return n.ID }, func(ctx context.Context) ([]*model.HasSlsa, error) {
+		return c.HasSlsa(ctx, hasSLSASpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSLSAConnection(p), nil
+}
+
 // TODO update to not use PackageSourceOrArtifact
 
 // TODO(pxp928): combine with testing backend in shared utility
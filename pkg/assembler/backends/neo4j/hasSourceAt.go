@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -52,7 +53,7 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 	setPkgMatchValues(&sb, hasSourceAtSpec.Package, false, &firstMatch, queryValues)
 	setSrcMatchValues(&sb, hasSourceAtSpec.Source, true, &firstMatch, queryValues)
 	setHasSourceAtValues(&sb, hasSourceAtSpec, &firstMatch, queryValues)
-	sb.WriteString(returnValue)
+	returnPage(ctx, &sb, "hasSourceAt", returnValue, queryValues)
 
 	if hasSourceAtSpec.Package == nil || hasSourceAtSpec.Package != nil && hasSourceAtSpec.Package.Version == nil && hasSourceAtSpec.Package.Subpath == nil &&
 		len(hasSourceAtSpec.Package.Qualifiers) == 0 && !*hasSourceAtSpec.Package.MatchOnlyEmptyQualifiers {
@@ -70,13 +71,13 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 		setPkgMatchValues(&sb, hasSourceAtSpec.Package, false, &firstMatch, queryValues)
 		setSrcMatchValues(&sb, hasSourceAtSpec.Source, true, &firstMatch, queryValues)
 		setHasSourceAtValues(&sb, hasSourceAtSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		returnPage(ctx, &sb, "hasSourceAt", returnValue, queryValues)
 	}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
 
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runPaged(ctx, tx, sb.String(), queryValues)
 			if err != nil {
 				return nil, err
 			}
@@ -109,12 +110,15 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 				}
 
 				hasSourceAt := &model.HasSourceAt{
+					ID:            nodeID(hasSourceAtNode),
 					Package:       pkg,
 					Source:        src,
 					KnownSince:    hasSourceAtNode.Props[knownSince].(time.Time),
 					Justification: hasSourceAtNode.Props[justification].(string),
 					Origin:        hasSourceAtNode.Props[origin].(string),
 					Collector:     hasSourceAtNode.Props[collector].(string),
+					Verified:      nodeVerified(hasSourceAtNode),
+					Signer:        nodeSigner(hasSourceAtNode),
 				}
 				collectedHasSourceAt = append(collectedHasSourceAt, hasSourceAt)
 			}
@@ -131,6 +135,17 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 	return result.([]*model.HasSourceAt), nil
 }
 
+// HasSourceAtList returns a page of the HasSourceAt query results, ordered by ID.
+func (c *neo4jClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.HasSourceAt) string { return n.ID }, func(ctx context.Context) ([]*model.HasSourceAt, error) {
+		return c.HasSourceAt(ctx, hasSourceAtSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSourceAtConnection(p), nil
+}
+
 func setHasSourceAtValues(sb *strings.Builder, hasSourceAtSpec *model.HasSourceAtSpec, firstMatch *bool, queryValues map[string]any) {
 	if hasSourceAtSpec.KnownSince != nil {
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -56,7 +57,7 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 	setArtifactMatchValues(&sb, dependentArt, true, &firstMatch, queryValues)
 	setHashEqualValues(&sb, hashEqualSpec, &firstMatch, queryValues)
 
-	sb.WriteString(returnValue)
+	returnPage(ctx, &sb, "hashEqual", returnValue, queryValues)
 
 	if len(hashEqualSpec.Artifacts) > 0 {
 		sb.WriteString("\nUNION")
@@ -70,12 +71,12 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 		setArtifactMatchValues(&sb, selectedArt, true, &firstMatch, queryValues)
 		setHashEqualValues(&sb, hashEqualSpec, &firstMatch, queryValues)
 
-		sb.WriteString(returnValue)
+		returnPage(ctx, &sb, "hashEqual", returnValue, queryValues)
 	}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runPaged(ctx, tx, sb.String(), queryValues)
 			if err != nil {
 				return nil, err
 			}
@@ -94,17 +95,19 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 
 				hashEqualNode := dbtype.Node{}
 				if result.Record().Values[2] != nil {
-					hashEqualNode = result.Record().Values[6].(dbtype.Node)
+					hashEqualNode = result.Record().Values[2].(dbtype.Node)
 				} else {
 					return nil, gqlerror.Errorf("hashEqual Node not found in neo4j")
 				}
 
 				hashEqual := &model.HashEqual{
+					ID:            nodeID(hashEqualNode),
 					Artifacts:     []*model.Artifact{artifact, depArtifact},
 					Justification: hashEqualNode.Props[justification].(string),
 					Origin:        hashEqualNode.Props[origin].(string),
 					Collector:     hashEqualNode.Props[collector].(string),
 				}
+				hashEqual.Verified, hashEqual.Signer = nodeVerified(hashEqualNode), nodeSigner(hashEqualNode)
 				collectedHashEqual = append(collectedHashEqual, hashEqual)
 			}
 			if err = result.Err(); err != nil {
@@ -120,6 +123,17 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 	return result.([]*model.HashEqual), nil
 }
 
+// HashEqualList returns a page of the HashEqual query results, ordered by ID.
+func (c *neo4jClient) HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.HashEqual) string { return n.ID }, func(ctx context.Context) ([]*model.HashEqual, error) {
+		return c.HashEqual(ctx, hashEqualSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.HashEqualConnection(p), nil
+}
+
 func setHashEqualValues(sb *strings.Builder, hashEqualSpec *model.HashEqualSpec, firstMatch *bool, queryValues map[string]any) {
 	if hashEqualSpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "hashEqual", "justification", "$justification")
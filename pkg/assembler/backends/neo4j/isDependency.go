@@ -28,8 +28,10 @@ import (
 )
 
 const (
-	versionRange   string = "versionRange"
-	dependencyType string = "dependencyType"
+	versionRange            string = "versionRange"
+	dependencyType          string = "dependencyType"
+	dependencyScope         string = "dependencyScope"
+	excludeDependencyScopes string = "excludeDependencyScopes"
 )
 
 // Query IsDependency
@@ -71,12 +73,12 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 	setPkgMatchValues(&sb, dependentPkg, true, &firstMatch, queryValues)
 	setIsDependencyValues(&sb, isDependencySpec, &firstMatch, queryValues)
 
-	sb.WriteString(returnValue)
+	returnPage(ctx, &sb, "isDependency", returnValue, queryValues)
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
 
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runPaged(ctx, tx, sb.String(), queryValues)
 			if err != nil {
 				return nil, err
 			}
@@ -110,14 +112,22 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 				if err != nil {
 					return nil, fmt.Errorf("convertDependencyTypeToEnum failed with error: %w", err)
 				}
+				dependencyScopeEnum, err := convertDependencyScopeToEnum(isDependencyNode.Props[dependencyScope])
+				if err != nil {
+					return nil, fmt.Errorf("convertDependencyScopeToEnum failed with error: %w", err)
+				}
 
 				isDependency := &model.IsDependency{
+					ID:                nodeID(isDependencyNode),
 					Package:           pkg,
 					DependencyPackage: depPkg,
 					VersionRange:      isDependencyNode.Props[versionRange].(string),
 					DependencyType:    dependencyTypeEnum,
+					DependencyScope:   dependencyScopeEnum,
 					Origin:            isDependencyNode.Props[origin].(string),
 					Collector:         isDependencyNode.Props[collector].(string),
+					Verified:          nodeVerified(isDependencyNode),
+					Signer:            nodeSigner(isDependencyNode),
 				}
 				collectedIsDependency = append(collectedIsDependency, isDependency)
 			}
@@ -134,6 +144,17 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 	return result.([]*model.IsDependency), nil
 }
 
+// IsDependencyList returns a page of the IsDependency query results, ordered by ID.
+func (c *neo4jClient) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.IsDependency) string { return n.ID }, func(ctx context.Context) ([]*model.IsDependency, error) {
+		return c.IsDependency(ctx, isDependencySpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsDependencyConnection(p), nil
+}
+
 func setIsDependencyValues(sb *strings.Builder, isDependencySpec *model.IsDependencySpec, firstMatch *bool, queryValues map[string]any) {
 	if isDependencySpec.VersionRange != nil {
 		matchProperties(sb, *firstMatch, "isDependency", versionRange, "$"+versionRange)
@@ -145,6 +166,25 @@ func setIsDependencyValues(sb *strings.Builder, isDependencySpec *model.IsDepend
 		*firstMatch = false
 		queryValues[dependencyType] = isDependencySpec.DependencyType.String()
 	}
+	if isDependencySpec.DependencyScope != nil {
+		matchProperties(sb, *firstMatch, "isDependency", dependencyScope, "$"+dependencyScope)
+		*firstMatch = false
+		queryValues[dependencyScope] = isDependencySpec.DependencyScope.String()
+	}
+	if len(isDependencySpec.ExcludeDependencyScopes) > 0 {
+		if *firstMatch {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString("NOT isDependency." + dependencyScope + " IN $" + excludeDependencyScopes)
+		*firstMatch = false
+		excluded := []string{}
+		for _, scope := range isDependencySpec.ExcludeDependencyScopes {
+			excluded = append(excluded, scope.String())
+		}
+		queryValues[excludeDependencyScopes] = excluded
+	}
 	if isDependencySpec.Origin != nil {
 		matchProperties(sb, *firstMatch, "isDependency", origin, "$"+origin)
 		*firstMatch = false
@@ -192,9 +232,12 @@ func (c *neo4jClient) IngestDependency(ctx context.Context, pkg model.PkgInputSp
 
 	queryValues[versionRange] = dependency.VersionRange
 	queryValues[dependencyType] = dependency.DependencyType.String()
+	queryValues[dependencyScope] = helper.DependencyScopeOrUnknown(dependency.DependencyScope).String()
 	queryValues[justification] = dependency.Justification
 	queryValues[origin] = dependency.Origin
 	queryValues[collector] = dependency.Collector
+	queryValues[verified] = dependency.Verified
+	queryValues[signer] = dependency.Signer
 
 	returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
 		"version.qualifier_list, isDependency, objPkgType.type, objPkgNamespace.namespace, objPkgName.name"
@@ -207,9 +250,10 @@ func (c *neo4jClient) IngestDependency(ctx context.Context, pkg model.PkgInputSp
 	setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
 	setPkgMatchValues(&sb, &depPkgSpec, true, &firstMatch, queryValues)
 
-	merge := "\nMERGE (version)<-[:subject]-(isDependency:IsDependency{versionRange:$versionRange,dependencyType:$dependencyType,justification:$justification,origin:$origin,collector:$collector})" +
+	merge := "\nMERGE (version)<-[:subject]-(isDependency:IsDependency{versionRange:$versionRange,dependencyType:$dependencyType,dependencyScope:$dependencyScope,justification:$justification,origin:$origin,collector:$collector})" +
 		"-[:dependency]->(objPkgName)"
 	sb.WriteString(merge)
+	setTrust(&sb, "isDependency")
 	sb.WriteString(returnValue)
 
 	result, err := session.WriteTransaction(
@@ -251,14 +295,21 @@ func (c *neo4jClient) IngestDependency(ctx context.Context, pkg model.PkgInputSp
 			if err != nil {
 				return nil, fmt.Errorf("convertDependencyTypeToEnum failed with error: %w", err)
 			}
+			dependencyScopeEnum, err := convertDependencyScopeToEnum(isDependencyNode.Props[dependencyScope])
+			if err != nil {
+				return nil, fmt.Errorf("convertDependencyScopeToEnum failed with error: %w", err)
+			}
 
 			isDependency := &model.IsDependency{
 				Package:           pkg,
 				DependencyPackage: depPkg,
 				VersionRange:      isDependencyNode.Props[versionRange].(string),
 				DependencyType:    dependencyTypeEnum,
+				DependencyScope:   dependencyScopeEnum,
 				Origin:            isDependencyNode.Props[origin].(string),
 				Collector:         isDependencyNode.Props[collector].(string),
+				Verified:          nodeVerified(isDependencyNode),
+				Signer:            nodeSigner(isDependencyNode),
 			}
 
 			return isDependency, nil
@@ -282,3 +333,14 @@ func convertDependencyTypeToEnum(status string) (model.DependencyType, error) {
 	}
 	return model.DependencyTypeUnknown, fmt.Errorf("failed to convert DependencyType to enum")
 }
+
+func convertDependencyScopeToEnum(scope any) (model.DependencyScope, error) {
+	// isDependency nodes created before scopes were recorded have none
+	if scope == nil {
+		return model.DependencyScopeUnknown, nil
+	}
+	if depScope := model.DependencyScope(fmt.Sprint(scope)); depScope.IsValid() {
+		return depScope, nil
+	}
+	return model.DependencyScopeUnknown, fmt.Errorf("failed to convert DependencyScope to enum")
+}
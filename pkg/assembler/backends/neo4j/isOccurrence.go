@@ -56,12 +56,12 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 		}
 		setArtifactMatchValues(&sb, isOccurrenceSpec.Artifact, true, &firstMatch, queryValues)
 		setIsOccurrenceValues(&sb, isOccurrenceSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		returnPage(ctx, &sb, "isOccurrence", returnValue, queryValues)
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -91,6 +91,8 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 
 					isOccurrence := generateModelIsOccurrence(pkg, artifact, isOccurrenceNode.Props[justification].(string),
 						isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+					isOccurrence.Verified, isOccurrence.Signer = nodeVerified(isOccurrenceNode), nodeSigner(isOccurrenceNode)
+					isOccurrence.ID = nodeID(isOccurrenceNode)
 
 					collectedIsOccurrence = append(collectedIsOccurrence, isOccurrence)
 				}
@@ -120,12 +122,12 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 		}
 		setArtifactMatchValues(&sb, isOccurrenceSpec.Artifact, true, &firstMatch, queryValues)
 		setIsOccurrenceValues(&sb, isOccurrenceSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, isOccurrence, objArt.algorithm, objArt.digest")
+		returnPage(ctx, &sb, "isOccurrence", " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, isOccurrence, objArt.algorithm, objArt.digest", queryValues)
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runPaged(ctx, tx, sb.String(), queryValues)
 				if err != nil {
 					return nil, err
 				}
@@ -153,6 +155,8 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 
 					isOccurrence := generateModelIsOccurrence(src, artifact, isOccurrenceNode.Props[justification].(string),
 						isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+					isOccurrence.Verified, isOccurrence.Signer = nodeVerified(isOccurrenceNode), nodeSigner(isOccurrenceNode)
+					isOccurrence.ID = nodeID(isOccurrenceNode)
 
 					collectedIsOccurrence = append(collectedIsOccurrence, isOccurrence)
 				}
@@ -170,6 +174,17 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 	return aggregateIsOccurrence, nil
 }
 
+// IsOccurrenceList returns a page of the IsOccurrence query results, ordered by ID.
+func (c *neo4jClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.IsOccurrence) string { return n.ID }, func(ctx context.Context) ([]*model.IsOccurrence, error) {
+		return c.IsOccurrence(ctx, isOccurrenceSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsOccurrenceConnection(p), nil
+}
+
 func setIsOccurrenceValues(sb *strings.Builder, isOccurrenceSpec *model.IsOccurrenceSpec, firstMatch *bool, queryValues map[string]any) {
 	if isOccurrenceSpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "isOccurrence", justification, "$"+justification)
@@ -221,6 +236,8 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 	queryValues[justification] = occurrence.Justification
 	queryValues[origin] = occurrence.Origin
 	queryValues[collector] = occurrence.Collector
+	queryValues[verified] = occurrence.Verified
+	queryValues[signer] = occurrence.Signer
 
 	if subject.Package != nil {
 		// TODO: use generics here between PkgInputSpec and PkgSpecs?
@@ -236,6 +253,7 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 		merge := "\nMERGE (version)<-[:subject]-(isOccurrence:IsOccurrence{justification:$justification,origin:$origin,collector:$collector})" +
 			"-[:has_occurrence]->(objArt)"
 		sb.WriteString(merge)
+		setTrust(&sb, "isOccurrence")
 		returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
 			"version.qualifier_list, isOccurrence, objArt.algorithm, objArt.digest"
 		sb.WriteString(returnValue)
@@ -275,6 +293,7 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 
 				isOccurrence := generateModelIsOccurrence(pkg, artifact, isOccurrenceNode.Props[justification].(string),
 					isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+				isOccurrence.Verified, isOccurrence.Signer = nodeVerified(isOccurrenceNode), nodeSigner(isOccurrenceNode)
 
 				return isOccurrence, nil
 			})
@@ -299,6 +318,7 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 		merge := "\nMERGE (name)<-[:subject]-(isOccurrence:IsOccurrence{justification:$justification,origin:$origin,collector:$collector})" +
 			"-[:has_occurrence]->(objArt)"
 		sb.WriteString(merge)
+		setTrust(&sb, "isOccurrence")
 		sb.WriteString(returnValue)
 
 		result, err := session.WriteTransaction(
@@ -334,6 +354,7 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 
 				isOccurrence := generateModelIsOccurrence(src, artifact, isOccurrenceNode.Props[justification].(string),
 					isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+				isOccurrence.Verified, isOccurrence.Signer = nodeVerified(isOccurrenceNode), nodeSigner(isOccurrenceNode)
 
 				return isOccurrence, nil
 			})
@@ -0,0 +1,160 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
+)
+
+// Paginated queries use keyset pagination on the internal node IDs: the
+// cursor of an edge is the id() of the node behind it, and a page is the set
+// of nodes with an id() greater than the after cursor, in ascending order.
+//
+// The query of a type may be made of several Cypher queries (one per kind of
+// subject, for instance), so the page is requested through the context: each
+// RETURN clause written with returnPage only returns the nodes of the page,
+// plus one to find out whether there is a next page, and the list merges the
+// results of the queries.
+
+type pageRequestKey struct{}
+
+// pageRequest is the page a list query asks for, and the number of nodes the
+// queries run on its behalf have matched.
+type pageRequest struct {
+	after *int64
+	limit *int
+	// totals holds the number of results of each paged query, so that the
+	// retries of a transaction do not count them twice.
+	totals map[string]int
+	// counts maps the paged RETURN clauses written by returnPage to the
+	// clauses that count their results.
+	counts map[string]string
+}
+
+// nodeID returns the ID of the model built from node n.
+func nodeID(n dbtype.Node) string {
+	return strconv.FormatInt(n.Id, 10)
+}
+
+// returnPage writes the RETURN clause returnValue for the nodes bound to
+// nodeVar. If the context carries a page request, only the nodes of the page
+// are returned, and the query must be run with runPaged.
+func returnPage(ctx context.Context, sb *strings.Builder, nodeVar string, returnValue string, queryValues map[string]any) {
+	req, ok := ctx.Value(pageRequestKey{}).(*pageRequest)
+	if !ok {
+		sb.WriteString(returnValue)
+		return
+	}
+
+	var clause strings.Builder
+	if req.after != nil {
+		clause.WriteString(fmt.Sprintf("\nWITH * WHERE id(%s) > $pageAfter\n", nodeVar))
+		queryValues["pageAfter"] = *req.after
+	}
+	clause.WriteString(returnValue)
+	clause.WriteString(fmt.Sprintf(" ORDER BY id(%s)", nodeVar))
+	if req.limit != nil {
+		clause.WriteString(" LIMIT $pageLimit")
+		queryValues["pageLimit"] = *req.limit
+	}
+	req.counts[clause.String()] = fmt.Sprintf(" RETURN id(%s) AS pageID", nodeVar)
+	sb.WriteString(clause.String())
+}
+
+// runPaged runs query, and if its RETURN clauses were written for a page
+// request, counts all its results.
+func runPaged(ctx context.Context, tx neo4j.Transaction, query string, queryValues map[string]any) (neo4j.Result, error) {
+	req, ok := ctx.Value(pageRequestKey{}).(*pageRequest)
+	if ok {
+		countQuery := query
+		for clause, count := range req.counts {
+			countQuery = strings.ReplaceAll(countQuery, clause, count)
+		}
+		if countQuery != query {
+			result, err := tx.Run("CALL {\n"+countQuery+"\n}\nRETURN count(*)", queryValues)
+			if err != nil {
+				return nil, err
+			}
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+			req.totals[query] = int(record.Values[0].(int64))
+		}
+	}
+	return tx.Run(query, queryValues)
+}
+
+// listPage runs query, a query of a type, for the page that follows the after
+// cursor. If query runs no paged Cypher query, as is the case for the types
+// that are built from several nodes, the page is taken from all its results
+// instead.
+func listPage[T any](ctx context.Context, after *string, first *int, nodeID func(T) string, query func(context.Context) ([]T, error)) (*helper.Page[T], error) {
+	if err := helper.ValidatePaginationFirst(first); err != nil {
+		return nil, err
+	}
+	req := &pageRequest{totals: map[string]int{}, counts: map[string]string{}}
+	if after != nil {
+		id, err := strconv.ParseInt(*after, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor %q: %w", *after, err)
+		}
+		req.after = &id
+	}
+	if first != nil {
+		limit := *first + 1
+		req.limit = &limit
+	}
+	nodes, err := query(context.WithValue(ctx, pageRequestKey{}, req))
+	if err != nil {
+		return nil, err
+	}
+	if len(req.totals) == 0 {
+		return helper.Paginate(nodes, nodeID, after, first)
+	}
+
+	// Each of the Cypher queries returned its own page, so the merged nodes
+	// still need to be cut to the size of one.
+	slices.SortStableFunc(nodes, func(a, b T) int {
+		return helper.CompareIDs(nodeID(a), nodeID(b))
+	})
+	nodes = slices.CompactFunc(nodes, func(a, b T) bool {
+		return nodeID(a) == nodeID(b)
+	})
+	p := &helper.Page[T]{PageInfo: &model.PageInfo{}}
+	for _, total := range req.totals {
+		p.TotalCount += total
+	}
+	if first != nil && len(nodes) > *first {
+		nodes = nodes[:*first]
+		p.PageInfo.HasNextPage = true
+	}
+	p.Nodes = nodes
+	for _, n := range nodes {
+		p.Cursors = append(p.Cursors, nodeID(n))
+	}
+	p.SetCursors()
+	return p, nil
+}
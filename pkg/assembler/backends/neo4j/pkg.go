@@ -21,6 +21,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -150,6 +151,21 @@ func (c *neo4jClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*
 	return result.([]*model.Package), nil
 }
 
+// PackagesList returns a page of the packages query results, ordered by ID.
+// The packages are built from the nodes of every level of their trie, so the
+// page is taken from all the results of the query.
+func (c *neo4jClient) PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	nodes, err := c.Packages(ctx, pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := helper.Paginate(nodes, func(n *model.Package) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.PackageConnection(p), nil
+}
+
 func (c *neo4jClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
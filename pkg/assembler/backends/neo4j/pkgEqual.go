@@ -49,16 +49,16 @@ func (c *neo4jClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqual
 	queryValues := map[string]any{}
 
 	// query with for subject and object package
-	queryPkgEqual(&sb, selectedPkg, dependentPkg, pkgEqualSpec, false, queryValues)
+	queryPkgEqual(ctx, &sb, selectedPkg, dependentPkg, pkgEqualSpec, false, queryValues)
 
 	if len(pkgEqualSpec.Packages) > 0 {
 		// query with reverse order for subject and object package
-		queryPkgEqual(&sb, dependentPkg, selectedPkg, pkgEqualSpec, true, queryValues)
+		queryPkgEqual(ctx, &sb, dependentPkg, selectedPkg, pkgEqualSpec, true, queryValues)
 	}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runPaged(ctx, tx, sb.String(), queryValues)
 			if err != nil {
 				return nil, err
 			}
@@ -93,11 +93,13 @@ func (c *neo4jClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqual
 				}
 
 				pkgEqual := &model.PkgEqual{
+					ID:            nodeID(pkgEqualNode),
 					Packages:      []*model.Package{pkg, depPkg},
 					Justification: pkgEqualNode.Props[justification].(string),
 					Origin:        pkgEqualNode.Props[origin].(string),
 					Collector:     pkgEqualNode.Props[collector].(string),
 				}
+				pkgEqual.Verified, pkgEqual.Signer = nodeVerified(pkgEqualNode), nodeSigner(pkgEqualNode)
 				collectedPkgEqual = append(collectedPkgEqual, pkgEqual)
 			}
 			if err = result.Err(); err != nil {
@@ -113,7 +115,18 @@ func (c *neo4jClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqual
 	return result.([]*model.PkgEqual), nil
 }
 
-func queryPkgEqual(sb *strings.Builder, selectedPkg *model.PkgSpec, dependentPkg *model.PkgSpec, pkgEqualSpec *model.PkgEqualSpec, addInitialUnion bool,
+// PkgEqualList returns a page of the PkgEqual query results, ordered by ID.
+func (c *neo4jClient) PkgEqualList(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.PkgEqual) string { return n.ID }, func(ctx context.Context) ([]*model.PkgEqual, error) {
+		return c.PkgEqual(ctx, pkgEqualSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.PkgEqualConnection(p), nil
+}
+
+func queryPkgEqual(ctx context.Context, sb *strings.Builder, selectedPkg *model.PkgSpec, dependentPkg *model.PkgSpec, pkgEqualSpec *model.PkgEqualSpec, addInitialUnion bool,
 	queryValues map[string]any) {
 
 	returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
@@ -139,7 +152,7 @@ func queryPkgEqual(sb *strings.Builder, selectedPkg *model.PkgSpec, dependentPkg
 	setPkgMatchValues(sb, dependentPkg, true, &firstMatch, queryValues)
 	setPkgEqualValues(sb, pkgEqualSpec, &firstMatch, queryValues)
 
-	sb.WriteString(returnValue)
+	returnPage(ctx, sb, "pkgEqual", returnValue, queryValues)
 }
 
 func setPkgEqualValues(sb *strings.Builder, pkgEqualSpec *model.PkgEqualSpec, firstMatch *bool, queryValues map[string]any) {
@@ -179,6 +192,8 @@ func (c *neo4jClient) IngestPkgEqual(ctx context.Context, pkg model.PkgInputSpec
 	queryValues[justification] = pkgEqual.Justification
 	queryValues[origin] = pkgEqual.Origin
 	queryValues[collector] = pkgEqual.Collector
+	queryValues[verified] = pkgEqual.Verified
+	queryValues[signer] = pkgEqual.Signer
 
 	query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
 		"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)"
@@ -198,6 +213,7 @@ func (c *neo4jClient) IngestPkgEqual(ctx context.Context, pkg model.PkgInputSpec
 		"-[:pkg_certification]->(objPkgVersion)"
 
 	sb.WriteString(merge)
+	setTrust(&sb, "pkgEqual")
 
 	returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
 		"version.qualifier_list, pkgEqual, objPkgType.type, objPkgNamespace.namespace, objPkgName.name, " +
@@ -249,6 +265,7 @@ func (c *neo4jClient) IngestPkgEqual(ctx context.Context, pkg model.PkgInputSpec
 				Origin:        pkgEqualNode.Props[origin].(string),
 				Collector:     pkgEqualNode.Props[collector].(string),
 			}
+			pkgEqual.Verified, pkgEqual.Signer = nodeVerified(pkgEqualNode), nodeSigner(pkgEqualNode)
 
 			return pkgEqual, nil
 		})
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -127,6 +128,21 @@ func (c *neo4jClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec)
 	return result.([]*model.Source), nil
 }
 
+// SourcesList returns a page of the sources query results, ordered by ID.
+// The sources are built from the nodes of every level of their trie, so the
+// page is taken from all the results of the query.
+func (c *neo4jClient) SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	nodes, err := c.Sources(ctx, sourceSpec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := helper.Paginate(nodes, func(n *model.Source) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.SourceConnection(p), nil
+}
+
 func (c *neo4jClient) sourcesType(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -163,6 +164,17 @@ func (c *neo4jClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEq
 	return []*model.VulnEqual{}, fmt.Errorf("not implemented - VulnEqual")
 }
 
+// VulnEqualList returns a page of the vulnEqual query results, ordered by ID.
+func (c *neo4jClient) VulnEqualList(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.VulnEqual) string { return n.ID }, func(ctx context.Context) ([]*model.VulnEqual, error) {
+		return c.VulnEqual(ctx, vulnEqualSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnEqualConnection(p), nil
+}
+
 // func setIsVulnerabilityValues(sb *strings.Builder, isVulnerabilitySpec *model.IsVulnerabilitySpec, firstMatch *bool, queryValues map[string]any) {
 // 	if isVulnerabilitySpec.Justification != nil {
 // 		matchProperties(sb, *firstMatch, "isVulnerability", justification, "$"+justification)
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -33,3 +34,14 @@ func (c *neo4jClient) IngestBulkVulnerabilityMetadata(ctx context.Context, vulne
 func (c *neo4jClient) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
 	return []*model.VulnerabilityMetadata{}, fmt.Errorf("not implemented - VulnerabilityMetadata")
 }
+
+// VulnerabilityMetadataList returns a page of the vulnerabilityMetadata query results, ordered by ID.
+func (c *neo4jClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+	p, err := listPage(ctx, after, first, func(n *model.VulnerabilityMetadata) string { return n.ID }, func(ctx context.Context) ([]*model.VulnerabilityMetadata, error) {
+		return c.VulnerabilityMetadata(ctx, vulnerabilityMetadataSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnerabilityMetadataConnection(p), nil
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -89,6 +90,21 @@ func (c *neo4jClient) Vulnerabilities(ctx context.Context, vulnSpec *model.Vulne
 	return []*model.Vulnerability{}, fmt.Errorf("not implemented - Vulnerabilities")
 }
 
+// VulnerabilitiesList returns a page of the vulnerabilities query results, ordered by ID.
+// The vulnerabilities are built from the nodes of every level of their trie, so the
+// page is taken from all the results of the query.
+func (c *neo4jClient) VulnerabilitiesList(ctx context.Context, vulnSpec *model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
+	nodes, err := c.Vulnerabilities(ctx, vulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	p, err := helper.Paginate(nodes, func(n *model.Vulnerability) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnerabilityConnection(p), nil
+}
+
 // func (c *neo4jClient) cveYear(ctx context.Context, cveSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
 // 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 // 	defer session.Close()
@@ -24,16 +24,20 @@ import (
 	"github.com/guacsec/guac/pkg/assembler"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
 )
 
 func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]assembler.AssemblerInput) error {
 	logger := logging.FromContext(ctx)
 	return func(preds []assembler.IngestPredicates) error {
+		ctx, span := tracing.Start(ctx, "assemble")
+		defer span.End()
 		for _, p := range preds {
 			var packageAndArtifactIDs []string
 
 			packages := p.GetPackages(ctx)
-			logger.Infof("assembling Package: %v", len(packages))
+			assembling(ctx, "Package", len(packages))
 			var collectedPackages []model.PkgInputSpec
 			collectedPackages = make([]model.PkgInputSpec, 0)
 			for _, v := range packages {
@@ -46,7 +50,7 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 			}
 
 			sources := p.GetSources(ctx)
-			logger.Infof("assembling Source: %v", len(sources))
+			assembling(ctx, "Source", len(sources))
 
 			var collectedSources []model.SourceInputSpec
 			collectedSources = make([]model.SourceInputSpec, 0)
@@ -58,7 +62,7 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 			}
 
 			artifacts := p.GetArtifacts(ctx)
-			logger.Infof("assembling Artifact: %v", len(artifacts))
+			assembling(ctx, "Artifact", len(artifacts))
 			var collectedArtifacts []model.ArtifactInputSpec
 			collectedArtifacts = make([]model.ArtifactInputSpec, 0)
 			for _, v := range artifacts {
@@ -71,7 +75,7 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 			}
 
 			materials := p.GetMaterials(ctx)
-			logger.Infof("assembling Materials (Artifact): %v", len(materials))
+			assembling(ctx, "Materials", len(materials))
 			if ids, err := ingestArtifacts(ctx, gqlclient, materials); err != nil {
 				logger.Errorf("ingestArtifacts failed with error: %v", err)
 			} else {
@@ -79,7 +83,7 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 			}
 
 			builders := p.GetBuilders(ctx)
-			logger.Infof("assembling Builder: %v", len(builders))
+			assembling(ctx, "Builder", len(builders))
 			var collectedBuilders []model.BuilderInputSpec
 			collectedBuilders = make([]model.BuilderInputSpec, 0)
 			for _, v := range builders {
@@ -90,7 +94,7 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 			}
 
 			vulns := p.GetVulnerabilities(ctx)
-			logger.Infof("assembling Vulnerability: %v", len(vulns))
+			assembling(ctx, "Vulnerability", len(vulns))
 			var collectedVulns []model.VulnerabilityInputSpec
 			collectedVulns = make([]model.VulnerabilityInputSpec, 0)
 			for _, v := range vulns {
@@ -101,17 +105,17 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 			}
 
 			licenses := p.GetLicenses(ctx)
-			logger.Infof("assembling Licenses: %v", len(licenses))
+			assembling(ctx, "Licenses", len(licenses))
 			if err := ingestLicenses(ctx, gqlclient, licenses); err != nil {
 				logger.Errorf("ingestLicenses failed with error: %v", err)
 			}
 
-			logger.Infof("assembling CertifyScorecard: %v", len(p.CertifyScorecard))
+			assembling(ctx, "CertifyScorecard", len(p.CertifyScorecard))
 			if err := ingestCertifyScorecards(ctx, gqlclient, p.CertifyScorecard); err != nil {
 				logger.Errorf("ingestCertifyScorecards failed with error: %v", err)
 			}
 
-			logger.Infof("assembling IsDependency: %v", len(p.IsDependency))
+			assembling(ctx, "IsDependency", len(p.IsDependency))
 			isDependenciesIDs := []string{}
 			if ingestedIsDependenciesIDs, err := ingestIsDependencies(ctx, gqlclient, p.IsDependency); err != nil {
 				logger.Errorf("ingestIsDependencies failed with error: %v", err)
@@ -119,7 +123,7 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 				isDependenciesIDs = append(isDependenciesIDs, ingestedIsDependenciesIDs...)
 			}
 
-			logger.Infof("assembling IsOccurrence: %v", len(p.IsOccurrence))
+			assembling(ctx, "IsOccurrence", len(p.IsOccurrence))
 			isOccurrencesIDs := []string{}
 			if ingestedIsOccurrencesIDs, err := ingestIsOccurrences(ctx, gqlclient, p.IsOccurrence); err != nil {
 				logger.Errorf("ingestIsOccurrences failed with error: %v", err)
@@ -127,55 +131,55 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 				isOccurrencesIDs = append(isOccurrencesIDs, ingestedIsOccurrencesIDs...)
 			}
 
-			logger.Infof("assembling HasSLSA: %v", len(p.HasSlsa))
+			assembling(ctx, "HasSLSA", len(p.HasSlsa))
 			if err := ingestHasSLSAs(ctx, gqlclient, p.HasSlsa); err != nil {
 				logger.Errorf("ingestHasSLSAs failed with error: %v", err)
 			}
 
-			logger.Infof("assembling CertifyVuln: %v", len(p.CertifyVuln))
+			assembling(ctx, "CertifyVuln", len(p.CertifyVuln))
 			if err := ingestCertifyVulns(ctx, gqlclient, p.CertifyVuln); err != nil {
 				logger.Errorf("ingestCertifyVulns failed with error: %v", err)
 			}
 
-			logger.Infof("assembling VulnMetadata: %v", len(p.VulnMetadata))
+			assembling(ctx, "VulnMetadata", len(p.VulnMetadata))
 			if err := ingestVulnMetadatas(ctx, gqlclient, p.VulnMetadata); err != nil {
 				logger.Errorf("ingestVulnMetadatas failed with error: %v", err)
 			}
 
-			logger.Infof("assembling VulnEqual: %v", len(p.VulnEqual))
+			assembling(ctx, "VulnEqual", len(p.VulnEqual))
 			if err := ingestVulnEquals(ctx, gqlclient, p.VulnEqual); err != nil {
 				logger.Errorf("ingestVulnEquals failed with error: %v", err)
 
 			}
 
-			logger.Infof("assembling HasSourceAt: %v", len(p.HasSourceAt))
+			assembling(ctx, "HasSourceAt", len(p.HasSourceAt))
 			if err := ingestHasSourceAts(ctx, gqlclient, p.HasSourceAt); err != nil {
 				return fmt.Errorf("ingestHasSourceAts failed with error: %w", err)
 			}
 
-			logger.Infof("assembling CertifyBad: %v", len(p.CertifyBad))
+			assembling(ctx, "CertifyBad", len(p.CertifyBad))
 			if err := ingestCertifyBads(ctx, gqlclient, p.CertifyBad); err != nil {
 				logger.Errorf("ingestCertifyBads failed with error: %v", err)
 
 			}
 
-			logger.Infof("assembling CertifyGood: %v", len(p.CertifyGood))
+			assembling(ctx, "CertifyGood", len(p.CertifyGood))
 			if err := ingestCertifyGoods(ctx, gqlclient, p.CertifyGood); err != nil {
 				logger.Errorf("ingestCertifyGoods failed with error: %v", err)
 
 			}
 
-			logger.Infof("assembling PointOfContact: %v", len(p.PointOfContact))
+			assembling(ctx, "PointOfContact", len(p.PointOfContact))
 			if err := ingestPointOfContacts(ctx, gqlclient, p.PointOfContact); err != nil {
 				logger.Errorf("ingestPointOfContacts failed with error: %v", err)
 			}
 
-			logger.Infof("assembling HasMetadata: %v", len(p.HasMetadata))
+			assembling(ctx, "HasMetadata", len(p.HasMetadata))
 			if err := ingestBulkHasMetadata(ctx, gqlclient, p.HasMetadata); err != nil {
 				logger.Errorf("ingestBulkHasMetadata failed with error: %v", err)
 			}
 
-			logger.Infof("assembling HasSBOM: %v", len(p.HasSBOM))
+			assembling(ctx, "HasSBOM", len(p.HasSBOM))
 			if err := ingestHasSBOMs(ctx, gqlclient, p.HasSBOM, model.HasSBOMIncludesInputSpec{
 				Software:     packageAndArtifactIDs,
 				Dependencies: isDependenciesIDs,
@@ -184,22 +188,22 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 				logger.Errorf("ingestHasSBOMs failed with error: %v", err)
 			}
 
-			logger.Infof("assembling VEX : %v", len(p.Vex))
+			assembling(ctx, "VEX", len(p.Vex))
 			if err := ingestVEXs(ctx, gqlclient, p.Vex); err != nil {
 				logger.Errorf("ingestVEXs failed with error: %v", err)
 			}
 
-			logger.Infof("assembling HashEqual : %v", len(p.HashEqual))
+			assembling(ctx, "HashEqual", len(p.HashEqual))
 			if err := ingestHashEquals(ctx, gqlclient, p.HashEqual); err != nil {
 				logger.Errorf("ingestHashEquals failed with error: %v", err)
 			}
 
-			logger.Infof("assembling PkgEqual : %v", len(p.PkgEqual))
+			assembling(ctx, "PkgEqual", len(p.PkgEqual))
 			if err := ingestPkgEquals(ctx, gqlclient, p.PkgEqual); err != nil {
 				logger.Errorf("ingestPkgEquals failed with error: %v", err)
 			}
 
-			logger.Infof("assembling CertifyLegal : %v", len(p.CertifyLegal))
+			assembling(ctx, "CertifyLegal", len(p.CertifyLegal))
 			if err := ingestCertifyLegals(ctx, gqlclient, p.CertifyLegal); err != nil {
 				logger.Errorf("ingestCertifyLegals failed with error: %v", err)
 			}
@@ -208,6 +212,15 @@ func GetBulkAssembler(ctx context.Context, gqlclient graphql.Client) func([]asse
 	}
 }
 
+// assembling logs the number of inputs of a kind being assembled, and
+// observes it in the assembler batch sizes
+func assembling(ctx context.Context, kind string, size int) {
+	logging.FromContext(ctx).Infof("assembling %s: %v", kind, size)
+	if size > 0 {
+		metrics.FromContext(ctx).ObserveSummaryVec(metrics.AssemblerBatchSize, float64(size), kind)
+	}
+}
+
 func ingestPackages(ctx context.Context, client graphql.Client, v []model.PkgInputSpec) ([]string, error) {
 	response, err := model.IngestPackages(ctx, client, v)
 	if err != nil {
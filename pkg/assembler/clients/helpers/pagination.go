@@ -0,0 +1,272 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// DefaultPageSize is the number of nodes requested per page by the helpers
+// that walk paginated queries.
+const DefaultPageSize = 100
+
+// PageInfo is implemented by the pageInfo of every generated `<query>List`
+// response.
+type PageInfo interface {
+	GetHasNextPage() bool
+	GetEndCursor() *string
+}
+
+// FollowPages calls fetchPage with the end cursor of the previous page, starting
+// with a nil cursor, until the last page has been fetched or fetchPage fails.
+func FollowPages[P PageInfo](fetchPage func(after *string) (P, error)) error {
+	var after *string
+	for {
+		pageInfo, err := fetchPage(after)
+		if err != nil {
+			return err
+		}
+		if !pageInfo.GetHasNextPage() {
+			return nil
+		}
+		after = pageInfo.GetEndCursor()
+	}
+}
+
+// collectPages returns the nodes of all the pages of a paginated query.
+// fetchPage fetches the page of first nodes that follows the after cursor.
+func collectPages[T any, P PageInfo](fetchPage func(after *string, first *int) ([]T, P, error)) ([]T, error) {
+	first := DefaultPageSize
+	var nodes []T
+	err := FollowPages(func(after *string) (P, error) {
+		page, pageInfo, err := fetchPage(after, &first)
+		nodes = append(nodes, page...)
+		return pageInfo, err
+	})
+	return nodes, err
+}
+
+// Artifacts returns all the artifacts matching filter, fetching them one page at a
+// time.
+func Artifacts(ctx context.Context, client graphql.Client, filter model.ArtifactSpec) ([]model.AllArtifactTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllArtifactTree, *model.ArtifactsListArtifactsListArtifactConnectionPageInfo, error) {
+		response, err := model.ArtifactsList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllArtifactTree
+		for _, edge := range response.ArtifactsList.Edges {
+			nodes = append(nodes, edge.Node.AllArtifactTree)
+		}
+		return nodes, &response.ArtifactsList.PageInfo, nil
+	})
+}
+
+// Packages returns all the packages matching filter, fetching them one page at a
+// time.
+func Packages(ctx context.Context, client graphql.Client, filter model.PkgSpec) ([]model.AllPkgTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllPkgTree, *model.PackagesListPackagesListPackageConnectionPageInfo, error) {
+		response, err := model.PackagesList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllPkgTree
+		for _, edge := range response.PackagesList.Edges {
+			nodes = append(nodes, edge.Node.AllPkgTree)
+		}
+		return nodes, &response.PackagesList.PageInfo, nil
+	})
+}
+
+// Sources returns all the sources matching filter, fetching them one page at a
+// time.
+func Sources(ctx context.Context, client graphql.Client, filter model.SourceSpec) ([]model.AllSourceTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllSourceTree, *model.SourcesListSourcesListSourceConnectionPageInfo, error) {
+		response, err := model.SourcesList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllSourceTree
+		for _, edge := range response.SourcesList.Edges {
+			nodes = append(nodes, edge.Node.AllSourceTree)
+		}
+		return nodes, &response.SourcesList.PageInfo, nil
+	})
+}
+
+// Vulnerabilities returns all the vulnerabilities matching filter, fetching them one page at a
+// time.
+func Vulnerabilities(ctx context.Context, client graphql.Client, filter model.VulnerabilitySpec) ([]model.AllVulnerabilityTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllVulnerabilityTree, *model.VulnerabilitiesListVulnerabilitiesListVulnerabilityConnectionPageInfo, error) {
+		response, err := model.VulnerabilitiesList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllVulnerabilityTree
+		for _, edge := range response.VulnerabilitiesList.Edges {
+			nodes = append(nodes, edge.Node.AllVulnerabilityTree)
+		}
+		return nodes, &response.VulnerabilitiesList.PageInfo, nil
+	})
+}
+
+// CertifyBads returns all the CertifyBad nodes matching filter, fetching them one page at a
+// time.
+func CertifyBads(ctx context.Context, client graphql.Client, filter model.CertifyBadSpec) ([]model.AllCertifyBad, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllCertifyBad, *model.CertifyBadListCertifyBadListCertifyBadConnectionPageInfo, error) {
+		response, err := model.CertifyBadList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllCertifyBad
+		for _, edge := range response.CertifyBadList.Edges {
+			nodes = append(nodes, edge.Node.AllCertifyBad)
+		}
+		return nodes, &response.CertifyBadList.PageInfo, nil
+	})
+}
+
+// CertifyLegals returns all the CertifyLegal nodes matching filter, fetching them one page at a
+// time.
+func CertifyLegals(ctx context.Context, client graphql.Client, filter model.CertifyLegalSpec) ([]model.AllCertifyLegalTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllCertifyLegalTree, *model.CertifyLegalListCertifyLegalListCertifyLegalConnectionPageInfo, error) {
+		response, err := model.CertifyLegalList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllCertifyLegalTree
+		for _, edge := range response.CertifyLegalList.Edges {
+			nodes = append(nodes, edge.Node.AllCertifyLegalTree)
+		}
+		return nodes, &response.CertifyLegalList.PageInfo, nil
+	})
+}
+
+// CertifyVEXStatements returns all the CertifyVEXStatement nodes matching filter, fetching them one page at a
+// time.
+func CertifyVEXStatements(ctx context.Context, client graphql.Client, filter model.CertifyVEXStatementSpec) ([]model.AllCertifyVEXStatement, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllCertifyVEXStatement, *model.CertifyVEXStatementListCertifyVEXStatementListCertifyVEXStatementConnectionPageInfo, error) {
+		response, err := model.CertifyVEXStatementList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllCertifyVEXStatement
+		for _, edge := range response.CertifyVEXStatementList.Edges {
+			nodes = append(nodes, edge.Node.AllCertifyVEXStatement)
+		}
+		return nodes, &response.CertifyVEXStatementList.PageInfo, nil
+	})
+}
+
+// CertifyVulns returns all the CertifyVuln nodes matching filter, fetching them one page at a
+// time.
+func CertifyVulns(ctx context.Context, client graphql.Client, filter model.CertifyVulnSpec) ([]model.AllCertifyVuln, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllCertifyVuln, *model.CertifyVulnListCertifyVulnListCertifyVulnConnectionPageInfo, error) {
+		response, err := model.CertifyVulnList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllCertifyVuln
+		for _, edge := range response.CertifyVulnList.Edges {
+			nodes = append(nodes, edge.Node.AllCertifyVuln)
+		}
+		return nodes, &response.CertifyVulnList.PageInfo, nil
+	})
+}
+
+// HasMetadata returns all the HasMetadata nodes matching filter, fetching them one page at a
+// time.
+func HasMetadata(ctx context.Context, client graphql.Client, filter model.HasMetadataSpec) ([]model.AllHasMetadata, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllHasMetadata, *model.HasMetadataListHasMetadataListHasMetadataConnectionPageInfo, error) {
+		response, err := model.HasMetadataList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllHasMetadata
+		for _, edge := range response.HasMetadataList.Edges {
+			nodes = append(nodes, edge.Node.AllHasMetadata)
+		}
+		return nodes, &response.HasMetadataList.PageInfo, nil
+	})
+}
+
+// HasSBOMs returns all the HasSBOM nodes matching filter, fetching them one page at a
+// time.
+func HasSBOMs(ctx context.Context, client graphql.Client, filter model.HasSBOMSpec) ([]model.AllHasSBOMTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllHasSBOMTree, *model.HasSBOMListHasSBOMListHasSBOMConnectionPageInfo, error) {
+		response, err := model.HasSBOMList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllHasSBOMTree
+		for _, edge := range response.HasSBOMList.Edges {
+			nodes = append(nodes, edge.Node.AllHasSBOMTree)
+		}
+		return nodes, &response.HasSBOMList.PageInfo, nil
+	})
+}
+
+// IsDependencies returns all the IsDependency nodes matching filter, fetching them one page at a
+// time.
+func IsDependencies(ctx context.Context, client graphql.Client, filter model.IsDependencySpec) ([]model.AllIsDependencyTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllIsDependencyTree, *model.IsDependencyListIsDependencyListIsDependencyConnectionPageInfo, error) {
+		response, err := model.IsDependencyList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllIsDependencyTree
+		for _, edge := range response.IsDependencyList.Edges {
+			nodes = append(nodes, edge.Node.AllIsDependencyTree)
+		}
+		return nodes, &response.IsDependencyList.PageInfo, nil
+	})
+}
+
+// IsOccurrences returns all the IsOccurrence nodes matching filter, fetching them one page at a
+// time.
+func IsOccurrences(ctx context.Context, client graphql.Client, filter model.IsOccurrenceSpec) ([]model.AllIsOccurrencesTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllIsOccurrencesTree, *model.IsOccurrenceListIsOccurrenceListIsOccurrenceConnectionPageInfo, error) {
+		response, err := model.IsOccurrenceList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllIsOccurrencesTree
+		for _, edge := range response.IsOccurrenceList.Edges {
+			nodes = append(nodes, edge.Node.AllIsOccurrencesTree)
+		}
+		return nodes, &response.IsOccurrenceList.PageInfo, nil
+	})
+}
+
+// VulnerabilityMetadata returns all the VulnerabilityMetadata nodes matching filter, fetching them one page at a
+// time.
+func VulnerabilityMetadata(ctx context.Context, client graphql.Client, filter model.VulnerabilityMetadataSpec) ([]model.AllVulnMetadataTree, error) {
+	return collectPages(func(after *string, first *int) ([]model.AllVulnMetadataTree, *model.VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionPageInfo, error) {
+		response, err := model.VulnerabilityMetadataList(ctx, client, filter, after, first)
+		if err != nil {
+			return nil, nil, err
+		}
+		var nodes []model.AllVulnMetadataTree
+		for _, edge := range response.VulnerabilityMetadataList.Edges {
+			nodes = append(nodes, edge.Node.AllVulnMetadataTree)
+		}
+		return nodes, &response.VulnerabilityMetadataList.PageInfo, nil
+	})
+}
@@ -35,9 +35,11 @@ type Config struct {
 type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
+	HasRole func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
@@ -47,18 +49,53 @@ type ComplexityRoot struct {
 		ID        func(childComplexity int) int
 	}
 
+	ArtifactConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ArtifactEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Builder struct {
 		ID  func(childComplexity int) int
 		URI func(childComplexity int) int
 	}
 
+	BuilderConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	BuilderEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyBad struct {
 		Collector     func(childComplexity int) int
 		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		KnownSince    func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		Subject       func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	CertifyBadConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyBadEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	CertifyGood struct {
@@ -67,7 +104,20 @@ type ComplexityRoot struct {
 		Justification func(childComplexity int) int
 		KnownSince    func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		Subject       func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	CertifyGoodConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyGoodEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	CertifyLegal struct {
@@ -80,8 +130,21 @@ type ComplexityRoot struct {
 		ID                 func(childComplexity int) int
 		Justification      func(childComplexity int) int
 		Origin             func(childComplexity int) int
+		Signer             func(childComplexity int) int
 		Subject            func(childComplexity int) int
 		TimeScanned        func(childComplexity int) int
+		Verified           func(childComplexity int) int
+	}
+
+	CertifyLegalConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyLegalEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	CertifyScorecard struct {
@@ -90,19 +153,43 @@ type ComplexityRoot struct {
 		Source    func(childComplexity int) int
 	}
 
+	CertifyScorecardConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyScorecardEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyVEXStatement struct {
 		Collector        func(childComplexity int) int
 		ID               func(childComplexity int) int
 		KnownSince       func(childComplexity int) int
 		Origin           func(childComplexity int) int
+		Signer           func(childComplexity int) int
 		Statement        func(childComplexity int) int
 		Status           func(childComplexity int) int
 		StatusNotes      func(childComplexity int) int
 		Subject          func(childComplexity int) int
+		Verified         func(childComplexity int) int
 		VexJustification func(childComplexity int) int
 		Vulnerability    func(childComplexity int) int
 	}
 
+	CertifyVEXStatementConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyVEXStatementEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyVuln struct {
 		ID            func(childComplexity int) int
 		Metadata      func(childComplexity int) int
@@ -110,15 +197,39 @@ type ComplexityRoot struct {
 		Vulnerability func(childComplexity int) int
 	}
 
+	CertifyVulnConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyVulnEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasMetadata struct {
 		Collector     func(childComplexity int) int
 		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Key           func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		Subject       func(childComplexity int) int
 		Timestamp     func(childComplexity int) int
 		Value         func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	HasMetadataConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasMetadataEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	HasSBOM struct {
@@ -132,8 +243,21 @@ type ComplexityRoot struct {
 		IncludedSoftware     func(childComplexity int) int
 		KnownSince           func(childComplexity int) int
 		Origin               func(childComplexity int) int
+		Signer               func(childComplexity int) int
 		Subject              func(childComplexity int) int
 		URI                  func(childComplexity int) int
+		Verified             func(childComplexity int) int
+	}
+
+	HasSBOMConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSBOMEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	HasSLSA struct {
@@ -142,6 +266,17 @@ type ComplexityRoot struct {
 		Subject func(childComplexity int) int
 	}
 
+	HasSLSAConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSLSAEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasSourceAt struct {
 		Collector     func(childComplexity int) int
 		ID            func(childComplexity int) int
@@ -149,7 +284,20 @@ type ComplexityRoot struct {
 		KnownSince    func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		Package       func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		Source        func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	HasSourceAtConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSourceAtEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	HashEqual struct {
@@ -158,26 +306,66 @@ type ComplexityRoot struct {
 		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Signer        func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	HashEqualConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HashEqualEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	IsDependency struct {
 		Collector         func(childComplexity int) int
 		DependencyPackage func(childComplexity int) int
+		DependencyScope   func(childComplexity int) int
 		DependencyType    func(childComplexity int) int
 		ID                func(childComplexity int) int
 		Justification     func(childComplexity int) int
 		Origin            func(childComplexity int) int
 		Package           func(childComplexity int) int
+		Signer            func(childComplexity int) int
+		Verified          func(childComplexity int) int
 		VersionRange      func(childComplexity int) int
 	}
 
+	IsDependencyConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	IsDependencyEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	IsOccurrence struct {
 		Artifact      func(childComplexity int) int
 		Collector     func(childComplexity int) int
 		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		Subject       func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	IsOccurrenceConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	IsOccurrenceEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	License struct {
@@ -187,7 +375,35 @@ type ComplexityRoot struct {
 		Name        func(childComplexity int) int
 	}
 
+	LicenseConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	LicenseEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Mutation struct {
+		DeleteCertifyBad                func(childComplexity int, id string) int
+		DeleteCertifyGood               func(childComplexity int, id string) int
+		DeleteCertifyLegal              func(childComplexity int, id string) int
+		DeleteCertifyScorecard          func(childComplexity int, id string) int
+		DeleteCertifyVEXStatement       func(childComplexity int, id string) int
+		DeleteCertifyVuln               func(childComplexity int, id string) int
+		DeleteHasMetadata               func(childComplexity int, id string) int
+		DeleteHasSbom                   func(childComplexity int, id string) int
+		DeleteHasSlsa                   func(childComplexity int, id string) int
+		DeleteHasSourceAt               func(childComplexity int, id string) int
+		DeleteHashEqual                 func(childComplexity int, id string) int
+		DeleteIsDependency              func(childComplexity int, id string) int
+		DeleteIsOccurrence              func(childComplexity int, id string) int
+		DeletePkgEqual                  func(childComplexity int, id string) int
+		DeletePointOfContact            func(childComplexity int, id string) int
+		DeleteVulnEqual                 func(childComplexity int, id string) int
+		DeleteVulnerabilityMetadata     func(childComplexity int, id string) int
 		IngestArtifact                  func(childComplexity int, artifact *model.ArtifactInputSpec) int
 		IngestArtifacts                 func(childComplexity int, artifacts []*model.ArtifactInputSpec) int
 		IngestBuilder                   func(childComplexity int, builder *model.BuilderInputSpec) int
@@ -234,6 +450,7 @@ type ComplexityRoot struct {
 		IngestVulnerabilities           func(childComplexity int, vulns []*model.VulnerabilityInputSpec) int
 		IngestVulnerability             func(childComplexity int, vuln model.VulnerabilityInputSpec) int
 		IngestVulnerabilityMetadata     func(childComplexity int, vulnerability model.VulnerabilityInputSpec, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) int
+		RetractEvidence                 func(childComplexity int, origin string) int
 	}
 
 	Package struct {
@@ -242,6 +459,17 @@ type ComplexityRoot struct {
 		Type       func(childComplexity int) int
 	}
 
+	PackageConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PackageEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	PackageIDs struct {
 		PackageNameID      func(childComplexity int) int
 		PackageNamespaceID func(childComplexity int) int
@@ -273,12 +501,31 @@ type ComplexityRoot struct {
 		Version    func(childComplexity int) int
 	}
 
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+		StartCursor func(childComplexity int) int
+	}
+
 	PkgEqual struct {
 		Collector     func(childComplexity int) int
 		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		Packages      func(childComplexity int) int
+		Signer        func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	PkgEqualConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PkgEqualEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	PointOfContact struct {
@@ -288,39 +535,75 @@ type ComplexityRoot struct {
 		Info          func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		Since         func(childComplexity int) int
 		Subject       func(childComplexity int) int
+		Verified      func(childComplexity int) int
+	}
+
+	PointOfContactConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PointOfContactEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	Query struct {
-		Artifacts             func(childComplexity int, artifactSpec model.ArtifactSpec) int
-		Builders              func(childComplexity int, builderSpec model.BuilderSpec) int
-		CertifyBad            func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
-		CertifyGood           func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec) int
-		CertifyLegal          func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec) int
-		CertifyVEXStatement   func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
-		CertifyVuln           func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
-		FindSoftware          func(childComplexity int, searchText string) int
-		HasMetadata           func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
-		HasSbom               func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
-		HasSlsa               func(childComplexity int, hasSLSASpec model.HasSLSASpec) int
-		HasSourceAt           func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec) int
-		HashEqual             func(childComplexity int, hashEqualSpec model.HashEqualSpec) int
-		IsDependency          func(childComplexity int, isDependencySpec model.IsDependencySpec) int
-		IsOccurrence          func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec) int
-		Licenses              func(childComplexity int, licenseSpec model.LicenseSpec) int
-		Neighbors             func(childComplexity int, node string, usingOnly []model.Edge) int
-		Node                  func(childComplexity int, node string) int
-		Nodes                 func(childComplexity int, nodes []string) int
-		Packages              func(childComplexity int, pkgSpec model.PkgSpec) int
-		Path                  func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
-		PkgEqual              func(childComplexity int, pkgEqualSpec model.PkgEqualSpec) int
-		PointOfContact        func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
-		Scorecards            func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
-		Sources               func(childComplexity int, sourceSpec model.SourceSpec) int
-		VulnEqual             func(childComplexity int, vulnEqualSpec model.VulnEqualSpec) int
-		Vulnerabilities       func(childComplexity int, vulnSpec model.VulnerabilitySpec) int
-		VulnerabilityMetadata func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) int
+		Artifacts                 func(childComplexity int, artifactSpec model.ArtifactSpec) int
+		ArtifactsList             func(childComplexity int, artifactSpec model.ArtifactSpec, after *string, first *int) int
+		Builders                  func(childComplexity int, builderSpec model.BuilderSpec) int
+		BuildersList              func(childComplexity int, builderSpec model.BuilderSpec, after *string, first *int) int
+		CertifyBad                func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
+		CertifyBadList            func(childComplexity int, certifyBadSpec model.CertifyBadSpec, after *string, first *int) int
+		CertifyGood               func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec) int
+		CertifyGoodList           func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) int
+		CertifyLegal              func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec) int
+		CertifyLegalList          func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) int
+		CertifyVEXStatement       func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
+		CertifyVEXStatementList   func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) int
+		CertifyVuln               func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
+		CertifyVulnList           func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) int
+		FindSoftware              func(childComplexity int, searchText string) int
+		HasMetadata               func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
+		HasMetadataList           func(childComplexity int, hasMetadataSpec model.HasMetadataSpec, after *string, first *int) int
+		HasSBOMList               func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) int
+		HasSLSAList               func(childComplexity int, hasSLSASpec model.HasSLSASpec, after *string, first *int) int
+		HasSbom                   func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
+		HasSlsa                   func(childComplexity int, hasSLSASpec model.HasSLSASpec) int
+		HasSourceAt               func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec) int
+		HasSourceAtList           func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) int
+		HashEqual                 func(childComplexity int, hashEqualSpec model.HashEqualSpec) int
+		HashEqualList             func(childComplexity int, hashEqualSpec model.HashEqualSpec, after *string, first *int) int
+		IsDependency              func(childComplexity int, isDependencySpec model.IsDependencySpec) int
+		IsDependencyList          func(childComplexity int, isDependencySpec model.IsDependencySpec, after *string, first *int) int
+		IsOccurrence              func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec) int
+		IsOccurrenceList          func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) int
+		Licenses                  func(childComplexity int, licenseSpec model.LicenseSpec) int
+		LicensesList              func(childComplexity int, licenseSpec model.LicenseSpec, after *string, first *int) int
+		Neighbors                 func(childComplexity int, node string, usingOnly []model.Edge) int
+		Node                      func(childComplexity int, node string) int
+		Nodes                     func(childComplexity int, nodes []string) int
+		Packages                  func(childComplexity int, pkgSpec model.PkgSpec) int
+		PackagesList              func(childComplexity int, pkgSpec model.PkgSpec, after *string, first *int) int
+		Path                      func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
+		PkgEqual                  func(childComplexity int, pkgEqualSpec model.PkgEqualSpec) int
+		PkgEqualList              func(childComplexity int, pkgEqualSpec model.PkgEqualSpec, after *string, first *int) int
+		PointOfContact            func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
+		PointOfContactList        func(childComplexity int, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) int
+		Scorecards                func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
+		ScorecardsList            func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) int
+		Sources                   func(childComplexity int, sourceSpec model.SourceSpec) int
+		SourcesList               func(childComplexity int, sourceSpec model.SourceSpec, after *string, first *int) int
+		VulnEqual                 func(childComplexity int, vulnEqualSpec model.VulnEqualSpec) int
+		VulnEqualList             func(childComplexity int, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) int
+		Vulnerabilities           func(childComplexity int, vulnSpec model.VulnerabilitySpec) int
+		VulnerabilitiesList       func(childComplexity int, vulnSpec model.VulnerabilitySpec, after *string, first *int) int
+		VulnerabilityMetadata     func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) int
+		VulnerabilityMetadataList func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) int
 	}
 
 	SLSA struct {
@@ -330,9 +613,11 @@ type ComplexityRoot struct {
 		Collector     func(childComplexity int) int
 		FinishedOn    func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		SlsaPredicate func(childComplexity int) int
 		SlsaVersion   func(childComplexity int) int
 		StartedOn     func(childComplexity int) int
+		Verified      func(childComplexity int) int
 	}
 
 	SLSAPredicate struct {
@@ -347,7 +632,9 @@ type ComplexityRoot struct {
 		Origin         func(childComplexity int) int
 		ScannerURI     func(childComplexity int) int
 		ScannerVersion func(childComplexity int) int
+		Signer         func(childComplexity int) int
 		TimeScanned    func(childComplexity int) int
+		Verified       func(childComplexity int) int
 	}
 
 	Scorecard struct {
@@ -357,7 +644,9 @@ type ComplexityRoot struct {
 		Origin           func(childComplexity int) int
 		ScorecardCommit  func(childComplexity int) int
 		ScorecardVersion func(childComplexity int) int
+		Signer           func(childComplexity int) int
 		TimeScanned      func(childComplexity int) int
+		Verified         func(childComplexity int) int
 	}
 
 	ScorecardCheck struct {
@@ -371,6 +660,17 @@ type ComplexityRoot struct {
 		Type       func(childComplexity int) int
 	}
 
+	SourceConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	SourceEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	SourceIDs struct {
 		SourceNameID      func(childComplexity int) int
 		SourceNamespaceID func(childComplexity int) int
@@ -390,20 +690,50 @@ type ComplexityRoot struct {
 		Namespace func(childComplexity int) int
 	}
 
+	Subscription struct {
+		CertifyBadAdded          func(childComplexity int, filter *model.CertifyBadSpec) int
+		CertifyVEXStatementAdded func(childComplexity int, filter *model.CertifyVEXStatementSpec) int
+		CertifyVulnAdded         func(childComplexity int, filter *model.CertifyVulnSpec) int
+	}
+
 	VulnEqual struct {
 		Collector       func(childComplexity int) int
 		ID              func(childComplexity int) int
 		Justification   func(childComplexity int) int
 		Origin          func(childComplexity int) int
+		Signer          func(childComplexity int) int
+		Verified        func(childComplexity int) int
 		Vulnerabilities func(childComplexity int) int
 	}
 
+	VulnEqualConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnEqualEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Vulnerability struct {
 		ID               func(childComplexity int) int
 		Type             func(childComplexity int) int
 		VulnerabilityIDs func(childComplexity int) int
 	}
 
+	VulnerabilityConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnerabilityEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	VulnerabilityID struct {
 		ID              func(childComplexity int) int
 		VulnerabilityID func(childComplexity int) int
@@ -420,9 +750,22 @@ type ComplexityRoot struct {
 		Origin        func(childComplexity int) int
 		ScoreType     func(childComplexity int) int
 		ScoreValue    func(childComplexity int) int
+		Signer        func(childComplexity int) int
 		Timestamp     func(childComplexity int) int
+		Verified      func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
+
+	VulnerabilityMetadataConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnerabilityMetadataEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -465,6 +808,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Artifact.ID(childComplexity), true
 
+	case "ArtifactConnection.edges":
+		if e.complexity.ArtifactConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ArtifactConnection.Edges(childComplexity), true
+
+	case "ArtifactConnection.pageInfo":
+		if e.complexity.ArtifactConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ArtifactConnection.PageInfo(childComplexity), true
+
+	case "ArtifactConnection.totalCount":
+		if e.complexity.ArtifactConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.ArtifactConnection.TotalCount(childComplexity), true
+
+	case "ArtifactEdge.cursor":
+		if e.complexity.ArtifactEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ArtifactEdge.Cursor(childComplexity), true
+
+	case "ArtifactEdge.node":
+		if e.complexity.ArtifactEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ArtifactEdge.Node(childComplexity), true
+
 	case "Builder.id":
 		if e.complexity.Builder.ID == nil {
 			break
@@ -479,6 +857,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Builder.URI(childComplexity), true
 
+	case "BuilderConnection.edges":
+		if e.complexity.BuilderConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.BuilderConnection.Edges(childComplexity), true
+
+	case "BuilderConnection.pageInfo":
+		if e.complexity.BuilderConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.BuilderConnection.PageInfo(childComplexity), true
+
+	case "BuilderConnection.totalCount":
+		if e.complexity.BuilderConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.BuilderConnection.TotalCount(childComplexity), true
+
+	case "BuilderEdge.cursor":
+		if e.complexity.BuilderEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.BuilderEdge.Cursor(childComplexity), true
+
+	case "BuilderEdge.node":
+		if e.complexity.BuilderEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.BuilderEdge.Node(childComplexity), true
+
 	case "CertifyBad.collector":
 		if e.complexity.CertifyBad.Collector == nil {
 			break
@@ -514,6 +927,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyBad.Origin(childComplexity), true
 
+	case "CertifyBad.signer":
+		if e.complexity.CertifyBad.Signer == nil {
+			break
+		}
+
+		return e.complexity.CertifyBad.Signer(childComplexity), true
+
 	case "CertifyBad.subject":
 		if e.complexity.CertifyBad.Subject == nil {
 			break
@@ -521,6 +941,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyBad.Subject(childComplexity), true
 
+	case "CertifyBad.verified":
+		if e.complexity.CertifyBad.Verified == nil {
+			break
+		}
+
+		return e.complexity.CertifyBad.Verified(childComplexity), true
+
+	case "CertifyBadConnection.edges":
+		if e.complexity.CertifyBadConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.Edges(childComplexity), true
+
+	case "CertifyBadConnection.pageInfo":
+		if e.complexity.CertifyBadConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.PageInfo(childComplexity), true
+
+	case "CertifyBadConnection.totalCount":
+		if e.complexity.CertifyBadConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.TotalCount(childComplexity), true
+
+	case "CertifyBadEdge.cursor":
+		if e.complexity.CertifyBadEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadEdge.Cursor(childComplexity), true
+
+	case "CertifyBadEdge.node":
+		if e.complexity.CertifyBadEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadEdge.Node(childComplexity), true
+
 	case "CertifyGood.collector":
 		if e.complexity.CertifyGood.Collector == nil {
 			break
@@ -556,6 +1018,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyGood.Origin(childComplexity), true
 
+	case "CertifyGood.signer":
+		if e.complexity.CertifyGood.Signer == nil {
+			break
+		}
+
+		return e.complexity.CertifyGood.Signer(childComplexity), true
+
 	case "CertifyGood.subject":
 		if e.complexity.CertifyGood.Subject == nil {
 			break
@@ -563,6 +1032,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyGood.Subject(childComplexity), true
 
+	case "CertifyGood.verified":
+		if e.complexity.CertifyGood.Verified == nil {
+			break
+		}
+
+		return e.complexity.CertifyGood.Verified(childComplexity), true
+
+	case "CertifyGoodConnection.edges":
+		if e.complexity.CertifyGoodConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodConnection.Edges(childComplexity), true
+
+	case "CertifyGoodConnection.pageInfo":
+		if e.complexity.CertifyGoodConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodConnection.PageInfo(childComplexity), true
+
+	case "CertifyGoodConnection.totalCount":
+		if e.complexity.CertifyGoodConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodConnection.TotalCount(childComplexity), true
+
+	case "CertifyGoodEdge.cursor":
+		if e.complexity.CertifyGoodEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodEdge.Cursor(childComplexity), true
+
+	case "CertifyGoodEdge.node":
+		if e.complexity.CertifyGoodEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodEdge.Node(childComplexity), true
+
 	case "CertifyLegal.attribution":
 		if e.complexity.CertifyLegal.Attribution == nil {
 			break
@@ -626,6 +1137,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyLegal.Origin(childComplexity), true
 
+	case "CertifyLegal.signer":
+		if e.complexity.CertifyLegal.Signer == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegal.Signer(childComplexity), true
+
 	case "CertifyLegal.subject":
 		if e.complexity.CertifyLegal.Subject == nil {
 			break
@@ -640,6 +1158,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyLegal.TimeScanned(childComplexity), true
 
+	case "CertifyLegal.verified":
+		if e.complexity.CertifyLegal.Verified == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegal.Verified(childComplexity), true
+
+	case "CertifyLegalConnection.edges":
+		if e.complexity.CertifyLegalConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalConnection.Edges(childComplexity), true
+
+	case "CertifyLegalConnection.pageInfo":
+		if e.complexity.CertifyLegalConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalConnection.PageInfo(childComplexity), true
+
+	case "CertifyLegalConnection.totalCount":
+		if e.complexity.CertifyLegalConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalConnection.TotalCount(childComplexity), true
+
+	case "CertifyLegalEdge.cursor":
+		if e.complexity.CertifyLegalEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalEdge.Cursor(childComplexity), true
+
+	case "CertifyLegalEdge.node":
+		if e.complexity.CertifyLegalEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalEdge.Node(childComplexity), true
+
 	case "CertifyScorecard.id":
 		if e.complexity.CertifyScorecard.ID == nil {
 			break
@@ -661,6 +1221,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyScorecard.Source(childComplexity), true
 
+	case "CertifyScorecardConnection.edges":
+		if e.complexity.CertifyScorecardConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.Edges(childComplexity), true
+
+	case "CertifyScorecardConnection.pageInfo":
+		if e.complexity.CertifyScorecardConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.PageInfo(childComplexity), true
+
+	case "CertifyScorecardConnection.totalCount":
+		if e.complexity.CertifyScorecardConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.TotalCount(childComplexity), true
+
+	case "CertifyScorecardEdge.cursor":
+		if e.complexity.CertifyScorecardEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardEdge.Cursor(childComplexity), true
+
+	case "CertifyScorecardEdge.node":
+		if e.complexity.CertifyScorecardEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardEdge.Node(childComplexity), true
+
 	case "CertifyVEXStatement.collector":
 		if e.complexity.CertifyVEXStatement.Collector == nil {
 			break
@@ -689,6 +1284,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVEXStatement.Origin(childComplexity), true
 
+	case "CertifyVEXStatement.signer":
+		if e.complexity.CertifyVEXStatement.Signer == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Signer(childComplexity), true
+
 	case "CertifyVEXStatement.statement":
 		if e.complexity.CertifyVEXStatement.Statement == nil {
 			break
@@ -717,6 +1319,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVEXStatement.Subject(childComplexity), true
 
+	case "CertifyVEXStatement.verified":
+		if e.complexity.CertifyVEXStatement.Verified == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Verified(childComplexity), true
+
 	case "CertifyVEXStatement.vexJustification":
 		if e.complexity.CertifyVEXStatement.VexJustification == nil {
 			break
@@ -731,22 +1340,57 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVEXStatement.Vulnerability(childComplexity), true
 
-	case "CertifyVuln.id":
-		if e.complexity.CertifyVuln.ID == nil {
+	case "CertifyVEXStatementConnection.edges":
+		if e.complexity.CertifyVEXStatementConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.CertifyVuln.ID(childComplexity), true
+		return e.complexity.CertifyVEXStatementConnection.Edges(childComplexity), true
 
-	case "CertifyVuln.metadata":
-		if e.complexity.CertifyVuln.Metadata == nil {
+	case "CertifyVEXStatementConnection.pageInfo":
+		if e.complexity.CertifyVEXStatementConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.CertifyVuln.Metadata(childComplexity), true
+		return e.complexity.CertifyVEXStatementConnection.PageInfo(childComplexity), true
 
-	case "CertifyVuln.package":
-		if e.complexity.CertifyVuln.Package == nil {
+	case "CertifyVEXStatementConnection.totalCount":
+		if e.complexity.CertifyVEXStatementConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementConnection.TotalCount(childComplexity), true
+
+	case "CertifyVEXStatementEdge.cursor":
+		if e.complexity.CertifyVEXStatementEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementEdge.Cursor(childComplexity), true
+
+	case "CertifyVEXStatementEdge.node":
+		if e.complexity.CertifyVEXStatementEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementEdge.Node(childComplexity), true
+
+	case "CertifyVuln.id":
+		if e.complexity.CertifyVuln.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.ID(childComplexity), true
+
+	case "CertifyVuln.metadata":
+		if e.complexity.CertifyVuln.Metadata == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.Metadata(childComplexity), true
+
+	case "CertifyVuln.package":
+		if e.complexity.CertifyVuln.Package == nil {
 			break
 		}
 
@@ -759,6 +1403,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVuln.Vulnerability(childComplexity), true
 
+	case "CertifyVulnConnection.edges":
+		if e.complexity.CertifyVulnConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.Edges(childComplexity), true
+
+	case "CertifyVulnConnection.pageInfo":
+		if e.complexity.CertifyVulnConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.PageInfo(childComplexity), true
+
+	case "CertifyVulnConnection.totalCount":
+		if e.complexity.CertifyVulnConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.TotalCount(childComplexity), true
+
+	case "CertifyVulnEdge.cursor":
+		if e.complexity.CertifyVulnEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnEdge.Cursor(childComplexity), true
+
+	case "CertifyVulnEdge.node":
+		if e.complexity.CertifyVulnEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnEdge.Node(childComplexity), true
+
 	case "HasMetadata.collector":
 		if e.complexity.HasMetadata.Collector == nil {
 			break
@@ -794,6 +1473,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasMetadata.Origin(childComplexity), true
 
+	case "HasMetadata.signer":
+		if e.complexity.HasMetadata.Signer == nil {
+			break
+		}
+
+		return e.complexity.HasMetadata.Signer(childComplexity), true
+
 	case "HasMetadata.subject":
 		if e.complexity.HasMetadata.Subject == nil {
 			break
@@ -815,6 +1501,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasMetadata.Value(childComplexity), true
 
+	case "HasMetadata.verified":
+		if e.complexity.HasMetadata.Verified == nil {
+			break
+		}
+
+		return e.complexity.HasMetadata.Verified(childComplexity), true
+
+	case "HasMetadataConnection.edges":
+		if e.complexity.HasMetadataConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataConnection.Edges(childComplexity), true
+
+	case "HasMetadataConnection.pageInfo":
+		if e.complexity.HasMetadataConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataConnection.PageInfo(childComplexity), true
+
+	case "HasMetadataConnection.totalCount":
+		if e.complexity.HasMetadataConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataConnection.TotalCount(childComplexity), true
+
+	case "HasMetadataEdge.cursor":
+		if e.complexity.HasMetadataEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataEdge.Cursor(childComplexity), true
+
+	case "HasMetadataEdge.node":
+		if e.complexity.HasMetadataEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataEdge.Node(childComplexity), true
+
 	case "HasSBOM.algorithm":
 		if e.complexity.HasSBOM.Algorithm == nil {
 			break
@@ -885,6 +1613,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSBOM.Origin(childComplexity), true
 
+	case "HasSBOM.signer":
+		if e.complexity.HasSBOM.Signer == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Signer(childComplexity), true
+
 	case "HasSBOM.subject":
 		if e.complexity.HasSBOM.Subject == nil {
 			break
@@ -899,6 +1634,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSBOM.URI(childComplexity), true
 
+	case "HasSBOM.verified":
+		if e.complexity.HasSBOM.Verified == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Verified(childComplexity), true
+
+	case "HasSBOMConnection.edges":
+		if e.complexity.HasSBOMConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.Edges(childComplexity), true
+
+	case "HasSBOMConnection.pageInfo":
+		if e.complexity.HasSBOMConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.PageInfo(childComplexity), true
+
+	case "HasSBOMConnection.totalCount":
+		if e.complexity.HasSBOMConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.TotalCount(childComplexity), true
+
+	case "HasSBOMEdge.cursor":
+		if e.complexity.HasSBOMEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMEdge.Cursor(childComplexity), true
+
+	case "HasSBOMEdge.node":
+		if e.complexity.HasSBOMEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMEdge.Node(childComplexity), true
+
 	case "HasSLSA.id":
 		if e.complexity.HasSLSA.ID == nil {
 			break
@@ -920,6 +1697,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSLSA.Subject(childComplexity), true
 
+	case "HasSLSAConnection.edges":
+		if e.complexity.HasSLSAConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.Edges(childComplexity), true
+
+	case "HasSLSAConnection.pageInfo":
+		if e.complexity.HasSLSAConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.PageInfo(childComplexity), true
+
+	case "HasSLSAConnection.totalCount":
+		if e.complexity.HasSLSAConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.TotalCount(childComplexity), true
+
+	case "HasSLSAEdge.cursor":
+		if e.complexity.HasSLSAEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAEdge.Cursor(childComplexity), true
+
+	case "HasSLSAEdge.node":
+		if e.complexity.HasSLSAEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAEdge.Node(childComplexity), true
+
 	case "HasSourceAt.collector":
 		if e.complexity.HasSourceAt.Collector == nil {
 			break
@@ -962,6 +1774,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSourceAt.Package(childComplexity), true
 
+	case "HasSourceAt.signer":
+		if e.complexity.HasSourceAt.Signer == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Signer(childComplexity), true
+
 	case "HasSourceAt.source":
 		if e.complexity.HasSourceAt.Source == nil {
 			break
@@ -969,6 +1788,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSourceAt.Source(childComplexity), true
 
+	case "HasSourceAt.verified":
+		if e.complexity.HasSourceAt.Verified == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Verified(childComplexity), true
+
+	case "HasSourceAtConnection.edges":
+		if e.complexity.HasSourceAtConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.Edges(childComplexity), true
+
+	case "HasSourceAtConnection.pageInfo":
+		if e.complexity.HasSourceAtConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.PageInfo(childComplexity), true
+
+	case "HasSourceAtConnection.totalCount":
+		if e.complexity.HasSourceAtConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.TotalCount(childComplexity), true
+
+	case "HasSourceAtEdge.cursor":
+		if e.complexity.HasSourceAtEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtEdge.Cursor(childComplexity), true
+
+	case "HasSourceAtEdge.node":
+		if e.complexity.HasSourceAtEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtEdge.Node(childComplexity), true
+
 	case "HashEqual.artifacts":
 		if e.complexity.HashEqual.Artifacts == nil {
 			break
@@ -1004,6 +1865,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HashEqual.Origin(childComplexity), true
 
+	case "HashEqual.signer":
+		if e.complexity.HashEqual.Signer == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Signer(childComplexity), true
+
+	case "HashEqual.verified":
+		if e.complexity.HashEqual.Verified == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Verified(childComplexity), true
+
+	case "HashEqualConnection.edges":
+		if e.complexity.HashEqualConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.Edges(childComplexity), true
+
+	case "HashEqualConnection.pageInfo":
+		if e.complexity.HashEqualConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.PageInfo(childComplexity), true
+
+	case "HashEqualConnection.totalCount":
+		if e.complexity.HashEqualConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.TotalCount(childComplexity), true
+
+	case "HashEqualEdge.cursor":
+		if e.complexity.HashEqualEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HashEqualEdge.Cursor(childComplexity), true
+
+	case "HashEqualEdge.node":
+		if e.complexity.HashEqualEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HashEqualEdge.Node(childComplexity), true
+
 	case "IsDependency.collector":
 		if e.complexity.IsDependency.Collector == nil {
 			break
@@ -1018,6 +1928,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsDependency.DependencyPackage(childComplexity), true
 
+	case "IsDependency.dependencyScope":
+		if e.complexity.IsDependency.DependencyScope == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.DependencyScope(childComplexity), true
+
 	case "IsDependency.dependencyType":
 		if e.complexity.IsDependency.DependencyType == nil {
 			break
@@ -1053,6 +1970,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsDependency.Package(childComplexity), true
 
+	case "IsDependency.signer":
+		if e.complexity.IsDependency.Signer == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Signer(childComplexity), true
+
+	case "IsDependency.verified":
+		if e.complexity.IsDependency.Verified == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Verified(childComplexity), true
+
 	case "IsDependency.versionRange":
 		if e.complexity.IsDependency.VersionRange == nil {
 			break
@@ -1060,6 +1991,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsDependency.VersionRange(childComplexity), true
 
+	case "IsDependencyConnection.edges":
+		if e.complexity.IsDependencyConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.Edges(childComplexity), true
+
+	case "IsDependencyConnection.pageInfo":
+		if e.complexity.IsDependencyConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.PageInfo(childComplexity), true
+
+	case "IsDependencyConnection.totalCount":
+		if e.complexity.IsDependencyConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.TotalCount(childComplexity), true
+
+	case "IsDependencyEdge.cursor":
+		if e.complexity.IsDependencyEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyEdge.Cursor(childComplexity), true
+
+	case "IsDependencyEdge.node":
+		if e.complexity.IsDependencyEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyEdge.Node(childComplexity), true
+
 	case "IsOccurrence.artifact":
 		if e.complexity.IsOccurrence.Artifact == nil {
 			break
@@ -1095,6 +2061,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsOccurrence.Origin(childComplexity), true
 
+	case "IsOccurrence.signer":
+		if e.complexity.IsOccurrence.Signer == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Signer(childComplexity), true
+
 	case "IsOccurrence.subject":
 		if e.complexity.IsOccurrence.Subject == nil {
 			break
@@ -1102,6 +2075,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsOccurrence.Subject(childComplexity), true
 
+	case "IsOccurrence.verified":
+		if e.complexity.IsOccurrence.Verified == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Verified(childComplexity), true
+
+	case "IsOccurrenceConnection.edges":
+		if e.complexity.IsOccurrenceConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.Edges(childComplexity), true
+
+	case "IsOccurrenceConnection.pageInfo":
+		if e.complexity.IsOccurrenceConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.PageInfo(childComplexity), true
+
+	case "IsOccurrenceConnection.totalCount":
+		if e.complexity.IsOccurrenceConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.TotalCount(childComplexity), true
+
+	case "IsOccurrenceEdge.cursor":
+		if e.complexity.IsOccurrenceEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceEdge.Cursor(childComplexity), true
+
+	case "IsOccurrenceEdge.node":
+		if e.complexity.IsOccurrenceEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceEdge.Node(childComplexity), true
+
 	case "License.id":
 		if e.complexity.License.ID == nil {
 			break
@@ -1130,6 +2145,245 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.License.Name(childComplexity), true
 
+	case "LicenseConnection.edges":
+		if e.complexity.LicenseConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.LicenseConnection.Edges(childComplexity), true
+
+	case "LicenseConnection.pageInfo":
+		if e.complexity.LicenseConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.LicenseConnection.PageInfo(childComplexity), true
+
+	case "LicenseConnection.totalCount":
+		if e.complexity.LicenseConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.LicenseConnection.TotalCount(childComplexity), true
+
+	case "LicenseEdge.cursor":
+		if e.complexity.LicenseEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.LicenseEdge.Cursor(childComplexity), true
+
+	case "LicenseEdge.node":
+		if e.complexity.LicenseEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.LicenseEdge.Node(childComplexity), true
+
+	case "Mutation.deleteCertifyBad":
+		if e.complexity.Mutation.DeleteCertifyBad == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCertifyBad_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCertifyBad(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteCertifyGood":
+		if e.complexity.Mutation.DeleteCertifyGood == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCertifyGood_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCertifyGood(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteCertifyLegal":
+		if e.complexity.Mutation.DeleteCertifyLegal == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCertifyLegal_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCertifyLegal(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteCertifyScorecard":
+		if e.complexity.Mutation.DeleteCertifyScorecard == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCertifyScorecard_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCertifyScorecard(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteCertifyVEXStatement":
+		if e.complexity.Mutation.DeleteCertifyVEXStatement == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCertifyVEXStatement_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCertifyVEXStatement(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteCertifyVuln":
+		if e.complexity.Mutation.DeleteCertifyVuln == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCertifyVuln_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCertifyVuln(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteHasMetadata":
+		if e.complexity.Mutation.DeleteHasMetadata == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteHasMetadata_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteHasMetadata(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteHasSBOM":
+		if e.complexity.Mutation.DeleteHasSbom == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteHasSBOM_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteHasSbom(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteHasSLSA":
+		if e.complexity.Mutation.DeleteHasSlsa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteHasSLSA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteHasSlsa(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteHasSourceAt":
+		if e.complexity.Mutation.DeleteHasSourceAt == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteHasSourceAt_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteHasSourceAt(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteHashEqual":
+		if e.complexity.Mutation.DeleteHashEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteHashEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteHashEqual(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteIsDependency":
+		if e.complexity.Mutation.DeleteIsDependency == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteIsDependency_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteIsDependency(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteIsOccurrence":
+		if e.complexity.Mutation.DeleteIsOccurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteIsOccurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteIsOccurrence(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deletePkgEqual":
+		if e.complexity.Mutation.DeletePkgEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deletePkgEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeletePkgEqual(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deletePointOfContact":
+		if e.complexity.Mutation.DeletePointOfContact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deletePointOfContact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeletePointOfContact(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteVulnEqual":
+		if e.complexity.Mutation.DeleteVulnEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteVulnEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteVulnEqual(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteVulnerabilityMetadata":
+		if e.complexity.Mutation.DeleteVulnerabilityMetadata == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteVulnerabilityMetadata_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteVulnerabilityMetadata(childComplexity, args["id"].(string)), true
+
 	case "Mutation.ingestArtifact":
 		if e.complexity.Mutation.IngestArtifact == nil {
 			break
@@ -1680,28 +2934,75 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestVulnerabilityMetadata(childComplexity, args["vulnerability"].(model.VulnerabilityInputSpec), args["vulnerabilityMetadata"].(model.VulnerabilityMetadataInputSpec)), true
+		return e.complexity.Mutation.IngestVulnerabilityMetadata(childComplexity, args["vulnerability"].(model.VulnerabilityInputSpec), args["vulnerabilityMetadata"].(model.VulnerabilityMetadataInputSpec)), true
+
+	case "Mutation.retractEvidence":
+		if e.complexity.Mutation.RetractEvidence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_retractEvidence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RetractEvidence(childComplexity, args["origin"].(string)), true
+
+	case "Package.id":
+		if e.complexity.Package.ID == nil {
+			break
+		}
+
+		return e.complexity.Package.ID(childComplexity), true
+
+	case "Package.namespaces":
+		if e.complexity.Package.Namespaces == nil {
+			break
+		}
+
+		return e.complexity.Package.Namespaces(childComplexity), true
+
+	case "Package.type":
+		if e.complexity.Package.Type == nil {
+			break
+		}
+
+		return e.complexity.Package.Type(childComplexity), true
+
+	case "PackageConnection.edges":
+		if e.complexity.PackageConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.Edges(childComplexity), true
+
+	case "PackageConnection.pageInfo":
+		if e.complexity.PackageConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.PageInfo(childComplexity), true
 
-	case "Package.id":
-		if e.complexity.Package.ID == nil {
+	case "PackageConnection.totalCount":
+		if e.complexity.PackageConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.Package.ID(childComplexity), true
+		return e.complexity.PackageConnection.TotalCount(childComplexity), true
 
-	case "Package.namespaces":
-		if e.complexity.Package.Namespaces == nil {
+	case "PackageEdge.cursor":
+		if e.complexity.PackageEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.Package.Namespaces(childComplexity), true
+		return e.complexity.PackageEdge.Cursor(childComplexity), true
 
-	case "Package.type":
-		if e.complexity.Package.Type == nil {
+	case "PackageEdge.node":
+		if e.complexity.PackageEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.Package.Type(childComplexity), true
+		return e.complexity.PackageEdge.Node(childComplexity), true
 
 	case "PackageIDs.packageNameID":
 		if e.complexity.PackageIDs.PackageNameID == nil {
@@ -1815,6 +3116,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PackageVersion.Version(childComplexity), true
 
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
 	case "PkgEqual.collector":
 		if e.complexity.PkgEqual.Collector == nil {
 			break
@@ -1850,6 +3172,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PkgEqual.Packages(childComplexity), true
 
+	case "PkgEqual.signer":
+		if e.complexity.PkgEqual.Signer == nil {
+			break
+		}
+
+		return e.complexity.PkgEqual.Signer(childComplexity), true
+
+	case "PkgEqual.verified":
+		if e.complexity.PkgEqual.Verified == nil {
+			break
+		}
+
+		return e.complexity.PkgEqual.Verified(childComplexity), true
+
+	case "PkgEqualConnection.edges":
+		if e.complexity.PkgEqualConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualConnection.Edges(childComplexity), true
+
+	case "PkgEqualConnection.pageInfo":
+		if e.complexity.PkgEqualConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualConnection.PageInfo(childComplexity), true
+
+	case "PkgEqualConnection.totalCount":
+		if e.complexity.PkgEqualConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualConnection.TotalCount(childComplexity), true
+
+	case "PkgEqualEdge.cursor":
+		if e.complexity.PkgEqualEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualEdge.Cursor(childComplexity), true
+
+	case "PkgEqualEdge.node":
+		if e.complexity.PkgEqualEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualEdge.Node(childComplexity), true
+
 	case "PointOfContact.collector":
 		if e.complexity.PointOfContact.Collector == nil {
 			break
@@ -1892,6 +3263,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PointOfContact.Origin(childComplexity), true
 
+	case "PointOfContact.signer":
+		if e.complexity.PointOfContact.Signer == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Signer(childComplexity), true
+
 	case "PointOfContact.since":
 		if e.complexity.PointOfContact.Since == nil {
 			break
@@ -1906,6 +3284,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PointOfContact.Subject(childComplexity), true
 
+	case "PointOfContact.verified":
+		if e.complexity.PointOfContact.Verified == nil {
+			break
+		}
+
+		return e.complexity.PointOfContact.Verified(childComplexity), true
+
+	case "PointOfContactConnection.edges":
+		if e.complexity.PointOfContactConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PointOfContactConnection.Edges(childComplexity), true
+
+	case "PointOfContactConnection.pageInfo":
+		if e.complexity.PointOfContactConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PointOfContactConnection.PageInfo(childComplexity), true
+
+	case "PointOfContactConnection.totalCount":
+		if e.complexity.PointOfContactConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PointOfContactConnection.TotalCount(childComplexity), true
+
+	case "PointOfContactEdge.cursor":
+		if e.complexity.PointOfContactEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PointOfContactEdge.Cursor(childComplexity), true
+
+	case "PointOfContactEdge.node":
+		if e.complexity.PointOfContactEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PointOfContactEdge.Node(childComplexity), true
+
 	case "Query.artifacts":
 		if e.complexity.Query.Artifacts == nil {
 			break
@@ -1918,6 +3338,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Artifacts(childComplexity, args["artifactSpec"].(model.ArtifactSpec)), true
 
+	case "Query.artifactsList":
+		if e.complexity.Query.ArtifactsList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_artifactsList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ArtifactsList(childComplexity, args["artifactSpec"].(model.ArtifactSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.builders":
 		if e.complexity.Query.Builders == nil {
 			break
@@ -1930,6 +3362,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Builders(childComplexity, args["builderSpec"].(model.BuilderSpec)), true
 
+	case "Query.buildersList":
+		if e.complexity.Query.BuildersList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_buildersList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BuildersList(childComplexity, args["builderSpec"].(model.BuilderSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.CertifyBad":
 		if e.complexity.Query.CertifyBad == nil {
 			break
@@ -1942,6 +3386,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyBad(childComplexity, args["certifyBadSpec"].(model.CertifyBadSpec)), true
 
+	case "Query.CertifyBadList":
+		if e.complexity.Query.CertifyBadList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyBadList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyBadList(childComplexity, args["certifyBadSpec"].(model.CertifyBadSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.CertifyGood":
 		if e.complexity.Query.CertifyGood == nil {
 			break
@@ -1954,6 +3410,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyGood(childComplexity, args["certifyGoodSpec"].(model.CertifyGoodSpec)), true
 
+	case "Query.CertifyGoodList":
+		if e.complexity.Query.CertifyGoodList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyGoodList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyGoodList(childComplexity, args["certifyGoodSpec"].(model.CertifyGoodSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.CertifyLegal":
 		if e.complexity.Query.CertifyLegal == nil {
 			break
@@ -1966,6 +3434,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyLegal(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec)), true
 
+	case "Query.CertifyLegalList":
+		if e.complexity.Query.CertifyLegalList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyLegalList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyLegalList(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.CertifyVEXStatement":
 		if e.complexity.Query.CertifyVEXStatement == nil {
 			break
@@ -1978,6 +3458,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyVEXStatement(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec)), true
 
+	case "Query.CertifyVEXStatementList":
+		if e.complexity.Query.CertifyVEXStatementList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVEXStatementList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVEXStatementList(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.CertifyVuln":
 		if e.complexity.Query.CertifyVuln == nil {
 			break
@@ -1990,6 +3482,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec)), true
 
+	case "Query.CertifyVulnList":
+		if e.complexity.Query.CertifyVulnList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVulnList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVulnList(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.findSoftware":
 		if e.complexity.Query.FindSoftware == nil {
 			break
@@ -2014,6 +3518,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.HasMetadata(childComplexity, args["hasMetadataSpec"].(model.HasMetadataSpec)), true
 
+	case "Query.HasMetadataList":
+		if e.complexity.Query.HasMetadataList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasMetadataList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasMetadataList(childComplexity, args["hasMetadataSpec"].(model.HasMetadataSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.HasSBOMList":
+		if e.complexity.Query.HasSBOMList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSBOMList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSBOMList(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.HasSLSAList":
+		if e.complexity.Query.HasSLSAList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSLSAList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSLSAList(childComplexity, args["hasSLSASpec"].(model.HasSLSASpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.HasSBOM":
 		if e.complexity.Query.HasSbom == nil {
 			break
@@ -2050,6 +3590,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.HasSourceAt(childComplexity, args["hasSourceAtSpec"].(model.HasSourceAtSpec)), true
 
+	case "Query.HasSourceAtList":
+		if e.complexity.Query.HasSourceAtList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSourceAtList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSourceAtList(childComplexity, args["hasSourceAtSpec"].(model.HasSourceAtSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.HashEqual":
 		if e.complexity.Query.HashEqual == nil {
 			break
@@ -2062,6 +3614,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.HashEqual(childComplexity, args["hashEqualSpec"].(model.HashEqualSpec)), true
 
+	case "Query.HashEqualList":
+		if e.complexity.Query.HashEqualList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HashEqualList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HashEqualList(childComplexity, args["hashEqualSpec"].(model.HashEqualSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.IsDependency":
 		if e.complexity.Query.IsDependency == nil {
 			break
@@ -2074,6 +3638,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.IsDependency(childComplexity, args["isDependencySpec"].(model.IsDependencySpec)), true
 
+	case "Query.IsDependencyList":
+		if e.complexity.Query.IsDependencyList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsDependencyList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsDependencyList(childComplexity, args["isDependencySpec"].(model.IsDependencySpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.IsOccurrence":
 		if e.complexity.Query.IsOccurrence == nil {
 			break
@@ -2086,6 +3662,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.IsOccurrence(childComplexity, args["isOccurrenceSpec"].(model.IsOccurrenceSpec)), true
 
+	case "Query.IsOccurrenceList":
+		if e.complexity.Query.IsOccurrenceList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsOccurrenceList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsOccurrenceList(childComplexity, args["isOccurrenceSpec"].(model.IsOccurrenceSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.licenses":
 		if e.complexity.Query.Licenses == nil {
 			break
@@ -2098,6 +3686,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Licenses(childComplexity, args["licenseSpec"].(model.LicenseSpec)), true
 
+	case "Query.licensesList":
+		if e.complexity.Query.LicensesList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_licensesList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LicensesList(childComplexity, args["licenseSpec"].(model.LicenseSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.neighbors":
 		if e.complexity.Query.Neighbors == nil {
 			break
@@ -2146,6 +3746,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(model.PkgSpec)), true
 
+	case "Query.packagesList":
+		if e.complexity.Query.PackagesList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packagesList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PackagesList(childComplexity, args["pkgSpec"].(model.PkgSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.path":
 		if e.complexity.Query.Path == nil {
 			break
@@ -2170,6 +3782,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.PkgEqual(childComplexity, args["pkgEqualSpec"].(model.PkgEqualSpec)), true
 
+	case "Query.PkgEqualList":
+		if e.complexity.Query.PkgEqualList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PkgEqualList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PkgEqualList(childComplexity, args["pkgEqualSpec"].(model.PkgEqualSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.PointOfContact":
 		if e.complexity.Query.PointOfContact == nil {
 			break
@@ -2182,6 +3806,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.PointOfContact(childComplexity, args["pointOfContactSpec"].(model.PointOfContactSpec)), true
 
+	case "Query.PointOfContactList":
+		if e.complexity.Query.PointOfContactList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PointOfContactList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PointOfContactList(childComplexity, args["pointOfContactSpec"].(model.PointOfContactSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.scorecards":
 		if e.complexity.Query.Scorecards == nil {
 			break
@@ -2194,6 +3830,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec)), true
 
+	case "Query.scorecardsList":
+		if e.complexity.Query.ScorecardsList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scorecardsList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ScorecardsList(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.sources":
 		if e.complexity.Query.Sources == nil {
 			break
@@ -2206,6 +3854,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(model.SourceSpec)), true
 
+	case "Query.sourcesList":
+		if e.complexity.Query.SourcesList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sourcesList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SourcesList(childComplexity, args["sourceSpec"].(model.SourceSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.vulnEqual":
 		if e.complexity.Query.VulnEqual == nil {
 			break
@@ -2218,6 +3878,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.VulnEqual(childComplexity, args["vulnEqualSpec"].(model.VulnEqualSpec)), true
 
+	case "Query.vulnEqualList":
+		if e.complexity.Query.VulnEqualList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnEqualList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnEqualList(childComplexity, args["vulnEqualSpec"].(model.VulnEqualSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.vulnerabilities":
 		if e.complexity.Query.Vulnerabilities == nil {
 			break
@@ -2228,7 +3900,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Vulnerabilities(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec)), true
+		return e.complexity.Query.Vulnerabilities(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec)), true
+
+	case "Query.vulnerabilitiesList":
+		if e.complexity.Query.VulnerabilitiesList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnerabilitiesList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnerabilitiesList(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec), args["after"].(*string), args["first"].(*int)), true
 
 	case "Query.vulnerabilityMetadata":
 		if e.complexity.Query.VulnerabilityMetadata == nil {
@@ -2242,6 +3926,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.VulnerabilityMetadata(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec)), true
 
+	case "Query.vulnerabilityMetadataList":
+		if e.complexity.Query.VulnerabilityMetadataList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnerabilityMetadataList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnerabilityMetadataList(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "SLSA.buildType":
 		if e.complexity.SLSA.BuildType == nil {
 			break
@@ -2284,6 +3980,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SLSA.Origin(childComplexity), true
 
+	case "SLSA.signer":
+		if e.complexity.SLSA.Signer == nil {
+			break
+		}
+
+		return e.complexity.SLSA.Signer(childComplexity), true
+
 	case "SLSA.slsaPredicate":
 		if e.complexity.SLSA.SlsaPredicate == nil {
 			break
@@ -2305,6 +4008,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SLSA.StartedOn(childComplexity), true
 
+	case "SLSA.verified":
+		if e.complexity.SLSA.Verified == nil {
+			break
+		}
+
+		return e.complexity.SLSA.Verified(childComplexity), true
+
 	case "SLSAPredicate.key":
 		if e.complexity.SLSAPredicate.Key == nil {
 			break
@@ -2361,6 +4071,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ScanMetadata.ScannerVersion(childComplexity), true
 
+	case "ScanMetadata.signer":
+		if e.complexity.ScanMetadata.Signer == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.Signer(childComplexity), true
+
 	case "ScanMetadata.timeScanned":
 		if e.complexity.ScanMetadata.TimeScanned == nil {
 			break
@@ -2368,6 +4085,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ScanMetadata.TimeScanned(childComplexity), true
 
+	case "ScanMetadata.verified":
+		if e.complexity.ScanMetadata.Verified == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.Verified(childComplexity), true
+
 	case "Scorecard.aggregateScore":
 		if e.complexity.Scorecard.AggregateScore == nil {
 			break
@@ -2410,6 +4134,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Scorecard.ScorecardVersion(childComplexity), true
 
+	case "Scorecard.signer":
+		if e.complexity.Scorecard.Signer == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.Signer(childComplexity), true
+
 	case "Scorecard.timeScanned":
 		if e.complexity.Scorecard.TimeScanned == nil {
 			break
@@ -2417,6 +4148,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Scorecard.TimeScanned(childComplexity), true
 
+	case "Scorecard.verified":
+		if e.complexity.Scorecard.Verified == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.Verified(childComplexity), true
+
 	case "ScorecardCheck.check":
 		if e.complexity.ScorecardCheck.Check == nil {
 			break
@@ -2452,6 +4190,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Source.Type(childComplexity), true
 
+	case "SourceConnection.edges":
+		if e.complexity.SourceConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.Edges(childComplexity), true
+
+	case "SourceConnection.pageInfo":
+		if e.complexity.SourceConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.PageInfo(childComplexity), true
+
+	case "SourceConnection.totalCount":
+		if e.complexity.SourceConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.TotalCount(childComplexity), true
+
+	case "SourceEdge.cursor":
+		if e.complexity.SourceEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Cursor(childComplexity), true
+
+	case "SourceEdge.node":
+		if e.complexity.SourceEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Node(childComplexity), true
+
 	case "SourceIDs.sourceNameID":
 		if e.complexity.SourceIDs.SourceNameID == nil {
 			break
@@ -2522,6 +4295,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceNamespace.Namespace(childComplexity), true
 
+	case "Subscription.certifyBadAdded":
+		if e.complexity.Subscription.CertifyBadAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_certifyBadAdded_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyBadAdded(childComplexity, args["filter"].(*model.CertifyBadSpec)), true
+
+	case "Subscription.certifyVEXStatementAdded":
+		if e.complexity.Subscription.CertifyVEXStatementAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_certifyVEXStatementAdded_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVEXStatementAdded(childComplexity, args["filter"].(*model.CertifyVEXStatementSpec)), true
+
+	case "Subscription.certifyVulnAdded":
+		if e.complexity.Subscription.CertifyVulnAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_certifyVulnAdded_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVulnAdded(childComplexity, args["filter"].(*model.CertifyVulnSpec)), true
+
 	case "VulnEqual.collector":
 		if e.complexity.VulnEqual.Collector == nil {
 			break
@@ -2550,6 +4359,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnEqual.Origin(childComplexity), true
 
+	case "VulnEqual.signer":
+		if e.complexity.VulnEqual.Signer == nil {
+			break
+		}
+
+		return e.complexity.VulnEqual.Signer(childComplexity), true
+
+	case "VulnEqual.verified":
+		if e.complexity.VulnEqual.Verified == nil {
+			break
+		}
+
+		return e.complexity.VulnEqual.Verified(childComplexity), true
+
 	case "VulnEqual.vulnerabilities":
 		if e.complexity.VulnEqual.Vulnerabilities == nil {
 			break
@@ -2557,6 +4380,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnEqual.Vulnerabilities(childComplexity), true
 
+	case "VulnEqualConnection.edges":
+		if e.complexity.VulnEqualConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualConnection.Edges(childComplexity), true
+
+	case "VulnEqualConnection.pageInfo":
+		if e.complexity.VulnEqualConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualConnection.PageInfo(childComplexity), true
+
+	case "VulnEqualConnection.totalCount":
+		if e.complexity.VulnEqualConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualConnection.TotalCount(childComplexity), true
+
+	case "VulnEqualEdge.cursor":
+		if e.complexity.VulnEqualEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualEdge.Cursor(childComplexity), true
+
+	case "VulnEqualEdge.node":
+		if e.complexity.VulnEqualEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualEdge.Node(childComplexity), true
+
 	case "Vulnerability.id":
 		if e.complexity.Vulnerability.ID == nil {
 			break
@@ -2578,6 +4436,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Vulnerability.VulnerabilityIDs(childComplexity), true
 
+	case "VulnerabilityConnection.edges":
+		if e.complexity.VulnerabilityConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityConnection.Edges(childComplexity), true
+
+	case "VulnerabilityConnection.pageInfo":
+		if e.complexity.VulnerabilityConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityConnection.PageInfo(childComplexity), true
+
+	case "VulnerabilityConnection.totalCount":
+		if e.complexity.VulnerabilityConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityConnection.TotalCount(childComplexity), true
+
+	case "VulnerabilityEdge.cursor":
+		if e.complexity.VulnerabilityEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEdge.Cursor(childComplexity), true
+
+	case "VulnerabilityEdge.node":
+		if e.complexity.VulnerabilityEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEdge.Node(childComplexity), true
+
 	case "VulnerabilityID.id":
 		if e.complexity.VulnerabilityID.ID == nil {
 			break
@@ -2641,6 +4534,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadata.ScoreValue(childComplexity), true
 
+	case "VulnerabilityMetadata.signer":
+		if e.complexity.VulnerabilityMetadata.Signer == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadata.Signer(childComplexity), true
+
 	case "VulnerabilityMetadata.timestamp":
 		if e.complexity.VulnerabilityMetadata.Timestamp == nil {
 			break
@@ -2648,6 +4548,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadata.Timestamp(childComplexity), true
 
+	case "VulnerabilityMetadata.verified":
+		if e.complexity.VulnerabilityMetadata.Verified == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadata.Verified(childComplexity), true
+
 	case "VulnerabilityMetadata.vulnerability":
 		if e.complexity.VulnerabilityMetadata.Vulnerability == nil {
 			break
@@ -2655,6 +4562,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadata.Vulnerability(childComplexity), true
 
+	case "VulnerabilityMetadataConnection.edges":
+		if e.complexity.VulnerabilityMetadataConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataConnection.Edges(childComplexity), true
+
+	case "VulnerabilityMetadataConnection.pageInfo":
+		if e.complexity.VulnerabilityMetadataConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataConnection.PageInfo(childComplexity), true
+
+	case "VulnerabilityMetadataConnection.totalCount":
+		if e.complexity.VulnerabilityMetadataConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataConnection.TotalCount(childComplexity), true
+
+	case "VulnerabilityMetadataEdge.cursor":
+		if e.complexity.VulnerabilityMetadataEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataEdge.Cursor(childComplexity), true
+
+	case "VulnerabilityMetadataEdge.node":
+		if e.complexity.VulnerabilityMetadataEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataEdge.Node(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -2771,6 +4713,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -2878,17 +4837,82 @@ input ArtifactInputSpec {
   digest: String!
 }
 
+"""
+ArtifactConnection returns the paginated results for Artifact.
+
+totalCount is the total number of Artifact nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the ArtifactEdge which contains the current cursor and the
+Artifact node itself.
+"""
+type ArtifactConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [ArtifactEdge!]!
+}
+
+"""
+ArtifactEdge contains the cursor for the resulting node and the Artifact node
+itself.
+"""
+type ArtifactEdge {
+  cursor: ID!
+  node: Artifact!
+}
+
 extend type Query {
   "Returns all artifacts matching a filter."
   artifacts(artifactSpec: ArtifactSpec!): [Artifact!]!
+  "Returns a paginated list of artifacts matching a filter."
+  artifactsList(artifactSpec: ArtifactSpec!, after: ID, first: Int): ArtifactConnection!
 }
 
 extend type Mutation {
   "Ingests a new artifact and returns it. The returned ID can be empty string."
-  ingestArtifact(artifact: ArtifactInputSpec): ID!
+  ingestArtifact(artifact: ArtifactInputSpec): ID! @hasRole(role: WRITER)
   "Bulk ingests new artifacts and returns a list of them. The returned array of IDs can be a an array of empty string."
-  ingestArtifacts(artifacts: [ArtifactInputSpec!]!): [ID!]!
+  ingestArtifacts(artifacts: [ArtifactInputSpec!]!): [ID!]! @hasRole(role: WRITER)
+}
+`, BuiltIn: false},
+	{Name: "../schema/auth.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Defines the authorization directive of the mutations.
+#
+# When the server authenticates its clients, each client is granted one role.
+# Roles are ordered: a WRITER can also do everything a READER can and an ADMIN
+# everything a WRITER can. Queries and subscriptions only require a READER.
+
+"Role of an authenticated client."
+enum Role {
+  "May run queries and subscriptions."
+  READER
+  "May also ingest new nodes."
+  WRITER
+  "May also delete or retract nodes."
+  ADMIN
 }
+
+"""
+hasRole rejects calls to a field from clients that have not been granted at
+least the given role.
+"""
+directive @hasRole(role: Role!) on FIELD_DEFINITION
 `, BuiltIn: false},
 	{Name: "../schema/builder.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -2930,16 +4954,44 @@ input BuilderInputSpec {
   uri: String!
 }
 
+"""
+BuilderConnection returns the paginated results for Builder.
+
+totalCount is the total number of Builder nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the BuilderEdge which contains the current cursor and the
+Builder node itself.
+"""
+type BuilderConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [BuilderEdge!]!
+}
+
+"""
+BuilderEdge contains the cursor for the resulting node and the Builder node
+itself.
+"""
+type BuilderEdge {
+  cursor: ID!
+  node: Builder!
+}
+
 extend type Query {
   "Returns all builders matching a filter."
   builders(builderSpec: BuilderSpec!): [Builder!]!
+  "Returns a paginated list of builders matching a filter."
+  buildersList(builderSpec: BuilderSpec!, after: ID, first: Int): BuilderConnection!
 }
 
 extend type Mutation {
   "Ingests a new builder and returns it. The returned ID can be empty string."
-  ingestBuilder(builder: BuilderInputSpec): ID!
+  ingestBuilder(builder: BuilderInputSpec): ID! @hasRole(role: WRITER)
   "Bulk ingests new builders and returns a list of them. The returned array of IDs can be a an array of empty string."
-  ingestBuilders(builders: [BuilderInputSpec!]!): [ID!]!
+  ingestBuilders(builders: [BuilderInputSpec!]!): [ID!]! @hasRole(role: WRITER)
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyBad.graphql", Input: `#
@@ -3019,6 +5071,10 @@ type CertifyBad {
   justification: String!
   origin: String!
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
   knownSince: Time!
 }
 
@@ -3053,6 +5109,9 @@ input CertifyBadInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
   knownSince: Time!
 }
 
@@ -3070,9 +5129,37 @@ input MatchFlags {
   pkg: PkgMatchType!
 }
 
+"""
+CertifyBadConnection returns the paginated results for CertifyBad.
+
+totalCount is the total number of CertifyBad nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the CertifyBadEdge which contains the current cursor and the
+CertifyBad node itself.
+"""
+type CertifyBadConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyBadEdge!]!
+}
+
+"""
+CertifyBadEdge contains the cursor for the resulting node and the CertifyBad node
+itself.
+"""
+type CertifyBadEdge {
+  cursor: ID!
+  node: CertifyBad!
+}
+
 extend type Query {
   "Returns all CertifyBad attestations matching a filter."
   CertifyBad(certifyBadSpec: CertifyBadSpec!): [CertifyBad!]!
+  "Returns a paginated list of CertifyBad attestations matching a filter."
+  CertifyBadList(certifyBadSpec: CertifyBadSpec!, after: ID, first: Int): CertifyBadConnection!
 }
 
 extend type Mutation {
@@ -3081,13 +5168,23 @@ extend type Mutation {
     subject: PackageSourceOrArtifactInput!
     pkgMatchType: MatchFlags!
     certifyBad: CertifyBadInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Adds bulk certifications that a package, source or artifact is considered bad. The returned array of IDs can be a an array of empty string."
   ingestCertifyBads(
     subjects: PackageSourceOrArtifactInputs!
     pkgMatchType: MatchFlags!
     certifyBads: [CertifyBadInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a CertifyBad and the edges that connect it to the rest of the graph. Returns false if there is no CertifyBad with the given ID."
+  deleteCertifyBad(id: ID!): Boolean! @hasRole(role: ADMIN)
+}
+
+extend type Subscription {
+  """
+  Streams every CertifyBad ingested after the subscription starts and matching
+  the optional filter.
+  """
+  certifyBadAdded(filter: CertifyBadSpec): CertifyBad!
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyGood.graphql", Input: `#
@@ -3128,6 +5225,10 @@ type CertifyGood {
   justification: String!
   origin: String!
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
   knownSince: Time!
 }
 
@@ -3161,12 +5262,43 @@ input CertifyGoodInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
   knownSince: Time!
 }
 
+"""
+CertifyGoodConnection returns the paginated results for CertifyGood.
+
+totalCount is the total number of CertifyGood nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the CertifyGoodEdge which contains the current cursor and the
+CertifyGood node itself.
+"""
+type CertifyGoodConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyGoodEdge!]!
+}
+
+"""
+CertifyGoodEdge contains the cursor for the resulting node and the CertifyGood node
+itself.
+"""
+type CertifyGoodEdge {
+  cursor: ID!
+  node: CertifyGood!
+}
+
 extend type Query {
   "Returns all CertifyGood attestations matching a filter."
   CertifyGood(certifyGoodSpec: CertifyGoodSpec!): [CertifyGood!]!
+  "Returns a paginated list of CertifyGood attestations matching a filter."
+  CertifyGoodList(certifyGoodSpec: CertifyGoodSpec!, after: ID, first: Int): CertifyGoodConnection!
 }
 
 extend type Mutation {
@@ -3175,13 +5307,15 @@ extend type Mutation {
     subject: PackageSourceOrArtifactInput!
     pkgMatchType: MatchFlags!
     certifyGood: CertifyGoodInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Adds bulk certifications that a package, source or artifact is considered good. The returned array of IDs can be a an array of empty string."
   ingestCertifyGoods(
     subjects: PackageSourceOrArtifactInputs!
     pkgMatchType: MatchFlags!
     certifyGoods: [CertifyGoodInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a CertifyGood and the edges that connect it to the rest of the graph. Returns false if there is no CertifyGood with the given ID."
+  deleteCertifyGood(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyLegal.graphql", Input: `#
@@ -3241,6 +5375,10 @@ type CertifyLegal {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -3276,18 +5414,51 @@ input CertifyLegalInputSpec {
   timeScanned: Time!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+CertifyLegalConnection returns the paginated results for CertifyLegal.
+
+totalCount is the total number of CertifyLegal nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the CertifyLegalEdge which contains the current cursor and the
+CertifyLegal node itself.
+"""
+type CertifyLegalConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyLegalEdge!]!
+}
+
+"""
+CertifyLegalEdge contains the cursor for the resulting node and the CertifyLegal node
+itself.
+"""
+type CertifyLegalEdge {
+  cursor: ID!
+  node: CertifyLegal!
 }
 
 extend type Query {
   "Returns all legal certifications matching the input filter."
   CertifyLegal(certifyLegalSpec: CertifyLegalSpec!): [CertifyLegal!]!
+  "Returns a paginated list of legal certifications matching the input filter."
+  CertifyLegalList(certifyLegalSpec: CertifyLegalSpec!, after: ID, first: Int): CertifyLegalConnection!
 }
 
 extend type Mutation {
   "Adds a legal certification to a package or source."
-  ingestCertifyLegal(subject: PackageOrSourceInput!, declaredLicenses: [LicenseInputSpec!]!, discoveredLicenses: [LicenseInputSpec!]!, certifyLegal: CertifyLegalInputSpec!): ID!
+  ingestCertifyLegal(subject: PackageOrSourceInput!, declaredLicenses: [LicenseInputSpec!]!, discoveredLicenses: [LicenseInputSpec!]!, certifyLegal: CertifyLegalInputSpec!): ID! @hasRole(role: WRITER)
   "Bulk add legal certifications to packages or sources, not both at same time."
-  ingestCertifyLegals(subjects: PackageOrSourceInputs!, declaredLicensesList: [[LicenseInputSpec!]!]!, discoveredLicensesList: [[LicenseInputSpec!]!]!, certifyLegals: [CertifyLegalInputSpec!]!): [ID!]!
+  ingestCertifyLegals(subjects: PackageOrSourceInputs!, declaredLicensesList: [[LicenseInputSpec!]!]!, discoveredLicensesList: [[LicenseInputSpec!]!]!, certifyLegals: [CertifyLegalInputSpec!]!): [ID!]! @hasRole(role: WRITER)
+  "Deletes a CertifyLegal and the edges that connect it to the rest of the graph. Returns false if there is no CertifyLegal with the given ID."
+  deleteCertifyLegal(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyScorecard.graphql", Input: `#
@@ -3352,6 +5523,10 @@ type Scorecard {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -3403,27 +5578,60 @@ input ScorecardInputSpec {
   scorecardCommit: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"ScorecardCheckInputSpec represents the mutation input for a Scorecard check."
+input ScorecardCheckInputSpec {
+  check: String!
+  score: Int!
+}
+
+"""
+CertifyScorecardConnection returns the paginated results for CertifyScorecard.
+
+totalCount is the total number of CertifyScorecard nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the CertifyScorecardEdge which contains the current cursor and the
+CertifyScorecard node itself.
+"""
+type CertifyScorecardConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyScorecardEdge!]!
 }
 
-"ScorecardCheckInputSpec represents the mutation input for a Scorecard check."
-input ScorecardCheckInputSpec {
-  check: String!
-  score: Int!
+"""
+CertifyScorecardEdge contains the cursor for the resulting node and the CertifyScorecard node
+itself.
+"""
+type CertifyScorecardEdge {
+  cursor: ID!
+  node: CertifyScorecard!
 }
 
 extend type Query {
   "Returns all Scorecard certifications matching the filter."
   scorecards(scorecardSpec: CertifyScorecardSpec!): [CertifyScorecard!]!
+  "Returns a paginated list of Scorecard certifications matching the filter."
+  scorecardsList(scorecardSpec: CertifyScorecardSpec!, after: ID, first: Int): CertifyScorecardConnection!
 }
 
 extend type Mutation {
   "Adds a certification that a source repository has a Scorecard. The returned ID can be empty string."
-  ingestScorecard(source: SourceInputSpec!, scorecard: ScorecardInputSpec!): ID!
+  ingestScorecard(source: SourceInputSpec!, scorecard: ScorecardInputSpec!): ID! @hasRole(role: WRITER)
   "Adds bulk certifications that a source repository has a Scorecard. The returned array of IDs can be a an array of empty string."
   ingestScorecards(
     sources: [SourceInputSpec!]!
     scorecards: [ScorecardInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a CertifyScorecard and the edges that connect it to the rest of the graph. Returns false if there is no CertifyScorecard with the given ID."
+  deleteCertifyScorecard(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyVEXStatement.graphql", Input: `#
@@ -3521,6 +5729,10 @@ type CertifyVEXStatement {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -3553,6 +5765,35 @@ input VexStatementInputSpec {
   knownSince: Time!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+CertifyVEXStatementConnection returns the paginated results for CertifyVEXStatement.
+
+totalCount is the total number of CertifyVEXStatement nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the CertifyVEXStatementEdge which contains the current cursor and the
+CertifyVEXStatement node itself.
+"""
+type CertifyVEXStatementConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyVEXStatementEdge!]!
+}
+
+"""
+CertifyVEXStatementEdge contains the cursor for the resulting node and the CertifyVEXStatement node
+itself.
+"""
+type CertifyVEXStatementEdge {
+  cursor: ID!
+  node: CertifyVEXStatement!
 }
 
 extend type Query {
@@ -3560,6 +5801,8 @@ extend type Query {
   CertifyVEXStatement(
     certifyVEXStatementSpec: CertifyVEXStatementSpec!
   ): [CertifyVEXStatement!]!
+  "Returns a paginated list of VEX certifications matching the input filter."
+  CertifyVEXStatementList(certifyVEXStatementSpec: CertifyVEXStatementSpec!, after: ID, first: Int): CertifyVEXStatementConnection!
 }
 
 extend type Mutation {
@@ -3568,13 +5811,23 @@ extend type Mutation {
     subject: PackageOrArtifactInput!
     vulnerability: VulnerabilityInputSpec!
     vexStatement: VexStatementInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk add VEX certifications for a package and vulnerability. The returned array of IDs can be a an array of empty string."
   ingestVEXStatements(
     subjects: PackageOrArtifactInputs!, 
     vulnerabilities: [VulnerabilityInputSpec!]!, 
     vexStatements: [VexStatementInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a CertifyVEXStatement and the edges that connect it to the rest of the graph. Returns false if there is no CertifyVEXStatement with the given ID."
+  deleteCertifyVEXStatement(id: ID!): Boolean! @hasRole(role: ADMIN)
+}
+
+extend type Subscription {
+  """
+  Streams every VEX statement ingested after the subscription starts and matching
+  the optional filter.
+  """
+  certifyVEXStatementAdded(filter: CertifyVEXStatementSpec): CertifyVEXStatement!
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyVuln.graphql", Input: `#
@@ -3633,6 +5886,10 @@ type ScanMetadata {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -3670,11 +5927,42 @@ input ScanMetadataInput {
   scannerVersion: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+CertifyVulnConnection returns the paginated results for CertifyVuln.
+
+totalCount is the total number of CertifyVuln nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the CertifyVulnEdge which contains the current cursor and the
+CertifyVuln node itself.
+"""
+type CertifyVulnConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyVulnEdge!]!
+}
+
+"""
+CertifyVulnEdge contains the cursor for the resulting node and the CertifyVuln node
+itself.
+"""
+type CertifyVulnEdge {
+  cursor: ID!
+  node: CertifyVuln!
 }
 
 extend type Query {
   "Returns all vulnerability certifications matching the input filter."
   CertifyVuln(certifyVulnSpec: CertifyVulnSpec!): [CertifyVuln!]!
+  "Returns a paginated list of vulnerability certifications matching the input filter."
+  CertifyVulnList(certifyVulnSpec: CertifyVulnSpec!, after: ID, first: Int): CertifyVulnConnection!
 }
 
 extend type Mutation {
@@ -3683,13 +5971,23 @@ extend type Mutation {
     pkg: PkgInputSpec!
     vulnerability: VulnerabilityInputSpec!
     certifyVuln: ScanMetadataInput!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk add certifications that a package has been scanned for vulnerabilities. The returned array of IDs can be a an array of empty string."
   ingestCertifyVulns(
     pkgs: [PkgInputSpec!]!
     vulnerabilities: [VulnerabilityInputSpec!]!
     certifyVulns: [ScanMetadataInput!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a CertifyVuln and the edges that connect it to the rest of the graph. Returns false if there is no CertifyVuln with the given ID."
+  deleteCertifyVuln(id: ID!): Boolean! @hasRole(role: ADMIN)
+}
+
+extend type Subscription {
+  """
+  Streams every vulnerability certification ingested after the subscription starts and matching
+  the optional filter.
+  """
+  certifyVulnAdded(filter: CertifyVulnSpec): CertifyVuln!
 }
 `, BuiltIn: false},
 	{Name: "../schema/contact.graphql", Input: `#
@@ -3741,6 +6039,10 @@ type PointOfContact {
   justification: String!
   origin: String!
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -3777,11 +6079,42 @@ input PointOfContactInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+PointOfContactConnection returns the paginated results for PointOfContact.
+
+totalCount is the total number of PointOfContact nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the PointOfContactEdge which contains the current cursor and the
+PointOfContact node itself.
+"""
+type PointOfContactConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [PointOfContactEdge!]!
+}
+
+"""
+PointOfContactEdge contains the cursor for the resulting node and the PointOfContact node
+itself.
+"""
+type PointOfContactEdge {
+  cursor: ID!
+  node: PointOfContact!
 }
 
 extend type Query {
   "Returns all PointOfContact attestations matching a filter."
   PointOfContact(pointOfContactSpec: PointOfContactSpec!): [PointOfContact!]!
+  "Returns a paginated list of PointOfContact attestations matching a filter."
+  PointOfContactList(pointOfContactSpec: PointOfContactSpec!, after: ID, first: Int): PointOfContactConnection!
 }
 
 extend type Mutation {
@@ -3790,13 +6123,15 @@ extend type Mutation {
     subject: PackageSourceOrArtifactInput!
     pkgMatchType: MatchFlags!
     pointOfContact: PointOfContactInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Adds bulk PointOfContact attestations to a package, source or artifact. The returned array of IDs can be a an array of empty string."
   ingestPointOfContacts(
     subjects: PackageSourceOrArtifactInputs!
     pkgMatchType: MatchFlags!
     pointOfContacts: [PointOfContactInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a PointOfContact and the edges that connect it to the rest of the graph. Returns false if there is no PointOfContact with the given ID."
+  deletePointOfContact(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/hasSBOM.graphql", Input: `#
@@ -3834,6 +6169,10 @@ type HasSBOM {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
   "Timestamp for SBOM creation"
   knownSince: Time!
   "Included packages and artifacts"
@@ -3881,12 +6220,43 @@ input HasSBOMInputSpec {
   downloadLocation: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
   knownSince: Time!
 }
 
+"""
+HasSBOMConnection returns the paginated results for HasSBOM.
+
+totalCount is the total number of HasSBOM nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the HasSBOMEdge which contains the current cursor and the
+HasSBOM node itself.
+"""
+type HasSBOMConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSBOMEdge!]!
+}
+
+"""
+HasSBOMEdge contains the cursor for the resulting node and the HasSBOM node
+itself.
+"""
+type HasSBOMEdge {
+  cursor: ID!
+  node: HasSBOM!
+}
+
 extend type Query {
   "Returns all SBOM certifications."
   HasSBOM(hasSBOMSpec: HasSBOMSpec!): [HasSBOM!]!
+  "Returns a paginated list of SBOM certifications."
+  HasSBOMList(hasSBOMSpec: HasSBOMSpec!, after: ID, first: Int): HasSBOMConnection!
 }
 
 extend type Mutation {
@@ -3895,13 +6265,15 @@ extend type Mutation {
     subject: PackageOrArtifactInput!
     hasSBOM: HasSBOMInputSpec!
     includes: HasSBOMIncludesInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk ingest that package or artifact has an SBOM. The returned array of IDs can be a an array of empty string."
   ingestHasSBOMs(
     subjects: PackageOrArtifactInputs!
     hasSBOMs: [HasSBOMInputSpec!]!
     includes: [HasSBOMIncludesInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a HasSBOM and the edges that connect it to the rest of the graph. Returns false if there is no HasSBOM with the given ID."
+  deleteHasSBOM(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/hasSLSA.graphql", Input: `#
@@ -3961,6 +6333,10 @@ type SLSA {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -4025,6 +6401,9 @@ input SLSAInputSpec {
   finishedOn: Time
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
 }
 
 "SLSAPredicateInputSpec allows ingesting SLSAPredicateSpec."
@@ -4033,9 +6412,37 @@ input SLSAPredicateInputSpec {
   value: String!
 }
 
+"""
+HasSLSAConnection returns the paginated results for HasSLSA.
+
+totalCount is the total number of HasSLSA nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the HasSLSAEdge which contains the current cursor and the
+HasSLSA node itself.
+"""
+type HasSLSAConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSLSAEdge!]!
+}
+
+"""
+HasSLSAEdge contains the cursor for the resulting node and the HasSLSA node
+itself.
+"""
+type HasSLSAEdge {
+  cursor: ID!
+  node: HasSLSA!
+}
+
 extend type Query {
   "Returns all SLSA attestations matching the filter."
   HasSLSA(hasSLSASpec: HasSLSASpec!): [HasSLSA!]!
+  "Returns a paginated list of SLSA attestations matching the filter."
+  HasSLSAList(hasSLSASpec: HasSLSASpec!, after: ID, first: Int): HasSLSAConnection!
 }
 
 extend type Mutation {
@@ -4045,14 +6452,16 @@ extend type Mutation {
     builtFrom: [ArtifactInputSpec!]!
     builtBy: BuilderInputSpec!
     slsa: SLSAInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk Ingest SLSA attestations. The returned array of IDs can be a an array of empty string."
   ingestSLSAs(
     subjects: [ArtifactInputSpec!]!
     builtFromList: [[ArtifactInputSpec!]!]!
     builtByList: [BuilderInputSpec!]!
     slsaList: [SLSAInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a HasSLSA and the edges that connect it to the rest of the graph. Returns false if there is no HasSLSA with the given ID."
+  deleteHasSLSA(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/hasSourceAt.graphql", Input: `#
@@ -4089,6 +6498,10 @@ type HasSourceAt {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 "HasSourceAtSpec allows filtering the list of HasSourceAt to return."
@@ -4108,11 +6521,42 @@ input HasSourceAtInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+HasSourceAtConnection returns the paginated results for HasSourceAt.
+
+totalCount is the total number of HasSourceAt nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the HasSourceAtEdge which contains the current cursor and the
+HasSourceAt node itself.
+"""
+type HasSourceAtConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSourceAtEdge!]!
+}
+
+"""
+HasSourceAtEdge contains the cursor for the resulting node and the HasSourceAt node
+itself.
+"""
+type HasSourceAtEdge {
+  cursor: ID!
+  node: HasSourceAt!
 }
 
 extend type Query {
   "Returns all source mappings that match the filter."
   HasSourceAt(hasSourceAtSpec: HasSourceAtSpec!): [HasSourceAt!]!
+  "Returns a paginated list of source mappings that match the filter."
+  HasSourceAtList(hasSourceAtSpec: HasSourceAtSpec!, after: ID, first: Int): HasSourceAtConnection!
 }
 
 extend type Mutation {
@@ -4122,14 +6566,16 @@ extend type Mutation {
     pkgMatchType: MatchFlags!
     source: SourceInputSpec!
     hasSourceAt: HasSourceAtInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk ingestion of certifications that a package (PackageName or PackageVersion) is built from the source. The returned array of IDs can be a an array of empty string."
   ingestHasSourceAts(
     pkgs: [PkgInputSpec!]!
     pkgMatchType: MatchFlags!
     sources: [SourceInputSpec!]!
     hasSourceAts: [HasSourceAtInputSpec!]!
-  ):[ID!]!
+  ):[ID!]! @hasRole(role: WRITER)
+  "Deletes a HasSourceAt and the edges that connect it to the rest of the graph. Returns false if there is no HasSourceAt with the given ID."
+  deleteHasSourceAt(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/hashEqual.graphql", Input: `#
@@ -4162,6 +6608,10 @@ type HashEqual {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -4184,11 +6634,42 @@ input HashEqualInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+HashEqualConnection returns the paginated results for HashEqual.
+
+totalCount is the total number of HashEqual nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the HashEqualEdge which contains the current cursor and the
+HashEqual node itself.
+"""
+type HashEqualConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HashEqualEdge!]!
+}
+
+"""
+HashEqualEdge contains the cursor for the resulting node and the HashEqual node
+itself.
+"""
+type HashEqualEdge {
+  cursor: ID!
+  node: HashEqual!
 }
 
 extend type Query {
   "Returns all artifact equality statements matching a filter."
   HashEqual(hashEqualSpec: HashEqualSpec!): [HashEqual!]!
+  "Returns a paginated list of artifact equality statements matching a filter."
+  HashEqualList(hashEqualSpec: HashEqualSpec!, after: ID, first: Int): HashEqualConnection!
 }
 
 extend type Mutation {
@@ -4197,13 +6678,15 @@ extend type Mutation {
     artifact: ArtifactInputSpec!
     otherArtifact: ArtifactInputSpec!
     hashEqual: HashEqualInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk ingest certifications that two artifacts are equal. The returned array of IDs can be a an array of empty string."
   ingestHashEquals(
     artifacts: [ArtifactInputSpec!]!
     otherArtifacts: [ArtifactInputSpec!]!
     hashEquals: [HashEqualInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a HashEqual and the edges that connect it to the rest of the graph. Returns false if there is no HashEqual with the given ID."
+  deleteHashEqual(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/isDependency.graphql", Input: `#
@@ -4235,6 +6718,30 @@ enum DependencyType {
   UNKNOWN
 }
 
+"""
+DependencyScope determines for what the dependency is needed.
+
+Dependencies needed only to develop, build or test the package, or which are
+optional, can be excluded when looking for the dependencies of what is
+deployed.
+"""
+enum DependencyScope {
+  "dependency needed at runtime"
+  RUNTIME
+  "dependency needed only for development"
+  DEV
+  "dependency needed only to build the package"
+  BUILD
+  "dependency needed only to test the package"
+  TEST
+  "optional dependency"
+  OPTIONAL
+  "dependency expected to be provided by the runtime environment"
+  PROVIDED
+  "scope not known/not specified"
+  UNKNOWN
+}
+
 "IsDependency is an attestation to record that a package depends on another. "
 type IsDependency {
   id: ID!
@@ -4246,12 +6753,18 @@ type IsDependency {
   versionRange: String!
   "Type of dependency"
   dependencyType: DependencyType!
+  "Scope of the dependency"
+  dependencyScope: DependencyScope!
   "Justification for the attested relationship"
   justification: String!
   "Document from which this attestation is generated from"
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -4261,6 +6774,10 @@ To obtain the list of dependency packages, caller must fill in the package
 field.
 
 Dependency packages must be defined at PackageName, not PackageVersion.
+
+dependencyScope only returns the dependencies of that scope, while
+excludeDependencyScopes leaves out the dependencies of any of the listed
+scopes, for example to ignore the dev and test only dependencies.
 """
 input IsDependencySpec {
   id: ID
@@ -4268,6 +6785,8 @@ input IsDependencySpec {
   dependencyPackage: PkgSpec
   versionRange: String
   dependencyType: DependencyType
+  dependencyScope: DependencyScope
+  excludeDependencyScopes: [DependencyScope!]
   justification: String
   origin: String
   collector: String
@@ -4278,14 +6797,47 @@ input IsDependencyInputSpec {
   "versionRange should be specified for depedentPackages that point to PackageName"
   versionRange: String!
   dependencyType: DependencyType!
+  "dependencyScope defaults to UNKNOWN when not specified"
+  dependencyScope: DependencyScope
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+IsDependencyConnection returns the paginated results for IsDependency.
+
+totalCount is the total number of IsDependency nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the IsDependencyEdge which contains the current cursor and the
+IsDependency node itself.
+"""
+type IsDependencyConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [IsDependencyEdge!]!
+}
+
+"""
+IsDependencyEdge contains the cursor for the resulting node and the IsDependency node
+itself.
+"""
+type IsDependencyEdge {
+  cursor: ID!
+  node: IsDependency!
 }
 
 extend type Query {
   "Returns all package dependencies that match the filter."
   IsDependency(isDependencySpec: IsDependencySpec!): [IsDependency!]!
+  "Returns a paginated list of package dependencies that match the filter."
+  IsDependencyList(isDependencySpec: IsDependencySpec!, after: ID, first: Int): IsDependencyConnection!
 }
 
 extend type Mutation {
@@ -4295,14 +6847,16 @@ extend type Mutation {
     depPkg: PkgInputSpec!
     depPkgMatchType: MatchFlags!
     dependency: IsDependencyInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk adds a dependency between two packages. The returned array of IDs can be a an array of empty string."
   ingestDependencies(
     pkgs: [PkgInputSpec!]!
     depPkgs: [PkgInputSpec!]!
     depPkgMatchType: MatchFlags!
     dependencies: [IsDependencyInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a IsDependency and the edges that connect it to the rest of the graph. Returns false if there is no IsDependency with the given ID."
+  deleteIsDependency(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/isOccurrence.graphql", Input: `#
@@ -4373,6 +6927,10 @@ type IsOccurrence {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -4393,11 +6951,42 @@ input IsOccurrenceInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+IsOccurrenceConnection returns the paginated results for IsOccurrence.
+
+totalCount is the total number of IsOccurrence nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the IsOccurrenceEdge which contains the current cursor and the
+IsOccurrence node itself.
+"""
+type IsOccurrenceConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [IsOccurrenceEdge!]!
+}
+
+"""
+IsOccurrenceEdge contains the cursor for the resulting node and the IsOccurrence node
+itself.
+"""
+type IsOccurrenceEdge {
+  cursor: ID!
+  node: IsOccurrence!
 }
 
 extend type Query {
   "Returns all artifacts-source/package mappings that match a filter."
   IsOccurrence(isOccurrenceSpec: IsOccurrenceSpec!): [IsOccurrence!]!
+  "Returns a paginated list of artifacts-source/package mappings that match a filter."
+  IsOccurrenceList(isOccurrenceSpec: IsOccurrenceSpec!, after: ID, first: Int): IsOccurrenceConnection!
 }
 
 extend type Mutation {
@@ -4406,13 +6995,15 @@ extend type Mutation {
     subject: PackageOrSourceInput!
     artifact: ArtifactInputSpec!
     occurrence: IsOccurrenceInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk ingest that an artifact is produced from a package or source. The returned array of IDs can be a an array of empty string."
   ingestOccurrences(
     subjects: PackageOrSourceInputs!
     artifacts: [ArtifactInputSpec!]!
     occurrences: [IsOccurrenceInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a IsOccurrence and the edges that connect it to the rest of the graph. Returns false if there is no IsOccurrence with the given ID."
+  deleteIsOccurrence(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/license.graphql", Input: `#
@@ -4488,16 +7079,44 @@ input LicenseInputSpec {
   listVersion: String
 }
 
+"""
+LicenseConnection returns the paginated results for License.
+
+totalCount is the total number of License nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the LicenseEdge which contains the current cursor and the
+License node itself.
+"""
+type LicenseConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [LicenseEdge!]!
+}
+
+"""
+LicenseEdge contains the cursor for the resulting node and the License node
+itself.
+"""
+type LicenseEdge {
+  cursor: ID!
+  node: License!
+}
+
 extend type Query {
   "Returns all licenses matching a filter."
   licenses(licenseSpec: LicenseSpec!): [License!]!
+  "Returns a paginated list of licenses matching a filter."
+  licensesList(licenseSpec: LicenseSpec!, after: ID, first: Int): LicenseConnection!
 }
 
 extend type Mutation {
   "Ingests a new license and returns it."
-  ingestLicense(license: LicenseInputSpec): ID!
+  ingestLicense(license: LicenseInputSpec): ID! @hasRole(role: WRITER)
   "Bulk ingests new licenses and returns a list of them."
-  ingestLicenses(licenses: [LicenseInputSpec!]!): [ID!]!
+  ingestLicenses(licenses: [LicenseInputSpec!]!): [ID!]! @hasRole(role: WRITER)
 }
 `, BuiltIn: false},
 	{Name: "../schema/metadata.graphql", Input: `#
@@ -4544,6 +7163,10 @@ type HasMetadata {
   justification: String!
   origin: String!
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -4580,11 +7203,42 @@ input HasMetadataInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+HasMetadataConnection returns the paginated results for HasMetadata.
+
+totalCount is the total number of HasMetadata nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the HasMetadataEdge which contains the current cursor and the
+HasMetadata node itself.
+"""
+type HasMetadataConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasMetadataEdge!]!
+}
+
+"""
+HasMetadataEdge contains the cursor for the resulting node and the HasMetadata node
+itself.
+"""
+type HasMetadataEdge {
+  cursor: ID!
+  node: HasMetadata!
 }
 
 extend type Query {
   "Returns all HasMetdata attestations matching a filter."
   HasMetadata(hasMetadataSpec: HasMetadataSpec!): [HasMetadata!]!
+  "Returns a paginated list of HasMetdata attestations matching a filter."
+  HasMetadataList(hasMetadataSpec: HasMetadataSpec!, after: ID, first: Int): HasMetadataConnection!
 }
 
 extend type Mutation {
@@ -4593,13 +7247,15 @@ extend type Mutation {
     subject: PackageSourceOrArtifactInput!
     pkgMatchType: MatchFlags!
     hasMetadata: HasMetadataInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Adds bulk metadata about a package, source or artifact. The returned array of IDs can be a an array of empty string."
   ingestBulkHasMetadata(
     subjects: PackageSourceOrArtifactInputs!
     pkgMatchType: MatchFlags!
     hasMetadataList: [HasMetadataInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a HasMetadata and the edges that connect it to the rest of the graph. Returns false if there is no HasMetadata with the given ID."
+  deleteHasMetadata(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/package.graphql", Input: `#
@@ -4789,16 +7445,88 @@ input PackageQualifierInputSpec {
   value: String!
 }
 
+"""
+PackageConnection returns the paginated results for Package.
+
+totalCount is the total number of Package nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the PackageEdge which contains the current cursor and the
+Package node itself.
+"""
+type PackageConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [PackageEdge!]!
+}
+
+"""
+PackageEdge contains the cursor for the resulting node and the Package node
+itself.
+"""
+type PackageEdge {
+  cursor: ID!
+  node: Package!
+}
+
 extend type Query {
   "Returns all packages matching a filter."
   packages(pkgSpec: PkgSpec!): [Package!]!
+  "Returns a paginated list of packages matching a filter."
+  packagesList(pkgSpec: PkgSpec!, after: ID, first: Int): PackageConnection!
 }
 
 extend type Mutation {
   "Ingests a new package and returns a corresponding package hierarchy containing only the IDs. The returned ID can be empty string."
-  ingestPackage(pkg: PkgInputSpec!): PackageIDs!
+  ingestPackage(pkg: PkgInputSpec!): PackageIDs! @hasRole(role: WRITER)
   "Bulk ingests packages and returns the list of corresponding package hierarchies containing only the IDs. The returned array of IDs can be empty strings."
-  ingestPackages(pkgs: [PkgInputSpec!]!): [PackageIDs!]!
+  ingestPackages(pkgs: [PkgInputSpec!]!): [PackageIDs!]! @hasRole(role: WRITER)
+}
+`, BuiltIn: false},
+	{Name: "../schema/pagination.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the GraphQL types shared by all paginated (Relay-style connection)
+# queries.
+#
+# Every list query has a ` + "`" + `<query>List` + "`" + ` variant which takes an optional ` + "`" + `after` + "`" + `
+# cursor and an optional ` + "`" + `first` + "`" + ` page size and returns a connection with the
+# ` + "`" + `totalCount` + "`" + ` of matching nodes, the ` + "`" + `pageInfo` + "`" + ` of the returned page and one
+# edge per node. Cursors are opaque to clients and results are returned in a
+# stable order, so a client can walk all results by passing the ` + "`" + `endCursor` + "`" + ` of a
+# page as the ` + "`" + `after` + "`" + ` argument of the next call until ` + "`" + `hasNextPage` + "`" + ` is false.
+#
+# When ` + "`" + `first` + "`" + ` is missing, all nodes after the cursor are returned.
+
+"""
+PageInfo serves the client information about the paginated query results.
+
+hasNextPage is true when there are results to be returned after this page.
+
+startCursor is the cursor of the first node in the page (null for empty pages).
+
+endCursor is the cursor of the last node in the page (null for empty pages).
+"""
+type PageInfo {
+  hasNextPage: Boolean!
+  startCursor: ID
+  endCursor: ID
 }
 `, BuiltIn: false},
 	{Name: "../schema/path.graphql", Input: `#
@@ -5026,6 +7754,10 @@ type PkgEqual {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -5048,11 +7780,42 @@ input PkgEqualInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+PkgEqualConnection returns the paginated results for PkgEqual.
+
+totalCount is the total number of PkgEqual nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the PkgEqualEdge which contains the current cursor and the
+PkgEqual node itself.
+"""
+type PkgEqualConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [PkgEqualEdge!]!
+}
+
+"""
+PkgEqualEdge contains the cursor for the resulting node and the PkgEqual node
+itself.
+"""
+type PkgEqualEdge {
+  cursor: ID!
+  node: PkgEqual!
 }
 
 extend type Query {
   "Returns all package equality statements matching a filter."
   PkgEqual(pkgEqualSpec: PkgEqualSpec!): [PkgEqual!]!
+  "Returns a paginated list of package equality statements matching a filter."
+  PkgEqualList(pkgEqualSpec: PkgEqualSpec!, after: ID, first: Int): PkgEqualConnection!
 }
 
 extend type Mutation {
@@ -5061,13 +7824,44 @@ extend type Mutation {
     pkg: PkgInputSpec!
     otherPackage: PkgInputSpec!
     pkgEqual: PkgEqualInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk ingest mapping between packages. The returned array of IDs can be a an array of empty string."
   ingestPkgEquals(
     pkgs: [PkgInputSpec!]!
     otherPackages: [PkgInputSpec!]!
     pkgEquals: [PkgEqualInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a PkgEqual and the edges that connect it to the rest of the graph. Returns false if there is no PkgEqual with the given ID."
+  deletePkgEqual(id: ID!): Boolean! @hasRole(role: ADMIN)
+}
+`, BuiltIn: false},
+	{Name: "../schema/retract.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+# Defines the GraphQL operations to remove evidence that was ingested from a
+# given document.
+
+extend type Mutation {
+  """
+  retractEvidence deletes every evidence node whose origin is the given
+  document, together with the edges that connect these nodes to the rest of
+  the graph. Software trees (packages, sources, artifacts, etc.) are left in
+  place. Returns the number of evidence nodes that were deleted.
+  """
+  retractEvidence(origin: String!): Int! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/search.graphql", Input: `#
@@ -5220,16 +8014,44 @@ input SourceInputSpec {
   commit: String = ""
 }
 
+"""
+SourceConnection returns the paginated results for Source.
+
+totalCount is the total number of Source nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the SourceEdge which contains the current cursor and the
+Source node itself.
+"""
+type SourceConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [SourceEdge!]!
+}
+
+"""
+SourceEdge contains the cursor for the resulting node and the Source node
+itself.
+"""
+type SourceEdge {
+  cursor: ID!
+  node: Source!
+}
+
 extend type Query {
   "Returns all sources matching a filter."
   sources(sourceSpec: SourceSpec!): [Source!]!
+  "Returns a paginated list of sources matching a filter."
+  sourcesList(sourceSpec: SourceSpec!, after: ID, first: Int): SourceConnection!
 }
 
 extend type Mutation {
   "Ingests a new source and returns the corresponding source trie path. The returned ID can be empty string."
-  ingestSource(source: SourceInputSpec!): SourceIDs!
+  ingestSource(source: SourceInputSpec!): SourceIDs! @hasRole(role: WRITER)
   "Bulk ingests sources and returns the list of corresponding source trie path. The returned array of IDs can be a an array of empty string."
-  ingestSources(sources: [SourceInputSpec!]!): [SourceIDs!]!
+  ingestSources(sources: [SourceInputSpec!]!): [SourceIDs!]! @hasRole(role: WRITER)
 }
 `, BuiltIn: false},
 	{Name: "../schema/vulnEqual.graphql", Input: `#
@@ -5266,6 +8088,10 @@ type VulnEqual {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -5285,11 +8111,42 @@ input VulnEqualInputSpec {
   justification: String!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+VulnEqualConnection returns the paginated results for VulnEqual.
+
+totalCount is the total number of VulnEqual nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the VulnEqualEdge which contains the current cursor and the
+VulnEqual node itself.
+"""
+type VulnEqualConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnEqualEdge!]!
+}
+
+"""
+VulnEqualEdge contains the cursor for the resulting node and the VulnEqual node
+itself.
+"""
+type VulnEqualEdge {
+  cursor: ID!
+  node: VulnEqual!
 }
 
 extend type Query {
   "Returns all equal vulnerability mappings that match a filter."
   vulnEqual(vulnEqualSpec: VulnEqualSpec!): [VulnEqual!]!
+  "Returns a paginated list of equal vulnerability mappings that match a filter."
+  vulnEqualList(vulnEqualSpec: VulnEqualSpec!, after: ID, first: Int): VulnEqualConnection!
 }
 
 extend type Mutation {
@@ -5298,13 +8155,15 @@ extend type Mutation {
     vulnerability: VulnerabilityInputSpec!
     otherVulnerability: VulnerabilityInputSpec!
     vulnEqual: VulnEqualInputSpec!
-  ): ID!
+  ): ID! @hasRole(role: WRITER)
   "Bulk ingest mapping between vulnerabilities. The returned array of IDs can be a an array of empty string."
   ingestVulnEquals(
     vulnerabilities: [VulnerabilityInputSpec!]!
     otherVulnerabilities: [VulnerabilityInputSpec!]!
     vulnEquals: [VulnEqualInputSpec!]!
-  ): [ID!]!
+  ): [ID!]! @hasRole(role: WRITER)
+  "Deletes a VulnEqual and the edges that connect it to the rest of the graph. Returns false if there is no VulnEqual with the given ID."
+  deleteVulnEqual(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/vulnMetadata.graphql", Input: `#
@@ -5378,6 +8237,10 @@ type VulnerabilityMetadata {
   timestamp: Time!
   origin: String!
   collector: String!
+  "Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node."
+  verified: Boolean
+  "Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node."
+  signer: String
 }
 
 """
@@ -5409,18 +8272,51 @@ input VulnerabilityMetadataInputSpec {
   timestamp: Time!
   origin: String!
   collector: String!
+  "verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked"
+  verified: Boolean
+  signer: String
+}
+
+"""
+VulnerabilityMetadataConnection returns the paginated results for VulnerabilityMetadata.
+
+totalCount is the total number of VulnerabilityMetadata nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the VulnerabilityMetadataEdge which contains the current cursor and the
+VulnerabilityMetadata node itself.
+"""
+type VulnerabilityMetadataConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnerabilityMetadataEdge!]!
+}
+
+"""
+VulnerabilityMetadataEdge contains the cursor for the resulting node and the VulnerabilityMetadata node
+itself.
+"""
+type VulnerabilityMetadataEdge {
+  cursor: ID!
+  node: VulnerabilityMetadata!
 }
 
 extend type Query {
   "Returns all vulnerabilityMetadata attestations matching a filter."
   vulnerabilityMetadata(vulnerabilityMetadataSpec: VulnerabilityMetadataSpec!): [VulnerabilityMetadata!]!
+  "Returns a paginated list of vulnerabilityMetadata attestations matching a filter."
+  vulnerabilityMetadataList(vulnerabilityMetadataSpec: VulnerabilityMetadataSpec!, after: ID, first: Int): VulnerabilityMetadataConnection!
 }
 
 extend type Mutation {
   "Adds metadata about a vulnerability. The returned ID can be empty string."
-  ingestVulnerabilityMetadata(vulnerability: VulnerabilityInputSpec!, vulnerabilityMetadata: VulnerabilityMetadataInputSpec!): ID!
+  ingestVulnerabilityMetadata(vulnerability: VulnerabilityInputSpec!, vulnerabilityMetadata: VulnerabilityMetadataInputSpec!): ID! @hasRole(role: WRITER)
   "Bulk add certifications that vulnerability has a specific score. The returned array of IDs can be a an array of empty string."
-  ingestBulkVulnerabilityMetadata(vulnerabilities: [VulnerabilityInputSpec!]!, vulnerabilityMetadataList: [VulnerabilityMetadataInputSpec!]!): [ID!]!
+  ingestBulkVulnerabilityMetadata(vulnerabilities: [VulnerabilityInputSpec!]!, vulnerabilityMetadataList: [VulnerabilityMetadataInputSpec!]!): [ID!]! @hasRole(role: WRITER)
+  "Deletes a VulnerabilityMetadata and the edges that connect it to the rest of the graph. Returns false if there is no VulnerabilityMetadata with the given ID."
+  deleteVulnerabilityMetadata(id: ID!): Boolean! @hasRole(role: ADMIN)
 }
 `, BuiltIn: false},
 	{Name: "../schema/vulnerability.graphql", Input: `#
@@ -5527,16 +8423,44 @@ type VulnerabilityIDs {
   vulnerabilityNodeID: ID!
 }
 
+"""
+VulnerabilityConnection returns the paginated results for Vulnerability.
+
+totalCount is the total number of Vulnerability nodes matching the filter.
+
+pageInfo provides information to the client if there is a next page of
+results and the starting and ending cursor for the current set.
+
+edges contains the VulnerabilityEdge which contains the current cursor and the
+Vulnerability node itself.
+"""
+type VulnerabilityConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnerabilityEdge!]!
+}
+
+"""
+VulnerabilityEdge contains the cursor for the resulting node and the Vulnerability node
+itself.
+"""
+type VulnerabilityEdge {
+  cursor: ID!
+  node: Vulnerability!
+}
+
 extend type Query {
   "Returns all vulnerabilities matching a filter."
   vulnerabilities(vulnSpec: VulnerabilitySpec!): [Vulnerability!]!
+  "Returns a paginated list of vulnerabilities matching a filter."
+  vulnerabilitiesList(vulnSpec: VulnerabilitySpec!, after: ID, first: Int): VulnerabilityConnection!
 }
 
 extend type Mutation {
   "Ingests a new vulnerability and returns the corresponding vulnerability trie path. The returned ID can be empty string."
-  ingestVulnerability(vuln: VulnerabilityInputSpec!): VulnerabilityIDs!
+  ingestVulnerability(vuln: VulnerabilityInputSpec!): VulnerabilityIDs! @hasRole(role: WRITER)
   "Bulk ingests vulnerabilities and returns the list of corresponding vulnerability trie path. The returned array of IDs can be a an array of empty string."
-  ingestVulnerabilities(vulns: [VulnerabilityInputSpec!]!): [VulnerabilityIDs!]!
+  ingestVulnerabilities(vulns: [VulnerabilityInputSpec!]!): [VulnerabilityIDs!]! @hasRole(role: WRITER)
 }
 `, BuiltIn: false},
 }
@@ -52,6 +52,28 @@ func (Artifact) IsPackageOrArtifact() {}
 
 func (Artifact) IsNode() {}
 
+// ArtifactConnection returns the paginated results for Artifact.
+//
+// totalCount is the total number of Artifact nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the ArtifactEdge which contains the current cursor and the
+// Artifact node itself.
+type ArtifactConnection struct {
+	TotalCount int             `json:"totalCount"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	Edges      []*ArtifactEdge `json:"edges"`
+}
+
+// ArtifactEdge contains the cursor for the resulting node and the Artifact node
+// itself.
+type ArtifactEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Artifact `json:"node"`
+}
+
 // ArtifactInputSpec specifies an artifact for mutations.
 //
 // The checksum fields are canonicalized to be lowercase.
@@ -79,6 +101,28 @@ type Builder struct {
 
 func (Builder) IsNode() {}
 
+// BuilderConnection returns the paginated results for Builder.
+//
+// totalCount is the total number of Builder nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the BuilderEdge which contains the current cursor and the
+// Builder node itself.
+type BuilderConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*BuilderEdge `json:"edges"`
+}
+
+// BuilderEdge contains the cursor for the resulting node and the Builder node
+// itself.
+type BuilderEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Builder `json:"node"`
+}
+
 // BuilderInputSpec specifies a builder for mutations.
 type BuilderInputSpec struct {
 	URI string `json:"uri"`
@@ -107,18 +151,47 @@ type CertifyBad struct {
 	Justification string                  `json:"justification"`
 	Origin        string                  `json:"origin"`
 	Collector     string                  `json:"collector"`
-	KnownSince    time.Time               `json:"knownSince"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer     *string   `json:"signer,omitempty"`
+	KnownSince time.Time `json:"knownSince"`
 }
 
 func (CertifyBad) IsNode() {}
 
+// CertifyBadConnection returns the paginated results for CertifyBad.
+//
+// totalCount is the total number of CertifyBad nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the CertifyBadEdge which contains the current cursor and the
+// CertifyBad node itself.
+type CertifyBadConnection struct {
+	TotalCount int               `json:"totalCount"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	Edges      []*CertifyBadEdge `json:"edges"`
+}
+
+// CertifyBadEdge contains the cursor for the resulting node and the CertifyBad node
+// itself.
+type CertifyBadEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *CertifyBad `json:"node"`
+}
+
 // CertifyBadInputSpec represents the mutation input to ingest a CertifyBad
 // evidence.
 type CertifyBadInputSpec struct {
-	Justification string    `json:"justification"`
-	Origin        string    `json:"origin"`
-	Collector     string    `json:"collector"`
-	KnownSince    time.Time `json:"knownSince"`
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified   *bool     `json:"verified,omitempty"`
+	Signer     *string   `json:"signer,omitempty"`
+	KnownSince time.Time `json:"knownSince"`
 }
 
 // CertifyBadSpec allows filtering the list of CertifyBad evidence to return in a
@@ -159,17 +232,46 @@ type CertifyGood struct {
 	Justification string                  `json:"justification"`
 	Origin        string                  `json:"origin"`
 	Collector     string                  `json:"collector"`
-	KnownSince    time.Time               `json:"knownSince"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer     *string   `json:"signer,omitempty"`
+	KnownSince time.Time `json:"knownSince"`
 }
 
 func (CertifyGood) IsNode() {}
 
+// CertifyGoodConnection returns the paginated results for CertifyGood.
+//
+// totalCount is the total number of CertifyGood nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the CertifyGoodEdge which contains the current cursor and the
+// CertifyGood node itself.
+type CertifyGoodConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*CertifyGoodEdge `json:"edges"`
+}
+
+// CertifyGoodEdge contains the cursor for the resulting node and the CertifyGood node
+// itself.
+type CertifyGoodEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *CertifyGood `json:"node"`
+}
+
 // CertifyGoodInputSpec represents the mutation input to ingest a CertifyGood evidence.
 type CertifyGoodInputSpec struct {
-	Justification string    `json:"justification"`
-	Origin        string    `json:"origin"`
-	Collector     string    `json:"collector"`
-	KnownSince    time.Time `json:"knownSince"`
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified   *bool     `json:"verified,omitempty"`
+	Signer     *string   `json:"signer,omitempty"`
+	KnownSince time.Time `json:"knownSince"`
 }
 
 // CertifyBadSpec allows filtering the list of CertifyBad evidence to return in a
@@ -229,10 +331,36 @@ type CertifyLegal struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (CertifyLegal) IsNode() {}
 
+// CertifyLegalConnection returns the paginated results for CertifyLegal.
+//
+// totalCount is the total number of CertifyLegal nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the CertifyLegalEdge which contains the current cursor and the
+// CertifyLegal node itself.
+type CertifyLegalConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*CertifyLegalEdge `json:"edges"`
+}
+
+// CertifyLegalEdge contains the cursor for the resulting node and the CertifyLegal node
+// itself.
+type CertifyLegalEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *CertifyLegal `json:"node"`
+}
+
 // CertifyLegalInputSpec represents the input for certifying legal information in
 // mutations.
 type CertifyLegalInputSpec struct {
@@ -243,6 +371,9 @@ type CertifyLegalInputSpec struct {
 	TimeScanned       time.Time `json:"timeScanned"`
 	Origin            string    `json:"origin"`
 	Collector         string    `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // CertifyLegalSpec allows filtering the list of legal certifications to
@@ -276,6 +407,28 @@ type CertifyScorecard struct {
 
 func (CertifyScorecard) IsNode() {}
 
+// CertifyScorecardConnection returns the paginated results for CertifyScorecard.
+//
+// totalCount is the total number of CertifyScorecard nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the CertifyScorecardEdge which contains the current cursor and the
+// CertifyScorecard node itself.
+type CertifyScorecardConnection struct {
+	TotalCount int                     `json:"totalCount"`
+	PageInfo   *PageInfo               `json:"pageInfo"`
+	Edges      []*CertifyScorecardEdge `json:"edges"`
+}
+
+// CertifyScorecardEdge contains the cursor for the resulting node and the CertifyScorecard node
+// itself.
+type CertifyScorecardEdge struct {
+	Cursor string            `json:"cursor"`
+	Node   *CertifyScorecard `json:"node"`
+}
+
 // CertifyScorecardSpec allows filtering the list of Scorecards to return.
 type CertifyScorecardSpec struct {
 	ID               *string               `json:"id,omitempty"`
@@ -311,10 +464,36 @@ type CertifyVEXStatement struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (CertifyVEXStatement) IsNode() {}
 
+// CertifyVEXStatementConnection returns the paginated results for CertifyVEXStatement.
+//
+// totalCount is the total number of CertifyVEXStatement nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the CertifyVEXStatementEdge which contains the current cursor and the
+// CertifyVEXStatement node itself.
+type CertifyVEXStatementConnection struct {
+	TotalCount int                        `json:"totalCount"`
+	PageInfo   *PageInfo                  `json:"pageInfo"`
+	Edges      []*CertifyVEXStatementEdge `json:"edges"`
+}
+
+// CertifyVEXStatementEdge contains the cursor for the resulting node and the CertifyVEXStatement node
+// itself.
+type CertifyVEXStatementEdge struct {
+	Cursor string               `json:"cursor"`
+	Node   *CertifyVEXStatement `json:"node"`
+}
+
 // CertifyVEXStatementSpec allows filtering the list of VEX statements to
 // return in a query.
 //
@@ -351,6 +530,28 @@ type CertifyVuln struct {
 
 func (CertifyVuln) IsNode() {}
 
+// CertifyVulnConnection returns the paginated results for CertifyVuln.
+//
+// totalCount is the total number of CertifyVuln nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the CertifyVulnEdge which contains the current cursor and the
+// CertifyVuln node itself.
+type CertifyVulnConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*CertifyVulnEdge `json:"edges"`
+}
+
+// CertifyVulnEdge contains the cursor for the resulting node and the CertifyVuln node
+// itself.
+type CertifyVulnEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *CertifyVuln `json:"node"`
+}
+
 // CertifyVulnSpec allows filtering the list of vulnerability certifications to
 // return in a query.
 //
@@ -395,10 +596,36 @@ type HasMetadata struct {
 	Justification string                  `json:"justification"`
 	Origin        string                  `json:"origin"`
 	Collector     string                  `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (HasMetadata) IsNode() {}
 
+// HasMetadataConnection returns the paginated results for HasMetadata.
+//
+// totalCount is the total number of HasMetadata nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the HasMetadataEdge which contains the current cursor and the
+// HasMetadata node itself.
+type HasMetadataConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*HasMetadataEdge `json:"edges"`
+}
+
+// HasMetadataEdge contains the cursor for the resulting node and the HasMetadata node
+// itself.
+type HasMetadataEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *HasMetadata `json:"node"`
+}
+
 // HasMetadataInputSpec represents the mutation input to ingest a CertifyGood evidence.
 type HasMetadataInputSpec struct {
 	Key           string    `json:"key"`
@@ -407,6 +634,9 @@ type HasMetadataInputSpec struct {
 	Justification string    `json:"justification"`
 	Origin        string    `json:"origin"`
 	Collector     string    `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // HasMetadataSpec allows filtering the list of HasMetadata evidence to return in a
@@ -447,6 +677,10 @@ type HasSbom struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 	// Timestamp for SBOM creation
 	KnownSince time.Time `json:"knownSince"`
 	// Included packages and artifacts
@@ -459,6 +693,28 @@ type HasSbom struct {
 
 func (HasSbom) IsNode() {}
 
+// HasSBOMConnection returns the paginated results for HasSBOM.
+//
+// totalCount is the total number of HasSBOM nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the HasSBOMEdge which contains the current cursor and the
+// HasSBOM node itself.
+type HasSBOMConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*HasSBOMEdge `json:"edges"`
+}
+
+// HasSBOMEdge contains the cursor for the resulting node and the HasSBOM node
+// itself.
+type HasSBOMEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *HasSbom `json:"node"`
+}
+
 type HasSBOMIncludesInputSpec struct {
 	Software     []string `json:"software"`
 	Dependencies []string `json:"dependencies"`
@@ -467,13 +723,16 @@ type HasSBOMIncludesInputSpec struct {
 
 // HasSBOMInputSpec is similar to HasSBOM but for mutation input.
 type HasSBOMInputSpec struct {
-	URI              string    `json:"uri"`
-	Algorithm        string    `json:"algorithm"`
-	Digest           string    `json:"digest"`
-	DownloadLocation string    `json:"downloadLocation"`
-	Origin           string    `json:"origin"`
-	Collector        string    `json:"collector"`
-	KnownSince       time.Time `json:"knownSince"`
+	URI              string `json:"uri"`
+	Algorithm        string `json:"algorithm"`
+	Digest           string `json:"digest"`
+	DownloadLocation string `json:"downloadLocation"`
+	Origin           string `json:"origin"`
+	Collector        string `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified   *bool     `json:"verified,omitempty"`
+	Signer     *string   `json:"signer,omitempty"`
+	KnownSince time.Time `json:"knownSince"`
 }
 
 // HasSBOMSpec allows filtering the list of HasSBOM to return.
@@ -508,6 +767,28 @@ type HasSlsa struct {
 
 func (HasSlsa) IsNode() {}
 
+// HasSLSAConnection returns the paginated results for HasSLSA.
+//
+// totalCount is the total number of HasSLSA nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the HasSLSAEdge which contains the current cursor and the
+// HasSLSA node itself.
+type HasSLSAConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*HasSLSAEdge `json:"edges"`
+}
+
+// HasSLSAEdge contains the cursor for the resulting node and the HasSLSA node
+// itself.
+type HasSLSAEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *HasSlsa `json:"node"`
+}
+
 // HasSLSASpec allows filtering the list of HasSLSA to return.
 type HasSLSASpec struct {
 	ID          *string              `json:"id,omitempty"`
@@ -538,16 +819,45 @@ type HasSourceAt struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (HasSourceAt) IsNode() {}
 
+// HasSourceAtConnection returns the paginated results for HasSourceAt.
+//
+// totalCount is the total number of HasSourceAt nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the HasSourceAtEdge which contains the current cursor and the
+// HasSourceAt node itself.
+type HasSourceAtConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*HasSourceAtEdge `json:"edges"`
+}
+
+// HasSourceAtEdge contains the cursor for the resulting node and the HasSourceAt node
+// itself.
+type HasSourceAtEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *HasSourceAt `json:"node"`
+}
+
 // HasSourceAtInputSpec is the same as HasSourceAt but for mutation input.
 type HasSourceAtInputSpec struct {
 	KnownSince    time.Time `json:"knownSince"`
 	Justification string    `json:"justification"`
 	Origin        string    `json:"origin"`
 	Collector     string    `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // HasSourceAtSpec allows filtering the list of HasSourceAt to return.
@@ -572,15 +882,44 @@ type HashEqual struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (HashEqual) IsNode() {}
 
+// HashEqualConnection returns the paginated results for HashEqual.
+//
+// totalCount is the total number of HashEqual nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the HashEqualEdge which contains the current cursor and the
+// HashEqual node itself.
+type HashEqualConnection struct {
+	TotalCount int              `json:"totalCount"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+	Edges      []*HashEqualEdge `json:"edges"`
+}
+
+// HashEqualEdge contains the cursor for the resulting node and the HashEqual node
+// itself.
+type HashEqualEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *HashEqual `json:"node"`
+}
+
 // HashEqualInputSpec represents the input to certify that packages are similar.
 type HashEqualInputSpec struct {
 	Justification string `json:"justification"`
 	Origin        string `json:"origin"`
 	Collector     string `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // HashEqualSpec allows filtering the list of artifact equality statements to
@@ -607,24 +946,57 @@ type IsDependency struct {
 	VersionRange string `json:"versionRange"`
 	// Type of dependency
 	DependencyType DependencyType `json:"dependencyType"`
+	// Scope of the dependency
+	DependencyScope DependencyScope `json:"dependencyScope"`
 	// Justification for the attested relationship
 	Justification string `json:"justification"`
 	// Document from which this attestation is generated from
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (IsDependency) IsNode() {}
 
+// IsDependencyConnection returns the paginated results for IsDependency.
+//
+// totalCount is the total number of IsDependency nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the IsDependencyEdge which contains the current cursor and the
+// IsDependency node itself.
+type IsDependencyConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*IsDependencyEdge `json:"edges"`
+}
+
+// IsDependencyEdge contains the cursor for the resulting node and the IsDependency node
+// itself.
+type IsDependencyEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *IsDependency `json:"node"`
+}
+
 // IsDependencyInputSpec is the input to record a new dependency.
 type IsDependencyInputSpec struct {
 	// versionRange should be specified for depedentPackages that point to PackageName
 	VersionRange   string         `json:"versionRange"`
 	DependencyType DependencyType `json:"dependencyType"`
-	Justification  string         `json:"justification"`
-	Origin         string         `json:"origin"`
-	Collector      string         `json:"collector"`
+	// dependencyScope defaults to UNKNOWN when not specified
+	DependencyScope *DependencyScope `json:"dependencyScope,omitempty"`
+	Justification   string           `json:"justification"`
+	Origin          string           `json:"origin"`
+	Collector       string           `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // IsDependencySpec allows filtering the list of dependencies to return.
@@ -633,15 +1005,21 @@ type IsDependencyInputSpec struct {
 // field.
 //
 // Dependency packages must be defined at PackageName, not PackageVersion.
+//
+// dependencyScope only returns the dependencies of that scope, while
+// excludeDependencyScopes leaves out the dependencies of any of the listed
+// scopes, for example to ignore the dev and test only dependencies.
 type IsDependencySpec struct {
-	ID                *string         `json:"id,omitempty"`
-	Package           *PkgSpec        `json:"package,omitempty"`
-	DependencyPackage *PkgSpec        `json:"dependencyPackage,omitempty"`
-	VersionRange      *string         `json:"versionRange,omitempty"`
-	DependencyType    *DependencyType `json:"dependencyType,omitempty"`
-	Justification     *string         `json:"justification,omitempty"`
-	Origin            *string         `json:"origin,omitempty"`
-	Collector         *string         `json:"collector,omitempty"`
+	ID                      *string           `json:"id,omitempty"`
+	Package                 *PkgSpec          `json:"package,omitempty"`
+	DependencyPackage       *PkgSpec          `json:"dependencyPackage,omitempty"`
+	VersionRange            *string           `json:"versionRange,omitempty"`
+	DependencyType          *DependencyType   `json:"dependencyType,omitempty"`
+	DependencyScope         *DependencyScope  `json:"dependencyScope,omitempty"`
+	ExcludeDependencyScopes []DependencyScope `json:"excludeDependencyScopes,omitempty"`
+	Justification           *string           `json:"justification,omitempty"`
+	Origin                  *string           `json:"origin,omitempty"`
+	Collector               *string           `json:"collector,omitempty"`
 }
 
 // IsOccurrence is an attestation to link an artifact to a package or source.
@@ -659,15 +1037,44 @@ type IsOccurrence struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (IsOccurrence) IsNode() {}
 
+// IsOccurrenceConnection returns the paginated results for IsOccurrence.
+//
+// totalCount is the total number of IsOccurrence nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the IsOccurrenceEdge which contains the current cursor and the
+// IsOccurrence node itself.
+type IsOccurrenceConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*IsOccurrenceEdge `json:"edges"`
+}
+
+// IsOccurrenceEdge contains the cursor for the resulting node and the IsOccurrence node
+// itself.
+type IsOccurrenceEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *IsOccurrence `json:"node"`
+}
+
 // IsOccurrenceInputSpec represents the input to record an artifact's origin.
 type IsOccurrenceInputSpec struct {
 	Justification string `json:"justification"`
 	Origin        string `json:"origin"`
 	Collector     string `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // IsOccurrenceSpec allows filtering the list of artifact occurences to return in
@@ -715,6 +1122,28 @@ type License struct {
 
 func (License) IsNode() {}
 
+// LicenseConnection returns the paginated results for License.
+//
+// totalCount is the total number of License nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the LicenseEdge which contains the current cursor and the
+// License node itself.
+type LicenseConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*LicenseEdge `json:"edges"`
+}
+
+// LicenseEdge contains the cursor for the resulting node and the License node
+// itself.
+type LicenseEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *License `json:"node"`
+}
+
 // LicenseInputSpec specifies an license for mutations. One of inline or
 // listVersion should be empty or missing.
 type LicenseInputSpec struct {
@@ -764,6 +1193,28 @@ func (Package) IsPackageOrSource() {}
 
 func (Package) IsNode() {}
 
+// PackageConnection returns the paginated results for Package.
+//
+// totalCount is the total number of Package nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the PackageEdge which contains the current cursor and the
+// Package node itself.
+type PackageConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*PackageEdge `json:"edges"`
+}
+
+// PackageEdge contains the cursor for the resulting node and the Package node
+// itself.
+type PackageEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Package `json:"node"`
+}
+
 // The IDs of the ingested package
 type PackageIDs struct {
 	PackageTypeID      string `json:"packageTypeID"`
@@ -936,6 +1387,19 @@ type PackageVersion struct {
 	Subpath    string              `json:"subpath"`
 }
 
+// PageInfo serves the client information about the paginated query results.
+//
+// hasNextPage is true when there are results to be returned after this page.
+//
+// startCursor is the cursor of the first node in the page (null for empty pages).
+//
+// endCursor is the cursor of the last node in the page (null for empty pages).
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	StartCursor *string `json:"startCursor,omitempty"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
+
 // PkgEqual is an attestation that a set of packages are similar.
 type PkgEqual struct {
 	ID string `json:"id"`
@@ -947,15 +1411,44 @@ type PkgEqual struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (PkgEqual) IsNode() {}
 
+// PkgEqualConnection returns the paginated results for PkgEqual.
+//
+// totalCount is the total number of PkgEqual nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the PkgEqualEdge which contains the current cursor and the
+// PkgEqual node itself.
+type PkgEqualConnection struct {
+	TotalCount int             `json:"totalCount"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	Edges      []*PkgEqualEdge `json:"edges"`
+}
+
+// PkgEqualEdge contains the cursor for the resulting node and the PkgEqual node
+// itself.
+type PkgEqualEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *PkgEqual `json:"node"`
+}
+
 // PkgEqualInputSpec represents the input to certify that packages are similar.
 type PkgEqualInputSpec struct {
 	Justification string `json:"justification"`
 	Origin        string `json:"origin"`
 	Collector     string `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // PkgEqualSpec allows filtering the list of package equality statements to return
@@ -1037,10 +1530,36 @@ type PointOfContact struct {
 	Justification string                  `json:"justification"`
 	Origin        string                  `json:"origin"`
 	Collector     string                  `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (PointOfContact) IsNode() {}
 
+// PointOfContactConnection returns the paginated results for PointOfContact.
+//
+// totalCount is the total number of PointOfContact nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the PointOfContactEdge which contains the current cursor and the
+// PointOfContact node itself.
+type PointOfContactConnection struct {
+	TotalCount int                   `json:"totalCount"`
+	PageInfo   *PageInfo             `json:"pageInfo"`
+	Edges      []*PointOfContactEdge `json:"edges"`
+}
+
+// PointOfContactEdge contains the cursor for the resulting node and the PointOfContact node
+// itself.
+type PointOfContactEdge struct {
+	Cursor string          `json:"cursor"`
+	Node   *PointOfContact `json:"node"`
+}
+
 // PointOfContactInputSpec represents the mutation input to ingest a PointOfContact evidence.
 type PointOfContactInputSpec struct {
 	Email         string    `json:"email"`
@@ -1049,6 +1568,9 @@ type PointOfContactInputSpec struct {
 	Justification string    `json:"justification"`
 	Origin        string    `json:"origin"`
 	Collector     string    `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // PointOfContactSpec allows filtering the list of PointOfContact evidence to return in a
@@ -1100,6 +1622,10 @@ type Slsa struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 // SLSAInputSpec is the same as SLSA but for mutation input.
@@ -1111,6 +1637,9 @@ type SLSAInputSpec struct {
 	FinishedOn    *time.Time                `json:"finishedOn,omitempty"`
 	Origin        string                    `json:"origin"`
 	Collector     string                    `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // SLSAPredicate are the values from the SLSA predicate in key-value pair form.
@@ -1175,6 +1704,10 @@ type ScanMetadata struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 // ScanMetadataInput represents the input for certifying vulnerability
@@ -1187,6 +1720,9 @@ type ScanMetadataInput struct {
 	ScannerVersion string    `json:"scannerVersion"`
 	Origin         string    `json:"origin"`
 	Collector      string    `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // Scorecard contains all of the fields present in a Scorecard attestation.
@@ -1209,6 +1745,10 @@ type Scorecard struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 // ScorecardCheck are the individual checks from scorecard and their values as a
@@ -1253,6 +1793,9 @@ type ScorecardInputSpec struct {
 	ScorecardCommit  string                     `json:"scorecardCommit"`
 	Origin           string                     `json:"origin"`
 	Collector        string                     `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // Source represents the root of the source trie/tree.
@@ -1278,6 +1821,28 @@ func (Source) IsPackageOrSource() {}
 
 func (Source) IsNode() {}
 
+// SourceConnection returns the paginated results for Source.
+//
+// totalCount is the total number of Source nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the SourceEdge which contains the current cursor and the
+// Source node itself.
+type SourceConnection struct {
+	TotalCount int           `json:"totalCount"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	Edges      []*SourceEdge `json:"edges"`
+}
+
+// SourceEdge contains the cursor for the resulting node and the Source node
+// itself.
+type SourceEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Source `json:"node"`
+}
+
 // The IDs of the ingested source
 type SourceIDs struct {
 	SourceTypeID      string `json:"sourceTypeID"`
@@ -1351,6 +1916,9 @@ type VexStatementInputSpec struct {
 	KnownSince       time.Time        `json:"knownSince"`
 	Origin           string           `json:"origin"`
 	Collector        string           `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // VulnEqual is an attestation to link two vulnerabilities together as being equal"
@@ -1366,15 +1934,44 @@ type VulnEqual struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (VulnEqual) IsNode() {}
 
+// VulnEqualConnection returns the paginated results for VulnEqual.
+//
+// totalCount is the total number of VulnEqual nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the VulnEqualEdge which contains the current cursor and the
+// VulnEqual node itself.
+type VulnEqualConnection struct {
+	TotalCount int              `json:"totalCount"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+	Edges      []*VulnEqualEdge `json:"edges"`
+}
+
+// VulnEqualEdge contains the cursor for the resulting node and the VulnEqual node
+// itself.
+type VulnEqualEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *VulnEqual `json:"node"`
+}
+
 // VulnEqualInputSpec represents the input to link vulnerabilities to each other.
 type VulnEqualInputSpec struct {
 	Justification string `json:"justification"`
 	Origin        string `json:"origin"`
 	Collector     string `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // VulnEqualSpec allows filtering the list of vulnerability links to return
@@ -1420,6 +2017,28 @@ type Vulnerability struct {
 
 func (Vulnerability) IsNode() {}
 
+// VulnerabilityConnection returns the paginated results for Vulnerability.
+//
+// totalCount is the total number of Vulnerability nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the VulnerabilityEdge which contains the current cursor and the
+// Vulnerability node itself.
+type VulnerabilityConnection struct {
+	TotalCount int                  `json:"totalCount"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+	Edges      []*VulnerabilityEdge `json:"edges"`
+}
+
+// VulnerabilityEdge contains the cursor for the resulting node and the Vulnerability node
+// itself.
+type VulnerabilityEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *Vulnerability `json:"node"`
+}
+
 // VulnerabilityID is a specific vulnerability ID associated with the type of the vulnerability.
 //
 // This will be enforced to be all lowercase.
@@ -1474,10 +2093,36 @@ type VulnerabilityMetadata struct {
 	Timestamp     time.Time              `json:"timestamp"`
 	Origin        string                 `json:"origin"`
 	Collector     string                 `json:"collector"`
+	// Whether the trust policy trusts a verified signer of the document, null if its signatures were not checked. Kept from the first ingestion of the node.
+	Verified *bool `json:"verified,omitempty"`
+	// Identity that signed the document, the trusted one if verified. Kept from the first ingestion of the node.
+	Signer *string `json:"signer,omitempty"`
 }
 
 func (VulnerabilityMetadata) IsNode() {}
 
+// VulnerabilityMetadataConnection returns the paginated results for VulnerabilityMetadata.
+//
+// totalCount is the total number of VulnerabilityMetadata nodes matching the filter.
+//
+// pageInfo provides information to the client if there is a next page of
+// results and the starting and ending cursor for the current set.
+//
+// edges contains the VulnerabilityMetadataEdge which contains the current cursor and the
+// VulnerabilityMetadata node itself.
+type VulnerabilityMetadataConnection struct {
+	TotalCount int                          `json:"totalCount"`
+	PageInfo   *PageInfo                    `json:"pageInfo"`
+	Edges      []*VulnerabilityMetadataEdge `json:"edges"`
+}
+
+// VulnerabilityMetadataEdge contains the cursor for the resulting node and the VulnerabilityMetadata node
+// itself.
+type VulnerabilityMetadataEdge struct {
+	Cursor string                 `json:"cursor"`
+	Node   *VulnerabilityMetadata `json:"node"`
+}
+
 // VulnerabilityMetadataInputSpec represents the mutation input to ingest a vulnerability metadata.
 type VulnerabilityMetadataInputSpec struct {
 	ScoreType  VulnerabilityScoreType `json:"scoreType"`
@@ -1485,6 +2130,9 @@ type VulnerabilityMetadataInputSpec struct {
 	Timestamp  time.Time              `json:"timestamp"`
 	Origin     string                 `json:"origin"`
 	Collector  string                 `json:"collector"`
+	// verified records whether the trust policy trusts a verified signer of the document, and is left out if its signatures were not checked
+	Verified *bool   `json:"verified,omitempty"`
+	Signer   *string `json:"signer,omitempty"`
 }
 
 // VulnerabilityMetadataSpec allows filtering the list of VulnerabilityMetadata evidence
@@ -1571,6 +2219,69 @@ func (e Comparator) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// DependencyScope determines for what the dependency is needed.
+//
+// Dependencies needed only to develop, build or test the package, or which are
+// optional, can be excluded when looking for the dependencies of what is
+// deployed.
+type DependencyScope string
+
+const (
+	// dependency needed at runtime
+	DependencyScopeRuntime DependencyScope = "RUNTIME"
+	// dependency needed only for development
+	DependencyScopeDev DependencyScope = "DEV"
+	// dependency needed only to build the package
+	DependencyScopeBuild DependencyScope = "BUILD"
+	// dependency needed only to test the package
+	DependencyScopeTest DependencyScope = "TEST"
+	// optional dependency
+	DependencyScopeOptional DependencyScope = "OPTIONAL"
+	// dependency expected to be provided by the runtime environment
+	DependencyScopeProvided DependencyScope = "PROVIDED"
+	// scope not known/not specified
+	DependencyScopeUnknown DependencyScope = "UNKNOWN"
+)
+
+var AllDependencyScope = []DependencyScope{
+	DependencyScopeRuntime,
+	DependencyScopeDev,
+	DependencyScopeBuild,
+	DependencyScopeTest,
+	DependencyScopeOptional,
+	DependencyScopeProvided,
+	DependencyScopeUnknown,
+}
+
+func (e DependencyScope) IsValid() bool {
+	switch e {
+	case DependencyScopeRuntime, DependencyScopeDev, DependencyScopeBuild, DependencyScopeTest, DependencyScopeOptional, DependencyScopeProvided, DependencyScopeUnknown:
+		return true
+	}
+	return false
+}
+
+func (e DependencyScope) String() string {
+	return string(e)
+}
+
+func (e *DependencyScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DependencyScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DependencyScope", str)
+	}
+	return nil
+}
+
+func (e DependencyScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // DependencyType determines the type of the dependency.
 type DependencyType string
 
@@ -1880,6 +2591,53 @@ func (e PkgMatchType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Role of an authenticated client.
+type Role string
+
+const (
+	// May run queries and subscriptions.
+	RoleReader Role = "READER"
+	// May also ingest new nodes.
+	RoleWriter Role = "WRITER"
+	// May also delete or retract nodes.
+	RoleAdmin Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleReader,
+	RoleWriter,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleReader, RoleWriter, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Records the justification included in the VEX statement.
 type VexJustification string
 
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -21,7 +22,12 @@ func (r *mutationResolver) IngestCertifyBad(ctx context.Context, subject model.P
 	if certifyBad.KnownSince.IsZero() {
 		return "", gqlerror.Errorf("certifyBad.KnownSince is a zero time")
 	}
-	return r.Backend.IngestCertifyBad(ctx, subject, &pkgMatchType, certifyBad)
+	id, err := r.Backend.IngestCertifyBad(ctx, subject, &pkgMatchType, certifyBad)
+	if err != nil {
+		return "", err
+	}
+	r.Broker.publishCertifyBads(ctx, id)
+	return id, nil
 }
 
 // IngestCertifyBads is the resolver for the ingestCertifyBads field.
@@ -57,7 +63,17 @@ func (r *mutationResolver) IngestCertifyBads(ctx context.Context, subjects model
 		}
 	}
 
-	return r.Backend.IngestCertifyBads(ctx, subjects, &pkgMatchType, certifyBads)
+	ids, err := r.Backend.IngestCertifyBads(ctx, subjects, &pkgMatchType, certifyBads)
+	if err != nil {
+		return nil, err
+	}
+	r.Broker.publishCertifyBads(ctx, ids...)
+	return ids, nil
+}
+
+// DeleteCertifyBad is the resolver for the deleteCertifyBad field.
+func (r *mutationResolver) DeleteCertifyBad(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteCertifyBad(ctx, id)
 }
 
 // CertifyBad is the resolver for the CertifyBad field.
@@ -67,3 +83,36 @@ func (r *queryResolver) CertifyBad(ctx context.Context, certifyBadSpec model.Cer
 	}
 	return r.Backend.CertifyBad(ctx, &certifyBadSpec)
 }
+
+// CertifyBadList is the resolver for the CertifyBadList field.
+func (r *queryResolver) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(certifyBadSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("CertifyBadList :: %s", err)
+	}
+	return r.Backend.CertifyBadList(ctx, &certifyBadSpec, after, first)
+}
+
+// CertifyBadAdded is the resolver for the certifyBadAdded field.
+func (r *subscriptionResolver) CertifyBadAdded(ctx context.Context, filter *model.CertifyBadSpec) (<-chan *model.CertifyBad, error) {
+	funcName := "CertifyBadAdded"
+	if r.Broker == nil {
+		return nil, gqlerror.Errorf("%v :: subscriptions are not enabled", funcName)
+	}
+	var spec model.CertifyBadSpec
+	if filter != nil {
+		spec = *filter
+	}
+	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(spec.Subject); err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	return r.Broker.certifyBads.subscribe(ctx, func(ctx context.Context, id string) ([]*model.CertifyBad, error) {
+		spec := spec
+		spec.ID = &id
+		return r.Query().CertifyBad(ctx, spec)
+	}), nil
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -60,6 +60,11 @@ func (r *mutationResolver) IngestCertifyGoods(ctx context.Context, subjects mode
 	return r.Backend.IngestCertifyGoods(ctx, subjects, &pkgMatchType, certifyGoods)
 }
 
+// DeleteCertifyGood is the resolver for the deleteCertifyGood field.
+func (r *mutationResolver) DeleteCertifyGood(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteCertifyGood(ctx, id)
+}
+
 // CertifyGood is the resolver for the CertifyGood field.
 func (r *queryResolver) CertifyGood(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
 	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(certifyGoodSpec.Subject); err != nil {
@@ -67,3 +72,11 @@ func (r *queryResolver) CertifyGood(ctx context.Context, certifyGoodSpec model.C
 	}
 	return r.Backend.CertifyGood(ctx, &certifyGoodSpec)
 }
+
+// CertifyGoodList is the resolver for the CertifyGoodList field.
+func (r *queryResolver) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
+	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(certifyGoodSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("CertifyGoodList :: %s", err)
+	}
+	return r.Backend.CertifyGoodList(ctx, &certifyGoodSpec, after, first)
+}
@@ -48,6 +48,11 @@ func (r *mutationResolver) IngestCertifyLegals(ctx context.Context, subjects mod
 	return r.Backend.IngestCertifyLegals(ctx, subjects, declaredLicensesList, discoveredLicensesList, certifyLegals)
 }
 
+// DeleteCertifyLegal is the resolver for the deleteCertifyLegal field.
+func (r *mutationResolver) DeleteCertifyLegal(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteCertifyLegal(ctx, id)
+}
+
 // CertifyLegal is the resolver for the CertifyLegal field.
 func (r *queryResolver) CertifyLegal(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
 	if err := helper.ValidatePackageOrSourceQueryFilter(certifyLegalSpec.Subject); err != nil {
@@ -56,3 +61,12 @@ func (r *queryResolver) CertifyLegal(ctx context.Context, certifyLegalSpec model
 
 	return r.Backend.CertifyLegal(ctx, &certifyLegalSpec)
 }
+
+// CertifyLegalList is the resolver for the CertifyLegalList field.
+func (r *queryResolver) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	if err := helper.ValidatePackageOrSourceQueryFilter(certifyLegalSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("CertifyLegalList :: %v", err)
+	}
+
+	return r.Backend.CertifyLegalList(ctx, &certifyLegalSpec, after, first)
+}
@@ -26,7 +26,17 @@ func (r *mutationResolver) IngestScorecards(ctx context.Context, sources []*mode
 	return r.Backend.IngestScorecards(ctx, sources, scorecards)
 }
 
+// DeleteCertifyScorecard is the resolver for the deleteCertifyScorecard field.
+func (r *mutationResolver) DeleteCertifyScorecard(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteCertifyScorecard(ctx, id)
+}
+
 // Scorecards is the resolver for the scorecards field.
 func (r *queryResolver) Scorecards(ctx context.Context, scorecardSpec model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	return r.Backend.Scorecards(ctx, &scorecardSpec)
 }
+
+// ScorecardsList is the resolver for the scorecardsList field.
+func (r *queryResolver) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	return r.Backend.ScorecardsList(ctx, &scorecardSpec, after, first)
+}
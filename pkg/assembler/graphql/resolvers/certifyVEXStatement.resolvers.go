@@ -34,9 +34,14 @@ func (r *mutationResolver) IngestVEXStatement(ctx context.Context, subject model
 	}
 
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
-	return r.Backend.IngestVEXStatement(ctx, subject,
+	id, err := r.Backend.IngestVEXStatement(ctx, subject,
 		model.VulnerabilityInputSpec{Type: strings.ToLower(vulnerability.Type), VulnerabilityID: strings.ToLower(vulnerability.VulnerabilityID)},
 		vexStatement)
+	if err != nil {
+		return "", err
+	}
+	r.Broker.publishCertifyVEXStatements(ctx, id)
+	return id, nil
 }
 
 // IngestVEXStatements is the resolver for the ingestVEXStatements field.
@@ -81,7 +86,17 @@ func (r *mutationResolver) IngestVEXStatements(ctx context.Context, subjects mod
 		}
 		lowercaseVulnInputList = append(lowercaseVulnInputList, &lowercaseVulnInput)
 	}
-	return r.Backend.IngestVEXStatements(ctx, subjects, lowercaseVulnInputList, vexStatements)
+	ids, err := r.Backend.IngestVEXStatements(ctx, subjects, lowercaseVulnInputList, vexStatements)
+	if err != nil {
+		return nil, err
+	}
+	r.Broker.publishCertifyVEXStatements(ctx, ids...)
+	return ids, nil
+}
+
+// DeleteCertifyVEXStatement is the resolver for the deleteCertifyVEXStatement field.
+func (r *mutationResolver) DeleteCertifyVEXStatement(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteCertifyVEXStatement(ctx, id)
 }
 
 // CertifyVEXStatement is the resolver for the CertifyVEXStatement field.
@@ -128,3 +143,53 @@ func (r *queryResolver) CertifyVEXStatement(ctx context.Context, certifyVEXState
 		return r.Backend.CertifyVEXStatement(ctx, &certifyVEXStatementSpec)
 	}
 }
+
+// CertifyVEXStatementList is the resolver for the CertifyVEXStatementList field.
+func (r *queryResolver) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	if err := helper.ValidatePackageOrArtifactQueryFilter(certifyVEXStatementSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("CertifyVEXStatementList :: %s", err)
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+
+	if certifyVEXStatementSpec.Vulnerability != nil {
+		var typeLowerCase *string = nil
+		var vulnIDLowerCase *string = nil
+		if certifyVEXStatementSpec.Vulnerability.Type != nil {
+			lower := strings.ToLower(*certifyVEXStatementSpec.Vulnerability.Type)
+			typeLowerCase = &lower
+		}
+		if certifyVEXStatementSpec.Vulnerability.VulnerabilityID != nil {
+			lower := strings.ToLower(*certifyVEXStatementSpec.Vulnerability.VulnerabilityID)
+			vulnIDLowerCase = &lower
+		}
+
+		certifyVEXStatementSpec.Vulnerability = &model.VulnerabilitySpec{
+			ID:              certifyVEXStatementSpec.Vulnerability.ID,
+			Type:            typeLowerCase,
+			VulnerabilityID: vulnIDLowerCase,
+			NoVuln:          certifyVEXStatementSpec.Vulnerability.NoVuln,
+		}
+	}
+	return r.Backend.CertifyVEXStatementList(ctx, &certifyVEXStatementSpec, after, first)
+}
+
+// CertifyVEXStatementAdded is the resolver for the certifyVEXStatementAdded field.
+func (r *subscriptionResolver) CertifyVEXStatementAdded(ctx context.Context, filter *model.CertifyVEXStatementSpec) (<-chan *model.CertifyVEXStatement, error) {
+	funcName := "CertifyVEXStatementAdded"
+	if r.Broker == nil {
+		return nil, gqlerror.Errorf("%v :: subscriptions are not enabled", funcName)
+	}
+	var spec model.CertifyVEXStatementSpec
+	if filter != nil {
+		spec = *filter
+	}
+	if err := helper.ValidatePackageOrArtifactQueryFilter(spec.Subject); err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	return r.Broker.certifyVEXStatements.subscribe(ctx, func(ctx context.Context, id string) ([]*model.CertifyVEXStatement, error) {
+		spec := spec
+		spec.ID = &id
+		return r.Query().CertifyVEXStatement(ctx, spec)
+	}), nil
+}
@@ -21,9 +21,14 @@ func (r *mutationResolver) IngestCertifyVuln(ctx context.Context, pkg model.PkgI
 		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
 	}
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
-	return r.Backend.IngestCertifyVuln(ctx, pkg,
+	id, err := r.Backend.IngestCertifyVuln(ctx, pkg,
 		model.VulnerabilityInputSpec{Type: strings.ToLower(vulnerability.Type), VulnerabilityID: strings.ToLower(vulnerability.VulnerabilityID)},
 		certifyVuln)
+	if err != nil {
+		return "", err
+	}
+	r.Broker.publishCertifyVulns(ctx, id)
+	return id, nil
 }
 
 // IngestCertifyVulns is the resolver for the ingestCertifyVulns field.
@@ -52,7 +57,17 @@ func (r *mutationResolver) IngestCertifyVulns(ctx context.Context, pkgs []*model
 		}
 		lowercaseVulnInputList = append(lowercaseVulnInputList, &lowercaseVulnInput)
 	}
-	return r.Backend.IngestCertifyVulns(ctx, pkgs, lowercaseVulnInputList, certifyVulns)
+	ids, err := r.Backend.IngestCertifyVulns(ctx, pkgs, lowercaseVulnInputList, certifyVulns)
+	if err != nil {
+		return nil, err
+	}
+	r.Broker.publishCertifyVulns(ctx, ids...)
+	return ids, nil
+}
+
+// DeleteCertifyVuln is the resolver for the deleteCertifyVuln field.
+func (r *mutationResolver) DeleteCertifyVuln(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteCertifyVuln(ctx, id)
 }
 
 // CertifyVuln is the resolver for the CertifyVuln field.
@@ -102,3 +117,52 @@ func (r *queryResolver) CertifyVuln(ctx context.Context, certifyVulnSpec model.C
 		return r.Backend.CertifyVuln(ctx, &certifyVulnSpec)
 	}
 }
+
+// CertifyVulnList is the resolver for the CertifyVulnList field.
+func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+
+	if certifyVulnSpec.Vulnerability != nil {
+		var typeLowerCase *string = nil
+		var vulnIDLowerCase *string = nil
+		if certifyVulnSpec.Vulnerability.Type != nil {
+			lower := strings.ToLower(*certifyVulnSpec.Vulnerability.Type)
+			typeLowerCase = &lower
+		}
+		if certifyVulnSpec.Vulnerability.VulnerabilityID != nil {
+			lower := strings.ToLower(*certifyVulnSpec.Vulnerability.VulnerabilityID)
+			vulnIDLowerCase = &lower
+		}
+
+		if certifyVulnSpec.Vulnerability.NoVuln != nil && !*certifyVulnSpec.Vulnerability.NoVuln {
+			if certifyVulnSpec.Vulnerability.Type != nil && *typeLowerCase == "novuln" {
+				return nil, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
+			}
+		}
+
+		certifyVulnSpec.Vulnerability = &model.VulnerabilitySpec{
+			ID:              certifyVulnSpec.Vulnerability.ID,
+			Type:            typeLowerCase,
+			VulnerabilityID: vulnIDLowerCase,
+			NoVuln:          certifyVulnSpec.Vulnerability.NoVuln,
+		}
+	}
+	return r.Backend.CertifyVulnList(ctx, &certifyVulnSpec, after, first)
+}
+
+// CertifyVulnAdded is the resolver for the certifyVulnAdded field.
+func (r *subscriptionResolver) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	funcName := "CertifyVulnAdded"
+	if r.Broker == nil {
+		return nil, gqlerror.Errorf("%v :: subscriptions are not enabled", funcName)
+	}
+	var spec model.CertifyVulnSpec
+	if filter != nil {
+		spec = *filter
+	}
+	return r.Broker.certifyVulns.subscribe(ctx, func(ctx context.Context, id string) ([]*model.CertifyVuln, error) {
+		spec := spec
+		spec.ID = &id
+		return r.Query().CertifyVuln(ctx, spec)
+	}), nil
+}
@@ -49,6 +49,11 @@ func (r *mutationResolver) IngestPointOfContacts(ctx context.Context, subjects m
 	return r.Backend.IngestPointOfContacts(ctx, subjects, &pkgMatchType, pointOfContacts)
 }
 
+// DeletePointOfContact is the resolver for the deletePointOfContact field.
+func (r *mutationResolver) DeletePointOfContact(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeletePointOfContact(ctx, id)
+}
+
 // PointOfContact is the resolver for the PointOfContact field.
 func (r *queryResolver) PointOfContact(ctx context.Context, pointOfContactSpec model.PointOfContactSpec) ([]*model.PointOfContact, error) {
 	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(pointOfContactSpec.Subject); err != nil {
@@ -56,3 +61,11 @@ func (r *queryResolver) PointOfContact(ctx context.Context, pointOfContactSpec m
 	}
 	return r.Backend.PointOfContact(ctx, &pointOfContactSpec)
 }
+
+// PointOfContactList is the resolver for the PointOfContactList field.
+func (r *queryResolver) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
+	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(pointOfContactSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("PointOfContactList :: %s", err)
+	}
+	return r.Backend.PointOfContactList(ctx, &pointOfContactSpec, after, first)
+}
@@ -0,0 +1,63 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/auth"
+)
+
+// NewDirectives returns the implementation of the schema directives. The
+// @hasRole directive only checks the role of the client, given by the
+// principal that auth.Middleware puts in the context, when authz is true.
+// Servers that do not authenticate their clients let everyone call every
+// field.
+func NewDirectives(authz bool) generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+			if authz {
+				if err := authorize(ctx, role); err != nil {
+					return nil, err
+				}
+			}
+			return next(ctx)
+		},
+	}
+}
+
+func authorize(ctx context.Context, role model.Role) error {
+	required, err := auth.ParseRole(string(role))
+	if err != nil {
+		return err
+	}
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return fmt.Errorf("access denied: client is not authenticated")
+	}
+	if !p.Role.Includes(required) {
+		field := "field"
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			field = fc.Field.Name
+		}
+		return fmt.Errorf("access denied: %s requires the %s role, %s has the %s role", field, required, p.Subject, p.Role)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	clients "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+	"github.com/guacsec/guac/pkg/auth"
+)
+
+func TestHasRole(t *testing.T) {
+	ctx := context.Background()
+	backend, err := backends.Get("keyvalue", nil, memmap.GetStore())
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+	var tokens []auth.Token
+	for _, role := range []string{"reader", "writer", "admin"} {
+		sum := sha256.Sum256([]byte(role + "-token"))
+		tokens = append(tokens, auth.Token{Name: role, SHA256: hex.EncodeToString(sum[:]), Role: role})
+	}
+	authenticator, err := auth.NewTokenAuthenticator(tokens)
+	if err != nil {
+		t.Fatalf("error creating token authenticator: %v", err)
+	}
+	config := generated.Config{
+		Resolvers:  &resolvers.Resolver{Backend: backend},
+		Directives: resolvers.NewDirectives(true),
+	}
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	server := httptest.NewServer(auth.Middleware(ctx, []auth.Authenticator{authenticator}, srv))
+	defer server.Close()
+
+	client := func(token string) graphql.Client {
+		httpClient, err := auth.NewHTTPClient(auth.ClientOptions{Token: token})
+		if err != nil {
+			t.Fatalf("error creating http client: %v", err)
+		}
+		return graphql.NewClient(server.URL, httpClient)
+	}
+	artifact := clients.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcd"}
+	query := func(c graphql.Client) error {
+		_, err := clients.Artifacts(ctx, c, clients.ArtifactSpec{})
+		return err
+	}
+	ingest := func(c graphql.Client) error {
+		_, err := clients.IngestArtifact(ctx, c, artifact)
+		return err
+	}
+	retract := func(c graphql.Client) error {
+		return c.MakeRequest(ctx, &graphql.Request{
+			OpName: "RetractEvidence",
+			Query:  `mutation RetractEvidence { retractEvidence(origin: "file:///sbom.json") }`,
+		}, &graphql.Response{Data: &struct{ RetractEvidence int }{}})
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		operation func(graphql.Client) error
+		wantErr   bool
+	}{
+		{name: "unauthenticated query", operation: query, wantErr: true},
+		{name: "reader query", token: "reader-token", operation: query},
+		{name: "reader ingest", token: "reader-token", operation: ingest, wantErr: true},
+		{name: "writer ingest", token: "writer-token", operation: ingest},
+		{name: "writer retract", token: "writer-token", operation: retract, wantErr: true},
+		{name: "admin ingest", token: "admin-token", operation: ingest},
+		{name: "admin retract", token: "admin-token", operation: retract},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.operation(client(tt.token))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -58,6 +58,11 @@ func (r *mutationResolver) IngestHasSBOMs(ctx context.Context, subjects model.Pa
 	return r.Backend.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
 }
 
+// DeleteHasSbom is the resolver for the deleteHasSBOM field.
+func (r *mutationResolver) DeleteHasSbom(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteHasSbom(ctx, id)
+}
+
 // HasSbom is the resolver for the HasSBOM field.
 func (r *queryResolver) HasSbom(ctx context.Context, hasSBOMSpec model.HasSBOMSpec) ([]*model.HasSbom, error) {
 	if err := helper.ValidatePackageOrArtifactQueryFilter(hasSBOMSpec.Subject); err != nil {
@@ -65,3 +70,11 @@ func (r *queryResolver) HasSbom(ctx context.Context, hasSBOMSpec model.HasSBOMSp
 	}
 	return r.Backend.HasSBOM(ctx, &hasSBOMSpec)
 }
+
+// HasSBOMList is the resolver for the HasSBOMList field.
+func (r *queryResolver) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	if err := helper.ValidatePackageOrArtifactQueryFilter(hasSBOMSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", "HasSBOMList", err)
+	}
+	return r.Backend.HasSBOMList(ctx, &hasSBOMSpec, after, first)
+}
@@ -37,7 +37,17 @@ func (r *mutationResolver) IngestSLSAs(ctx context.Context, subjects []*model.Ar
 	return r.Backend.IngestSLSAs(ctx, subjects, builtFromList, builtByList, slsaList)
 }
 
+// DeleteHasSlsa is the resolver for the deleteHasSLSA field.
+func (r *mutationResolver) DeleteHasSlsa(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteHasSlsa(ctx, id)
+}
+
 // HasSlsa is the resolver for the HasSLSA field.
 func (r *queryResolver) HasSlsa(ctx context.Context, hasSLSASpec model.HasSLSASpec) ([]*model.HasSlsa, error) {
 	return r.Backend.HasSlsa(ctx, &hasSLSASpec)
 }
+
+// HasSLSAList is the resolver for the HasSLSAList field.
+func (r *queryResolver) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	return r.Backend.HasSLSAList(ctx, &hasSLSASpec, after, first)
+}
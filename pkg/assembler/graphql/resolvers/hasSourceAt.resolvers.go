@@ -28,7 +28,17 @@ func (r *mutationResolver) IngestHasSourceAts(ctx context.Context, pkgs []*model
 	return r.Backend.IngestHasSourceAts(ctx, pkgs, &pkgMatchType, sources, hasSourceAts)
 }
 
+// DeleteHasSourceAt is the resolver for the deleteHasSourceAt field.
+func (r *mutationResolver) DeleteHasSourceAt(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteHasSourceAt(ctx, id)
+}
+
 // HasSourceAt is the resolver for the HasSourceAt field.
 func (r *queryResolver) HasSourceAt(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
 	return r.Backend.HasSourceAt(ctx, &hasSourceAtSpec)
 }
+
+// HasSourceAtList is the resolver for the HasSourceAtList field.
+func (r *queryResolver) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	return r.Backend.HasSourceAtList(ctx, &hasSourceAtSpec, after, first)
+}
@@ -29,6 +29,11 @@ func (r *mutationResolver) IngestHashEquals(ctx context.Context, artifacts []*mo
 	return r.Backend.IngestHashEquals(ctx, artifacts, otherArtifacts, hashEquals)
 }
 
+// DeleteHashEqual is the resolver for the deleteHashEqual field.
+func (r *mutationResolver) DeleteHashEqual(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteHashEqual(ctx, id)
+}
+
 // HashEqual is the resolver for the HashEqual field.
 func (r *queryResolver) HashEqual(ctx context.Context, hashEqualSpec model.HashEqualSpec) ([]*model.HashEqual, error) {
 	if hashEqualSpec.Artifacts != nil && len(hashEqualSpec.Artifacts) > 2 {
@@ -36,3 +41,11 @@ func (r *queryResolver) HashEqual(ctx context.Context, hashEqualSpec model.HashE
 	}
 	return r.Backend.HashEqual(ctx, &hashEqualSpec)
 }
+
+// HashEqualList is the resolver for the HashEqualList field.
+func (r *queryResolver) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	if hashEqualSpec.Artifacts != nil && len(hashEqualSpec.Artifacts) > 2 {
+		return nil, gqlerror.Errorf("HashEqualList :: Provided spec has too many Artifacts")
+	}
+	return r.Backend.HashEqualList(ctx, &hashEqualSpec, after, first)
+}
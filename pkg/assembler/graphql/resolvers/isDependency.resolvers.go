@@ -30,7 +30,17 @@ func (r *mutationResolver) IngestDependencies(ctx context.Context, pkgs []*model
 	return r.Backend.IngestDependencies(ctx, pkgs, depPkgs, depPkgMatchType, dependencies)
 }
 
+// DeleteIsDependency is the resolver for the deleteIsDependency field.
+func (r *mutationResolver) DeleteIsDependency(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteIsDependency(ctx, id)
+}
+
 // IsDependency is the resolver for the IsDependency field.
 func (r *queryResolver) IsDependency(ctx context.Context, isDependencySpec model.IsDependencySpec) ([]*model.IsDependency, error) {
 	return r.Backend.IsDependency(ctx, &isDependencySpec)
 }
+
+// IsDependencyList is the resolver for the IsDependencyList field.
+func (r *queryResolver) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	return r.Backend.IsDependencyList(ctx, &isDependencySpec, after, first)
+}
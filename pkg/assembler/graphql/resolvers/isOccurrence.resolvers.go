@@ -51,6 +51,11 @@ func (r *mutationResolver) IngestOccurrences(ctx context.Context, subjects model
 	return r.Backend.IngestOccurrences(ctx, subjects, artifacts, occurrences)
 }
 
+// DeleteIsOccurrence is the resolver for the deleteIsOccurrence field.
+func (r *mutationResolver) DeleteIsOccurrence(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteIsOccurrence(ctx, id)
+}
+
 // IsOccurrence is the resolver for the IsOccurrence field.
 func (r *queryResolver) IsOccurrence(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
 	if err := helper.ValidatePackageOrSourceQueryFilter(isOccurrenceSpec.Subject); err != nil {
@@ -58,3 +63,11 @@ func (r *queryResolver) IsOccurrence(ctx context.Context, isOccurrenceSpec model
 	}
 	return r.Backend.IsOccurrence(ctx, &isOccurrenceSpec)
 }
+
+// IsOccurrenceList is the resolver for the IsOccurrenceList field.
+func (r *queryResolver) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	if err := helper.ValidatePackageOrSourceQueryFilter(isOccurrenceSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("IsOccurrenceList :: %s", err)
+	}
+	return r.Backend.IsOccurrenceList(ctx, &isOccurrenceSpec, after, first)
+}
@@ -33,3 +33,8 @@ func (r *mutationResolver) IngestLicenses(ctx context.Context, licenses []*model
 func (r *queryResolver) Licenses(ctx context.Context, licenseSpec model.LicenseSpec) ([]*model.License, error) {
 	return r.Backend.Licenses(ctx, &licenseSpec)
 }
+
+// LicensesList is the resolver for the licensesList field.
+func (r *queryResolver) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
+	return r.Backend.LicensesList(ctx, &licenseSpec, after, first)
+}
@@ -50,6 +50,11 @@ func (r *mutationResolver) IngestBulkHasMetadata(ctx context.Context, subjects m
 	return r.Backend.IngestBulkHasMetadata(ctx, subjects, &pkgMatchType, hasMetadataList)
 }
 
+// DeleteHasMetadata is the resolver for the deleteHasMetadata field.
+func (r *mutationResolver) DeleteHasMetadata(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteHasMetadata(ctx, id)
+}
+
 // HasMetadata is the resolver for the HasMetadata field.
 func (r *queryResolver) HasMetadata(ctx context.Context, hasMetadataSpec model.HasMetadataSpec) ([]*model.HasMetadata, error) {
 	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(hasMetadataSpec.Subject); err != nil {
@@ -57,3 +62,11 @@ func (r *queryResolver) HasMetadata(ctx context.Context, hasMetadataSpec model.H
 	}
 	return r.Backend.HasMetadata(ctx, &hasMetadataSpec)
 }
+
+// HasMetadataList is the resolver for the HasMetadataList field.
+func (r *queryResolver) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
+	if err := helper.ValidatePackageSourceOrArtifactQueryFilter(hasMetadataSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("HasMetadataList :: %s", err)
+	}
+	return r.Backend.HasMetadataList(ctx, &hasMetadataSpec, after, first)
+}
@@ -29,6 +29,11 @@ func (r *mutationResolver) IngestPkgEquals(ctx context.Context, pkgs []*model.Pk
 	return r.Backend.IngestPkgEquals(ctx, pkgs, otherPackages, pkgEquals)
 }
 
+// DeletePkgEqual is the resolver for the deletePkgEqual field.
+func (r *mutationResolver) DeletePkgEqual(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeletePkgEqual(ctx, id)
+}
+
 // PkgEqual is the resolver for the PkgEqual field.
 func (r *queryResolver) PkgEqual(ctx context.Context, pkgEqualSpec model.PkgEqualSpec) ([]*model.PkgEqual, error) {
 	if len(pkgEqualSpec.Packages) > 2 {
@@ -36,3 +41,11 @@ func (r *queryResolver) PkgEqual(ctx context.Context, pkgEqualSpec model.PkgEqua
 	}
 	return r.Backend.PkgEqual(ctx, &pkgEqualSpec)
 }
+
+// PkgEqualList is the resolver for the PkgEqualList field.
+func (r *queryResolver) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
+	if len(pkgEqualSpec.Packages) > 2 {
+		return nil, gqlerror.Errorf("PkgEqualList :: too many packages in query, max 2, got: %v", len(pkgEqualSpec.Packages))
+	}
+	return r.Backend.PkgEqualList(ctx, &pkgEqualSpec, after, first)
+}
@@ -25,4 +25,6 @@ import (
 
 type Resolver struct {
 	Backend backends.Backend
+	// Broker feeds the subscriptions, which are disabled if it is nil.
+	Broker *Broker
 }
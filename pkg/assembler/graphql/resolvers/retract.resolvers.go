@@ -0,0 +1,20 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.41
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// RetractEvidence is the resolver for the retractEvidence field.
+func (r *mutationResolver) RetractEvidence(ctx context.Context, origin string) (int, error) {
+	funcName := "RetractEvidence"
+	if origin == "" {
+		return 0, gqlerror.Errorf("%v :: origin must not be empty", funcName)
+	}
+	return r.Backend.RetractEvidence(ctx, origin)
+}
@@ -31,3 +31,14 @@ func (r *queryResolver) Sources(ctx context.Context, sourceSpec model.SourceSpec
 
 	return r.Backend.Sources(ctx, &sourceSpec)
 }
+
+// SourcesList is the resolver for the sourcesList field.
+func (r *queryResolver) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
+		if *sourceSpec.Commit != "" && *sourceSpec.Tag != "" {
+			return nil, gqlerror.Errorf("SourcesList :: Passing both commit and tag selectors is an error")
+		}
+	}
+
+	return r.Backend.SourcesList(ctx, &sourceSpec, after, first)
+}
@@ -0,0 +1,182 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+	"golang.org/x/exp/maps"
+)
+
+// subscriptionBufferSize is the number of nodes queued for a subscriber
+// before newer ones are dropped.
+const subscriptionBufferSize = 100
+
+// publishQueueSize is the number of ingestions queued for a topic before the
+// IDs of newer ones are dropped.
+const publishQueueSize = 1000
+
+// Broker delivers the evidence ingested through the mutation resolvers to the
+// subscriptions matching it. The zero value is ready to use. A Resolver
+// without a Broker rejects subscriptions.
+type Broker struct {
+	certifyBads          topic[*model.CertifyBad]
+	certifyVEXStatements topic[*model.CertifyVEXStatement]
+	certifyVulns         topic[*model.CertifyVuln]
+}
+
+func (b *Broker) publishCertifyBads(ctx context.Context, ids ...string) {
+	if b != nil {
+		b.certifyBads.publish(ctx, ids)
+	}
+}
+
+func (b *Broker) publishCertifyVEXStatements(ctx context.Context, ids ...string) {
+	if b != nil {
+		b.certifyVEXStatements.publish(ctx, ids)
+	}
+}
+
+func (b *Broker) publishCertifyVulns(ctx context.Context, ids ...string) {
+	if b != nil {
+		b.certifyVulns.publish(ctx, ids)
+	}
+}
+
+// matchFunc returns the node with the given ID if it matches the filter of a
+// subscription, usually by running the corresponding query resolver with the
+// filter restricted to that ID.
+type matchFunc[N any] func(ctx context.Context, id string) ([]N, error)
+
+// subscriber is a subscription to a topic. Its filter is evaluated under the
+// context of the subscription, not the one of the ingestion.
+type subscriber[N any] struct {
+	ctx   context.Context
+	match matchFunc[N]
+}
+
+// topic fans out the newly ingested nodes of one type to its subscribers.
+// Ingestions only queue the IDs of the nodes, which are matched against the
+// subscriptions and delivered by a goroutine started on the first publish.
+type topic[N any] struct {
+	mu          sync.Mutex
+	subscribers map[chan N]subscriber[N]
+
+	start sync.Once
+	queue chan []string
+}
+
+// subscribe registers a subscriber until ctx is done, at which point the
+// returned channel is closed.
+func (t *topic[N]) subscribe(ctx context.Context, match matchFunc[N]) <-chan N {
+	ch := make(chan N, subscriptionBufferSize)
+
+	t.mu.Lock()
+	if t.subscribers == nil {
+		t.subscribers = map[chan N]subscriber[N]{}
+	}
+	t.subscribers[ch] = subscriber[N]{ctx: ctx, match: match}
+	t.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		t.mu.Lock()
+		delete(t.subscribers, ch)
+		close(ch)
+		t.mu.Unlock()
+	}()
+	return ch
+}
+
+// publish queues the nodes with the given IDs for delivery to the
+// subscribers they match. It never blocks the ingestion: if the queue of the
+// topic is full the nodes are dropped.
+func (t *topic[N]) publish(ctx context.Context, ids []string) {
+	t.start.Do(func() {
+		t.queue = make(chan []string, publishQueueSize)
+		go t.deliver()
+	})
+
+	t.mu.Lock()
+	subscribed := len(t.subscribers) > 0
+	t.mu.Unlock()
+	if !subscribed {
+		return
+	}
+
+	select {
+	case t.queue <- ids:
+	default:
+		logging.FromContext(ctx).Warnf("subscriptions are too slow, dropping nodes %v", ids)
+	}
+}
+
+// deliver sends the queued nodes to the subscribers they match, in the order
+// they were published.
+func (t *topic[N]) deliver() {
+	for ids := range t.queue {
+		t.mu.Lock()
+		subscribers := maps.Clone(t.subscribers)
+		t.mu.Unlock()
+
+		for ch, sub := range subscribers {
+			t.deliverTo(ch, sub, ids)
+		}
+	}
+}
+
+// deliverTo sends the nodes with the given IDs that match the filter of the
+// subscriber.
+func (t *topic[N]) deliverTo(ch chan N, sub subscriber[N], ids []string) {
+	logger := logging.FromContext(sub.ctx)
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if sub.ctx.Err() != nil {
+			return
+		}
+		nodes, err := sub.match(sub.ctx, id)
+		if err != nil {
+			logger.Warnf("failed to match node %s against subscription: %v", id, err)
+			continue
+		}
+		for _, node := range nodes {
+			if !t.send(ch, node) {
+				logger.Warnf("subscriber is too slow, dropping node %s", id)
+			}
+		}
+	}
+}
+
+// send delivers the node unless the subscriber is gone or its buffer is full,
+// and reports whether the subscriber can still keep up.
+func (t *topic[N]) send(ch chan N, node N) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.subscribers[ch]; !ok {
+		return true
+	}
+	select {
+	case ch <- node:
+		return true
+	default:
+		return false
+	}
+}
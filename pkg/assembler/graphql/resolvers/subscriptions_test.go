@@ -0,0 +1,97 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestCertifyBadAdded(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	r := resolvers.Resolver{Backend: b, Broker: &resolvers.Broker{}}
+
+	filter := &model.CertifyBadSpec{Justification: ptrfrom.String("match")}
+	added, err := r.Subscription().CertifyBadAdded(ctx, filter)
+	if err != nil {
+		t.Fatalf("CertifyBadAdded failed: %v", err)
+	}
+
+	sub := model.PackageSourceOrArtifactInput{Package: testdata.P1}
+	b.EXPECT().IngestCertifyBad(ctx, sub, gomock.Any(), gomock.Any()).Return("1", nil)
+	b.EXPECT().IngestCertifyBad(ctx, sub, gomock.Any(), gomock.Any()).Return("2", nil)
+	// The backend applies the subscription filter, only the second
+	// certification matches it.
+	b.EXPECT().CertifyBad(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, spec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+			if spec.Justification == nil || *spec.Justification != "match" {
+				t.Errorf("subscription filter not passed to the backend: %+v", spec)
+			}
+			if *spec.ID != "2" {
+				return nil, nil
+			}
+			return []*model.CertifyBad{{ID: *spec.ID, Justification: "match"}}, nil
+		}).Times(2)
+
+	for _, justification := range []string{"other", "match"} {
+		cb := model.CertifyBadInputSpec{Justification: justification, KnownSince: ZeroTime}
+		if _, err := r.Mutation().IngestCertifyBad(ctx, sub, model.MatchFlags{}, cb); err != nil {
+			t.Fatalf("IngestCertifyBad failed: %v", err)
+		}
+	}
+
+	select {
+	case got := <-added:
+		if got.ID != "2" {
+			t.Errorf("received CertifyBad %s, want 2", got.ID)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no CertifyBad received")
+	}
+	select {
+	case got := <-added:
+		t.Errorf("received unexpected CertifyBad %v", got)
+	default:
+	}
+
+	cancel()
+	select {
+	case _, ok := <-added:
+		if ok {
+			t.Errorf("received a CertifyBad after the subscription ended")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("subscription channel not closed after the context was canceled")
+	}
+}
+
+func TestSubscriptionsDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	r := resolvers.Resolver{Backend: mocks.NewMockBackend(ctrl)}
+	if _, err := r.Subscription().CertifyVulnAdded(context.Background(), nil); err == nil {
+		t.Errorf("CertifyVulnAdded succeeded without a Broker")
+	}
+}
@@ -93,6 +93,11 @@ func (r *mutationResolver) IngestVulnEquals(ctx context.Context, vulnerabilities
 	return r.Backend.IngestVulnEquals(ctx, lowercaseVulnList, lowercaseOtherVulnList, vulnEquals)
 }
 
+// DeleteVulnEqual is the resolver for the deleteVulnEqual field.
+func (r *mutationResolver) DeleteVulnEqual(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteVulnEqual(ctx, id)
+}
+
 // VulnEqual is the resolver for the vulnEqual field.
 func (r *queryResolver) VulnEqual(ctx context.Context, vulnEqualSpec model.VulnEqualSpec) ([]*model.VulnEqual, error) {
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
@@ -136,3 +141,36 @@ func (r *queryResolver) VulnEqual(ctx context.Context, vulnEqualSpec model.VulnE
 		return r.Backend.VulnEqual(ctx, &vulnEqualSpec)
 	}
 }
+
+// VulnEqualList is the resolver for the vulnEqualList field.
+func (r *queryResolver) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+
+	if vulnEqualSpec.Vulnerabilities != nil && len(vulnEqualSpec.Vulnerabilities) > 2 {
+		return nil, gqlerror.Errorf("VulnEqualList :: cannot specify more than 2 vulnerabilities in VulnEqual")
+	}
+
+	var lowercaseVulnFilterList []*model.VulnerabilitySpec
+	for _, v := range vulnEqualSpec.Vulnerabilities {
+		var typeLowerCase *string = nil
+		var vulnIDLowerCase *string = nil
+		if v.Type != nil {
+			lower := strings.ToLower(*v.Type)
+			typeLowerCase = &lower
+		}
+		if v.VulnerabilityID != nil {
+			lower := strings.ToLower(*v.VulnerabilityID)
+			vulnIDLowerCase = &lower
+		}
+
+		lowercaseVulnFilterList = append(lowercaseVulnFilterList, &model.VulnerabilitySpec{
+			ID:              v.ID,
+			Type:            typeLowerCase,
+			VulnerabilityID: vulnIDLowerCase,
+			NoVuln:          v.NoVuln,
+		})
+	}
+	vulnEqualSpec.Vulnerabilities = lowercaseVulnFilterList
+
+	return r.Backend.VulnEqualList(ctx, &vulnEqualSpec, after, first)
+}
@@ -61,6 +61,11 @@ func (r *mutationResolver) IngestBulkVulnerabilityMetadata(ctx context.Context,
 	return r.Backend.IngestBulkVulnerabilityMetadata(ctx, lowercaseVulnInputList, vulnerabilityMetadataList)
 }
 
+// DeleteVulnerabilityMetadata is the resolver for the deleteVulnerabilityMetadata field.
+func (r *mutationResolver) DeleteVulnerabilityMetadata(ctx context.Context, id string) (bool, error) {
+	return r.Backend.DeleteVulnerabilityMetadata(ctx, id)
+}
+
 // VulnerabilityMetadata is the resolver for the vulnerabilityMetadata field.
 func (r *queryResolver) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
 	funcName := "IngestVulnerabilityMetadata"
@@ -110,3 +115,39 @@ func (r *queryResolver) VulnerabilityMetadata(ctx context.Context, vulnerability
 		return r.Backend.VulnerabilityMetadata(ctx, &vulnerabilityMetadataSpec)
 	}
 }
+
+// VulnerabilityMetadataList is the resolver for the vulnerabilityMetadataList field.
+func (r *queryResolver) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+	funcName := "VulnerabilityMetadataList"
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+
+	if vulnerabilityMetadataSpec.Comparator != nil && vulnerabilityMetadataSpec.ScoreValue == nil {
+		return nil, gqlerror.Errorf("%v :: comparator cannot be set without a score value specified", funcName)
+	}
+
+	if vulnerabilityMetadataSpec.Vulnerability != nil {
+		var typeLowerCase *string = nil
+		var vulnIDLowerCase *string = nil
+		if vulnerabilityMetadataSpec.Vulnerability.Type != nil {
+			lower := strings.ToLower(*vulnerabilityMetadataSpec.Vulnerability.Type)
+			typeLowerCase = &lower
+		}
+		if vulnerabilityMetadataSpec.Vulnerability.VulnerabilityID != nil {
+			lower := strings.ToLower(*vulnerabilityMetadataSpec.Vulnerability.VulnerabilityID)
+			vulnIDLowerCase = &lower
+		}
+
+		err := helper.ValidateVulnerabilitySpec(*vulnerabilityMetadataSpec.Vulnerability)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v ::  %s", funcName, err)
+		}
+
+		vulnerabilityMetadataSpec.Vulnerability = &model.VulnerabilitySpec{
+			ID:              vulnerabilityMetadataSpec.Vulnerability.ID,
+			Type:            typeLowerCase,
+			VulnerabilityID: vulnIDLowerCase,
+			NoVuln:          vulnerabilityMetadataSpec.Vulnerability.NoVuln,
+		}
+	}
+	return r.Backend.VulnerabilityMetadataList(ctx, &vulnerabilityMetadataSpec, after, first)
+}
@@ -71,3 +71,27 @@ func (r *queryResolver) Vulnerabilities(ctx context.Context, vulnSpec model.Vuln
 	return r.Backend.Vulnerabilities(ctx, &model.VulnerabilitySpec{ID: vulnSpec.ID, Type: typeLowerCase,
 		VulnerabilityID: vulnIDLowerCase, NoVuln: vulnSpec.NoVuln})
 }
+
+// VulnerabilitiesList is the resolver for the vulnerabilitiesList field.
+func (r *queryResolver) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+
+	var typeLowerCase *string = nil
+	var vulnIDLowerCase *string = nil
+	if vulnSpec.Type != nil {
+		lower := strings.ToLower(*vulnSpec.Type)
+		typeLowerCase = &lower
+	}
+	if vulnSpec.VulnerabilityID != nil {
+		lower := strings.ToLower(*vulnSpec.VulnerabilityID)
+		vulnIDLowerCase = &lower
+	}
+
+	err := helper.ValidateVulnerabilitySpec(vulnSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("VulnerabilitiesList ::  %s", err)
+	}
+
+	return r.Backend.VulnerabilitiesList(ctx, &model.VulnerabilitySpec{ID: vulnSpec.ID, Type: typeLowerCase,
+		VulnerabilityID: vulnIDLowerCase, NoVuln: vulnSpec.NoVuln}, after, first)
+}
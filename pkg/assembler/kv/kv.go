@@ -19,6 +19,8 @@ package kv
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 )
 
 // Store is an interface to define to serve as a keyvalue store
@@ -31,10 +33,111 @@ type Store interface {
 	// Sets a value, creates collection if necessary
 	Set(ctx context.Context, collection, key string, value any) error
 
-	// Returns a slice of all keys for a collection. If collection does not
-	// exist, return a nil slice.
-	// TODO(jeffmendoza) implement scanning in kv interface
-	Keys(ctx context.Context, collection string) ([]string, error)
+	// Removes a value from the store. Deleting a key that does not exist is
+	// not an error.
+	Delete(ctx context.Context, collection, key string) error
+
+	// Retrieves the values of several keys at once. Ptr must be a pointer to
+	// a slice of the type of values stored, which is set to a slice with one
+	// element per key. Elements of keys that are not found are left as the
+	// zero value.
+	MultiGet(ctx context.Context, collection string, keys []string, ptr any) error
+
+	// Sets several values at once, creates collection if necessary
+	BatchSet(ctx context.Context, collection string, values map[string]any) error
+
+	// Returns a page of at most count keys of the collection that start with
+	// prefix and sort after cursor, in ascending byte order, and the cursor
+	// to pass to get the next page. Pass an empty cursor to get the first
+	// page, or any key to resume the scan after it. An empty next cursor
+	// means the scan is complete. A page may hold fewer than count keys, even
+	// none, before the scan is complete. Keys set or deleted during a scan
+	// may or may not be returned. Use a Scanner to iterate over all pages.
+	Scan(ctx context.Context, collection, prefix, cursor string, count int) (keys []string, next string, err error)
+
+	// Atomically adds delta to the counter stored at key, which starts at
+	// zero when missing, and returns its new value. Counters are safe to
+	// share between processes using the same store. Get reads a counter into
+	// a uint64.
+	Increment(ctx context.Context, collection, key string, delta uint64) (uint64, error)
+}
+
+// DefaultScanCount is the page size used by Scanners created with a count of
+// zero or less.
+const DefaultScanCount = 1000
+
+// Scanner iterates over the keys of a collection that start with a prefix,
+// in order, one page at a time.
+type Scanner struct {
+	s          Store
+	collection string
+	prefix     string
+	count      int
+	cursor     string
+	keys       []string
+	done       bool
+	err        error
+}
+
+// NewScanner returns a Scanner reading pages of count keys.
+func NewScanner(s Store, collection, prefix string, count int) *Scanner {
+	if count <= 0 {
+		count = DefaultScanCount
+	}
+	return &Scanner{
+		s:          s,
+		collection: collection,
+		prefix:     prefix,
+		count:      count,
+	}
+}
+
+// Next fetches the next non-empty page of keys and reports whether there was
+// one.
+func (sc *Scanner) Next(ctx context.Context) bool {
+	for !sc.done && sc.err == nil {
+		sc.keys, sc.cursor, sc.err = sc.s.Scan(ctx, sc.collection, sc.prefix, sc.cursor, sc.count)
+		sc.done = sc.cursor == ""
+		if sc.err == nil && len(sc.keys) > 0 {
+			return true
+		}
+	}
+	sc.keys = nil
+	return false
+}
+
+// Keys returns the page of keys fetched by the last call to Next.
+func (sc *Scanner) Keys() []string {
+	return sc.keys
+}
+
+// Cursor returns the cursor to resume the scan after the current page, or an
+// empty string once the scan is complete.
+func (sc *Scanner) Cursor() string {
+	return sc.cursor
+}
+
+// Err returns the error that stopped the scan, if any.
+func (sc *Scanner) Err() error {
+	return sc.err
+}
+
+// FillSlice helps implementing MultiGet. It sets the slice ptr points to to a
+// new slice of n elements and calls set with the index and a pointer to each
+// element.
+func FillSlice(ptr any, n int, set func(i int, elem any) error) error {
+	p := reflect.ValueOf(ptr)
+	if p.Kind() != reflect.Pointer || p.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("%w : Not a pointer to a slice", BadPtrError)
+	}
+	s := reflect.MakeSlice(p.Elem().Type(), n, n)
+	for i := 0; i < n; i++ {
+		if err := set(i, s.Index(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	p.Elem().Set(s)
+	return nil
 }
 
 // Error to return (wrap) on Get if value not found
@@ -19,22 +19,41 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/guacsec/guac/pkg/assembler/kv"
-	"golang.org/x/exp/maps"
 )
 
+// Store is safe for concurrent use. Get and MultiGet hand out the stored
+// values themselves rather than copies, so callers must not modify them.
 type Store struct {
-	m map[string]map[string]any
+	mu  sync.RWMutex
+	m   map[string]map[string]any
+	idx map[string]*keyIndex
+}
+
+// keyIndex keeps the keys of a collection sorted for Scan. Keys added since
+// the last Scan wait in pending, and deleted keys stay in sorted, until the
+// next Scan merges them, so that writes stay cheap.
+type keyIndex struct {
+	sorted  []string
+	pending []string
+	stale   bool
 }
 
 func GetStore() kv.Store {
 	return &Store{
-		m: make(map[string]map[string]any),
+		m:   make(map[string]map[string]any),
+		idx: make(map[string]*keyIndex),
 	}
 }
 
 func (s *Store) Get(_ context.Context, c, k string, v any) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	col, ok := s.m[c]
 	if !ok {
 		return fmt.Errorf("%w : Collection %q", kv.NotFoundError, c)
@@ -48,18 +67,142 @@ func (s *Store) Get(_ context.Context, c, k string, v any) error {
 }
 
 func (s *Store) Set(_ context.Context, c, k string, v any) error {
-	if s.m[c] == nil {
-		s.m[c] = make(map[string]any)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set(c, k, v)
+	return nil
+}
+
+// set must be called with the write lock held.
+func (s *Store) set(c, k string, v any) {
+	col := s.m[c]
+	if col == nil {
+		col = make(map[string]any)
+		s.m[c] = col
+		s.idx[c] = &keyIndex{}
+	}
+	if _, ok := col[k]; !ok {
+		s.idx[c].pending = append(s.idx[c].pending, k)
+	}
+	col[k] = v
+}
+
+func (s *Store) Delete(_ context.Context, c, k string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.m[c][k]; ok {
+		delete(s.m[c], k)
+		s.idx[c].stale = true
+	}
+	return nil
+}
+
+func (s *Store) MultiGet(_ context.Context, c string, ks []string, v any) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	col := s.m[c]
+	return kv.FillSlice(v, len(ks), func(i int, elem any) error {
+		val, ok := col[ks[i]]
+		if !ok {
+			return nil
+		}
+		return copyAny(val, elem)
+	})
+}
+
+func (s *Store) BatchSet(_ context.Context, c string, vs map[string]any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range vs {
+		s.set(c, k, v)
 	}
-	s.m[c][k] = v
 	return nil
 }
 
-func (s *Store) Keys(_ context.Context, c string) ([]string, error) {
-	if s.m[c] == nil {
-		return nil, nil
+func (s *Store) Increment(_ context.Context, c, k string, delta uint64) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var n uint64
+	if val, ok := s.m[c][k]; ok {
+		if n, ok = val.(uint64); !ok {
+			return 0, fmt.Errorf("%w : Key %q is not a counter", kv.BadPtrError, k)
+		}
+	}
+	n += delta
+	s.set(c, k, n)
+	return n, nil
+}
+
+// Scan binary searches the prefix and the cursor in the sorted keys of the
+// collection, after merging the keys written since the previous Scan into
+// them. The keys starting with the prefix follow each other.
+func (s *Store) Scan(_ context.Context, c, prefix, cursor string, count int) ([]string, string, error) {
+	s.mu.RLock()
+	if ix := s.idx[c]; ix != nil && (len(ix.pending) > 0 || ix.stale) {
+		s.mu.RUnlock()
+		s.mu.Lock()
+		s.idx[c].merge(s.m[c])
+		s.mu.Unlock()
+		s.mu.RLock()
+	}
+	defer s.mu.RUnlock()
+	ix := s.idx[c]
+	if ix == nil {
+		return nil, "", nil
+	}
+	start, _ := slices.BinarySearch(ix.sorted, prefix)
+	if cursor != "" {
+		after, found := slices.BinarySearch(ix.sorted, cursor)
+		if found {
+			after++
+		}
+		start = max(start, after)
+	}
+	last := start + sort.Search(len(ix.sorted)-start, func(i int) bool {
+		return !strings.HasPrefix(ix.sorted[start+i], prefix)
+	})
+	end := last
+	if count > 0 && start+count < end {
+		end = start + count
+	}
+	// keys deleted since the merge are still in sorted
+	ks := make([]string, 0, end-start)
+	for _, k := range ix.sorted[start:end] {
+		if _, ok := s.m[c][k]; ok {
+			ks = append(ks, k)
+		}
+	}
+	if end == last {
+		return ks, "", nil
+	}
+	return ks, ix.sorted[end-1], nil
+}
+
+// merge sorts the pending keys into the sorted ones and drops the deleted
+// ones. It must be called with the write lock held.
+func (ix *keyIndex) merge(col map[string]any) {
+	slices.Sort(ix.pending)
+	merged := make([]string, 0, len(ix.sorted)+len(ix.pending))
+	i, j := 0, 0
+	for i < len(ix.sorted) || j < len(ix.pending) {
+		var k string
+		if j == len(ix.pending) || i < len(ix.sorted) && ix.sorted[i] <= ix.pending[j] {
+			k = ix.sorted[i]
+			i++
+		} else {
+			k = ix.pending[j]
+			j++
+		}
+		if _, ok := col[k]; !ok {
+			continue
+		}
+		// a key deleted and set again is in both
+		if n := len(merged); n > 0 && merged[n-1] == k {
+			continue
+		}
+		merged = append(merged, k)
 	}
-	return maps.Keys(s.m[c]), nil
+	ix.sorted, ix.pending, ix.stale = merged, nil, false
 }
 
 func copyAny(src any, dst any) error {
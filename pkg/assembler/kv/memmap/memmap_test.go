@@ -0,0 +1,165 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memmap_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+func TestScanner(t *testing.T) {
+	ctx := context.Background()
+	s := memmap.GetStore()
+	if err := s.BatchSet(ctx, "c", map[string]any{
+		"a:1": 1, "a:2": 2, "a:3": 3, "b:1": 4, "a:4": 5,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var pages [][]string
+	sc := kv.NewScanner(s, "c", "", 2)
+	for sc.Next(ctx) {
+		pages = append(pages, sc.Keys())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"a:1", "a:2"}, {"a:3", "a:4"}, {"b:1"}}
+	if diff := cmp.Diff(want, pages); diff != "" {
+		t.Errorf("Unexpected pages (-want +got):\n%s", diff)
+	}
+
+	pages = nil
+	sc = kv.NewScanner(s, "c", "a:", 3)
+	for sc.Next(ctx) {
+		pages = append(pages, sc.Keys())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want = [][]string{{"a:1", "a:2", "a:3"}, {"a:4"}}
+	if diff := cmp.Diff(want, pages); diff != "" {
+		t.Errorf("Unexpected pages with prefix (-want +got):\n%s", diff)
+	}
+
+	var values []int
+	if err := s.MultiGet(ctx, "c", []string{"a:2", "missing", "b:1"}, &values); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]int{2, 0, 4}, values); diff != "" {
+		t.Errorf("Unexpected values (-want +got):\n%s", diff)
+	}
+}
+
+func TestScanAfterWrites(t *testing.T) {
+	ctx := context.Background()
+	s := memmap.GetStore()
+	for _, k := range []string{"d", "b", "a", "c"} {
+		if err := s.Set(ctx, "c", k, k); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := s.Scan(ctx, "c", "", "", 10); err != nil {
+		t.Fatal(err)
+	}
+	// delete a key, set it again and add new ones between scans
+	for _, op := range []struct {
+		key string
+		del bool
+	}{{"c", true}, {"c", false}, {"b", true}, {"bb", false}, {"e", false}} {
+		var err error
+		if op.del {
+			err = s.Delete(ctx, "c", op.key)
+		} else {
+			err = s.Set(ctx, "c", op.key, op.key)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys, next, err := s.Scan(ctx, "c", "", "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"a", "bb", "c", "d", "e"}, keys); diff != "" {
+		t.Errorf("Unexpected keys (-want +got):\n%s", diff)
+	}
+	if next != "" {
+		t.Errorf("Scan() next = %q, want none", next)
+	}
+
+	// resume after a key that is not in the collection
+	keys, next, err = s.Scan(ctx, "c", "", "b", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"bb", "c"}, keys); diff != "" {
+		t.Errorf("Unexpected keys (-want +got):\n%s", diff)
+	}
+	if next != "c" {
+		t.Errorf("Scan() next = %q, want %q", next, "c")
+	}
+
+	// the cursor and the prefix both bound the start of the page
+	for _, tt := range []struct {
+		prefix, cursor string
+		want           []string
+	}{{"b", "", []string{"bb"}}, {"b", "a", []string{"bb"}}, {"b", "bb", []string{}}, {"c", "", []string{"c"}}, {"f", "", []string{}}} {
+		keys, next, err = s.Scan(ctx, "c", tt.prefix, tt.cursor, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tt.want, keys); diff != "" {
+			t.Errorf("Unexpected keys with prefix %q after %q (-want +got):\n%s", tt.prefix, tt.cursor, diff)
+		}
+		if next != "" {
+			t.Errorf("Scan() next = %q, want none", next)
+		}
+	}
+}
+
+func TestIncrement(t *testing.T) {
+	ctx := context.Background()
+	s := memmap.GetStore()
+	for _, want := range []uint64{5, 10} {
+		got, err := s.Increment(ctx, "c", "n", 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Increment() = %d, want %d", got, want)
+		}
+	}
+	var n uint64
+	if err := s.Get(ctx, "c", "n", &n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 10 {
+		t.Errorf("Get() = %d, want 10", n)
+	}
+
+	if err := s.Set(ctx, "c", "s", "text"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Increment(ctx, "c", "s", 1); err == nil {
+		t.Errorf("Increment() of a string did not return an error")
+	}
+}
@@ -57,15 +57,157 @@ func (s *Store) Get(ctx context.Context, c, k string, v any) error {
 	return json.Unmarshal([]byte(j), v)
 }
 
+// Set also adds the key to the sorted set Scan reads, in the same
+// transaction.
 func (s *Store) Set(ctx context.Context, c, k string, v any) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
-	return s.c.HSet(ctx, c, k, string(b)).Err()
+	_, err = s.c.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, c, k, string(b))
+		p.ZAdd(ctx, indexKey(c), redis.Z{Member: k})
+		return nil
+	})
+	return err
 }
 
-func (s *Store) Keys(ctx context.Context, c string) ([]string, error) {
-	// TODO(jeffmendoza) implement scanning in kv interface, use "Keys" for now.
-	return s.c.HKeys(ctx, c).Result()
+func (s *Store) Delete(ctx context.Context, c, k string) error {
+	_, err := s.c.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HDel(ctx, c, k)
+		p.ZRem(ctx, indexKey(c), k)
+		return nil
+	})
+	return err
+}
+
+func (s *Store) MultiGet(ctx context.Context, c string, ks []string, v any) error {
+	if len(ks) == 0 {
+		return kv.FillSlice(v, 0, nil)
+	}
+	js, err := s.c.HMGet(ctx, c, ks...).Result()
+	if err != nil {
+		return err
+	}
+	return kv.FillSlice(v, len(ks), func(i int, elem any) error {
+		// missing fields are returned as nil
+		j, ok := js[i].(string)
+		if !ok || j == "" {
+			return nil
+		}
+		return json.Unmarshal([]byte(j), elem)
+	})
+}
+
+func (s *Store) BatchSet(ctx context.Context, c string, vs map[string]any) error {
+	if len(vs) == 0 {
+		return nil
+	}
+	fields := make(map[string]any, len(vs))
+	members := make([]redis.Z, 0, len(vs))
+	for k, v := range vs {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fields[k] = string(b)
+		members = append(members, redis.Z{Member: k})
+	}
+	_, err := s.c.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, c, fields)
+		p.ZAdd(ctx, indexKey(c), members...)
+		return nil
+	})
+	return err
+}
+
+// Increment uses HINCRBY, which stores the counter as a decimal integer, a
+// valid JSON encoding of it.
+func (s *Store) Increment(ctx context.Context, c, k string, delta uint64) (uint64, error) {
+	var incr *redis.IntCmd
+	if _, err := s.c.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		incr = p.HIncrBy(ctx, c, k, int64(delta))
+		p.ZAdd(ctx, indexKey(c), redis.Z{Member: k})
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return uint64(incr.Val()), nil
+}
+
+// Scan reads the keys with ZRANGEBYLEX from a sorted set kept next to the
+// hash of the collection, as HSCAN returns them in no order and may return
+// some twice. The sorted set of a collection written before it existed is
+// built by the first scan that finds it out of step with the hash.
+// Keys starting with a prefix are the range from the prefix to the prefix
+// followed by 0xff, a byte that never appears in UTF-8.
+func (s *Store) Scan(ctx context.Context, c, prefix, cursor string, count int) ([]string, string, error) {
+	if count <= 0 {
+		count = kv.DefaultScanCount
+	}
+	if cursor == "" {
+		if err := s.syncIndex(ctx, c); err != nil {
+			return nil, "", err
+		}
+	}
+	start, stop := "-", "+"
+	if prefix != "" {
+		start, stop = "["+prefix, "["+prefix+"\xff"
+	}
+	if cursor != "" && cursor >= prefix {
+		start = "(" + cursor
+	}
+	ks, err := s.c.ZRangeByLex(ctx, indexKey(c), &redis.ZRangeBy{
+		Min:   start,
+		Max:   stop,
+		Count: int64(count),
+	}).Result()
+	if err != nil {
+		return nil, "", err
+	}
+	if len(ks) < count {
+		return ks, "", nil
+	}
+	return ks, ks[len(ks)-1], nil
+}
+
+// syncIndex rebuilds the sorted set of the keys of a collection when it does
+// not hold as many keys as the hash. Keys deleted during the rebuild may be
+// left in the sorted set, and are skipped by MultiGet callers as missing.
+func (s *Store) syncIndex(ctx context.Context, c string) error {
+	var hlen, zcard *redis.IntCmd
+	if _, err := s.c.Pipelined(ctx, func(p redis.Pipeliner) error {
+		hlen = p.HLen(ctx, c)
+		zcard = p.ZCard(ctx, indexKey(c))
+		return nil
+	}); err != nil {
+		return err
+	}
+	if hlen.Val() == zcard.Val() {
+		return nil
+	}
+	if err := s.c.Del(ctx, indexKey(c)).Err(); err != nil {
+		return err
+	}
+	ks, err := s.c.HKeys(ctx, c).Result()
+	if err != nil {
+		return err
+	}
+	for len(ks) > 0 {
+		n := min(len(ks), kv.DefaultScanCount)
+		members := make([]redis.Z, n)
+		for i, k := range ks[:n] {
+			members[i] = redis.Z{Member: k}
+		}
+		if err := s.c.ZAdd(ctx, indexKey(c), members...).Err(); err != nil {
+			return err
+		}
+		ks = ks[n:]
+	}
+	return nil
+}
+
+// indexKey is the key of the sorted set of the keys of a collection.
+func indexKey(c string) string {
+	return "scan-index:" + c
 }
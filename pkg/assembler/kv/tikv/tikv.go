@@ -38,8 +38,9 @@ func GetStore(ctx context.Context, s string) (kv.Store, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Increment uses CompareAndSwap, which requires every write to be atomic
 	return &Store{
-		c: c,
+		c: c.SetAtomicForCAS(true),
 	}, nil
 }
 
@@ -66,15 +67,101 @@ func (s *Store) Set(ctx context.Context, c, k string, v any) error {
 	return s.c.Put(ctx, []byte(ck), bts)
 }
 
-func (s *Store) Keys(ctx context.Context, c string) ([]string, error) {
-	// TODO(jeffmendoza) implement scanning in kv interface, use 1000 for now.
-	ks, _, err := s.c.Scan(ctx, []byte(c), kvti.PrefixNextKey([]byte(c)), 10000, rawkv.ScanKeyOnly())
+func (s *Store) Delete(ctx context.Context, c, k string) error {
+	ck := strings.Join([]string{c, k}, ":")
+	return s.c.Delete(ctx, []byte(ck))
+}
+
+func (s *Store) MultiGet(ctx context.Context, c string, ks []string, v any) error {
+	cks := make([][]byte, len(ks))
+	for i, k := range ks {
+		cks[i] = []byte(strings.Join([]string{c, k}, ":"))
+	}
+	var bts [][]byte
+	if len(cks) > 0 {
+		var err error
+		if bts, err = s.c.BatchGet(ctx, cks); err != nil {
+			return err
+		}
+	}
+	return kv.FillSlice(v, len(ks), func(i int, elem any) error {
+		// missing keys are returned as empty values
+		if len(bts[i]) == 0 {
+			return nil
+		}
+		return json.Unmarshal(bts[i], elem)
+	})
+}
+
+func (s *Store) BatchSet(ctx context.Context, c string, vs map[string]any) error {
+	if len(vs) == 0 {
+		return nil
+	}
+	cks := make([][]byte, 0, len(vs))
+	bts := make([][]byte, 0, len(vs))
+	for k, v := range vs {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		cks = append(cks, []byte(strings.Join([]string{c, k}, ":")))
+		bts = append(bts, b)
+	}
+	return s.c.BatchPut(ctx, cks, bts)
+}
+
+// Increment retries a CompareAndSwap of the JSON encoded counter until no
+// other client changes it concurrently.
+func (s *Store) Increment(ctx context.Context, c, k string, delta uint64) (uint64, error) {
+	ck := []byte(strings.Join([]string{c, k}, ":"))
+	prev, err := s.c.Get(ctx, ck)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	rv := make([]string, len(ks))
-	for i, k := range ks {
-		rv[i] = string(k)
+	for {
+		var n uint64
+		if len(prev) == 0 {
+			// a nil previous value swaps only if the key does not exist
+			prev = nil
+		} else if err := json.Unmarshal(prev, &n); err != nil {
+			return 0, err
+		}
+		next, err := json.Marshal(n + delta)
+		if err != nil {
+			return 0, err
+		}
+		actual, swapped, err := s.c.CompareAndSwap(ctx, ck, prev, next)
+		if err != nil {
+			return 0, err
+		}
+		if swapped {
+			return n + delta, nil
+		}
+		prev = actual
+	}
+}
+
+// Scan returns the keys in order, the cursor is the last key of the page.
+func (s *Store) Scan(ctx context.Context, c, prefix, cursor string, count int) ([]string, string, error) {
+	if count <= 0 {
+		count = kv.DefaultScanCount
+	}
+	cp := c + ":"
+	start := []byte(cp + prefix)
+	if cursor != "" && cursor >= prefix {
+		// skip the cursor itself
+		start = append([]byte(cp+cursor), 0)
+	}
+	cks, _, err := s.c.Scan(ctx, start, kvti.PrefixNextKey([]byte(cp+prefix)), count, rawkv.ScanKeyOnly())
+	if err != nil {
+		return nil, "", err
+	}
+	ks := make([]string, len(cks))
+	for i, ck := range cks {
+		ks[i] = strings.TrimPrefix(string(ck), cp)
+	}
+	if len(ks) < count {
+		return ks, "", nil
 	}
-	return rv, nil
+	return ks, ks[len(ks)-1], nil
 }
@@ -0,0 +1,151 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates the clients of the GraphQL server and gives the
+// resolvers the role of each client, which the @hasRole schema directive
+// checks on every mutation.
+//
+// Clients are authenticated with static bearer tokens, TLS client certificates
+// or OIDC JWTs signed by a key of a local JWKS file, as set up by Config. The
+// client side of these methods is in ClientOptions.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Role is the set of operations that a client may do. Roles are ordered, each
+// one granting everything that the previous ones grant.
+type Role int
+
+const (
+	// RoleNone grants nothing.
+	RoleNone Role = iota
+	// RoleReader may run queries and subscriptions.
+	RoleReader
+	// RoleWriter may also ingest new nodes.
+	RoleWriter
+	// RoleAdmin may also delete or retract nodes.
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleNone:   "none",
+	RoleReader: "reader",
+	RoleWriter: "writer",
+	RoleAdmin:  "admin",
+}
+
+// ParseRole parses the case insensitive name of a role.
+func ParseRole(name string) (Role, error) {
+	for role, n := range roleNames {
+		if role != RoleNone && strings.EqualFold(name, n) {
+			return role, nil
+		}
+	}
+	return RoleNone, fmt.Errorf("unknown role %q, expected one of reader, writer or admin", name)
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+// Includes reports whether r grants everything that other grants.
+func (r Role) Includes(other Role) bool {
+	return r >= other
+}
+
+// Principal is an authenticated client.
+type Principal struct {
+	// Subject names the client, such as the name of its token or the common
+	// name of its certificate.
+	Subject string
+	Role    Role
+	// Method is the authentication method that identified the client.
+	Method string
+}
+
+// ErrNoCredentials is returned by an Authenticator when a request carries no
+// credentials that it knows about, so that the next one can be tried.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Authenticator identifies the client of a request.
+type Authenticator interface {
+	// Authenticate returns the principal of the request, ErrNoCredentials if
+	// the request has no credentials for this method, or another error if the
+	// credentials are not valid.
+	Authenticate(r *http.Request) (*Principal, error)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal carried by ctx, if any.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// Middleware rejects the requests that none of the authenticators accept and
+// passes the others to next with their principal in the request context. The
+// authenticators are tried in order until one of them finds credentials.
+func Middleware(ctx context.Context, authenticators []Authenticator, next http.Handler) http.Handler {
+	logger := logging.FromContext(ctx)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, a := range authenticators {
+			p, err := a.Authenticate(r)
+			if errors.Is(err, ErrNoCredentials) {
+				continue
+			}
+			if err != nil {
+				logger.Infof("rejected request from %s: %v", r.RemoteAddr, err)
+				unauthorized(w)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), p)))
+			return
+		}
+		logger.Debugf("rejected request without credentials from %s", r.RemoteAddr)
+		unauthorized(w)
+	})
+}
+
+func unauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="guac"`)
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
+
+// bearerToken returns the bearer token of the Authorization header of r.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,243 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func digest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Role
+		wantErr bool
+	}{
+		{name: "reader", want: RoleReader},
+		{name: "WRITER", want: RoleWriter},
+		{name: "Admin", want: RoleAdmin},
+		{name: "none", wantErr: true},
+		{name: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseRole(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseRole(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseRole(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if !RoleAdmin.Includes(RoleWriter) || RoleReader.Includes(RoleWriter) {
+		t.Errorf("roles are not ordered")
+	}
+}
+
+func TestNewTokenAuthenticator(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens []Token
+	}{
+		{"no name", []Token{{SHA256: digest("a"), Role: "reader"}}},
+		{"bad digest", []Token{{Name: "a", SHA256: "abcd", Role: "reader"}}},
+		{"bad role", []Token{{Name: "a", SHA256: digest("a"), Role: "owner"}}},
+		{"duplicate", []Token{{Name: "a", SHA256: digest("a"), Role: "reader"}, {Name: "b", SHA256: digest("a"), Role: "writer"}}},
+	}
+	for _, tt := range tests {
+		if _, err := NewTokenAuthenticator(tt.tokens); err == nil {
+			t.Errorf("NewTokenAuthenticator() with %s succeeded", tt.name)
+		}
+	}
+}
+
+type rejectAll struct{}
+
+func (rejectAll) Authenticate(*http.Request) (*Principal, error) {
+	return nil, errors.New("rejected")
+}
+
+func TestMiddleware(t *testing.T) {
+	tokens, err := NewTokenAuthenticator([]Token{
+		{Name: "ingestor", SHA256: digest("s3cr3t"), Role: "writer"},
+	})
+	if err != nil {
+		t.Fatalf("NewTokenAuthenticator() error = %v", err)
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, ok := FromContext(r.Context())
+		if !ok {
+			t.Errorf("no principal in request context")
+			return
+		}
+		_, _ = w.Write([]byte(p.Subject + " " + p.Role.String()))
+	})
+
+	tests := []struct {
+		name           string
+		authenticators []Authenticator
+		header         string
+		wantStatus     int
+		wantBody       string
+	}{
+		{
+			name:           "known token",
+			authenticators: []Authenticator{tokens},
+			header:         "Bearer s3cr3t",
+			wantStatus:     http.StatusOK,
+			wantBody:       "ingestor writer",
+		},
+		{
+			name:           "case insensitive scheme",
+			authenticators: []Authenticator{tokens},
+			header:         "bearer s3cr3t",
+			wantStatus:     http.StatusOK,
+			wantBody:       "ingestor writer",
+		},
+		{
+			name:           "unknown token",
+			authenticators: []Authenticator{tokens},
+			header:         "Bearer guess",
+			wantStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:           "no credentials",
+			authenticators: []Authenticator{tokens},
+			wantStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:           "basic auth",
+			authenticators: []Authenticator{tokens},
+			header:         "Basic czNjcjN0",
+			wantStatus:     http.StatusUnauthorized,
+		},
+		{
+			name:           "first authenticator with credentials wins",
+			authenticators: []Authenticator{tokens, rejectAll{}},
+			header:         "Bearer s3cr3t",
+			wantStatus:     http.StatusOK,
+			wantBody:       "ingestor writer",
+		},
+		{
+			name:           "invalid credentials",
+			authenticators: []Authenticator{rejectAll{}, tokens},
+			header:         "Bearer s3cr3t",
+			wantStatus:     http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/query", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			Middleware(context.Background(), tt.authenticators, next).ServeHTTP(w, r)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", w.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("got body %q, want %q", w.Body.String(), tt.wantBody)
+			}
+			if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Errorf("no WWW-Authenticate header")
+			}
+		})
+	}
+}
+
+func TestClientCertAuthenticator(t *testing.T) {
+	withCert := func(cn string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/query", nil)
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}}}
+		return r
+	}
+	tests := []struct {
+		name        string
+		defaultRole string
+		request     *http.Request
+		want        Role
+		wantErr     error
+	}{
+		{
+			name:    "no TLS",
+			request: httptest.NewRequest(http.MethodPost, "/query", nil),
+			wantErr: ErrNoCredentials,
+		},
+		{
+			name:    "unverified certificate",
+			request: &http.Request{TLS: &tls.ConnectionState{}},
+			wantErr: ErrNoCredentials,
+		},
+		{
+			name:    "mapped common name",
+			request: withCert("guac-collector"),
+			want:    RoleWriter,
+		},
+		{
+			name:        "default role",
+			defaultRole: "reader",
+			request:     withCert("dashboard"),
+			want:        RoleReader,
+		},
+		{
+			name:    "no role",
+			request: withCert("dashboard"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewClientCertAuthenticator(&ClientCertConfig{
+				Roles:       map[string]string{"guac-collector": "writer"},
+				DefaultRole: tt.defaultRole,
+			})
+			if err != nil {
+				t.Fatalf("NewClientCertAuthenticator() error = %v", err)
+			}
+			p, err := a.Authenticate(tt.request)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if tt.want == RoleNone {
+				if err == nil {
+					t.Errorf("Authenticate() accepted a certificate without a role")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if p.Role != tt.want {
+				t.Errorf("Authenticate() role = %v, want %v", p.Role, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	"github.com/guacsec/guac/pkg/tracing"
+)
+
+// ClientOptions are the credentials that a client presents to the GraphQL
+// server. The zero value presents none.
+type ClientOptions struct {
+	// Token is a bearer token, either static or a JWT.
+	Token string
+	// CertFile and KeyFile are the paths to a PEM encoded TLS client
+	// certificate and its key.
+	CertFile string
+	KeyFile  string
+}
+
+// ValidateClientFlags checks the credential flags of a client.
+func ValidateClientFlags(token, certFile, keyFile string) (ClientOptions, error) {
+	if (certFile == "") != (keyFile == "") {
+		return ClientOptions{}, fmt.Errorf("both the client certificate and its key must be given")
+	}
+	return ClientOptions{
+		Token:    token,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	}, nil
+}
+
+// NewHTTPClient returns an HTTP client that presents the credentials of opts
+// with every request.
+func NewHTTPClient(opts ClientOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+	var rt http.RoundTripper = transport
+	if opts.Token != "" {
+		rt = &bearerTransport{token: opts.Token, next: transport}
+	}
+	// the trace context of the requests is propagated to the server
+	return &http.Client{Transport: tracing.Transport(rt)}, nil
+}
+
+type bearerTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the request it is given
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+t.token)
+	return t.next.RoundTrip(r)
+}
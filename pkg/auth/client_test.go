@@ -0,0 +1,83 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidateClientFlags(t *testing.T) {
+	if _, err := ValidateClientFlags("", "client.pem", ""); err == nil {
+		t.Errorf("ValidateClientFlags() accepted a certificate without its key")
+	}
+	if _, err := ValidateClientFlags("", "", "client.key"); err == nil {
+		t.Errorf("ValidateClientFlags() accepted a key without its certificate")
+	}
+	opts, err := ValidateClientFlags("s3cr3t", "client.pem", "client.key")
+	if err != nil {
+		t.Fatalf("ValidateClientFlags() error = %v", err)
+	}
+	if opts.Token != "s3cr3t" || opts.CertFile != "client.pem" || opts.KeyFile != "client.key" {
+		t.Errorf("ValidateClientFlags() = %+v", opts)
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	tokens, err := NewTokenAuthenticator([]Token{{Name: "ingestor", SHA256: digest("s3cr3t"), Role: "writer"}})
+	if err != nil {
+		t.Fatalf("NewTokenAuthenticator() error = %v", err)
+	}
+	ok := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	server := httptest.NewServer(Middleware(context.Background(), []Authenticator{tokens}, ok))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		opts       ClientOptions
+		wantStatus int
+	}{
+		{"no credentials", ClientOptions{}, http.StatusUnauthorized},
+		{"token", ClientOptions{Token: "s3cr3t"}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		client, err := NewHTTPClient(tt.opts)
+		if err != nil {
+			t.Fatalf("NewHTTPClient() error = %v", err)
+		}
+		req, err := http.NewRequest(http.MethodPost, server.URL, nil)
+		if err != nil {
+			t.Fatalf("error creating request: %v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: request failed: %v", tt.name, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.wantStatus {
+			t.Errorf("%s: got status %d, want %d", tt.name, resp.StatusCode, tt.wantStatus)
+		}
+		if req.Header.Get("Authorization") != "" {
+			t.Errorf("%s: client modified the request", tt.name)
+		}
+	}
+
+	if _, err := NewHTTPClient(ClientOptions{CertFile: "missing.pem", KeyFile: "missing.key"}); err == nil {
+		t.Errorf("NewHTTPClient() with a missing certificate succeeded")
+	}
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// ClientCertConfig configures the authentication of clients with TLS client
+// certificates issued by a trusted CA.
+type ClientCertConfig struct {
+	// CAFile is the path to the PEM encoded certificates of the CAs that
+	// issue client certificates.
+	CAFile string `yaml:"caFile"`
+	// Roles maps the common name of client certificates to their role.
+	Roles map[string]string `yaml:"roles"`
+	// DefaultRole is the role of the certificates whose common name is not in
+	// Roles. These certificates are rejected when it is empty.
+	DefaultRole string `yaml:"defaultRole"`
+}
+
+type clientCertAuthenticator struct {
+	roles       map[string]Role
+	defaultRole Role
+}
+
+// NewClientCertAuthenticator returns an Authenticator that accepts the
+// requests made with a verified client certificate. The server must verify
+// the certificates with the TLS configuration of TLSConfig.
+func NewClientCertAuthenticator(cfg *ClientCertConfig) (Authenticator, error) {
+	a := &clientCertAuthenticator{roles: map[string]Role{}}
+	for cn, name := range cfg.Roles {
+		role, err := ParseRole(name)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate %q: %w", cn, err)
+		}
+		a.roles[cn] = role
+	}
+	if cfg.DefaultRole != "" {
+		role, err := ParseRole(cfg.DefaultRole)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate default role: %w", err)
+		}
+		a.defaultRole = role
+	}
+	return a, nil
+}
+
+// Authenticate accepts requests whose client certificate was verified during
+// the TLS handshake.
+func (a *clientCertAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, ErrNoCredentials
+	}
+	cn := r.TLS.VerifiedChains[0][0].Subject.CommonName
+	role, ok := a.roles[cn]
+	if !ok {
+		role = a.defaultRole
+	}
+	if role == RoleNone {
+		return nil, fmt.Errorf("no role for client certificate %q", cn)
+	}
+	return &Principal{Subject: cn, Role: role, Method: "client-cert"}, nil
+}
+
+// TLSConfig returns the server TLS configuration that verifies the client
+// certificates given during the handshake. Clients without a certificate are
+// still allowed to connect, to authenticate with another method.
+func (cfg *ClientCertConfig) TLSConfig() (*tls.Config, error) {
+	pem, err := os.ReadFile(cfg.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in client CA file %s", cfg.CAFile)
+	}
+	return &tls.Config{
+		ClientCAs:  pool,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
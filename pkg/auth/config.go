@@ -0,0 +1,89 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config configures the authentication methods of the GraphQL server, each
+// section enabling one method:
+//
+//	tokens:
+//	  - name: ingestor
+//	    sha256: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+//	    role: writer
+//	clientCerts:
+//	  caFile: /etc/guac/client-ca.pem
+//	  roles:
+//	    guac-collector: writer
+//	  defaultRole: reader
+//	oidc:
+//	  issuer: https://accounts.example.com
+//	  audience: guac
+//	  jwksFile: /etc/guac/jwks.json
+type Config struct {
+	Tokens      []Token           `yaml:"tokens"`
+	ClientCerts *ClientCertConfig `yaml:"clientCerts"`
+	OIDC        *OIDCConfig       `yaml:"oidc"`
+}
+
+// LoadConfig reads and validates an authentication configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth config %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse auth config %s: %w", path, err)
+	}
+	if len(cfg.Tokens) == 0 && cfg.ClientCerts == nil && cfg.OIDC == nil {
+		return nil, fmt.Errorf("auth config %s enables no authentication method", path)
+	}
+	return &cfg, nil
+}
+
+// Authenticators returns the authenticators of the enabled methods. Client
+// certificates are tried first, then static tokens and then JWTs.
+func (c *Config) Authenticators() ([]Authenticator, error) {
+	var authenticators []Authenticator
+	if c.ClientCerts != nil {
+		a, err := NewClientCertAuthenticator(c.ClientCerts)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, a)
+	}
+	if len(c.Tokens) > 0 {
+		a, err := NewTokenAuthenticator(c.Tokens)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, a)
+	}
+	if c.OIDC != nil {
+		a, err := NewOIDCAuthenticator(c.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, a)
+	}
+	return authenticators, nil
+}
@@ -0,0 +1,176 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// defaultRoleClaim is the JWT claim holding the role of the client when
+// OIDCConfig.RoleClaim is not set.
+const defaultRoleClaim = "guac_role"
+
+// OIDCConfig configures the authentication of clients with JWTs issued by an
+// OIDC provider. The tokens are verified with a local copy of the JWKS of the
+// provider, so that the server does not depend on the provider being
+// reachable.
+type OIDCConfig struct {
+	// Issuer must match the iss claim of the tokens.
+	Issuer string `yaml:"issuer"`
+	// Audience must be in the aud claim of the tokens.
+	Audience string `yaml:"audience"`
+	// JWKSFile is the path to the JSON Web Key Set of the provider.
+	JWKSFile string `yaml:"jwksFile"`
+	// RoleClaim is the claim holding the role of the client, either as a
+	// string or as a list of strings of which the highest role is granted.
+	// Defaults to guac_role.
+	RoleClaim string `yaml:"roleClaim"`
+	// DefaultRole is the role of the tokens without a role claim. These
+	// tokens are rejected when it is empty.
+	DefaultRole string `yaml:"defaultRole"`
+}
+
+// signatureAlgorithms are the accepted JWT signature algorithms. Symmetric
+// algorithms are left out as the keys of a JWKS are public.
+var signatureAlgorithms = map[string]bool{
+	string(jose.RS256): true, string(jose.RS384): true, string(jose.RS512): true,
+	string(jose.PS256): true, string(jose.PS384): true, string(jose.PS512): true,
+	string(jose.ES256): true, string(jose.ES384): true, string(jose.ES512): true,
+	string(jose.EdDSA): true,
+}
+
+type oidcAuthenticator struct {
+	cfg         OIDCConfig
+	keys        jose.JSONWebKeySet
+	defaultRole Role
+	now         func() time.Time
+}
+
+// NewOIDCAuthenticator returns an Authenticator that accepts bearer JWTs
+// signed by a key of the JWKS file of the configuration.
+func NewOIDCAuthenticator(cfg *OIDCConfig) (Authenticator, error) {
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, fmt.Errorf("oidc issuer and audience must be set")
+	}
+	data, err := os.ReadFile(cfg.JWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+	a := &oidcAuthenticator{cfg: *cfg, now: time.Now}
+	if err := json.Unmarshal(data, &a.keys); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file %s: %w", cfg.JWKSFile, err)
+	}
+	if len(a.keys.Keys) == 0 {
+		return nil, fmt.Errorf("no key found in JWKS file %s", cfg.JWKSFile)
+	}
+	if a.cfg.RoleClaim == "" {
+		a.cfg.RoleClaim = defaultRoleClaim
+	}
+	if cfg.DefaultRole != "" {
+		if a.defaultRole, err = ParseRole(cfg.DefaultRole); err != nil {
+			return nil, fmt.Errorf("oidc default role: %w", err)
+		}
+	}
+	return a, nil
+}
+
+// Authenticate accepts requests with a valid bearer JWT. Bearer tokens that
+// are not JWTs are left to the next authenticator.
+func (a *oidcAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	raw, ok := bearerToken(r)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, ErrNoCredentials
+	}
+	if len(token.Headers) != 1 || !signatureAlgorithms[token.Headers[0].Algorithm] {
+		return nil, fmt.Errorf("unsupported JWT signature")
+	}
+
+	var claims jwt.Claims
+	custom := map[string]interface{}{}
+	if err := a.verify(token, &claims, &custom); err != nil {
+		return nil, err
+	}
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("JWT has no expiry")
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:   a.cfg.Issuer,
+		Audience: jwt.Audience{a.cfg.Audience},
+		Time:     a.now(),
+	}, jwt.DefaultLeeway); err != nil {
+		return nil, fmt.Errorf("invalid JWT claims: %w", err)
+	}
+
+	role := roleFromClaim(custom[a.cfg.RoleClaim])
+	if role == RoleNone {
+		role = a.defaultRole
+	}
+	if role == RoleNone {
+		return nil, fmt.Errorf("no role for JWT subject %q", claims.Subject)
+	}
+	return &Principal{Subject: claims.Subject, Role: role, Method: "oidc"}, nil
+}
+
+// verify checks the signature of the token with the key named by its kid
+// header, or with every signing key when it has none.
+func (a *oidcAuthenticator) verify(token *jwt.JSONWebToken, out ...interface{}) error {
+	keys := a.keys.Keys
+	if kid := token.Headers[0].KeyID; kid != "" {
+		keys = a.keys.Key(kid)
+	}
+	for _, key := range keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if err := token.Claims(key, out...); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("JWT signature not verified by any key")
+}
+
+// roleFromClaim returns the highest known role of a claim value.
+func roleFromClaim(value interface{}) Role {
+	var names []string
+	switch v := value.(type) {
+	case string:
+		names = []string{v}
+	case []interface{}:
+		for _, n := range v {
+			if s, ok := n.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+	highest := RoleNone
+	for _, name := range names {
+		if role, err := ParseRole(name); err == nil && role > highest {
+			highest = role
+		}
+	}
+	return highest
+}
@@ -0,0 +1,201 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	testIssuer   = "https://accounts.example.com"
+	testAudience = "guac"
+)
+
+func TestOIDCAuthenticator(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: key.Public(), KeyID: "k1", Algorithm: string(jose.ES256), Use: "sig"},
+	}}
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatalf("error marshaling JWKS: %v", err)
+	}
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksFile, data, 0o600); err != nil {
+		t.Fatalf("error writing JWKS: %v", err)
+	}
+
+	sign := func(signingKey interface{}, kid string, claims jwt.Claims, custom map[string]interface{}) string {
+		signer, err := jose.NewSigner(jose.SigningKey{
+			Algorithm: jose.ES256,
+			Key:       jose.JSONWebKey{Key: signingKey, KeyID: kid},
+		}, (&jose.SignerOptions{}).WithType("JWT"))
+		if err != nil {
+			t.Fatalf("error creating signer: %v", err)
+		}
+		token, err := jwt.Signed(signer).Claims(claims).Claims(custom).CompactSerialize()
+		if err != nil {
+			t.Fatalf("error signing token: %v", err)
+		}
+		return token
+	}
+	now := time.Now()
+	valid := jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "ci-pipeline",
+		Audience: jwt.Audience{testAudience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	with := func(change func(*jwt.Claims)) jwt.Claims {
+		c := valid
+		change(&c)
+		return c
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		defaultRole string
+		want        Role
+		wantNoCreds bool
+	}{
+		{
+			name:  "writer",
+			token: sign(key, "k1", valid, map[string]interface{}{"guac_role": "writer"}),
+			want:  RoleWriter,
+		},
+		{
+			name:  "highest role of a list",
+			token: sign(key, "k1", valid, map[string]interface{}{"guac_role": []string{"reader", "admin", "unknown"}}),
+			want:  RoleAdmin,
+		},
+		{
+			name:  "no kid",
+			token: sign(key, "", valid, map[string]interface{}{"guac_role": "reader"}),
+			want:  RoleReader,
+		},
+		{
+			name:        "default role",
+			token:       sign(key, "k1", valid, nil),
+			defaultRole: "reader",
+			want:        RoleReader,
+		},
+		{
+			name:  "no role",
+			token: sign(key, "k1", valid, nil),
+		},
+		{
+			name:  "expired",
+			token: sign(key, "k1", with(func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(now.Add(-time.Hour)) }), map[string]interface{}{"guac_role": "writer"}),
+		},
+		{
+			name:  "no expiry",
+			token: sign(key, "k1", with(func(c *jwt.Claims) { c.Expiry = nil }), map[string]interface{}{"guac_role": "writer"}),
+		},
+		{
+			name:  "other issuer",
+			token: sign(key, "k1", with(func(c *jwt.Claims) { c.Issuer = "https://evil.example.com" }), map[string]interface{}{"guac_role": "writer"}),
+		},
+		{
+			name:  "other audience",
+			token: sign(key, "k1", with(func(c *jwt.Claims) { c.Audience = jwt.Audience{"other"} }), map[string]interface{}{"guac_role": "writer"}),
+		},
+		{
+			name:  "unknown key",
+			token: sign(otherKey, "k1", valid, map[string]interface{}{"guac_role": "writer"}),
+		},
+		{
+			name:        "not a JWT",
+			token:       "s3cr3t",
+			wantNoCreds: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewOIDCAuthenticator(&OIDCConfig{
+				Issuer:      testIssuer,
+				Audience:    testAudience,
+				JWKSFile:    jwksFile,
+				DefaultRole: tt.defaultRole,
+			})
+			if err != nil {
+				t.Fatalf("NewOIDCAuthenticator() error = %v", err)
+			}
+			r := httptest.NewRequest(http.MethodPost, "/query", nil)
+			r.Header.Set("Authorization", "Bearer "+tt.token)
+			p, err := a.Authenticate(r)
+			if tt.wantNoCreds {
+				if !errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() error = %v, want %v", err, ErrNoCredentials)
+				}
+				return
+			}
+			if tt.want == RoleNone {
+				if err == nil || errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() error = %v, want a rejection", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if p.Subject != "ci-pipeline" || p.Role != tt.want {
+				t.Errorf("Authenticate() = %+v, want ci-pipeline with role %v", p, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewOIDCAuthenticator(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(empty, []byte(`{"keys": []}`), 0o600); err != nil {
+		t.Fatalf("error writing JWKS: %v", err)
+	}
+	tests := []struct {
+		name string
+		cfg  OIDCConfig
+	}{
+		{"no issuer", OIDCConfig{Audience: testAudience, JWKSFile: empty}},
+		{"missing JWKS", OIDCConfig{Issuer: testIssuer, Audience: testAudience, JWKSFile: "missing.json"}},
+		{"empty JWKS", OIDCConfig{Issuer: testIssuer, Audience: testAudience, JWKSFile: empty}},
+	}
+	for _, tt := range tests {
+		if _, err := NewOIDCAuthenticator(&tt.cfg); err == nil {
+			t.Errorf("NewOIDCAuthenticator() with %s succeeded", tt.name)
+		}
+	}
+}
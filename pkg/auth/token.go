@@ -0,0 +1,74 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+)
+
+// Token is a static bearer token. Only the hex encoded SHA-256 digest of the
+// token is configured, so that the configuration holds no secret.
+type Token struct {
+	Name   string `yaml:"name"`
+	SHA256 string `yaml:"sha256"`
+	Role   string `yaml:"role"`
+}
+
+type tokenAuthenticator struct {
+	principals map[[sha256.Size]byte]*Principal
+}
+
+// NewTokenAuthenticator returns an Authenticator that accepts the given bearer
+// tokens.
+func NewTokenAuthenticator(tokens []Token) (Authenticator, error) {
+	a := &tokenAuthenticator{principals: map[[sha256.Size]byte]*Principal{}}
+	for _, t := range tokens {
+		if t.Name == "" {
+			return nil, fmt.Errorf("token has no name")
+		}
+		digest, err := hex.DecodeString(t.SHA256)
+		if err != nil || len(digest) != sha256.Size {
+			return nil, fmt.Errorf("token %q has an invalid sha256 digest", t.Name)
+		}
+		role, err := ParseRole(t.Role)
+		if err != nil {
+			return nil, fmt.Errorf("token %q: %w", t.Name, err)
+		}
+		var key [sha256.Size]byte
+		copy(key[:], digest)
+		if _, ok := a.principals[key]; ok {
+			return nil, fmt.Errorf("token %q is configured twice", t.Name)
+		}
+		a.principals[key] = &Principal{Subject: t.Name, Role: role, Method: "token"}
+	}
+	return a, nil
+}
+
+// Authenticate accepts requests with a known bearer token. Unknown tokens are
+// left to the next authenticator, as they can be JWTs.
+func (a *tokenAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	if p, ok := a.principals[sha256.Sum256([]byte(token))]; ok {
+		return p, nil
+	}
+	return nil, ErrNoCredentials
+}
@@ -45,8 +45,28 @@ type Metadata struct {
 // Result defines the Vulnerability ID and its alias. There can be multiple
 // results per artifact
 type Result struct {
-	VulnerabilityId string   `json:"vulnerability_id,omitempty"`
-	Aliases         []string `json:"aliases,omitempty"`
+	VulnerabilityId string          `json:"vulnerability_id,omitempty"`
+	Aliases         []string        `json:"aliases,omitempty"`
+	Severity        []Severity      `json:"severity,omitempty"`
+	Affected        []AffectedRange `json:"affected,omitempty"`
+}
+
+// Severity defines a score of the vulnerability, in the format of the
+// scoring method, such as a CVSS vector
+type Severity struct {
+	Method string `json:"method,omitempty"`
+	Score  string `json:"score,omitempty"`
+}
+
+// AffectedRange defines a range of versions of the subject affected by the
+// vulnerability. Versions from Introduced are affected, up to the Fixed
+// version, or up to and including LastAffected. An empty end leaves the
+// range open.
+type AffectedRange struct {
+	Type         string `json:"type,omitempty"`
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
 }
 
 // DB defines the scanner database used at the time of scan
@@ -273,7 +273,7 @@ func testSubscribe(ctx context.Context, transportFunc func(processor.DocumentTre
 		return err
 	}
 
-	processFunc := func(d []byte) error {
+	processFunc := func(_ context.Context, d []byte) error {
 		doc := processor.Document{}
 		err := json.Unmarshal(d, &doc)
 		if err != nil {
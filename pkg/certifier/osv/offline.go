@@ -0,0 +1,263 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/osv-scanner/pkg/models"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+type offlineOSVCertifier struct {
+	db *offlineDB
+}
+
+// NewOfflineOSVCertificationParser initializes an OSV certifier that looks up
+// vulnerabilities in the OSV ecosystem exports (such as PyPI/all.zip from
+// https://osv-vulnerabilities.storage.googleapis.com) found under dir,
+// instead of querying osv.dev.
+func NewOfflineOSVCertificationParser(dir string) (certifier.Certifier, error) {
+	db, err := loadOfflineDB(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &offlineOSVCertifier{db: db}, nil
+}
+
+// CertifyComponent takes in the root component from the gauc database and
+// generates vulnerability attestations from the local OSV database
+func (o *offlineOSVCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	packageNodes, ok := rootComponent.([]*root_package.PackageNode)
+	if !ok {
+		return ErrOSVComponenetTypeMismatch
+	}
+	logger := logging.FromContext(ctx)
+
+	var purls []string
+	packMap := map[string][]*root_package.PackageNode{}
+	for _, node := range packageNodes {
+		if _, ok := packMap[node.Purl]; !ok {
+			purls = append(purls, node.Purl)
+		}
+		packMap[node.Purl] = append(packMap[node.Purl], node)
+	}
+
+	for _, purl := range purls {
+		var vulns []models.Vulnerability
+		pkg, err := models.PURLToPackage(purl)
+		if err != nil {
+			logger.Debugf("unable to look up %s in the OSV database: %v", purl, err)
+		} else {
+			vulns = o.db.query(pkg.Ecosystem, pkg.Name, pkg.Version)
+		}
+		if err := generateDocument(packMap[purl], vulns, docChannel); err != nil {
+			return fmt.Errorf("could not generate document from OSV results: %w", err)
+		}
+	}
+	return nil
+}
+
+// offlineDB indexes the affected entries of OSV vulnerabilities by the
+// ecosystem and name of their package.
+type offlineDB struct {
+	packages map[string][]affectedEntry
+}
+
+type affectedEntry struct {
+	vuln     *models.Vulnerability
+	affected models.Affected
+}
+
+// loadOfflineDB loads the vulnerabilities of every zip file under dir.
+func loadOfflineDB(dir string) (*offlineDB, error) {
+	db := &offlineDB{packages: map[string][]affectedEntry{}}
+	var zips int
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.EqualFold(filepath.Ext(path), ".zip") {
+			return nil
+		}
+		zips++
+		return db.loadZip(path)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load OSV database from %s: %w", dir, err)
+	}
+	if zips == 0 {
+		return nil, fmt.Errorf("no OSV database zip files found in %s", dir)
+	}
+	return db, nil
+}
+
+func (db *offlineDB) loadZip(path string) error {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("unable to open %s: %w", path, err)
+	}
+	defer r.Close()
+	for _, f := range r.File {
+		if !strings.HasSuffix(f.Name, ".json") {
+			continue
+		}
+		vuln, err := readVulnerability(f)
+		if err != nil {
+			return fmt.Errorf("unable to read %s from %s: %w", f.Name, path, err)
+		}
+		db.add(vuln)
+	}
+	return nil
+}
+
+func readVulnerability(f *zip.File) (*models.Vulnerability, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	var vuln models.Vulnerability
+	if err := json.Unmarshal(data, &vuln); err != nil {
+		return nil, err
+	}
+	return &vuln, nil
+}
+
+func (db *offlineDB) add(vuln *models.Vulnerability) {
+	if !vuln.Withdrawn.IsZero() {
+		return
+	}
+	for _, affected := range vuln.Affected {
+		key := packageKey(string(affected.Package.Ecosystem), affected.Package.Name)
+		db.packages[key] = append(db.packages[key], affectedEntry{vuln: vuln, affected: affected})
+	}
+}
+
+var pypiNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// packageKey returns the index key of a package. Ecosystem releases, as in
+// "Debian:11", are dropped and PyPI names are normalized as PEP 503 does.
+func packageKey(ecosystem, name string) string {
+	ecosystem, _, _ = strings.Cut(ecosystem, ":")
+	if ecosystem == string(models.EcosystemPyPI) {
+		name = pypiNameSeparators.ReplaceAllString(strings.ToLower(name), "-")
+	}
+	return ecosystem + "/" + name
+}
+
+// query returns the vulnerabilities that affect the version of a package.
+func (db *offlineDB) query(ecosystem, name, version string) []models.Vulnerability {
+	var vulns []models.Vulnerability
+	seen := map[string]bool{}
+	for _, entry := range db.packages[packageKey(ecosystem, name)] {
+		if seen[entry.vuln.ID] || !affectsVersion(entry.affected, ecosystem, version) {
+			continue
+		}
+		seen[entry.vuln.ID] = true
+		vulns = append(vulns, *entry.vuln)
+	}
+	return vulns
+}
+
+// affectsVersion reports whether the version is listed by the affected entry
+// or falls in one of its ranges. Git ranges are skipped, as only the commits
+// of the source repository can be matched against them.
+func affectsVersion(affected models.Affected, ecosystem, version string) bool {
+	ecosystem, _, _ = strings.Cut(ecosystem, ":")
+	for _, v := range affected.Versions {
+		if v == version {
+			return true
+		}
+	}
+	if version == "" {
+		return false
+	}
+	for _, r := range affected.Ranges {
+		if r.Type == models.RangeGit {
+			continue
+		}
+		if inRange(r, ecosystem, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// inRange evaluates the events of a range in version order, as the OSV
+// schema describes.
+func inRange(r models.Range, ecosystem, version string) bool {
+	compare := func(a, b string) int {
+		return compareVersions(r.Type, ecosystem, a, b)
+	}
+	eventVersion := func(e models.Event) string {
+		switch {
+		case e.Introduced != "":
+			return e.Introduced
+		case e.Fixed != "":
+			return e.Fixed
+		case e.LastAffected != "":
+			return e.LastAffected
+		}
+		return e.Limit
+	}
+	events := append([]models.Event(nil), r.Events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := eventVersion(events[i]), eventVersion(events[j])
+		if a == "0" || b == "0" {
+			return a == "0" && b != "0"
+		}
+		return compare(a, b) < 0
+	})
+
+	affected := false
+	for _, e := range events {
+		switch {
+		case e.Introduced != "":
+			if e.Introduced == "0" || compare(version, e.Introduced) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if compare(version, e.Fixed) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if compare(version, e.LastAffected) > 0 {
+				affected = false
+			}
+		case e.Limit != "":
+			if compare(version, e.Limit) >= 0 {
+				return false
+			}
+		}
+	}
+	return affected
+}
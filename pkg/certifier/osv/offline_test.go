@@ -0,0 +1,233 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scanner/pkg/models"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_compareVersions(t *testing.T) {
+	tests := []struct {
+		ecosystem models.Ecosystem
+		a, b      string
+		want      int
+	}{
+		{models.EcosystemGo, "v1.2.3", "1.2.3", 0},
+		{models.EcosystemGo, "v1.10.0", "v1.9.9", 1},
+		{models.EcosystemGo, "v1.0.0-rc.1", "v1.0.0", -1},
+		{models.EcosystemGo, "v1.0.0-alpha.2", "v1.0.0-alpha.10", -1},
+		{models.EcosystemGo, "v1.0.0-alpha", "v1.0.0-alpha.1", -1},
+		{models.EcosystemGo, "v0.0.0-20230101000000-abcdef", "v0.0.1", -1},
+		{models.EcosystemNPM, "2.0.0", "2.0.0+build.1", 0},
+		{models.EcosystemPyPI, "1.0", "1.0.0", 0},
+		{models.EcosystemPyPI, "1.0.dev1", "1.0a1", -1},
+		{models.EcosystemPyPI, "1.0a1", "1.0b1", -1},
+		{models.EcosystemPyPI, "1.0rc1", "1.0", -1},
+		{models.EcosystemPyPI, "1.0", "1.0.post1", -1},
+		{models.EcosystemPyPI, "1.0.post1.dev1", "1.0.post1", -1},
+		{models.EcosystemPyPI, "1.0-1", "1.0.post1", 0},
+		{models.EcosystemPyPI, "1!0.1", "2.0", 1},
+		{models.EcosystemPyPI, "1.10", "1.9", 1},
+		{models.EcosystemMaven, "1.0", "1", 0},
+		{models.EcosystemMaven, "1-ga", "1", 0},
+		{models.EcosystemMaven, "1.0-alpha-1", "1.0", -1},
+		{models.EcosystemMaven, "1.0-alpha1", "1.0-beta1", -1},
+		{models.EcosystemMaven, "1.0-rc1", "1.0-cr1", 0},
+		{models.EcosystemMaven, "1.0-SNAPSHOT", "1.0", -1},
+		{models.EcosystemMaven, "1.0", "1.0-sp1", -1},
+		{models.EcosystemMaven, "1.0-sp1", "1.0.1", -1},
+		{models.EcosystemMaven, "2.17.0", "2.9.1", 1},
+		{models.EcosystemMaven, "1.0-foo", "1.0-sp", 1},
+		{models.EcosystemNuGet, "1.0.0.0", "1.0.0", 0},
+		{models.EcosystemNuGet, "1.0.0.1", "1.0.0", 1},
+		{models.EcosystemNuGet, "1.0.0-Beta", "1.0.0-beta", 0},
+		{models.EcosystemNuGet, "1.0.0-beta", "1.0.0", -1},
+		{models.EcosystemRubyGems, "1.0", "1", 0},
+		{models.EcosystemRubyGems, "1.0.a", "1.a", 0},
+		{models.EcosystemRubyGems, "1.0.0.pre1", "1.0.0", -1},
+		{models.EcosystemRubyGems, "1.0.0-rc1", "1.0.0.pre.rc1", 0},
+		{models.EcosystemRubyGems, "1.0.a", "1.0.b", -1},
+		{models.EcosystemRubyGems, "1.10", "1.9", 1},
+		{models.EcosystemPackagist, "v1.0.0", "1.0.0", 0},
+		{models.EcosystemPackagist, "1.0.0-dev", "1.0.0-alpha1", -1},
+		{models.EcosystemPackagist, "1.0.0-alpha1", "1.0.0-beta1", -1},
+		{models.EcosystemPackagist, "1.0.0-RC1", "1.0.0", -1},
+		{models.EcosystemPackagist, "1.0.0", "1.0.0-pl1", -1},
+		{models.EcosystemPackagist, "1.0", "1.0.1", -1},
+		{models.EcosystemDebian, "1.2.10", "1.2.9", 1},
+		{models.EcosystemDebian, "1:0.9", "2.0", 1},
+		{models.EcosystemDebian, "1.0~rc1", "1.0", -1},
+		{models.EcosystemDebian, "1.0", "1.0+deb11u1", -1},
+		{models.EcosystemDebian, "1.0-1", "1.0-1ubuntu1", -1},
+		{models.EcosystemDebian, "1.0-2", "1.0-10", -1},
+		{models.EcosystemDebian, "1.0a", "1.0+", -1},
+		{models.EcosystemAlpine, "1.2.3-r0", "1.2.3-r10", -1},
+		{models.EcosystemAlpine, "1.2.3", "1.2.3a", -1},
+		{models.EcosystemAlpine, "1.2.3_rc1", "1.2.3", -1},
+		{models.EcosystemAlpine, "1.2.3_alpha2", "1.2.3_beta1", -1},
+		{models.EcosystemAlpine, "1.2.3", "1.2.3_p1", -1},
+		{models.EcosystemAlpine, "1.2", "1.2.1", -1},
+		{models.EcosystemAlpine, "1.10", "1.9", 1},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.ecosystem)+" "+tt.a+" "+tt.b, func(t *testing.T) {
+			if got := compareVersions(models.RangeEcosystem, string(tt.ecosystem), tt.a, tt.b); got != tt.want {
+				t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := compareVersions(models.RangeEcosystem, string(tt.ecosystem), tt.b, tt.a); got != -tt.want {
+				t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+// writeOSVZip writes the vulnerabilities as an OSV ecosystem export.
+func writeOSVZip(t *testing.T, path string, vulns ...models.Vulnerability) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for _, vuln := range vulns {
+		entry, err := w.Create(vuln.ID + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(vuln)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := entry.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func ecosystemRange(events ...models.Event) []models.Range {
+	return []models.Range{{Type: models.RangeEcosystem, Events: events}}
+}
+
+func TestOfflineOSVCertifier(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	dir := t.TempDir()
+	writeOSVZip(t, filepath.Join(dir, "Maven", "all.zip"), models.Vulnerability{
+		ID:      "GHSA-jfh8-c2jp-5v3q",
+		Aliases: []string{"CVE-2021-44228"},
+		Affected: []models.Affected{{
+			Package: models.Package{Ecosystem: models.EcosystemMaven, Name: "org.apache.logging.log4j:log4j-core"},
+			Ranges: ecosystemRange(
+				models.Event{Introduced: "2.0-beta9"},
+				models.Event{Fixed: "2.3.1"},
+				models.Event{Introduced: "2.4"},
+				models.Event{Fixed: "2.12.2"},
+				models.Event{Introduced: "2.13.0"},
+				models.Event{Fixed: "2.15.0"},
+			),
+		}},
+	})
+	writeOSVZip(t, filepath.Join(dir, "PyPI", "all.zip"), models.Vulnerability{
+		ID: "PYSEC-2021-1",
+		Affected: []models.Affected{{
+			Package:  models.Package{Ecosystem: models.EcosystemPyPI, Name: "Some_Package"},
+			Versions: []string{"0.9"},
+			Ranges:   ecosystemRange(models.Event{Introduced: "1.0"}, models.Event{LastAffected: "1.4"}),
+		}},
+	}, models.Vulnerability{
+		ID: "PYSEC-2021-2",
+		Affected: []models.Affected{{
+			Package: models.Package{Ecosystem: models.EcosystemPyPI, Name: "some-package"},
+			Ranges:  ecosystemRange(models.Event{Introduced: "0"}),
+		}},
+	})
+	writeOSVZip(t, filepath.Join(dir, "Go.zip"), models.Vulnerability{
+		ID: "GO-2022-0001",
+		Affected: []models.Affected{{
+			Package: models.Package{Ecosystem: models.EcosystemGo, Name: "github.com/example/module"},
+			Ranges: []models.Range{{
+				Type:   models.RangeSemVer,
+				Events: []models.Event{{Introduced: "0"}, {Fixed: "1.2.0"}},
+			}},
+		}},
+	})
+
+	c, err := NewOfflineOSVCertificationParser(dir)
+	if err != nil {
+		t.Fatalf("NewOfflineOSVCertificationParser() error = %v", err)
+	}
+	tests := []struct {
+		purl string
+		want []string
+	}{
+		{"pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", []string{"GHSA-jfh8-c2jp-5v3q"}},
+		{"pkg:maven/org.apache.logging.log4j/log4j-core@2.3", []string{"GHSA-jfh8-c2jp-5v3q"}},
+		{"pkg:maven/org.apache.logging.log4j/log4j-core@2.3.1", nil},
+		{"pkg:maven/org.apache.logging.log4j/log4j-core@2.0-beta8", nil},
+		{"pkg:maven/org.apache.logging.log4j/log4j-core@2.15.0", nil},
+		{"pkg:pypi/some.package@0.9", []string{"PYSEC-2021-1", "PYSEC-2021-2"}},
+		{"pkg:pypi/some-package@1.4", []string{"PYSEC-2021-1", "PYSEC-2021-2"}},
+		{"pkg:pypi/some-package@1.4.post1", []string{"PYSEC-2021-2"}},
+		{"pkg:golang/github.com/example/module@v1.1.9", []string{"GO-2022-0001"}},
+		{"pkg:golang/github.com/example/module@v1.2.0", nil},
+		{"pkg:npm/unknown@1.0.0", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			docChan := make(chan *processor.Document, 1)
+			if err := c.CertifyComponent(ctx, []*root_package.PackageNode{{Purl: tt.purl}}, docChan); err != nil {
+				t.Fatalf("CertifyComponent() error = %v", err)
+			}
+			var statement attestation_vuln.VulnerabilityStatement
+			if err := json.Unmarshal((<-docChan).Blob, &statement); err != nil {
+				t.Fatal(err)
+			}
+			if statement.Subject[0].Name != tt.purl {
+				t.Errorf("subject = %q, want %q", statement.Subject[0].Name, tt.purl)
+			}
+			var got []string
+			for _, result := range statement.Predicate.Scanner.Result {
+				got = append(got, result.VulnerabilityId)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("CertifyComponent() unexpected vulnerabilities (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOfflineOSVCertifier_NoDatabase(t *testing.T) {
+	if _, err := NewOfflineOSVCertificationParser(t.TempDir()); err == nil {
+		t.Error("NewOfflineOSVCertificationParser() of an empty directory did not fail")
+	}
+}
@@ -24,8 +24,10 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 
+	"github.com/google/osv-scanner/pkg/models"
 	osv_scanner "github.com/google/osv-scanner/pkg/osv"
 	intoto "github.com/in-toto/in-toto-golang/in_toto"
+	"github.com/package-url/packageurl-go"
 
 	"github.com/guacsec/guac/pkg/certifier"
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
@@ -80,8 +82,14 @@ func (o *osvCertifier) CertifyComponent(ctx context.Context, rootComponent inter
 	if err != nil {
 		return fmt.Errorf("osv.dev batched request failed: %w", err)
 	}
+	// the batched query only returns IDs, fetch the full records for the
+	// aliases, severities and affected ranges
+	hydrated, err := osv_scanner.HydrateWithClient(resp, o.osvHTTPClient)
+	if err != nil {
+		return fmt.Errorf("osv.dev vulnerability request failed: %w", err)
+	}
 	for i, query := range query.Queries {
-		response := resp.Results[i]
+		response := hydrated.Results[i]
 		purl := query.Package.PURL
 		if err := generateDocument(packMap[purl], response.Vulns, docChannel); err != nil {
 			return fmt.Errorf("could not generate document from OSV results: %w", err)
@@ -90,7 +98,7 @@ func (o *osvCertifier) CertifyComponent(ctx context.Context, rootComponent inter
 	return nil
 }
 
-func generateDocument(packNodes []*root_package.PackageNode, vulns []osv_scanner.MinimalVulnerability, docChannel chan<- *processor.Document) error {
+func generateDocument(packNodes []*root_package.PackageNode, vulns []models.Vulnerability, docChannel chan<- *processor.Document) error {
 	currentTime := time.Now()
 	for _, node := range packNodes {
 		payload, err := json.Marshal(createAttestation(node, vulns, currentTime))
@@ -111,7 +119,7 @@ func generateDocument(packNodes []*root_package.PackageNode, vulns []osv_scanner
 	return nil
 }
 
-func createAttestation(packageNode *root_package.PackageNode, vulns []osv_scanner.MinimalVulnerability, currentTime time.Time) *attestation_vuln.VulnerabilityStatement {
+func createAttestation(packageNode *root_package.PackageNode, vulns []models.Vulnerability, currentTime time.Time) *attestation_vuln.VulnerabilityStatement {
 
 	attestation := &attestation_vuln.VulnerabilityStatement{
 		StatementHeader: intoto.StatementHeader{
@@ -138,9 +146,104 @@ func createAttestation(packageNode *root_package.PackageNode, vulns []osv_scanne
 	attestation.StatementHeader.Subject = []intoto.Subject{subject}
 
 	for _, vuln := range vulns {
+		affected := affectedEntries(vuln, packageNode.Purl)
 		attestation.Predicate.Scanner.Result = append(attestation.Predicate.Scanner.Result, attestation_vuln.Result{
 			VulnerabilityId: vuln.ID,
+			Aliases:         vuln.Aliases,
+			Severity:        severities(vuln, affected),
+			Affected:        affectedRanges(affected),
 		})
 	}
 	return attestation
 }
+
+// affectedEntries returns the affected entries of the vulnerability that are
+// about the package of purl. OSV returned the vulnerability for purl, so if
+// none can be matched by name, all entries are returned.
+func affectedEntries(vuln models.Vulnerability, purl string) []models.Affected {
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return vuln.Affected
+	}
+	var matched []models.Affected
+	for _, affected := range vuln.Affected {
+		if affectsPackage(affected.Package, p) {
+			matched = append(matched, affected)
+		}
+	}
+	if len(matched) == 0 {
+		return vuln.Affected
+	}
+	return matched
+}
+
+func affectsPackage(pkg models.Package, p packageurl.PackageURL) bool {
+	if pkg.Purl != "" {
+		affected, err := packageurl.FromString(pkg.Purl)
+		return err == nil && affected.Type == p.Type && affected.Namespace == p.Namespace && affected.Name == p.Name
+	}
+	// OSV package names join the namespace and name the way the ecosystem
+	// does, such as "group:artifact" for Maven and "@scope/name" for npm
+	switch pkg.Name {
+	case p.Name, p.Namespace + "/" + p.Name, p.Namespace + ":" + p.Name:
+		return true
+	}
+	return false
+}
+
+// severities returns the scores of the vulnerability and of its affected
+// entries, without duplicates.
+func severities(vuln models.Vulnerability, affected []models.Affected) []attestation_vuln.Severity {
+	var result []attestation_vuln.Severity
+	seen := map[attestation_vuln.Severity]bool{}
+	add := func(severity []models.Severity) {
+		for _, s := range severity {
+			sev := attestation_vuln.Severity{Method: string(s.Type), Score: s.Score}
+			if !seen[sev] {
+				seen[sev] = true
+				result = append(result, sev)
+			}
+		}
+	}
+	add(vuln.Severity)
+	for _, a := range affected {
+		add(a.Severity)
+	}
+	return result
+}
+
+// affectedRanges turns the events of the affected entries into ranges. Git
+// ranges are skipped, their events are commits rather than versions of the
+// package.
+func affectedRanges(affected []models.Affected) []attestation_vuln.AffectedRange {
+	var result []attestation_vuln.AffectedRange
+	for _, a := range affected {
+		for _, r := range a.Ranges {
+			if r.Type == models.RangeGit {
+				continue
+			}
+			var current *attestation_vuln.AffectedRange
+			for _, event := range r.Events {
+				switch {
+				case event.Introduced != "":
+					if current != nil {
+						result = append(result, *current)
+					}
+					current = &attestation_vuln.AffectedRange{Type: string(r.Type), Introduced: event.Introduced}
+				case event.Fixed != "" || event.LastAffected != "":
+					if current == nil {
+						current = &attestation_vuln.AffectedRange{Type: string(r.Type)}
+					}
+					current.Fixed = event.Fixed
+					current.LastAffected = event.LastAffected
+					result = append(result, *current)
+					current = nil
+				}
+			}
+			if current != nil {
+				result = append(result, *current)
+			}
+		}
+	}
+	return result
+}
@@ -18,10 +18,15 @@ package osv
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scanner/pkg/models"
 	osv_scanner "github.com/google/osv-scanner/pkg/osv"
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
@@ -181,7 +186,13 @@ func TestOSVCertifier_CertifyVulns(t *testing.T) {
 			}
 			if err == nil {
 				for i := range collectedDocs {
-					result, err := dochelper.DocEqualWithTimestamp(collectedDocs[i], tt.want[i])
+					// the details of the vulnerabilities change upstream,
+					// only compare their IDs
+					got, err := withoutDetails(collectedDocs[i])
+					if err != nil {
+						t.Fatal(err)
+					}
+					result, err := dochelper.DocEqualWithTimestamp(got, tt.want[i])
 					if err != nil {
 						t.Error(err)
 					}
@@ -198,7 +209,7 @@ func Test_createAttestation(t *testing.T) {
 	currentTime := time.Now()
 	type args struct {
 		packageNode root_package.PackageNode
-		vulns       []osv_scanner.MinimalVulnerability
+		vulns       []models.Vulnerability
 	}
 	tests := []struct {
 		name string
@@ -210,7 +221,7 @@ func Test_createAttestation(t *testing.T) {
 			packageNode: root_package.PackageNode{
 				Purl: "",
 			},
-			vulns: []osv_scanner.MinimalVulnerability{
+			vulns: []models.Vulnerability{
 				{
 					ID: "testId",
 				},
@@ -259,3 +270,113 @@ func deepEqualIgnoreTimestamp(a, b *attestation_vuln.VulnerabilityStatement) boo
 	// use DeepEqual to compare the copies
 	return reflect.DeepEqual(aCopy, bCopy)
 }
+
+// withoutDetails drops the aliases, severities and affected ranges from a
+// vulnerability attestation.
+func withoutDetails(doc *processor.Document) (*processor.Document, error) {
+	var statement attestation_vuln.VulnerabilityStatement
+	if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+		return nil, err
+	}
+	for i := range statement.Predicate.Scanner.Result {
+		statement.Predicate.Scanner.Result[i] = attestation_vuln.Result{
+			VulnerabilityId: statement.Predicate.Scanner.Result[i].VulnerabilityId,
+		}
+	}
+	blob, err := json.Marshal(statement)
+	if err != nil {
+		return nil, err
+	}
+	stripped := *doc
+	stripped.Blob = blob
+	return &stripped, nil
+}
+
+// redirectTransport sends every request to the test server.
+type redirectTransport struct {
+	server *url.URL
+}
+
+func (r redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.server.Scheme
+	req.URL.Host = r.server.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestOSVCertifier_VulnDetails(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	vuln := models.Vulnerability{
+		ID:      "GHSA-599f-7c49-w659",
+		Aliases: []string{"CVE-2022-42889"},
+		Severity: []models.Severity{{
+			Type:  models.SeverityCVSSV3,
+			Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		}},
+		Affected: []models.Affected{{
+			Package: models.Package{Ecosystem: "Maven", Name: "org.apache.commons:commons-text"},
+			Ranges: []models.Range{{
+				Type: models.RangeEcosystem,
+				Events: []models.Event{
+					{Introduced: "1.5"},
+					{Fixed: "1.10.0"},
+				},
+			}, {
+				Type:   models.RangeGit,
+				Repo:   "https://github.com/apache/commons-text",
+				Events: []models.Event{{Introduced: "0"}, {Fixed: "abcdef"}},
+			}},
+		}, {
+			Package: models.Package{Ecosystem: "Maven", Name: "org.example:other"},
+			Ranges: []models.Range{{
+				Type:   models.RangeEcosystem,
+				Events: []models.Event{{Introduced: "0"}, {Fixed: "2.0.0"}},
+			}},
+		}},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/querybatch", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(osv_scanner.BatchedResponse{
+			Results: []osv_scanner.MinimalResponse{{
+				Vulns: []osv_scanner.MinimalVulnerability{{ID: vuln.ID}},
+			}},
+		})
+	})
+	mux.HandleFunc("/v1/vulns/"+vuln.ID, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(vuln)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := &osvCertifier{osvHTTPClient: &http.Client{Transport: redirectTransport{server: serverURL}}}
+	docChan := make(chan *processor.Document, 1)
+	pkg := &root_package.PackageNode{Purl: "pkg:maven/org.apache.commons/commons-text@1.9"}
+	if err := o.CertifyComponent(ctx, []*root_package.PackageNode{pkg}, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	doc := <-docChan
+	var got attestation_vuln.VulnerabilityStatement
+	if err := json.Unmarshal(doc.Blob, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []attestation_vuln.Result{{
+		VulnerabilityId: "GHSA-599f-7c49-w659",
+		Aliases:         []string{"CVE-2022-42889"},
+		Severity: []attestation_vuln.Severity{{
+			Method: "CVSS_V3",
+			Score:  "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		}},
+		Affected: []attestation_vuln.AffectedRange{{
+			Type:       "ECOSYSTEM",
+			Introduced: "1.5",
+			Fixed:      "1.10.0",
+		}},
+	}}
+	if diff := cmp.Diff(want, got.Predicate.Scanner.Result); diff != "" {
+		t.Errorf("CertifyComponent() unexpected results (-want +got):\n%s", diff)
+	}
+}
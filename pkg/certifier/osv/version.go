@@ -0,0 +1,717 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/osv-scanner/pkg/models"
+)
+
+// compareVersions orders two versions of a package the way its ecosystem
+// does, returning -1, 0 or +1. Versions of ecosystems without a known
+// ordering are compared by their runs of digits and other characters.
+func compareVersions(rangeType models.RangeType, ecosystem, a, b string) int {
+	if rangeType == models.RangeSemVer {
+		return compareSemver(a, b)
+	}
+	switch ecosystem {
+	case string(models.EcosystemGo), string(models.EcosystemNPM), string(models.EcosystemCratesIO), string(models.EcosystemHex), string(models.EcosystemPub):
+		return compareSemver(a, b)
+	case string(models.EcosystemPyPI):
+		return comparePyPI(a, b)
+	case string(models.EcosystemMaven):
+		return compareMaven(a, b)
+	case string(models.EcosystemNuGet):
+		return compareSemver(strings.ToLower(a), strings.ToLower(b))
+	case string(models.EcosystemRubyGems):
+		return compareRubyGems(a, b)
+	case string(models.EcosystemPackagist):
+		return comparePackagist(a, b)
+	case string(models.EcosystemDebian):
+		return compareDebian(a, b)
+	case string(models.EcosystemAlpine):
+		return compareAlpine(a, b)
+	default:
+		return compareGeneric(a, b)
+	}
+}
+
+func sign(c int) int {
+	switch {
+	case c < 0:
+		return -1
+	case c > 0:
+		return 1
+	}
+	return 0
+}
+
+// compareNumbers compares two strings of digits of any length.
+func compareNumbers(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return sign(len(a) - len(b))
+	}
+	return strings.Compare(a, b)
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// compareGeneric compares the runs of digits of two versions numerically and
+// their other runs lexically.
+func compareGeneric(a, b string) int {
+	ra, rb := splitRuns(a), splitRuns(b)
+	for i := 0; i < len(ra) && i < len(rb); i++ {
+		var c int
+		if isNumber(ra[i]) && isNumber(rb[i]) {
+			c = compareNumbers(ra[i], rb[i])
+		} else {
+			c = strings.Compare(ra[i], rb[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return sign(len(ra) - len(rb))
+}
+
+// splitRuns splits s into runs of digits and runs of letters, dropping
+// everything else.
+func splitRuns(s string) []string {
+	var runs []string
+	start := -1
+	digits := false
+	for i, r := range s {
+		alnum := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if start >= 0 && (!alnum || unicode.IsDigit(r) != digits) {
+			runs = append(runs, s[start:i])
+			start = -1
+		}
+		if alnum && start < 0 {
+			start = i
+			digits = unicode.IsDigit(r)
+		}
+	}
+	if start >= 0 {
+		runs = append(runs, s[start:])
+	}
+	return runs
+}
+
+// compareSemver compares semantic versions, tolerating a "v" prefix and
+// missing minor or patch numbers. Build metadata is ignored. NuGet versions,
+// which may have a fourth number, are compared the same way once lowercased.
+func compareSemver(a, b string) int {
+	releaseA, preA := splitSemver(a)
+	releaseB, preB := splitSemver(b)
+	for i := 0; i < len(releaseA) || i < len(releaseB); i++ {
+		na, nb := "0", "0"
+		if i < len(releaseA) {
+			na = releaseA[i]
+		}
+		if i < len(releaseB) {
+			nb = releaseB[i]
+		}
+		var c int
+		if isNumber(na) && isNumber(nb) {
+			c = compareNumbers(na, nb)
+		} else {
+			c = compareGeneric(na, nb)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	// a release sorts after its pre-releases
+	switch {
+	case preA == nil && preB == nil:
+		return 0
+	case preA == nil:
+		return 1
+	case preB == nil:
+		return -1
+	}
+	for i := 0; i < len(preA) && i < len(preB); i++ {
+		var c int
+		switch numA, numB := isNumber(preA[i]), isNumber(preB[i]); {
+		case numA && numB:
+			c = compareNumbers(preA[i], preB[i])
+		case numA:
+			c = -1
+		case numB:
+			c = 1
+		default:
+			c = strings.Compare(preA[i], preB[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return sign(len(preA) - len(preB))
+}
+
+// splitSemver returns the release numbers and pre-release identifiers of a
+// semantic version. The pre-release is nil for releases.
+func splitSemver(v string) ([]string, []string) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		v = v[:i]
+	}
+	var pre []string
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		pre = strings.Split(v[i+1:], ".")
+		v = v[:i]
+	}
+	return strings.Split(v, "."), pre
+}
+
+var pypiVersion = regexp.MustCompile(`^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|b|c|rc|alpha|beta|pre|preview)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?(dev)[-_.]?(\d*))?` +
+	`(?:\+[a-z0-9]+(?:[-_.][a-z0-9]+)*)?$`)
+
+// pypiKey is a PEP 440 version reduced to the fields it is ordered by.
+type pypiKey struct {
+	epoch   string
+	release []string
+	// preRank orders versions with the same release: a development release
+	// without a pre-release comes first, then alpha, beta and release
+	// candidates, then the final release.
+	preRank int
+	pre     string
+	post    string
+	hasPost bool
+	dev     string
+	hasDev  bool
+}
+
+func parsePyPI(v string) (pypiKey, bool) {
+	m := pypiVersion.FindStringSubmatch(strings.ToLower(strings.TrimSpace(v)))
+	if m == nil {
+		return pypiKey{}, false
+	}
+	k := pypiKey{epoch: m[1], release: strings.Split(m[2], ".")}
+	for len(k.release) > 1 && strings.TrimLeft(k.release[len(k.release)-1], "0") == "" {
+		k.release = k.release[:len(k.release)-1]
+	}
+	switch m[3] {
+	case "a", "alpha":
+		k.preRank = 1
+	case "b", "beta":
+		k.preRank = 2
+	case "c", "rc", "pre", "preview":
+		k.preRank = 3
+	default:
+		k.preRank = 4
+	}
+	k.pre = m[4]
+	switch {
+	case m[5] != "":
+		k.post, k.hasPost = m[5], true
+	case m[6] != "":
+		k.post, k.hasPost = m[7], true
+	}
+	if m[8] != "" {
+		k.dev, k.hasDev = m[9], true
+		if m[3] == "" && !k.hasPost {
+			k.preRank = 0
+		}
+	}
+	return k, true
+}
+
+// comparePyPI compares versions as PEP 440 orders them. Local version labels
+// are ignored.
+func comparePyPI(a, b string) int {
+	ka, okA := parsePyPI(a)
+	kb, okB := parsePyPI(b)
+	if !okA || !okB {
+		return compareGeneric(a, b)
+	}
+	if c := compareNumbers(ka.epoch, kb.epoch); c != 0 {
+		return c
+	}
+	for i := 0; i < len(ka.release) || i < len(kb.release); i++ {
+		na, nb := "0", "0"
+		if i < len(ka.release) {
+			na = ka.release[i]
+		}
+		if i < len(kb.release) {
+			nb = kb.release[i]
+		}
+		if c := compareNumbers(na, nb); c != 0 {
+			return c
+		}
+	}
+	if c := sign(ka.preRank - kb.preRank); c != 0 {
+		return c
+	}
+	if c := compareNumbers(ka.pre, kb.pre); c != 0 {
+		return c
+	}
+	// a version without a post-release comes before its post-releases
+	if ka.hasPost != kb.hasPost {
+		if ka.hasPost {
+			return 1
+		}
+		return -1
+	}
+	if c := compareNumbers(ka.post, kb.post); c != 0 {
+		return c
+	}
+	// and a version without a development release after them
+	if ka.hasDev != kb.hasDev {
+		if ka.hasDev {
+			return -1
+		}
+		return 1
+	}
+	return compareNumbers(ka.dev, kb.dev)
+}
+
+// mavenToken is an item of a Maven version, with the separator before it.
+// Transitions between digits and letters separate items like a hyphen.
+type mavenToken struct {
+	sep    byte
+	value  string
+	number bool
+}
+
+func (t mavenToken) null() bool {
+	return t.value == "" || t.value == "0"
+}
+
+// mavenQualifiers are the qualifiers Maven knows the order of. Unknown
+// qualifiers come after them, in lexical order.
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+func mavenQualifierRank(q string) int {
+	for i, known := range mavenQualifiers {
+		if q == known {
+			return i
+		}
+	}
+	return len(mavenQualifiers)
+}
+
+func parseMaven(v string) []mavenToken {
+	v = strings.ToLower(strings.TrimSpace(v))
+	var tokens []mavenToken
+	sep := byte('.')
+	start := 0
+	for start <= len(v) {
+		end := strings.IndexAny(v[start:], ".-")
+		if end < 0 {
+			end = len(v)
+		} else {
+			end += start
+		}
+		item := v[start:end]
+		runs := splitMavenItem(item)
+		for i, run := range runs {
+			s := sep
+			if i > 0 {
+				s = '-'
+			}
+			// "a", "b" and "m" abbreviate qualifiers when a number follows
+			if i < len(runs)-1 {
+				switch run {
+				case "a":
+					run = "alpha"
+				case "b":
+					run = "beta"
+				case "m":
+					run = "milestone"
+				}
+			}
+			switch run {
+			case "cr":
+				run = "rc"
+			case "ga", "final", "release":
+				run = ""
+			}
+			t := mavenToken{sep: s, value: run, number: isNumber(run)}
+			if t.number {
+				t.value = strings.TrimLeft(run, "0")
+				if t.value == "" {
+					t.value = "0"
+				}
+			}
+			tokens = append(tokens, t)
+		}
+		if end == len(v) {
+			break
+		}
+		sep = v[end]
+		start = end + 1
+	}
+	return trimMaven(tokens)
+}
+
+// splitMavenItem splits an item between separators at the transitions between
+// digits and other characters. An empty item is a zero.
+func splitMavenItem(item string) []string {
+	if item == "" {
+		return []string{"0"}
+	}
+	var runs []string
+	start := 0
+	for i := 1; i < len(item); i++ {
+		if isDigit(item[i]) != isDigit(item[i-1]) {
+			runs = append(runs, item[start:i])
+			start = i
+		}
+	}
+	return append(runs, item[start:])
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// trimMaven drops the null items at the end of the version and at the end of
+// each part before a hyphen, so that "1.0" equals "1" and "1-0-ga".
+func trimMaven(tokens []mavenToken) []mavenToken {
+	end := len(tokens)
+	for end > 0 {
+		start := end - 1
+		for start > 0 && tokens[start].sep != '-' {
+			start--
+		}
+		i := end - 1
+		for i >= start && i > 0 && tokens[i].null() {
+			i--
+		}
+		tokens = append(tokens[:i+1], tokens[end:]...)
+		end = start
+	}
+	return tokens
+}
+
+// compareMaven compares versions the way Maven's ComparableVersion does.
+func compareMaven(a, b string) int {
+	ta, tb := parseMaven(a), parseMaven(b)
+	for i := 0; i < len(ta) || i < len(tb); i++ {
+		var c int
+		switch {
+		case i >= len(ta):
+			c = -compareMavenNull(tb[i])
+		case i >= len(tb):
+			c = compareMavenNull(ta[i])
+		default:
+			c = compareMavenTokens(ta[i], tb[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareMavenNull compares an item with the missing item of a shorter
+// version: numbers compare with zero and qualifiers with the release.
+func compareMavenNull(t mavenToken) int {
+	if t.number {
+		return compareNumbers(t.value, "0")
+	}
+	return sign(mavenQualifierRank(t.value) - mavenQualifierRank(""))
+}
+
+// mavenKindRank orders items of different kinds: ".qualifier" <
+// "-qualifier" < "-number" < ".number".
+func mavenKindRank(t mavenToken) int {
+	switch {
+	case t.number && t.sep == '.':
+		return 3
+	case t.number:
+		return 2
+	case t.sep == '-':
+		return 1
+	}
+	return 0
+}
+
+func compareMavenTokens(a, b mavenToken) int {
+	if a.sep != b.sep || a.number != b.number {
+		return sign(mavenKindRank(a) - mavenKindRank(b))
+	}
+	if a.number {
+		return compareNumbers(a.value, b.value)
+	}
+	ra, rb := mavenQualifierRank(a.value), mavenQualifierRank(b.value)
+	if ra == len(mavenQualifiers) && rb == len(mavenQualifiers) {
+		return strings.Compare(a.value, b.value)
+	}
+	return sign(ra - rb)
+}
+
+// rubyGemsSegments returns the canonical segments of a RubyGems version: its
+// runs of digits and letters, with the zeros dropped from the end of each
+// run of numbers, so that "1.0" equals "1" and "1.0.a" equals "1.a". A
+// hyphen stands for ".pre.".
+func rubyGemsSegments(v string) []string {
+	runs := splitRuns(strings.ReplaceAll(strings.TrimSpace(v), "-", ".pre."))
+	var segments []string
+	for i, run := range runs {
+		segments = append(segments, run)
+		if i == len(runs)-1 || !isNumber(runs[i+1]) && isNumber(run) {
+			for len(segments) > 0 && isNumber(segments[len(segments)-1]) && compareNumbers(segments[len(segments)-1], "0") == 0 {
+				segments = segments[:len(segments)-1]
+			}
+		}
+	}
+	return segments
+}
+
+// compareRubyGems compares versions the way Gem::Version does. Versions with
+// letters are pre-releases, which sort before the numbers in their place.
+func compareRubyGems(a, b string) int {
+	sa, sb := rubyGemsSegments(a), rubyGemsSegments(b)
+	for i := 0; i < len(sa) || i < len(sb); i++ {
+		na, nb := "0", "0"
+		if i < len(sa) {
+			na = sa[i]
+		}
+		if i < len(sb) {
+			nb = sb[i]
+		}
+		var c int
+		switch numA, numB := isNumber(na), isNumber(nb); {
+		case numA && numB:
+			c = compareNumbers(na, nb)
+		case numA:
+			c = 1
+		case numB:
+			c = -1
+		default:
+			c = strings.Compare(na, nb)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// packagistForms ranks the special forms of PHP's version_compare. "#"
+// stands for any number, and unknown forms come before all of them.
+var packagistForms = map[string]int{
+	"dev":   0,
+	"alpha": 1,
+	"a":     1,
+	"beta":  2,
+	"b":     2,
+	"rc":    3,
+	"#":     4,
+	"pl":    5,
+	"p":     5,
+}
+
+func packagistFormRank(part string) int {
+	if isNumber(part) {
+		part = "#"
+	}
+	if rank, ok := packagistForms[part]; ok {
+		return rank
+	}
+	return -1
+}
+
+func comparePackagistParts(a, b string) int {
+	if isNumber(a) && isNumber(b) {
+		return compareNumbers(a, b)
+	}
+	return sign(packagistFormRank(a) - packagistFormRank(b))
+}
+
+// comparePackagist compares versions the way PHP's version_compare does,
+// which Composer orders versions by. When one version runs out of parts, a
+// number left in the other makes it greater and a special form is compared
+// with a number.
+func comparePackagist(a, b string) int {
+	pa := splitRuns(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(a)), "v"))
+	pb := splitRuns(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(b)), "v"))
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		if c := comparePackagistParts(pa[i], pb[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(pa) > len(pb):
+		return comparePackagistRest(pa[len(pb)])
+	case len(pa) < len(pb):
+		return -comparePackagistRest(pb[len(pa)])
+	}
+	return 0
+}
+
+// comparePackagistRest compares a version with a prefix of it that ends
+// before the given part.
+func comparePackagistRest(part string) int {
+	if isNumber(part) {
+		return 1
+	}
+	return comparePackagistParts(part, "#")
+}
+
+// splitDebian splits a Debian version into its epoch, upstream version and
+// revision.
+func splitDebian(v string) (string, string, string) {
+	v = strings.TrimSpace(v)
+	epoch := "0"
+	if e, rest, ok := strings.Cut(v, ":"); ok {
+		epoch, v = e, rest
+	}
+	revision := ""
+	if i := strings.LastIndexByte(v, '-'); i >= 0 {
+		v, revision = v[:i], v[i+1:]
+	}
+	return epoch, v, revision
+}
+
+// debianOrder is the weight of a character in a non-digit part of a Debian
+// version: a tilde sorts before anything, even the end of the part, and
+// letters before the other characters.
+func debianOrder(s string) int {
+	switch {
+	case s == "" || isDigit(s[0]):
+		return 0
+	case s[0] == '~':
+		return -1
+	case unicode.IsLetter(rune(s[0])):
+		return int(s[0])
+	}
+	return int(s[0]) + 256
+}
+
+// compareDebianPart compares upstream versions or revisions the way dpkg
+// does, alternating between non-digit and digit parts.
+func compareDebianPart(a, b string) int {
+	for a != "" || b != "" {
+		for a != "" && !isDigit(a[0]) || b != "" && !isDigit(b[0]) {
+			if c := sign(debianOrder(a) - debianOrder(b)); c != 0 {
+				return c
+			}
+			a, b = a[1:], b[1:]
+		}
+		i := 0
+		for i < len(a) && isDigit(a[i]) {
+			i++
+		}
+		j := 0
+		for j < len(b) && isDigit(b[j]) {
+			j++
+		}
+		if c := compareNumbers(a[:i], b[:j]); c != 0 {
+			return c
+		}
+		a, b = a[i:], b[j:]
+	}
+	return 0
+}
+
+// compareDebian compares versions the way dpkg does.
+func compareDebian(a, b string) int {
+	epochA, upstreamA, revisionA := splitDebian(a)
+	epochB, upstreamB, revisionB := splitDebian(b)
+	if c := compareNumbers(epochA, epochB); c != 0 {
+		return c
+	}
+	if c := compareDebianPart(upstreamA, upstreamB); c != 0 {
+		return c
+	}
+	return compareDebianPart(revisionA, revisionB)
+}
+
+var alpineVersion = regexp.MustCompile(`^(\d+(?:\.\d+)*)([a-z]?)((?:_(?:alpha|beta|pre|rc|cvs|svn|git|hg|p)\d*)*)(?:~[0-9a-f]+)?(?:-r(\d+))?$`)
+
+// alpineSuffixes are the suffixes of Alpine versions in order. Those before
+// the empty suffix mark pre-releases, those after it patches.
+var alpineSuffixes = []string{"alpha", "beta", "pre", "rc", "", "cvs", "svn", "git", "hg", "p"}
+
+// alpineSuffix is a suffix of an Alpine version with its number.
+type alpineSuffix struct {
+	rank   int
+	number string
+}
+
+func parseAlpineSuffixes(s string) []alpineSuffix {
+	var suffixes []alpineSuffix
+	for _, suffix := range strings.Split(s, "_")[1:] {
+		name := strings.TrimRightFunc(suffix, unicode.IsDigit)
+		suffixes = append(suffixes, alpineSuffix{
+			rank:   slices.Index(alpineSuffixes, name),
+			number: suffix[len(name):],
+		})
+	}
+	return suffixes
+}
+
+// compareAlpine compares versions the way apk does: by their numbers, their
+// letter, their suffixes and then their package release.
+func compareAlpine(a, b string) int {
+	ma := alpineVersion.FindStringSubmatch(strings.TrimSpace(a))
+	mb := alpineVersion.FindStringSubmatch(strings.TrimSpace(b))
+	if ma == nil || mb == nil {
+		return compareGeneric(a, b)
+	}
+	na, nb := strings.Split(ma[1], "."), strings.Split(mb[1], ".")
+	for i := 0; i < len(na) && i < len(nb); i++ {
+		if c := compareNumbers(na[i], nb[i]); c != 0 {
+			return c
+		}
+	}
+	if c := sign(len(na) - len(nb)); c != 0 {
+		return c
+	}
+	if c := strings.Compare(ma[2], mb[2]); c != 0 {
+		return c
+	}
+	sa, sb := parseAlpineSuffixes(ma[3]), parseAlpineSuffixes(mb[3])
+	none := alpineSuffix{rank: slices.Index(alpineSuffixes, "")}
+	for i := 0; i < len(sa) || i < len(sb); i++ {
+		xa, xb := none, none
+		if i < len(sa) {
+			xa = sa[i]
+		}
+		if i < len(sb) {
+			xb = sb[i]
+		}
+		if c := sign(xa.rank - xb.rank); c != 0 {
+			return c
+		}
+		if c := compareNumbers(xa.number, xb.number); c != 0 {
+			return c
+		}
+	}
+	return compareNumbers(ma[4], mb[4])
+}
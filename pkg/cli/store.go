@@ -41,6 +41,8 @@ func init() {
 	set.Bool("csub-tls-skip-verify", false, "skip verifying server certificate (for self-signed certificates for example)")
 	set.Bool("use-csub", true, "use collectsub server for datasource")
 
+	set.Int("metrics-port", 0, "port to serve the prometheus metrics on at /metrics, not served when 0")
+
 	set.Int("csub-listen-port", 2782, "port to listen to on collect-sub service")
 	set.String("csub-tls-cert-file", "", "path to the TLS certificate in PEM format for collect-sub service")
 	set.String("csub-tls-key-file", "", "path to the TLS key in PEM format for collect-sub service")
@@ -49,6 +51,7 @@ func init() {
 	set.Int("gql-listen-port", 8080, "port used for graphql api server")
 	set.String("gql-tls-cert-file", "", "path to the TLS certificate in PEM format for graphql api server")
 	set.String("gql-tls-key-file", "", "path to the TLS key in PEM format for graphql api server")
+	set.String("gql-auth-config", "", "path to the YAML file configuring the authentication of the graphql api clients, which are not authenticated when empty")
 	set.Bool("gql-debug", false, "debug flag which enables the graphQL playground")
 	set.Bool("gql-trace", false, "flag which enables tracing of graphQL requests and responses on the console")
 
@@ -73,9 +76,14 @@ func init() {
 	set.String("arango-pass", "", "arango password to connect to graph db")
 
 	set.String("gql-addr", "http://localhost:8080/query", "endpoint used to connect to graphQL server")
+	set.String("gql-token", "", "bearer token, static or JWT, presented to the graphQL server")
+	set.String("gql-client-cert-file", "", "path to the TLS client certificate in PEM format presented to the graphQL server")
+	set.String("gql-client-key-file", "", "path to the TLS client key in PEM format presented to the graphQL server")
 
 	set.String("verifier-key-path", "", "path to pem file to verify dsse")
 	set.String("verifier-key-id", "", "ID of the key to be stored")
+	set.String("verifier-keys", "", "path to a directory of PEM or JWK public keys, or to a TUF-style keyring file, to verify signatures with. Changes to the keys are picked up while running")
+	set.String("trust-policy", "", "path to the YAML or JSON trust policy that maps the identities signing documents to the document types they are trusted for. Signatures are only verified when set")
 
 	set.Bool("service-poll", true, "sets the collector or certifier to polling mode")
 	set.BoolP("poll", "p", false, "sets the collector or certifier to polling mode")
@@ -95,6 +103,13 @@ func init() {
 	set.String("stop-purl", "", "string input of purl with package to stop search at")
 	set.Bool("is-pkg-version-start", false, "for query path are you inputting a packageVersion to start the search from (if false then packageName)")
 	set.Bool("is-pkg-version-stop", false, "for query path are you inputting a packageVersion to stop the search at (if false then packageName)")
+	set.StringP("output", "o", "table", "format of the output of the query commands: [table | json | sarif]")
+
+	set.StringP("format", "f", "", "format of the exported document: [spdx | cyclonedx] for SBOMs, defaults to spdx, and [openvex | csaf] for VEX, defaults to openvex")
+
+	set.String("policy", "", "path to the YAML or JSON policy file to evaluate")
+
+	set.String("offline-db", "", "directory of OSV ecosystem zip exports (such as PyPI/all.zip) to look up vulnerabilities in, instead of querying osv.dev")
 
 	// Google Cloud platform flags
 	set.String("gcp-credentials-path", "", "Path to the Google Cloud service account credentials json file.\nAlternatively you can set GOOGLE_APPLICATION_CREDENTIALS=<path> in your environment.")
@@ -108,8 +123,12 @@ func init() {
 	set.String("s3-queues", "", "comma-separated list of queue/topic names")
 	set.String("s3-region", "us-east-1", "aws region")
 
+	// Collector state options
+	set.String("collector-state", "memory", "where collectors record what they collected, to resume from it after a restart: memory, file, redis, tikv (redis and tikv use kv-redis and kv-tikv)")
+	set.String("collector-state-file", "guac-collector-state.json", "path of the file collectors record what they collected in, when collector-state is file")
+
 	// KeyValue Backend Store options.
-	set.String("kv-store", "memmap", "Which keyvalue store to use: memmap, redis, tikv.")
+	set.String("kv-store", "memmap", "Which keyvalue store to use: memmap, redis, tikv. Only a single guacgql may use a redis or tikv store at a time.")
 	set.String("kv-redis", "redis://user@localhost:6379/0", "Experimental: Redis connection string for keyvalue backend")
 	set.String("kv-tikv", "127.0.0.1:2379", "Experimental: TiKV address and port")
 
@@ -18,13 +18,16 @@ package emitter
 import (
 	"context"
 	"time"
+
+	"github.com/guacsec/guac/pkg/tracing"
 )
 
-// DataFunc determines how the data return from NATS is transformed based on implementation per module
-type DataFunc func([]byte) error
+// DataFunc determines how the data return from NATS is transformed based on implementation per module.
+// The context carries the trace context the data was published with.
+type DataFunc func(context.Context, []byte) error
 
 type pubSub struct {
-	dataChan <-chan []byte
+	dataChan <-chan message
 	errChan  <-chan error
 }
 
@@ -46,13 +49,13 @@ func (psub *pubSub) GetDataFromNats(ctx context.Context, dataFunc DataFunc) erro
 	for {
 		select {
 		case d := <-psub.dataChan:
-			if err := dataFunc(d); err != nil {
+			if err := dataFunc(tracing.ExtractNATS(ctx, d.header), d.data); err != nil {
 				return err
 			}
 		case err := <-psub.errChan:
 			for len(psub.dataChan) > 0 {
 				d := <-psub.dataChan
-				if err := dataFunc(d); err != nil {
+				if err := dataFunc(tracing.ExtractNATS(ctx, d.header), d.data); err != nil {
 					return err
 				}
 			}
@@ -60,7 +63,7 @@ func (psub *pubSub) GetDataFromNats(ctx context.Context, dataFunc DataFunc) erro
 		case <-ctx.Done():
 			for len(psub.dataChan) > 0 {
 				d := <-psub.dataChan
-				if err := dataFunc(d); err != nil {
+				if err := dataFunc(tracing.ExtractNATS(ctx, d.header), d.data); err != nil {
 					return err
 				}
 			}
@@ -0,0 +1,247 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/nats-io/nats.go"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// Pipeline stages at which a document can fail
+const (
+	StageDecode   string = "decode"
+	StageProcess  string = "process"
+	StageParse    string = "parse"
+	StageAssemble string = "assemble"
+)
+
+// StageError is an error of a stage of the pipeline
+type StageError struct {
+	Stage string
+	Err   error
+}
+
+// NewStageError wraps the error of a stage of the pipeline
+func NewStageError(stage string, err error) error {
+	return &StageError{Stage: stage, Err: err}
+}
+
+func (e *StageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// FailedDocument is a message that could not be handled, as published on
+// the SubjectNameDocFailed subject
+type FailedDocument struct {
+	// Subject the message was pulled from, where it is replayed
+	Subject string `json:"subject"`
+	// Data of the message
+	Data []byte `json:"data"`
+	// Stage that failed, if known
+	Stage string `json:"stage,omitempty"`
+	// Error of the last attempt
+	Error    string    `json:"error"`
+	Attempts int       `json:"attempts"`
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// FailedMessage is a failed document stored on the stream
+type FailedMessage struct {
+	FailedDocument
+	// Sequence of the message on the stream
+	Sequence uint64
+}
+
+// RetryPolicy bounds the attempts to handle a message. The wait between
+// attempts starts at InitialBackoff and doubles up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of the subscribers of the pipeline
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: BackOffTimer,
+	MaxBackoff:     30 * time.Second,
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// Retry calls the DataFunc with the data of a message pulled from subj until
+// it succeeds or the attempts of the policy run out. The data of a message
+// that still fails is published to SubjectNameDocFailed, along with the
+// error, so that it can be replayed. An error is only returned when the
+// message could not be published there or the context is canceled.
+func Retry(ctx context.Context, policy RetryPolicy, subj string, data []byte, dataFunc DataFunc) error {
+	logger := logging.FromContext(ctx)
+	var err error
+	attempt := 0
+	for attempt < policy.MaxAttempts || attempt == 0 {
+		attempt++
+		if err = dataFunc(ctx, data); err == nil {
+			return nil
+		}
+		if attempt >= policy.MaxAttempts {
+			break
+		}
+		logger.Warnf("attempt %d of %d failed, retrying: %v", attempt, policy.MaxAttempts, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(policy.backoff(attempt)):
+		}
+	}
+	return PublishFailed(ctx, subj, data, err, attempt)
+}
+
+// PublishFailed publishes the data of a message pulled from subj that could
+// not be handled to SubjectNameDocFailed. The stage is taken from the error,
+// when it is a StageError.
+func PublishFailed(ctx context.Context, subj string, data []byte, err error, attempts int) error {
+	logger := logging.FromContext(ctx)
+	failed := FailedDocument{
+		Subject:  subj,
+		Data:     data,
+		Error:    err.Error(),
+		Attempts: attempts,
+		FailedAt: time.Now().UTC(),
+	}
+	var stageErr *StageError
+	if errors.As(err, &stageErr) {
+		failed.Stage = stageErr.Stage
+	}
+	failedBytes, err := json.Marshal(failed)
+	if err != nil {
+		return fmt.Errorf("failed to marshal failed document: %w", err)
+	}
+	if err := Publish(ctx, SubjectNameDocFailed, failedBytes); err != nil {
+		return err
+	}
+	logger.Errorf("document from %s failed at stage %q after %d attempts, published to %s: %s",
+		subj, failed.Stage, attempts, SubjectNameDocFailed, failed.Error)
+	return nil
+}
+
+// ListFailed returns the failed documents stored on the stream, in the order
+// they failed.
+func ListFailed(ctx context.Context) ([]FailedMessage, error) {
+	js := FromContext(ctx)
+	if js == nil {
+		return nil, errors.New("jetstream not found from context")
+	}
+	info, err := js.StreamInfo(StreamName, nats.Context(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream info: %w", err)
+	}
+	if !info.Config.AllowDirect {
+		return nil, fmt.Errorf("stream %s does not allow direct gets", StreamName)
+	}
+	var failed []FailedMessage
+	// work queue streams can not be browsed with a consumer without removing
+	// the messages, so each failed document is read with a direct get of the
+	// next message on the failed subject
+	for seq := uint64(1); ; {
+		msg, err := js.GetMsg(StreamName, seq, nats.DirectGetNext(SubjectNameDocFailed), nats.Context(ctx))
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			return failed, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get failed document from sequence %d: %w", seq, err)
+		}
+		f := FailedMessage{Sequence: msg.Sequence}
+		if err := json.Unmarshal(msg.Data, &f.FailedDocument); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal failed document %d: %w", msg.Sequence, err)
+		}
+		failed = append(failed, f)
+		seq = msg.Sequence + 1
+	}
+}
+
+// ReplayFailed publishes the failed documents with the given sequences back
+// to the subject they failed on, and removes them from the failed ones. All
+// the failed documents are replayed when no sequence is given. It returns
+// the number of replayed documents.
+func ReplayFailed(ctx context.Context, seqs ...uint64) (int, error) {
+	js := FromContext(ctx)
+	if js == nil {
+		return 0, errors.New("jetstream not found from context")
+	}
+	failed, err := ListFailed(ctx)
+	if err != nil {
+		return 0, err
+	}
+	selected := map[uint64]bool{}
+	for _, seq := range seqs {
+		selected[seq] = true
+	}
+	replayed := 0
+	for _, f := range failed {
+		if len(seqs) > 0 && !selected[f.Sequence] {
+			continue
+		}
+		delete(selected, f.Sequence)
+		// the message ID differs from the one of the original message, which
+		// may still be in the duplicates window of the stream
+		msgID := fmt.Sprintf("%s-replay-%d", getHash(f.Data), f.Sequence)
+		if _, err := js.Publish(f.Subject, f.Data, nats.MsgId(msgID), nats.Context(ctx)); err != nil {
+			return replayed, fmt.Errorf("failed to replay document %d: %w", f.Sequence, err)
+		}
+		if err := js.DeleteMsg(StreamName, f.Sequence, nats.Context(ctx)); err != nil {
+			return replayed, fmt.Errorf("failed to remove replayed document %d: %w", f.Sequence, err)
+		}
+		replayed++
+	}
+	for seq := range selected {
+		return replayed, fmt.Errorf("no failed document with sequence %d", seq)
+	}
+	return replayed, nil
+}
+
+// PurgeFailed removes all the failed documents from the stream
+func PurgeFailed(ctx context.Context) error {
+	js := FromContext(ctx)
+	if js == nil {
+		return errors.New("jetstream not found from context")
+	}
+	if err := js.PurgeStream(StreamName, &nats.StreamPurgeRequest{Subject: SubjectNameDocFailed}, nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to purge failed documents: %w", err)
+	}
+	return nil
+}
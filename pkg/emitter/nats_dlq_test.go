@@ -0,0 +1,172 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emitter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	nats_test "github.com/guacsec/guac/internal/testing/nats"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/nats-io/nats.go"
+)
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := p.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	natsTest := nats_test.NewNatsTestServer()
+	url, err := natsTest.EnableJetStreamForTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer natsTest.Shutdown()
+
+	ctx := logging.WithLogger(context.Background())
+	jetStream := NewJetStream(url, "", "")
+	ctx, err = jetStream.JetStreamInit(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error initializing jetstream: %v", err)
+	}
+	if err := jetStream.RecreateStream(ctx); err != nil {
+		t.Fatalf("unexpected error recreating jetstream: %v", err)
+	}
+	defer jetStream.Close()
+
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	// a transient failure is retried
+	attempts := 0
+	err = Retry(ctx, policy, SubjectNameDocCollected, []byte("transient"), func(_ context.Context, d []byte) error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	if err != nil || attempts != 2 {
+		t.Fatalf("Retry() error = %v after %d attempts, want success after 2", err, attempts)
+	}
+
+	// a document that keeps failing is dead-lettered
+	attempts = 0
+	err = Retry(ctx, policy, SubjectNameDocCollected, []byte("broken"), func(_ context.Context, d []byte) error {
+		attempts++
+		return NewStageError(StageParse, errors.New("unexpected end of JSON input"))
+	})
+	if err != nil || attempts != 3 {
+		t.Fatalf("Retry() error = %v after %d attempts, want nil after 3", err, attempts)
+	}
+	if err := Publish(ctx, SubjectNameDocCollected, []byte("pending")); err != nil {
+		t.Fatal(err)
+	}
+	if err := PublishFailed(ctx, SubjectNameDocCollected, []byte("undecodable"), errors.New("invalid document"), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	failed, err := ListFailed(ctx)
+	if err != nil {
+		t.Fatalf("ListFailed() error = %v", err)
+	}
+	if len(failed) != 2 {
+		t.Fatalf("ListFailed() = %v, want 2 failed documents", failed)
+	}
+	got := failed[0].FailedDocument
+	if string(got.Data) != "broken" || got.Subject != SubjectNameDocCollected || got.Stage != StageParse ||
+		got.Attempts != 3 || got.Error != "unexpected end of JSON input" || got.FailedAt.IsZero() {
+		t.Errorf("ListFailed()[0] = %+v, unexpected failed document", got)
+	}
+
+	// replaying a document publishes it back and removes it from the failed ones
+	if _, err := ReplayFailed(ctx, 12345); err == nil {
+		t.Error("ReplayFailed() of an unknown sequence did not fail")
+	}
+	replayed, err := ReplayFailed(ctx, failed[0].Sequence)
+	if err != nil || replayed != 1 {
+		t.Fatalf("ReplayFailed() = %d, %v, want 1 replayed document", replayed, err)
+	}
+	msg, err := FromContext(ctx).GetLastMsg(StreamName, SubjectNameDocCollected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(msg.Data) != "broken" {
+		t.Errorf("last collected document = %q, want the replayed one", msg.Data)
+	}
+	failed, err = ListFailed(ctx)
+	if err != nil || len(failed) != 1 || string(failed[0].Data) != "undecodable" {
+		t.Errorf("ListFailed() after replay = %v, %v, want the undecodable document", failed, err)
+	}
+
+	if err := PurgeFailed(ctx); err != nil {
+		t.Fatalf("PurgeFailed() error = %v", err)
+	}
+	failed, err = ListFailed(ctx)
+	if err != nil || len(failed) != 0 {
+		t.Errorf("ListFailed() after purge = %v, %v, want none", failed, err)
+	}
+	if _, err := FromContext(ctx).GetLastMsg(StreamName, SubjectNameDocCollected); err != nil {
+		t.Errorf("purge removed collected documents: %v", err)
+	}
+}
+
+func TestListFailed_streamWithoutDirectGet(t *testing.T) {
+	natsTest := nats_test.NewNatsTestServer()
+	url, err := natsTest.EnableJetStreamForTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer natsTest.Shutdown()
+
+	// a stream created before failed documents were listed with direct gets
+	nc, err := nats.Connect(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nc.Close()
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = js.DeleteStream(StreamName)
+	if _, err := js.AddStream(&nats.StreamConfig{Name: StreamName, Subjects: []string{StreamSubjects}, Retention: nats.WorkQueuePolicy}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := js.Publish(SubjectNameDocFailed, []byte(`{"subject":"DOCUMENTS.collected","error":"invalid document"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := logging.WithLogger(context.Background())
+	jetStream := NewJetStream(url, "", "")
+	ctx, err = jetStream.JetStreamInit(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error initializing jetstream: %v", err)
+	}
+	defer jetStream.Close()
+
+	failed, err := ListFailed(ctx)
+	if err != nil || len(failed) != 1 || failed[0].Error != "invalid document" {
+		t.Errorf("ListFailed() = %v, %v, want the stored failed document", failed, err)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/tracing"
 	"github.com/nats-io/nats.go"
 )
 
@@ -35,6 +36,7 @@ const (
 	SubjectNameDocCollected string        = "DOCUMENTS.collected"
 	SubjectNameDocProcessed string        = "DOCUMENTS.processed"
 	SubjectNameDocParsed    string        = "DOCUMENTS.parsed"
+	SubjectNameDocFailed    string        = "DOCUMENTS.failed"
 	DurableProcessor        string        = "processor"
 	DurableIngestor         string        = "ingestor"
 	BufferChannelSize       int           = 1000
@@ -111,11 +113,21 @@ func (j *jetStream) JetStreamInit(ctx context.Context) (context.Context, error)
 
 func createStreamOrExists(ctx context.Context, js nats.JetStreamContext) error {
 	logger := logging.FromContext(ctx)
-	_, err := js.StreamInfo(StreamName)
+	info, err := js.StreamInfo(StreamName)
 
 	if err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
 		return err
 	}
+	// failed documents are listed with direct gets, which streams created
+	// by earlier versions do not allow
+	if err == nil && !info.Config.AllowDirect {
+		logger.Infof("allowing direct gets on stream %q", StreamName)
+		config := info.Config
+		config.AllowDirect = true
+		if _, err := js.UpdateStream(&config); err != nil {
+			return err
+		}
+	}
 	// stream not found, create it
 	if errors.Is(err, nats.ErrStreamNotFound) {
 		logger.Infof("creating stream %q and subjects %q", StreamName, StreamSubjects)
@@ -126,6 +138,8 @@ func createStreamOrExists(ctx context.Context, js nats.JetStreamContext) error {
 			// window to track duplicates in the stream.
 			// see https://github.com/nats-io/nats.docs/blob/master/using-nats/jetstream/model_deep_dive.md#message-deduplication
 			Duplicates: 5 * time.Minute,
+			// failed documents are listed with direct gets
+			AllowDirect: true,
 		})
 		if err != nil {
 			return err
@@ -169,9 +183,16 @@ func FromContext(ctx context.Context) nats.JetStreamContext {
 	return nil
 }
 
-func createSubscriber(ctx context.Context, id string, subj string, durable string, backOffTimer time.Duration) (<-chan []byte, <-chan error, error) {
+// message is the data of a NATS message, along with its headers that carry
+// the trace context
+type message struct {
+	data   []byte
+	header nats.Header
+}
+
+func createSubscriber(ctx context.Context, id string, subj string, durable string, backOffTimer time.Duration) (<-chan message, <-chan error, error) {
 	// docChan to collect artifacts
-	dataChan := make(chan []byte, BufferChannelSize)
+	dataChan := make(chan message, BufferChannelSize)
 	// errChan to receive error from collectors
 	errChan := make(chan error, 1)
 	logger := logging.FromContext(ctx)
@@ -212,22 +233,26 @@ func createSubscriber(ctx context.Context, id string, subj string, durable strin
 					errChan <- fmt.Errorf(fmtErrString+": %w", err)
 					return
 				}
-				dataChan <- msgs[0].Data
+				dataChan <- message{data: msgs[0].Data, header: msgs[0].Header}
 			}
 		}
 	}()
 	return dataChan, errChan, nil
 }
 
-// Publish publishes the data onto the NATS stream for consumption by upstream services
+// Publish publishes the data onto the NATS stream for consumption by upstream services.
+// The trace context of ctx is propagated in the headers of the message.
 func Publish(ctx context.Context, subj string, data []byte) error {
 	js := FromContext(ctx)
 	if js == nil {
 		return errors.New("jetstream not found from context")
 	}
+	msg := nats.NewMsg(subj)
+	msg.Data = data
+	tracing.InjectNATS(ctx, msg.Header)
 	// messageID set using the hash to check for duplicate data on the stream
 	// see: https://github.com/nats-io/nats.docs/blob/master/using-nats/jetstream/model_deep_dive.md#message-deduplication
-	_, err := js.Publish(subj, data, nats.MsgId(getHash(data)))
+	_, err := js.PublishMsg(msg, nats.MsgId(getHash(data)))
 	if err != nil {
 		return fmt.Errorf("failed to publish document on stream: %w", err)
 	}
@@ -22,18 +22,16 @@ import (
 	"testing"
 	"time"
 
-	jsoniter "github.com/json-iterator/go"
-
 	uuid "github.com/gofrs/uuid"
 	"github.com/guacsec/guac/internal/testing/dochelper"
 	nats_test "github.com/guacsec/guac/internal/testing/nats"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
-	json = jsoniter.ConfigCompatibleWithStandardLibrary
 	// Taken from: https://slsa.dev/provenance/v0.1#example
 	ite6SLSA = `
 	{
@@ -247,6 +245,54 @@ func TestNatsEmitter_RecreateStream(t *testing.T) {
 	}
 }
 
+func TestNatsEmitter_TraceContext(t *testing.T) {
+	natsTest := nats_test.NewNatsTestServer()
+	url, err := natsTest.EnableJetStreamForTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer natsTest.Shutdown()
+
+	ctx := logging.WithLogger(context.Background())
+	jetStream := NewJetStream(url, "", "")
+	ctx, err = jetStream.JetStreamInit(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error initializing jetstream: %v", err)
+	}
+	if err := jetStream.RecreateStream(ctx); err != nil {
+		t.Fatalf("unexpected error recreating jetstream: %v", err)
+	}
+	defer jetStream.Close()
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+	})
+	if err := Publish(trace.ContextWithSpanContext(ctx, sc), SubjectNameDocCollected, []byte("traced")); err != nil {
+		t.Fatalf("unexpected error on publish: %v", err)
+	}
+
+	psub, err := NewPubSub(ctx, "test", SubjectNameDocCollected, DurableProcessor, BackOffTimer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var got trace.SpanContext
+	err = psub.GetDataFromNats(ctx, func(ctx context.Context, d []byte) error {
+		got = trace.SpanContextFromContext(ctx)
+		cancel()
+		return nil
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error getting data from nats: %v", err)
+	}
+	if got.TraceID() != sc.TraceID() || got.SpanID() != sc.SpanID() || !got.IsRemote() {
+		t.Errorf("span context of the data = %v, want remote %v", got, sc)
+	}
+}
+
 func testPublish(ctx context.Context, d *processor.Document) error {
 	logger := logging.FromContext(ctx)
 	docByte, err := json.Marshal(d)
@@ -273,7 +319,7 @@ func testSubscribe(ctx context.Context, transportFunc func(processor.DocumentTre
 		return err
 	}
 
-	processFunc := func(d []byte) error {
+	processFunc := func(_ context.Context, d []byte) error {
 		doc := processor.Document{}
 		err := json.Unmarshal(d, &doc)
 		if err != nil {
@@ -0,0 +1,659 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// optional returns a pointer to s, or nil if s is empty.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// pkgInputs returns the input specs of every package version in the tree.
+func pkgInputs(pkg model.AllPkgTree) []model.PkgInputSpec {
+	var inputs []model.PkgInputSpec
+	for _, namespace := range pkg.Namespaces {
+		namespace := namespace
+		for _, name := range namespace.Names {
+			for _, version := range name.Versions {
+				var qualifiers []model.PackageQualifierInputSpec
+				for _, qualifier := range version.Qualifiers {
+					qualifiers = append(qualifiers, model.PackageQualifierInputSpec{Key: qualifier.Key, Value: qualifier.Value})
+				}
+				inputs = append(inputs, model.PkgInputSpec{
+					Type:       pkg.Type,
+					Namespace:  &namespace.Namespace,
+					Name:       name.Name,
+					Version:    optional(version.Version),
+					Qualifiers: qualifiers,
+					Subpath:    optional(version.Subpath),
+				})
+			}
+		}
+	}
+	return inputs
+}
+
+// pkgSubject returns the input spec of the package version or package name
+// that is the subject or object of an evidence, along with the match flags
+// selecting it.
+func pkgSubject(pkg model.AllPkgTree) (*model.PkgInputSpec, model.MatchFlags, error) {
+	if len(pkg.Namespaces) != 1 || len(pkg.Namespaces[0].Names) != 1 {
+		return nil, model.MatchFlags{}, fmt.Errorf("expected a single package name in package %s", pkg.Id)
+	}
+	namespace := pkg.Namespaces[0]
+	name := namespace.Names[0]
+	if len(name.Versions) == 0 {
+		input := &model.PkgInputSpec{
+			Type:      pkg.Type,
+			Namespace: &namespace.Namespace,
+			Name:      name.Name,
+		}
+		return input, model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}, nil
+	}
+	inputs := pkgInputs(pkg)
+	return &inputs[0], model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, nil
+}
+
+// pkgVersionSubject returns the input spec of a package version that is the
+// subject or object of an evidence.
+func pkgVersionSubject(pkg model.AllPkgTree) (*model.PkgInputSpec, error) {
+	input, flags, err := pkgSubject(pkg)
+	if err != nil {
+		return nil, err
+	}
+	if flags.Pkg != model.PkgMatchTypeSpecificVersion {
+		return nil, fmt.Errorf("expected a package version in package %s", pkg.Id)
+	}
+	return input, nil
+}
+
+func srcInputs(src model.AllSourceTree) []model.SourceInputSpec {
+	var inputs []model.SourceInputSpec
+	for _, namespace := range src.Namespaces {
+		for _, name := range namespace.Names {
+			inputs = append(inputs, model.SourceInputSpec{
+				Type:      src.Type,
+				Namespace: namespace.Namespace,
+				Name:      name.Name,
+				Tag:       name.Tag,
+				Commit:    name.Commit,
+			})
+		}
+	}
+	return inputs
+}
+
+func srcSubject(src model.AllSourceTree) (*model.SourceInputSpec, error) {
+	inputs := srcInputs(src)
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("expected a single source name in source %s", src.Id)
+	}
+	return &inputs[0], nil
+}
+
+func artifactInput(artifact model.AllArtifactTree) model.ArtifactInputSpec {
+	return model.ArtifactInputSpec{Algorithm: artifact.Algorithm, Digest: artifact.Digest}
+}
+
+func licenseInput(license model.AllLicenseTree) model.LicenseInputSpec {
+	return model.LicenseInputSpec{Name: license.Name, Inline: license.Inline, ListVersion: license.ListVersion}
+}
+
+func vulnInputs(vuln model.AllVulnerabilityTree) []model.VulnerabilityInputSpec {
+	var inputs []model.VulnerabilityInputSpec
+	for _, id := range vuln.VulnerabilityIDs {
+		inputs = append(inputs, model.VulnerabilityInputSpec{Type: vuln.Type, VulnerabilityID: id.VulnerabilityID})
+	}
+	return inputs
+}
+
+func vulnSubject(vuln model.AllVulnerabilityTree) (*model.VulnerabilityInputSpec, error) {
+	inputs := vulnInputs(vuln)
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf("expected a single vulnerability ID in vulnerability %s", vuln.Id)
+	}
+	return &inputs[0], nil
+}
+
+// pkgSrcOrArtifact returns the input spec of the subject of an evidence that
+// can be a package, a source or an artifact.
+func pkgSrcOrArtifact(subject any) (*model.PkgInputSpec, model.MatchFlags, *model.SourceInputSpec, *model.ArtifactInputSpec, error) {
+	var pkg model.AllPkgTree
+	var src model.AllSourceTree
+	var artifact model.AllArtifactTree
+	switch s := subject.(type) {
+	case *model.AllCertifyBadSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllCertifyBadSubjectSource:
+		src = s.AllSourceTree
+	case *model.AllCertifyBadSubjectArtifact:
+		artifact = s.AllArtifactTree
+	case *model.AllCertifyGoodSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllCertifyGoodSubjectSource:
+		src = s.AllSourceTree
+	case *model.AllCertifyGoodSubjectArtifact:
+		artifact = s.AllArtifactTree
+	case *model.AllHasMetadataSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllHasMetadataSubjectSource:
+		src = s.AllSourceTree
+	case *model.AllHasMetadataSubjectArtifact:
+		artifact = s.AllArtifactTree
+	case *model.AllPointOfContactSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllPointOfContactSubjectSource:
+		src = s.AllSourceTree
+	case *model.AllPointOfContactSubjectArtifact:
+		artifact = s.AllArtifactTree
+	case *model.AllCertifyLegalTreeSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllCertifyLegalTreeSubjectSource:
+		src = s.AllSourceTree
+	case *model.AllIsOccurrencesTreeSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllIsOccurrencesTreeSubjectSource:
+		src = s.AllSourceTree
+	case *model.AllHasSBOMTreeSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllHasSBOMTreeSubjectArtifact:
+		artifact = s.AllArtifactTree
+	case *model.AllHasSBOMTreeIncludedSoftwarePackage:
+		pkg = s.AllPkgTree
+	case *model.AllHasSBOMTreeIncludedSoftwareArtifact:
+		artifact = s.AllArtifactTree
+	case *model.AllCertifyVEXStatementSubjectPackage:
+		pkg = s.AllPkgTree
+	case *model.AllCertifyVEXStatementSubjectArtifact:
+		artifact = s.AllArtifactTree
+	default:
+		return nil, model.MatchFlags{}, nil, nil, fmt.Errorf("unexpected subject type %T", subject)
+	}
+	switch {
+	case pkg.Id != "":
+		input, flags, err := pkgSubject(pkg)
+		return input, flags, nil, nil, err
+	case src.Id != "":
+		input, err := srcSubject(src)
+		return nil, model.MatchFlags{}, input, nil, err
+	default:
+		input := artifactInput(artifact)
+		return nil, model.MatchFlags{}, nil, &input, nil
+	}
+}
+
+func isDependencyIngest(dep model.AllIsDependencyTree) (assembler.IsDependencyIngest, error) {
+	pkg, err := pkgVersionSubject(dep.Package.AllPkgTree)
+	if err != nil {
+		return assembler.IsDependencyIngest{}, err
+	}
+	depPkg, depPkgMatchFlag, err := pkgSubject(dep.DependencyPackage.AllPkgTree)
+	if err != nil {
+		return assembler.IsDependencyIngest{}, err
+	}
+	return assembler.IsDependencyIngest{
+		Pkg:             pkg,
+		DepPkg:          depPkg,
+		DepPkgMatchFlag: depPkgMatchFlag,
+		IsDependency: &model.IsDependencyInputSpec{
+			VersionRange:    dep.VersionRange,
+			DependencyType:  dep.DependencyType,
+			DependencyScope: &dep.DependencyScope,
+			Justification:   dep.Justification,
+			Origin:          dep.Origin,
+			Collector:       dep.Collector,
+			Verified:        dep.Verified,
+			Signer:          dep.Signer,
+		},
+	}, nil
+}
+
+func isOccurrenceIngest(occurrence model.AllIsOccurrencesTree) (assembler.IsOccurrenceIngest, error) {
+	pkg, _, src, _, err := pkgSrcOrArtifact(occurrence.Subject)
+	if err != nil {
+		return assembler.IsOccurrenceIngest{}, err
+	}
+	artifact := artifactInput(occurrence.Artifact.AllArtifactTree)
+	return assembler.IsOccurrenceIngest{
+		Pkg:      pkg,
+		Src:      src,
+		Artifact: &artifact,
+		IsOccurrence: &model.IsOccurrenceInputSpec{
+			Justification: occurrence.Justification,
+			Origin:        occurrence.Origin,
+			Collector:     occurrence.Collector,
+			Verified:      occurrence.Verified,
+			Signer:        occurrence.Signer,
+		},
+	}, nil
+}
+
+func hasSLSAIngest(slsa model.AllSLSATree) assembler.HasSlsaIngest {
+	artifact := artifactInput(slsa.Subject.AllArtifactTree)
+	var materials []model.ArtifactInputSpec
+	for _, material := range slsa.Slsa.BuiltFrom {
+		materials = append(materials, artifactInput(material.AllArtifactTree))
+	}
+	var predicate []model.SLSAPredicateInputSpec
+	for _, p := range slsa.Slsa.SlsaPredicate {
+		predicate = append(predicate, model.SLSAPredicateInputSpec{Key: p.Key, Value: p.Value})
+	}
+	return assembler.HasSlsaIngest{
+		Artifact:  &artifact,
+		Materials: materials,
+		Builder:   &model.BuilderInputSpec{Uri: slsa.Slsa.BuiltBy.Uri},
+		HasSlsa: &model.SLSAInputSpec{
+			BuildType:     slsa.Slsa.BuildType,
+			SlsaPredicate: predicate,
+			SlsaVersion:   slsa.Slsa.SlsaVersion,
+			StartedOn:     slsa.Slsa.StartedOn,
+			FinishedOn:    slsa.Slsa.FinishedOn,
+			Origin:        slsa.Slsa.Origin,
+			Collector:     slsa.Slsa.Collector,
+			Verified:      slsa.Slsa.Verified,
+			Signer:        slsa.Slsa.Signer,
+		},
+	}
+}
+
+func scorecardIngest(scorecard model.AllCertifyScorecard) (assembler.CertifyScorecardIngest, error) {
+	src, err := srcSubject(scorecard.Source.AllSourceTree)
+	if err != nil {
+		return assembler.CertifyScorecardIngest{}, err
+	}
+	var checks []model.ScorecardCheckInputSpec
+	for _, check := range scorecard.Scorecard.Checks {
+		checks = append(checks, model.ScorecardCheckInputSpec{Check: check.Check, Score: check.Score})
+	}
+	return assembler.CertifyScorecardIngest{
+		Source: src,
+		Scorecard: &model.ScorecardInputSpec{
+			Checks:           checks,
+			AggregateScore:   scorecard.Scorecard.AggregateScore,
+			TimeScanned:      scorecard.Scorecard.TimeScanned,
+			ScorecardVersion: scorecard.Scorecard.ScorecardVersion,
+			ScorecardCommit:  scorecard.Scorecard.ScorecardCommit,
+			Origin:           scorecard.Scorecard.Origin,
+			Collector:        scorecard.Scorecard.Collector,
+			Verified:         scorecard.Scorecard.Verified,
+			Signer:           scorecard.Scorecard.Signer,
+		},
+	}, nil
+}
+
+func certifyVulnIngest(certifyVuln model.AllCertifyVuln) (assembler.CertifyVulnIngest, error) {
+	pkg, err := pkgVersionSubject(certifyVuln.Package.AllPkgTree)
+	if err != nil {
+		return assembler.CertifyVulnIngest{}, err
+	}
+	vuln, err := vulnSubject(certifyVuln.Vulnerability.AllVulnerabilityTree)
+	if err != nil {
+		return assembler.CertifyVulnIngest{}, err
+	}
+	return assembler.CertifyVulnIngest{
+		Pkg:           pkg,
+		Vulnerability: vuln,
+		VulnData: &model.ScanMetadataInput{
+			TimeScanned:    certifyVuln.Metadata.TimeScanned,
+			DbUri:          certifyVuln.Metadata.DbUri,
+			DbVersion:      certifyVuln.Metadata.DbVersion,
+			ScannerUri:     certifyVuln.Metadata.ScannerUri,
+			ScannerVersion: certifyVuln.Metadata.ScannerVersion,
+			Origin:         certifyVuln.Metadata.Origin,
+			Collector:      certifyVuln.Metadata.Collector,
+			Verified:       certifyVuln.Metadata.Verified,
+			Signer:         certifyVuln.Metadata.Signer,
+		},
+	}, nil
+}
+
+func vulnMetadataIngest(metadata model.AllVulnMetadataTree) (assembler.VulnMetadataIngest, error) {
+	if len(metadata.Vulnerability.VulnerabilityIDs) != 1 {
+		return assembler.VulnMetadataIngest{}, fmt.Errorf("expected a single vulnerability ID in vulnerability %s", metadata.Vulnerability.Id)
+	}
+	return assembler.VulnMetadataIngest{
+		Vulnerability: &model.VulnerabilityInputSpec{
+			Type:            metadata.Vulnerability.Type,
+			VulnerabilityID: metadata.Vulnerability.VulnerabilityIDs[0].VulnerabilityID,
+		},
+		VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+			ScoreType:  metadata.ScoreType,
+			ScoreValue: metadata.ScoreValue,
+			Timestamp:  metadata.Timestamp,
+			Origin:     metadata.Origin,
+			Collector:  metadata.Collector,
+			Verified:   metadata.Verified,
+			Signer:     metadata.Signer,
+		},
+	}, nil
+}
+
+func vulnEqualIngest(vulnEqual model.AllVulnEqual) (assembler.VulnEqualIngest, error) {
+	var vulns []*model.VulnerabilityInputSpec
+	for _, v := range vulnEqual.Vulnerabilities {
+		vuln, err := vulnSubject(v.AllVulnerabilityTree)
+		if err != nil {
+			return assembler.VulnEqualIngest{}, err
+		}
+		vulns = append(vulns, vuln)
+	}
+	if len(vulns) != 2 {
+		return assembler.VulnEqualIngest{}, fmt.Errorf("expected two vulnerabilities in VulnEqual %s", vulnEqual.Id)
+	}
+	// The backends order the two sides by their IDs, which the import does
+	// not preserve, so write them in an order of their own.
+	slices.SortFunc(vulns, func(a, b *model.VulnerabilityInputSpec) int {
+		if c := strings.Compare(a.Type, b.Type); c != 0 {
+			return c
+		}
+		return strings.Compare(a.VulnerabilityID, b.VulnerabilityID)
+	})
+	return assembler.VulnEqualIngest{
+		Vulnerability:      vulns[0],
+		EqualVulnerability: vulns[1],
+		VulnEqual: &model.VulnEqualInputSpec{
+			Justification: vulnEqual.Justification,
+			Origin:        vulnEqual.Origin,
+			Collector:     vulnEqual.Collector,
+			Verified:      vulnEqual.Verified,
+			Signer:        vulnEqual.Signer,
+		},
+	}, nil
+}
+
+func hasSourceAtIngest(hasSourceAt model.AllHasSourceAt) (assembler.HasSourceAtIngest, error) {
+	pkg, pkgMatchFlag, err := pkgSubject(hasSourceAt.Package.AllPkgTree)
+	if err != nil {
+		return assembler.HasSourceAtIngest{}, err
+	}
+	src, err := srcSubject(hasSourceAt.Source.AllSourceTree)
+	if err != nil {
+		return assembler.HasSourceAtIngest{}, err
+	}
+	return assembler.HasSourceAtIngest{
+		Pkg:          pkg,
+		PkgMatchFlag: pkgMatchFlag,
+		Src:          src,
+		HasSourceAt: &model.HasSourceAtInputSpec{
+			KnownSince:    hasSourceAt.KnownSince,
+			Justification: hasSourceAt.Justification,
+			Origin:        hasSourceAt.Origin,
+			Collector:     hasSourceAt.Collector,
+			Verified:      hasSourceAt.Verified,
+			Signer:        hasSourceAt.Signer,
+		},
+	}, nil
+}
+
+func certifyBadIngest(bad model.AllCertifyBad) (assembler.CertifyBadIngest, error) {
+	pkg, pkgMatchFlag, src, artifact, err := pkgSrcOrArtifact(bad.Subject)
+	if err != nil {
+		return assembler.CertifyBadIngest{}, err
+	}
+	return assembler.CertifyBadIngest{
+		Pkg:          pkg,
+		PkgMatchFlag: pkgMatchFlag,
+		Src:          src,
+		Artifact:     artifact,
+		CertifyBad: &model.CertifyBadInputSpec{
+			Justification: bad.Justification,
+			Origin:        bad.Origin,
+			Collector:     bad.Collector,
+			Verified:      bad.Verified,
+			Signer:        bad.Signer,
+			KnownSince:    bad.KnownSince,
+		},
+	}, nil
+}
+
+func certifyGoodIngest(good model.AllCertifyGood) (assembler.CertifyGoodIngest, error) {
+	pkg, pkgMatchFlag, src, artifact, err := pkgSrcOrArtifact(good.Subject)
+	if err != nil {
+		return assembler.CertifyGoodIngest{}, err
+	}
+	return assembler.CertifyGoodIngest{
+		Pkg:          pkg,
+		PkgMatchFlag: pkgMatchFlag,
+		Src:          src,
+		Artifact:     artifact,
+		CertifyGood: &model.CertifyGoodInputSpec{
+			Justification: good.Justification,
+			Origin:        good.Origin,
+			Collector:     good.Collector,
+			Verified:      good.Verified,
+			Signer:        good.Signer,
+			KnownSince:    good.KnownSince,
+		},
+	}, nil
+}
+
+func pointOfContactIngest(poc model.AllPointOfContact) (assembler.PointOfContactIngest, error) {
+	pkg, pkgMatchFlag, src, artifact, err := pkgSrcOrArtifact(poc.Subject)
+	if err != nil {
+		return assembler.PointOfContactIngest{}, err
+	}
+	return assembler.PointOfContactIngest{
+		Pkg:          pkg,
+		PkgMatchFlag: pkgMatchFlag,
+		Src:          src,
+		Artifact:     artifact,
+		PointOfContact: &model.PointOfContactInputSpec{
+			Email:         poc.Email,
+			Info:          poc.Info,
+			Since:         poc.Since,
+			Justification: poc.Justification,
+			Origin:        poc.Origin,
+			Collector:     poc.Collector,
+			Verified:      poc.Verified,
+			Signer:        poc.Signer,
+		},
+	}, nil
+}
+
+func hasMetadataIngest(metadata model.AllHasMetadata) (assembler.HasMetadataIngest, error) {
+	pkg, pkgMatchFlag, src, artifact, err := pkgSrcOrArtifact(metadata.Subject)
+	if err != nil {
+		return assembler.HasMetadataIngest{}, err
+	}
+	return assembler.HasMetadataIngest{
+		Pkg:          pkg,
+		PkgMatchFlag: pkgMatchFlag,
+		Src:          src,
+		Artifact:     artifact,
+		HasMetadata: &model.HasMetadataInputSpec{
+			Key:           metadata.Key,
+			Value:         metadata.Value,
+			Timestamp:     metadata.Timestamp,
+			Justification: metadata.Justification,
+			Origin:        metadata.Origin,
+			Collector:     metadata.Collector,
+			Verified:      metadata.Verified,
+			Signer:        metadata.Signer,
+		},
+	}, nil
+}
+
+func vexIngest(vex model.AllCertifyVEXStatement) (assembler.VexIngest, error) {
+	pkg, _, _, artifact, err := pkgSrcOrArtifact(vex.Subject)
+	if err != nil {
+		return assembler.VexIngest{}, err
+	}
+	vuln, err := vulnSubject(vex.Vulnerability.AllVulnerabilityTree)
+	if err != nil {
+		return assembler.VexIngest{}, err
+	}
+	return assembler.VexIngest{
+		Pkg:           pkg,
+		Artifact:      artifact,
+		Vulnerability: vuln,
+		VexData: &model.VexStatementInputSpec{
+			Status:           vex.Status,
+			VexJustification: vex.VexJustification,
+			Statement:        vex.Statement,
+			StatusNotes:      vex.StatusNotes,
+			KnownSince:       vex.KnownSince,
+			Origin:           vex.Origin,
+			Collector:        vex.Collector,
+			Verified:         vex.Verified,
+			Signer:           vex.Signer,
+		},
+	}, nil
+}
+
+func hashEqualIngest(hashEqual model.AllHashEqualTree) (assembler.HashEqualIngest, error) {
+	if len(hashEqual.Artifacts) != 2 {
+		return assembler.HashEqualIngest{}, fmt.Errorf("expected two artifacts in HashEqual %s", hashEqual.Id)
+	}
+	artifact := artifactInput(hashEqual.Artifacts[0].AllArtifactTree)
+	equalArtifact := artifactInput(hashEqual.Artifacts[1].AllArtifactTree)
+	// As in vulnEqualIngest, the order of the two sides is our own.
+	if artifact.Algorithm+":"+artifact.Digest > equalArtifact.Algorithm+":"+equalArtifact.Digest {
+		artifact, equalArtifact = equalArtifact, artifact
+	}
+	return assembler.HashEqualIngest{
+		Artifact:      &artifact,
+		EqualArtifact: &equalArtifact,
+		HashEqual: &model.HashEqualInputSpec{
+			Justification: hashEqual.Justification,
+			Origin:        hashEqual.Origin,
+			Collector:     hashEqual.Collector,
+			Verified:      hashEqual.Verified,
+			Signer:        hashEqual.Signer,
+		},
+	}, nil
+}
+
+func pkgEqualIngest(pkgEqual model.AllPkgEqual) (assembler.PkgEqualIngest, error) {
+	var pkgs []*model.PkgInputSpec
+	for _, p := range pkgEqual.Packages {
+		pkg, err := pkgVersionSubject(p.AllPkgTree)
+		if err != nil {
+			return assembler.PkgEqualIngest{}, err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	if len(pkgs) != 2 {
+		return assembler.PkgEqualIngest{}, fmt.Errorf("expected two packages in PkgEqual %s", pkgEqual.Id)
+	}
+	// As in vulnEqualIngest, the order of the two sides is our own.
+	if helpers.PkgInputSpecToPurl(pkgs[0]) > helpers.PkgInputSpecToPurl(pkgs[1]) {
+		pkgs[0], pkgs[1] = pkgs[1], pkgs[0]
+	}
+	return assembler.PkgEqualIngest{
+		Pkg:      pkgs[0],
+		EqualPkg: pkgs[1],
+		PkgEqual: &model.PkgEqualInputSpec{
+			Justification: pkgEqual.Justification,
+			Origin:        pkgEqual.Origin,
+			Collector:     pkgEqual.Collector,
+			Verified:      pkgEqual.Verified,
+			Signer:        pkgEqual.Signer,
+		},
+	}, nil
+}
+
+func certifyLegalIngest(legal model.AllCertifyLegalTree) (assembler.CertifyLegalIngest, error) {
+	pkg, _, src, _, err := pkgSrcOrArtifact(legal.Subject)
+	if err != nil {
+		return assembler.CertifyLegalIngest{}, err
+	}
+	var declared, discovered []model.LicenseInputSpec
+	for _, license := range legal.DeclaredLicenses {
+		declared = append(declared, licenseInput(license.AllLicenseTree))
+	}
+	for _, license := range legal.DiscoveredLicenses {
+		discovered = append(discovered, licenseInput(license.AllLicenseTree))
+	}
+	return assembler.CertifyLegalIngest{
+		Pkg:        pkg,
+		Src:        src,
+		Declared:   declared,
+		Discovered: discovered,
+		CertifyLegal: &model.CertifyLegalInputSpec{
+			DeclaredLicense:   legal.DeclaredLicense,
+			DiscoveredLicense: legal.DiscoveredLicense,
+			Attribution:       legal.Attribution,
+			Justification:     legal.Justification,
+			TimeScanned:       legal.TimeScanned,
+			Origin:            legal.Origin,
+			Collector:         legal.Collector,
+			Verified:          legal.Verified,
+			Signer:            legal.Signer,
+		},
+	}, nil
+}
+
+// hasSBOMIngest returns the HasSBOM along with the software, dependencies
+// and occurrences it includes.
+func hasSBOMIngest(hasSBOM model.AllHasSBOMTree) (assembler.HasSBOMIngest, *Includes, error) {
+	pkg, _, _, artifact, err := pkgSrcOrArtifact(hasSBOM.Subject)
+	if err != nil {
+		return assembler.HasSBOMIngest{}, nil, err
+	}
+	includes := &Includes{}
+	for _, software := range hasSBOM.IncludedSoftware {
+		pkg, _, _, artifact, err := pkgSrcOrArtifact(software)
+		if err != nil {
+			return assembler.HasSBOMIngest{}, nil, err
+		}
+		if pkg != nil {
+			includes.Packages = append(includes.Packages, *pkg)
+		} else {
+			includes.Artifacts = append(includes.Artifacts, *artifact)
+		}
+	}
+	for _, dep := range hasSBOM.IncludedDependencies {
+		ingest, err := isDependencyIngest(dep.AllIsDependencyTree)
+		if err != nil {
+			return assembler.HasSBOMIngest{}, nil, err
+		}
+		includes.Dependencies = append(includes.Dependencies, ingest)
+	}
+	for _, occurrence := range hasSBOM.IncludedOccurrences {
+		ingest, err := isOccurrenceIngest(occurrence.AllIsOccurrencesTree)
+		if err != nil {
+			return assembler.HasSBOMIngest{}, nil, err
+		}
+		includes.Occurrences = append(includes.Occurrences, ingest)
+	}
+	return assembler.HasSBOMIngest{
+		Pkg:      pkg,
+		Artifact: artifact,
+		HasSBOM: &model.HasSBOMInputSpec{
+			Uri:              hasSBOM.Uri,
+			Algorithm:        hasSBOM.Algorithm,
+			Digest:           hasSBOM.Digest,
+			DownloadLocation: hasSBOM.DownloadLocation,
+			Origin:           hasSBOM.Origin,
+			Collector:        hasSBOM.Collector,
+			Verified:         hasSBOM.Verified,
+			Signer:           hasSBOM.Signer,
+			KnownSince:       hasSBOM.KnownSince,
+		},
+	}, includes, nil
+}
@@ -0,0 +1,552 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graph exports every node and evidence of the graph to a versioned
+// JSON lines format, and imports it back, to back up a deployment or move its
+// data to another backend.
+package graph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+const (
+	// FormatName identifies a graph export in its header.
+	FormatName = "guac-graph"
+	// Version is the version of the format written by Export. Import reads
+	// this and every earlier version.
+	Version = 1
+)
+
+// Header is the first line of a graph export.
+type Header struct {
+	Format  string    `json:"format"`
+	Version int       `json:"version"`
+	Created time.Time `json:"created"`
+}
+
+// Record is a line of a graph export after its header. It holds either nodes,
+// a page of evidence, or a single HasSBOM along with the software and
+// evidence it includes.
+type Record struct {
+	Nodes      *Nodes                      `json:"nodes,omitempty"`
+	Predicates *assembler.IngestPredicates `json:"predicates,omitempty"`
+	// Includes is only set with the HasSBOM of Predicates, as the IDs of
+	// its included nodes change between backends.
+	Includes *Includes `json:"includes,omitempty"`
+}
+
+// Nodes holds the nodes of the graph, including the ones no evidence refers
+// to.
+type Nodes struct {
+	Packages        []model.PkgInputSpec           `json:"packages,omitempty"`
+	Sources         []model.SourceInputSpec        `json:"sources,omitempty"`
+	Artifacts       []model.ArtifactInputSpec      `json:"artifacts,omitempty"`
+	Builders        []model.BuilderInputSpec       `json:"builders,omitempty"`
+	Vulnerabilities []model.VulnerabilityInputSpec `json:"vulnerabilities,omitempty"`
+	Licenses        []model.LicenseInputSpec       `json:"licenses,omitempty"`
+}
+
+// Includes holds the software, dependencies and occurrences included in a
+// HasSBOM.
+type Includes struct {
+	Packages     []model.PkgInputSpec           `json:"packages,omitempty"`
+	Artifacts    []model.ArtifactInputSpec      `json:"artifacts,omitempty"`
+	Dependencies []assembler.IsDependencyIngest `json:"dependencies,omitempty"`
+	Occurrences  []assembler.IsOccurrenceIngest `json:"occurrences,omitempty"`
+}
+
+type exporter struct {
+	client graphql.Client
+	enc    *jsoniter.Encoder
+	first  int
+}
+
+// Export writes every node and evidence of the graph to w, one page of the
+// paginated queries per line after the header. Timestamps, origins and
+// collectors of the evidence are preserved.
+func Export(ctx context.Context, client graphql.Client, w io.Writer) error {
+	logger := logging.FromContext(ctx)
+	e := &exporter{
+		client: client,
+		enc:    json.NewEncoder(w),
+		first:  helpers.DefaultPageSize,
+	}
+	if err := e.enc.Encode(Header{Format: FormatName, Version: Version, Created: time.Now().UTC()}); err != nil {
+		return fmt.Errorf("error writing graph export header: %w", err)
+	}
+
+	steps := []struct {
+		name   string
+		export func(context.Context) error
+	}{
+		{"packages", e.packages},
+		{"sources", e.sources},
+		{"artifacts", e.artifacts},
+		{"builders", e.builders},
+		{"vulnerabilities", e.vulnerabilities},
+		{"licenses", e.licenses},
+		{"IsDependency", e.isDependencies},
+		{"IsOccurrence", e.isOccurrences},
+		{"HasSLSA", e.hasSLSAs},
+		{"CertifyScorecard", e.scorecards},
+		{"CertifyVuln", e.certifyVulns},
+		{"VulnerabilityMetadata", e.vulnMetadata},
+		{"VulnEqual", e.vulnEquals},
+		{"HasSourceAt", e.hasSourceAts},
+		{"CertifyBad", e.certifyBads},
+		{"CertifyGood", e.certifyGoods},
+		{"PointOfContact", e.pointOfContacts},
+		{"HasMetadata", e.hasMetadata},
+		{"CertifyVEXStatement", e.vexStatements},
+		{"HashEqual", e.hashEquals},
+		{"PkgEqual", e.pkgEquals},
+		{"CertifyLegal", e.certifyLegals},
+		{"HasSBOM", e.hasSBOMs},
+	}
+	for _, step := range steps {
+		logger.Infof("exporting %s", step.name)
+		if err := step.export(ctx); err != nil {
+			return fmt.Errorf("error exporting %s: %w", step.name, err)
+		}
+	}
+	return nil
+}
+
+// writeNodes writes the nodes of a page, unless the page is empty.
+func (e *exporter) writeNodes(count int, nodes Nodes) error {
+	if count == 0 {
+		return nil
+	}
+	return e.enc.Encode(Record{Nodes: &nodes})
+}
+
+// writePredicates writes the evidence of a page, unless the page is empty.
+func (e *exporter) writePredicates(count int, preds assembler.IngestPredicates) error {
+	if count == 0 {
+		return nil
+	}
+	return e.enc.Encode(Record{Predicates: &preds})
+}
+
+func (e *exporter) packages(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.PackagesList(ctx, e.client, model.PkgSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var nodes Nodes
+		for _, edge := range response.PackagesList.Edges {
+			nodes.Packages = append(nodes.Packages, pkgInputs(edge.Node.AllPkgTree)...)
+		}
+		return &response.PackagesList.PageInfo, e.writeNodes(len(response.PackagesList.Edges), nodes)
+	})
+}
+
+func (e *exporter) sources(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.SourcesList(ctx, e.client, model.SourceSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var nodes Nodes
+		for _, edge := range response.SourcesList.Edges {
+			nodes.Sources = append(nodes.Sources, srcInputs(edge.Node.AllSourceTree)...)
+		}
+		return &response.SourcesList.PageInfo, e.writeNodes(len(response.SourcesList.Edges), nodes)
+	})
+}
+
+func (e *exporter) artifacts(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.ArtifactsList(ctx, e.client, model.ArtifactSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var nodes Nodes
+		for _, edge := range response.ArtifactsList.Edges {
+			nodes.Artifacts = append(nodes.Artifacts, artifactInput(edge.Node.AllArtifactTree))
+		}
+		return &response.ArtifactsList.PageInfo, e.writeNodes(len(response.ArtifactsList.Edges), nodes)
+	})
+}
+
+func (e *exporter) builders(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.BuildersList(ctx, e.client, model.BuilderSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var nodes Nodes
+		for _, edge := range response.BuildersList.Edges {
+			nodes.Builders = append(nodes.Builders, model.BuilderInputSpec{Uri: edge.Node.Uri})
+		}
+		return &response.BuildersList.PageInfo, e.writeNodes(len(response.BuildersList.Edges), nodes)
+	})
+}
+
+func (e *exporter) vulnerabilities(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.VulnerabilitiesList(ctx, e.client, model.VulnerabilitySpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var nodes Nodes
+		for _, edge := range response.VulnerabilitiesList.Edges {
+			nodes.Vulnerabilities = append(nodes.Vulnerabilities, vulnInputs(edge.Node.AllVulnerabilityTree)...)
+		}
+		return &response.VulnerabilitiesList.PageInfo, e.writeNodes(len(response.VulnerabilitiesList.Edges), nodes)
+	})
+}
+
+func (e *exporter) licenses(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.LicensesList(ctx, e.client, model.LicenseSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var nodes Nodes
+		for _, edge := range response.LicensesList.Edges {
+			nodes.Licenses = append(nodes.Licenses, licenseInput(edge.Node.AllLicenseTree))
+		}
+		return &response.LicensesList.PageInfo, e.writeNodes(len(response.LicensesList.Edges), nodes)
+	})
+}
+
+func (e *exporter) isDependencies(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.IsDependencyList(ctx, e.client, model.IsDependencySpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.IsDependencyList.Edges {
+			ingest, err := isDependencyIngest(edge.Node.AllIsDependencyTree)
+			if err != nil {
+				return nil, err
+			}
+			preds.IsDependency = append(preds.IsDependency, ingest)
+		}
+		return &response.IsDependencyList.PageInfo, e.writePredicates(len(response.IsDependencyList.Edges), preds)
+	})
+}
+
+func (e *exporter) isOccurrences(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.IsOccurrenceList(ctx, e.client, model.IsOccurrenceSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.IsOccurrenceList.Edges {
+			ingest, err := isOccurrenceIngest(edge.Node.AllIsOccurrencesTree)
+			if err != nil {
+				return nil, err
+			}
+			preds.IsOccurrence = append(preds.IsOccurrence, ingest)
+		}
+		return &response.IsOccurrenceList.PageInfo, e.writePredicates(len(response.IsOccurrenceList.Edges), preds)
+	})
+}
+
+func (e *exporter) hasSLSAs(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.HasSLSAList(ctx, e.client, model.HasSLSASpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.HasSLSAList.Edges {
+			preds.HasSlsa = append(preds.HasSlsa, hasSLSAIngest(edge.Node.AllSLSATree))
+		}
+		return &response.HasSLSAList.PageInfo, e.writePredicates(len(response.HasSLSAList.Edges), preds)
+	})
+}
+
+func (e *exporter) scorecards(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.ScorecardsList(ctx, e.client, model.CertifyScorecardSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.ScorecardsList.Edges {
+			ingest, err := scorecardIngest(edge.Node.AllCertifyScorecard)
+			if err != nil {
+				return nil, err
+			}
+			preds.CertifyScorecard = append(preds.CertifyScorecard, ingest)
+		}
+		return &response.ScorecardsList.PageInfo, e.writePredicates(len(response.ScorecardsList.Edges), preds)
+	})
+}
+
+func (e *exporter) certifyVulns(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.CertifyVulnList(ctx, e.client, model.CertifyVulnSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.CertifyVulnList.Edges {
+			ingest, err := certifyVulnIngest(edge.Node.AllCertifyVuln)
+			if err != nil {
+				return nil, err
+			}
+			preds.CertifyVuln = append(preds.CertifyVuln, ingest)
+		}
+		return &response.CertifyVulnList.PageInfo, e.writePredicates(len(response.CertifyVulnList.Edges), preds)
+	})
+}
+
+func (e *exporter) vulnMetadata(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.VulnerabilityMetadataList(ctx, e.client, model.VulnerabilityMetadataSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.VulnerabilityMetadataList.Edges {
+			ingest, err := vulnMetadataIngest(edge.Node.AllVulnMetadataTree)
+			if err != nil {
+				return nil, err
+			}
+			preds.VulnMetadata = append(preds.VulnMetadata, ingest)
+		}
+		return &response.VulnerabilityMetadataList.PageInfo, e.writePredicates(len(response.VulnerabilityMetadataList.Edges), preds)
+	})
+}
+
+func (e *exporter) vulnEquals(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.VulnEqualList(ctx, e.client, model.VulnEqualSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.VulnEqualList.Edges {
+			ingest, err := vulnEqualIngest(edge.Node.AllVulnEqual)
+			if err != nil {
+				return nil, err
+			}
+			preds.VulnEqual = append(preds.VulnEqual, ingest)
+		}
+		return &response.VulnEqualList.PageInfo, e.writePredicates(len(response.VulnEqualList.Edges), preds)
+	})
+}
+
+func (e *exporter) hasSourceAts(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.HasSourceAtList(ctx, e.client, model.HasSourceAtSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.HasSourceAtList.Edges {
+			ingest, err := hasSourceAtIngest(edge.Node.AllHasSourceAt)
+			if err != nil {
+				return nil, err
+			}
+			preds.HasSourceAt = append(preds.HasSourceAt, ingest)
+		}
+		return &response.HasSourceAtList.PageInfo, e.writePredicates(len(response.HasSourceAtList.Edges), preds)
+	})
+}
+
+func (e *exporter) certifyBads(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.CertifyBadList(ctx, e.client, model.CertifyBadSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.CertifyBadList.Edges {
+			ingest, err := certifyBadIngest(edge.Node.AllCertifyBad)
+			if err != nil {
+				return nil, err
+			}
+			preds.CertifyBad = append(preds.CertifyBad, ingest)
+		}
+		return &response.CertifyBadList.PageInfo, e.writePredicates(len(response.CertifyBadList.Edges), preds)
+	})
+}
+
+func (e *exporter) certifyGoods(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.CertifyGoodList(ctx, e.client, model.CertifyGoodSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.CertifyGoodList.Edges {
+			ingest, err := certifyGoodIngest(edge.Node.AllCertifyGood)
+			if err != nil {
+				return nil, err
+			}
+			preds.CertifyGood = append(preds.CertifyGood, ingest)
+		}
+		return &response.CertifyGoodList.PageInfo, e.writePredicates(len(response.CertifyGoodList.Edges), preds)
+	})
+}
+
+func (e *exporter) pointOfContacts(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.PointOfContactList(ctx, e.client, model.PointOfContactSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.PointOfContactList.Edges {
+			ingest, err := pointOfContactIngest(edge.Node.AllPointOfContact)
+			if err != nil {
+				return nil, err
+			}
+			preds.PointOfContact = append(preds.PointOfContact, ingest)
+		}
+		return &response.PointOfContactList.PageInfo, e.writePredicates(len(response.PointOfContactList.Edges), preds)
+	})
+}
+
+func (e *exporter) hasMetadata(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.HasMetadataList(ctx, e.client, model.HasMetadataSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.HasMetadataList.Edges {
+			ingest, err := hasMetadataIngest(edge.Node.AllHasMetadata)
+			if err != nil {
+				return nil, err
+			}
+			preds.HasMetadata = append(preds.HasMetadata, ingest)
+		}
+		return &response.HasMetadataList.PageInfo, e.writePredicates(len(response.HasMetadataList.Edges), preds)
+	})
+}
+
+func (e *exporter) vexStatements(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.CertifyVEXStatementList(ctx, e.client, model.CertifyVEXStatementSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.CertifyVEXStatementList.Edges {
+			ingest, err := vexIngest(edge.Node.AllCertifyVEXStatement)
+			if err != nil {
+				return nil, err
+			}
+			preds.Vex = append(preds.Vex, ingest)
+		}
+		return &response.CertifyVEXStatementList.PageInfo, e.writePredicates(len(response.CertifyVEXStatementList.Edges), preds)
+	})
+}
+
+func (e *exporter) hashEquals(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.HashEqualList(ctx, e.client, model.HashEqualSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.HashEqualList.Edges {
+			ingest, err := hashEqualIngest(edge.Node.AllHashEqualTree)
+			if err != nil {
+				return nil, err
+			}
+			preds.HashEqual = append(preds.HashEqual, ingest)
+		}
+		return &response.HashEqualList.PageInfo, e.writePredicates(len(response.HashEqualList.Edges), preds)
+	})
+}
+
+func (e *exporter) pkgEquals(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.PkgEqualList(ctx, e.client, model.PkgEqualSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.PkgEqualList.Edges {
+			ingest, err := pkgEqualIngest(edge.Node.AllPkgEqual)
+			if err != nil {
+				return nil, err
+			}
+			preds.PkgEqual = append(preds.PkgEqual, ingest)
+		}
+		return &response.PkgEqualList.PageInfo, e.writePredicates(len(response.PkgEqualList.Edges), preds)
+	})
+}
+
+func (e *exporter) certifyLegals(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.CertifyLegalList(ctx, e.client, model.CertifyLegalSpec{}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		var preds assembler.IngestPredicates
+		for _, edge := range response.CertifyLegalList.Edges {
+			ingest, err := certifyLegalIngest(edge.Node.AllCertifyLegalTree)
+			if err != nil {
+				return nil, err
+			}
+			preds.CertifyLegal = append(preds.CertifyLegal, ingest)
+		}
+		return &response.CertifyLegalList.PageInfo, e.writePredicates(len(response.CertifyLegalList.Edges), preds)
+	})
+}
+
+// hasSBOMs writes a record per HasSBOM, with the software, dependencies and
+// occurrences it includes.
+func (e *exporter) hasSBOMs(ctx context.Context) error {
+	return helpers.FollowPages(func(after *string) (helpers.PageInfo, error) {
+		response, err := model.HasSBOMList(ctx, e.client, model.HasSBOMSpec{
+			IncludedSoftware:     []*model.PackageOrArtifactSpec{},
+			IncludedDependencies: []*model.IsDependencySpec{},
+			IncludedOccurrences:  []*model.IsOccurrenceSpec{},
+		}, after, &e.first)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range response.HasSBOMList.Edges {
+			ingest, includes, err := hasSBOMIngest(edge.Node.AllHasSBOMTree)
+			if err != nil {
+				return nil, err
+			}
+			record := Record{
+				Predicates: &assembler.IngestPredicates{HasSBOM: []assembler.HasSBOMIngest{ingest}},
+				Includes:   includes,
+			}
+			if err := e.enc.Encode(record); err != nil {
+				return nil, err
+			}
+		}
+		return &response.HasSBOMList.PageInfo, nil
+	})
+}
@@ -0,0 +1,301 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/gqlserver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var (
+	tm = time.Date(2023, 7, 17, 17, 45, 50, 0, time.UTC)
+
+	appPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "app", Version: ptrfrom.String("1.0.0")}
+	libPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "lib", Version: ptrfrom.String("2.0.0"),
+		Qualifiers: []model.PackageQualifierInputSpec{{Key: "arch", Value: "amd64"}}}
+	forkPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("fork.example.com"), Name: "lib", Version: ptrfrom.String("2.0.0")}
+	appSrc  = model.SourceInputSpec{Type: "git", Namespace: "github.com/example", Name: "app", Commit: ptrfrom.String("abcdef")}
+	appArt  = model.ArtifactInputSpec{Algorithm: "sha256", Digest: "aaaa"}
+	libArt  = model.ArtifactInputSpec{Algorithm: "sha256", Digest: "bbbb"}
+	altArt  = model.ArtifactInputSpec{Algorithm: "sha512", Digest: "cccc"}
+	osvVuln = model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-1234"}
+	cveVuln = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-1234"}
+	mit     = model.LicenseInputSpec{Name: "MIT", ListVersion: ptrfrom.String("3.21")}
+)
+
+// ingestTestGraph ingests every kind of evidence, a HasSBOM including some of
+// it, and nodes that no evidence refers to.
+func ingestTestGraph(ctx context.Context, t *testing.T, client graphql.Client) {
+	t.Helper()
+	specificVersion := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	preds := assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{{
+			Pkg: &appPkg, DepPkg: &libPkg, DepPkgMatchFlag: specificVersion,
+			IsDependency: &model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, VersionRange: ">=2.0.0", Origin: "sbom", Collector: "test"},
+		}},
+		IsOccurrence: []assembler.IsOccurrenceIngest{
+			{Pkg: &appPkg, Artifact: &appArt, IsOccurrence: &model.IsOccurrenceInputSpec{Justification: "built", Origin: "sbom", Collector: "test"}},
+			{Src: &appSrc, Artifact: &appArt, IsOccurrence: &model.IsOccurrenceInputSpec{Justification: "built", Origin: "slsa", Collector: "test"}},
+		},
+		HasSlsa: []assembler.HasSlsaIngest{{
+			Artifact: &appArt, Materials: []model.ArtifactInputSpec{libArt}, Builder: &model.BuilderInputSpec{Uri: "https://example.com/builder"},
+			HasSlsa: &model.SLSAInputSpec{BuildType: "make", SlsaVersion: "v1", StartedOn: &tm, FinishedOn: &tm,
+				SlsaPredicate: []model.SLSAPredicateInputSpec{{Key: "slsa.buildDefinition", Value: "make"}}, Origin: "slsa", Collector: "test"},
+		}},
+		CertifyScorecard: []assembler.CertifyScorecardIngest{{
+			Source: &appSrc,
+			Scorecard: &model.ScorecardInputSpec{AggregateScore: 7.5, TimeScanned: tm, ScorecardVersion: "v4", ScorecardCommit: "123",
+				Checks: []model.ScorecardCheckInputSpec{{Check: "Binary-Artifacts", Score: 10}}, Origin: "scorecard", Collector: "test"},
+		}},
+		CertifyVuln: []assembler.CertifyVulnIngest{{
+			Pkg: &libPkg, Vulnerability: &osvVuln,
+			VulnData: &model.ScanMetadataInput{TimeScanned: tm, DbUri: "https://osv.dev", DbVersion: "1", ScannerUri: "osv", ScannerVersion: "1", Origin: "osv", Collector: "test"},
+		}},
+		VulnMetadata: []assembler.VulnMetadataIngest{{
+			Vulnerability: &osvVuln,
+			VulnMetadata:  &model.VulnerabilityMetadataInputSpec{ScoreType: model.VulnerabilityScoreTypeCvssv3, ScoreValue: 9.8, Timestamp: tm, Origin: "osv", Collector: "test"},
+		}},
+		VulnEqual: []assembler.VulnEqualIngest{{
+			Vulnerability: &osvVuln, EqualVulnerability: &cveVuln,
+			VulnEqual: &model.VulnEqualInputSpec{Justification: "alias", Origin: "osv", Collector: "test"},
+		}},
+		HasSourceAt: []assembler.HasSourceAtIngest{{
+			Pkg: &appPkg, PkgMatchFlag: specificVersion, Src: &appSrc,
+			HasSourceAt: &model.HasSourceAtInputSpec{KnownSince: tm, Justification: "repo", Origin: "deps.dev", Collector: "test"},
+		}},
+		CertifyBad: []assembler.CertifyBadIngest{{
+			Artifact:   &altArt,
+			CertifyBad: &model.CertifyBadInputSpec{Justification: "malware", KnownSince: tm, Origin: "bad-origin", Collector: "test"},
+		}},
+		CertifyGood: []assembler.CertifyGoodIngest{{
+			Src:         &appSrc,
+			CertifyGood: &model.CertifyGoodInputSpec{Justification: "reviewed", KnownSince: tm, Origin: "good-origin", Collector: "test", Verified: ptrfrom.Bool(true), Signer: ptrfrom.String("release")},
+		}},
+		PointOfContact: []assembler.PointOfContactIngest{{
+			Pkg: &appPkg, PkgMatchFlag: specificVersion,
+			PointOfContact: &model.PointOfContactInputSpec{Email: "security@example.com", Info: "security", Since: tm, Origin: "manual", Collector: "test"},
+		}},
+		HasMetadata: []assembler.HasMetadataIngest{{
+			Pkg: &libPkg, PkgMatchFlag: specificVersion,
+			HasMetadata: &model.HasMetadataInputSpec{Key: "lifecycle", Value: "maintained", Timestamp: tm, Origin: "manual", Collector: "test"},
+		}},
+		Vex: []assembler.VexIngest{{
+			Pkg: &libPkg, Vulnerability: &osvVuln,
+			VexData: &model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+				Statement: "not called", KnownSince: tm, Origin: "openvex", Collector: "test"},
+		}},
+		HashEqual: []assembler.HashEqualIngest{{
+			Artifact: &libArt, EqualArtifact: &altArt,
+			HashEqual: &model.HashEqualInputSpec{Justification: "same file", Origin: "scan", Collector: "test"},
+		}},
+		PkgEqual: []assembler.PkgEqualIngest{{
+			Pkg: &libPkg, EqualPkg: &forkPkg,
+			PkgEqual: &model.PkgEqualInputSpec{Justification: "fork", Origin: "manual", Collector: "test"},
+		}},
+		CertifyLegal: []assembler.CertifyLegalIngest{{
+			Pkg: &libPkg, Declared: []model.LicenseInputSpec{mit}, Discovered: []model.LicenseInputSpec{},
+			CertifyLegal: &model.CertifyLegalInputSpec{DeclaredLicense: "MIT", Attribution: "Copyright Example", TimeScanned: tm, Origin: "clearlydefined", Collector: "test"},
+		}},
+	}
+	if err := helpers.GetBulkAssembler(ctx, client)([]assembler.IngestPredicates{preds}); err != nil {
+		t.Fatalf("error ingesting predicates: %v", err)
+	}
+
+	dep, err := model.IsDependency(ctx, client, appPkg, libPkg, specificVersion,
+		model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, VersionRange: ">=2.0.0", Origin: "sbom", Collector: "test"})
+	if err != nil {
+		t.Fatalf("error ingesting dependency: %v", err)
+	}
+	lib, err := model.IngestPackage(ctx, client, libPkg)
+	if err != nil {
+		t.Fatalf("error ingesting package: %v", err)
+	}
+	if _, err := model.HasSBOMPkg(ctx, client, appPkg,
+		model.HasSBOMInputSpec{Uri: "https://example.com/app.spdx.json", Algorithm: "sha256", Digest: "dddd", KnownSince: tm, Origin: "sbom", Collector: "test"},
+		model.HasSBOMIncludesInputSpec{Software: []string{lib.IngestPackage.PackageVersionID}, Dependencies: []string{dep.IngestDependency}, Occurrences: []string{}}); err != nil {
+		t.Fatalf("error ingesting HasSBOM: %v", err)
+	}
+
+	if _, err := model.IngestBuilder(ctx, client, model.BuilderInputSpec{Uri: "https://example.com/unused-builder"}); err != nil {
+		t.Fatalf("error ingesting builder: %v", err)
+	}
+	if _, err := model.IngestLicense(ctx, client, model.LicenseInputSpec{Name: "LicenseRef-unused", Inline: ptrfrom.String("text")}); err != nil {
+		t.Fatalf("error ingesting license: %v", err)
+	}
+}
+
+// exportedItems exports the graph and returns its nodes and evidence, each
+// as its JSON encoding prefixed by its kind, in order.
+func exportedItems(ctx context.Context, t *testing.T, client graphql.Client) []string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Export(ctx, client, &buf); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	reader, err := NewReader(&buf)
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+	var items []string
+	for {
+		record, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			t.Fatalf("Next() error = %v", err)
+		}
+		if record.Nodes != nil {
+			items = append(items, marshalEach(t, *record.Nodes)...)
+		}
+		if record.Predicates != nil {
+			items = append(items, marshalEach(t, *record.Predicates)...)
+		}
+		if record.Includes != nil {
+			items = append(items, marshalEach(t, *record.Includes)...)
+		}
+	}
+	sort.Strings(items)
+	return items
+}
+
+// marshalEach marshals every element of the slice fields of v.
+func marshalEach(t *testing.T, v any) []string {
+	t.Helper()
+	var items []string
+	rv := reflect.ValueOf(v)
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Field(i)
+		for j := 0; j < field.Len(); j++ {
+			b, err := json.Marshal(field.Index(j).Interface())
+			if err != nil {
+				t.Fatalf("error marshaling %s: %v", rv.Type().Field(i).Name, err)
+			}
+			items = append(items, rv.Type().Field(i).Name+" "+string(b))
+		}
+	}
+	return items
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	source := gqlserver.NewClient(t)
+	ingestTestGraph(ctx, t, source)
+
+	var buf bytes.Buffer
+	if err := Export(ctx, source, &buf); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	target := gqlserver.NewClient(t)
+	if err := Import(ctx, target, &buf); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	want := exportedItems(ctx, t, source)
+	got := exportedItems(ctx, t, target)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected graph after import (-want +got):\n%s", diff)
+	}
+
+	kinds := map[string]bool{}
+	for _, item := range want {
+		kind, _, _ := strings.Cut(item, " ")
+		kinds[kind] = true
+	}
+	for _, kind := range []string{
+		"Packages", "Sources", "Artifacts", "Builders", "Vulnerabilities", "Licenses",
+		"IsDependency", "IsOccurrence", "HasSlsa", "CertifyScorecard", "CertifyVuln",
+		"VulnMetadata", "VulnEqual", "HasSourceAt", "CertifyBad", "CertifyGood",
+		"PointOfContact", "HasMetadata", "Vex", "HashEqual", "PkgEqual", "CertifyLegal",
+		"HasSBOM", "Dependencies",
+	} {
+		if !kinds[kind] {
+			t.Errorf("export has no %s", kind)
+		}
+	}
+	wantBad := `CertifyBad {"pkgMatchFlag":{"pkg":""},"artifact":{"algorithm":"sha512","digest":"cccc"},"certifyBad":{"justification":"malware","origin":"bad-origin","collector":"test","verified":null,"signer":null,"knownSince":"2023-07-17T17:45:50Z"}}`
+	found := false
+	for _, item := range want {
+		if strings.HasPrefix(item, "CertifyBad ") {
+			found = true
+			if item != wantBad {
+				t.Errorf("got CertifyBad %s, want %s", item, wantBad)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("export has no CertifyBad")
+	}
+}
+
+func TestImport_header(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := gqlserver.NewClient(t)
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{
+			name:  "empty export",
+			input: `{"format":"guac-graph","version":1,"created":"2023-07-17T17:45:50Z"}` + "\n",
+		},
+		{
+			name:    "missing header",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "other format",
+			input:   `{"format":"spdx","version":1}`,
+			wantErr: true,
+		},
+		{
+			name:    "newer version",
+			input:   `{"format":"guac-graph","version":2}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed record",
+			input:   `{"format":"guac-graph","version":1}` + "\n" + `{"nodes":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Import(ctx, client, strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
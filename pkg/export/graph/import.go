@@ -0,0 +1,243 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Reader reads the records of a graph export.
+type Reader struct {
+	Header Header
+	r      *bufio.Reader
+	line   int
+}
+
+// NewReader reads the header of the graph export from r, and returns a
+// Reader for its records.
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := &Reader{r: bufio.NewReader(r)}
+	line, err := reader.readLine()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("graph export has no header")
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(line, &reader.Header); err != nil {
+		return nil, fmt.Errorf("error reading graph export header: %w", err)
+	}
+	if reader.Header.Format != FormatName {
+		return nil, fmt.Errorf("unexpected graph export format %q", reader.Header.Format)
+	}
+	if reader.Header.Version < 1 || reader.Header.Version > Version {
+		return nil, fmt.Errorf("unsupported graph export version %d, expected at most %d", reader.Header.Version, Version)
+	}
+	return reader, nil
+}
+
+// Next returns the next record of the export, or io.EOF after the last one.
+func (r *Reader) Next() (*Record, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	var record Record
+	if err := json.Unmarshal(line, &record); err != nil {
+		return nil, fmt.Errorf("error reading graph export line %d: %w", r.line, err)
+	}
+	return &record, nil
+}
+
+// readLine returns the next line that is not blank.
+func (r *Reader) readLine() ([]byte, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			r.line++
+			return line, nil
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("error reading graph export: %w", err)
+		}
+		r.line++
+	}
+}
+
+// Import reads a graph export from r and ingests its nodes and evidence.
+// Evidence is ingested through the bulk assembler, as for collected
+// documents, and is deduplicated by the backend if already present.
+func Import(ctx context.Context, client graphql.Client, r io.Reader) error {
+	logger := logging.FromContext(ctx)
+	reader, err := NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	assemble := helpers.GetBulkAssembler(ctx, client)
+	for {
+		record, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		switch {
+		case record.Nodes != nil:
+			err = ingestNodes(ctx, client, *record.Nodes)
+		case record.Includes != nil:
+			err = ingestHasSBOM(ctx, client, *record)
+		case record.Predicates != nil:
+			fillLicenses(record.Predicates)
+			err = assemble([]assembler.IngestPredicates{*record.Predicates})
+		}
+		if err != nil {
+			return fmt.Errorf("error importing graph export line %d: %w", reader.line, err)
+		}
+	}
+	logger.Infof("imported graph exported at %s", reader.Header.Created)
+	return nil
+}
+
+// fillLicenses sets the license lists of CertifyLegal omitted from the export
+// for being empty, as the mutations require them.
+func fillLicenses(preds *assembler.IngestPredicates) {
+	for i := range preds.CertifyLegal {
+		if preds.CertifyLegal[i].Declared == nil {
+			preds.CertifyLegal[i].Declared = []model.LicenseInputSpec{}
+		}
+		if preds.CertifyLegal[i].Discovered == nil {
+			preds.CertifyLegal[i].Discovered = []model.LicenseInputSpec{}
+		}
+	}
+}
+
+func ingestNodes(ctx context.Context, client graphql.Client, nodes Nodes) error {
+	if len(nodes.Packages) > 0 {
+		if _, err := model.IngestPackages(ctx, client, nodes.Packages); err != nil {
+			return fmt.Errorf("error ingesting packages: %w", err)
+		}
+	}
+	if len(nodes.Sources) > 0 {
+		if _, err := model.IngestSources(ctx, client, nodes.Sources); err != nil {
+			return fmt.Errorf("error ingesting sources: %w", err)
+		}
+	}
+	if len(nodes.Artifacts) > 0 {
+		if _, err := model.IngestArtifacts(ctx, client, nodes.Artifacts); err != nil {
+			return fmt.Errorf("error ingesting artifacts: %w", err)
+		}
+	}
+	if len(nodes.Builders) > 0 {
+		if _, err := model.IngestBuilders(ctx, client, nodes.Builders); err != nil {
+			return fmt.Errorf("error ingesting builders: %w", err)
+		}
+	}
+	if len(nodes.Vulnerabilities) > 0 {
+		if _, err := model.IngestVulnerabilities(ctx, client, nodes.Vulnerabilities); err != nil {
+			return fmt.Errorf("error ingesting vulnerabilities: %w", err)
+		}
+	}
+	if len(nodes.Licenses) > 0 {
+		if _, err := model.IngestLicenses(ctx, client, nodes.Licenses); err != nil {
+			return fmt.Errorf("error ingesting licenses: %w", err)
+		}
+	}
+	return nil
+}
+
+// ingestHasSBOM ingests the HasSBOM of the record after its included
+// software, dependencies and occurrences, so that it includes them by their
+// IDs in this backend.
+func ingestHasSBOM(ctx context.Context, client graphql.Client, record Record) error {
+	if record.Predicates == nil || len(record.Predicates.HasSBOM) != 1 {
+		return fmt.Errorf("expected a single HasSBOM with its includes")
+	}
+	hasSBOM := record.Predicates.HasSBOM[0]
+	includes := model.HasSBOMIncludesInputSpec{
+		Software:     []string{},
+		Dependencies: []string{},
+		Occurrences:  []string{},
+	}
+
+	if len(record.Includes.Packages) > 0 {
+		response, err := model.IngestPackages(ctx, client, record.Includes.Packages)
+		if err != nil {
+			return fmt.Errorf("error ingesting included packages: %w", err)
+		}
+		for _, pkg := range response.IngestPackages {
+			includes.Software = append(includes.Software, pkg.PackageVersionID)
+		}
+	}
+	if len(record.Includes.Artifacts) > 0 {
+		response, err := model.IngestArtifacts(ctx, client, record.Includes.Artifacts)
+		if err != nil {
+			return fmt.Errorf("error ingesting included artifacts: %w", err)
+		}
+		includes.Software = append(includes.Software, response.IngestArtifacts...)
+	}
+	for _, dep := range record.Includes.Dependencies {
+		response, err := model.IsDependency(ctx, client, *dep.Pkg, *dep.DepPkg, dep.DepPkgMatchFlag, *dep.IsDependency)
+		if err != nil {
+			return fmt.Errorf("error ingesting included dependency: %w", err)
+		}
+		includes.Dependencies = append(includes.Dependencies, response.IngestDependency)
+	}
+	for _, occurrence := range record.Includes.Occurrences {
+		var id string
+		if occurrence.Pkg != nil {
+			response, err := model.IsOccurrencePkg(ctx, client, *occurrence.Pkg, *occurrence.Artifact, *occurrence.IsOccurrence)
+			if err != nil {
+				return fmt.Errorf("error ingesting included occurrence: %w", err)
+			}
+			id = response.IngestOccurrence
+		} else {
+			response, err := model.IsOccurrenceSrc(ctx, client, *occurrence.Src, *occurrence.Artifact, *occurrence.IsOccurrence)
+			if err != nil {
+				return fmt.Errorf("error ingesting included occurrence: %w", err)
+			}
+			id = response.IngestOccurrence
+		}
+		includes.Occurrences = append(includes.Occurrences, id)
+	}
+
+	if hasSBOM.Pkg != nil {
+		if _, err := model.HasSBOMPkg(ctx, client, *hasSBOM.Pkg, *hasSBOM.HasSBOM, includes); err != nil {
+			return fmt.Errorf("error ingesting HasSBOM: %w", err)
+		}
+	} else {
+		if _, err := model.HasSBOMArtifact(ctx, client, *hasSBOM.Artifact, *hasSBOM.HasSBOM, includes); err != nil {
+			return fmt.Errorf("error ingesting HasSBOM: %w", err)
+		}
+	}
+	return nil
+}
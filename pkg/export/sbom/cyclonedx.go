@@ -0,0 +1,141 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/gofrs/uuid"
+)
+
+var cdxHashAlgorithms = map[string]cdx.HashAlgorithm{}
+
+func init() {
+	for _, algorithm := range []cdx.HashAlgorithm{
+		cdx.HashAlgoMD5, cdx.HashAlgoSHA1, cdx.HashAlgoSHA256, cdx.HashAlgoSHA384, cdx.HashAlgoSHA512,
+		cdx.HashAlgoSHA3_256, cdx.HashAlgoSHA3_384, cdx.HashAlgoSHA3_512,
+		cdx.HashAlgoBlake2b_256, cdx.HashAlgoBlake2b_384, cdx.HashAlgoBlake2b_512, cdx.HashAlgoBlake3,
+	} {
+		// GUAC stores algorithms lowercase and without the dash, "sha256"
+		cdxHashAlgorithms[strings.ToLower(string(algorithm))] = algorithm
+		cdxHashAlgorithms[strings.ToLower(strings.Replace(string(algorithm), "SHA-", "SHA", 1))] = algorithm
+	}
+}
+
+func writeCycloneDX(w io.Writer, s *SBOM) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	bom := cdx.NewBOM()
+	bom.SerialNumber = "urn:uuid:" + id.String()
+	root := cdxComponent(s.Subject)
+	bom.Metadata = &cdx.Metadata{
+		Timestamp: s.Created.UTC().Format(time.RFC3339),
+		Tools:     &[]cdx.Tool{{Vendor: "guacsec", Name: "guac"}},
+		Component: &root,
+	}
+
+	var components []cdx.Component
+	for _, c := range s.Components {
+		components = append(components, cdxComponent(c))
+	}
+	if len(components) > 0 {
+		bom.Components = &components
+	}
+
+	var dependencies []cdx.Dependency
+	for _, c := range append([]*Component{s.Subject}, s.Components...) {
+		if len(c.DependsOn) == 0 {
+			continue
+		}
+		var refs []string
+		for _, dep := range c.DependsOn {
+			refs = append(refs, cdxBOMRef(dep))
+		}
+		dependencies = append(dependencies, cdx.Dependency{Ref: cdxBOMRef(c.ID), Dependencies: &refs})
+	}
+	if len(dependencies) > 0 {
+		bom.Dependencies = &dependencies
+	}
+
+	var references []cdx.ExternalReference
+	for _, source := range s.Sources {
+		ref := cdx.ExternalReference{URL: source.URI, Type: cdx.ERTypeBOM}
+		if algorithm, ok := cdxHashAlgorithms[source.Algorithm]; ok {
+			ref.Hashes = &[]cdx.Hash{{Algorithm: algorithm, Value: source.Digest}}
+		}
+		references = append(references, ref)
+	}
+	if len(references) > 0 {
+		bom.ExternalReferences = &references
+	}
+
+	encoder := cdx.NewBOMEncoder(w, cdx.BOMFileFormatJSON)
+	encoder.SetPretty(true)
+	return encoder.Encode(bom)
+}
+
+func cdxComponent(c *Component) cdx.Component {
+	component := cdx.Component{
+		BOMRef:     cdxBOMRef(c.ID),
+		Type:       cdx.ComponentTypeLibrary,
+		Group:      c.Namespace,
+		Name:       c.Name,
+		Version:    c.Version,
+		PackageURL: c.Purl,
+		Copyright:  c.Attribution,
+	}
+	if c.isArtifact {
+		component.Type = cdx.ComponentTypeFile
+	}
+
+	var hashes []cdx.Hash
+	for _, h := range c.Hashes {
+		if algorithm, ok := cdxHashAlgorithms[h.Algorithm]; ok {
+			hashes = append(hashes, cdx.Hash{Algorithm: algorithm, Value: h.Digest})
+		}
+	}
+	if len(hashes) > 0 {
+		component.Hashes = &hashes
+	}
+
+	// CycloneDX has a single list of licenses, prefer the declared one
+	license := c.DeclaredLicense
+	if license == "" || license == spdxNoAssertion {
+		license = c.DiscoveredLicense
+	}
+	if license != "" && license != spdxNoAssertion {
+		component.Licenses = &cdx.Licenses{{Expression: license}}
+	}
+
+	var properties []cdx.Property
+	for _, prop := range c.Properties {
+		properties = append(properties, cdx.Property{Name: prop.Key, Value: prop.Value})
+	}
+	if len(properties) > 0 {
+		component.Properties = &properties
+	}
+	return component
+}
+
+func cdxBOMRef(id string) string {
+	return "guac-" + id
+}
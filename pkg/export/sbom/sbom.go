@@ -0,0 +1,518 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom rebuilds software bills of materials from the evidence in the
+// graph and writes them as SPDX or CycloneDX documents.
+package sbom
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+type Format string
+
+const (
+	FormatSPDX      Format = "spdx"
+	FormatCycloneDX Format = "cyclonedx"
+)
+
+// SBOM is a software bill of materials assembled from the HasSBOM,
+// IsDependency, IsOccurrence, CertifyLegal and HasMetadata nodes reachable
+// from its subject.
+type SBOM struct {
+	// Subject is the package or artifact the SBOM describes.
+	Subject *Component
+	// Components holds the software reachable from the subject, ordered by
+	// purl.
+	Components []*Component
+	// Sources lists the ingested SBOMs of the subject merged into this one.
+	Sources []Source
+	Created time.Time
+}
+
+// Component is a package, or for an artifact subject the artifact itself.
+type Component struct {
+	// ID is the GUAC ID of the package version, package name or artifact.
+	ID        string
+	Purl      string
+	Type      string
+	Namespace string
+	Name      string
+	Version   string
+	Hashes    []Hash
+
+	DeclaredLicense   string
+	DiscoveredLicense string
+	Attribution       string
+
+	Properties []Property
+	// DependsOn holds the IDs of the components this one depends on.
+	DependsOn []string
+
+	isArtifact   bool
+	isPkgVersion bool
+}
+
+type Hash struct {
+	Algorithm string
+	Digest    string
+}
+
+// Property is a key/value pair attached through HasMetadata.
+type Property struct {
+	Key       string
+	Value     string
+	Timestamp time.Time
+}
+
+// Source is an ingested SBOM document of the subject.
+type Source struct {
+	URI              string
+	Algorithm        string
+	Digest           string
+	DownloadLocation string
+	Origin           string
+	Collector        string
+	KnownSince       time.Time
+}
+
+// Write writes the SBOM to w in the given format.
+func (s *SBOM) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatSPDX:
+		return writeSPDX(w, s)
+	case FormatCycloneDX:
+		return writeCycloneDX(w, s)
+	default:
+		return fmt.Errorf("unsupported SBOM format: %s", format)
+	}
+}
+
+type builder struct {
+	gqlClient  graphql.Client
+	components map[string]*Component
+	dependsOn  map[string]map[string]bool
+	sources    []Source
+	// package versions whose dependencies are still to be looked up
+	queue []string
+}
+
+// Build assembles the SBOM of the subject, given as a purl or as an artifact
+// in the algorithm:digest form.
+//
+// The SBOM merges the software and dependencies included in every HasSBOM of
+// the subject with the IsDependency nodes transitively reachable from it.
+// Each package is then enriched with the hashes of its IsOccurrence
+// artifacts, the licenses of its latest CertifyLegal and its HasMetadata
+// key/value pairs.
+func Build(ctx context.Context, gqlClient graphql.Client, subject string) (*SBOM, error) {
+	b := &builder{
+		gqlClient:  gqlClient,
+		components: map[string]*Component{},
+		dependsOn:  map[string]map[string]bool{},
+	}
+
+	var root *Component
+	var err error
+	if strings.HasPrefix(subject, "pkg:") {
+		root, err = b.resolvePackage(ctx, subject)
+	} else {
+		root, err = b.resolveArtifact(ctx, subject)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.addHasSBOMs(ctx, root); err != nil {
+		return nil, err
+	}
+	for len(b.queue) > 0 {
+		id := b.queue[0]
+		b.queue = b.queue[1:]
+		if err := b.addDependencies(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range b.components {
+		if !c.isPkgVersion {
+			continue
+		}
+		if err := b.enrich(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.sbom(root), nil
+}
+
+func (b *builder) resolvePackage(ctx context.Context, purl string) (*Component, error) {
+	pkgInput, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse purl %s: %w", purl, err)
+	}
+	pkgQualifierFilter := []model.PackageQualifierSpec{}
+	for _, qualifier := range pkgInput.Qualifiers {
+		qualifier := qualifier
+		pkgQualifierFilter = append(pkgQualifierFilter, model.PackageQualifierSpec{
+			Key:   qualifier.Key,
+			Value: &qualifier.Value,
+		})
+	}
+	pkgFilter := model.PkgSpec{
+		Type:      &pkgInput.Type,
+		Namespace: pkgInput.Namespace,
+		Name:      &pkgInput.Name,
+	}
+	if pkgInput.Version != nil {
+		pkgFilter.Version = pkgInput.Version
+		pkgFilter.Subpath = pkgInput.Subpath
+		pkgFilter.Qualifiers = pkgQualifierFilter
+		// a purl without qualifiers names the version without any, not
+		// every qualified variant of it
+		pkgFilter.MatchOnlyEmptyQualifiers = ptrfrom.Bool(len(pkgQualifierFilter) == 0)
+	}
+	pkgs, err := clienthelpers.Packages(ctx, b.gqlClient, pkgFilter)
+	if err != nil {
+		return nil, fmt.Errorf("error querying for package %s: %w", purl, err)
+	}
+	// split the results into single packages, to find out whether the purl
+	// is ambiguous
+	var matches []model.AllPkgTree
+	for _, pkg := range pkgs {
+		for _, ns := range pkg.Namespaces {
+			for _, name := range ns.Names {
+				if pkgInput.Version == nil {
+					// only keep the name
+					name.Versions = nil
+					matches = append(matches, singlePackage(pkg, ns, name))
+					continue
+				}
+				for _, version := range name.Versions {
+					name := name
+					name.Versions = []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion{version}
+					matches = append(matches, singlePackage(pkg, ns, name))
+				}
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no package matching purl %s found", purl)
+	}
+	if len(matches) > 1 {
+		var purls []string
+		for _, match := range matches {
+			purls = append(purls, packagePurl(match))
+		}
+		return nil, fmt.Errorf("purl %s matches %d packages, use one of %s", purl, len(matches), strings.Join(purls, ", "))
+	}
+	id, err := b.addPackage(matches[0])
+	if err != nil {
+		return nil, err
+	}
+	return b.components[id], nil
+}
+
+func (b *builder) resolveArtifact(ctx context.Context, artifact string) (*Component, error) {
+	algorithm, digest, ok := strings.Cut(artifact, ":")
+	if !ok {
+		return nil, fmt.Errorf("expected subject to be a purl or an artifact as algorithm:digest, got %s", artifact)
+	}
+	algorithm = strings.ToLower(algorithm)
+	digest = strings.ToLower(digest)
+	arts, err := clienthelpers.Artifacts(ctx, b.gqlClient, model.ArtifactSpec{Algorithm: &algorithm, Digest: &digest})
+	if err != nil {
+		return nil, fmt.Errorf("error querying for artifact %s: %w", artifact, err)
+	}
+	if len(arts) == 0 {
+		return nil, fmt.Errorf("no artifact %s found", artifact)
+	}
+	art := arts[0]
+	root := &Component{
+		ID:         art.Id,
+		Name:       art.Algorithm + ":" + art.Digest,
+		Hashes:     []Hash{{Algorithm: art.Algorithm, Digest: art.Digest}},
+		isArtifact: true,
+	}
+	b.components[root.ID] = root
+
+	// the artifact is made of the packages it is an occurrence of
+	occs, err := clienthelpers.IsOccurrences(ctx, b.gqlClient, model.IsOccurrenceSpec{Artifact: &model.ArtifactSpec{Id: &art.Id}})
+	if err != nil {
+		return nil, fmt.Errorf("error querying for occurrences of artifact %s: %w", artifact, err)
+	}
+	for _, occ := range occs {
+		if pkg, ok := occ.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+			id, err := b.addPackage(pkg.AllPkgTree)
+			if err != nil {
+				return nil, err
+			}
+			b.addDependency(root.ID, id)
+		}
+	}
+	return root, nil
+}
+
+func (b *builder) addHasSBOMs(ctx context.Context, root *Component) error {
+	subject := &model.PackageOrArtifactSpec{}
+	if root.isArtifact {
+		subject.Artifact = &model.ArtifactSpec{Id: &root.ID}
+	} else {
+		subject.Package = &model.PkgSpec{Id: &root.ID}
+	}
+	hasSBOMs, err := clienthelpers.HasSBOMs(ctx, b.gqlClient, model.HasSBOMSpec{
+		Subject:              subject,
+		IncludedSoftware:     []*model.PackageOrArtifactSpec{},
+		IncludedDependencies: []*model.IsDependencySpec{},
+		IncludedOccurrences:  []*model.IsOccurrenceSpec{},
+	})
+	if err != nil {
+		return fmt.Errorf("error querying for HasSBOM: %w", err)
+	}
+	for _, hasSBOM := range hasSBOMs {
+		b.sources = append(b.sources, Source{
+			URI:              hasSBOM.Uri,
+			Algorithm:        hasSBOM.Algorithm,
+			Digest:           hasSBOM.Digest,
+			DownloadLocation: hasSBOM.DownloadLocation,
+			Origin:           hasSBOM.Origin,
+			Collector:        hasSBOM.Collector,
+			KnownSince:       hasSBOM.KnownSince,
+		})
+		for _, software := range hasSBOM.IncludedSoftware {
+			// artifacts are picked up with the occurrences of their packages
+			if pkg, ok := software.(*model.AllHasSBOMTreeIncludedSoftwarePackage); ok {
+				if _, err := b.addPackage(pkg.AllPkgTree); err != nil {
+					return err
+				}
+			}
+		}
+		for _, dep := range hasSBOM.IncludedDependencies {
+			from, err := b.addPackage(dep.Package.AllPkgTree)
+			if err != nil {
+				return err
+			}
+			to, err := b.addPackage(dep.DependencyPackage.AllPkgTree)
+			if err != nil {
+				return err
+			}
+			b.addDependency(from, to)
+		}
+		for _, occ := range hasSBOM.IncludedOccurrences {
+			if pkg, ok := occ.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+				id, err := b.addPackage(pkg.AllPkgTree)
+				if err != nil {
+					return err
+				}
+				b.components[id].addHash(occ.Artifact.Algorithm, occ.Artifact.Digest)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *builder) addDependencies(ctx context.Context, id string) error {
+	deps, err := clienthelpers.IsDependencies(ctx, b.gqlClient, model.IsDependencySpec{Package: &model.PkgSpec{Id: &id}})
+	if err != nil {
+		return fmt.Errorf("error querying for dependencies of %s: %w", id, err)
+	}
+	for _, dep := range deps {
+		depID, err := b.addPackage(dep.DependencyPackage.AllPkgTree)
+		if err != nil {
+			return err
+		}
+		b.addDependency(id, depID)
+	}
+	return nil
+}
+
+// enrich adds the hashes, licenses and metadata of a package version
+func (b *builder) enrich(ctx context.Context, c *Component) error {
+	pkgSpec := &model.PkgSpec{Id: &c.ID}
+
+	occs, err := clienthelpers.IsOccurrences(ctx, b.gqlClient, model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Package: pkgSpec}})
+	if err != nil {
+		return fmt.Errorf("error querying for occurrences of %s: %w", c.Purl, err)
+	}
+	for _, occ := range occs {
+		c.addHash(occ.Artifact.Algorithm, occ.Artifact.Digest)
+	}
+
+	legals, err := clienthelpers.CertifyLegals(ctx, b.gqlClient, model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{Package: pkgSpec}})
+	if err != nil {
+		return fmt.Errorf("error querying for licenses of %s: %w", c.Purl, err)
+	}
+	var latest time.Time
+	for _, legal := range legals {
+		if legal.TimeScanned.Before(latest) {
+			continue
+		}
+		latest = legal.TimeScanned
+		c.DeclaredLicense = legal.DeclaredLicense
+		c.DiscoveredLicense = legal.DiscoveredLicense
+		c.Attribution = legal.Attribution
+	}
+
+	metadata, err := clienthelpers.HasMetadata(ctx, b.gqlClient, model.HasMetadataSpec{Subject: &model.PackageSourceOrArtifactSpec{Package: pkgSpec}})
+	if err != nil {
+		return fmt.Errorf("error querying for metadata of %s: %w", c.Purl, err)
+	}
+	for _, metadata := range metadata {
+		c.Properties = append(c.Properties, Property{Key: metadata.Key, Value: metadata.Value, Timestamp: metadata.Timestamp})
+	}
+	sort.Slice(c.Properties, func(i, j int) bool {
+		if c.Properties[i].Key != c.Properties[j].Key {
+			return c.Properties[i].Key < c.Properties[j].Key
+		}
+		return c.Properties[i].Value < c.Properties[j].Value
+	})
+	return nil
+}
+
+// addPackage adds a package version, or a package name for dependencies on
+// any version, and returns its ID.
+func (b *builder) addPackage(pkg model.AllPkgTree) (string, error) {
+	if len(pkg.Namespaces) == 0 || len(pkg.Namespaces[0].Names) == 0 {
+		return "", fmt.Errorf("package %s of type %s has no name", pkg.Id, pkg.Type)
+	}
+	ns := pkg.Namespaces[0]
+	name := ns.Names[0]
+	c := &Component{
+		ID:        name.Id,
+		Type:      pkg.Type,
+		Namespace: ns.Namespace,
+		Name:      name.Name,
+	}
+	if len(name.Versions) > 0 {
+		version := name.Versions[0]
+		c.ID = version.Id
+		c.Version = version.Version
+		c.isPkgVersion = true
+	}
+	if _, ok := b.components[c.ID]; ok {
+		return c.ID, nil
+	}
+	c.Purl = packagePurl(pkg)
+	b.components[c.ID] = c
+	if c.isPkgVersion {
+		b.queue = append(b.queue, c.ID)
+	}
+	return c.ID, nil
+}
+
+// singlePackage returns the tree of a single package name or version.
+func singlePackage(pkg model.AllPkgTree, ns model.AllPkgTreeNamespacesPackageNamespace,
+	name model.AllPkgTreeNamespacesPackageNamespaceNamesPackageName) model.AllPkgTree {
+	ns.Names = []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageName{name}
+	pkg.Namespaces = []model.AllPkgTreeNamespacesPackageNamespace{ns}
+	return pkg
+}
+
+// packagePurl returns the purl of the first package name or version in a tree
+// that has one.
+func packagePurl(pkg model.AllPkgTree) string {
+	ns := pkg.Namespaces[0]
+	name := ns.Names[0]
+	var version, subpath string
+	var qualifiers []string
+	if len(name.Versions) > 0 {
+		version = name.Versions[0].Version
+		subpath = name.Versions[0].Subpath
+		for _, qualifier := range name.Versions[0].Qualifiers {
+			qualifiers = append(qualifiers, qualifier.Key, qualifier.Value)
+		}
+	}
+	return helpers.PkgToPurl(pkg.Type, ns.Namespace, name.Name, version, subpath, qualifiers)
+}
+
+func (b *builder) addDependency(from, to string) {
+	if from == to {
+		return
+	}
+	if b.dependsOn[from] == nil {
+		b.dependsOn[from] = map[string]bool{}
+	}
+	b.dependsOn[from][to] = true
+}
+
+func (c *Component) addHash(algorithm, digest string) {
+	for _, h := range c.Hashes {
+		if h.Algorithm == algorithm && h.Digest == digest {
+			return
+		}
+	}
+	c.Hashes = append(c.Hashes, Hash{Algorithm: algorithm, Digest: digest})
+}
+
+// DisplayName returns the purl of a package or the algorithm:digest of an
+// artifact.
+func (c *Component) DisplayName() string {
+	if c.Purl != "" {
+		return c.Purl
+	}
+	return c.Name
+}
+
+// IsArtifact reports whether the component is the artifact subject of the
+// SBOM rather than a package.
+func (c *Component) IsArtifact() bool {
+	return c.isArtifact
+}
+
+// IsPackageVersion reports whether the component is a package version rather
+// than a package name or an artifact.
+func (c *Component) IsPackageVersion() bool {
+	return c.isPkgVersion
+}
+
+func (b *builder) sbom(root *Component) *SBOM {
+	s := &SBOM{
+		Subject: root,
+		Sources: b.sources,
+		Created: time.Now().UTC(),
+	}
+	for id, c := range b.components {
+		for dep := range b.dependsOn[id] {
+			c.DependsOn = append(c.DependsOn, dep)
+		}
+		sort.Strings(c.DependsOn)
+		sort.Slice(c.Hashes, func(i, j int) bool {
+			if c.Hashes[i].Algorithm != c.Hashes[j].Algorithm {
+				return c.Hashes[i].Algorithm < c.Hashes[j].Algorithm
+			}
+			return c.Hashes[i].Digest < c.Hashes[j].Digest
+		})
+		if id != root.ID {
+			s.Components = append(s.Components, c)
+		}
+	}
+	sort.Slice(s.Components, func(i, j int) bool {
+		if s.Components[i].Purl != s.Components[j].Purl {
+			return s.Components[i].Purl < s.Components[j].Purl
+		}
+		return s.Components[i].ID < s.Components[j].ID
+	})
+	return s
+}
@@ -0,0 +1,295 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/gqlserver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/spdx"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var (
+	tm = time.Date(2023, 7, 17, 17, 45, 50, 0, time.UTC)
+
+	appPkg  = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "app", Version: ptrfrom.String("1.0.0")}
+	libPkg  = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "lib", Version: ptrfrom.String("2.0.0")}
+	leafPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "leaf", Version: ptrfrom.String("3.0.0")}
+	libArt  = model.ArtifactInputSpec{Algorithm: "sha256", Digest: "aaaa"}
+	appArt  = model.ArtifactInputSpec{Algorithm: "sha256", Digest: "bbbb"}
+
+	// a variant of app that nothing refers to
+	armAppPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "app", Version: ptrfrom.String("1.0.0"),
+		Qualifiers: []model.PackageQualifierInputSpec{{Key: "arch", Value: "arm64"}}}
+)
+
+// ingestTestGraph ingests an SBOM of app that includes its dependency on lib,
+// and from other sources a dependency of lib on leaf, the hash, license and
+// metadata of lib, and the artifact of app. An arm64 variant of app is in the
+// graph as well.
+func ingestTestGraph(ctx context.Context, t *testing.T, client graphql.Client) {
+	t.Helper()
+	for _, pkg := range []model.PkgInputSpec{appPkg, libPkg, leafPkg, armAppPkg} {
+		if _, err := model.IngestPackage(ctx, client, pkg); err != nil {
+			t.Fatalf("error ingesting package: %v", err)
+		}
+	}
+	for _, art := range []model.ArtifactInputSpec{libArt, appArt} {
+		if _, err := model.IngestArtifact(ctx, client, art); err != nil {
+			t.Fatalf("error ingesting artifact: %v", err)
+		}
+	}
+	mit := model.LicenseInputSpec{Name: "MIT", ListVersion: ptrfrom.String("3.21")}
+	if _, err := model.IngestLicense(ctx, client, mit); err != nil {
+		t.Fatalf("error ingesting license: %v", err)
+	}
+	dep, err := model.IsDependency(ctx, client, appPkg, libPkg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Collector: "test"})
+	if err != nil {
+		t.Fatalf("error ingesting dependency: %v", err)
+	}
+	if _, err := model.IsDependency(ctx, client, libPkg, leafPkg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting dependency: %v", err)
+	}
+	if _, err := model.HasSBOMPkg(ctx, client, appPkg,
+		model.HasSBOMInputSpec{Uri: "https://example.com/app.spdx.json", Algorithm: "sha256", Digest: "cccc", KnownSince: tm, Collector: "test"},
+		model.HasSBOMIncludesInputSpec{Software: []string{}, Dependencies: []string{dep.IngestDependency}, Occurrences: []string{}}); err != nil {
+		t.Fatalf("error ingesting HasSBOM: %v", err)
+	}
+	if _, err := model.IsOccurrencePkg(ctx, client, libPkg, libArt, model.IsOccurrenceInputSpec{Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting occurrence: %v", err)
+	}
+	if _, err := model.IsOccurrencePkg(ctx, client, appPkg, appArt, model.IsOccurrenceInputSpec{Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting occurrence: %v", err)
+	}
+	if _, err := model.CertifyLegalPkg(ctx, client, libPkg,
+		[]model.LicenseInputSpec{mit}, []model.LicenseInputSpec{},
+		model.CertifyLegalInputSpec{DeclaredLicense: "MIT", Attribution: "Copyright Example", TimeScanned: tm, Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting CertifyLegal: %v", err)
+	}
+	if _, err := model.HasMetadataPkg(ctx, client, libPkg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.HasMetadataInputSpec{Key: "lifecycle", Value: "maintained", Timestamp: tm, Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting HasMetadata: %v", err)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := gqlserver.NewClient(t)
+	ingestTestGraph(ctx, t, client)
+
+	ignoreIDs := cmpopts.IgnoreFields(Component{}, "ID", "DependsOn")
+	lib := &Component{
+		Purl:            "pkg:golang/example.com/lib@2.0.0",
+		Type:            "golang",
+		Namespace:       "example.com",
+		Name:            "lib",
+		Version:         "2.0.0",
+		Hashes:          []Hash{{Algorithm: "sha256", Digest: "aaaa"}},
+		DeclaredLicense: "MIT",
+		Attribution:     "Copyright Example",
+		Properties:      []Property{{Key: "lifecycle", Value: "maintained", Timestamp: tm}},
+	}
+	leaf := &Component{
+		Purl:      "pkg:golang/example.com/leaf@3.0.0",
+		Type:      "golang",
+		Namespace: "example.com",
+		Name:      "leaf",
+		Version:   "3.0.0",
+	}
+	app := &Component{
+		Purl:      "pkg:golang/example.com/app@1.0.0",
+		Type:      "golang",
+		Namespace: "example.com",
+		Name:      "app",
+		Version:   "1.0.0",
+		Hashes:    []Hash{{Algorithm: "sha256", Digest: "bbbb"}},
+	}
+
+	tests := []struct {
+		name           string
+		subject        string
+		wantSubject    *Component
+		wantComponents []*Component
+		wantSources    int
+		wantDependsOn  int
+		wantErr        bool
+	}{
+		{
+			name:           "package",
+			subject:        "pkg:golang/example.com/app@1.0.0",
+			wantSubject:    app,
+			wantComponents: []*Component{leaf, lib},
+			wantSources:    1,
+			// app depends on lib
+			wantDependsOn: 1,
+		},
+		{
+			name:    "qualified package",
+			subject: "pkg:golang/example.com/app@1.0.0?arch=arm64",
+			wantSubject: &Component{
+				Purl:      "pkg:golang/example.com/app@1.0.0?arch=arm64",
+				Type:      "golang",
+				Namespace: "example.com",
+				Name:      "app",
+				Version:   "1.0.0",
+			},
+		},
+		{
+			name:    "artifact",
+			subject: "sha256:bbbb",
+			wantSubject: &Component{
+				Name:   "sha256:bbbb",
+				Hashes: []Hash{{Algorithm: "sha256", Digest: "bbbb"}},
+			},
+			wantComponents: []*Component{app, leaf, lib},
+			// the app artifact is an occurrence of app
+			wantDependsOn: 1,
+		},
+		{
+			name:    "unknown package",
+			subject: "pkg:golang/example.com/unknown@1.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "malformed subject",
+			subject: "not-a-subject",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Build(ctx, client, tt.subject)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wantSubject, got.Subject, ignoreIDs, cmpopts.IgnoreUnexported(Component{})); diff != "" {
+				t.Errorf("unexpected subject (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantComponents, got.Components, ignoreIDs, cmpopts.IgnoreUnexported(Component{})); diff != "" {
+				t.Errorf("unexpected components (-want +got):\n%s", diff)
+			}
+			if len(got.Sources) != tt.wantSources {
+				t.Errorf("got %d sources, want %d", len(got.Sources), tt.wantSources)
+			}
+			if len(got.Subject.DependsOn) != tt.wantDependsOn {
+				t.Errorf("subject depends on %v, want %d components", got.Subject.DependsOn, tt.wantDependsOn)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := gqlserver.NewClient(t)
+	ingestTestGraph(ctx, t, client)
+
+	s, err := Build(ctx, client, "pkg:golang/example.com/app@1.0.0")
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	t.Run("spdx", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := s.Write(&buf, FormatSPDX); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		// the exported document can be ingested again
+		p := spdx.NewSpdxParser()
+		doc := &processor.Document{
+			Blob:   buf.Bytes(),
+			Type:   processor.DocumentSPDX,
+			Format: processor.FormatJSON,
+		}
+		if err := p.Parse(ctx, doc); err != nil {
+			t.Fatalf("exported SPDX does not parse: %v", err)
+		}
+		preds := p.GetPredicates(ctx)
+		if len(preds.HasSBOM) != 1 || preds.HasSBOM[0].Pkg.Name != "app" {
+			t.Errorf("unexpected top-level package in exported SPDX: %+v", preds.HasSBOM)
+		}
+		// app on lib and lib on leaf, and app on leaf as a transitive dependency
+		indirect := 0
+		for _, dep := range preds.IsDependency {
+			if dep.IsDependency.DependencyType == model.DependencyTypeIndirect {
+				indirect++
+			}
+		}
+		if len(preds.IsDependency) != 3 || indirect != 1 {
+			t.Errorf("got %d dependencies from exported SPDX, %d of them indirect, want 3 and 1", len(preds.IsDependency), indirect)
+		}
+		if len(preds.CertifyLegal) != 1 || preds.CertifyLegal[0].CertifyLegal.DeclaredLicense != "MIT" {
+			t.Errorf("unexpected licenses from exported SPDX: %+v", preds.CertifyLegal)
+		}
+		if len(preds.IsOccurrence) != 2 {
+			t.Errorf("got %d occurrences from exported SPDX, want 2", len(preds.IsOccurrence))
+		}
+	})
+
+	t.Run("cyclonedx", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := s.Write(&buf, FormatCycloneDX); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		bom := cdx.BOM{}
+		if err := cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(&bom); err != nil {
+			t.Fatalf("exported CycloneDX does not decode: %v", err)
+		}
+		if bom.Metadata.Component.PackageURL != "pkg:golang/example.com/app@1.0.0" {
+			t.Errorf("unexpected metadata component: %+v", bom.Metadata.Component)
+		}
+		if bom.Components == nil || len(*bom.Components) != 2 {
+			t.Fatalf("unexpected components: %+v", bom.Components)
+		}
+		gotLib := (*bom.Components)[1]
+		if gotLib.Licenses == nil || (*gotLib.Licenses)[0].Expression != "MIT" {
+			t.Errorf("unexpected lib licenses: %+v", gotLib.Licenses)
+		}
+		if gotLib.Hashes == nil || (*gotLib.Hashes)[0].Algorithm != cdx.HashAlgoSHA256 {
+			t.Errorf("unexpected lib hashes: %+v", gotLib.Hashes)
+		}
+		if gotLib.Properties == nil || (*gotLib.Properties)[0].Name != "lifecycle" {
+			t.Errorf("unexpected lib properties: %+v", gotLib.Properties)
+		}
+		if bom.Dependencies == nil || len(*bom.Dependencies) != 2 {
+			t.Errorf("unexpected dependencies: %+v", bom.Dependencies)
+		}
+		if bom.ExternalReferences == nil || (*bom.ExternalReferences)[0].URL != "https://example.com/app.spdx.json" {
+			t.Errorf("unexpected external references: %+v", bom.ExternalReferences)
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		if err := s.Write(&bytes.Buffer{}, "swid"); err == nil {
+			t.Errorf("Write() succeeded for an unsupported format")
+		}
+	})
+}
@@ -0,0 +1,136 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/spdx/tools-golang/json"
+	spdx "github.com/spdx/tools-golang/spdx"
+	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+const spdxNoAssertion = "NOASSERTION"
+
+var spdxChecksumAlgorithms = map[string]spdx.ChecksumAlgorithm{}
+
+func init() {
+	for _, algorithm := range []spdx.ChecksumAlgorithm{
+		spdx.SHA1, spdx.SHA224, spdx.SHA256, spdx.SHA384, spdx.SHA512,
+		spdx.MD2, spdx.MD4, spdx.MD5, spdx.MD6,
+		spdx.SHA3_256, spdx.SHA3_384, spdx.SHA3_512,
+		spdx.BLAKE2b_256, spdx.BLAKE2b_384, spdx.BLAKE2b_512, spdx.BLAKE3,
+		spdx.ADLER32,
+	} {
+		spdxChecksumAlgorithms[strings.ToLower(string(algorithm))] = algorithm
+	}
+}
+
+// SPDX identifiers may only hold letters, numbers, "." and "-"
+var spdxIDInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9.-]`)
+
+func writeSPDX(w io.Writer, s *SBOM) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("failed to generate document namespace: %w", err)
+	}
+	name := s.Subject.DisplayName()
+	doc := &spdx.Document{
+		SPDXVersion:       spdx.Version,
+		DataLicense:       spdx.DataLicense,
+		SPDXIdentifier:    "DOCUMENT",
+		DocumentName:      name,
+		DocumentNamespace: fmt.Sprintf("https://guac.sh/spdx/%s-%s", spdxIDInvalidChars.ReplaceAllString(name, "-"), id),
+		CreationInfo: &spdx.CreationInfo{
+			Creators: []spdx.Creator{{Creator: "guac", CreatorType: "Tool"}},
+			Created:  s.Created.UTC().Format(time.RFC3339),
+		},
+	}
+	if len(s.Sources) > 0 {
+		var uris []string
+		for _, source := range s.Sources {
+			uris = append(uris, source.URI)
+		}
+		doc.DocumentComment = "Merged from: " + strings.Join(uris, ", ")
+	}
+
+	components := append([]*Component{s.Subject}, s.Components...)
+	for _, c := range components {
+		doc.Packages = append(doc.Packages, spdxPackage(c))
+	}
+
+	doc.Relationships = append(doc.Relationships, &spdx.Relationship{
+		RefA:         spdx_common.MakeDocElementID("", "DOCUMENT"),
+		RefB:         spdx_common.MakeDocElementID("", string(spdxID(s.Subject.ID))),
+		Relationship: spdx_common.TypeRelationshipDescribe,
+	})
+	for _, c := range components {
+		for _, dep := range c.DependsOn {
+			doc.Relationships = append(doc.Relationships, &spdx.Relationship{
+				RefA:         spdx_common.MakeDocElementID("", string(spdxID(c.ID))),
+				RefB:         spdx_common.MakeDocElementID("", string(spdxID(dep))),
+				Relationship: spdx_common.TypeRelationshipDependsOn,
+			})
+		}
+	}
+
+	return json.Write(doc, w)
+}
+
+func spdxPackage(c *Component) *spdx.Package {
+	p := &spdx.Package{
+		PackageName:             c.Name,
+		PackageSPDXIdentifier:   spdxID(c.ID),
+		PackageVersion:          c.Version,
+		PackageDownloadLocation: spdxNoAssertion,
+		// licenses and copyright are optional since SPDX 2.3, leaving them
+		// out keeps NOASSERTION licenses from being ingested back
+		PackageLicenseDeclared:  c.DeclaredLicense,
+		PackageLicenseConcluded: c.DiscoveredLicense,
+		PackageCopyrightText:    c.Attribution,
+	}
+	if c.Purl != "" {
+		p.PackageExternalReferences = []*spdx.PackageExternalReference{{
+			Category: spdx.CategoryPackageManager,
+			RefType:  spdx_common.TypePackageManagerPURL,
+			Locator:  c.Purl,
+		}}
+	}
+	for _, h := range c.Hashes {
+		if algorithm, ok := spdxChecksumAlgorithms[h.Algorithm]; ok {
+			p.PackageChecksums = append(p.PackageChecksums, spdx.Checksum{Algorithm: algorithm, Value: h.Digest})
+		}
+	}
+	// SPDX packages have no properties, metadata is kept as annotations
+	for _, prop := range c.Properties {
+		p.Annotations = append(p.Annotations, spdx.Annotation{
+			Annotator:         spdx.Annotator{Annotator: "guac", AnnotatorType: "Tool"},
+			AnnotationDate:    prop.Timestamp.UTC().Format(time.RFC3339),
+			AnnotationType:    "OTHER",
+			AnnotationComment: prop.Key + "=" + prop.Value,
+		})
+	}
+	return p
+}
+
+func spdxID(id string) spdx.ElementID {
+	return spdx.ElementID("Package-" + spdxIDInvalidChars.ReplaceAllString(id, "-"))
+}
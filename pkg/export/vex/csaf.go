@@ -0,0 +1,269 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vex
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/export/sbom"
+)
+
+// The CSAF document is written with its own types: the ones of go-vex only
+// cover what is needed to read a document and miss fields that a CSAF VEX
+// document requires.
+
+type csafDocument struct {
+	Document        csafMetadata        `json:"document"`
+	ProductTree     csafProductTree     `json:"product_tree"`
+	Vulnerabilities []csafVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type csafMetadata struct {
+	Category    string        `json:"category"`
+	CSAFVersion string        `json:"csaf_version"`
+	Publisher   csafPublisher `json:"publisher"`
+	Title       string        `json:"title"`
+	Tracking    csafTracking  `json:"tracking"`
+}
+
+type csafPublisher struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type csafTracking struct {
+	ID                 string         `json:"id"`
+	Status             string         `json:"status"`
+	Version            string         `json:"version"`
+	RevisionHistory    []csafRevision `json:"revision_history"`
+	InitialReleaseDate time.Time      `json:"initial_release_date"`
+	CurrentReleaseDate time.Time      `json:"current_release_date"`
+}
+
+type csafRevision struct {
+	Date    time.Time `json:"date"`
+	Number  string    `json:"number"`
+	Summary string    `json:"summary"`
+}
+
+type csafProductTree struct {
+	Branches      []csafBranch       `json:"branches"`
+	Relationships []csafRelationship `json:"relationships,omitempty"`
+}
+
+type csafBranch struct {
+	Category string      `json:"category"`
+	Name     string      `json:"name"`
+	Product  csafProduct `json:"product"`
+}
+
+type csafProduct struct {
+	Name                 string            `json:"name"`
+	ID                   string            `json:"product_id"`
+	IdentificationHelper map[string]string `json:"product_identification_helper,omitempty"`
+}
+
+type csafRelationship struct {
+	Category            string      `json:"category"`
+	FullProductName     csafProduct `json:"full_product_name"`
+	ProductRef          string      `json:"product_reference"`
+	RelatesToProductRef string      `json:"relates_to_product_reference"`
+}
+
+type csafVulnerability struct {
+	CVE           string              `json:"cve,omitempty"`
+	IDs           []csafID            `json:"ids,omitempty"`
+	ProductStatus map[string][]string `json:"product_status"`
+	Flags         []csafFlag          `json:"flags,omitempty"`
+	Threats       []csafThreat        `json:"threats,omitempty"`
+	Remediations  []csafRemediation   `json:"remediations,omitempty"`
+}
+
+type csafID struct {
+	SystemName string `json:"system_name"`
+	Text       string `json:"text"`
+}
+
+type csafFlag struct {
+	Label      string    `json:"label"`
+	Date       time.Time `json:"date"`
+	ProductIDs []string  `json:"product_ids"`
+}
+
+type csafThreat struct {
+	Category   string    `json:"category"`
+	Date       time.Time `json:"date"`
+	Details    string    `json:"details"`
+	ProductIDs []string  `json:"product_ids"`
+}
+
+type csafRemediation struct {
+	Category   string    `json:"category"`
+	Date       time.Time `json:"date"`
+	Details    string    `json:"details"`
+	ProductIDs []string  `json:"product_ids"`
+}
+
+var (
+	csafStatuses = map[model.VexStatus]string{
+		model.VexStatusNotAffected:        "known_not_affected",
+		model.VexStatusAffected:           "known_affected",
+		model.VexStatusFixed:              "fixed",
+		model.VexStatusUnderInvestigation: "under_investigation",
+	}
+
+	csafFlagLabels = map[model.VexJustification]string{
+		model.VexJustificationComponentNotPresent:                         "component_not_present",
+		model.VexJustificationVulnerableCodeNotPresent:                    "vulnerable_code_not_present",
+		model.VexJustificationVulnerableCodeNotInExecutePath:              "vulnerable_code_not_in_execute_path",
+		model.VexJustificationVulnerableCodeCannotBeControlledByAdversary: "vulnerable_code_cannot_be_controlled_by_adversary",
+		model.VexJustificationInlineMitigationsAlreadyExist:               "inline_mitigations_already_exist",
+	}
+)
+
+func writeCSAF(w io.Writer, d *Document) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("failed to generate CSAF tracking ID: %w", err)
+	}
+	subjectName := d.Subject.DisplayName()
+	doc := csafDocument{
+		Document: csafMetadata{
+			Category:    "csaf_vex",
+			CSAFVersion: "2.0",
+			Publisher: csafPublisher{
+				Category:  "other",
+				Name:      author,
+				Namespace: "https://guac.sh",
+			},
+			Title: "VEX of " + subjectName,
+			Tracking: csafTracking{
+				ID:      "guac-vex-" + id.String(),
+				Status:  "final",
+				Version: "1",
+				RevisionHistory: []csafRevision{{
+					Date:    d.Created,
+					Number:  "1",
+					Summary: "Generated from the GUAC graph",
+				}},
+				InitialReleaseDate: d.Created,
+				CurrentReleaseDate: d.Created,
+			},
+		},
+	}
+
+	// The subject is the product, the other components are related to it
+	// and referenced by their relationship.
+	productIDs := map[string]string{d.Subject.ID: subjectName}
+	doc.ProductTree.Branches = append(doc.ProductTree.Branches, csafProductBranch(d.Subject))
+	for _, c := range d.Components {
+		doc.ProductTree.Branches = append(doc.ProductTree.Branches, csafProductBranch(c))
+		productIDs[c.ID] = subjectName + ":" + c.DisplayName()
+		doc.ProductTree.Relationships = append(doc.ProductTree.Relationships, csafRelationship{
+			Category: "default_component_of",
+			FullProductName: csafProduct{
+				Name: c.DisplayName() + " as a component of " + subjectName,
+				ID:   productIDs[c.ID],
+			},
+			ProductRef:          c.DisplayName(),
+			RelatesToProductRef: subjectName,
+		})
+	}
+
+	vulns := map[string]*csafVulnerability{}
+	var vulnOrder []string
+	for _, s := range d.Statements {
+		status, ok := csafStatuses[s.Status]
+		if !ok {
+			return fmt.Errorf("unexpected VEX status %s for %s in %s", s.Status, s.Vulnerability, s.Product.DisplayName())
+		}
+		v, ok := vulns[s.Vulnerability]
+		if !ok {
+			v = newCSAFVulnerability(s.Vulnerability)
+			vulns[s.Vulnerability] = v
+			vulnOrder = append(vulnOrder, s.Vulnerability)
+		}
+		productID := productIDs[s.Product.ID]
+		v.ProductStatus[status] = append(v.ProductStatus[status], productID)
+
+		switch s.Status {
+		case model.VexStatusNotAffected:
+			if label, ok := csafFlagLabels[s.Justification]; ok {
+				v.Flags = append(v.Flags, csafFlag{Label: label, Date: s.Timestamp, ProductIDs: []string{productID}})
+			}
+			if s.Statement != "" {
+				v.Threats = append(v.Threats, csafThreat{Category: "impact", Date: s.Timestamp, Details: s.Statement, ProductIDs: []string{productID}})
+			}
+		case model.VexStatusAffected:
+			remediation := csafRemediation{Category: "none_available", Date: s.Timestamp, Details: s.Statement, ProductIDs: []string{productID}}
+			if s.Statement == "" {
+				remediation.Details = "No action statement provided"
+			} else {
+				remediation.Category = "mitigation"
+			}
+			v.Remediations = append(v.Remediations, remediation)
+		}
+	}
+	for _, name := range vulnOrder {
+		doc.Vulnerabilities = append(doc.Vulnerabilities, *vulns[name])
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode CSAF document: %w", err)
+	}
+	return nil
+}
+
+// csafProductBranch names a product after its purl, or its algorithm:digest
+// for an artifact, so that relationships can reference it.
+func csafProductBranch(c *sbom.Component) csafBranch {
+	name := c.DisplayName()
+	branch := csafBranch{
+		Category: "product_name",
+		Name:     name,
+		Product:  csafProduct{Name: name, ID: name},
+	}
+	if c.IsPackageVersion() {
+		branch.Category = "product_version"
+	}
+	if c.Purl != "" {
+		branch.Product.IdentificationHelper = map[string]string{"purl": c.Purl}
+	}
+	return branch
+}
+
+// newCSAFVulnerability identifies CVEs by the cve field and other
+// vulnerabilities by their tracking system.
+func newCSAFVulnerability(name string) *csafVulnerability {
+	v := &csafVulnerability{ProductStatus: map[string][]string{}}
+	system, _, _ := strings.Cut(name, "-")
+	if system == "CVE" {
+		v.CVE = name
+	} else {
+		v.IDs = []csafID{{SystemName: system, Text: name}}
+	}
+	return v
+}
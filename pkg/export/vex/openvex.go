@@ -0,0 +1,106 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vex
+
+import (
+	"fmt"
+	"io"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/export/sbom"
+	"github.com/openvex/go-vex/pkg/vex"
+)
+
+const author = "GUAC"
+
+var (
+	openVEXStatuses = map[model.VexStatus]vex.Status{
+		model.VexStatusNotAffected:        vex.StatusNotAffected,
+		model.VexStatusAffected:           vex.StatusAffected,
+		model.VexStatusFixed:              vex.StatusFixed,
+		model.VexStatusUnderInvestigation: vex.StatusUnderInvestigation,
+	}
+
+	openVEXJustifications = map[model.VexJustification]vex.Justification{
+		model.VexJustificationComponentNotPresent:                         vex.ComponentNotPresent,
+		model.VexJustificationVulnerableCodeNotPresent:                    vex.VulnerableCodeNotPresent,
+		model.VexJustificationVulnerableCodeNotInExecutePath:              vex.VulnerableCodeNotInExecutePath,
+		model.VexJustificationVulnerableCodeCannotBeControlledByAdversary: vex.VulnerableCodeCannotBeControlledByAdversary,
+		model.VexJustificationInlineMitigationsAlreadyExist:               vex.InlineMitigationsAlreadyExist,
+	}
+
+	openVEXAlgorithms = map[string]vex.Algorithm{
+		"md5":    vex.MD5,
+		"sha1":   vex.SHA1,
+		"sha256": vex.SHA256,
+		"sha384": vex.SHA384,
+		"sha512": vex.SHA512,
+	}
+)
+
+func writeOpenVEX(w io.Writer, d *Document) error {
+	doc := vex.New()
+	doc.Author = author
+	doc.Tooling = author
+	doc.Timestamp = &d.Created
+
+	for _, s := range d.Statements {
+		status, ok := openVEXStatuses[s.Status]
+		if !ok {
+			return fmt.Errorf("unexpected VEX status %s for %s in %s", s.Status, s.Vulnerability, s.Product.DisplayName())
+		}
+		timestamp := s.Timestamp
+		statement := vex.Statement{
+			Vulnerability: vex.Vulnerability{Name: vex.VulnerabilityID(s.Vulnerability)},
+			Timestamp:     &timestamp,
+			Products:      []vex.Product{{Component: openVEXComponent(s.Product)}},
+			Status:        status,
+			StatusNotes:   s.StatusNotes,
+			Justification: openVEXJustifications[s.Justification],
+		}
+		switch status {
+		case vex.StatusNotAffected:
+			statement.ImpactStatement = s.Statement
+		case vex.StatusAffected:
+			statement.ActionStatement = s.Statement
+			if statement.ActionStatement == "" {
+				statement.ActionStatement = vex.NoActionStatementMsg
+			}
+		}
+		doc.Statements = append(doc.Statements, statement)
+	}
+
+	if _, err := doc.GenerateCanonicalID(); err != nil {
+		return fmt.Errorf("failed to generate OpenVEX document ID: %w", err)
+	}
+	return doc.ToJSON(w)
+}
+
+// openVEXComponent identifies packages by purl and artifacts by their hash.
+func openVEXComponent(c *sbom.Component) vex.Component {
+	if !c.IsArtifact() {
+		return vex.Component{ID: c.Purl}
+	}
+	component := vex.Component{Hashes: map[vex.Algorithm]vex.Hash{}}
+	for _, h := range c.Hashes {
+		algorithm, ok := openVEXAlgorithms[h.Algorithm]
+		if !ok {
+			algorithm = vex.Algorithm(h.Algorithm)
+		}
+		component.Hashes[algorithm] = vex.Hash(h.Digest)
+	}
+	return component
+}
@@ -0,0 +1,256 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vex rebuilds VEX documents from the vulnerability certifications
+// and VEX statements in the graph and writes them as OpenVEX or CSAF
+// documents.
+package vex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/export/sbom"
+)
+
+type Format string
+
+const (
+	FormatOpenVEX Format = "openvex"
+	FormatCSAF    Format = "csaf"
+)
+
+const noVulnType = "novuln"
+
+// Document holds the status of every vulnerability known for a package or
+// artifact and for the software it contains.
+type Document struct {
+	// Subject is the package or artifact the document describes.
+	Subject *sbom.Component
+	// Components holds the software reachable from the subject, as found in
+	// its SBOM.
+	Components []*sbom.Component
+	// Statements are ordered by product, subject first, and then by
+	// vulnerability.
+	Statements []Statement
+	Created    time.Time
+}
+
+// Statement is the status of a vulnerability in the subject or in one of its
+// components.
+type Statement struct {
+	Product *sbom.Component
+	// Vulnerability is the vulnerability identifier as usually written, e.g.
+	// CVE-2023-1234 or GHSA-xxxx-xxxx-xxxx.
+	Vulnerability string
+	Status        model.VexStatus
+	Justification model.VexJustification
+	// Statement is the impact statement of not affected products and the
+	// action statement of the others.
+	Statement   string
+	StatusNotes string
+	Timestamp   time.Time
+}
+
+// Write writes the document to w in the given format.
+func (d *Document) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatOpenVEX:
+		return writeOpenVEX(w, d)
+	case FormatCSAF:
+		return writeCSAF(w, d)
+	default:
+		return fmt.Errorf("unsupported VEX format: %s", format)
+	}
+}
+
+// Build assembles the VEX document of the subject, given as a purl or as an
+// artifact in the algorithm:digest form.
+//
+// The products of the document are the subject and the package versions of
+// its SBOM, as rebuilt by sbom.Build. The latest CertifyVEXStatement of each
+// product and vulnerability gives its status. Vulnerabilities reported by a
+// CertifyVuln without any VEX statement, for themselves or for one of their
+// aliases, are under investigation.
+func Build(ctx context.Context, gqlClient graphql.Client, subject string) (*Document, error) {
+	s, err := sbom.Build(ctx, gqlClient, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Document{
+		Subject:    s.Subject,
+		Components: s.Components,
+		Created:    time.Now().UTC(),
+	}
+	for _, product := range append([]*sbom.Component{s.Subject}, s.Components...) {
+		statements, err := productStatements(ctx, gqlClient, product)
+		if err != nil {
+			return nil, err
+		}
+		d.Statements = append(d.Statements, statements...)
+	}
+	return d, nil
+}
+
+// productStatements returns the statements of a product ordered by
+// vulnerability.
+func productStatements(ctx context.Context, gqlClient graphql.Client, product *sbom.Component) ([]Statement, error) {
+	vexSubject := &model.PackageOrArtifactSpec{}
+	switch {
+	case product.IsArtifact():
+		vexSubject.Artifact = &model.ArtifactSpec{Id: &product.ID}
+	case product.IsPackageVersion():
+		vexSubject.Package = &model.PkgSpec{Id: &product.ID}
+	default:
+		// vulnerabilities are only certified for package versions
+		return nil, nil
+	}
+
+	statements := map[string]*Statement{}
+	vexs, err := clienthelpers.CertifyVEXStatements(ctx, gqlClient, model.CertifyVEXStatementSpec{Subject: vexSubject})
+	if err != nil {
+		return nil, fmt.Errorf("error querying for VEX statements of %s: %w", product.DisplayName(), err)
+	}
+	for _, v := range vexs {
+		name := vulnName(v.Vulnerability.AllVulnerabilityTree)
+		if s, ok := statements[name]; ok && !v.KnownSince.After(s.Timestamp) {
+			continue
+		}
+		statements[name] = &Statement{
+			Product:       product,
+			Vulnerability: name,
+			Status:        v.Status,
+			Justification: v.VexJustification,
+			Statement:     v.Statement,
+			StatusNotes:   v.StatusNotes,
+			Timestamp:     v.KnownSince,
+		}
+	}
+
+	if product.IsPackageVersion() {
+		vulns, err := clienthelpers.CertifyVulns(ctx, gqlClient, model.CertifyVulnSpec{Package: vexSubject.Package})
+		if err != nil {
+			return nil, fmt.Errorf("error querying for vulnerabilities of %s: %w", product.DisplayName(), err)
+		}
+		reported := map[string]*Statement{}
+		for _, v := range vulns {
+			if v.Vulnerability.Type == noVulnType {
+				continue
+			}
+			name := vulnName(v.Vulnerability.AllVulnerabilityTree)
+			if _, ok := statements[name]; ok {
+				continue
+			}
+			// the VEX statement may be about an alias of the reported
+			// vulnerability
+			aliases, err := vulnAliases(ctx, gqlClient, v.Vulnerability.AllVulnerabilityTree)
+			if err != nil {
+				return nil, err
+			}
+			if hasStatement(statements, aliases) {
+				continue
+			}
+			if s, ok := reported[name]; ok && !v.Metadata.TimeScanned.After(s.Timestamp) {
+				continue
+			}
+			reported[name] = &Statement{
+				Product:       product,
+				Vulnerability: name,
+				Status:        model.VexStatusUnderInvestigation,
+				Justification: model.VexJustificationNotProvided,
+				StatusNotes:   fmt.Sprintf("reported by %s without any VEX statement", v.Metadata.ScannerUri),
+				Timestamp:     v.Metadata.TimeScanned,
+			}
+		}
+		for name, s := range reported {
+			statements[name] = s
+		}
+	}
+
+	var result []Statement
+	for _, s := range statements {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Vulnerability < result[j].Vulnerability
+	})
+	return result, nil
+}
+
+// vulnAliases returns the names of the vulnerabilities linked to vuln by
+// VulnEqual, directly or through other aliases.
+func vulnAliases(ctx context.Context, gqlClient graphql.Client, vuln model.AllVulnerabilityTree) ([]string, error) {
+	var queue []string
+	seen := map[string]bool{}
+	for _, id := range vuln.VulnerabilityIDs {
+		queue = append(queue, id.Id)
+		seen[id.Id] = true
+	}
+	var names []string
+	for i := 0; i < len(queue); i++ {
+		neighbors, err := model.Neighbors(ctx, gqlClient, queue[i], []model.Edge{model.EdgeVulnerabilityVulnEqual})
+		if err != nil {
+			return nil, fmt.Errorf("error querying for aliases of %s: %w", vulnName(vuln), err)
+		}
+		for _, neighbor := range neighbors.Neighbors {
+			vulnEqual, ok := neighbor.(*model.NeighborsNeighborsVulnEqual)
+			if !ok {
+				continue
+			}
+			for _, alias := range vulnEqual.Vulnerabilities {
+				if alias.Type == noVulnType || len(alias.VulnerabilityIDs) == 0 || seen[alias.VulnerabilityIDs[0].Id] {
+					continue
+				}
+				seen[alias.VulnerabilityIDs[0].Id] = true
+				queue = append(queue, alias.VulnerabilityIDs[0].Id)
+				names = append(names, vulnName(alias.AllVulnerabilityTree))
+			}
+		}
+	}
+	return names, nil
+}
+
+// hasStatement reports whether there is a statement about any of the named
+// vulnerabilities.
+func hasStatement(statements map[string]*Statement, names []string) bool {
+	for _, name := range names {
+		if _, ok := statements[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// vulnName returns the identifier of a vulnerability with its upper case
+// prefix, as vulnerability IDs are stored in lower case.
+func vulnName(vuln model.AllVulnerabilityTree) string {
+	if len(vuln.VulnerabilityIDs) == 0 {
+		return vuln.Type
+	}
+	id := vuln.VulnerabilityIDs[0].VulnerabilityID
+	prefix, rest, ok := strings.Cut(id, "-")
+	if !ok {
+		return id
+	}
+	return strings.ToUpper(prefix) + "-" + rest
+}
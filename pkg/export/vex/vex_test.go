@@ -0,0 +1,343 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/gqlserver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/open_vex"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/openvex/go-vex/pkg/csaf"
+)
+
+var (
+	tm = time.Date(2023, 7, 17, 17, 45, 50, 0, time.UTC)
+
+	appPkg  = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "app", Version: ptrfrom.String("1.0.0")}
+	libPkg  = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "lib", Version: ptrfrom.String("2.0.0")}
+	leafPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "leaf", Version: ptrfrom.String("3.0.0")}
+	toolPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "tool", Version: ptrfrom.String("4.0.0")}
+	appArt  = model.ArtifactInputSpec{Algorithm: "sha256", Digest: "bbbb"}
+
+	libCVE  = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0001"}
+	libGHSA = model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-aaaa-bbbb-cccc"}
+	appCVE  = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0002"}
+	artCVE  = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0003"}
+	toolOSV = model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "go-2023-0004"}
+	toolCVE = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0004"}
+	noVuln  = model.VulnerabilityInputSpec{Type: "novuln", VulnerabilityID: ""}
+)
+
+// ingestTestGraph ingests app depending on lib depending on leaf, with app
+// built as an artifact. lib has two vulnerabilities, one of them with VEX
+// statements, leaf has none, and both app and its artifact have a VEX
+// statement without a vulnerability certification. Apart from them, tool has
+// a vulnerability with a VEX statement about its alias.
+func ingestTestGraph(ctx context.Context, t *testing.T, client graphql.Client) {
+	t.Helper()
+	for _, pkg := range []model.PkgInputSpec{appPkg, libPkg, leafPkg, toolPkg} {
+		if _, err := model.IngestPackage(ctx, client, pkg); err != nil {
+			t.Fatalf("error ingesting package: %v", err)
+		}
+	}
+	if _, err := model.IngestArtifact(ctx, client, appArt); err != nil {
+		t.Fatalf("error ingesting artifact: %v", err)
+	}
+	for _, vuln := range []model.VulnerabilityInputSpec{libCVE, libGHSA, appCVE, artCVE, toolOSV, toolCVE, noVuln} {
+		if _, err := model.IngestVulnerability(ctx, client, vuln); err != nil {
+			t.Fatalf("error ingesting vulnerability: %v", err)
+		}
+	}
+	for _, dep := range [][2]model.PkgInputSpec{{appPkg, libPkg}, {libPkg, leafPkg}} {
+		if _, err := model.IsDependency(ctx, client, dep[0], dep[1], model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Collector: "test"}); err != nil {
+			t.Fatalf("error ingesting dependency: %v", err)
+		}
+	}
+	if _, err := model.IsOccurrencePkg(ctx, client, appPkg, appArt, model.IsOccurrenceInputSpec{Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting occurrence: %v", err)
+	}
+
+	certifyVulns := []struct {
+		pkg  model.PkgInputSpec
+		vuln model.VulnerabilityInputSpec
+	}{
+		{libPkg, libCVE},
+		{libPkg, libGHSA},
+		{leafPkg, noVuln},
+		{toolPkg, toolOSV},
+	}
+	for _, cv := range certifyVulns {
+		if _, err := model.CertifyVulnPkg(ctx, client, cv.pkg, cv.vuln,
+			model.ScanMetadataInput{ScannerUri: "osv.dev", TimeScanned: tm, Collector: "test"}); err != nil {
+			t.Fatalf("error ingesting CertifyVuln: %v", err)
+		}
+	}
+
+	vexStatements := []struct {
+		pkg  model.PkgInputSpec
+		vuln model.VulnerabilityInputSpec
+		vex  model.VexStatementInputSpec
+	}{
+		{libPkg, libCVE, model.VexStatementInputSpec{
+			Status:           model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationComponentNotPresent,
+			KnownSince:       tm,
+			Collector:        "test",
+		}},
+		// supersedes the first statement
+		{libPkg, libCVE, model.VexStatementInputSpec{
+			Status:           model.VexStatusAffected,
+			VexJustification: model.VexJustificationNotProvided,
+			Statement:        "upgrade to 2.0.1",
+			KnownSince:       tm.Add(time.Hour),
+			Collector:        "test",
+		}},
+		{appPkg, appCVE, model.VexStatementInputSpec{
+			Status:           model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+			Statement:        "the vulnerable function is never called",
+			KnownSince:       tm,
+			Collector:        "test",
+		}},
+		{toolPkg, toolCVE, model.VexStatementInputSpec{
+			Status:           model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationVulnerableCodeNotPresent,
+			KnownSince:       tm,
+			Collector:        "test",
+		}},
+	}
+	for _, v := range vexStatements {
+		if _, err := model.CertifyVexPkg(ctx, client, v.pkg, v.vuln, v.vex); err != nil {
+			t.Fatalf("error ingesting VEX statement: %v", err)
+		}
+	}
+	if _, err := model.CertifyVexArtifact(ctx, client, appArt, artCVE, model.VexStatementInputSpec{
+		Status:           model.VexStatusFixed,
+		VexJustification: model.VexJustificationNotProvided,
+		KnownSince:       tm,
+		Collector:        "test",
+	}); err != nil {
+		t.Fatalf("error ingesting VEX statement: %v", err)
+	}
+	if _, err := model.IngestVulnEqual(ctx, client, toolOSV, toolCVE,
+		model.VulnEqualInputSpec{Justification: "alias", Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting VulnEqual: %v", err)
+	}
+}
+
+type wantStatement struct {
+	Product       string
+	Vulnerability string
+	Status        model.VexStatus
+	Justification model.VexJustification
+	Statement     string
+}
+
+func TestBuild(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := gqlserver.NewClient(t)
+	ingestTestGraph(ctx, t, client)
+
+	appStatement := wantStatement{
+		Product:       "pkg:golang/example.com/app@1.0.0",
+		Vulnerability: "CVE-2023-0002",
+		Status:        model.VexStatusNotAffected,
+		Justification: model.VexJustificationVulnerableCodeNotInExecutePath,
+		Statement:     "the vulnerable function is never called",
+	}
+	libStatements := []wantStatement{
+		{
+			Product:       "pkg:golang/example.com/lib@2.0.0",
+			Vulnerability: "CVE-2023-0001",
+			Status:        model.VexStatusAffected,
+			Justification: model.VexJustificationNotProvided,
+			Statement:     "upgrade to 2.0.1",
+		},
+		{
+			Product:       "pkg:golang/example.com/lib@2.0.0",
+			Vulnerability: "GHSA-aaaa-bbbb-cccc",
+			Status:        model.VexStatusUnderInvestigation,
+			Justification: model.VexJustificationNotProvided,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		subject string
+		want    []wantStatement
+		wantErr bool
+	}{
+		{
+			name:    "package",
+			subject: "pkg:golang/example.com/app@1.0.0",
+			want:    append([]wantStatement{appStatement}, libStatements...),
+		},
+		{
+			name:    "artifact",
+			subject: "sha256:bbbb",
+			want: append([]wantStatement{
+				{
+					Product:       "sha256:bbbb",
+					Vulnerability: "CVE-2023-0003",
+					Status:        model.VexStatusFixed,
+					Justification: model.VexJustificationNotProvided,
+				},
+				appStatement,
+			}, libStatements...),
+		},
+		{
+			// the statement about the alias covers the reported vulnerability
+			name:    "statement about an alias",
+			subject: "pkg:golang/example.com/tool@4.0.0",
+			want: []wantStatement{
+				{
+					Product:       "pkg:golang/example.com/tool@4.0.0",
+					Vulnerability: "CVE-2023-0004",
+					Status:        model.VexStatusNotAffected,
+					Justification: model.VexJustificationVulnerableCodeNotPresent,
+				},
+			},
+		},
+		{
+			name:    "no vulnerabilities",
+			subject: "pkg:golang/example.com/leaf@3.0.0",
+		},
+		{
+			name:    "unknown subject",
+			subject: "pkg:golang/example.com/unknown@1.0.0",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Build(ctx, client, tt.subject)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			var gotStatements []wantStatement
+			for _, s := range got.Statements {
+				gotStatements = append(gotStatements, wantStatement{
+					Product:       s.Product.DisplayName(),
+					Vulnerability: s.Vulnerability,
+					Status:        s.Status,
+					Justification: s.Justification,
+					Statement:     s.Statement,
+				})
+			}
+			if diff := cmp.Diff(tt.want, gotStatements); diff != "" {
+				t.Errorf("unexpected statements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := gqlserver.NewClient(t)
+	ingestTestGraph(ctx, t, client)
+
+	d, err := Build(ctx, client, "pkg:golang/example.com/app@1.0.0")
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	t.Run("openvex", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := d.Write(&buf, FormatOpenVEX); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		// the exported document can be ingested again
+		p := open_vex.NewOpenVEXParser()
+		doc := &processor.Document{
+			Blob:   buf.Bytes(),
+			Type:   processor.DocumentOpenVEX,
+			Format: processor.FormatJSON,
+		}
+		if err := p.Parse(ctx, doc); err != nil {
+			t.Fatalf("exported OpenVEX does not parse: %v", err)
+		}
+		preds := p.GetPredicates(ctx)
+		if len(preds.Vex) != 3 {
+			t.Fatalf("got %d VEX statements from exported OpenVEX, want 3", len(preds.Vex))
+		}
+		for _, v := range preds.Vex {
+			if v.Pkg.Name != "app" {
+				continue
+			}
+			if v.VexData.VexJustification != model.VexJustificationVulnerableCodeNotInExecutePath ||
+				v.VexData.Statement != "the vulnerable function is never called" {
+				t.Errorf("unexpected app statement: %+v", v.VexData)
+			}
+		}
+		// affected and under investigation statements are vulnerabilities
+		if len(preds.CertifyVuln) != 2 {
+			t.Errorf("got %d vulnerabilities from exported OpenVEX, want 2", len(preds.CertifyVuln))
+		}
+	})
+
+	t.Run("csaf", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := d.Write(&buf, FormatCSAF); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		var doc csaf.CSAF
+		if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+			t.Fatalf("exported CSAF does not decode: %v", err)
+		}
+		if p := doc.ProductTree.FindProductIdentifier("purl", "pkg:golang/example.com/lib@2.0.0"); p == nil {
+			t.Errorf("lib is missing from the product tree: %+v", doc.ProductTree)
+		}
+		if len(doc.ProductTree.Relationships) != 2 {
+			t.Errorf("got %d relationships, want 2", len(doc.ProductTree.Relationships))
+		}
+		gotStatus := map[string]map[string][]string{}
+		for _, v := range doc.Vulnerabilities {
+			name := v.CVE
+			if name == "" {
+				name = v.IDs[0].Text
+			}
+			gotStatus[name] = v.ProductStatus
+		}
+		wantStatus := map[string]map[string][]string{
+			"CVE-2023-0001":       {"known_affected": {"pkg:golang/example.com/app@1.0.0:pkg:golang/example.com/lib@2.0.0"}},
+			"CVE-2023-0002":       {"known_not_affected": {"pkg:golang/example.com/app@1.0.0"}},
+			"GHSA-aaaa-bbbb-cccc": {"under_investigation": {"pkg:golang/example.com/app@1.0.0:pkg:golang/example.com/lib@2.0.0"}},
+		}
+		if diff := cmp.Diff(wantStatus, gotStatus); diff != "" {
+			t.Errorf("unexpected product status (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		if err := d.Write(&bytes.Buffer{}, Format("cyclonedx")); err == nil {
+			t.Errorf("expected an error for an unsupported format")
+		}
+	})
+}
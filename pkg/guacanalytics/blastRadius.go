@@ -0,0 +1,487 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/misc/depversion"
+)
+
+const noVulnType = "novuln"
+
+// AffectedArtifact is a top-level artifact in the blast radius of a
+// vulnerability: an occurrence of an affected package that no other affected
+// package depends on.
+type AffectedArtifact struct {
+	Artifact model.AllArtifactTree
+	// Path holds the IDs of the nodes and of the evidence linking the
+	// vulnerability to the artifact, starting with the vulnerability ID.
+	Path []string
+}
+
+// AffectedPackage is a top-level package version in the blast radius of a
+// vulnerability: an affected package that no other affected package depends
+// on and that has no affected artifact, such as a package only known from
+// the dependencies of an SBOM.
+type AffectedPackage struct {
+	Package model.AllPkgTree
+	// Path holds the IDs of the nodes and of the evidence linking the
+	// vulnerability to the package, starting with the vulnerability ID.
+	Path []string
+}
+
+type blastNode struct {
+	parent string // node the search came from, the vulnerability for the vulnerable packages
+	edge   string // evidence linking the node to its parent
+	depth  int
+
+	// set for package versions
+	isPackage     bool
+	pkg           *model.AllPkgTree
+	nameID        string
+	version       string
+	hasDependents bool // true if an affected package depends on this one
+	hasArtifacts  bool // true if an affected artifact is an occurrence of this one
+
+	// set for artifacts
+	artifact    *model.AllArtifactTree
+	occurrences []string // affected packages the artifact is an occurrence of
+}
+
+// aliasStep links an alias of the vulnerability to the vulnerability ID it was
+// found from.
+type aliasStep struct {
+	parent string
+	edge   string // VulnEqual linking the alias to its parent
+}
+
+type blastRadius struct {
+	gqlClient graphql.Client
+	maxDepth  int
+	nodes     map[string]*blastNode
+	aliases   map[string]aliasStep
+	excluded  map[string]bool // subjects of NOT_AFFECTED and FIXED VEX statements
+	queue     []string
+}
+
+// SearchBlastRadiusFromVuln finds the top-level artifacts and packages
+// affected by the vulnerability with the given ID, such as a CVE or GHSA ID.
+//
+// The search starts from the packages linked to the vulnerability by a
+// CertifyVuln and expands to their dependents through IsDependency, to their
+// artifacts through IsOccurrence and to equivalent packages and artifacts
+// through PkgEqual and HashEqual. Aliases of the vulnerability, linked to it by
+// VulnEqual, are searched from as well. Packages and artifacts that are the subject
+// of a CertifyVEXStatement with status NOT_AFFECTED or FIXED for the
+// vulnerability are left out. Nodes at maxDepth hops from the vulnerable
+// packages are not expanded, a maxDepth of 0 has no limit.
+func SearchBlastRadiusFromVuln(ctx context.Context, gqlClient graphql.Client, vulnID string, maxDepth int) ([]AffectedArtifact, []AffectedPackage, error) {
+	vulns, err := clienthelpers.Vulnerabilities(ctx, gqlClient, model.VulnerabilitySpec{VulnerabilityID: &vulnID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed getting vulnerability %s: %w", vulnID, err)
+	}
+	var vulnIDs []string
+	for _, vuln := range vulns {
+		if vuln.Type == noVulnType {
+			continue
+		}
+		for _, id := range vuln.VulnerabilityIDs {
+			vulnIDs = append(vulnIDs, id.Id)
+		}
+	}
+	if len(vulnIDs) == 0 {
+		return nil, nil, fmt.Errorf("vulnerability %s not found", vulnID)
+	}
+
+	b := &blastRadius{
+		gqlClient: gqlClient,
+		maxDepth:  maxDepth,
+		nodes:     map[string]*blastNode{},
+		aliases:   map[string]aliasStep{},
+		excluded:  map[string]bool{},
+	}
+	vulnIDs, err = b.addAliases(ctx, vulnIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	// exclusions must be known before the search visits any package
+	for _, id := range vulnIDs {
+		if err := b.addVEXExclusions(ctx, id); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, id := range vulnIDs {
+		neighborsResponse, err := model.Neighbors(ctx, gqlClient, id, []model.Edge{model.EdgeVulnerabilityCertifyVuln})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed getting neighbors:%w", err)
+		}
+		for _, neighbor := range neighborsResponse.Neighbors {
+			if certifyVuln, ok := neighbor.(*model.NeighborsNeighborsCertifyVuln); ok {
+				if _, err := b.visitPackage(certifyVuln.Package.AllPkgTree, id, certifyVuln.Id); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+
+	if err := b.search(ctx); err != nil {
+		return nil, nil, err
+	}
+	return b.topLevelArtifacts(), b.topLevelPackages(), nil
+}
+
+// addAliases returns the vulnerability IDs along with all the IDs linked to
+// them, directly or through other aliases, by VulnEqual.
+func (b *blastRadius) addAliases(ctx context.Context, vulnIDs []string) ([]string, error) {
+	seen := map[string]bool{}
+	for _, id := range vulnIDs {
+		seen[id] = true
+	}
+	for i := 0; i < len(vulnIDs); i++ {
+		now := vulnIDs[i]
+		neighborsResponse, err := model.Neighbors(ctx, b.gqlClient, now, []model.Edge{model.EdgeVulnerabilityVulnEqual})
+		if err != nil {
+			return nil, fmt.Errorf("failed getting neighbors:%w", err)
+		}
+		for _, neighbor := range neighborsResponse.Neighbors {
+			vulnEqual, ok := neighbor.(*model.NeighborsNeighborsVulnEqual)
+			if !ok {
+				continue
+			}
+			for _, vuln := range vulnEqual.Vulnerabilities {
+				if vuln.Type == noVulnType {
+					continue
+				}
+				for _, id := range vuln.VulnerabilityIDs {
+					if seen[id.Id] {
+						continue
+					}
+					seen[id.Id] = true
+					b.aliases[id.Id] = aliasStep{parent: now, edge: vulnEqual.Id}
+					vulnIDs = append(vulnIDs, id.Id)
+				}
+			}
+		}
+	}
+	return vulnIDs, nil
+}
+
+func (b *blastRadius) addVEXExclusions(ctx context.Context, vulnID string) error {
+	neighborsResponse, err := model.Neighbors(ctx, b.gqlClient, vulnID, []model.Edge{model.EdgeVulnerabilityCertifyVexStatement})
+	if err != nil {
+		return fmt.Errorf("failed getting neighbors:%w", err)
+	}
+	for _, neighbor := range neighborsResponse.Neighbors {
+		vex, ok := neighbor.(*model.NeighborsNeighborsCertifyVEXStatement)
+		if !ok || (vex.Status != model.VexStatusNotAffected && vex.Status != model.VexStatusFixed) {
+			continue
+		}
+		switch subject := vex.Subject.(type) {
+		case *model.AllCertifyVEXStatementSubjectPackage:
+			if id, ok := pkgVersionID(subject.AllPkgTree); ok {
+				b.excluded[id] = true
+			}
+		case *model.AllCertifyVEXStatementSubjectArtifact:
+			b.excluded[subject.Id] = true
+		}
+	}
+	return nil
+}
+
+// search performs a breadth-first search from the queued nodes
+func (b *blastRadius) search(ctx context.Context) error {
+	for len(b.queue) > 0 {
+		now := b.queue[0]
+		b.queue = b.queue[1:]
+		nowNode := b.nodes[now]
+
+		if b.maxDepth != 0 && nowNode.depth >= b.maxDepth {
+			continue
+		}
+
+		var err error
+		if nowNode.isPackage {
+			err = b.expandPackage(ctx, now, nowNode)
+		} else {
+			err = b.expandArtifact(ctx, now)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *blastRadius) expandPackage(ctx context.Context, now string, nowNode *blastNode) error {
+	neighborsResponse, err := model.Neighbors(ctx, b.gqlClient, now, []model.Edge{model.EdgePackageIsDependency, model.EdgePackageIsOccurrence, model.EdgePackagePkgEqual})
+	if err != nil {
+		return fmt.Errorf("failed getting neighbors:%w", err)
+	}
+	for _, neighbor := range neighborsResponse.Neighbors {
+		switch neighbor := neighbor.(type) {
+		case *model.NeighborsNeighborsIsDependency:
+			// only follow the dependency to its dependent
+			if depID, ok := pkgVersionID(neighbor.DependencyPackage.AllPkgTree); !ok || depID != now {
+				continue
+			}
+			visited, err := b.visitPackage(neighbor.Package.AllPkgTree, now, neighbor.Id)
+			if err != nil {
+				return err
+			}
+			if visited {
+				nowNode.hasDependents = true
+			}
+		case *model.NeighborsNeighborsIsOccurrence:
+			if b.visitArtifact(neighbor.Artifact.AllArtifactTree, now, neighbor.Id) {
+				b.nodes[neighbor.Artifact.Id].addOccurrence(now)
+				nowNode.hasArtifacts = true
+			}
+		case *model.NeighborsNeighborsPkgEqual:
+			for _, pkg := range neighbor.Packages {
+				if id, ok := pkgVersionID(pkg.AllPkgTree); ok && id != now {
+					if _, err := b.visitPackage(pkg.AllPkgTree, now, neighbor.Id); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	// dependencies on the package name apply to the versions in their range
+	neighborsResponse, err = model.Neighbors(ctx, b.gqlClient, nowNode.nameID, []model.Edge{model.EdgePackageIsDependency})
+	if err != nil {
+		return fmt.Errorf("failed getting neighbors:%w", err)
+	}
+	for _, neighbor := range neighborsResponse.Neighbors {
+		isDependency, ok := neighbor.(*model.NeighborsNeighborsIsDependency)
+		if !ok {
+			continue
+		}
+		depNameID, err := pkgNameID(isDependency.DependencyPackage.AllPkgTree)
+		if err != nil {
+			return fmt.Errorf("dependency of IsDependency %s: %w", isDependency.Id, err)
+		}
+		if _, isVersion := pkgVersionID(isDependency.DependencyPackage.AllPkgTree); depNameID != nowNode.nameID || isVersion {
+			continue
+		}
+		// TODO(jeffmendoza): depversion is not handling all possible version
+		// ranges, skip the dependencies it cannot match.
+		doesRangeInclude, err := depversion.DoesRangeInclude([]string{nowNode.version}, isDependency.VersionRange)
+		if err != nil || !doesRangeInclude {
+			continue
+		}
+		visited, err := b.visitPackage(isDependency.Package.AllPkgTree, now, isDependency.Id)
+		if err != nil {
+			return err
+		}
+		if visited {
+			nowNode.hasDependents = true
+		}
+	}
+	return nil
+}
+
+func (b *blastRadius) expandArtifact(ctx context.Context, now string) error {
+	neighborsResponse, err := model.Neighbors(ctx, b.gqlClient, now, []model.Edge{model.EdgeArtifactIsOccurrence, model.EdgeArtifactHashEqual})
+	if err != nil {
+		return fmt.Errorf("failed getting neighbors:%w", err)
+	}
+	for _, neighbor := range neighborsResponse.Neighbors {
+		switch neighbor := neighbor.(type) {
+		case *model.NeighborsNeighborsIsOccurrence:
+			subject, ok := neighbor.Subject.(*model.AllIsOccurrencesTreeSubjectPackage)
+			if !ok {
+				continue
+			}
+			visited, err := b.visitPackage(subject.AllPkgTree, now, neighbor.Id)
+			if err != nil {
+				return err
+			}
+			if visited {
+				id, _ := pkgVersionID(subject.AllPkgTree)
+				b.nodes[now].addOccurrence(id)
+				b.nodes[id].hasArtifacts = true
+			}
+		case *model.NeighborsNeighborsHashEqual:
+			for _, artifact := range neighbor.Artifacts {
+				if artifact.Id != now {
+					b.visitArtifact(artifact.AllArtifactTree, now, neighbor.Id)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// visitPackage adds a package version to the blast radius unless it is
+// excluded, and reports whether it is in the blast radius. Trees without a
+// package version are not.
+func (b *blastRadius) visitPackage(pkg model.AllPkgTree, parent, edge string) (bool, error) {
+	nameID, err := pkgNameID(pkg)
+	if err != nil {
+		return false, err
+	}
+	id, ok := pkgVersionID(pkg)
+	if !ok {
+		return false, nil
+	}
+	return b.visit(id, parent, edge, &blastNode{
+		isPackage: true,
+		pkg:       &pkg,
+		nameID:    nameID,
+		version:   pkg.Namespaces[0].Names[0].Versions[0].Version,
+	}), nil
+}
+
+// visitArtifact adds an artifact to the blast radius unless it is excluded,
+// and reports whether it is in the blast radius.
+func (b *blastRadius) visitArtifact(artifact model.AllArtifactTree, parent, edge string) bool {
+	return b.visit(artifact.Id, parent, edge, &blastNode{artifact: &artifact})
+}
+
+func (b *blastRadius) visit(id, parent, edge string, n *blastNode) bool {
+	if b.excluded[id] {
+		return false
+	}
+	if _, seen := b.nodes[id]; seen {
+		return true
+	}
+	n.parent = parent
+	n.edge = edge
+	if parentNode, ok := b.nodes[parent]; ok {
+		n.depth = parentNode.depth + 1
+	}
+	b.nodes[id] = n
+	b.queue = append(b.queue, id)
+	return true
+}
+
+func (n *blastNode) addOccurrence(pkgID string) {
+	for _, id := range n.occurrences {
+		if id == pkgID {
+			return
+		}
+	}
+	n.occurrences = append(n.occurrences, pkgID)
+}
+
+// topLevelArtifacts returns the artifacts of the blast radius whose packages
+// have no affected dependents, ordered by digest.
+func (b *blastRadius) topLevelArtifacts() []AffectedArtifact {
+	var affected []AffectedArtifact
+	for id, n := range b.nodes {
+		if n.artifact == nil {
+			continue
+		}
+		topLevel := true
+		for _, pkgID := range n.occurrences {
+			if b.nodes[pkgID].hasDependents {
+				topLevel = false
+				break
+			}
+		}
+		if topLevel {
+			affected = append(affected, AffectedArtifact{
+				Artifact: *n.artifact,
+				Path:     b.path(id),
+			})
+		}
+	}
+	sort.Slice(affected, func(i, j int) bool {
+		if affected[i].Artifact.Algorithm != affected[j].Artifact.Algorithm {
+			return affected[i].Artifact.Algorithm < affected[j].Artifact.Algorithm
+		}
+		return affected[i].Artifact.Digest < affected[j].Artifact.Digest
+	})
+	return affected
+}
+
+// topLevelPackages returns the package versions of the blast radius that have
+// no affected dependents and no affected artifacts, ordered by type,
+// namespace, name and version.
+func (b *blastRadius) topLevelPackages() []AffectedPackage {
+	var affected []AffectedPackage
+	for id, n := range b.nodes {
+		if !n.isPackage || n.hasDependents || n.hasArtifacts {
+			continue
+		}
+		affected = append(affected, AffectedPackage{
+			Package: *n.pkg,
+			Path:    b.path(id),
+		})
+	}
+	sort.Slice(affected, func(i, j int) bool {
+		return pkgSortKey(affected[i].Package) < pkgSortKey(affected[j].Package)
+	})
+	return affected
+}
+
+// pkgSortKey orders the trees of package versions, which visitPackage checked
+// to hold a version.
+func pkgSortKey(pkg model.AllPkgTree) string {
+	ns := pkg.Namespaces[0]
+	return strings.Join([]string{pkg.Type, ns.Namespace, ns.Names[0].Name, ns.Names[0].Versions[0].Version}, "\x00")
+}
+
+// path returns the IDs from the vulnerability to the node with the given ID
+func (b *blastRadius) path(id string) []string {
+	var reversed []string
+	for {
+		n, ok := b.nodes[id]
+		if !ok {
+			if alias, ok := b.aliases[id]; ok {
+				reversed = append(reversed, id, alias.edge)
+				id = alias.parent
+				continue
+			}
+			// the vulnerability
+			reversed = append(reversed, id)
+			break
+		}
+		reversed = append(reversed, id, n.edge)
+		id = n.parent
+	}
+	path := make([]string, len(reversed))
+	for i, id := range reversed {
+		path[len(reversed)-1-i] = id
+	}
+	return path
+}
+
+// pkgNameID returns the ID of the package name in the tree, which every tree
+// of a package version or name holds.
+func pkgNameID(pkg model.AllPkgTree) (string, error) {
+	if len(pkg.Namespaces) == 0 || len(pkg.Namespaces[0].Names) == 0 {
+		return "", fmt.Errorf("package %s has no name in its tree", pkg.Id)
+	}
+	return pkg.Namespaces[0].Names[0].Id, nil
+}
+
+// pkgVersionID returns the ID of the package version in the tree, if the tree
+// holds one.
+func pkgVersionID(pkg model.AllPkgTree) (string, bool) {
+	if len(pkg.Namespaces) == 0 || len(pkg.Namespaces[0].Names) == 0 || len(pkg.Namespaces[0].Names[0].Versions) == 0 {
+		return "", false
+	}
+	return pkg.Namespaces[0].Names[0].Versions[0].Id, true
+}
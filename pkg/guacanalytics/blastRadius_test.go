@@ -0,0 +1,286 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var (
+	blastVuln  = &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-blast-radius"}
+	blastAlias = &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0001"}
+
+	blastLib = &model.PkgInputSpec{
+		Type:    "golang",
+		Name:    "lib",
+		Version: ptrfrom.String("1.0.0"),
+	}
+	blastApp = &model.PkgInputSpec{
+		Type:    "golang",
+		Name:    "app",
+		Version: ptrfrom.String("2.0.0"),
+	}
+	blastService = &model.PkgInputSpec{
+		Type:    "golang",
+		Name:    "service",
+		Version: ptrfrom.String("3.0.0"),
+	}
+	blastPatchedLib = &model.PkgInputSpec{
+		Type:    "golang",
+		Name:    "patched-lib",
+		Version: ptrfrom.String("1.0.0"),
+	}
+
+	blastLibArtifact        = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "1111"}
+	blastAppArtifact        = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "2222"}
+	blastAppImage           = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "3333"}
+	blastPatchedLibArtifact = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "4444"}
+
+	// lib and patched-lib are vulnerable, but patched-lib is not affected
+	// according to its VEX statement. app depends on lib, so the lib artifact
+	// is not top-level. The app image is the same as the app artifact. service
+	// depends on lib too, but has no artifact. The vulnerability is known under
+	// an alias as well.
+	blastRadiusGraph = assembler.IngestPredicates{
+		CertifyVuln: []assembler.CertifyVulnIngest{
+			{
+				Pkg:           blastLib,
+				Vulnerability: blastVuln,
+				VulnData:      &model.ScanMetadataInput{TimeScanned: tm, Collector: "testCollector"},
+			},
+			{
+				Pkg:           blastPatchedLib,
+				Vulnerability: blastVuln,
+				VulnData:      &model.ScanMetadataInput{TimeScanned: tm, Collector: "testCollector"},
+			},
+		},
+		Vex: []assembler.VexIngest{
+			{
+				Pkg:           blastPatchedLib,
+				Vulnerability: blastVuln,
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusNotAffected,
+					VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+					KnownSince:       tm,
+					Collector:        "testCollector",
+				},
+			},
+		},
+		IsDependency: []assembler.IsDependencyIngest{
+			{
+				Pkg:             blastApp,
+				DepPkg:          blastLib,
+				DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType: model.DependencyTypeDirect,
+					Collector:      "testCollector",
+				},
+			},
+			{
+				Pkg:             blastService,
+				DepPkg:          blastLib,
+				DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType: model.DependencyTypeDirect,
+					Collector:      "testCollector",
+				},
+			},
+		},
+		IsOccurrence: []assembler.IsOccurrenceIngest{
+			{
+				Pkg:          blastLib,
+				Artifact:     blastLibArtifact,
+				IsOccurrence: &model.IsOccurrenceInputSpec{Collector: "testCollector"},
+			},
+			{
+				Pkg:          blastApp,
+				Artifact:     blastAppArtifact,
+				IsOccurrence: &model.IsOccurrenceInputSpec{Collector: "testCollector"},
+			},
+			{
+				Pkg:          blastPatchedLib,
+				Artifact:     blastPatchedLibArtifact,
+				IsOccurrence: &model.IsOccurrenceInputSpec{Collector: "testCollector"},
+			},
+		},
+		HashEqual: []assembler.HashEqualIngest{
+			{
+				Artifact:      blastAppArtifact,
+				EqualArtifact: blastAppImage,
+				HashEqual:     &model.HashEqualInputSpec{Collector: "testCollector"},
+			},
+		},
+		VulnEqual: []assembler.VulnEqualIngest{
+			{
+				Vulnerability:      blastAlias,
+				EqualVulnerability: blastVuln,
+				VulnEqual:          &model.VulnEqualInputSpec{Collector: "testCollector"},
+			},
+		},
+	}
+)
+
+func Test_SearchBlastRadiusFromVuln(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	srv, err := getGraphqlTestServer()
+	if err != nil {
+		t.Fatalf("unable to initialize graphql server: %v", err)
+	}
+	server := httptest.NewServer(srv)
+	defer server.Close()
+	gqlClient := graphql.NewClient(server.URL, http.DefaultClient)
+
+	if err := ingestBlastRadiusData(ctx, gqlClient, blastRadiusGraph); err != nil {
+		t.Fatalf("error ingesting test data: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		vulnID      string
+		maxDepth    int
+		wantDigests []string
+		// number of IDs in the path of each artifact
+		wantPathLens []int
+		wantPkgNames []string
+		// number of IDs in the path of each package
+		wantPkgPathLens []int
+		wantErr         bool
+	}{
+		{
+			name:   "dependents and equal artifacts",
+			vulnID: blastVuln.VulnerabilityID,
+			// vuln, certifyVuln, lib, isDependency, app, isOccurrence, artifact
+			// and then hashEqual, image
+			wantDigests:  []string{"2222", "3333"},
+			wantPathLens: []int{7, 9},
+			// vuln, certifyVuln, lib, isDependency, service
+			wantPkgNames:    []string{"service"},
+			wantPkgPathLens: []int{5},
+		},
+		{
+			name:   "alias of the vulnerability",
+			vulnID: blastAlias.VulnerabilityID,
+			// alias and vulnEqual, then as above
+			wantDigests:     []string{"2222", "3333"},
+			wantPathLens:    []int{9, 11},
+			wantPkgNames:    []string{"service"},
+			wantPkgPathLens: []int{7},
+		},
+		{
+			name:         "depth limited",
+			vulnID:       blastVuln.VulnerabilityID,
+			maxDepth:     1,
+			wantDigests:  nil,
+			wantPathLens: nil,
+			// the artifacts of the dependents are not searched
+			wantPkgNames:    []string{"app", "service"},
+			wantPkgPathLens: []int{5, 5},
+		},
+		{
+			name:    "unknown vulnerability",
+			vulnID:  "ghsa-unknown",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotPkgs, err := SearchBlastRadiusFromVuln(ctx, gqlClient, tt.vulnID, tt.maxDepth)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SearchBlastRadiusFromVuln() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var gotDigests []string
+			var gotPathLens []int
+			for _, a := range got {
+				gotDigests = append(gotDigests, a.Artifact.Digest)
+				gotPathLens = append(gotPathLens, len(a.Path))
+				if a.Path[len(a.Path)-1] != a.Artifact.Id {
+					t.Errorf("path of %s does not end with the artifact: %v", a.Artifact.Digest, a.Path)
+				}
+			}
+			if diff := cmp.Diff(tt.wantDigests, gotDigests); diff != "" {
+				t.Errorf("unexpected artifacts (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPathLens, gotPathLens); diff != "" {
+				t.Errorf("unexpected path lengths (-want +got):\n%s", diff)
+			}
+			var gotPkgNames []string
+			var gotPkgPathLens []int
+			for _, p := range gotPkgs {
+				name := p.Package.Namespaces[0].Names[0]
+				gotPkgNames = append(gotPkgNames, name.Name)
+				gotPkgPathLens = append(gotPkgPathLens, len(p.Path))
+				if p.Path[len(p.Path)-1] != name.Versions[0].Id {
+					t.Errorf("path of %s does not end with the package version: %v", name.Name, p.Path)
+				}
+			}
+			if diff := cmp.Diff(tt.wantPkgNames, gotPkgNames); diff != "" {
+				t.Errorf("unexpected packages (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPkgPathLens, gotPkgPathLens); diff != "" {
+				t.Errorf("unexpected package path lengths (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func ingestBlastRadiusData(ctx context.Context, client graphql.Client, graph assembler.IngestPredicates) error {
+	if err := ingestIsDependency(ctx, client, graph); err != nil {
+		return err
+	}
+	if err := ingestIsOccurrence(ctx, client, graph); err != nil {
+		return err
+	}
+	if err := ingestHashEqual(ctx, client, graph); err != nil {
+		return err
+	}
+	for _, ingest := range graph.CertifyVuln {
+		if _, err := model.IngestPackage(ctx, client, *ingest.Pkg); err != nil {
+			return fmt.Errorf("error in ingesting Package for CertifyVuln: %v\n", err)
+		}
+		if _, err := model.IngestVulnerability(ctx, client, *ingest.Vulnerability); err != nil {
+			return fmt.Errorf("error in ingesting Vulnerability for CertifyVuln: %v\n", err)
+		}
+		if _, err := model.CertifyVulnPkg(ctx, client, *ingest.Pkg, *ingest.Vulnerability, *ingest.VulnData); err != nil {
+			return fmt.Errorf("error in ingesting CertifyVuln: %v\n", err)
+		}
+	}
+	for _, ingest := range graph.VulnEqual {
+		if _, err := model.IngestVulnerability(ctx, client, *ingest.Vulnerability); err != nil {
+			return fmt.Errorf("error in ingesting Vulnerability for VulnEqual: %v\n", err)
+		}
+		if _, err := model.IngestVulnEqual(ctx, client, *ingest.Vulnerability, *ingest.EqualVulnerability, *ingest.VulnEqual); err != nil {
+			return fmt.Errorf("error in ingesting VulnEqual: %v\n", err)
+		}
+	}
+	for _, ingest := range graph.Vex {
+		if _, err := model.CertifyVexPkg(ctx, client, *ingest.Pkg, *ingest.Vulnerability, *ingest.VexData); err != nil {
+			return fmt.Errorf("error in ingesting VEX: %v\n", err)
+		}
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/misc/depversion"
 )
 
@@ -411,13 +412,13 @@ func explorePointOfContact(ctx context.Context, gqlClient graphql.Client, q *que
 			Name:      &poc.Namespaces[0].Names[0].Name,
 		}
 
-		pkgResponse, err := model.Packages(ctx, gqlClient, pkgFilter)
+		pkgs, err := clienthelpers.Packages(ctx, gqlClient, pkgFilter)
 
 		if err != nil {
 			return fmt.Errorf("error finding inputted node %s", err)
 		}
 
-		for _, versionEntry := range pkgResponse.Packages[0].Namespaces[0].Names[0].Versions {
+		for _, versionEntry := range pkgs[0].Namespaces[0].Names[0].Versions {
 			if node, seen := q.nodeMap[versionEntry.Id]; seen {
 				nodeParents := node.Parents
 
@@ -482,14 +483,14 @@ func (q *queueValues) addNodesToQueueFromPackageName(ctx context.Context, gqlCli
 		Name:      &pkgName,
 	}
 
-	pkgResponse, err := model.Packages(ctx, gqlClient, pkgFilter)
+	pkgs, err := clienthelpers.Packages(ctx, gqlClient, pkgFilter)
 
 	if err != nil {
 		return fmt.Errorf("error finding inputted node %s", err)
 	}
 
 	var versionsList []string
-	for _, versionEntry := range pkgResponse.Packages[0].Namespaces[0].Names[0].Versions {
+	for _, versionEntry := range pkgs[0].Namespaces[0].Names[0].Versions {
 		versionsList = append(versionsList, versionEntry.Version)
 		if versionNode, seen := q.nodeMap[versionEntry.Id]; seen {
 			if !q.nodeMap[versionEntry.Id].Expanded {
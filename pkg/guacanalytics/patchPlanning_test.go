@@ -1709,7 +1709,7 @@ func getGraphqlTestServer() (*handler.Server, error) {
 
 	topResolver = resolvers.Resolver{Backend: backend}
 
-	config := generated.Config{Resolvers: &topResolver}
+	config := generated.Config{Resolvers: &topResolver, Directives: resolvers.NewDirectives(false)}
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
 
 	return srv, nil
@@ -22,8 +22,13 @@ import (
 	jsoniter "github.com/json-iterator/go"
 
 	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
@@ -44,8 +49,13 @@ type Collector interface {
 	Type() string
 }
 
-// Emitter processes a document
-type Emitter func(*processor.Document) error
+// StateStore records the checkpoints collectors resume from after a restart,
+// see package state for its implementations.
+type StateStore = state.Store
+
+// Emitter processes a document. The context carries the trace of the
+// document.
+type Emitter func(context.Context, *processor.Document) error
 
 // ErrHandler processes an error and returns a boolean representing if
 // the error was able to be gracefully handled
@@ -73,8 +83,6 @@ func Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
 	docChan := make(chan *processor.Document, BufferChannelSize)
 	// errChan to receive error from collectors
 	errChan := make(chan error, len(documentCollectors))
-	// logger
-	logger := logging.FromContext(ctx)
 
 	for _, collector := range documentCollectors {
 		c := collector
@@ -88,9 +96,7 @@ func Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
 	for collectorsDone < numCollectors {
 		select {
 		case d := <-docChan:
-			if err := emitter(d); err != nil {
-				logger.Errorf("emit error: %v", err)
-			}
+			emit(ctx, emitter, d)
 		case err := <-errChan:
 			if !handleErr(err) {
 				return err
@@ -102,13 +108,26 @@ func Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
 	}
 	for len(docChan) > 0 {
 		d := <-docChan
-		if err := emitter(d); err != nil {
-			logger.Errorf("emit error: %v", err)
-		}
+		emit(ctx, emitter, d)
 	}
 	return nil
 }
 
+// emit emits the collected document in a new trace, and counts it in the
+// documents collected by its collector.
+func emit(ctx context.Context, emitter Emitter, d *processor.Document) {
+	ctx, span := tracing.Start(ctx, "collect", trace.WithNewRoot(), trace.WithAttributes(
+		attribute.String("guac.collector", d.SourceInformation.Collector),
+		attribute.String("guac.source", d.SourceInformation.Source),
+	))
+	metrics.FromContext(ctx).IncrementCounterVec(metrics.DocumentsCollected, d.SourceInformation.Collector)
+	err := emitter(ctx, d)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("emit error: %v", err)
+	}
+	tracing.End(span, err)
+}
+
 // Publish is used by NATS JetStream to stream the documents and send them to the processor
 func Publish(ctx context.Context, d *processor.Document) error {
 	logger := logging.FromContext(ctx)
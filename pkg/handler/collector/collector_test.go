@@ -71,7 +71,7 @@ func TestCollect(t *testing.T) {
 				t.Error(err)
 			}
 
-			emit := func(d *processor.Document) error {
+			emit := func(_ context.Context, d *processor.Document) error {
 				collectedDoc = append(collectedDoc, d)
 				return nil
 			}
@@ -146,7 +146,7 @@ func testSubscribe(ctx context.Context, transportFunc func(processor.DocumentTre
 		return err
 	}
 
-	processFunc := func(d []byte) error {
+	processFunc := func(_ context.Context, d []byte) error {
 		doc := processor.Document{}
 		err := json.Unmarshal(d, &doc)
 		if err != nil {
@@ -30,6 +30,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	pb "github.com/guacsec/guac/pkg/handler/collector/deps_dev/internal"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/version"
@@ -73,9 +74,20 @@ type depsCollector struct {
 	projectInfoMap       map[string]*pb.Project
 	versions             map[string]*pb.Version
 	dependencies         map[string]*pb.Dependencies
+	state                state.Store
 }
 
-func NewDepsCollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, retrieveDependencies bool, interval time.Duration) (*depsCollector, error) {
+type Opt func(*depsCollector)
+
+// WithStateStore sets the store that records the purls collected. By
+// default, they are only kept in memory.
+func WithStateStore(s state.Store) Opt {
+	return func(d *depsCollector) {
+		d.state = s
+	}
+}
+
+func NewDepsCollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, retrieveDependencies bool, interval time.Duration, opts ...Opt) (*depsCollector, error) {
 	// Get the system certificates.
 	sysPool, err := x509.SystemCertPool()
 	if err != nil {
@@ -94,7 +106,7 @@ func NewDepsCollector(ctx context.Context, collectDataSource datasource.CollectS
 	// Create a new Insights Client.
 	client := pb.NewInsightsClient(conn)
 
-	return &depsCollector{
+	d := &depsCollector{
 		collectDataSource:    collectDataSource,
 		client:               client,
 		poll:                 poll,
@@ -105,7 +117,14 @@ func NewDepsCollector(ctx context.Context, collectDataSource datasource.CollectS
 		projectInfoMap:       map[string]*pb.Project{},
 		versions:             map[string]*pb.Version{},
 		dependencies:         map[string]*pb.Dependencies{},
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.state == nil {
+		d.state = state.NewMemoryStore()
+	}
+	return d, nil
 }
 
 // RetrieveArtifacts get the metadata from deps.dev based on the purl provided
@@ -177,7 +196,7 @@ func (d *depsCollector) validatePurls(ctx context.Context, datasources []datasou
 	for _, ds := range datasources {
 		purl := ds.Value
 
-		if _, ok := d.checkedPurls[purl]; ok {
+		if _, ok := d.checkedPurls[purl]; ok || d.isPurlCollected(ctx, purl) {
 			logger.Infof("purl %s already queried", purl)
 			continue
 		}
@@ -279,6 +298,7 @@ func (d *depsCollector) collectMetadata(ctx context.Context, docChannel chan<- *
 			},
 		}
 		docChannel <- doc
+		d.markPurlCollected(ctx, purl)
 	}
 }
 
@@ -386,7 +406,7 @@ func (d *depsCollector) fetchDependencies(ctx context.Context, purl string, docC
 	component := &PackageComponent{}
 
 	// check if top level purl has already been queried
-	if _, ok := d.checkedPurls[purl]; ok {
+	if _, ok := d.checkedPurls[purl]; ok || d.isPurlCollected(ctx, purl) {
 		logger.Infof("purl %s already queried", purl)
 		return nil
 	}
@@ -514,10 +534,37 @@ func (d *depsCollector) fetchDependencies(ctx context.Context, purl string, docC
 		},
 	}
 	docChannel <- doc
+	d.markPurlCollected(ctx, purl)
 
 	return nil
 }
 
+// collectedMode is the checkpoint of the purls collected, as a purl collected
+// without its dependencies still has to be collected with them.
+func (d *depsCollector) collectedMode() string {
+	if d.retrieveDependencies {
+		return "dependencies"
+	}
+	return "metadata"
+}
+
+// isPurlCollected checks if the top level purl was collected by a previous run
+func (d *depsCollector) isPurlCollected(ctx context.Context, purl string) bool {
+	collected, err := state.HasCheckpoint(ctx, d.state, DepsCollector, purl, d.collectedMode())
+	if err != nil {
+		logging.FromContext(ctx).Warnf("unable to check whether purl %s was collected: %v", purl, err)
+		return false
+	}
+	return collected
+}
+
+// markPurlCollected records the top level purl as collected
+func (d *depsCollector) markPurlCollected(ctx context.Context, purl string) {
+	if err := d.state.SetCheckpoint(ctx, DepsCollector, purl, d.collectedMode()); err != nil {
+		logging.FromContext(ctx).Warnf("unable to record purl %s as collected: %v", purl, err)
+	}
+}
+
 func (d *depsCollector) collectAdditionalMetadata(ctx context.Context, pkgType string, namespace *string, name string, version *string, pkgComponent *PackageComponent) error {
 	logger := logging.FromContext(ctx)
 
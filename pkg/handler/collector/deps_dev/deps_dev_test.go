@@ -235,7 +235,7 @@ func Test_depsCollector_RetrieveArtifacts(t *testing.T) {
 				t.Fatalf("could not register collector: %v", err)
 			}
 			var collectedDocs []*processor.Document
-			em := func(d *processor.Document) error {
+			em := func(_ context.Context, d *processor.Document) error {
 				collectedDocs = append(collectedDocs, d)
 				return nil
 			}
@@ -352,7 +352,7 @@ func TestPerformanceDepsCollector(t *testing.T) {
 		t.Fatalf("could not register collector: %v", err)
 	}
 	var collectedDocs []*processor.Document
-	em := func(d *processor.Document) error {
+	em := func(_ context.Context, d *processor.Document) error {
 		collectedDocs = append(collectedDocs, d)
 		return nil
 	}
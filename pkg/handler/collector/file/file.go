@@ -21,8 +21,10 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
@@ -30,19 +32,49 @@ const (
 	FileCollector = "FileCollector"
 )
 
+// signatureSuffixes maps the suffixes of the detached signature files that
+// are published next to documents to the type of their signatures.
+var signatureSuffixes = []struct {
+	suffix        string
+	signatureType string
+}{
+	{".sig", processor.SignatureCosign},
+	{".sigstore.json", processor.SignatureSigstoreBundle},
+	{".sigstore", processor.SignatureSigstoreBundle},
+	{".asc", processor.SignaturePGP},
+}
+
 type fileCollector struct {
-	path        string
-	lastChecked time.Time
-	poll        bool
-	interval    time.Duration
+	path     string
+	poll     bool
+	interval time.Duration
+	state    state.Store
 }
 
-func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration) *fileCollector {
-	return &fileCollector{
+type Opt func(*fileCollector)
+
+// WithStateStore sets the store that records the modification time of the
+// collected files, so that only new and modified files are collected after a
+// restart. By default, it is only kept in memory.
+func WithStateStore(s state.Store) Opt {
+	return func(f *fileCollector) {
+		f.state = s
+	}
+}
+
+func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration, opts ...Opt) *fileCollector {
+	f := &fileCollector{
 		path:     path,
 		poll:     poll,
 		interval: interval,
 	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if f.state == nil {
+		f.state = state.NewMemoryStore()
+	}
+	return f
 }
 
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
@@ -71,14 +103,28 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 		if err != nil {
 			return fmt.Errorf("path: %s is invalid", path)
 		}
-		if dirEntry.IsDir() {
+		if dirEntry.IsDir() || isSignatureOfFile(path) {
 			return nil
 		}
 		info, err := dirEntry.Info()
 		if err != nil {
 			return fmt.Errorf("unknown error on dirEntry.Info while walking path: %w", err)
 		}
-		if !info.ModTime().After(f.lastChecked) {
+		signatures, sigModTime, err := readSignatures(path)
+		if err != nil {
+			return err
+		}
+		// a signature published after the document is collected again with it
+		latest := info.ModTime()
+		if sigModTime.After(latest) {
+			latest = sigModTime
+		}
+		modTime := latest.UTC().Format(time.RFC3339Nano)
+		collected, err := state.HasCheckpoint(ctx, f.state, FileCollector, path, modTime)
+		if err != nil {
+			return err
+		}
+		if collected {
 			return nil
 		}
 
@@ -95,18 +141,18 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 				Collector: string(FileCollector),
 				Source:    fmt.Sprintf("file:///%s", path),
 			},
+			Signatures: signatures,
 		}
 
 		docChannel <- doc
 
-		return nil
+		return f.state.SetCheckpoint(ctx, FileCollector, path, modTime)
 	}
 
 	for {
 		if err := filepath.WalkDir(f.path, readFunc); err != nil {
 			return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
 		}
-		f.lastChecked = time.Now()
 		if !f.poll {
 			break
 		}
@@ -121,6 +167,43 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 	return nil
 }
 
+// isSignatureOfFile reports whether the file is a detached signature of
+// another file, which is collected along with that file.
+func isSignatureOfFile(path string) bool {
+	for _, s := range signatureSuffixes {
+		signed, ok := strings.CutSuffix(path, s.suffix)
+		if !ok {
+			continue
+		}
+		if info, err := os.Stat(signed); err == nil && info.Mode().IsRegular() {
+			return true
+		}
+	}
+	return false
+}
+
+// readSignatures reads the detached signatures published next to the file,
+// and returns them along with the latest modification time of their files.
+func readSignatures(path string) ([]processor.Signature, time.Time, error) {
+	var signatures []processor.Signature
+	var modTime time.Time
+	for _, s := range signatureSuffixes {
+		info, err := os.Stat(path + s.suffix)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		blob, err := os.ReadFile(path + s.suffix)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("error reading signature file: %s, err: %w", path+s.suffix, err)
+		}
+		signatures = append(signatures, processor.Signature{Type: s.signatureType, Blob: blob})
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	return signatures, modTime, nil
+}
+
 // Type returns the collector type
 func (f *fileCollector) Type() string {
 	return FileCollector
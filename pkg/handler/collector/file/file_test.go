@@ -18,20 +18,23 @@ package file
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
 func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 	type fields struct {
-		path        string
-		lastChecked time.Time
-		poll        bool
-		interval    time.Duration
+		path     string
+		poll     bool
+		interval time.Duration
 	}
 	tests := []struct {
 		name    string
@@ -41,20 +44,18 @@ func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 	}{{
 		name: "nonexistent file path",
 		fields: fields{
-			path:        "./doesnotexist",
-			lastChecked: time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC),
-			poll:        false,
-			interval:    0,
+			path:     "./doesnotexist",
+			poll:     false,
+			interval: 0,
 		},
 		want:    nil,
 		wantErr: true,
 	}, {
 		name: "found file",
 		fields: fields{
-			path:        "./testdata",
-			lastChecked: time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC),
-			poll:        false,
-			interval:    0,
+			path:     "./testdata",
+			poll:     false,
+			interval: 0,
 		},
 		want: []*processor.Document{{
 			Blob:   []byte("hello\n"),
@@ -69,10 +70,9 @@ func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 	}, {
 		name: "with canceled poll",
 		fields: fields{
-			path:        "./testdata",
-			lastChecked: time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC),
-			poll:        true,
-			interval:    time.Millisecond,
+			path:     "./testdata",
+			poll:     true,
+			interval: time.Millisecond,
 		},
 		want: []*processor.Document{{
 			Blob:   []byte("hello\n"),
@@ -88,10 +88,10 @@ func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			f := &fileCollector{
-				path:        tt.fields.path,
-				lastChecked: tt.fields.lastChecked,
-				poll:        tt.fields.poll,
-				interval:    tt.fields.interval,
+				path:     tt.fields.path,
+				poll:     tt.fields.poll,
+				interval: tt.fields.interval,
+				state:    state.NewMemoryStore(),
 			}
 			// NOTE: Below is one of the simplest ways to validate the context getting canceled()
 			// This is still brittle if a test for some reason takes longer than a second.
@@ -111,7 +111,7 @@ func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 			}
 
 			var s []*processor.Document
-			em := func(d *processor.Document) error {
+			em := func(_ context.Context, d *processor.Document) error {
 				s = append(s, d)
 				return nil
 			}
@@ -135,3 +135,86 @@ func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 		})
 	}
 }
+
+func Test_fileCollector_Resume(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	doc := filepath.Join(dir, "docs", "sbom.json")
+	if err := os.MkdirAll(filepath.Dir(doc), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(doc, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stateFile := filepath.Join(dir, "state.json")
+
+	// collect runs a new collector, as after a restart, and counts the
+	// documents it emits
+	collect := func() int {
+		t.Helper()
+		s, err := state.NewFileStore(stateFile)
+		if err != nil {
+			t.Fatalf("NewFileStore() error = %v", err)
+		}
+		docChan := make(chan *processor.Document, 10)
+		f := NewFileCollector(ctx, filepath.Dir(doc), false, 0, WithStateStore(s))
+		if err := f.RetrieveArtifacts(ctx, docChan); err != nil {
+			t.Fatalf("RetrieveArtifacts() error = %v", err)
+		}
+		return len(docChan)
+	}
+
+	if got := collect(); got != 1 {
+		t.Errorf("first run collected %d documents, want 1", got)
+	}
+	if got := collect(); got != 0 {
+		t.Errorf("run after restart collected %d documents, want 0", got)
+	}
+	modified := time.Now().Add(time.Hour)
+	if err := os.Chtimes(doc, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+	if got := collect(); got != 1 {
+		t.Errorf("run after modification collected %d documents, want 1", got)
+	}
+}
+
+func Test_fileCollector_Signatures(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	files := map[string]string{
+		"sbom.json":               "{}",
+		"sbom.json.sig":           "c2lnbmF0dXJl",
+		"sbom.json.asc":           "-----BEGIN PGP SIGNATURE-----",
+		"sbom.json.sigstore.json": `{"mediaType": "application/vnd.dev.sigstore.bundle+json;version=0.2"}`,
+		"orphan.sig":              "c2lnbmF0dXJl",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	docChan := make(chan *processor.Document, 10)
+	f := NewFileCollector(ctx, dir, false, 0)
+	if err := f.RetrieveArtifacts(ctx, docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+
+	got := map[string][]processor.Signature{}
+	for doc := range docChan {
+		got[filepath.Base(doc.SourceInformation.Source)] = doc.Signatures
+	}
+	want := map[string][]processor.Signature{
+		"orphan.sig": nil,
+		"sbom.json": {
+			{Type: processor.SignatureCosign, Blob: []byte(files["sbom.json.sig"])},
+			{Type: processor.SignatureSigstoreBundle, Blob: []byte(files["sbom.json.sigstore.json"])},
+			{Type: processor.SignaturePGP, Blob: []byte(files["sbom.json.asc"])},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RetrieveArtifacts() unexpected signatures (-want +got):\n%s", diff)
+	}
+}
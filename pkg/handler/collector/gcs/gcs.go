@@ -20,22 +20,24 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"time"
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/iterator"
 
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
 type gcs struct {
-	bucket       string
-	reader       gcsReader
-	client       *storage.Client
-	lastDownload time.Time
-	poll         bool
-	interval     time.Duration
+	bucket   string
+	reader   gcsReader
+	client   *storage.Client
+	state    state.Store
+	poll     bool
+	interval time.Duration
 }
 
 const CollectorGCS = "GCS"
@@ -59,6 +61,10 @@ func NewGCSCollector(opts ...Opt) (*gcs, error) {
 		return nil, errors.New("gcs client not specified")
 	}
 
+	if gstore.state == nil {
+		gstore.state = state.NewMemoryStore()
+	}
+
 	return gstore, nil
 }
 
@@ -83,6 +89,14 @@ func WithBucket(bucket string) Opt {
 	}
 }
 
+// WithStateStore sets the store that records the generation of each object
+// collected. By default, it is only kept in memory.
+func WithStateStore(s state.Store) Opt {
+	return func(g *gcs) {
+		g.state = s
+	}
+}
+
 // Type is the collector type of the collector
 func (g *gcs) Type() string {
 	return CollectorGCS
@@ -102,8 +116,8 @@ func (r *reader) getIterator(ctx context.Context) (*storage.ObjectIterator, erro
 	q := &storage.Query{
 		Projection: storage.ProjectionNoACL,
 	}
-	// set query to return only the Name and Updated attributes
-	err := q.SetAttrSelection([]string{"Name", "Updated"})
+	// set query to return only the Name, Generation and Updated attributes
+	err := q.SetAttrSelection([]string{"Name", "Generation", "Updated"})
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +140,6 @@ func (g *gcs) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processo
 		if err != nil {
 			return fmt.Errorf("failed to get artifacts from gcs: %w", err)
 		}
-		g.lastDownload = time.Now()
 		return nil
 	}
 
@@ -167,7 +180,13 @@ func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Doc
 			return fmt.Errorf("failed to retrieve object attribute from bucket: %s, error: %w", g.bucket, err)
 		}
 
-		if g.lastDownload.IsZero() || attrs.Updated.After(g.lastDownload) {
+		// an object is collected again when a new generation of it is written
+		generation := strconv.FormatInt(attrs.Generation, 10)
+		collected, err := state.HasCheckpoint(ctx, g.state, CollectorGCS, attrs.Name, generation)
+		if err != nil {
+			return err
+		}
+		if !collected {
 			payload, err := g.getObject(ctx, attrs.Name)
 			if err != nil {
 				logger.Warnf("failed to retrieve object: %s from bucket: %s, error: %w", attrs.Name, g.bucket, err)
@@ -187,6 +206,9 @@ func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Doc
 				},
 			}
 			docChannel <- doc
+			if err := g.state.SetCheckpoint(ctx, CollectorGCS, attrs.Name, generation); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
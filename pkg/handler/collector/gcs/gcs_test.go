@@ -25,6 +25,7 @@ import (
 	"cloud.google.com/go/storage"
 	"github.com/fsouza/fake-gcs-server/fakestorage"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
@@ -37,6 +38,7 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 				BucketName: bucketName,
 				Name:       "some/object/file.txt",
 				Updated:    time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC),
+				Generation: 2,
 			},
 			Content: []byte("inside the file"),
 		},
@@ -55,10 +57,10 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 	}
 
 	type fields struct {
-		bucket       string
-		reader       gcsReader
-		lastDownload time.Time
-		poll         bool
+		bucket     string
+		reader     gcsReader
+		checkpoint string
+		poll       bool
 	}
 	tests := []struct {
 		name     string
@@ -80,21 +82,21 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 		wantErr:  false,
 		wantDone: true,
 	}, {
-		name: "last download time the same",
+		name: "generation already collected",
 		fields: fields{
-			bucket:       bucketName,
-			reader:       &reader{client: client, bucket: bucketName},
-			lastDownload: time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC),
+			bucket:     bucketName,
+			reader:     &reader{client: client, bucket: bucketName},
+			checkpoint: "2",
 		},
 		want:     nil,
 		wantErr:  false,
 		wantDone: true,
 	}, {
-		name: "last download time set before",
+		name: "previous generation collected",
 		fields: fields{
-			bucket:       bucketName,
-			reader:       &reader{client: client, bucket: bucketName},
-			lastDownload: time.Date(2009, 10, 17, 20, 34, 58, 651387237, time.UTC),
+			bucket:     bucketName,
+			reader:     &reader{client: client, bucket: bucketName},
+			checkpoint: "1",
 		},
 		want:     []*processor.Document{doc},
 		wantErr:  false,
@@ -104,10 +106,15 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := &gcs{
-				bucket:       tt.fields.bucket,
-				reader:       tt.fields.reader,
-				lastDownload: tt.fields.lastDownload,
-				poll:         tt.fields.poll,
+				bucket: tt.fields.bucket,
+				reader: tt.fields.reader,
+				state:  state.NewMemoryStore(),
+				poll:   tt.fields.poll,
+			}
+			if tt.fields.checkpoint != "" {
+				if err := g.state.SetCheckpoint(ctx, CollectorGCS, "some/object/file.txt", tt.fields.checkpoint); err != nil {
+					t.Fatal(err)
+				}
 			}
 			if err := collector.RegisterDocumentCollector(g, CollectorGCS); err != nil &&
 				!errors.Is(err, collector.ErrCollectorOverwrite) {
@@ -115,7 +122,7 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 			}
 
 			var docs []*processor.Document
-			em := func(d *processor.Document) error {
+			em := func(_ context.Context, d *processor.Document) error {
 				docs = append(docs, d)
 				return nil
 			}
@@ -175,6 +182,7 @@ func TestNewGCSCollector(t *testing.T) {
 				bucket: "some-bucket",
 				client: client,
 				reader: &reader{bucket: "some-bucket", client: client},
+				state:  state.NewMemoryStore(),
 				poll:   false,
 			},
 			wantErr: false,
@@ -189,6 +197,7 @@ func TestNewGCSCollector(t *testing.T) {
 				bucket:   "some-bucket",
 				client:   client,
 				reader:   &reader{bucket: "some-bucket", client: client},
+				state:    state.NewMemoryStore(),
 				poll:     true,
 				interval: 2 * time.Minute,
 			},
@@ -17,6 +17,7 @@ package git_collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"go.uber.org/zap"
@@ -40,24 +42,40 @@ const (
 type gitDocumentCollector struct {
 	url           string
 	dir           string
-	lastChecked   time.Time
 	poll          bool
 	interval      time.Duration
 	fileCollector collector.Collector
+	state         collector.StateStore
 }
 
-func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll bool, interval time.Duration) *gitDocumentCollector {
-	fileCollector := file.NewFileCollector(ctx, dir, false, time.Second)
+type Opt func(*gitDocumentCollector)
 
-	return &gitDocumentCollector{
-		url:           url,
-		dir:           dir,
-		poll:          poll,
-		interval:      interval,
-		fileCollector: fileCollector,
+// WithStateStore sets the store that records the last collected commit of
+// the repository and the files collected from it. By default, it is only
+// kept in memory.
+func WithStateStore(s collector.StateStore) Opt {
+	return func(g *gitDocumentCollector) {
+		g.state = s
 	}
 }
 
+func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll bool, interval time.Duration, opts ...Opt) *gitDocumentCollector {
+	g := &gitDocumentCollector{
+		url:      url,
+		dir:      dir,
+		poll:     poll,
+		interval: interval,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.state == nil {
+		g.state = state.NewMemoryStore()
+	}
+	g.fileCollector = file.NewFileCollector(ctx, dir, false, time.Second, file.WithStateStore(g.state))
+	return g
+}
+
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
 // document through the channel to be collected and processed by the upstream processor.
 // The function should block until all the artifacts are collected and return a nil error
@@ -73,7 +91,6 @@ func (g *gitDocumentCollector) RetrieveArtifacts(ctx context.Context, docChannel
 			if err != nil {
 				return fmt.Errorf("error creating or pulling git repo: %w", err)
 			}
-			g.lastChecked = time.Now()
 			select {
 			// If the context has been canceled it contains an err which we can throw.
 			case <-ctx.Done():
@@ -86,7 +103,6 @@ func (g *gitDocumentCollector) RetrieveArtifacts(ctx context.Context, docChannel
 		if err != nil {
 			return fmt.Errorf("error creating or pulling git repo: %w", err)
 		}
-		g.lastChecked = time.Now()
 	}
 
 	return nil
@@ -98,6 +114,7 @@ func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.Sug
 		return fmt.Errorf("error checking if directory exists: %w", err)
 	}
 
+	upToDate := false
 	if !exists {
 		if err := os.Mkdir(g.dir, os.ModePerm); err != nil {
 			return fmt.Errorf("error creating directory: %w", err)
@@ -106,22 +123,36 @@ func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.Sug
 		if err != nil {
 			return fmt.Errorf("error cloning repo: %w", err)
 		}
-		err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
-		if err != nil {
-			return fmt.Errorf("error retrieving artifacts: %w", err)
-		}
 	} else {
 		err := pullRepo(logger, g.dir)
 		if err != nil && err != git.NoErrAlreadyUpToDate {
 			return fmt.Errorf("error pulling repo: %w", err)
-		} else if err == nil {
-			err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
-			if err != nil {
-				return fmt.Errorf("error retrieving artifacts: %w", err)
-			}
 		}
+		upToDate = err == git.NoErrAlreadyUpToDate
 	}
-	return nil
+
+	head, err := headCommit(g.dir)
+	if err != nil {
+		return err
+	}
+	checkpoint, err := g.state.GetCheckpoint(ctx, CollectorGitDocument, g.url)
+	if err != nil && !errors.Is(err, state.ErrNoCheckpoint) {
+		return err
+	}
+	switch {
+	case checkpoint == head:
+		logger.Debugf("commit %s of %s already collected", head, g.url)
+		return nil
+	case checkpoint != "" || !upToDate:
+		// collect the files of commits that were pulled but not collected
+		// before a restart, as well as new ones
+		if err := g.fileCollector.RetrieveArtifacts(ctx, docChannel); err != nil {
+			return fmt.Errorf("error retrieving artifacts: %w", err)
+		}
+	}
+	// an existing clone that is up to date was collected before the state
+	// was kept
+	return g.state.SetCheckpoint(ctx, CollectorGitDocument, g.url, head)
 }
 
 // Type returns the collector type
@@ -168,6 +199,19 @@ func cloneRepoToDir(logger *zap.SugaredLogger, url string, directory string) err
 	return nil
 }
 
+// headCommit returns the SHA of the commit HEAD of the repository points to.
+func headCommit(directory string) (string, error) {
+	r, err := git.PlainOpen(directory)
+	if err != nil {
+		return "", fmt.Errorf("error opening repo: %w", err)
+	}
+	ref, err := r.Head()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving HEAD: %w", err)
+	}
+	return ref.Hash().String(), nil
+}
+
 func pullRepo(logger *zap.SugaredLogger, directory string) error {
 	// We instantiate a new repository targeting the given path (the .git folder)
 	r, err := git.PlainOpen(directory)
@@ -26,6 +26,7 @@ import (
 	"github.com/guacsec/guac/internal/client/githubclient"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -49,6 +50,7 @@ type githubCollector struct {
 	repoToReleaseTags map[client.Repo][]TagOrLatest
 	assetSuffixes     []string
 	collectDataSource datasource.CollectSource
+	state             state.Store
 }
 
 type Config struct {
@@ -85,6 +87,9 @@ func NewGithubCollector(opts ...Opt) (*githubCollector, error) {
 	if len(g.repoToReleaseTags) == 0 && g.collectDataSource == nil {
 		return nil, fmt.Errorf("no repos and releases to collect nor any data source for future subscriptions")
 	}
+	if g.state == nil {
+		g.state = state.NewMemoryStore()
+	}
 	return g, nil
 }
 
@@ -119,6 +124,14 @@ func WithCollectDataSource(collectDataSource datasource.CollectSource) Opt {
 	}
 }
 
+// WithStateStore sets the store that records the release collected for each
+// repo and tag. By default, it is only kept in memory.
+func WithStateStore(s state.Store) Opt {
+	return func(g *githubCollector) {
+		g.state = s
+	}
+}
+
 // RetrieveArtifacts get the artifacts from the collector source based on polling or one time
 func (g *githubCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
 	err := g.populateRepoToReleaseTags(ctx)
@@ -179,7 +192,6 @@ func (g *githubCollector) populateRepoToReleaseTags(ctx context.Context) error {
 
 func (g *githubCollector) fetchAssets(ctx context.Context, owner string, repo string, tags []TagOrLatest, docChannel chan<- *processor.Document) {
 	logger := logging.FromContext(ctx)
-	var releases []client.Release
 	for _, gitTag := range tags {
 		var release *client.Release
 		var err error
@@ -193,12 +205,34 @@ func (g *githubCollector) fetchAssets(ctx context.Context, owner string, repo st
 			logger.Warnf("unable to fetch release: %v", err)
 			continue
 		}
-		releases = append(releases, *release)
+
+		// the latest release, or a moved tag, is collected again once it
+		// points to another release
+		key := releaseKey(owner, repo, gitTag)
+		checkpoint := release.Tag + "@" + release.Commit
+		collected, err := state.HasCheckpoint(ctx, g.state, GithubCollector, key, checkpoint)
+		if err != nil {
+			logger.Warnf("unable to check whether release %s was collected: %v", key, err)
+		}
+		if collected {
+			logger.Debugf("release %s already collected", key)
+			continue
+		}
+		g.collectAssetsForRelease(ctx, *release, docChannel)
+		if ctx.Err() != nil {
+			return
+		}
+		if err := g.state.SetCheckpoint(ctx, GithubCollector, key, checkpoint); err != nil {
+			logger.Warnf("unable to record release %s as collected: %v", key, err)
+		}
 	}
+}
 
-	for _, release := range releases {
-		g.collectAssetsForRelease(ctx, release, docChannel)
+func releaseKey(owner string, repo string, tag TagOrLatest) string {
+	if tag == Latest {
+		tag = "latest"
 	}
+	return owner + "/" + repo + "@" + tag
 }
 
 func (g *githubCollector) collectAssetsForRelease(ctx context.Context, release client.Release, docChannel chan<- *processor.Document) {
@@ -30,6 +30,7 @@ import (
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
@@ -175,6 +176,7 @@ func TestNewGithubCollector(t *testing.T) {
 				repoToReleaseTags: map[client.Repo][]TagOrLatest{},
 				assetSuffixes:     defaultAssetSuffixes(),
 				collectDataSource: mockData,
+				state:             state.NewMemoryStore(),
 			},
 			wantErr: false,
 		},
@@ -193,6 +195,7 @@ func TestNewGithubCollector(t *testing.T) {
 				repoToReleaseTags: mockLatest,
 				assetSuffixes:     defaultAssetSuffixes(),
 				collectDataSource: nil,
+				state:             state.NewMemoryStore(),
 			},
 			wantErr: false,
 		},
@@ -211,6 +214,7 @@ func TestNewGithubCollector(t *testing.T) {
 				repoToReleaseTags: mockTag,
 				assetSuffixes:     defaultAssetSuffixes(),
 				collectDataSource: nil,
+				state:             state.NewMemoryStore(),
 			},
 			wantErr: false,
 		},
@@ -348,6 +352,7 @@ func Test_githubCollector_RetrieveArtifacts(t *testing.T) {
 				repoToReleaseTags: tt.fields.repoToReleaseTags,
 				assetSuffixes:     tt.fields.assetSuffixes,
 				collectDataSource: tt.fields.collectDataSource,
+				state:             state.NewMemoryStore(),
 			}
 			ctx := context.Background()
 			var cancel context.CancelFunc
@@ -364,7 +369,7 @@ func Test_githubCollector_RetrieveArtifacts(t *testing.T) {
 
 			var collectedDocs []*processor.Document
 
-			em := func(d *processor.Document) error {
+			em := func(_ context.Context, d *processor.Document) error {
 				collectedDocs = append(collectedDocs, d)
 				return nil
 			}
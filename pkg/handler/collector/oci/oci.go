@@ -18,12 +18,12 @@ package oci
 import (
 	"context"
 	"fmt"
-	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/version"
@@ -66,23 +66,39 @@ var wellKnownSuffixes = []string{"att", "sbom"}
 
 type ociCollector struct {
 	collectDataSource datasource.CollectSource
-	checkedDigest     sync.Map
+	state             state.Store
 	poll              bool
 	interval          time.Duration
 }
 
+type Opt func(*ociCollector)
+
+// WithStateStore sets the store that records the digests collected from each
+// repository. By default, they are only kept in memory.
+func WithStateStore(s state.Store) Opt {
+	return func(o *ociCollector) {
+		o.state = s
+	}
+}
+
 // NewOCICollector initializes the oci collector by passing in the repo and tag being collected.
 // Note: OCI collector can be called upon by a upstream registry collector in the future to collect from all
 // repos in a given registry. For further details see issue #298
 //
 // Interval should be set to about 5 mins or more for production so that it doesn't clobber registries.
-func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, interval time.Duration) *ociCollector {
-	return &ociCollector{
+func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, interval time.Duration, opts ...Opt) *ociCollector {
+	o := &ociCollector{
 		collectDataSource: collectDataSource,
-		checkedDigest:     sync.Map{},
 		poll:              poll,
 		interval:          interval,
 	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.state == nil {
+		o.state = state.NewMemoryStore()
+	}
+	return o
 }
 
 // RetrieveArtifacts get the artifacts from the collector source based on polling or one time
@@ -283,13 +299,13 @@ func (o *ociCollector) fetchManifestList(ctx context.Context, repo string, rc *r
 				Digest:     desc.Digest.String(),
 			}
 			// check if the platform digest has already been collected
-			if !o.isDigestCollected(repo, platformImage.Digest) {
+			if !o.isDigestCollected(ctx, repo, platformImage.Digest) {
 				logger.Infof("Fetching %s for platform %s", platformImage.Digest, desc.Platform)
 				if err := o.fetchOCIArtifacts(ctx, repo, rc, platformImage, docChannel); err != nil {
 					errorChan <- fmt.Errorf("failed fetching artifacts for platform specific digest: %w", err)
 					cancel()
 				}
-				o.markDigestAsCollected(repo, platformImage.Digest)
+				o.markDigestAsCollected(ctx, repo, platformImage.Digest)
 			}
 		}(p)
 	}
@@ -322,13 +338,13 @@ func (o *ociCollector) fetchFallbackArtifacts(ctx context.Context, repo string,
 	for _, suffix := range wellKnownSuffixes {
 		digestTag := fmt.Sprintf("%v.%v", digestFormatted, suffix)
 		// check to see if the digest + suffix has already been collected
-		if !o.isDigestCollected(repo, digestTag) {
+		if !o.isDigestCollected(ctx, repo, digestTag) {
 			imageTag := fmt.Sprintf("%v:%v", repo, digestTag)
 			err := fetchOCIArtifactBlobs(ctx, rc, imageTag, "unknown", docChannel)
 			if err != nil {
 				return fmt.Errorf("failed retrieving artifact blobs from registry fallback artifacts: %w", err)
 			}
-			o.markDigestAsCollected(repo, digestTag)
+			o.markDigestAsCollected(ctx, repo, digestTag)
 		}
 	}
 	return nil
@@ -364,7 +380,7 @@ func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string,
 			if _, ok := wellKnownOCIArtifactTypes[referrerDesc.ArtifactType]; ok {
 				referrerDescDigest := referrerDesc.Digest.String()
 
-				if !o.isDigestCollected(repo, referrerDescDigest) {
+				if !o.isDigestCollected(ctx, repo, referrerDescDigest) {
 					logger.Infof("Fetching referrer %s with artifact type %s", referrerDescDigest, referrerDesc.ArtifactType)
 					referrerDigest := fmt.Sprintf("%v@%v", repo, referrerDescDigest)
 					e := fetchOCIArtifactBlobs(ctx, rc, referrerDigest, referrerDesc.ArtifactType, docChannel)
@@ -373,7 +389,7 @@ func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string,
 						cancel()
 						return
 					}
-					o.markDigestAsCollected(repo, referrerDescDigest)
+					o.markDigestAsCollected(ctx, repo, referrerDescDigest)
 				}
 			} else {
 				logger.Infof("Skipping referrer %s with unknown artifact type %s", referrerDesc.Digest, referrerDesc.ArtifactType)
@@ -471,32 +487,20 @@ func fetchOCIArtifactBlobs(ctx context.Context, rc *regclient.RegClient, artifac
 
 // isDigestCollected checks if a given digest has already been collected for a given repository.
 // It returns true if the digest has been collected, false otherwise.
-func (o *ociCollector) isDigestCollected(repo string, digest string) bool {
-	collectedDigests, ok := o.checkedDigest.Load(repo)
-	if !ok {
-		o.checkedDigest.Store(repo, []string{})
+func (o *ociCollector) isDigestCollected(ctx context.Context, repo string, digest string) bool {
+	collected, err := state.HasCheckpoint(ctx, o.state, OCICollector, repo+"@"+digest, digest)
+	if err != nil {
+		logging.FromContext(ctx).Warnf("unable to check whether %s@%s was collected: %v", repo, digest, err)
 		return false
-	} else {
-		digests, ok := collectedDigests.([]string)
-		if !ok {
-			return false
-		}
-		return slices.Contains(digests, digest)
 	}
+	return collected
 }
 
-// markDigestAsCollected adds the given digest to the list of collected digests for the given repository.
-// If the repository is not yet in the checkedDigest map, it will be added with an empty slice of digests.
-func (o *ociCollector) markDigestAsCollected(repo string, digest string) {
-	collectedDigests, ok := o.checkedDigest.Load(repo)
-	if !ok {
-		o.checkedDigest.Store(repo, []string{})
-	}
-	digests, ok := collectedDigests.([]string)
-	if !ok {
-		return
+// markDigestAsCollected records the given digest as collected for the given repository.
+func (o *ociCollector) markDigestAsCollected(ctx context.Context, repo string, digest string) {
+	if err := o.state.SetCheckpoint(ctx, OCICollector, repo+"@"+digest, digest); err != nil {
+		logging.FromContext(ctx).Warnf("unable to record %s@%s as collected: %v", repo, digest, err)
 	}
-	o.checkedDigest.Store(repo, append(digests, digest))
 }
 
 // Type is the collector type of the collector
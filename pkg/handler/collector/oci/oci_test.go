@@ -307,7 +307,7 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 			}
 
 			var collectedDocs []*processor.Document
-			em := func(d *processor.Document) error {
+			em := func(_ context.Context, d *processor.Document) error {
 				collectedDocs = append(collectedDocs, d)
 				return nil
 			}
@@ -45,6 +45,7 @@ type Bucket interface {
 	ListFiles(ctx context.Context, bucket string, token *string, max int32) ([]string, *string, error)
 	DownloadFile(ctx context.Context, bucket string, item string) ([]byte, error)
 	GetEncoding(ctx context.Context, bucket string, item string) (string, error)
+	GetETag(ctx context.Context, bucket string, item string) (string, error)
 }
 
 type s3Bucket struct {
@@ -158,3 +159,31 @@ func (d *s3Bucket) GetEncoding(ctx context.Context, bucket string, item string)
 
 	return *headObject.ContentEncoding, nil
 }
+
+func (d *s3Bucket) GetETag(ctx context.Context, bucket string, item string) (string, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error loading AWS SDK config: %w", err)
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+		if d.url != "" {
+			o.BaseEndpoint = aws.String(d.url)
+		}
+		if d.region != "" {
+			o.Region = d.region
+		}
+	})
+
+	headObject, err := client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: aws.String(bucket), Key: aws.String(item)})
+	if err != nil {
+		return "", fmt.Errorf("could not get head object: %w", err)
+	}
+
+	if headObject.ETag == nil {
+		return "", nil
+	}
+
+	return *headObject.ETag, nil
+}
@@ -27,6 +27,10 @@ func ExtractEncoding(encoding string, filename string) processor.EncodingType {
 		return processor.EncodingBzip2
 	case "ZSTD":
 		return processor.EncodingZstd
+	case "GZIP":
+		return processor.EncodingGzip
+	case "XZ":
+		return processor.EncodingXz
 	default:
 		return FromFile(filename)
 	}
@@ -40,6 +44,10 @@ func FromFile(file string) processor.EncodingType {
 		return processor.EncodingBzip2
 	case "zst":
 		return processor.EncodingZstd
+	case "gz", "tgz":
+		return processor.EncodingGzip
+	case "xz", "txz":
+		return processor.EncodingXz
 	default:
 		return processor.EncodingUnknown
 	}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/guacsec/guac/pkg/handler/collector/s3/bucket"
 	"github.com/guacsec/guac/pkg/handler/collector/s3/messaging"
+	"github.com/guacsec/guac/pkg/handler/collector/state"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -45,10 +46,14 @@ type S3CollectorConfig struct {
 	Queues                  string                           // optional (comma-separated list of queues/topics)
 	MpBuilder               messaging.MessageProviderBuilder // optional
 	BucketBuilder           bucket.BuildBucket               // optional
+	StateStore              state.Store                      // optional (records the ETags of the items collected, defaults to memory)
 	Poll                    bool
 }
 
 func NewS3Collector(cfg S3CollectorConfig) *S3Collector {
+	if cfg.StateStore == nil {
+		cfg.StateStore = state.NewMemoryStore()
+	}
 	s3collector := &S3Collector{
 		config: cfg,
 	}
@@ -72,6 +77,16 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 
 	item := s.config.S3Item
 	if len(item) > 0 {
+		etag, collected, err := isCollected(s, ctx, downloader, s.config.S3Bucket, item)
+		if err != nil {
+			logger.Errorf("could not check whether item %v was collected: %v", item, err)
+			return err
+		}
+		if collected {
+			logger.Infof("item %v already collected", item)
+			return nil
+		}
+
 		blob, err := downloader.DownloadFile(ctx, s.config.S3Bucket, item)
 		if err != nil {
 			logger.Errorf("could not download item %v: %v", item, err)
@@ -95,6 +110,9 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 			},
 		}
 		docChannel <- doc
+		if err := markCollected(s, ctx, s.config.S3Bucket, item, etag); err != nil {
+			return err
+		}
 	} else {
 		var token *string
 		const MaxKeys = 100
@@ -107,6 +125,15 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 			token = t
 
 			for _, item := range files {
+				etag, collected, err := isCollected(s, ctx, downloader, s.config.S3Bucket, item)
+				if err != nil {
+					logger.Errorf("could not check whether item %v was collected, skipping: %v", item, err)
+					continue
+				}
+				if collected {
+					continue
+				}
+
 				blob, err := downloader.DownloadFile(ctx, s.config.S3Bucket, item)
 				if err != nil {
 					logger.Errorf("could not download item %v, skipping: %v", item, err)
@@ -130,6 +157,9 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 					},
 				}
 				docChannel <- doc
+				if err := markCollected(s, ctx, s.config.S3Bucket, item, etag); err != nil {
+					return err
+				}
 			}
 
 			if len(files) < MaxKeys {
@@ -197,6 +227,16 @@ func retrieveWithPoll(s S3Collector, ctx context.Context, docChannel chan<- *pro
 						continue
 					}
 
+					etag, collected, err := isCollected(s, cncCtx, downloader, bucketName, item)
+					if err != nil {
+						logger.Errorf("could not check whether item %v was collected, skipping: %v", item, err)
+						continue
+					}
+					if collected {
+						logger.Debugf("item %v already collected, skipping", item)
+						continue
+					}
+
 					blob, err := downloader.DownloadFile(cncCtx, bucketName, item)
 					if err != nil {
 						logger.Errorf("could not download item %v, skipping: %v", item, err)
@@ -225,6 +265,9 @@ func retrieveWithPoll(s S3Collector, ctx context.Context, docChannel chan<- *pro
 						logger.Infof("Shutting down collector for queue %s...\n", queue)
 						return
 					}
+					if err := markCollected(s, cncCtx, bucketName, item, etag); err != nil {
+						logger.Errorf("%v", err)
+					}
 				}
 			}
 
@@ -234,6 +277,31 @@ func retrieveWithPoll(s S3Collector, ctx context.Context, docChannel chan<- *pro
 	wg.Wait()
 }
 
+// isCollected returns the ETag of the item, and whether that version of the
+// item was already collected.
+func isCollected(s S3Collector, ctx context.Context, downloader bucket.Bucket, bucketName string, item string) (string, bool, error) {
+	etag, err := downloader.GetETag(ctx, bucketName, item)
+	if err != nil {
+		return "", false, err
+	}
+	if etag == "" {
+		return "", false, nil
+	}
+	collected, err := state.HasCheckpoint(ctx, s.config.StateStore, S3CollectorType, bucketName+"/"+item, etag)
+	if err != nil {
+		return "", false, err
+	}
+	return etag, collected, nil
+}
+
+// markCollected records the version of the item that was collected.
+func markCollected(s S3Collector, ctx context.Context, bucketName string, item string, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	return s.config.StateStore.SetCheckpoint(ctx, S3CollectorType, bucketName+"/"+item, etag)
+}
+
 func getMessageProvider(s S3Collector, queue string) (messaging.MessageProvider, error) {
 	var err error
 	var mpBuilder messaging.MessageProviderBuilder
@@ -96,6 +96,10 @@ func (td *TestBucket) GetEncoding(ctx context.Context, bucket string, item strin
 	return "application/json", nil
 }
 
+func (td *TestBucket) GetETag(ctx context.Context, bucket string, item string) (string, error) {
+	return "\"etag\"", nil
+}
+
 type TestBucketBuilder struct {
 }
 
@@ -124,7 +128,7 @@ func testQueuesSplitPolling(t *testing.T, ctx context.Context) {
 
 	// create fake emitter and handler
 	var s []*processor.Document
-	em := func(d *processor.Document) error {
+	em := func(_ context.Context, d *processor.Document) error {
 		s = append(s, d)
 		return nil
 	}
@@ -183,7 +187,7 @@ func testNoPolling(t *testing.T, ctx context.Context) {
 
 	// create fake emitter and handler
 	var s []*processor.Document
-	em := func(d *processor.Document) error {
+	em := func(_ context.Context, d *processor.Document) error {
 		s = append(s, d)
 		return nil
 	}
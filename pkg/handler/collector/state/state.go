@@ -0,0 +1,170 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package state persists the checkpoints of collectors, so that a restarted
+// collector resumes where it stopped instead of emitting everything again.
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// ErrNoCheckpoint is returned (wrapped) by GetCheckpoint when nothing is
+// stored under the key.
+var ErrNoCheckpoint = errors.New("no checkpoint")
+
+// Store keeps a checkpoint per collector and key. Collectors choose the keys
+// and values, such as the digests, ETags, object versions or commit SHAs of
+// what they last collected. A Store may be shared by several collectors.
+type Store interface {
+	// GetCheckpoint returns the checkpoint stored under key for the
+	// collector, or an error wrapping ErrNoCheckpoint.
+	GetCheckpoint(ctx context.Context, collector, key string) (string, error)
+	// SetCheckpoint stores the checkpoint under key for the collector.
+	SetCheckpoint(ctx context.Context, collector, key, value string) error
+}
+
+// HasCheckpoint reports whether value is the checkpoint stored under key for
+// the collector.
+func HasCheckpoint(ctx context.Context, s Store, collector, key, value string) (bool, error) {
+	stored, err := s.GetCheckpoint(ctx, collector, key)
+	if errors.Is(err, ErrNoCheckpoint) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return stored == value, nil
+}
+
+type kvStore struct {
+	kv kv.Store
+}
+
+// NewKVStore returns a Store keeping the checkpoints of each collector in a
+// collection of a keyvalue store.
+func NewKVStore(store kv.Store) Store {
+	return &kvStore{kv: store}
+}
+
+// NewMemoryStore returns a Store that keeps the checkpoints for the life of
+// the process only.
+func NewMemoryStore() Store {
+	return NewKVStore(memmap.GetStore())
+}
+
+func collection(collector string) string {
+	return "collector-state:" + collector
+}
+
+func (s *kvStore) GetCheckpoint(ctx context.Context, collector, key string) (string, error) {
+	var value string
+	if err := s.kv.Get(ctx, collection(collector), key, &value); err != nil {
+		if errors.Is(err, kv.NotFoundError) {
+			return "", fmt.Errorf("%w for %s %q", ErrNoCheckpoint, collector, key)
+		}
+		return "", fmt.Errorf("unable to read checkpoint of %s %q: %w", collector, key, err)
+	}
+	return value, nil
+}
+
+func (s *kvStore) SetCheckpoint(ctx context.Context, collector, key, value string) error {
+	if err := s.kv.Set(ctx, collection(collector), key, value); err != nil {
+		return fmt.Errorf("unable to write checkpoint of %s %q: %w", collector, key, err)
+	}
+	return nil
+}
+
+type fileStore struct {
+	path string
+
+	mu          sync.Mutex
+	checkpoints map[string]map[string]string
+}
+
+// NewFileStore returns a Store that keeps the checkpoints in a JSON file,
+// which is created if it does not exist. The file is rewritten on every
+// update, so it should only be used by a single process.
+func NewFileStore(path string) (Store, error) {
+	s := &fileStore{path: path, checkpoints: map[string]map[string]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read collector state file %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &s.checkpoints); err != nil {
+		return nil, fmt.Errorf("unable to parse collector state file %s: %w", path, err)
+	}
+	return s, nil
+}
+
+func (s *fileStore) GetCheckpoint(_ context.Context, collector, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.checkpoints[collector][key]
+	if !ok {
+		return "", fmt.Errorf("%w for %s %q", ErrNoCheckpoint, collector, key)
+	}
+	return value, nil
+}
+
+func (s *fileStore) SetCheckpoint(_ context.Context, collector, key, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.checkpoints[collector] == nil {
+		s.checkpoints[collector] = map[string]string{}
+	}
+	s.checkpoints[collector][key] = value
+	return s.save()
+}
+
+// save writes the checkpoints to a temporary file and renames it over the
+// state file, so that a crash never leaves a partial file behind.
+func (s *fileStore) save() error {
+	data, err := json.Marshal(s.checkpoints)
+	if err != nil {
+		return fmt.Errorf("unable to marshal collector state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("unable to write collector state file %s: %w", s.path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("unable to write collector state file %s: %w", s.path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to write collector state file %s: %w", s.path, err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("unable to write collector state file %s: %w", s.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	fileStore, err := NewFileStore(filepath.Join(t.TempDir(), "state.json"))
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	stores := map[string]Store{
+		"memory": NewMemoryStore(),
+		"file":   fileStore,
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			if _, err := s.GetCheckpoint(ctx, "a", "key"); !errors.Is(err, ErrNoCheckpoint) {
+				t.Errorf("GetCheckpoint() of a missing key error = %v, want %v", err, ErrNoCheckpoint)
+			}
+			if err := s.SetCheckpoint(ctx, "a", "key", "v1"); err != nil {
+				t.Fatalf("SetCheckpoint() error = %v", err)
+			}
+			if err := s.SetCheckpoint(ctx, "b", "key", "other"); err != nil {
+				t.Fatalf("SetCheckpoint() error = %v", err)
+			}
+			if got, err := s.GetCheckpoint(ctx, "a", "key"); err != nil || got != "v1" {
+				t.Errorf("GetCheckpoint() = %q, %v, want %q", got, err, "v1")
+			}
+			for value, want := range map[string]bool{"v1": true, "v2": false} {
+				if got, err := HasCheckpoint(ctx, s, "a", "key", value); err != nil || got != want {
+					t.Errorf("HasCheckpoint(%q) = %v, %v, want %v", value, got, err, want)
+				}
+			}
+			if got, err := HasCheckpoint(ctx, s, "a", "missing", "v1"); err != nil || got {
+				t.Errorf("HasCheckpoint() of a missing key = %v, %v, want false", got, err)
+			}
+		})
+	}
+}
+
+func TestFileStore_Reopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.json")
+	s, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	if err := s.SetCheckpoint(ctx, "collector", "key", "value"); err != nil {
+		t.Fatalf("SetCheckpoint() error = %v", err)
+	}
+
+	reopened, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore() of an existing file error = %v", err)
+	}
+	if got, err := reopened.GetCheckpoint(ctx, "collector", "key"); err != nil || got != "value" {
+		t.Errorf("GetCheckpoint() after reopening = %q, %v, want %q", got, err, "value")
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("state directory has %d entries, want only the state file", len(entries))
+	}
+}
+
+func TestFileStore_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileStore(path); err == nil {
+		t.Error("NewFileStore() of an invalid file did not fail")
+	}
+}
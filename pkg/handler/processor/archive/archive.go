@@ -0,0 +1,213 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const (
+	// DefaultMaxSize is the default limit on the total size of the files
+	// unpacked from an archive.
+	DefaultMaxSize int64 = 1 << 30
+	// DefaultMaxEntries is the default limit on the number of entries of an
+	// archive.
+	DefaultMaxEntries = 10000
+)
+
+// ArchiveProcessor unpacks tar and zip archives into the documents they
+// contain. Archives exceeding its limits are rejected, so that a small
+// archive can not expand into more data than the ingestion can hold.
+type ArchiveProcessor struct {
+	// MaxSize limits the total size of the unpacked files, DefaultMaxSize
+	// if zero.
+	MaxSize int64
+	// MaxEntries limits the number of entries, including directories and
+	// skipped files, DefaultMaxEntries if zero.
+	MaxEntries int
+}
+
+func (a *ArchiveProcessor) ValidateSchema(i *processor.Document) error {
+	if i.Type != processor.DocumentArchive {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentArchive, i.Type)
+	}
+
+	switch i.Format {
+	case processor.FormatTar:
+		_, err := tar.NewReader(bytes.NewReader(i.Blob)).Next()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("invalid tar archive: %w", err)
+		}
+	case processor.FormatZip:
+		if _, err := zip.NewReader(bytes.NewReader(i.Blob), int64(len(i.Blob))); err != nil {
+			return fmt.Errorf("invalid zip archive: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported archive format: %v", i.Format)
+	}
+	return nil
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Every regular file in the archive becomes a sub-document of unknown type
+// and format, with its location in the archive recorded in the Path of its
+// source information. Directories, links and empty files are skipped.
+func (a *ArchiveProcessor) Unpack(i *processor.Document) ([]*processor.Document, error) {
+	return a.UnpackWithin(i, a.Limits())
+}
+
+// UnpackWithin is Unpack with what is left of the limits of the document the
+// archive was found in, so that nested archives and compressed files share
+// a single budget instead of starting a new one.
+func (a *ArchiveProcessor) UnpackWithin(i *processor.Document, l *Limits) ([]*processor.Document, error) {
+	if i.Type != processor.DocumentArchive {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentArchive, i.Type)
+	}
+
+	switch i.Format {
+	case processor.FormatTar:
+		return unpackTar(i, l)
+	case processor.FormatZip:
+		return unpackZip(i, l)
+	default:
+		return nil, fmt.Errorf("unsupported archive format: %v", i.Format)
+	}
+}
+
+// Limits returns new limits for a document, as configured on a.
+func (a *ArchiveProcessor) Limits() *Limits {
+	l := &Limits{size: a.MaxSize, entries: a.MaxEntries}
+	if l.size == 0 {
+		l.size = DefaultMaxSize
+	}
+	if l.entries == 0 {
+		l.entries = DefaultMaxEntries
+	}
+	return l
+}
+
+// Limits tracks what is left of the limits of a document while unpacking it:
+// the size of the data decompressed or unpacked from it and the number of
+// entries of the archives in it.
+type Limits struct {
+	size    int64
+	entries int
+}
+
+// entry accounts for the next entry of an archive.
+func (l *Limits) entry() error {
+	if l.entries == 0 {
+		return errors.New("archive exceeds the limit on the number of entries")
+	}
+	l.entries--
+	return nil
+}
+
+// Read reads a file of an archive or a decompressed document, failing once
+// the data read exceeds the size limit. The declared size of a file is not
+// trusted.
+func (l *Limits) Read(r io.Reader) ([]byte, error) {
+	blob, err := io.ReadAll(io.LimitReader(r, l.size+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(blob)) > l.size {
+		return nil, errors.New("document exceeds the limit on the unpacked size")
+	}
+	l.size -= int64(len(blob))
+	return blob, nil
+}
+
+func unpackTar(i *processor.Document, l *Limits) ([]*processor.Document, error) {
+	docs := []*processor.Document{}
+	r := tar.NewReader(bytes.NewReader(i.Blob))
+	for {
+		hdr, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tar archive: %w", err)
+		}
+		if err := l.entry(); err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg || hdr.Size == 0 {
+			continue
+		}
+		blob, err := l.Read(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from tar archive: %w", hdr.Name, err)
+		}
+		docs = append(docs, member(i, hdr.Name, blob))
+	}
+}
+
+func unpackZip(i *processor.Document, l *Limits) ([]*processor.Document, error) {
+	r, err := zip.NewReader(bytes.NewReader(i.Blob), int64(len(i.Blob)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zip archive: %w", err)
+	}
+	docs := []*processor.Document{}
+	for _, f := range r.File {
+		if err := l.entry(); err != nil {
+			return nil, err
+		}
+		if !f.Mode().IsRegular() || f.UncompressedSize64 == 0 {
+			continue
+		}
+		blob, err := readZipFile(f, l)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from zip archive: %w", f.Name, err)
+		}
+		docs = append(docs, member(i, f.Name, blob))
+	}
+	return docs, nil
+}
+
+func readZipFile(f *zip.File, l *Limits) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return l.Read(rc)
+}
+
+// member creates the sub-document for a file of the archive. Paths of nested
+// archives are joined, so that the path of the sub-document is relative to the
+// document the collector provided.
+func member(i *processor.Document, name string, blob []byte) *processor.Document {
+	sourceInformation := i.SourceInformation
+	sourceInformation.Path = path.Join(i.SourceInformation.Path, strings.TrimPrefix(path.Clean("/"+name), "/"))
+	return &processor.Document{
+		Blob:              blob,
+		Type:              processor.DocumentUnknown,
+		Format:            processor.FormatUnknown,
+		SourceInformation: sourceInformation,
+	}
+}
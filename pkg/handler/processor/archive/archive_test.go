@@ -0,0 +1,111 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestUnpackLimits(t *testing.T) {
+	files := []string{"a.json", "b.json", "c.json"}
+	content := []byte(`{"size": 16}   `)
+	archives := map[processor.FormatType][]byte{
+		processor.FormatTar: tarArchive(t, files, content),
+		processor.FormatZip: zipArchive(t, files, content),
+	}
+	tests := []struct {
+		name      string
+		processor ArchiveProcessor
+		wantErr   string
+	}{{
+		name: "default limits",
+	}, {
+		name:      "within limits",
+		processor: ArchiveProcessor{MaxSize: 3 * int64(len(content)), MaxEntries: 3},
+	}, {
+		name:      "too large",
+		processor: ArchiveProcessor{MaxSize: 3*int64(len(content)) - 1},
+		wantErr:   "unpacked size",
+	}, {
+		name:      "too many entries",
+		processor: ArchiveProcessor{MaxEntries: 2},
+		wantErr:   "number of entries",
+	}}
+	for format, blob := range archives {
+		for _, tt := range tests {
+			t.Run(string(format)+" "+tt.name, func(t *testing.T) {
+				docs, err := tt.processor.Unpack(&processor.Document{
+					Blob:   blob,
+					Type:   processor.DocumentArchive,
+					Format: format,
+				})
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Errorf("Unpack() error = %v, want %q", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("Unpack() error = %v", err)
+				}
+				if len(docs) != len(files) {
+					t.Errorf("Unpack() returned %d documents, want %d", len(docs), len(files))
+				}
+			})
+		}
+	}
+}
+
+func tarArchive(t *testing.T, names []string, content []byte) []byte {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, name := range names {
+		if err := w.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, names []string, content []byte) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
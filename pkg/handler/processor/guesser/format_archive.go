@@ -0,0 +1,47 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"bytes"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var (
+	// tarMagic is found at offset 257 of the first header of ustar, pax and
+	// GNU tar archives.
+	tarMagic       = []byte("ustar")
+	tarMagicOffset = 257
+	// zipMagic is the signature of a local file header, zipEmptyMagic the
+	// signature of the end of central directory record that starts an empty
+	// zip archive.
+	zipMagic      = []byte("PK\x03\x04")
+	zipEmptyMagic = []byte("PK\x05\x06")
+)
+
+type archiveFormatGuesser struct{}
+
+// GuessFormat identifies tar and zip archives by their magic numbers
+func (_ *archiveFormatGuesser) GuessFormat(blob []byte) processor.FormatType {
+	if len(blob) >= tarMagicOffset+len(tarMagic) && bytes.Equal(blob[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic) {
+		return processor.FormatTar
+	}
+	if bytes.HasPrefix(blob, zipMagic) || bytes.HasPrefix(blob, zipEmptyMagic) {
+		return processor.FormatZip
+	}
+	return processor.FormatUnknown
+}
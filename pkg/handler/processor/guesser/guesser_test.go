@@ -79,6 +79,16 @@ func Test_GuessDocument(t *testing.T) {
 		},
 		expectedType:   processor.DocumentSPDX,
 		expectedFormat: processor.FormatJSON,
+	}, {
+		name: "valid spdx 3 Document",
+		document: &processor.Document{
+			Blob:              testdata.Spdx3Example,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectedType:   processor.DocumentSPDX3,
+		expectedFormat: processor.FormatJSON,
 	}, {
 		name: "valid DSSE Document",
 		document: &processor.Document{
@@ -0,0 +1,36 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/spdx"
+)
+
+type spdx3TypeGuesser struct{}
+
+func (_ *spdx3TypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
+	switch format {
+	case processor.FormatJSON:
+		// SPDX 3 documents are JSON-LD graphs, of an SPDX 3 @context, with
+		// the SpdxDocument element describing the document.
+		spdxDoc, err := spdx.ParseSPDX3(blob)
+		if err == nil && spdxDoc.SpdxDocument() != nil {
+			return processor.DocumentSPDX3
+		}
+	}
+	return processor.DocumentUnknown
+}
@@ -18,17 +18,20 @@ package process
 import (
 	"bytes"
 	"compress/bzip2"
+	"compress/gzip"
 	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"path/filepath"
 	"strings"
+	"time"
 
 	uuid "github.com/gofrs/uuid"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/archive"
 	"github.com/guacsec/guac/pkg/handler/processor/csaf"
 	"github.com/guacsec/guac/pkg/handler/processor/cyclonedx"
 	"github.com/guacsec/guac/pkg/handler/processor/deps_dev"
@@ -39,8 +42,13 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor/scorecard"
 	"github.com/guacsec/guac/pkg/handler/processor/spdx"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -54,11 +62,13 @@ func init() {
 	_ = RegisterDocumentProcessor(&ite6.ITE6Processor{}, processor.DocumentITE6Vul)
 	_ = RegisterDocumentProcessor(&dsse.DSSEProcessor{}, processor.DocumentDSSE)
 	_ = RegisterDocumentProcessor(&spdx.SPDXProcessor{}, processor.DocumentSPDX)
+	_ = RegisterDocumentProcessor(&spdx.SPDX3Processor{}, processor.DocumentSPDX3)
 	_ = RegisterDocumentProcessor(&csaf.CSAFProcessor{}, processor.DocumentCsaf)
 	_ = RegisterDocumentProcessor(&open_vex.OpenVEXProcessor{}, processor.DocumentOpenVEX)
 	_ = RegisterDocumentProcessor(&scorecard.ScorecardProcessor{}, processor.DocumentScorecard)
 	_ = RegisterDocumentProcessor(&cyclonedx.CycloneDXProcessor{}, processor.DocumentCycloneDX)
 	_ = RegisterDocumentProcessor(&deps_dev.DepsDev{}, processor.DocumentDepsDev)
+	_ = RegisterDocumentProcessor(&archive.ArchiveProcessor{}, processor.DocumentArchive)
 }
 
 func RegisterDocumentProcessor(p processor.DocumentProcessor, d processor.DocumentType) error {
@@ -85,19 +95,26 @@ func Subscribe(ctx context.Context, em collector.Emitter) error {
 		return fmt.Errorf("[processor: %s] failed to create new pubsub: %w", uuidString, err)
 	}
 
-	// should still continue if there are errors since problem is with individual documents
-	processFunc := func(d []byte) error {
+	// should still continue if there are errors since problem is with individual documents.
+	// Documents that still fail after the retries are published to the failed documents
+	processFunc := func(ctx context.Context, d []byte) error {
 
 		doc := processor.Document{}
 		err := json.Unmarshal(d, &doc)
 		if err != nil {
 			logger.Errorf("[processor: %s] failed unmarshal the document bytes: %v", uuidString, err)
+			err = emitter.PublishFailed(ctx, emitter.SubjectNameDocCollected, d, emitter.NewStageError(emitter.StageDecode, err), 1)
+			if err != nil {
+				logger.Errorf("[processor: %s] failed to publish failed document: %v", uuidString, err)
+			}
 			return nil
 		}
 
-		err = em(&doc)
+		err = emitter.Retry(ctx, emitter.DefaultRetryPolicy, emitter.SubjectNameDocCollected, d, func(ctx context.Context, _ []byte) error {
+			return em(ctx, &doc)
+		})
 		if err != nil {
-			logger.Error("[processor: %s] failed transportFunc: %v", uuidString, err)
+			logger.Errorf("[processor: %s] failed transportFunc: %v", uuidString, err)
 			return nil
 		}
 		return nil
@@ -113,27 +130,52 @@ func Subscribe(ctx context.Context, em collector.Emitter) error {
 // Process processes the documents received from the collector to determine
 // their format and document type.
 func Process(ctx context.Context, i *processor.Document) (processor.DocumentTree, error) {
-	node, err := processHelper(ctx, i)
+	ctx, span := tracing.Start(ctx, "process", trace.WithAttributes(attribute.String("guac.source", i.SourceInformation.Source)))
+	start := time.Now()
+	node, err := processHelper(ctx, i, archiveLimits())
+	// the type of the document is only known once processed
+	span.SetAttributes(attribute.String("guac.document_type", string(i.Type)))
+	metrics.FromContext(ctx).ObserveSummaryVec(metrics.ProcessSeconds, time.Since(start).Seconds(), string(i.Type))
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
 	return processor.DocumentTree(node), nil
 }
 
-func processHelper(ctx context.Context, doc *processor.Document) (*processor.DocumentNode, error) {
-	ds, err := processDocument(ctx, doc)
+// archiveLimits returns the limits on the data unpacked from a document and
+// the documents found in it, as configured on the archive processor.
+func archiveLimits() *archive.Limits {
+	if a, ok := documentProcessors[processor.DocumentArchive].(*archive.ArchiveProcessor); ok {
+		return a.Limits()
+	}
+	return (&archive.ArchiveProcessor{}).Limits()
+}
+
+func processHelper(ctx context.Context, doc *processor.Document, limits *archive.Limits) (*processor.DocumentNode, error) {
+	ds, err := processDocument(ctx, doc, limits)
 	if err != nil {
 		return nil, err
 	}
 
-	children := make([]*processor.DocumentNode, len(ds))
-	for i, d := range ds {
+	children := make([]*processor.DocumentNode, 0, len(ds))
+	for _, d := range ds {
+		path := d.SourceInformation.Path
 		d.SourceInformation = doc.SourceInformation
-		n, err := processHelper(ctx, d)
+		if path != "" {
+			d.SourceInformation.Path = path
+		}
+		n, err := processHelper(ctx, d, limits)
 		if err != nil {
+			// archives commonly bundle files other than documents, such as
+			// READMEs or licenses, which must not fail the whole archive
+			if doc.Type == processor.DocumentArchive {
+				logging.FromContext(ctx).Warnf("skipping %s in archive %s: %v", d.SourceInformation.Path, doc.SourceInformation.Source, err)
+				continue
+			}
 			return nil, err
 		}
-		children[i] = n
+		children = append(children, n)
 	}
 	return &processor.DocumentNode{
 		Document: doc,
@@ -141,8 +183,8 @@ func processHelper(ctx context.Context, doc *processor.Document) (*processor.Doc
 	}, nil
 }
 
-func processDocument(ctx context.Context, i *processor.Document) ([]*processor.Document, error) {
-	if err := decodeDocument(ctx, i); err != nil {
+func processDocument(ctx context.Context, i *processor.Document, limits *archive.Limits) ([]*processor.Document, error) {
+	if err := decodeDocument(ctx, i, limits); err != nil {
 		return nil, err
 	}
 
@@ -159,7 +201,7 @@ func processDocument(ctx context.Context, i *processor.Document) ([]*processor.D
 		return nil, err
 	}
 
-	ds, err := unpackDocument(i)
+	ds, err := unpackDocument(i, limits)
 	if err != nil {
 		return nil, fmt.Errorf("unable to unpack document: %w", err)
 	}
@@ -189,6 +231,8 @@ func validateFormat(i *processor.Document) error {
 		if err := xml.Unmarshal(i.Blob, &struct{}{}); err != nil {
 			return fmt.Errorf("invalid XML document")
 		}
+	case processor.FormatTar, processor.FormatZip:
+		// checked by the archive processor
 	case processor.FormatUnknown:
 		return nil
 	default:
@@ -206,20 +250,29 @@ func validateDocument(i *processor.Document) error {
 	return p.ValidateSchema(i) // nolint:wrapcheck
 }
 
-func unpackDocument(i *processor.Document) ([]*processor.Document, error) {
+func unpackDocument(i *processor.Document, limits *archive.Limits) ([]*processor.Document, error) {
 	p, ok := documentProcessors[i.Type]
 	if !ok {
 		return nil, fmt.Errorf("no document processor registered for type: %s", i.Type)
 	}
+	// nested archives share the limits of the document they are in
+	if a, ok := p.(*archive.ArchiveProcessor); ok {
+		return a.UnpackWithin(i, limits) // nolint:wrapcheck
+	}
 	return p.Unpack(i) // nolint:wrapcheck
 }
 
-func decodeDocument(ctx context.Context, i *processor.Document) error {
+func decodeDocument(ctx context.Context, i *processor.Document, limits *archive.Limits) error {
 	logger := logging.FromContext(ctx)
 	var reader io.Reader
 	var err error
 	if i.Encoding == "" {
-		ext := filepath.Ext(i.SourceInformation.Source)
+		// documents unpacked from an archive are named by their path in it
+		name := i.SourceInformation.Source
+		if i.SourceInformation.Path != "" {
+			name = i.SourceInformation.Path
+		}
+		ext := filepath.Ext(name)
 		encoding, ok := processor.EncodingExts[strings.ToLower(ext)]
 		if ok {
 			i.Encoding = encoding
@@ -234,17 +287,29 @@ func decodeDocument(ctx context.Context, i *processor.Document) error {
 		if err != nil {
 			return fmt.Errorf("unable to create zstd reader: %w", err)
 		}
+	case processor.EncodingGzip:
+		reader, err = gzip.NewReader(bytes.NewReader(i.Blob))
+		if err != nil {
+			return fmt.Errorf("unable to create gzip reader: %w", err)
+		}
+	case processor.EncodingXz:
+		reader, err = xz.NewReader(bytes.NewReader(i.Blob))
+		if err != nil {
+			return fmt.Errorf("unable to create xz reader: %w", err)
+		}
 	}
 	if reader != nil {
-		if err := decompressDocument(i, reader); err != nil {
+		if err := decompressDocument(i, reader, limits); err != nil {
 			return fmt.Errorf("unable to decode document: %w", err)
 		}
 	}
 	return nil
 }
 
-func decompressDocument(i *processor.Document, reader io.Reader) error {
-	uncompressed, err := io.ReadAll(reader)
+// decompressDocument replaces the blob of the document with its decompressed
+// content, which counts against the limits on the unpacked size.
+func decompressDocument(i *processor.Document, reader io.Reader, limits *archive.Limits) error {
+	uncompressed, err := limits.Read(reader)
 	if err != nil {
 		return fmt.Errorf("unable to decompress document: %w", err)
 	}
@@ -16,7 +16,13 @@
 package process
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
@@ -24,6 +30,7 @@ import (
 	"github.com/guacsec/guac/internal/testing/simpledoc"
 	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/archive"
 	"github.com/guacsec/guac/pkg/handler/processor/guesser"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -777,3 +784,196 @@ func testPublish(ctx context.Context, d *processor.Document) error {
 	return nil
 }
 */
+
+func Test_ProcessArchive(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	files := map[string][]byte{
+		"sboms/small-spdx.json": testdata.SpdxExampleSmall,
+		"sboms/cdx.json.gz":     gzipBytes(t, testdata.CycloneDXDistrolessExample),
+		"README.md":             []byte("not a document"),
+	}
+	expected := map[string]processor.DocumentType{
+		"sboms/small-spdx.json": processor.DocumentSPDX,
+		"sboms/cdx.json.gz":     processor.DocumentCycloneDX,
+	}
+	testCases := []struct {
+		name             string
+		doc              processor.Document
+		expectedEncoding processor.EncodingType
+		expectedFormat   processor.FormatType
+	}{{
+		name: "tar.gz",
+		doc: processor.Document{
+			Blob:              gzipBytes(t, tarBytes(t, files)),
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{Collector: "test", Source: "file:///sboms.tar.gz"},
+		},
+		expectedEncoding: processor.EncodingGzip,
+		expectedFormat:   processor.FormatTar,
+	}, {
+		name: "zip without extension",
+		doc: processor.Document{
+			Blob:              zipBytes(t, files),
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{Collector: "test", Source: "S3"},
+		},
+		expectedFormat: processor.FormatZip,
+	}}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			docTree, err := Process(ctx, &tt.doc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if docTree.Document.Type != processor.DocumentArchive || docTree.Document.Format != tt.expectedFormat || docTree.Document.Encoding != tt.expectedEncoding {
+				t.Errorf("got archive %v/%v/%v, expected %v/%v/%v", docTree.Document.Type, docTree.Document.Format, docTree.Document.Encoding,
+					processor.DocumentArchive, tt.expectedFormat, tt.expectedEncoding)
+			}
+			got := map[string]processor.DocumentType{}
+			for _, c := range docTree.Children {
+				si := c.Document.SourceInformation
+				if si.Collector != tt.doc.SourceInformation.Collector || si.Source != tt.doc.SourceInformation.Source {
+					t.Errorf("source information of %s not propagated: %+v", si.Path, si)
+				}
+				got[si.Path] = c.Document.Type
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("got archive members %v, expected %v", got, expected)
+			}
+		})
+	}
+}
+
+func Test_ProcessLimits(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	inner := tarBytes(t, map[string][]byte{"b.json": testdata.SpdxExampleSmall})
+	outer := tarBytes(t, map[string][]byte{"a.json": testdata.SpdxExampleSmall, "inner.tar": inner})
+	testCases := []struct {
+		name         string
+		limits       archive.ArchiveProcessor
+		doc          processor.Document
+		wantErr      string
+		wantChildren []string
+	}{{
+		name:   "decompressed document too large",
+		limits: archive.ArchiveProcessor{MaxSize: int64(len(testdata.SpdxExampleSmall)) - 1},
+		doc: processor.Document{
+			Blob:              gzipBytes(t, testdata.SpdxExampleSmall),
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{Source: "file:///spdx.json.gz"},
+		},
+		wantErr: "unpacked size",
+	}, {
+		name:   "compressed archive too large",
+		limits: archive.ArchiveProcessor{MaxSize: int64(len(outer)) - 1},
+		doc: processor.Document{
+			Blob:              gzipBytes(t, outer),
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{Source: "file:///sboms.tar.gz"},
+		},
+		wantErr: "unpacked size",
+	}, {
+		// the outer archive has a directory and two files, which leaves
+		// nothing for the entry of the nested one
+		name:   "nested archive shares the entry limit",
+		limits: archive.ArchiveProcessor{MaxEntries: 3},
+		doc: processor.Document{
+			Blob:              outer,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{Source: "file:///sboms.tar"},
+		},
+		wantChildren: []string{"a.json"},
+	}, {
+		name:   "nested archive within limits",
+		limits: archive.ArchiveProcessor{MaxEntries: 5},
+		doc: processor.Document{
+			Blob:              outer,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{Source: "file:///sboms.tar"},
+		},
+		wantChildren: []string{"a.json", "inner.tar"},
+	}}
+
+	defer func() { documentProcessors[processor.DocumentArchive] = &archive.ArchiveProcessor{} }()
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := tt.limits
+			documentProcessors[processor.DocumentArchive] = &limits
+			docTree, err := Process(ctx, &tt.doc)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Process() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, c := range docTree.Children {
+				got = append(got, c.Document.SourceInformation.Path)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.wantChildren) {
+				t.Errorf("got archive members %v, expected %v", got, tt.wantChildren)
+			}
+		})
+	}
+}
+
+func gzipBytes(t *testing.T, b []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func tarBytes(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	if err := w.WriteHeader(&tar.Header{Name: "sboms/", Typeflag: tar.TypeDir, Mode: 0755}); err != nil {
+		t.Fatal(err)
+	}
+	for name, b := range files {
+		if err := w.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(b))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func zipBytes(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, b := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
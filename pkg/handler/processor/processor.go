@@ -36,8 +36,30 @@ type Document struct {
 	Format            FormatType
 	Encoding          EncodingType
 	SourceInformation SourceInformation
+	// Signatures are the detached signatures found for the document, such
+	// as a cosign signature or a Sigstore bundle stored next to it.
+	Signatures []Signature `json:",omitempty"`
 }
 
+// Signature is a detached signature over the blob of a document. A
+// compressed document is decompressed before its signatures are verified, so
+// the signature must be over the decompressed document.
+type Signature struct {
+	// Type is the type of the verifier of the signature, one of the
+	// Signature* types
+	Type string
+	// Blob is the signature as found, for example a base64 encoded cosign
+	// signature, a Sigstore bundle or an armored PGP signature
+	Blob []byte
+}
+
+// Signature* are the types of detached signatures
+const (
+	SignatureCosign         = "cosign"
+	SignatureSigstoreBundle = "sigstore-bundle"
+	SignaturePGP            = "pgp"
+)
+
 // DocumentTree describes the output of a document tree that resulted from
 // processing a node
 type DocumentTree *DocumentNode
@@ -58,6 +80,7 @@ const (
 	DocumentITE6Vul          DocumentType = "ITE6VUL"
 	DocumentDSSE             DocumentType = "DSSE"
 	DocumentSPDX             DocumentType = "SPDX"
+	DocumentSPDX3            DocumentType = "SPDX3"
 	DocumentJsonLines        DocumentType = "JSON_LINES"
 	DocumentScorecard        DocumentType = "SCORECARD"
 	DocumentCycloneDX        DocumentType = "CycloneDX"
@@ -65,6 +88,7 @@ const (
 	DocumentCsaf             DocumentType = "CSAF"
 	DocumentOpenVEX          DocumentType = "OPEN_VEX"
 	DocumentIngestPredicates DocumentType = "INGEST_PREDICATES"
+	DocumentArchive          DocumentType = "ARCHIVE"
 	DocumentUnknown          DocumentType = "UNKNOWN"
 )
 
@@ -76,6 +100,8 @@ const (
 	FormatJSON      FormatType = "JSON"
 	FormatJSONLines FormatType = "JSON_LINES"
 	FormatXML       FormatType = "XML"
+	FormatTar       FormatType = "TAR"
+	FormatZip       FormatType = "ZIP"
 	FormatUnknown   FormatType = "UNKNOWN"
 )
 
@@ -84,12 +110,18 @@ type EncodingType string
 const (
 	EncodingBzip2   EncodingType = "BZIP2"
 	EncodingZstd    EncodingType = "ZSTD"
+	EncodingGzip    EncodingType = "GZIP"
+	EncodingXz      EncodingType = "XZ"
 	EncodingUnknown EncodingType = "UNKNOWN"
 )
 
 var EncodingExts = map[string]EncodingType{
 	".bz2": EncodingBzip2,
 	".zst": EncodingZstd,
+	".gz":  EncodingGzip,
+	".tgz": EncodingGzip,
+	".xz":  EncodingXz,
+	".txz": EncodingXz,
 }
 
 // SourceInformation provides additional information about where the document comes from
@@ -98,4 +130,7 @@ type SourceInformation struct {
 	Collector string
 	// Source describes the source which the collector got this information
 	Source string
+	// Path describes the location of the document inside the archive it was
+	// unpacked from. It is empty for documents that did not come from an archive.
+	Path string
 }
@@ -0,0 +1,65 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// SPDX3Processor processes SPDX 3 documents.
+// Currently only supports JSON-LD SPDX 3 documents
+type SPDX3Processor struct {
+}
+
+// ValidateSchema checks the structure of the document with CheckStructure.
+//
+// TODO: validate against the SPDX 3.0 JSON schema
+// (https://spdx.org/schema/3.0.1/spdx-json-schema.json) once it is vendored.
+// The schema is a draft 2020-12 schema, which gojsonschema does not support.
+// Until then, a document that CheckStructure accepts may still be invalid
+// SPDX 3.
+func (p *SPDX3Processor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentSPDX3 {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSPDX3, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		doc, err := ParseSPDX3(d.Blob)
+		if err != nil {
+			return err
+		}
+		return doc.CheckStructure()
+	}
+
+	return fmt.Errorf("unable to support parsing of SPDX 3 document format: %v", d.Format)
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *SPDX3Processor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentSPDX3 {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSPDX3, d.Type)
+	}
+
+	// SPDX 3 doesn't unpack into additional documents at the moment.
+	return []*processor.Document{}, nil
+}
@@ -0,0 +1,257 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+var jsonLD = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// spdx3ContextPrefix prefixes the JSON-LD contexts of the SPDX 3 versions,
+// such as https://spdx.org/rdf/3.0.1/spdx-context.jsonld
+const spdx3ContextPrefix = "https://spdx.org/rdf/3."
+
+// SPDX3Document is an SPDX 3 document serialized as JSON-LD. All of its
+// elements, including the SpdxDocument itself, are in the graph.
+type SPDX3Document struct {
+	Context any             `json:"@context"`
+	Graph   []*SPDX3Element `json:"@graph"`
+
+	elements map[string]*SPDX3Element
+}
+
+// SPDX3Element holds the properties, from the Core, Software, SimpleLicensing
+// and Security profiles, of the elements of the graph that GUAC ingests.
+type SPDX3Element struct {
+	Type   string `json:"type"`
+	SpdxID string `json:"spdxId"`
+	// type and spdxId are aliases of the JSON-LD @type and @id keywords,
+	// which some serializers emit instead. Blank nodes, like CreationInfo,
+	// only have an @id.
+	LDType string `json:"@type"`
+	LDID   string `json:"@id"`
+
+	Name               string                    `json:"name"`
+	Comment            string                    `json:"comment"`
+	CreationInfo       *SPDX3CreationInfo        `json:"creationInfo"`
+	VerifiedUsing      []SPDX3Hash               `json:"verifiedUsing"`
+	ExternalIdentifier []SPDX3ExternalIdentifier `json:"externalIdentifier"`
+
+	// CreationInfo
+	SpecVersion string `json:"specVersion"`
+	Created     string `json:"created"`
+
+	// SpdxDocument and Bom
+	RootElement []string `json:"rootElement"`
+	Element     []string `json:"element"`
+
+	// Relationship and LifecycleScopedRelationship
+	From             string   `json:"from"`
+	To               []string `json:"to"`
+	RelationshipType string   `json:"relationshipType"`
+	Scope            string   `json:"scope"`
+
+	// Software profile
+	PackageVersion string `json:"software_packageVersion"`
+	PackageURL     string `json:"software_packageUrl"`
+	CopyrightText  string `json:"software_copyrightText"`
+
+	// SimpleLicensing profile
+	LicenseExpression  string                 `json:"simplelicensing_licenseExpression"`
+	LicenseListVersion string                 `json:"simplelicensing_licenseListVersion"`
+	CustomIDToURI      []SPDX3DictionaryEntry `json:"simplelicensing_customIdToUri"`
+	LicenseText        string                 `json:"simplelicensing_licenseText"`
+
+	// Security profile
+	AssessedElement   string  `json:"security_assessedElement"`
+	PublishedTime     string  `json:"security_publishedTime"`
+	StatusNotes       string  `json:"security_statusNotes"`
+	ActionStatement   string  `json:"security_actionStatement"`
+	ImpactStatement   string  `json:"security_impactStatement"`
+	JustificationType string  `json:"security_justificationType"`
+	Score             float64 `json:"security_score"`
+	VectorString      string  `json:"security_vectorString"`
+}
+
+// SPDX3CreationInfo is the creationInfo of an element. It is either inline or
+// refers to a CreationInfo blank node of the graph by its ID.
+type SPDX3CreationInfo struct {
+	ID          string `json:"@id"`
+	SpecVersion string `json:"specVersion"`
+	Created     string `json:"created"`
+}
+
+func (c *SPDX3CreationInfo) UnmarshalJSON(b []byte) error {
+	var id string
+	if err := jsonLD.Unmarshal(b, &id); err == nil {
+		c.ID = id
+		return nil
+	}
+	type creationInfo SPDX3CreationInfo
+	return jsonLD.Unmarshal(b, (*creationInfo)(c))
+}
+
+// SPDX3Hash is the Hash integrity method of the verifiedUsing property.
+type SPDX3Hash struct {
+	Type      string `json:"type"`
+	Algorithm string `json:"algorithm"`
+	HashValue string `json:"hashValue"`
+}
+
+type SPDX3ExternalIdentifier struct {
+	ExternalIdentifierType string `json:"externalIdentifierType"`
+	Identifier             string `json:"identifier"`
+}
+
+type SPDX3DictionaryEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ParseSPDX3 decodes the SPDX 3 JSON-LD document.
+func ParseSPDX3(blob []byte) (*SPDX3Document, error) {
+	var doc SPDX3Document
+	if err := jsonLD.Unmarshal(blob, &doc); err != nil {
+		return nil, err
+	}
+	if !doc.isSPDX3Context() {
+		return nil, fmt.Errorf("document @context is not an SPDX 3 context")
+	}
+	doc.elements = map[string]*SPDX3Element{}
+	for _, e := range doc.Graph {
+		if e == nil {
+			continue
+		}
+		if e.Type == "" {
+			e.Type = e.LDType
+		}
+		if e.SpdxID == "" && !strings.HasPrefix(e.LDID, "_:") {
+			e.SpdxID = e.LDID
+		}
+		if e.SpdxID != "" {
+			doc.elements[e.SpdxID] = e
+		}
+	}
+	return &doc, nil
+}
+
+func (d *SPDX3Document) isSPDX3Context() bool {
+	var contexts []any
+	switch c := d.Context.(type) {
+	case string:
+		contexts = []any{c}
+	case []any:
+		contexts = c
+	}
+	for _, c := range contexts {
+		if s, ok := c.(string); ok && strings.HasPrefix(s, spdx3ContextPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckStructure checks that the document has the shape GUAC relies on: a
+// single SpdxDocument, of a 3.x specVersion, and elements and relationships
+// with the required properties. It is not a validation against the SPDX 3
+// JSON schema or SHACL model; any other property is left unchecked.
+func (d *SPDX3Document) CheckStructure() error {
+	var spdxDocuments int
+	for i, e := range d.Graph {
+		if e == nil {
+			return fmt.Errorf("element %d of the graph is empty", i)
+		}
+		if e.Type == "" {
+			return fmt.Errorf("element %d of the graph has no type", i)
+		}
+		if e.Type == "CreationInfo" {
+			continue
+		}
+		if e.SpdxID == "" {
+			return fmt.Errorf("%s element %d of the graph has no spdxId", e.Type, i)
+		}
+		if e.CreationInfo == nil {
+			return fmt.Errorf("element %s has no creationInfo", e.SpdxID)
+		}
+		ci := d.CreationInfo(e)
+		if ci == nil {
+			return fmt.Errorf("creationInfo %s of element %s not found", e.CreationInfo.ID, e.SpdxID)
+		}
+		if !strings.HasPrefix(ci.SpecVersion, "3.") {
+			return fmt.Errorf("element %s has specVersion %q, expected 3.x", e.SpdxID, ci.SpecVersion)
+		}
+		if _, err := time.Parse(time.RFC3339, ci.Created); err != nil {
+			return fmt.Errorf("element %s has invalid created time %q: %w", e.SpdxID, ci.Created, err)
+		}
+		if e.IsRelationship() {
+			if e.From == "" || len(e.To) == 0 || e.RelationshipType == "" {
+				return fmt.Errorf("relationship %s requires from, to and relationshipType", e.SpdxID)
+			}
+		}
+		if e.Type == "SpdxDocument" {
+			spdxDocuments++
+		}
+	}
+	if spdxDocuments != 1 {
+		return fmt.Errorf("expected a single SpdxDocument element, found %d", spdxDocuments)
+	}
+	return nil
+}
+
+// Element returns the element of the graph with the spdxId, or nil when the
+// element is not in the document.
+func (d *SPDX3Document) Element(spdxID string) *SPDX3Element {
+	return d.elements[spdxID]
+}
+
+// SpdxDocument returns the SpdxDocument element of the document.
+func (d *SPDX3Document) SpdxDocument() *SPDX3Element {
+	for _, e := range d.Graph {
+		if e != nil && e.Type == "SpdxDocument" {
+			return e
+		}
+	}
+	return nil
+}
+
+// CreationInfo returns the creationInfo of the element, resolving references
+// to the CreationInfo nodes of the graph.
+func (d *SPDX3Document) CreationInfo(e *SPDX3Element) *SPDX3CreationInfo {
+	if e.CreationInfo == nil {
+		return nil
+	}
+	if e.CreationInfo.ID == "" || e.CreationInfo.SpecVersion != "" {
+		return e.CreationInfo
+	}
+	for _, ci := range d.Graph {
+		if ci != nil && ci.Type == "CreationInfo" && ci.LDID == e.CreationInfo.ID {
+			return &SPDX3CreationInfo{ID: ci.LDID, SpecVersion: ci.SpecVersion, Created: ci.Created}
+		}
+	}
+	return nil
+}
+
+// IsRelationship returns whether the element is a Relationship or one of its
+// subclasses, like LifecycleScopedRelationship or the vulnerability
+// assessment relationships of the Security profile.
+func (e *SPDX3Element) IsRelationship() bool {
+	return strings.HasSuffix(e.Type, "Relationship")
+}
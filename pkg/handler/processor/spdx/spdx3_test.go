@@ -0,0 +1,160 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestSPDX3Processor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "SPDX 3 document",
+		doc: processor.Document{
+			Blob:   testdata.Spdx3Example,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentSPDX3,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		expected:  []*processor.Document{},
+		expectErr: false,
+	}, {
+		name: "Incorrect type",
+		doc: processor.Document{
+			Blob:   testdata.Spdx3Example,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		expected:  nil,
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := SPDX3Processor{}
+			actual, err := d.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("SPDX3Processor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("SPDX3Processor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSPDX3Processor_ValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		blob      []byte
+		format    processor.FormatType
+		expectErr bool
+	}{{
+		name:      "valid SPDX 3 document",
+		blob:      testdata.Spdx3Example,
+		format:    processor.FormatJSON,
+		expectErr: false,
+	}, {
+		name: "valid SPDX 3 document with inline creationInfo",
+		blob: []byte(`{
+			"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+			"@graph": [{
+				"type": "SpdxDocument",
+				"spdxId": "https://example.com/document",
+				"creationInfo": {"type": "CreationInfo", "specVersion": "3.0.1", "created": "2024-05-02T10:15:00Z"}
+			}]
+		}`),
+		format:    processor.FormatJSON,
+		expectErr: false,
+	}, {
+		name:      "invalid SPDX 3 document",
+		blob:      testdata.Spdx3InvalidExample,
+		format:    processor.FormatJSON,
+		expectErr: true,
+	}, {
+		name:      "SPDX 2 document",
+		blob:      testdata.SpdxExampleSmall,
+		format:    processor.FormatJSON,
+		expectErr: true,
+	}, {
+		name: "relationship without to",
+		blob: []byte(`{
+			"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+			"@graph": [{
+				"type": "CreationInfo",
+				"@id": "_:creationinfo",
+				"specVersion": "3.0.1",
+				"created": "2024-05-02T10:15:00Z"
+			}, {
+				"type": "SpdxDocument",
+				"spdxId": "https://example.com/document",
+				"creationInfo": "_:creationinfo"
+			}, {
+				"type": "Relationship",
+				"spdxId": "https://example.com/relationship",
+				"creationInfo": "_:creationinfo",
+				"from": "https://example.com/document",
+				"relationshipType": "describes"
+			}]
+		}`),
+		format:    processor.FormatJSON,
+		expectErr: true,
+	}, {
+		name: "unknown creationInfo",
+		blob: []byte(`{
+			"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+			"@graph": [{
+				"type": "SpdxDocument",
+				"spdxId": "https://example.com/document",
+				"creationInfo": "_:creationinfo"
+			}]
+		}`),
+		format:    processor.FormatJSON,
+		expectErr: true,
+	}, {
+		name:      "invalid format supported",
+		blob:      testdata.Spdx3Example,
+		format:    processor.FormatUnknown,
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := SPDX3Processor{}
+			err := d.ValidateSchema(&processor.Document{
+				Blob:   tt.blob,
+				Format: tt.format,
+				Type:   processor.DocumentSPDX3,
+			})
+			if (err != nil) != tt.expectErr {
+				t.Errorf("SPDX3Processor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
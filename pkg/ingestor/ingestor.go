@@ -18,7 +18,6 @@ package ingestor
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -26,32 +25,41 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/collectsub/collectsub/input"
+	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/process"
 	"github.com/guacsec/guac/pkg/ingestor/parser"
 	parser_common "github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Synchronously ingest document using GraphQL endpoint
-func Ingest(ctx context.Context, d *processor.Document, graphqlEndpoint string, csubClient csub_client.Client) error {
+// Synchronously ingest document using GraphQL client
+func Ingest(ctx context.Context, d *processor.Document, gqlclient graphql.Client, csubClient csub_client.Client) (err error) {
+	ctx, span := tracing.Start(ctx, "ingest", trace.WithAttributes(
+		attribute.String("guac.collector", d.SourceInformation.Collector),
+		attribute.String("guac.source", d.SourceInformation.Source),
+	))
+	defer func() { tracing.End(span, err) }()
 	logger := logging.FromContext(ctx)
 	// Get pipeline of components
 	processorFunc := GetProcessor(ctx)
 	ingestorFunc := GetIngestor(ctx)
 	collectSubEmitFunc := GetCollectSubEmit(ctx, csubClient)
-	assemblerFunc := GetAssembler(ctx, graphqlEndpoint)
+	assemblerFunc := GetAssembler(ctx, gqlclient)
 
 	start := time.Now()
 
 	docTree, err := processorFunc(d)
 	if err != nil {
-		return fmt.Errorf("unable to process doc: %v, format: %v, document: %v", err, d.Format, d.Type)
+		return emitter.NewStageError(emitter.StageProcess, fmt.Errorf("unable to process doc: %v, format: %v, document: %v", err, d.Format, d.Type))
 	}
 
 	predicates, idstrings, err := ingestorFunc(docTree)
 	if err != nil {
-		return fmt.Errorf("unable to ingest doc tree: %v", err)
+		return emitter.NewStageError(emitter.StageParse, fmt.Errorf("unable to ingest doc tree: %v", err))
 	}
 
 	err = collectSubEmitFunc(idstrings)
@@ -61,7 +69,7 @@ func Ingest(ctx context.Context, d *processor.Document, graphqlEndpoint string,
 
 	err = assemblerFunc(predicates)
 	if err != nil {
-		return fmt.Errorf("unable to assemble graphs: %v", err)
+		return emitter.NewStageError(emitter.StageAssemble, fmt.Errorf("unable to assemble graphs: %v", err))
 	}
 	t := time.Now()
 	elapsed := t.Sub(start)
@@ -69,13 +77,13 @@ func Ingest(ctx context.Context, d *processor.Document, graphqlEndpoint string,
 	return nil
 }
 
-func MergedIngest(ctx context.Context, docs []*processor.Document, graphqlEndpoint string, csubClient csub_client.Client) error {
+func MergedIngest(ctx context.Context, docs []*processor.Document, gqlclient graphql.Client, csubClient csub_client.Client) error {
 	logger := logging.FromContext(ctx)
 	// Get pipeline of components
 	processorFunc := GetProcessor(ctx)
 	ingestorFunc := GetIngestor(ctx)
 	collectSubEmitFunc := GetCollectSubEmit(ctx, csubClient)
-	assemblerFunc := GetAssembler(ctx, graphqlEndpoint)
+	assemblerFunc := GetAssembler(ctx, gqlclient)
 
 	start := time.Now()
 
@@ -152,9 +160,7 @@ func GetIngestor(ctx context.Context) func(processor.DocumentTree) ([]assembler.
 	}
 }
 
-func GetAssembler(ctx context.Context, graphqlEndpoint string) func([]assembler.IngestPredicates) error {
-	httpClient := http.Client{}
-	gqlclient := graphql.NewClient(graphqlEndpoint, &httpClient)
+func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assembler.IngestPredicates) error {
 	f := helpers.GetBulkAssembler(ctx, gqlclient)
 	return f
 }
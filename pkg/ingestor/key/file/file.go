@@ -0,0 +1,414 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package file provides a key provider that loads the public keys to verify
+// signatures with from files, so that they can be managed declaratively.
+//
+// The path of the provider is either a keyring file or a directory. In a
+// directory, each .pem or .pub file holds a PEM public key, each .jwk file a
+// JSON Web Key and each .json file a keyring or a JSON Web Key. The ID of a
+// key file is its name without the extension, or the "kid" of a JSON Web Key.
+//
+// A keyring follows the key format of TUF metadata, either at its top level
+// or in its "signed" object, and adds the validity window and revocation of
+// each key:
+//
+//	{
+//	  "keys": {
+//	    "release-2023": {
+//	      "keytype": "ecdsa",
+//	      "scheme": "ecdsa-sha2-nistp256",
+//	      "keyval": {"public": "-----BEGIN PUBLIC KEY-----\n..."},
+//	      "notAfter": "2024-01-01T00:00:00Z"
+//	    },
+//	    "release-2024": {
+//	      "keytype": "ecdsa",
+//	      "scheme": "ecdsa-sha2-nistp256",
+//	      "keyval": {"public": "-----BEGIN PUBLIC KEY-----\n..."},
+//	      "notBefore": "2023-12-01T00:00:00Z"
+//	    },
+//	    "leaked": {
+//	      "keytype": "ed25519",
+//	      "scheme": "ed25519",
+//	      "keyval": {"public": "<hex encoded key>"},
+//	      "revoked": true
+//	    }
+//	  }
+//	}
+//
+// Keys are found by their ID or by their hash, which DSSE signatures name.
+// Revoked keys are reported with key.ErrRevoked, so that no other provider
+// is used for them, and keys outside of their validity window are not found.
+package file
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// ErrReadOnly is returned when storing or deleting keys, which are only
+// managed through the files of the provider.
+var ErrReadOnly = errors.New("the keys of the file key provider are managed through its files")
+
+// keyring is the TUF-style keyring file format.
+type keyring struct {
+	Signed *struct {
+		Keys map[string]keyringEntry `json:"keys"`
+	} `json:"signed,omitempty"`
+	Keys map[string]keyringEntry `json:"keys,omitempty"`
+}
+
+type keyringEntry struct {
+	KeyType string `json:"keytype"`
+	Scheme  string `json:"scheme"`
+	KeyVal  struct {
+		// Public is a PEM public key, or a hex encoded ed25519 key
+		Public string `json:"public"`
+	} `json:"keyval"`
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	NotAfter  *time.Time `json:"notAfter,omitempty"`
+	Revoked   bool       `json:"revoked,omitempty"`
+}
+
+type entry struct {
+	id        string
+	key       *key.Key
+	notBefore time.Time
+	notAfter  time.Time
+	revoked   bool
+	// source is the file that defines the key
+	source string
+}
+
+type fileProvider struct {
+	path string
+
+	mu   sync.RWMutex
+	keys map[string]*entry
+	// hashes maps the hashes of the keys to their entries
+	hashes map[string]*entry
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewFileProvider returns a key provider serving the keys of the keyring
+// file or directory at path. See Watch to pick up changes to the files.
+func NewFileProvider(path string) (*fileProvider, error) {
+	f := &fileProvider{path: path, now: time.Now}
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// RetrieveKey returns the key with the ID or hash, or nil if it is unknown or
+// outside of its validity window. Revoked keys return key.ErrRevoked.
+func (f *fileProvider) RetrieveKey(ctx context.Context, id string) (*key.Key, error) {
+	f.mu.RLock()
+	e, ok := f.keys[id]
+	if !ok {
+		e, ok = f.hashes[id]
+	}
+	f.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	logger := logging.FromContext(ctx)
+	now := f.now()
+	switch {
+	case e.revoked:
+		return nil, fmt.Errorf("key %s from %s: %w", e.id, e.source, key.ErrRevoked)
+	case !e.notBefore.IsZero() && now.Before(e.notBefore):
+		logger.Warnf("key %s from %s is not valid before %s", e.id, e.source, e.notBefore)
+		return nil, nil
+	case !e.notAfter.IsZero() && now.After(e.notAfter):
+		logger.Warnf("key %s from %s is not valid after %s", e.id, e.source, e.notAfter)
+		return nil, nil
+	}
+	k := *e.key
+	return &k, nil
+}
+
+// StoreKey is not supported, keys are added to the files of the provider
+func (f *fileProvider) StoreKey(ctx context.Context, id string, pk *key.Key) error {
+	return ErrReadOnly
+}
+
+// DeleteKey is not supported, keys are removed or revoked in the files of
+// the provider
+func (f *fileProvider) DeleteKey(ctx context.Context, id string) error {
+	return ErrReadOnly
+}
+
+// Type returns the key provider type
+func (f *fileProvider) Type() key.KeyProviderType {
+	return "file"
+}
+
+// Watch reloads the keys whenever the files of the provider change, until
+// the context is canceled. When the files can not be loaded, the keys that
+// were last loaded are kept.
+func (f *fileProvider) Watch(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// keyring files are often replaced rather than written to, so their
+	// directory is watched
+	dir := f.path
+	if info, err := os.Stat(f.path); err == nil && !info.IsDir() {
+		dir = filepath.Dir(f.path)
+	}
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if dir != f.path && filepath.Clean(ev.Name) != filepath.Clean(f.path) {
+					continue
+				}
+				if err := f.load(); err != nil {
+					logger.Errorf("failed to reload keys from %s, keeping the previous keys: %v", f.path, err)
+					continue
+				}
+				logger.Infof("reloaded keys from %s", f.path)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Errorf("error watching keys in %s: %v", f.path, err)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// load reads the keys and replaces the loaded ones.
+func (f *fileProvider) load() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("failed to read keys: %w", err)
+	}
+	var entries []*entry
+	if info.IsDir() {
+		entries, err = loadDir(f.path)
+	} else {
+		entries, err = loadKeyring(f.path)
+	}
+	if err != nil {
+		return err
+	}
+
+	keys := map[string]*entry{}
+	hashes := map[string]*entry{}
+	for _, e := range entries {
+		if other, ok := keys[e.id]; ok {
+			return fmt.Errorf("key %s is defined in both %s and %s", e.id, other.source, e.source)
+		}
+		keys[e.id] = e
+		hashes[e.key.Hash] = e
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys = keys
+	f.hashes = hashes
+	return nil
+}
+
+func loadDir(dir string) ([]*entry, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys: %w", err)
+	}
+	var entries []*entry
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		ext := filepath.Ext(file.Name())
+		id := strings.TrimSuffix(file.Name(), ext)
+		switch ext {
+		case ".pem", ".pub":
+			e, err := loadPEM(path, id)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		case ".jwk":
+			e, err := loadJWK(path, id)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		case ".json":
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read keys: %w", err)
+			}
+			if isJWK(data) {
+				e, err := parseJWK(data, path, id)
+				if err != nil {
+					return nil, err
+				}
+				entries = append(entries, e)
+				continue
+			}
+			keyringEntries, err := parseKeyring(data, path)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, keyringEntries...)
+		}
+	}
+	return entries, nil
+}
+
+func loadPEM(path, id string) (*entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys: %w", err)
+	}
+	pub, err := cryptoutils.UnmarshalPEMToPublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s: %w", path, err)
+	}
+	return newEntry(id, pub, path)
+}
+
+func loadJWK(path, id string) (*entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys: %w", err)
+	}
+	return parseJWK(data, path, id)
+}
+
+func isJWK(data []byte) bool {
+	var fields struct {
+		Kty string `json:"kty"`
+	}
+	return json.Unmarshal(data, &fields) == nil && fields.Kty != ""
+}
+
+func parseJWK(data []byte, path, id string) (*entry, error) {
+	var jwk jose.JSONWebKey
+	if err := jwk.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("failed to parse key %s: %w", path, err)
+	}
+	if !jwk.IsPublic() {
+		return nil, fmt.Errorf("key %s is not a public key", path)
+	}
+	if jwk.KeyID != "" {
+		id = jwk.KeyID
+	}
+	return newEntry(id, jwk.Key, path)
+}
+
+func loadKeyring(path string) ([]*entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys: %w", err)
+	}
+	return parseKeyring(data, path)
+}
+
+func parseKeyring(data []byte, path string) ([]*entry, error) {
+	var k keyring
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, fmt.Errorf("failed to parse keyring %s: %w", path, err)
+	}
+	keys := k.Keys
+	if k.Signed != nil {
+		keys = k.Signed.Keys
+	}
+	var entries []*entry
+	for id, ke := range keys {
+		pub, err := ke.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key %s of keyring %s: %w", id, path, err)
+		}
+		e, err := newEntry(id, pub, path)
+		if err != nil {
+			return nil, err
+		}
+		if ke.NotBefore != nil {
+			e.notBefore = *ke.NotBefore
+		}
+		if ke.NotAfter != nil {
+			e.notAfter = *ke.NotAfter
+		}
+		e.revoked = ke.Revoked
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func (ke *keyringEntry) publicKey() (crypto.PublicKey, error) {
+	if strings.HasPrefix(strings.TrimSpace(ke.KeyVal.Public), "-----BEGIN") {
+		return cryptoutils.UnmarshalPEMToPublicKey([]byte(ke.KeyVal.Public))
+	}
+	if ke.KeyType != "ed25519" {
+		return nil, fmt.Errorf("%s keys must be PEM encoded", ke.KeyType)
+	}
+	raw, err := hex.DecodeString(ke.KeyVal.Public)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 key length %d", len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+func newEntry(id string, pub crypto.PublicKey, source string) (*entry, error) {
+	k, err := key.NewKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key %s from %s: %w", id, source, err)
+	}
+	return &entry{id: id, key: k, source: source}, nil
+}
@@ -0,0 +1,276 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"gopkg.in/square/go-jose.v2"
+)
+
+func newECDSAKey(t *testing.T) crypto.PublicKey {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv.Public()
+}
+
+func pemKey(t *testing.T, pub crypto.PublicKey) []byte {
+	t.Helper()
+	pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pemBytes
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func hash(t *testing.T, pub crypto.PublicKey) string {
+	t.Helper()
+	k, err := key.NewKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k.Hash
+}
+
+func TestFileProvider_RetrieveKey(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	dir := t.TempDir()
+
+	pemPub := newECDSAKey(t)
+	writeFile(t, filepath.Join(dir, "release.pem"), pemKey(t, pemPub))
+
+	jwkPub := newECDSAKey(t)
+	jwk, err := jose.JSONWebKey{Key: jwkPub, KeyID: "builder"}.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(dir, "builder-key.jwk"), jwk)
+
+	oldPub, newPub, revokedPub := newECDSAKey(t), newECDSAKey(t), newECDSAKey(t)
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyring, err := json.Marshal(map[string]interface{}{
+		"signed": map[string]interface{}{
+			"keys": map[string]interface{}{
+				"release-2023": map[string]interface{}{
+					"keytype":  "ecdsa",
+					"scheme":   "ecdsa-sha2-nistp256",
+					"keyval":   map[string]string{"public": string(pemKey(t, oldPub))},
+					"notAfter": "2024-01-01T00:00:00Z",
+				},
+				"release-2024": map[string]interface{}{
+					"keytype":   "ecdsa",
+					"scheme":    "ecdsa-sha2-nistp256",
+					"keyval":    map[string]string{"public": string(pemKey(t, newPub))},
+					"notBefore": "2023-12-01T00:00:00Z",
+				},
+				"leaked": map[string]interface{}{
+					"keytype": "ecdsa",
+					"scheme":  "ecdsa-sha2-nistp256",
+					"keyval":  map[string]string{"public": string(pemKey(t, revokedPub))},
+					"revoked": true,
+				},
+				"maintainer": map[string]interface{}{
+					"keytype": "ed25519",
+					"scheme":  "ed25519",
+					"keyval":  map[string]string{"public": hex.EncodeToString(edPub)},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(dir, "root.json"), keyring)
+	writeFile(t, filepath.Join(dir, "README.md"), []byte("not a key"))
+
+	f, err := NewFileProvider(dir)
+	if err != nil {
+		t.Fatalf("NewFileProvider() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		id      string
+		now     time.Time
+		want    crypto.PublicKey
+		wantErr error
+	}{
+		{"PEM file", "release", time.Now(), pemPub, nil},
+		{"JWK file with key ID", "builder", time.Now(), jwkPub, nil},
+		{"hash of a key", hash(t, jwkPub), time.Now(), jwkPub, nil},
+		{"ed25519 keyring key", "maintainer", time.Now(), edPub, nil},
+		{"rotated out key before rotation", "release-2023", time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC), oldPub, nil},
+		{"rotated in key after rotation", "release-2024", time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC), newPub, nil},
+		{"rotated out key after rotation", "release-2023", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), nil, nil},
+		{"rotated in key before rotation", "release-2024", time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC), nil, nil},
+		{"revoked key", "leaked", time.Now(), nil, key.ErrRevoked},
+		{"hash of a revoked key", hash(t, revokedPub), time.Now(), nil, key.ErrRevoked},
+		{"unknown key", "unknown", time.Now(), nil, nil},
+		{"file name of a JWK with key ID", "builder-key", time.Now(), nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f.now = func() time.Time { return tt.now }
+			got, err := f.RetrieveKey(ctx, tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RetrieveKey() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("RetrieveKey() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.Hash != hash(t, tt.want) {
+				t.Errorf("RetrieveKey() = %v, want key %s", got, hash(t, tt.want))
+			}
+		})
+	}
+}
+
+func TestFileProvider_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string][]byte
+	}{{
+		name:  "invalid PEM",
+		files: map[string][]byte{"release.pem": []byte("not a key")},
+	}, {
+		name:  "invalid keyring",
+		files: map[string][]byte{"root.json": []byte(`{"keys": {"a": {"keytype": "rsa", "keyval": {"public": "00"}}}}`)},
+	}, {
+		name: "duplicate ID",
+		files: map[string][]byte{
+			"release.pem": pemKey(t, newECDSAKey(t)),
+			"release.pub": pemKey(t, newECDSAKey(t)),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, data := range tt.files {
+				writeFile(t, filepath.Join(dir, name), data)
+			}
+			if _, err := NewFileProvider(dir); err == nil {
+				t.Error("NewFileProvider() did not fail")
+			}
+		})
+	}
+	if _, err := NewFileProvider(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("NewFileProvider() of a missing path did not fail")
+	}
+}
+
+func TestFileProvider_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	f, err := NewFileProvider(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.StoreKey(ctx, "id", &key.Key{}); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("StoreKey() error = %v, want %v", err, ErrReadOnly)
+	}
+	if err := f.DeleteKey(ctx, "id"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("DeleteKey() error = %v, want %v", err, ErrReadOnly)
+	}
+}
+
+func TestFileProvider_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background()))
+	defer cancel()
+	dir := t.TempDir()
+	keyringPath := filepath.Join(dir, "keyring.json")
+	writeKeyring := func(revoked bool) {
+		data, err := json.Marshal(map[string]interface{}{
+			"keys": map[string]interface{}{
+				"release": map[string]interface{}{
+					"keytype": "ecdsa",
+					"keyval":  map[string]string{"public": string(pemKey(t, newECDSAKey(t)))},
+					"revoked": revoked,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// replace the keyring, as configuration management tools do
+		writeFile(t, keyringPath+".tmp", data)
+		if err := os.Rename(keyringPath+".tmp", keyringPath); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeKeyring(false)
+
+	f, err := NewFileProvider(keyringPath)
+	if err != nil {
+		t.Fatalf("NewFileProvider() error = %v", err)
+	}
+	if err := f.Watch(ctx); err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	if got, _ := f.RetrieveKey(ctx, "release"); got == nil {
+		t.Fatal("RetrieveKey() = nil before revocation")
+	}
+
+	writeKeyring(true)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := f.RetrieveKey(ctx, "release")
+		if errors.Is(err, key.ErrRevoked) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("RetrieveKey() still returns the key after its revocation")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// an invalid keyring keeps the previous keys
+	writeFile(t, keyringPath, []byte("{"))
+	time.Sleep(100 * time.Millisecond)
+	f.mu.RLock()
+	_, ok := f.keys["release"]
+	f.mu.RUnlock()
+	if !ok {
+		t.Error("invalid keyring dropped the previous keys")
+	}
+}
@@ -73,6 +73,10 @@ var (
 	keyProviders = map[KeyProviderType]KeyProvider{}
 )
 
+// ErrRevoked is returned by key providers for the keys that are revoked,
+// which must not be used even if another provider has them.
+var ErrRevoked = errors.New("key is revoked")
+
 func RegisterKeyProvider(k KeyProvider, providerType KeyProviderType) error {
 	if _, ok := keyProviders[providerType]; ok {
 		keyProviders[providerType] = k
@@ -82,18 +86,18 @@ func RegisterKeyProvider(k KeyProvider, providerType KeyProviderType) error {
 	return nil
 }
 
-// Find goes through each of the registered key providers and retrieves the wrapped Key
+// Find goes through each of the registered key providers and retrieves the wrapped Key.
+// All the providers are asked, so that a key revoked by any of them is not found.
 // TODO: Should this handle if multiple keys are returned
 func Find(ctx context.Context, id string) (*Key, error) {
 	var foundKey *Key
-	var err error
 	for i := range keyProviders {
-		foundKey, err = Retrieve(ctx, id, i)
+		k, err := Retrieve(ctx, id, i)
 		if err != nil && !strings.Contains(err.Error(), "failed to find key from key provider") {
 			return nil, err
 		}
-		if foundKey != nil {
-			break
+		if foundKey == nil {
+			foundKey = k
 		}
 	}
 	if foundKey == nil {
@@ -126,20 +130,10 @@ func Store(ctx context.Context, id string, pemBytes []byte, providerType KeyProv
 	if err != nil {
 		return err
 	}
-	keyHash, err := dsse.SHA256KeyID(key)
-	if err != nil {
-		return err
-	}
-	keyType, KeyScheme, err := getKeyInfo(key)
+	foundKey, err := NewKey(key)
 	if err != nil {
 		return err
 	}
-	foundKey := &Key{
-		Hash:   keyHash,
-		Type:   keyType,
-		Val:    key,
-		Scheme: KeyScheme,
-	}
 	if provider, ok := keyProviders[providerType]; ok {
 		err := provider.StoreKey(ctx, id, foundKey)
 		if err != nil {
@@ -151,6 +145,25 @@ func Store(ctx context.Context, id string, pemBytes []byte, providerType KeyProv
 	return nil
 }
 
+// NewKey wraps the public key, computing its hash and finding its type and
+// scheme
+func NewKey(pub crypto.PublicKey) (*Key, error) {
+	keyHash, err := dsse.SHA256KeyID(pub)
+	if err != nil {
+		return nil, err
+	}
+	keyType, keyScheme, err := getKeyInfo(pub)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{
+		Hash:   keyHash,
+		Type:   keyType,
+		Val:    pub,
+		Scheme: keyScheme,
+	}, nil
+}
+
 // Delete goes to the specified key provider and deletes the Key
 // returns a nil error when successful
 func Delete(ctx context.Context, id string, providerType KeyProviderType) error {
@@ -18,6 +18,7 @@ package key
 import (
 	"context"
 	"crypto"
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -104,6 +105,21 @@ func TestFind_MultiProvider(t *testing.T) {
 	}
 }
 
+func TestFind_Revoked(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	provider, _, wantKey := setupTwoProvider(t)
+	provider[0].collector["revoked"] = wantKey[0]
+	provider[1].revoked["revoked"] = true
+	defer delete(provider[0].collector, "revoked")
+	defer delete(provider[1].revoked, "revoked")
+	// the providers are asked in any order
+	for i := 0; i < 10; i++ {
+		if got, err := Find(ctx, "revoked"); !errors.Is(err, ErrRevoked) {
+			t.Fatalf("Find() = %v, %v, want error %v", got, err, ErrRevoked)
+		}
+	}
+}
+
 func TestRetrieve(t *testing.T) {
 	ctx := logging.WithLogger(context.Background())
 	provider, _, wantKey := setupTwoProvider(t)
@@ -311,15 +327,20 @@ func TestDelete(t *testing.T) {
 
 type mockKeyProvider struct {
 	collector map[string]*Key
+	revoked   map[string]bool
 }
 
 func newMockProvider() *mockKeyProvider {
 	return &mockKeyProvider{
 		collector: map[string]*Key{},
+		revoked:   map[string]bool{},
 	}
 }
 
 func (m *mockKeyProvider) RetrieveKey(ctx context.Context, id string) (*Key, error) {
+	if m.revoked[id] {
+		return nil, ErrRevoked
+	}
 	if key, ok := m.collector[id]; ok {
 		return key, nil
 	}
@@ -53,58 +53,140 @@ func (b *GraphBuilder) GetIdentities() []TrustInformation {
 	return b.foundIdentities
 }
 
+// AddIdentities records identities that signed the document, such as those
+// of the envelopes or detached signatures it came with
+func (b *GraphBuilder) AddIdentities(identities ...TrustInformation) {
+	b.foundIdentities = append(b.foundIdentities, identities...)
+}
+
 func (b *GraphBuilder) GetIdentifiers(ctx context.Context) (*IdentifierStrings, error) {
 	return b.docParser.GetIdentifiers(ctx)
 }
 
 // addMetadata adds trust and source collector metadata
 func addMetadata(predicates *assembler.IngestPredicates, foundIdentities []TrustInformation, srcInfo processor.SourceInformation) {
-	// TODO: when trust information fields need to be added to GQL nodes
-	// and added here.
-	_ = foundIdentities
+	verified, signer := trust(foundIdentities)
 
 	for _, v := range predicates.CertifyScorecard {
 		v.Scorecard.Collector = srcInfo.Collector
 		v.Scorecard.Origin = srcInfo.Source
+		v.Scorecard.Verified, v.Scorecard.Signer = verified, signer
 	}
 
 	for _, v := range predicates.IsDependency {
 		v.IsDependency.Collector = srcInfo.Collector
 		v.IsDependency.Origin = srcInfo.Source
+		v.IsDependency.Verified, v.IsDependency.Signer = verified, signer
 	}
 
 	for _, v := range predicates.IsOccurrence {
 		v.IsOccurrence.Collector = srcInfo.Collector
 		v.IsOccurrence.Origin = srcInfo.Source
+		v.IsOccurrence.Verified, v.IsOccurrence.Signer = verified, signer
 	}
 
 	for _, v := range predicates.HasSlsa {
 		v.HasSlsa.Collector = srcInfo.Collector
 		v.HasSlsa.Origin = srcInfo.Source
+		v.HasSlsa.Verified, v.HasSlsa.Signer = verified, signer
 	}
 
 	for _, v := range predicates.CertifyVuln {
 		v.VulnData.Collector = srcInfo.Collector
 		v.VulnData.Origin = srcInfo.Source
+		v.VulnData.Verified, v.VulnData.Signer = verified, signer
 	}
 
 	for _, v := range predicates.VulnEqual {
 		v.VulnEqual.Collector = srcInfo.Collector
 		v.VulnEqual.Origin = srcInfo.Source
+		v.VulnEqual.Verified, v.VulnEqual.Signer = verified, signer
 	}
 
 	for _, v := range predicates.HasSourceAt {
 		v.HasSourceAt.Collector = srcInfo.Collector
 		v.HasSourceAt.Origin = srcInfo.Source
+		v.HasSourceAt.Verified, v.HasSourceAt.Signer = verified, signer
 	}
 
 	for _, v := range predicates.VulnMetadata {
 		v.VulnMetadata.Collector = srcInfo.Collector
 		v.VulnMetadata.Origin = srcInfo.Source
+		v.VulnMetadata.Verified, v.VulnMetadata.Signer = verified, signer
 	}
 
 	for _, v := range predicates.Vex {
 		v.VexData.Collector = srcInfo.Collector
 		v.VexData.Origin = srcInfo.Source
+		v.VexData.Verified, v.VexData.Signer = verified, signer
+	}
+
+	for _, v := range predicates.HasSBOM {
+		if v.HasSBOM != nil {
+			v.HasSBOM.Verified, v.HasSBOM.Signer = verified, signer
+		}
+	}
+
+	for _, v := range predicates.CertifyLegal {
+		if v.CertifyLegal != nil {
+			v.CertifyLegal.Verified, v.CertifyLegal.Signer = verified, signer
+		}
+	}
+
+	for _, v := range predicates.CertifyGood {
+		if v.CertifyGood != nil {
+			v.CertifyGood.Verified, v.CertifyGood.Signer = verified, signer
+		}
+	}
+
+	for _, v := range predicates.CertifyBad {
+		if v.CertifyBad != nil {
+			v.CertifyBad.Verified, v.CertifyBad.Signer = verified, signer
+		}
+	}
+
+	for _, v := range predicates.HasMetadata {
+		if v.HasMetadata != nil {
+			v.HasMetadata.Verified, v.HasMetadata.Signer = verified, signer
+		}
+	}
+
+	for _, v := range predicates.PointOfContact {
+		if v.PointOfContact != nil {
+			v.PointOfContact.Verified, v.PointOfContact.Signer = verified, signer
+		}
+	}
+
+	for _, v := range predicates.HashEqual {
+		if v.HashEqual != nil {
+			v.HashEqual.Verified, v.HashEqual.Signer = verified, signer
+		}
+	}
+
+	for _, v := range predicates.PkgEqual {
+		if v.PkgEqual != nil {
+			v.PkgEqual.Verified, v.PkgEqual.Signer = verified, signer
+		}
+	}
+}
+
+// trust returns the verification status and signer recorded with the
+// evidence of a document: the first trusted signer if there is one, else the
+// first signer. Both are nil when signatures were not checked.
+func trust(foundIdentities []TrustInformation) (*bool, *string) {
+	if len(foundIdentities) == 0 {
+		return nil, nil
+	}
+	signer := foundIdentities[0]
+	for _, identity := range foundIdentities {
+		if identity.Verified {
+			signer = identity
+			break
+		}
+	}
+	verified := signer.Verified
+	if signer.ID == "" {
+		return &verified, nil
 	}
+	return &verified, &signer.ID
 }
@@ -26,75 +26,65 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
-// TODO: change the DependencyType based on the relationship, currently set to unknown
-func GetIsDep(foundNode *model.PkgInputSpec, relatedPackNodes []*model.PkgInputSpec, relatedFileNodes []*model.PkgInputSpec, justification string) (*assembler.IsDependencyIngest, error) {
+// GetIsDep creates the IsDependency of the found node on the related file, or
+// else package, with the dependency type and scope of their relationship.
+func GetIsDep(foundNode *model.PkgInputSpec, relatedPackNodes []*model.PkgInputSpec, relatedFileNodes []*model.PkgInputSpec, dependencyType model.DependencyType, dependencyScope model.DependencyScope, justification string) (*assembler.IsDependencyIngest, error) {
 	if len(relatedFileNodes) > 0 {
 		for _, rfileNode := range relatedFileNodes {
 
 			// TODO: Check is this always just expected to be one?
-			return &assembler.IsDependencyIngest{
-				Pkg:             foundNode,
-				DepPkg:          rfileNode,
-				DepPkgMatchFlag: getMatchFlagsFromPkgInput(rfileNode),
-				IsDependency: &model.IsDependencyInputSpec{
-					DependencyType: model.DependencyTypeUnknown,
-					Justification:  justification,
-					VersionRange:   *rfileNode.Version,
-				},
-			}, nil
+			p := CreateScopedIsDep(foundNode, rfileNode, dependencyType, dependencyScope, justification)
+			return &p, nil
 		}
 	} else if len(relatedPackNodes) > 0 {
 		for _, rpackNode := range relatedPackNodes {
-			return &assembler.IsDependencyIngest{
-				Pkg:             foundNode,
-				DepPkg:          rpackNode,
-				DepPkgMatchFlag: getMatchFlagsFromPkgInput(rpackNode),
-				IsDependency: &model.IsDependencyInputSpec{
-					DependencyType: model.DependencyTypeUnknown,
-					Justification:  justification,
-					VersionRange:   *rpackNode.Version,
-				},
-			}, nil
+			p := CreateScopedIsDep(foundNode, rpackNode, dependencyType, dependencyScope, justification)
+			return &p, nil
 
 		}
 	}
 	return nil, nil
 }
 
-// TODO: change the DependencyType based on the relationship, currently set to unknown
+// CreateIsDep creates the IsDependency of the package on the dependency
+// package with the dependency type.
+func CreateIsDep(pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependencyType model.DependencyType, justification string) assembler.IsDependencyIngest {
+	return assembler.IsDependencyIngest{
+		Pkg:             pkg,
+		DepPkg:          depPkg,
+		DepPkgMatchFlag: getMatchFlagsFromPkgInput(depPkg),
+		IsDependency: &model.IsDependencyInputSpec{
+			DependencyType: dependencyType,
+			Justification:  justification,
+			VersionRange:   *depPkg.Version,
+		},
+	}
+}
+
+// CreateScopedIsDep creates the IsDependency of the package on the dependency
+// package with the dependency type, needed in the dependency scope.
+func CreateScopedIsDep(pkg *model.PkgInputSpec, depPkg *model.PkgInputSpec, dependencyType model.DependencyType, dependencyScope model.DependencyScope, justification string) assembler.IsDependencyIngest {
+	isDep := CreateIsDep(pkg, depPkg, dependencyType, justification)
+	isDep.IsDependency.DependencyScope = &dependencyScope
+	return isDep
+}
+
+// CreateTopLevelIsDeps connects the top level package to each of the packages
+// and files. Without a relationship to derive them from, the dependencies are
+// of unknown type.
 func CreateTopLevelIsDeps(topLevel *model.PkgInputSpec, packages map[string][]*model.PkgInputSpec, files map[string][]*model.PkgInputSpec, justification string) []assembler.IsDependencyIngest {
 	isDeps := []assembler.IsDependencyIngest{}
 	for _, packNodes := range packages {
 		for _, packNode := range packNodes {
 			if !reflect.DeepEqual(packNode, topLevel) {
-				p := assembler.IsDependencyIngest{
-					Pkg:             topLevel,
-					DepPkg:          packNode,
-					DepPkgMatchFlag: getMatchFlagsFromPkgInput(packNode),
-					IsDependency: &model.IsDependencyInputSpec{
-						DependencyType: model.DependencyTypeUnknown,
-						Justification:  justification,
-						VersionRange:   *packNode.Version,
-					},
-				}
-				isDeps = append(isDeps, p)
+				isDeps = append(isDeps, CreateIsDep(topLevel, packNode, model.DependencyTypeUnknown, justification))
 			}
 		}
 	}
 
 	for _, fileNodes := range files {
 		for _, fileNode := range fileNodes {
-			p := assembler.IsDependencyIngest{
-				Pkg:             topLevel,
-				DepPkg:          fileNode,
-				DepPkgMatchFlag: getMatchFlagsFromPkgInput(fileNode),
-				IsDependency: &model.IsDependencyInputSpec{
-					DependencyType: model.DependencyTypeUnknown,
-					Justification:  justification,
-					VersionRange:   *fileNode.Version,
-				},
-			}
-			isDeps = append(isDeps, p)
+			isDeps = append(isDeps, CreateIsDep(topLevel, fileNode, model.DependencyTypeUnknown, justification))
 		}
 	}
 
@@ -57,4 +57,15 @@ type IdentifierStrings struct {
 	UnclassifiedStrings []string
 }
 
-type TrustInformation struct{}
+// TrustInformation records an identity that signed a document. A document
+// without any signature is recorded with an empty ID.
+type TrustInformation struct {
+	// ID identifies the signer, such as a key ID or the email address of a
+	// signing certificate
+	ID string
+	// KeyHash is the hash of the key that created the signature
+	KeyHash string
+	// Verified indicates that the signature was verified and that the trust
+	// policy trusts the signer for the type of the document
+	Verified bool
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/depversion"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
@@ -45,6 +46,12 @@ var vexStatusMap = map[cdx.ImpactAnalysisState]model.VexStatus{
 	cdx.IASNotAffected: model.VexStatusNotAffected,
 }
 
+const (
+	cdxDependencyJustification           = "CDX BOM Dependency"
+	cdxTransitiveDependencyJustification = "CDX BOM transitive dependency"
+	topLevelHeuristicJustification       = "top-level package GUAC heuristic connecting to each file/package"
+)
+
 var justificationsMap = map[cdx.ImpactAnalysisJustification]model.VexJustification{
 	cdx.IAJCodeNotPresent:   model.VexJustificationVulnerableCodeNotPresent,
 	cdx.IAJCodeNotReachable: model.VexJustificationVulnerableCodeNotInExecutePath,
@@ -54,6 +61,8 @@ type cyclonedxParser struct {
 	doc               *processor.Document
 	packagePackages   map[string][]*model.PkgInputSpec
 	packageArtifacts  map[string][]*model.ArtifactInputSpec
+	packageLegals     map[string][]*model.CertifyLegalInputSpec
+	licenseTexts      map[string]string
 	identifierStrings *common.IdentifierStrings
 	cdxBom            *cdx.BOM
 	vulnData          vulnData
@@ -69,6 +78,8 @@ func NewCycloneDXParser() common.DocumentParser {
 	return &cyclonedxParser{
 		packagePackages:   map[string][]*model.PkgInputSpec{},
 		packageArtifacts:  map[string][]*model.ArtifactInputSpec{},
+		packageLegals:     map[string][]*model.CertifyLegalInputSpec{},
+		licenseTexts:      map[string]string{},
 		identifierStrings: &common.IdentifierStrings{},
 	}
 }
@@ -87,6 +98,9 @@ func (c *cyclonedxParser) Parse(ctx context.Context, doc *processor.Document) er
 	if err := c.getPackages(); err != nil {
 		return err
 	}
+	if err := c.getServices(c.cdxBom.Services); err != nil {
+		return err
+	}
 	if err := c.getVulnerabilities(ctx); err != nil {
 		return err
 	}
@@ -135,6 +149,7 @@ func (c *cyclonedxParser) getTopLevelPackage() error {
 				c.packageArtifacts[c.cdxBom.Metadata.Component.BOMRef] = append(c.packageArtifacts[c.cdxBom.Metadata.Component.BOMRef], artifact)
 			}
 		}
+		c.getLegal(c.cdxBom.Metadata.Component.BOMRef, c.cdxBom.Metadata.Component.Licenses, c.cdxBom.Metadata.Component.Evidence, c.cdxBom.Metadata.Component.Copyright)
 		return nil
 	} else {
 		// currently GUAC does not support CycloneDX component field in metadata or the BOM ref being nil.
@@ -204,12 +219,117 @@ func (c *cyclonedxParser) getPackages() error {
 						c.packageArtifacts[comp.BOMRef] = append(c.packageArtifacts[comp.BOMRef], artifact)
 					}
 				}
+				c.getLegal(comp.BOMRef, comp.Licenses, comp.Evidence, comp.Copyright)
 			}
 		}
 	}
 	return nil
 }
 
+// getServices creates a package for each of the services, and the services
+// they are composed of, so that they take part in the dependency graph.
+func (c *cyclonedxParser) getServices(services *[]cdx.Service) error {
+	if services == nil {
+		return nil
+	}
+	for _, service := range *services {
+		name := service.Name
+		if service.Group != "" {
+			name = service.Group + "/" + service.Name
+		}
+		var version *string
+		if service.Version != "" {
+			version = &service.Version
+		}
+		pkg, err := asmhelpers.PurlToPkg(asmhelpers.GuacPkgPurl(name, version))
+		if err != nil {
+			return err
+		}
+		c.packagePackages[service.BOMRef] = append(c.packagePackages[service.BOMRef], pkg)
+		c.getLegal(service.BOMRef, service.Licenses, nil, "")
+
+		if err := c.getServices(service.Services); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getLegal records the declared licenses, the licenses found as evidence and
+// the copyright of the component or service with the bom-ref.
+func (c *cyclonedxParser) getLegal(bomRef string, licenses *cdx.Licenses, evidence *cdx.Evidence, copyright string) {
+	declared := c.licenseExpression(licenses)
+	discovered := ""
+	if evidence != nil {
+		discovered = c.licenseExpression(evidence.Licenses)
+		if copyright == "" && evidence.Copyright != nil {
+			var texts []string
+			for _, text := range *evidence.Copyright {
+				texts = append(texts, text.Text)
+			}
+			copyright = strings.Join(texts, "\n")
+		}
+	}
+	if declared == "" && discovered == "" && copyright == "" {
+		return
+	}
+	c.packageLegals[bomRef] = append(c.packageLegals[bomRef], &model.CertifyLegalInputSpec{
+		DeclaredLicense:   declared,
+		DiscoveredLicense: discovered,
+		Attribution:       copyright,
+		Justification:     "Found in CycloneDX document.",
+	})
+}
+
+// licenseExpression returns the SPDX license expression of the licenses. The
+// licenses without an SPDX identifier are named after the hash of their text,
+// as the SPDX parser does for the licenses extracted from a document.
+func (c *cyclonedxParser) licenseExpression(licenses *cdx.Licenses) string {
+	if licenses == nil {
+		return ""
+	}
+	var exps []string
+	for _, choice := range *licenses {
+		switch {
+		case choice.Expression != "":
+			exps = append(exps, choice.Expression)
+		case choice.License != nil && choice.License.ID != "":
+			exps = append(exps, choice.License.ID)
+		case choice.License != nil && choice.License.Name != "":
+			text := choice.License.Name
+			if choice.License.Text != nil && choice.License.Text.Content != "" && choice.License.Text.Encoding == "" {
+				text = choice.License.Text.Content
+			}
+			name := common.HashLicense(text)
+			c.licenseTexts[name] = text
+			exps = append(exps, name)
+		}
+	}
+	if len(exps) > 1 {
+		for i, exp := range exps {
+			if strings.Contains(exp, " ") {
+				exps[i] = "(" + exp + ")"
+			}
+		}
+	}
+	return strings.Join(exps, " AND ")
+}
+
+// parseLicenses returns the licenses of the expression, inlining the text of
+// the ones without an SPDX identifier. CycloneDX does not record the version
+// of the SPDX license list, so none is set.
+func (c *cyclonedxParser) parseLicenses(exp string) []model.LicenseInputSpec {
+	licenses := common.ParseLicenses(exp, "")
+	for i := range licenses {
+		licenses[i].ListVersion = nil
+		if text, ok := c.licenseTexts[licenses[i].Name]; ok {
+			text := text
+			licenses[i].Inline = &text
+		}
+	}
+	return licenses
+}
+
 func ParseCycloneDXBOM(doc *processor.Document) (*cdx.BOM, error) {
 	bom := cdx.BOM{}
 	switch doc.Format {
@@ -240,24 +360,19 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 		toplevel = c.getPackageElement(c.cdxBom.Metadata.Component.BOMRef)
 	}
 
-	// adding top level package edge manually for all depends on package
-	// TODO: This is not based on the relationship so that can be inaccurate (can capture both direct and in-direct)...Remove this and be done below by the *c.cdxBom.Dependencies?
-	// see https://github.com/CycloneDX/specification/issues/33
-	if toplevel != nil {
-		var timestamp time.Time
+	// set the time to zero time if timestamp is not provided
+	timestamp := zeroTime
+	if c.cdxBom.Metadata != nil && c.cdxBom.Metadata.Timestamp != "" {
 		var err error
-		if c.cdxBom.Metadata.Timestamp == "" {
-			// set the time to zero time if timestamp is not provided
-			timestamp = zeroTime
-		} else {
-			timestamp, err = time.Parse(time.RFC3339, c.cdxBom.Metadata.Timestamp)
-			if err != nil {
-				logger.Errorf("SPDX document had invalid created time %q : %v", c.cdxBom.Metadata.Timestamp, err)
-				return nil
-			}
+		timestamp, err = time.Parse(time.RFC3339, c.cdxBom.Metadata.Timestamp)
+		if err != nil {
+			logger.Errorf("CycloneDX document had invalid created time %q : %v", c.cdxBom.Metadata.Timestamp, err)
+			return nil
 		}
+	}
 
-		preds.IsDependency = append(preds.IsDependency, common.CreateTopLevelIsDeps(toplevel[0], c.packagePackages, nil, "top-level package GUAC heuristic connecting to each file/package")...)
+	if toplevel != nil {
+		preds.IsDependency = append(preds.IsDependency, c.getTopLevelIsDeps(toplevel[0])...)
 		preds.HasSBOM = append(preds.HasSBOM, common.CreateTopLevelHasSBOM(toplevel[0], c.doc, c.cdxBom.SerialNumber, timestamp))
 	}
 
@@ -275,6 +390,20 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 		}
 	}
 
+	for id, cls := range c.packageLegals {
+		for _, cl := range cls {
+			cl.TimeScanned = timestamp
+			for _, pkg := range c.packagePackages[id] {
+				preds.CertifyLegal = append(preds.CertifyLegal, assembler.CertifyLegalIngest{
+					Pkg:          pkg,
+					Declared:     c.parseLicenses(cl.DeclaredLicense),
+					Discovered:   c.parseLicenses(cl.DiscoveredLicense),
+					CertifyLegal: cl,
+				})
+			}
+		}
+	}
+
 	preds.Vex = c.vulnData.vex
 	preds.VulnMetadata = c.vulnData.vulnMetadata
 	preds.CertifyVuln = c.vulnData.certifyVuln
@@ -291,17 +420,11 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 			continue
 		}
 		if deps.Dependencies != nil {
-			for _, depPkg := range *deps.Dependencies {
-				if depPkg, exist := c.packagePackages[depPkg]; exist {
+			for _, depRef := range *deps.Dependencies {
+				for _, depPkg := range c.packagePackages[depRef] {
 					for _, packNode := range currPkg {
-						p, err := common.GetIsDep(packNode, depPkg, []*model.PkgInputSpec{}, "CDX BOM Dependency")
-						if err != nil {
-							logger.Errorf("error generating CycloneDX edge %v", err)
-							continue
-						}
-						if p != nil {
-							preds.IsDependency = append(preds.IsDependency, *p)
-						}
+						preds.IsDependency = append(preds.IsDependency,
+							common.CreateIsDep(packNode, depPkg, model.DependencyTypeDirect, cdxDependencyJustification))
 					}
 				}
 			}
@@ -311,6 +434,74 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 	return preds
 }
 
+// getTopLevelIsDeps connects the top level package to the other packages of
+// the document. The direct and transitive dependencies of the top level
+// package come from the dependency graph. The packages the graph does not
+// reach, or all of them when the graph does not include the top level
+// component, are connected by the GUAC heuristic with an unknown type.
+func (c *cyclonedxParser) getTopLevelIsDeps(toplevel *model.PkgInputSpec) []assembler.IsDependencyIngest {
+	depths := c.dependencyDepths(c.cdxBom.Metadata.Component.BOMRef)
+	if depths == nil {
+		return common.CreateTopLevelIsDeps(toplevel, c.packagePackages, nil, topLevelHeuristicJustification)
+	}
+
+	var isDeps []assembler.IsDependencyIngest
+	unreached := map[string][]*model.PkgInputSpec{}
+	for ref, pkgs := range c.packagePackages {
+		depth, ok := depths[ref]
+		switch {
+		case !ok:
+			unreached[ref] = pkgs
+		case depth == 0:
+			continue
+		case depth == 1:
+			for _, pkg := range pkgs {
+				isDeps = append(isDeps, common.CreateIsDep(toplevel, pkg, model.DependencyTypeDirect, cdxDependencyJustification))
+			}
+		default:
+			for _, pkg := range pkgs {
+				isDeps = append(isDeps, common.CreateIsDep(toplevel, pkg, model.DependencyTypeIndirect, cdxTransitiveDependencyJustification))
+			}
+		}
+	}
+	return append(isDeps, common.CreateTopLevelIsDeps(toplevel, unreached, nil, topLevelHeuristicJustification)...)
+}
+
+// dependencyDepths returns the depth in the dependency graph of each bom-ref
+// reachable from the root, or nil if the graph does not include the root.
+func (c *cyclonedxParser) dependencyDepths(root string) map[string]int {
+	if c.cdxBom.Dependencies == nil {
+		return nil
+	}
+	graph := map[string][]string{}
+	found := false
+	for _, deps := range *c.cdxBom.Dependencies {
+		if deps.Ref == root {
+			found = true
+		}
+		if deps.Dependencies != nil {
+			graph[deps.Ref] = append(graph[deps.Ref], *deps.Dependencies...)
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	depths := map[string]int{root: 0}
+	queue := []string{root}
+	for len(queue) > 0 {
+		now := queue[0]
+		queue = queue[1:]
+		for _, dep := range graph[now] {
+			if _, seen := depths[dep]; !seen {
+				depths[dep] = depths[now] + 1
+				queue = append(queue, dep)
+			}
+		}
+	}
+	return depths
+}
+
 func (c *cyclonedxParser) getVulnerabilities(ctx context.Context) error {
 	logger := logging.FromContext(ctx)
 	if c.cdxBom.Vulnerabilities == nil {
@@ -401,7 +592,6 @@ func (c *cyclonedxParser) getVulnerabilities(ctx context.Context) error {
 
 // Get package name and range versions to create package input spec for the affected packages.
 func (c *cyclonedxParser) getAffectedPackages(ctx context.Context, vulnInput *model.VulnerabilityInputSpec, vexData model.VexStatementInputSpec, affectsObj cdx.Affects) (*[]assembler.VexIngest, error) {
-	logger := logging.FromContext(ctx)
 	pkgRef := affectsObj.Ref
 
 	// split ref using # as delimiter.
@@ -427,9 +617,17 @@ func (c *cyclonedxParser) getAffectedPackages(ctx context.Context, vulnInput *mo
 
 	var viList []assembler.VexIngest
 	for _, affect := range *affectsObj.Range {
-		// TODO: Handle package range versions (see - https://github.com/CycloneDX/bom-examples/blob/master/VEX/CISA-Use-Cases/Case-8/vex.json#L42)
+		// see - https://github.com/CycloneDX/bom-examples/blob/master/VEX/CISA-Use-Cases/Case-8/vex.json#L42
 		if affect.Range != "" {
-			logger.Debugf("[cdx vex] package range versions not supported yet: %q", affect.Range)
+			vis, err := c.getAffectedRange(ctx, vulnInput, vexData, pkdIdentifier, affect.Range)
+			if err != nil {
+				logging.FromContext(ctx).Warnf("[cdx vex] %v, recording the range", err)
+				vis, err = c.recordAffectedRange(ctx, vulnInput, vexData, pkdIdentifier, affect.Range)
+				if err != nil {
+					return nil, err
+				}
+			}
+			viList = append(viList, vis...)
 			continue
 		}
 		if affect.Version == "" {
@@ -454,6 +652,128 @@ func (c *cyclonedxParser) getAffectedPackages(ctx context.Context, vulnInput *mo
 	return &viList, nil
 }
 
+// getAffectedRange expands the range of versions of the affected package to
+// the versions of the package found in the document. If none of them is in
+// the range, the range is recorded, see recordAffectedRange.
+func (c *cyclonedxParser) getAffectedRange(ctx context.Context, vulnInput *model.VulnerabilityInputSpec, vexData model.VexStatementInputSpec, pkgIdentifier string, versions string) ([]assembler.VexIngest, error) {
+	logger := logging.FromContext(ctx)
+	versionRange, err := versRange(versions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse affected versions %q of %q: %w", versions, pkgIdentifier, err)
+	}
+
+	pkgID := guacCDXPkgPurl(pkgIdentifier, "", "", false)
+	affectedPkg, err := asmhelpers.PurlToPkg(pkgID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create package input spec from guac pkg purl: %v", err)
+	}
+
+	// the versions of the package are the ones of the component with the
+	// reference, and of the packages with the same name
+	candidates := map[string]*model.PkgInputSpec{}
+	for ref, pkgs := range c.packagePackages {
+		for _, pkg := range pkgs {
+			if pkg.Version == nil || *pkg.Version == "" {
+				continue
+			}
+			if ref == pkgIdentifier || (pkg.Type == affectedPkg.Type && reflect.DeepEqual(pkg.Namespace, affectedPkg.Namespace) && pkg.Name == affectedPkg.Name) {
+				candidates[*pkg.Version] = pkg
+			}
+		}
+	}
+	var candidateVersions []string
+	for version := range candidates {
+		candidateVersions = append(candidateVersions, version)
+	}
+	matched, err := depversion.WhichVersionMatches(candidateVersions, versionRange)
+	if err != nil {
+		return nil, fmt.Errorf("unable to match affected versions %q of %q: %w", versions, pkgIdentifier, err)
+	}
+
+	var viList []assembler.VexIngest
+	for version := range matched {
+		viList = append(viList, assembler.VexIngest{VexData: &vexData, Vulnerability: vulnInput, Pkg: candidates[version]})
+	}
+	if len(viList) == 0 {
+		logger.Debugf("[cdx vex] no version of %q found in range %q, recording the range", pkgIdentifier, versions)
+		return c.recordAffectedRange(ctx, vulnInput, vexData, pkgIdentifier, versions)
+	}
+	return viList, nil
+}
+
+// recordAffectedRange records the statement on the affected package, without
+// a version, with the range of affected versions in its notes. Statements that
+// the versions are not affected or fixed are skipped, as on the package
+// without a version they would clear all of its versions.
+func (c *cyclonedxParser) recordAffectedRange(ctx context.Context, vulnInput *model.VulnerabilityInputSpec, vexData model.VexStatementInputSpec, pkgIdentifier string, versions string) ([]assembler.VexIngest, error) {
+	if vexData.Status == model.VexStatusNotAffected || vexData.Status == model.VexStatusFixed {
+		logging.FromContext(ctx).Warnf("[cdx vex] skipping %s statement about versions %q of %q, none of them found in the document",
+			vexData.Status, versions, pkgIdentifier)
+		return nil, nil
+	}
+	pkgID := guacCDXPkgPurl(pkgIdentifier, "", "", false)
+	affectedPkg, err := asmhelpers.PurlToPkg(pkgID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create package input spec from guac pkg purl: %v", err)
+	}
+	vexData.StatusNotes = fmt.Sprintf("%s (versions: %s)", vexData.StatusNotes, versions)
+	c.identifierStrings.PurlStrings = append(c.identifierStrings.PurlStrings, pkgID)
+	return []assembler.VexIngest{{VexData: &vexData, Vulnerability: vulnInput, Pkg: affectedPkg}}, nil
+}
+
+// versRange converts a vers range, such as "vers:generic/>=2.9|<=4.1", to a
+// range of versions depversion can match. Each lower bound is paired with the
+// upper bound that follows it.
+func versRange(vers string) (string, error) {
+	if !strings.HasPrefix(vers, "vers:") {
+		return vers, nil
+	}
+	_, constraints, found := strings.Cut(strings.TrimPrefix(vers, "vers:"), "/")
+	if !found {
+		return "", fmt.Errorf("missing versioning scheme")
+	}
+	if strings.TrimSpace(constraints) == "*" {
+		return "", nil
+	}
+
+	var ranges []string
+	lower := ""
+	for _, constraint := range strings.Split(constraints, "|") {
+		constraint = strings.TrimSpace(constraint)
+		switch {
+		case constraint == "":
+			continue
+		case strings.HasPrefix(constraint, "!="):
+			return "", fmt.Errorf("unsupported constraint %q", constraint)
+		case strings.HasPrefix(constraint, ">"):
+			if lower != "" {
+				ranges = append(ranges, lower)
+			}
+			lower = constraint
+		case strings.HasPrefix(constraint, "<"):
+			if lower != "" {
+				ranges = append(ranges, lower+","+constraint)
+				lower = ""
+			} else {
+				ranges = append(ranges, constraint)
+			}
+		default:
+			if lower != "" {
+				ranges = append(ranges, lower)
+				lower = ""
+			}
+			ranges = append(ranges, "="+strings.TrimPrefix(constraint, "="))
+		}
+	}
+	if lower != "" {
+		ranges = append(ranges, lower)
+	}
+	if len(ranges) == 0 {
+		return "", fmt.Errorf("no constraint found")
+	}
+	return strings.Join(ranges, "||"), nil
+}
+
 func (c *cyclonedxParser) getPackageElement(elementID string) []*model.PkgInputSpec {
 	if packNode, ok := c.packagePackages[elementID]; ok {
 		return packNode
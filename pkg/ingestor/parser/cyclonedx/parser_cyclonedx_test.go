@@ -118,6 +118,19 @@ func Test_cyclonedxParser(t *testing.T) {
 		},
 		wantPredicates: affectedVexPredicates(),
 		wantErr:        false,
+	}, {
+		name: "valid CycloneDX document with licenses, services and a dependency graph",
+		doc: &processor.Document{
+			Blob:   testdata.CycloneDXServicesLicenses,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCycloneDX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: servicesLicensesPredicates(),
+		wantErr:        false,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -516,3 +529,185 @@ func affectedVexPredicates() *assembler.IngestPredicates {
 		},
 	}
 }
+
+func servicesLicensesPredicates() *assembler.IngestPredicates {
+	shop, _ := asmhelpers.PurlToPkg("pkg:npm/shop@1.0.0")
+	libA, _ := asmhelpers.PurlToPkg("pkg:npm/lib-a@1.0.0")
+	libB, _ := asmhelpers.PurlToPkg("pkg:npm/lib-b@2.0.0")
+	libC, _ := asmhelpers.PurlToPkg("pkg:npm/lib-c@3.0.0")
+	payments, _ := asmhelpers.PurlToPkg("pkg:guac/pkg/acme/payments@2.1")
+	timestamp, _ := time.Parse(time.RFC3339, "2023-06-01T00:00:00Z")
+	proprietary := "Acme Proprietary"
+	proprietaryRef := common.HashLicense(proprietary)
+
+	isDep := func(pkg, depPkg *model.PkgInputSpec, depType model.DependencyType, justification string) assembler.IsDependencyIngest {
+		return assembler.IsDependencyIngest{
+			Pkg:             pkg,
+			DepPkg:          depPkg,
+			DepPkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			IsDependency: &model.IsDependencyInputSpec{
+				DependencyType: depType,
+				VersionRange:   *depPkg.Version,
+				Justification:  justification,
+			},
+		}
+	}
+
+	return &assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{
+			isDep(shop, libA, model.DependencyTypeDirect, cdxDependencyJustification),
+			isDep(shop, payments, model.DependencyTypeDirect, cdxDependencyJustification),
+			isDep(shop, libB, model.DependencyTypeIndirect, cdxTransitiveDependencyJustification),
+			isDep(shop, libC, model.DependencyTypeUnknown, topLevelHeuristicJustification),
+			isDep(libA, libB, model.DependencyTypeDirect, cdxDependencyJustification),
+		},
+		HasSBOM: []assembler.HasSBOMIngest{
+			{
+				Pkg: shop,
+				HasSBOM: &model.HasSBOMInputSpec{
+					Uri:              "urn:uuid:4f0a3c2e-6b7d-4b1e-9c55-2f8f5a1d7e10",
+					Algorithm:        "sha256",
+					Digest:           "2c5e264e2bbf409a0ae73890ac6cfcf8a53346dbdf15d6a446060e68c338a166",
+					DownloadLocation: "TestSource",
+					KnownSince:       timestamp,
+				},
+			},
+		},
+		CertifyLegal: []assembler.CertifyLegalIngest{
+			{
+				Pkg:      shop,
+				Declared: []model.LicenseInputSpec{{Name: "MIT"}, {Name: "Apache-2.0"}},
+				CertifyLegal: &model.CertifyLegalInputSpec{
+					DeclaredLicense: "MIT OR Apache-2.0",
+					Justification:   "Found in CycloneDX document.",
+					TimeScanned:     timestamp,
+				},
+			},
+			{
+				Pkg:      libA,
+				Declared: []model.LicenseInputSpec{{Name: "MIT"}, {Name: proprietaryRef, Inline: &proprietary}},
+				CertifyLegal: &model.CertifyLegalInputSpec{
+					DeclaredLicense: "MIT AND " + proprietaryRef,
+					Attribution:     "Copyright Acme",
+					Justification:   "Found in CycloneDX document.",
+					TimeScanned:     timestamp,
+				},
+			},
+			{
+				Pkg:        libB,
+				Discovered: []model.LicenseInputSpec{{Name: "BSD-3-Clause"}},
+				CertifyLegal: &model.CertifyLegalInputSpec{
+					DiscoveredLicense: "BSD-3-Clause",
+					Attribution:       "Copyright B",
+					Justification:     "Found in CycloneDX document.",
+					TimeScanned:       timestamp,
+				},
+			},
+			{
+				Pkg:      payments,
+				Declared: []model.LicenseInputSpec{{Name: proprietaryRef, Inline: &proprietary}},
+				CertifyLegal: &model.CertifyLegalInputSpec{
+					DeclaredLicense: proprietaryRef,
+					Justification:   "Found in CycloneDX document.",
+					TimeScanned:     timestamp,
+				},
+			},
+		},
+	}
+}
+
+func Test_versRange(t *testing.T) {
+	tests := []struct {
+		vers    string
+		want    string
+		wantErr bool
+	}{
+		{vers: "vers:generic/>=2.9|<=4.1", want: ">=2.9,<=4.1"},
+		{vers: "vers:npm/1.2.3|>=2.0.0|<3.0.0|>=4.0.0", want: "=1.2.3||>=2.0.0,<3.0.0||>=4.0.0"},
+		{vers: "vers:generic/<1.0", want: "<1.0"},
+		{vers: "vers:generic/*", want: ""},
+		{vers: ">=1.0", want: ">=1.0"},
+		{vers: "vers:generic/!=1.0", wantErr: true},
+		{vers: "vers:>=1.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.vers, func(t *testing.T) {
+			got, err := versRange(tt.vers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("versRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("versRange() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cyclonedxParser_getAffectedRange(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	vuln := &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2021-44228"}
+	vexData := model.VexStatementInputSpec{
+		Status:      model.VexStatusAffected,
+		StatusNotes: "AFFECTED:NOT_PROVIDED",
+	}
+
+	c := NewCycloneDXParser().(*cyclonedxParser)
+	for _, version := range []string{"2.4", "3.0", "5.0"} {
+		c.packagePackages["abc-"+version] = []*model.PkgInputSpec{guacPkgHelper("product-ABC", version)}
+	}
+
+	got, err := c.getAffectedRange(ctx, vuln, vexData, "product-ABC", "vers:generic/>=2.9|<=4.1")
+	if err != nil {
+		t.Fatalf("getAffectedRange() error = %v", err)
+	}
+	want := []assembler.VexIngest{{Pkg: guacPkgHelper("product-ABC", "3.0"), Vulnerability: vuln, VexData: &vexData}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("getAffectedRange() of known versions (-want +got):\n%s", d)
+	}
+
+	got, err = c.getAffectedRange(ctx, vuln, vexData, "product-XYZ", "vers:generic/>=1.0|<2.0")
+	if err != nil {
+		t.Fatalf("getAffectedRange() error = %v", err)
+	}
+	recorded := vexData
+	recorded.StatusNotes = "AFFECTED:NOT_PROVIDED (versions: vers:generic/>=1.0|<2.0)"
+	want = []assembler.VexIngest{{Pkg: guacPkgHelper("product-XYZ", ""), Vulnerability: vuln, VexData: &recorded}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("getAffectedRange() of unknown versions (-want +got):\n%s", d)
+	}
+
+	// a range that can not be matched is recorded as well
+	affects := cdx.Affects{
+		Ref:   "urn:cdx:3e671687-395b-41f5-a30f-a58921a69b79/1#product-ABC",
+		Range: &[]cdx.AffectedVersions{{Range: "vers:generic/!=3.0"}},
+	}
+	gotPkgs, err := c.getAffectedPackages(ctx, vuln, vexData, affects)
+	if err != nil {
+		t.Fatalf("getAffectedPackages() error = %v", err)
+	}
+	recorded.StatusNotes = "AFFECTED:NOT_PROVIDED (versions: vers:generic/!=3.0)"
+	want = []assembler.VexIngest{{Pkg: guacPkgHelper("product-ABC", ""), Vulnerability: vuln, VexData: &recorded}}
+	if d := cmp.Diff(want, *gotPkgs); d != "" {
+		t.Errorf("getAffectedPackages() of an invalid range (-want +got):\n%s", d)
+	}
+
+	// a range of versions that are not affected is not recorded, as it would
+	// clear all the versions of the package
+	notAffected := model.VexStatementInputSpec{
+		Status:           model.VexStatusNotAffected,
+		VexJustification: model.VexJustificationVulnerableCodeNotPresent,
+	}
+	got, err = c.getAffectedRange(ctx, vuln, notAffected, "product-XYZ", "vers:generic/>=1.0|<2.0")
+	if err != nil || len(got) != 0 {
+		t.Errorf("getAffectedRange() of unknown not affected versions = %v, %v, want none", got, err)
+	}
+	got, err = c.getAffectedRange(ctx, vuln, notAffected, "product-ABC", "vers:generic/>=2.9|<=4.1")
+	want = []assembler.VexIngest{{Pkg: guacPkgHelper("product-ABC", "3.0"), Vulnerability: vuln, VexData: &notAffected}}
+	if d := cmp.Diff(want, got); err != nil || d != "" {
+		t.Errorf("getAffectedRange() of known not affected versions, error %v (-want +got):\n%s", err, d)
+	}
+	gotPkgs, err = c.getAffectedPackages(ctx, vuln, notAffected, affects)
+	if err != nil || len(*gotPkgs) != 0 {
+		t.Errorf("getAffectedPackages() of an invalid not affected range = %v, %v, want none", gotPkgs, err)
+	}
+}
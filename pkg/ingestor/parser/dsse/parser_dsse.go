@@ -71,7 +71,7 @@ func (d *dsseParser) getIdentity(ctx context.Context) error {
 		}
 	} */
 	logger := logging.FromContext(ctx)
-	logger.Warn("DSSE verification currently not implemented in this release. Continuing without DSSE verification")
+	logger.Debug("DSSE signatures are only verified when a trust policy is set, see the trust-policy flag")
 	return nil
 }
 
@@ -18,9 +18,13 @@ package parser
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/guacsec/guac/pkg/assembler"
 	"github.com/guacsec/guac/pkg/emitter"
@@ -35,7 +39,11 @@ import (
 	"github.com/guacsec/guac/pkg/ingestor/parser/slsa"
 	"github.com/guacsec/guac/pkg/ingestor/parser/spdx"
 	"github.com/guacsec/guac/pkg/ingestor/parser/vuln"
+	"github.com/guacsec/guac/pkg/ingestor/trust"
+	"github.com/guacsec/guac/pkg/ingestor/verifier"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/tracing"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
@@ -45,6 +53,7 @@ func init() {
 	_ = RegisterDocumentParser(slsa.NewSLSAParser, processor.DocumentITE6SLSA)
 	_ = RegisterDocumentParser(vuln.NewVulnCertificationParser, processor.DocumentITE6Vul)
 	_ = RegisterDocumentParser(spdx.NewSpdxParser, processor.DocumentSPDX)
+	_ = RegisterDocumentParser(spdx.NewSpdx3Parser, processor.DocumentSPDX3)
 	_ = RegisterDocumentParser(cyclonedx.NewCycloneDXParser, processor.DocumentCycloneDX)
 	_ = RegisterDocumentParser(scorecard.NewScorecardParser, processor.DocumentScorecard)
 	_ = RegisterDocumentParser(deps_dev.NewDepsDevParser, processor.DocumentDepsDev)
@@ -59,6 +68,9 @@ var (
 type docTreeBuilder struct {
 	identities    []common.TrustInformation
 	graphBuilders []*common.GraphBuilder
+	// sources holds the source information of the documents the graph
+	// builders parsed, by index.
+	sources []processor.SourceInformation
 }
 
 func newDocTreeBuilder() *docTreeBuilder {
@@ -93,21 +105,36 @@ func Subscribe(ctx context.Context, transportFunc func([]assembler.IngestPredica
 		return err
 	}
 
+	// publishFailed publishes documents that failed to the failed documents
+	publishFailed := func(ctx context.Context, d []byte, err error) {
+		if err := emitter.PublishFailed(ctx, emitter.SubjectNameDocProcessed, d, err, 1); err != nil {
+			logger.Errorf("[ingestor: %s] failed to publish failed document: %v", uuidString, err)
+		}
+	}
+
 	// should still continue if there are errors since problem is with individual documents
-	parserFunc := func(d []byte) error {
+	parserFunc := func(ctx context.Context, d []byte) error {
 		docNode := processor.DocumentNode{}
 		err = json.Unmarshal(d, &docNode)
 		if err != nil {
 			logger.Error("[ingestor: %s] failed unmarshal the document tree bytes: %v", uuidString, err)
+			publishFailed(ctx, d, emitter.NewStageError(emitter.StageDecode, err))
 			return nil
 		}
+		// parsing is not retried, as it fails the same way every time
 		assemblerInputs, idStrings, err := ParseDocumentTree(ctx, &docNode)
 		if err != nil {
 			logger.Error("[ingestor: %s] failed parse document: %v", uuidString, err)
+			publishFailed(ctx, d, emitter.NewStageError(emitter.StageParse, err))
 			return nil
 		}
 
-		err = transportFunc(assemblerInputs, idStrings)
+		err = emitter.Retry(ctx, emitter.DefaultRetryPolicy, emitter.SubjectNameDocProcessed, d, func(context.Context, []byte) error {
+			if err := transportFunc(assemblerInputs, idStrings); err != nil {
+				return emitter.NewStageError(emitter.StageAssemble, err)
+			}
+			return nil
+		})
 		if err != nil {
 			logger.Error("[ingestor: %s] failed transportFunc: %v", uuidString, err)
 			return nil
@@ -126,6 +153,18 @@ func Subscribe(ctx context.Context, transportFunc func([]assembler.IngestPredica
 
 // ParseDocumentTree takes the DocumentTree and create graph inputs (nodes and edges) per document node.
 func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree) ([]assembler.IngestPredicates, []*common.IdentifierStrings, error) {
+	docType := string(docTree.Document.Type)
+	// the parsers do not trace themselves, so the context of the span is not
+	// passed down to them
+	_, span := tracing.Start(ctx, "parse", trace.WithAttributes(attribute.String("guac.document_type", docType)))
+	start := time.Now()
+	assemblerInputs, identifierStrings, err := parseDocumentTree(ctx, docTree)
+	metrics.FromContext(ctx).ObserveSummaryVec(metrics.ParseSeconds, time.Since(start).Seconds(), docType)
+	tracing.End(span, err)
+	return assemblerInputs, identifierStrings, err
+}
+
+func parseDocumentTree(ctx context.Context, docTree processor.DocumentTree) ([]assembler.IngestPredicates, []*common.IdentifierStrings, error) {
 	assemblerInputs := []assembler.IngestPredicates{}
 	identifierStrings := []*common.IdentifierStrings{}
 	logger := logging.FromContext(ctx)
@@ -137,8 +176,8 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree) ([]a
 		return nil, nil, err
 	}
 
-	for _, builder := range docTreeBuilder.graphBuilders {
-		assemblerInput := builder.CreateAssemblerInput(ctx, docTreeBuilder.identities, docTree.Document.SourceInformation)
+	for i, builder := range docTreeBuilder.graphBuilders {
+		assemblerInput := builder.CreateAssemblerInput(ctx, builder.GetIdentities(), docTreeBuilder.sources[i])
 		assemblerInputs = append(assemblerInputs, *assemblerInput)
 		if idStrings, err := builder.GetIdentifiers(ctx); err == nil {
 			identifierStrings = append(identifierStrings, idStrings)
@@ -162,6 +201,28 @@ type visitedKey struct {
 
 // The visited map is used to keep track of the document nodes that have already been visited to avoid infinite loops.
 func (t *docTreeBuilder) parse(ctx context.Context, root processor.DocumentTree, visited map[visitedKey]bool) error {
+	return t.parseTrusted(ctx, root, visited, nil)
+}
+
+// parseTrusted parses the tree like parse, and applies the trust policy, if
+// one is set, to its documents. The signers are the identities that signed
+// the envelopes and archives that the root came in.
+func (t *docTreeBuilder) parseTrusted(ctx context.Context, root processor.DocumentTree, visited map[visitedKey]bool, signers []verifier.Identity) error {
+	policy := trust.GetPolicy()
+	if policy != nil {
+		signers = append(signers[:len(signers):len(signers)], verifySignatures(ctx, root.Document)...)
+	}
+
+	// archives carry no evidence of their own, only the documents unpacked from them
+	if root.Document.Type == processor.DocumentArchive {
+		for _, c := range root.Children {
+			if err := t.parseTrusted(ctx, c, visited, signers); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	builder, err := parseHelper(ctx, root.Document)
 	if err != nil {
 		return err
@@ -173,17 +234,80 @@ func (t *docTreeBuilder) parse(ctx context.Context, root processor.DocumentTree,
 	}
 	visited[key] = true
 
+	// the signers of a DSSE envelope sign its payload, which is checked instead
+	if policy != nil && root.Document.Type != processor.DocumentDSSE {
+		identities, trusted := trustInformation(policy, root.Document.Type, signers)
+		if !trusted && policy.Enforce {
+			return fmt.Errorf("document %s of type %s is not signed by an identity trusted by the trust policy",
+				root.Document.SourceInformation.Source, root.Document.Type)
+		}
+		builder.AddIdentities(identities...)
+	}
+
 	t.graphBuilders = append(t.graphBuilders, builder)
+	t.sources = append(t.sources, sourceInformation(root.Document))
 	t.identities = append(t.identities, builder.GetIdentities()...)
 
+	var childSigners []verifier.Identity
+	if root.Document.Type == processor.DocumentDSSE {
+		childSigners = signers
+	}
 	for _, c := range root.Children {
-		if err := t.parse(ctx, c, visited); err != nil {
+		if err := t.parseTrusted(ctx, c, visited, childSigners); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// sourceInformation returns the source information recorded with the
+// evidence of a document. The path of a document unpacked from an archive is
+// appended to the source of the archive, so that the evidence of each member
+// can be told apart, and retracted, on its own.
+func sourceInformation(doc *processor.Document) processor.SourceInformation {
+	srcInfo := doc.SourceInformation
+	if srcInfo.Path != "" {
+		srcInfo.Source = strings.TrimSuffix(srcInfo.Source, "/") + "/" + srcInfo.Path
+	}
+	return srcInfo
+}
+
+// verifySignatures returns the identities that signed the document, either
+// as a DSSE envelope or with detached signatures. A document that can not be
+// verified is treated as unsigned.
+func verifySignatures(ctx context.Context, doc *processor.Document) []verifier.Identity {
+	if doc.Type != processor.DocumentDSSE && len(doc.Signatures) == 0 {
+		return nil
+	}
+	identities, err := verifier.VerifyIdentity(ctx, doc)
+	if err != nil {
+		logger := logging.FromContext(ctx)
+		logger.Warnf("failed to verify the signatures of %s: %v", doc.SourceInformation.Source, err)
+		return nil
+	}
+	return identities
+}
+
+// trustInformation returns the trust information of a document signed by
+// the signers, and whether any of them is trusted for its type.
+func trustInformation(policy *trust.Policy, docType processor.DocumentType, signers []verifier.Identity) ([]common.TrustInformation, bool) {
+	if len(signers) == 0 {
+		return []common.TrustInformation{{}}, false
+	}
+	var identities []common.TrustInformation
+	trusted := false
+	for _, signer := range signers {
+		verified := policy.Trusts(docType, signer)
+		trusted = trusted || verified
+		identities = append(identities, common.TrustInformation{
+			ID:       signer.ID,
+			KeyHash:  signer.Key.Hash,
+			Verified: verified,
+		})
+	}
+	return identities, trusted
+}
+
 func parseHelper(ctx context.Context, doc *processor.Document) (*common.GraphBuilder, error) {
 	pFunc, ok := documentParser[doc.Type]
 	if !ok {
@@ -25,8 +25,11 @@ import (
 
 	"github.com/guacsec/guac/internal/testing/mockverifier"
 	nats_test "github.com/guacsec/guac/internal/testing/nats"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/ingestor/trust"
 	"github.com/guacsec/guac/pkg/ingestor/verifier"
 	"github.com/guacsec/guac/pkg/logging"
 
@@ -309,6 +312,160 @@ func TestParseDocumentTree(t *testing.T) {
 	}
 }
 
+func TestParseDocumentTree_ArchiveMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDocumentParser := mocks.NewMockDocumentParser(ctrl)
+	ctx := context.Background()
+
+	mockDocumentParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockDocumentParser.EXPECT().GetIdentities(gomock.Any()).Return([]common.TrustInformation{}).AnyTimes()
+	mockDocumentParser.EXPECT().GetPredicates(gomock.Any()).DoAndReturn(func(ctx context.Context) *assembler.IngestPredicates {
+		return &assembler.IngestPredicates{IsDependency: []assembler.IsDependencyIngest{{IsDependency: &generated.IsDependencyInputSpec{}}}}
+	}).AnyTimes()
+	mockDocumentParser.EXPECT().GetIdentifiers(gomock.Any()).Return(&common.IdentifierStrings{}, nil).AnyTimes()
+	_ = RegisterDocumentParser(func() common.DocumentParser { return mockDocumentParser }, "test")
+
+	srcInfo := processor.SourceInformation{Collector: "test", Source: "file:///sboms.tar.gz"}
+	member := func(path string) *processor.DocumentNode {
+		memberInfo := srcInfo
+		memberInfo.Path = path
+		return &processor.DocumentNode{Document: &processor.Document{Type: "test", SourceInformation: memberInfo}}
+	}
+	docTree := &processor.DocumentNode{
+		Document: &processor.Document{Type: processor.DocumentArchive, SourceInformation: srcInfo},
+		Children: []*processor.DocumentNode{member("a.json"), member("nested/b.json")},
+	}
+
+	got, _, err := ParseDocumentTree(ctx, docTree)
+	if err != nil {
+		t.Fatalf("ParseDocumentTree() error = %v", err)
+	}
+	var origins []string
+	for _, p := range got {
+		origins = append(origins, p.IsDependency[0].IsDependency.Origin)
+	}
+	want := []string{"file:///sboms.tar.gz/a.json", "file:///sboms.tar.gz/nested/b.json"}
+	if !reflect.DeepEqual(origins, want) {
+		t.Errorf("ParseDocumentTree() origins = %v, want %v", origins, want)
+	}
+}
+
+// signatureVerifier verifies the detached signatures that name the identity
+// that signed the document.
+type signatureVerifier struct{}
+
+func (signatureVerifier) Verify(ctx context.Context, payloadBytes []byte) ([]verifier.Identity, error) {
+	return nil, errors.New("detached only")
+}
+
+func (signatureVerifier) VerifyDetached(ctx context.Context, payloadBytes []byte, signatureBytes []byte) ([]verifier.Identity, error) {
+	return []verifier.Identity{{ID: string(signatureBytes), Verified: true}}, nil
+}
+
+func (signatureVerifier) Type() verifier.VerifierType {
+	return "test-signature"
+}
+
+func TestParseDocumentTree_TrustPolicy(t *testing.T) {
+	policy, err := trust.Parse([]byte(`
+rules:
+  - identities: [release]
+    documentTypes: [test-trust]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = verifier.RegisterVerifier(signatureVerifier{}, "test-signature") // Ignoring error because it is mutating a global variable
+	trust.SetPolicy(policy)
+	defer trust.SetPolicy(nil)
+
+	signed := func(signer string) *processor.Document {
+		doc := &processor.Document{
+			Type:              "test-trust",
+			SourceInformation: processor.SourceInformation{Collector: "FileCollector", Source: "sbom.json"},
+		}
+		if signer != "" {
+			doc.Signatures = []processor.Signature{{Type: "test-signature", Blob: []byte(signer)}}
+		}
+		return doc
+	}
+	tests := []struct {
+		name         string
+		enforce      bool
+		docTree      processor.DocumentTree
+		wantVerified bool
+		wantSigner   *string
+		wantErr      bool
+	}{{
+		name:         "trusted signer",
+		docTree:      &processor.DocumentNode{Document: signed("release")},
+		wantVerified: true,
+		wantSigner:   ptrfrom.String("release"),
+	}, {
+		name:       "untrusted signer",
+		docTree:    &processor.DocumentNode{Document: signed("someone")},
+		wantSigner: ptrfrom.String("someone"),
+	}, {
+		name:    "unsigned",
+		docTree: &processor.DocumentNode{Document: signed("")},
+	}, {
+		name:    "untrusted signer enforced",
+		enforce: true,
+		docTree: &processor.DocumentNode{Document: signed("someone")},
+		wantErr: true,
+	}, {
+		name:    "signed archive",
+		enforce: true,
+		docTree: &processor.DocumentNode{
+			Document: &processor.Document{
+				Type:              processor.DocumentArchive,
+				SourceInformation: processor.SourceInformation{Collector: "FileCollector", Source: "sboms.tar.gz"},
+				Signatures:        []processor.Signature{{Type: "test-signature", Blob: []byte("release")}},
+			},
+			Children: []*processor.DocumentNode{{Document: signed("")}},
+		},
+		wantVerified: true,
+		wantSigner:   ptrfrom.String("release"),
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDocumentParser := mocks.NewMockDocumentParser(ctrl)
+			ctx := logging.WithLogger(context.Background())
+			policy.Enforce = test.enforce
+
+			mockDocumentParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockDocumentParser.EXPECT().GetIdentities(gomock.Any()).Return([]common.TrustInformation{}).AnyTimes()
+			mockDocumentParser.EXPECT().GetPredicates(gomock.Any()).DoAndReturn(func(ctx context.Context) *assembler.IngestPredicates {
+				return &assembler.IngestPredicates{
+					HasSBOM: []assembler.HasSBOMIngest{{HasSBOM: &generated.HasSBOMInputSpec{}}},
+				}
+			}).AnyTimes()
+			mockDocumentParser.EXPECT().GetIdentifiers(gomock.Any()).Return(&common.IdentifierStrings{}, nil).AnyTimes()
+			_ = RegisterDocumentParser(func() common.DocumentParser { return mockDocumentParser }, "test-trust") // Ignoring error because it is mutating a global variable
+
+			got, _, err := ParseDocumentTree(ctx, test.docTree)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseDocumentTree() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("ParseDocumentTree() got = %+v, want a single document", got)
+			}
+			hasSBOM := got[0].HasSBOM[0].HasSBOM
+			if hasSBOM.Verified == nil || *hasSBOM.Verified != test.wantVerified || !reflect.DeepEqual(hasSBOM.Signer, test.wantSigner) {
+				t.Errorf("ParseDocumentTree() got HasSBOM %+v, want verified %v by %v", hasSBOM, test.wantVerified, test.wantSigner)
+			}
+			if hasSBOM.Collector != "" {
+				t.Errorf("ParseDocumentTree() changed the HasSBOM collector to %q", hasSBOM.Collector)
+			}
+		})
+	}
+}
+
 func TestSubscribe(t *testing.T) {
 	ctx := logging.WithLogger(context.Background())
 	err := verifier.RegisterVerifier(mockverifier.NewMockSigstoreVerifier(), "sigstore")
@@ -35,6 +35,11 @@ import (
 	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
 )
 
+const (
+	spdxTransitiveDependencyJustification = "SPDX transitive dependency"
+	topLevelHeuristicJustification        = "top-level package GUAC heuristic connecting to each file/package"
+)
+
 type spdxParser struct {
 	// TODO: Add hasSBOMInputSpec when its created
 	doc                 *processor.Document
@@ -252,24 +257,27 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 			preds.HasSBOM = append(preds.HasSBOM, common.CreateTopLevelHasSBOM(topLevelPkg, s.doc, s.spdxDoc.DocumentNamespace, timestamp))
 		}
 
-		if s.topLevelIsHeuristic {
-			preds.IsDependency = append(preds.IsDependency,
-				common.CreateTopLevelIsDeps(topLevel[0], s.packagePackages, s.filePackages,
-					"top-level package GUAC heuristic connecting to each file/package")...)
+		isDeps, err := s.getTopLevelIsDeps(topLevel[0])
+		if err != nil {
+			logger.Errorf("error getting predicates: %v", err)
+			return preds
 		}
+		preds.IsDependency = append(preds.IsDependency, isDeps...)
 	}
 	for _, rel := range s.spdxDoc.Relationships {
 		var foundId string
 		var relatedId string
 
-		if isDependency(rel.Relationship) {
-			foundId = string(rel.RefA.ElementRefID)
-			relatedId = string(rel.RefB.ElementRefID)
-		} else if isDependent(rel.Relationship) || isPackageOf(rel.Relationship) {
+		dep, ok := spdxDependencies[rel.Relationship]
+		if !ok {
+			continue
+		}
+		if dep.ofDependent {
 			foundId = string(rel.RefB.ElementRefID)
 			relatedId = string(rel.RefA.ElementRefID)
 		} else {
-			continue
+			foundId = string(rel.RefA.ElementRefID)
+			relatedId = string(rel.RefB.ElementRefID)
 		}
 
 		foundPackNodes := s.getPackageElement(foundId)
@@ -280,7 +288,7 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 		justification := getJustification(rel)
 
 		for _, packNode := range foundPackNodes {
-			p, err := common.GetIsDep(packNode, relatedPackNodes, relatedFileNodes, justification)
+			p, err := common.GetIsDep(packNode, relatedPackNodes, relatedFileNodes, dep.dependencyType, dep.dependencyScope, justification)
 			if err != nil {
 				logger.Errorf("error generating spdx edge %v", err)
 				continue
@@ -290,7 +298,7 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 			}
 		}
 		for _, fileNode := range foundFileNodes {
-			p, err := common.GetIsDep(fileNode, relatedPackNodes, relatedFileNodes, justification)
+			p, err := common.GetIsDep(fileNode, relatedPackNodes, relatedFileNodes, dep.dependencyType, dep.dependencyScope, justification)
 			if err != nil {
 				logger.Errorf("error generating spdx edge %v", err)
 				continue
@@ -388,6 +396,126 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 	return preds
 }
 
+// getTopLevelIsDeps connects the top level package to the dependencies the
+// relationships do not connect it to, see topLevelIsDeps.
+func (s *spdxParser) getTopLevelIsDeps(topLevel *model.PkgInputSpec) ([]assembler.IsDependencyIngest, error) {
+	elements := map[string][]*model.PkgInputSpec{}
+	for id, pkgs := range s.packagePackages {
+		elements[id] = append(elements[id], pkgs...)
+	}
+	for id, files := range s.filePackages {
+		elements[id] = append(elements[id], files...)
+	}
+	if s.topLevelIsHeuristic {
+		return heuristicTopLevelIsDeps(topLevel, s.dependencyGraph(), string(s.spdxDoc.SPDXIdentifier), elements), nil
+	}
+	topLevelSpdxIds, err := s.getTopLevelPackageSpdxIds()
+	if err != nil {
+		return nil, err
+	}
+	topLevelPackages := map[string][]*model.PkgInputSpec{}
+	for _, id := range topLevelSpdxIds {
+		topLevelPackages[id] = s.packagePackages[id]
+	}
+	return transitiveTopLevelIsDeps(topLevelPackages, s.dependencyGraph(), elements), nil
+}
+
+// transitiveTopLevelIsDeps connects the top level packages, by the ID of
+// their element, to the transitive dependencies of their element in the
+// dependency graph, as the relationships only connect them to their direct
+// dependencies.
+func transitiveTopLevelIsDeps(topLevelPackages map[string][]*model.PkgInputSpec, graph map[string][]string, elements map[string][]*model.PkgInputSpec) []assembler.IsDependencyIngest {
+	var isDeps []assembler.IsDependencyIngest
+	for topLevelID, topLevelPkgs := range topLevelPackages {
+		for id, depth := range dependencyDepths(graph, topLevelID) {
+			if depth < 2 {
+				continue
+			}
+			for _, topLevelPkg := range topLevelPkgs {
+				for _, pkg := range elements[id] {
+					isDeps = append(isDeps, common.CreateIsDep(topLevelPkg, pkg, model.DependencyTypeIndirect, spdxTransitiveDependencyJustification))
+				}
+			}
+		}
+	}
+	return isDeps
+}
+
+// heuristicTopLevelIsDeps connects the GUAC heuristic top level package to
+// every element of the document. It directly depends on the elements no
+// other element depends on, and transitively on the ones they depend on.
+// The elements the dependency graph does not reach from them, such as the
+// ones of a cycle, are connected with an unknown type.
+func heuristicTopLevelIsDeps(topLevel *model.PkgInputSpec, graph map[string][]string, root string, elements map[string][]*model.PkgInputSpec) []assembler.IsDependencyIngest {
+	dependencies := map[string]bool{}
+	for _, deps := range graph {
+		for _, dep := range deps {
+			dependencies[dep] = true
+		}
+	}
+	for id := range elements {
+		if !dependencies[id] {
+			graph[root] = append(graph[root], id)
+		}
+	}
+	depths := dependencyDepths(graph, root)
+
+	var isDeps []assembler.IsDependencyIngest
+	unreached := map[string][]*model.PkgInputSpec{}
+	for id, pkgs := range elements {
+		depth, ok := depths[id]
+		switch {
+		case !ok:
+			unreached[id] = pkgs
+		case depth == 1:
+			for _, pkg := range pkgs {
+				isDeps = append(isDeps, common.CreateIsDep(topLevel, pkg, model.DependencyTypeDirect, topLevelHeuristicJustification))
+			}
+		default:
+			for _, pkg := range pkgs {
+				isDeps = append(isDeps, common.CreateIsDep(topLevel, pkg, model.DependencyTypeIndirect, topLevelHeuristicJustification))
+			}
+		}
+	}
+	return append(isDeps, common.CreateTopLevelIsDeps(topLevel, unreached, nil, topLevelHeuristicJustification)...)
+}
+
+// dependencyGraph returns the elements each element depends on, following
+// the relationships ingested as IsDependency.
+func (s *spdxParser) dependencyGraph() map[string][]string {
+	graph := map[string][]string{}
+	for _, rel := range s.spdxDoc.Relationships {
+		dep, ok := spdxDependencies[rel.Relationship]
+		if !ok {
+			continue
+		}
+		dependent, dependency := string(rel.RefA.ElementRefID), string(rel.RefB.ElementRefID)
+		if dep.ofDependent {
+			dependent, dependency = dependency, dependent
+		}
+		graph[dependent] = append(graph[dependent], dependency)
+	}
+	return graph
+}
+
+// dependencyDepths returns the depth in the dependency graph of each element
+// reachable from the root.
+func dependencyDepths(graph map[string][]string, root string) map[string]int {
+	depths := map[string]int{root: 0}
+	queue := []string{root}
+	for len(queue) > 0 {
+		now := queue[0]
+		queue = queue[1:]
+		for _, dep := range graph[now] {
+			if _, seen := depths[dep]; !seen {
+				depths[dep] = depths[now] + 1
+				queue = append(queue, dep)
+			}
+		}
+	}
+	return depths
+}
+
 func fixLicense(ctx context.Context, l *generated.LicenseInputSpec, ol []*spdx.OtherLicense) (string, string) {
 	logger := logging.FromContext(ctx)
 	if !strings.HasPrefix(l.Name, "LicenseRef-") {
@@ -412,24 +540,35 @@ func fixLicense(ctx context.Context, l *generated.LicenseInputSpec, ol []*spdx.O
 	return oldName, l.Name
 }
 
-func isDependency(rel string) bool {
-	return map[string]bool{
-		spdx_common.TypeRelationshipContains:  true,
-		spdx_common.TypeRelationshipDependsOn: true,
-	}[rel]
-}
-
-func isDependent(rel string) bool {
-	return map[string]bool{
-		spdx_common.TypeRelationshipContainedBy:  true,
-		spdx_common.TypeRelationshipDependencyOf: true,
-	}[rel]
+// spdxDependency is the IsDependency an SPDX relationship is ingested as.
+type spdxDependency struct {
+	// ofDependent is set for the relationships from the dependency to the
+	// element depending on it, like DEPENDENCY_OF.
+	ofDependent     bool
+	dependencyType  model.DependencyType
+	dependencyScope model.DependencyScope
 }
 
-func isPackageOf(rel string) bool {
-	return map[string]bool{
-		spdx_common.TypeRelationshipPackageOf: true,
-	}[rel]
+// spdxDependencies maps the SPDX relationships ingested as IsDependency.
+// Relationships between two elements state that one directly depends on the
+// other, except for containment which says nothing about how the contained
+// element is depended on.
+var spdxDependencies = map[string]spdxDependency{
+	spdx_common.TypeRelationshipContains:             {false, model.DependencyTypeUnknown, model.DependencyScopeUnknown},
+	spdx_common.TypeRelationshipDependsOn:            {false, model.DependencyTypeDirect, model.DependencyScopeUnknown},
+	spdx_common.TypeRelationshipContainedBy:          {true, model.DependencyTypeUnknown, model.DependencyScopeUnknown},
+	spdx_common.TypeRelationshipPackageOf:            {true, model.DependencyTypeUnknown, model.DependencyScopeUnknown},
+	spdx_common.TypeRelationshipDependencyOf:         {true, model.DependencyTypeDirect, model.DependencyScopeUnknown},
+	spdx_common.TypeRelationshipRuntimeDependencyOf:  {true, model.DependencyTypeDirect, model.DependencyScopeRuntime},
+	spdx_common.TypeRelationshipDevDependencyOf:      {true, model.DependencyTypeDirect, model.DependencyScopeDev},
+	spdx_common.TypeRelationshipDevToolOf:            {true, model.DependencyTypeDirect, model.DependencyScopeDev},
+	spdx_common.TypeRelationshipBuildDependencyOf:    {true, model.DependencyTypeDirect, model.DependencyScopeBuild},
+	spdx_common.TypeRelationshipBuildToolOf:          {true, model.DependencyTypeDirect, model.DependencyScopeBuild},
+	spdx_common.TypeRelationshipTestDependencyOf:     {true, model.DependencyTypeDirect, model.DependencyScopeTest},
+	spdx_common.TypeRelationshipTestToolOf:           {true, model.DependencyTypeDirect, model.DependencyScopeTest},
+	spdx_common.TypeRelationshipOptionalDependencyOf: {true, model.DependencyTypeDirect, model.DependencyScopeOptional},
+	spdx_common.TypeRelationshipOptionalComponentOf:  {true, model.DependencyTypeUnknown, model.DependencyScopeOptional},
+	spdx_common.TypeRelationshipProvidedDependencyOf: {true, model.DependencyTypeDirect, model.DependencyScopeProvided},
 }
 
 func (s *spdxParser) GetIdentities(ctx context.Context) []common.TrustInformation {
@@ -0,0 +1,608 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	spdxprocessor "github.com/guacsec/guac/pkg/handler/processor/spdx"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Types of the SPDX 3 elements ingested, other elements, like those of the
+// Build profile, are ignored.
+const (
+	spdx3Package                     = "software_Package"
+	spdx3File                        = "software_File"
+	spdx3LicenseExpression           = "simplelicensing_LicenseExpression"
+	spdx3Vulnerability               = "security_Vulnerability"
+	spdx3VexAffected                 = "security_VexAffectedVulnAssessmentRelationship"
+	spdx3VexNotAffected              = "security_VexNotAffectedVulnAssessmentRelationship"
+	spdx3VexFixed                    = "security_VexFixedVulnAssessmentRelationship"
+	spdx3VexUnderInvestigation       = "security_VexUnderInvestigationVulnAssessmentRelationship"
+	spdx3CvssV2                      = "security_CvssV2VulnAssessmentRelationship"
+	spdx3CvssV3                      = "security_CvssV3VulnAssessmentRelationship"
+	spdx3CvssV4                      = "security_CvssV4VulnAssessmentRelationship"
+	spdx3HasDeclaredLicense          = "hasDeclaredLicense"
+	spdx3HasConcludedLicense         = "hasConcludedLicense"
+	spdx3LifecycleScopedRelationship = "LifecycleScopedRelationship"
+)
+
+type spdx3Parser struct {
+	doc                 *processor.Document
+	packagePackages     map[string][]*model.PkgInputSpec
+	packageArtifacts    map[string][]*model.ArtifactInputSpec
+	filePackages        map[string][]*model.PkgInputSpec
+	fileArtifacts       map[string][]*model.ArtifactInputSpec
+	topLevelPackages    []*model.PkgInputSpec
+	topLevelIDs         []string
+	identifierStrings   *common.IdentifierStrings
+	spdxDoc             *spdxprocessor.SPDX3Document
+	topLevelIsHeuristic bool
+	timeScanned         time.Time
+}
+
+// NewSpdx3Parser returns the parser of SPDX 3 JSON-LD documents.
+func NewSpdx3Parser() common.DocumentParser {
+	return &spdx3Parser{
+		packagePackages:   map[string][]*model.PkgInputSpec{},
+		packageArtifacts:  map[string][]*model.ArtifactInputSpec{},
+		filePackages:      map[string][]*model.PkgInputSpec{},
+		fileArtifacts:     map[string][]*model.ArtifactInputSpec{},
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+func (s *spdx3Parser) Parse(ctx context.Context, doc *processor.Document) error {
+	s.doc = doc
+	spdxDoc, err := spdxprocessor.ParseSPDX3(doc.Blob)
+	if err != nil {
+		return fmt.Errorf("failed to parse SPDX 3 document: %w", err)
+	}
+	if err := spdxDoc.CheckStructure(); err != nil {
+		return fmt.Errorf("invalid SPDX 3 document: %w", err)
+	}
+	s.spdxDoc = spdxDoc
+	created := spdxDoc.CreationInfo(spdxDoc.SpdxDocument()).Created
+	s.timeScanned, err = time.Parse(time.RFC3339, created)
+	if err != nil {
+		return fmt.Errorf("SPDX 3 document had invalid created time %q : %w", created, err)
+	}
+	for _, e := range spdxDoc.Graph {
+		switch e.Type {
+		case spdx3Package:
+			if err := s.getPackage(e); err != nil {
+				return err
+			}
+		case spdx3File:
+			if err := s.getFile(e); err != nil {
+				return err
+			}
+		}
+	}
+	return s.getTopLevelPackages()
+}
+
+func (s *spdx3Parser) getPackage(e *spdxprocessor.SPDX3Element) error {
+	purl := e.PackageURL
+	if purl == "" {
+		for _, id := range e.ExternalIdentifier {
+			if id.ExternalIdentifierType == "packageUrl" {
+				purl = id.Identifier
+			}
+		}
+	}
+	if purl == "" {
+		purl = asmhelpers.GuacPkgPurl(e.Name, &e.PackageVersion)
+	}
+
+	s.identifierStrings.PurlStrings = append(s.identifierStrings.PurlStrings, purl)
+
+	pkg, err := asmhelpers.PurlToPkg(purl)
+	if err != nil {
+		return err
+	}
+	s.packagePackages[e.SpdxID] = append(s.packagePackages[e.SpdxID], pkg)
+
+	// if hashes exists create an artifact for each of them
+	for _, hash := range e.VerifiedUsing {
+		artifact := &model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(hash.Algorithm),
+			Digest:    hash.HashValue,
+		}
+		s.packageArtifacts[e.SpdxID] = append(s.packageArtifacts[e.SpdxID], artifact)
+	}
+	return nil
+}
+
+func (s *spdx3Parser) getFile(e *spdxprocessor.SPDX3Element) error {
+	for _, hash := range e.VerifiedUsing {
+		if isEmptyChecksum(hash.HashValue) {
+			continue
+		}
+		algorithm := strings.ToLower(hash.Algorithm)
+		// for each file create a package for each of them so they can be referenced as a dependency
+		purl := asmhelpers.GuacFilePurl(algorithm, hash.HashValue, &e.Name)
+		pkg, err := asmhelpers.PurlToPkg(purl)
+		if err != nil {
+			return err
+		}
+		s.filePackages[e.SpdxID] = append(s.filePackages[e.SpdxID], pkg)
+
+		artifact := &model.ArtifactInputSpec{
+			Algorithm: algorithm,
+			Digest:    hash.HashValue,
+		}
+		s.fileArtifacts[e.SpdxID] = append(s.fileArtifacts[e.SpdxID], artifact)
+	}
+	return nil
+}
+
+// getTopLevelPackages finds the packages the document is about, which are
+// the root elements of the SpdxDocument, or of the SBOMs it is about.
+func (s *spdx3Parser) getTopLevelPackages() error {
+	spdxDocument := s.spdxDoc.SpdxDocument()
+	seen := map[string]bool{}
+	roots := spdxDocument.RootElement
+	for len(roots) > 0 {
+		id := roots[0]
+		roots = roots[1:]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if pkgs, ok := s.packagePackages[id]; ok {
+			s.topLevelPackages = append(s.topLevelPackages, pkgs...)
+			s.topLevelIDs = append(s.topLevelIDs, id)
+		} else if e := s.spdxDoc.Element(id); e != nil {
+			roots = append(roots, e.RootElement...)
+		}
+	}
+
+	// If there is no top level package in the root elements, we take a best guess for it.
+	if len(s.topLevelPackages) == 0 {
+		purl := "pkg:guac/spdx/" + asmhelpers.SanitizeString(spdxDocument.Name)
+		topPackage, err := asmhelpers.PurlToPkg(purl)
+		if err != nil {
+			return err
+		}
+		s.topLevelPackages = append(s.topLevelPackages, topPackage)
+		s.identifierStrings.PurlStrings = append(s.identifierStrings.PurlStrings, purl)
+		s.topLevelIsHeuristic = true
+	}
+	return nil
+}
+
+func (s *spdx3Parser) getElementPackages(elementID string) []*model.PkgInputSpec {
+	if packNode, ok := s.packagePackages[elementID]; ok {
+		return packNode
+	}
+	return s.filePackages[elementID]
+}
+
+// getTopLevelIsDeps connects the top level packages to the dependencies the
+// relationships do not connect them to, the same way as for SPDX 2.
+func (s *spdx3Parser) getTopLevelIsDeps() []assembler.IsDependencyIngest {
+	elements := map[string][]*model.PkgInputSpec{}
+	for id, pkgs := range s.packagePackages {
+		elements[id] = append(elements[id], pkgs...)
+	}
+	for id, files := range s.filePackages {
+		elements[id] = append(elements[id], files...)
+	}
+	if s.topLevelIsHeuristic {
+		return heuristicTopLevelIsDeps(s.topLevelPackages[0], s.dependencyGraph(), s.spdxDoc.SpdxDocument().SpdxID, elements)
+	}
+	topLevelPackages := map[string][]*model.PkgInputSpec{}
+	for _, id := range s.topLevelIDs {
+		topLevelPackages[id] = s.packagePackages[id]
+	}
+	return transitiveTopLevelIsDeps(topLevelPackages, s.dependencyGraph(), elements)
+}
+
+// dependencyGraph maps the ID of each element to the IDs of the elements
+// it depends on.
+func (s *spdx3Parser) dependencyGraph() map[string][]string {
+	graph := map[string][]string{}
+	for _, rel := range s.spdxDoc.Graph {
+		if _, ok := spdx3Dependencies[rel.RelationshipType]; !ok || !rel.IsRelationship() {
+			continue
+		}
+		graph[rel.From] = append(graph[rel.From], rel.To...)
+	}
+	return graph
+}
+
+func (s *spdx3Parser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	logger := logging.FromContext(ctx)
+	preds := &assembler.IngestPredicates{}
+
+	for _, topLevelPkg := range s.topLevelPackages {
+		preds.HasSBOM = append(preds.HasSBOM, common.CreateTopLevelHasSBOM(topLevelPkg, s.doc, s.spdxDoc.SpdxDocument().SpdxID, s.timeScanned))
+	}
+	preds.IsDependency = append(preds.IsDependency, s.getTopLevelIsDeps()...)
+
+	for _, rel := range s.spdxDoc.Graph {
+		dep, ok := spdx3Dependencies[rel.RelationshipType]
+		if !ok || !rel.IsRelationship() {
+			continue
+		}
+		if rel.Type == spdx3LifecycleScopedRelationship && dep.dependencyScope == model.DependencyScopeUnknown {
+			dep.dependencyScope = spdx3LifecycleScopes[rel.Scope]
+		}
+		if dep.dependencyScope == "" {
+			dep.dependencyScope = model.DependencyScopeUnknown
+		}
+		justification := getSpdx3Justification(rel)
+
+		for _, foundNode := range s.getElementPackages(rel.From) {
+			for _, to := range rel.To {
+				p, err := common.GetIsDep(foundNode, s.packagePackages[to], s.filePackages[to], dep.dependencyType, dep.dependencyScope, justification)
+				if err != nil {
+					logger.Errorf("error generating spdx edge %v", err)
+					continue
+				}
+				if p != nil {
+					preds.IsDependency = append(preds.IsDependency, *p)
+				}
+			}
+		}
+	}
+
+	// Create predicates for IsOccurrence for all artifacts found
+	for id := range s.fileArtifacts {
+		for _, pkg := range s.filePackages[id] {
+			for _, art := range s.fileArtifacts[id] {
+				preds.IsOccurrence = append(preds.IsOccurrence, assembler.IsOccurrenceIngest{
+					Pkg:      pkg,
+					Artifact: art,
+					IsOccurrence: &model.IsOccurrenceInputSpec{
+						Justification: "spdx file with checksum",
+					},
+				})
+			}
+		}
+	}
+
+	for id := range s.packagePackages {
+		for _, pkg := range s.packagePackages[id] {
+			for _, art := range s.packageArtifacts[id] {
+				preds.IsOccurrence = append(preds.IsOccurrence, assembler.IsOccurrenceIngest{
+					Pkg:      pkg,
+					Artifact: art,
+					IsOccurrence: &model.IsOccurrenceInputSpec{
+						Justification: "spdx package with checksum",
+					},
+				})
+			}
+		}
+	}
+
+	preds.CertifyLegal = s.getCertifyLegals(ctx)
+	preds.HasMetadata = s.getHasMetadata()
+	preds.Vex, preds.CertifyVuln, preds.VulnMetadata = s.getVulnerabilities(ctx)
+
+	return preds
+}
+
+// getCertifyLegals creates the CertifyLegal of the packages from their
+// declared and concluded license relationships, and their copyright text.
+func (s *spdx3Parser) getCertifyLegals(ctx context.Context) []assembler.CertifyLegalIngest {
+	logger := logging.FromContext(ctx)
+	declared := map[string]*spdxprocessor.SPDX3Element{}
+	concluded := map[string]*spdxprocessor.SPDX3Element{}
+	for _, rel := range s.spdxDoc.Graph {
+		if rel.RelationshipType != spdx3HasDeclaredLicense && rel.RelationshipType != spdx3HasConcludedLicense {
+			continue
+		}
+		for _, to := range rel.To {
+			license := s.spdxDoc.Element(to)
+			if license == nil || license.Type != spdx3LicenseExpression {
+				logger.Debugf("unsupported SPDX 3 license element %q of %s", to, rel.From)
+				continue
+			}
+			if rel.RelationshipType == spdx3HasDeclaredLicense {
+				declared[rel.From] = license
+			} else {
+				concluded[rel.From] = license
+			}
+		}
+	}
+
+	var cls []assembler.CertifyLegalIngest
+	for _, pac := range s.spdxDoc.Graph {
+		if pac.Type != spdx3Package {
+			continue
+		}
+		dec, decExp := s.getLicenses(ctx, declared[pac.SpdxID])
+		dis, disExp := s.getLicenses(ctx, concluded[pac.SpdxID])
+		if decExp == "" && disExp == "" && pac.CopyrightText == "" {
+			continue
+		}
+		cl := &model.CertifyLegalInputSpec{
+			DeclaredLicense:   decExp,
+			DiscoveredLicense: disExp,
+			Attribution:       pac.CopyrightText,
+			Justification:     "Found in SPDX document.",
+			TimeScanned:       s.timeScanned,
+		}
+		for _, pkg := range s.packagePackages[pac.SpdxID] {
+			cls = append(cls, assembler.CertifyLegalIngest{
+				Pkg:          pkg,
+				Declared:     dec,
+				Discovered:   dis,
+				CertifyLegal: cl,
+			})
+		}
+	}
+	return cls
+}
+
+// getLicenses parses the licenses of the license expression element,
+// replacing the LicenseRefs of the expression by the hash of their text.
+func (s *spdx3Parser) getLicenses(ctx context.Context, license *spdxprocessor.SPDX3Element) ([]model.LicenseInputSpec, string) {
+	if license == nil {
+		return nil, ""
+	}
+	exp := license.LicenseExpression
+	licenses := common.ParseLicenses(exp, license.LicenseListVersion)
+	for i := range licenses {
+		o, n := s.fixLicense(ctx, &licenses[i], license)
+		if o != "" {
+			exp = strings.ReplaceAll(exp, o, n)
+		}
+	}
+	return licenses, exp
+}
+
+func (s *spdx3Parser) fixLicense(ctx context.Context, l *model.LicenseInputSpec, license *spdxprocessor.SPDX3Element) (string, string) {
+	logger := logging.FromContext(ctx)
+	if !strings.HasPrefix(l.Name, "LicenseRef-") {
+		return "", ""
+	}
+	oldName := l.Name
+	l.ListVersion = nil
+	found := false
+	for _, entry := range license.CustomIDToURI {
+		if entry.Key != l.Name {
+			continue
+		}
+		if text := s.spdxDoc.Element(entry.Value); text != nil && text.LicenseText != "" {
+			l.Inline = &text.LicenseText
+			found = true
+		}
+		break
+	}
+	if !found {
+		logger.Errorf("License identifier %q not found in simplelicensing_customIdToUri", l.Name)
+		s := "Not found"
+		l.Inline = &s
+	}
+	l.Name = common.HashLicense(*l.Inline)
+	return oldName, l.Name
+}
+
+func (s *spdx3Parser) getHasMetadata() []assembler.HasMetadataIngest {
+	var hms []assembler.HasMetadataIngest
+	for _, pac := range s.spdxDoc.Graph {
+		if pac.Type != spdx3Package {
+			continue
+		}
+		for _, id := range pac.ExternalIdentifier {
+			if id.ExternalIdentifierType != "cpe22" && id.ExternalIdentifierType != "cpe23" {
+				continue
+			}
+			metadataInputSpec := &model.HasMetadataInputSpec{
+				Key:           "cpe",
+				Value:         id.Identifier,
+				Timestamp:     time.Now().UTC(),
+				Justification: "spdx cpe external identifier",
+				Origin:        "GUAC SPDX",
+				Collector:     "GUAC",
+			}
+			for _, pkg := range s.packagePackages[pac.SpdxID] {
+				hms = append(hms, assembler.HasMetadataIngest{
+					Pkg:          pkg,
+					PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+					HasMetadata:  metadataInputSpec,
+				})
+			}
+		}
+	}
+	return hms
+}
+
+var spdx3VexStatuses = map[string]model.VexStatus{
+	spdx3VexAffected:           model.VexStatusAffected,
+	spdx3VexNotAffected:        model.VexStatusNotAffected,
+	spdx3VexFixed:              model.VexStatusFixed,
+	spdx3VexUnderInvestigation: model.VexStatusUnderInvestigation,
+}
+
+var spdx3VexJustifications = map[string]model.VexJustification{
+	"componentNotPresent":                         model.VexJustificationComponentNotPresent,
+	"vulnerableCodeNotPresent":                    model.VexJustificationVulnerableCodeNotPresent,
+	"vulnerableCodeNotInExecutePath":              model.VexJustificationVulnerableCodeNotInExecutePath,
+	"vulnerableCodeCannotBeControlledByAdversary": model.VexJustificationVulnerableCodeCannotBeControlledByAdversary,
+	"inlineMitigationsAlreadyExist":               model.VexJustificationInlineMitigationsAlreadyExist,
+}
+
+// getVulnerabilities creates the VEX statements, vulnerabilities and
+// vulnerability scores of the vulnerability assessment relationships of the
+// Security profile.
+func (s *spdx3Parser) getVulnerabilities(ctx context.Context) ([]assembler.VexIngest, []assembler.CertifyVulnIngest, []assembler.VulnMetadataIngest) {
+	logger := logging.FromContext(ctx)
+	var vex []assembler.VexIngest
+	var certifyVuln []assembler.CertifyVulnIngest
+	var vulnMetadata []assembler.VulnMetadataIngest
+	for _, rel := range s.spdxDoc.Graph {
+		status, isVex := spdx3VexStatuses[rel.Type]
+		scoreType, isCvss := s.getScoreType(rel)
+		if !isVex && !isCvss {
+			continue
+		}
+		vuln, err := s.getVulnerability(rel.From)
+		if err != nil {
+			logger.Errorf("unable to ingest SPDX 3 assessment %s: %v", rel.SpdxID, err)
+			continue
+		}
+		knownSince := s.timeScanned
+		if rel.PublishedTime != "" {
+			if t, err := time.Parse(time.RFC3339, rel.PublishedTime); err == nil {
+				knownSince = t
+			}
+		}
+
+		if isCvss {
+			vulnMetadata = append(vulnMetadata, assembler.VulnMetadataIngest{
+				Vulnerability: vuln,
+				VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+					ScoreType:  scoreType,
+					ScoreValue: rel.Score,
+					Timestamp:  knownSince,
+				},
+			})
+			continue
+		}
+
+		justification, ok := spdx3VexJustifications[rel.JustificationType]
+		if !ok {
+			justification = model.VexJustificationNotProvided
+		}
+		vd := &model.VexStatementInputSpec{
+			Status:           status,
+			VexJustification: justification,
+			KnownSince:       knownSince,
+			StatusNotes:      rel.StatusNotes,
+			Statement:        rel.ImpactStatement,
+		}
+		if vd.StatusNotes == "" {
+			vd.StatusNotes = fmt.Sprintf("%s:%s", string(status), string(justification))
+		}
+		if vd.Statement == "" {
+			vd.Statement = rel.ActionStatement
+		}
+
+		assessed := rel.To
+		if rel.AssessedElement != "" {
+			assessed = []string{rel.AssessedElement}
+		}
+		for _, id := range assessed {
+			for _, pkg := range s.getElementPackages(id) {
+				vex = append(vex, assembler.VexIngest{
+					Pkg:           pkg,
+					Vulnerability: vuln,
+					VexData:       vd,
+				})
+				if status == model.VexStatusAffected || status == model.VexStatusUnderInvestigation {
+					certifyVuln = append(certifyVuln, assembler.CertifyVulnIngest{
+						Pkg:           pkg,
+						Vulnerability: vuln,
+						VulnData: &model.ScanMetadataInput{
+							TimeScanned: knownSince,
+						},
+					})
+				}
+			}
+		}
+	}
+	return vex, certifyVuln, vulnMetadata
+}
+
+// getVulnerability creates the vulnerability of the security_Vulnerability
+// element, identified by its CVE or other security identifier, or its name.
+func (s *spdx3Parser) getVulnerability(elementID string) (*model.VulnerabilityInputSpec, error) {
+	e := s.spdxDoc.Element(elementID)
+	if e == nil || e.Type != spdx3Vulnerability {
+		return nil, fmt.Errorf("vulnerability %q not found", elementID)
+	}
+	vulnID := e.Name
+	for _, id := range e.ExternalIdentifier {
+		if id.ExternalIdentifierType == "cve" {
+			vulnID = id.Identifier
+			break
+		}
+		if id.ExternalIdentifierType == "securityOther" {
+			vulnID = id.Identifier
+		}
+	}
+	if vulnID == "" {
+		return nil, fmt.Errorf("vulnerability %q has no identifier", elementID)
+	}
+	return asmhelpers.CreateVulnInput(vulnID)
+}
+
+func (s *spdx3Parser) getScoreType(e *spdxprocessor.SPDX3Element) (model.VulnerabilityScoreType, bool) {
+	switch e.Type {
+	case spdx3CvssV2:
+		return model.VulnerabilityScoreTypeCvssv2, true
+	case spdx3CvssV3:
+		if strings.HasPrefix(e.VectorString, "CVSS:3.1/") {
+			return model.VulnerabilityScoreTypeCvssv31, true
+		}
+		return model.VulnerabilityScoreTypeCvssv3, true
+	case spdx3CvssV4:
+		return model.VulnerabilityScoreTypeCvssv4, true
+	}
+	return "", false
+}
+
+// spdx3Dependencies maps the SPDX 3 relationship types ingested as
+// IsDependency. All of them go from the dependent element to its
+// dependencies.
+var spdx3Dependencies = map[string]spdxDependency{
+	"contains":              {false, model.DependencyTypeUnknown, model.DependencyScopeUnknown},
+	"dependsOn":             {false, model.DependencyTypeDirect, model.DependencyScopeUnknown},
+	"hasStaticLink":         {false, model.DependencyTypeDirect, model.DependencyScopeUnknown},
+	"hasDynamicLink":        {false, model.DependencyTypeDirect, model.DependencyScopeUnknown},
+	"usesTool":              {false, model.DependencyTypeDirect, model.DependencyScopeUnknown},
+	"hasOptionalDependency": {false, model.DependencyTypeDirect, model.DependencyScopeOptional},
+	"hasProvidedDependency": {false, model.DependencyTypeDirect, model.DependencyScopeProvided},
+	"hasOptionalComponent":  {false, model.DependencyTypeUnknown, model.DependencyScopeOptional},
+}
+
+// spdx3LifecycleScopes maps the scopes of LifecycleScopedRelationships to
+// the dependency scopes, the design and other scopes are unknown.
+var spdx3LifecycleScopes = map[string]model.DependencyScope{
+	"build":       model.DependencyScopeBuild,
+	"development": model.DependencyScopeDev,
+	"test":        model.DependencyScopeTest,
+	"runtime":     model.DependencyScopeRuntime,
+}
+
+func (s *spdx3Parser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (s *spdx3Parser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return s.identifierStrings, nil
+}
+
+func getSpdx3Justification(r *spdxprocessor.SPDX3Element) string {
+	s := fmt.Sprintf("Derived from SPDX %s relationship", r.RelationshipType)
+	if len(r.Comment) > 0 {
+		s += fmt.Sprintf(" with comment: %s", r.Comment)
+	}
+	return s
+}
@@ -0,0 +1,253 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_spdx3Parser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name           string
+		additionalOpts []cmp.Option
+		doc            *processor.Document
+		wantPredicates *assembler.IngestPredicates
+		wantPurls      []string
+		wantErr        bool
+	}{
+		{
+			name: "valid SPDX 3 document",
+			additionalOpts: []cmp.Option{
+				cmpopts.IgnoreFields(generated.HasMetadataInputSpec{},
+					"Timestamp"),
+			},
+			doc: &processor.Document{
+				Blob:   testdata.Spdx3Example,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentSPDX3,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			},
+			wantPredicates: &testdata.Spdx3IngestionPredicates,
+			wantPurls: []string{
+				"pkg:npm/web-app@1.4.0",
+				"pkg:npm/express@4.18.2",
+				"pkg:npm/body-parser@1.20.1",
+				"pkg:guac/pkg/jest@29.7.0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "SPDX 3 document without top level package",
+			doc: &processor.Document{
+				Blob: []byte(`{
+					"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+					"@graph": [{
+						"type": "CreationInfo",
+						"@id": "_:creationinfo",
+						"specVersion": "3.0.1",
+						"created": "2024-05-02T10:15:00Z"
+					}, {
+						"type": "SpdxDocument",
+						"spdxId": "https://example.com/spdx/libs/document",
+						"creationInfo": "_:creationinfo",
+						"name": "libs"
+					}, {
+						"type": "software_Package",
+						"spdxId": "https://example.com/spdx/libs/package/zlib",
+						"creationInfo": "_:creationinfo",
+						"name": "zlib",
+						"software_packageVersion": "1.3.1",
+						"software_packageUrl": "pkg:generic/zlib@1.3.1"
+					}, {
+						"type": "LifecycleScopedRelationship",
+						"spdxId": "https://example.com/spdx/libs/relationship/zlib-uses-cmake",
+						"creationInfo": "_:creationinfo",
+						"from": "https://example.com/spdx/libs/package/zlib",
+						"relationshipType": "usesTool",
+						"scope": "build",
+						"to": ["https://example.com/spdx/libs/package/cmake"]
+					}]
+				}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentSPDX3,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			},
+			wantPredicates: &assembler.IngestPredicates{
+				IsDependency: []assembler.IsDependencyIngest{
+					{
+						Pkg:             pUrlToPkgDiscardError("pkg:guac/spdx/libs"),
+						DepPkg:          pUrlToPkgDiscardError("pkg:generic/zlib@1.3.1"),
+						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+						IsDependency: &generated.IsDependencyInputSpec{
+							DependencyType: generated.DependencyTypeDirect,
+							VersionRange:   "1.3.1",
+							Justification:  "top-level package GUAC heuristic connecting to each file/package",
+						},
+					},
+				},
+				HasSBOM: []assembler.HasSBOMIngest{
+					{
+						Pkg: pUrlToPkgDiscardError("pkg:guac/spdx/libs"),
+						HasSBOM: &generated.HasSBOMInputSpec{
+							Uri:              "https://example.com/spdx/libs/document",
+							Algorithm:        "sha256",
+							DownloadLocation: "TestSource",
+							KnownSince:       parseRfc3339("2024-05-02T10:15:00Z"),
+						},
+					},
+				},
+			},
+			additionalOpts: []cmp.Option{
+				cmpopts.IgnoreFields(generated.HasSBOMInputSpec{}, "Digest"),
+			},
+			wantPurls: []string{
+				"pkg:generic/zlib@1.3.1",
+				"pkg:guac/spdx/libs",
+			},
+			wantErr: false,
+		},
+		{
+			name: "SPDX 3 document with build scoped tool",
+			doc: &processor.Document{
+				Blob: []byte(`{
+					"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+					"@graph": [{
+						"type": "SpdxDocument",
+						"spdxId": "https://example.com/spdx/zlib/document",
+						"creationInfo": {"type": "CreationInfo", "specVersion": "3.0.1", "created": "2024-05-02T10:15:00Z"},
+						"rootElement": ["https://example.com/spdx/zlib/package/zlib"]
+					}, {
+						"type": "software_Package",
+						"spdxId": "https://example.com/spdx/zlib/package/zlib",
+						"creationInfo": {"type": "CreationInfo", "specVersion": "3.0.1", "created": "2024-05-02T10:15:00Z"},
+						"name": "zlib",
+						"software_packageVersion": "1.3.1",
+						"software_packageUrl": "pkg:generic/zlib@1.3.1"
+					}, {
+						"type": "software_Package",
+						"spdxId": "https://example.com/spdx/zlib/package/cmake",
+						"creationInfo": {"type": "CreationInfo", "specVersion": "3.0.1", "created": "2024-05-02T10:15:00Z"},
+						"name": "cmake",
+						"software_packageVersion": "3.29.2",
+						"software_packageUrl": "pkg:generic/cmake@3.29.2"
+					}, {
+						"type": "LifecycleScopedRelationship",
+						"spdxId": "https://example.com/spdx/zlib/relationship/zlib-uses-cmake",
+						"creationInfo": {"type": "CreationInfo", "specVersion": "3.0.1", "created": "2024-05-02T10:15:00Z"},
+						"from": "https://example.com/spdx/zlib/package/zlib",
+						"relationshipType": "usesTool",
+						"scope": "build",
+						"comment": "configured with cmake",
+						"to": ["https://example.com/spdx/zlib/package/cmake"]
+					}]
+				}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentSPDX3,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			},
+			wantPredicates: &assembler.IngestPredicates{
+				IsDependency: []assembler.IsDependencyIngest{
+					{
+						Pkg:             pUrlToPkgDiscardError("pkg:generic/zlib@1.3.1"),
+						DepPkg:          pUrlToPkgDiscardError("pkg:generic/cmake@3.29.2"),
+						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+						IsDependency: &generated.IsDependencyInputSpec{
+							DependencyType:  generated.DependencyTypeDirect,
+							DependencyScope: ptrfrom.Any(generated.DependencyScopeBuild),
+							VersionRange:    "3.29.2",
+							Justification:   "Derived from SPDX usesTool relationship with comment: configured with cmake",
+						},
+					},
+				},
+				HasSBOM: []assembler.HasSBOMIngest{
+					{
+						Pkg: pUrlToPkgDiscardError("pkg:generic/zlib@1.3.1"),
+						HasSBOM: &generated.HasSBOMInputSpec{
+							Uri:              "https://example.com/spdx/zlib/document",
+							Algorithm:        "sha256",
+							DownloadLocation: "TestSource",
+							KnownSince:       parseRfc3339("2024-05-02T10:15:00Z"),
+						},
+					},
+				},
+			},
+			additionalOpts: []cmp.Option{
+				cmpopts.IgnoreFields(generated.HasSBOMInputSpec{}, "Digest"),
+			},
+			wantPurls: []string{
+				"pkg:generic/zlib@1.3.1",
+				"pkg:generic/cmake@3.29.2",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid SPDX 3 document",
+			doc: &processor.Document{
+				Blob:   testdata.Spdx3InvalidExample,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentSPDX3,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSpdx3Parser()
+			err := s.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("spdx3Parser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			preds := s.GetPredicates(ctx)
+			opts := append(testdata.IngestPredicatesCmpOpts, tt.additionalOpts...)
+			if d := cmp.Diff(tt.wantPredicates, preds, opts...); len(d) != 0 {
+				t.Errorf("spdx3.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+
+			ids, err := s.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("spdx3Parser.GetIdentifiers() error = %v", err)
+			}
+			if d := cmp.Diff(tt.wantPurls, ids.PurlStrings, cmpopts.SortSlices(func(a, b string) bool { return a < b })); len(d) != 0 {
+				t.Errorf("spdx3.GetIdentifiers mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
@@ -130,7 +130,7 @@ func Test_spdxParser(t *testing.T) {
 						DepPkgMatchFlag: generated.MatchFlags{Pkg: "SPECIFIC_VERSION"},
 						IsDependency: &generated.IsDependencyInputSpec{
 							VersionRange:   "sha256:a743268cd3c56f921f3fb706cc0425c8ab78119fd433e38bb7c5dcd5635b0d10",
-							DependencyType: "UNKNOWN",
+							DependencyType: "DIRECT",
 							Justification:  "top-level package GUAC heuristic connecting to each file/package",
 						},
 					},
@@ -426,9 +426,10 @@ func Test_spdxParser(t *testing.T) {
 						DepPkg:          pUrlToPkgDiscardError("pkg:rpm/redhat/python3-libcomps@0.1.18-1.el9?arch=x86_64"),
 						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
 						IsDependency: &generated.IsDependencyInputSpec{
-							DependencyType: generated.DependencyTypeUnknown,
-							VersionRange:   "0.1.18-1.el9",
-							Justification:  "Derived from SPDX CONTAINED_BY relationship",
+							DependencyType:  generated.DependencyTypeUnknown,
+							DependencyScope: ptrfrom.Any(generated.DependencyScopeUnknown),
+							VersionRange:    "0.1.18-1.el9",
+							Justification:   "Derived from SPDX CONTAINED_BY relationship",
 						},
 					},
 				},
@@ -438,6 +439,118 @@ func Test_spdxParser(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "SPDX with scoped dependency relationships",
+			additionalOpts: []cmp.Option{
+				cmpopts.IgnoreFields(assembler.HasSBOMIngest{},
+					"HasSBOM"),
+			},
+			doc: &processor.Document{
+				Blob: []byte(`
+		{
+			"SPDXID":"SPDXRef-DOCUMENT",
+			"spdxVersion": "SPDX-2.3",
+			"name":"scoped-sbom",
+			"creationInfo": { "created": "2022-09-24T17:27:55.556104Z" },
+			"packages":[
+				{
+					"SPDXID": "SPDXRef-app",
+					"name": "app",
+					"versionInfo": "1.0.0",
+					"externalRefs": [{"referenceCategory": "PACKAGE_MANAGER", "referenceLocator": "pkg:npm/app@1.0.0", "referenceType": "purl"}]
+				},
+				{
+					"SPDXID": "SPDXRef-express",
+					"name": "express",
+					"versionInfo": "4.18.2",
+					"externalRefs": [{"referenceCategory": "PACKAGE_MANAGER", "referenceLocator": "pkg:npm/express@4.18.2", "referenceType": "purl"}]
+				},
+				{
+					"SPDXID": "SPDXRef-jest",
+					"name": "jest",
+					"versionInfo": "29.7.0",
+					"externalRefs": [{"referenceCategory": "PACKAGE_MANAGER", "referenceLocator": "pkg:npm/jest@29.7.0", "referenceType": "purl"}]
+				},
+				{
+					"SPDXID": "SPDXRef-eslint",
+					"name": "eslint",
+					"versionInfo": "8.56.0",
+					"externalRefs": [{"referenceCategory": "PACKAGE_MANAGER", "referenceLocator": "pkg:npm/eslint@8.56.0", "referenceType": "purl"}]
+				}
+			],
+			"relationships":[
+				{
+					"spdxElementId": "SPDXRef-DOCUMENT",
+					"relationshipType": "DESCRIBES",
+					"relatedSpdxElement": "SPDXRef-app"
+				},
+				{
+					"spdxElementId": "SPDXRef-app",
+					"relationshipType": "DEPENDS_ON",
+					"relatedSpdxElement": "SPDXRef-express"
+				},
+				{
+					"spdxElementId": "SPDXRef-jest",
+					"relationshipType": "TEST_DEPENDENCY_OF",
+					"relatedSpdxElement": "SPDXRef-app"
+				},
+				{
+					"spdxElementId": "SPDXRef-eslint",
+					"relationshipType": "DEV_TOOL_OF",
+					"relatedSpdxElement": "SPDXRef-app"
+				}
+			]
+		}
+	`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentSPDX,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			},
+			wantPredicates: &assembler.IngestPredicates{
+				IsDependency: []assembler.IsDependencyIngest{
+					{
+						Pkg:             pUrlToPkgDiscardError("pkg:npm/app@1.0.0"),
+						DepPkg:          pUrlToPkgDiscardError("pkg:npm/express@4.18.2"),
+						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+						IsDependency: &generated.IsDependencyInputSpec{
+							DependencyType:  generated.DependencyTypeDirect,
+							DependencyScope: ptrfrom.Any(generated.DependencyScopeUnknown),
+							VersionRange:    "4.18.2",
+							Justification:   "Derived from SPDX DEPENDS_ON relationship",
+						},
+					},
+					{
+						Pkg:             pUrlToPkgDiscardError("pkg:npm/app@1.0.0"),
+						DepPkg:          pUrlToPkgDiscardError("pkg:npm/jest@29.7.0"),
+						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+						IsDependency: &generated.IsDependencyInputSpec{
+							DependencyType:  generated.DependencyTypeDirect,
+							DependencyScope: ptrfrom.Any(generated.DependencyScopeTest),
+							VersionRange:    "29.7.0",
+							Justification:   "Derived from SPDX TEST_DEPENDENCY_OF relationship",
+						},
+					},
+					{
+						Pkg:             pUrlToPkgDiscardError("pkg:npm/app@1.0.0"),
+						DepPkg:          pUrlToPkgDiscardError("pkg:npm/eslint@8.56.0"),
+						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+						IsDependency: &generated.IsDependencyInputSpec{
+							DependencyType:  generated.DependencyTypeDirect,
+							DependencyScope: ptrfrom.Any(generated.DependencyScopeDev),
+							VersionRange:    "8.56.0",
+							Justification:   "Derived from SPDX DEV_TOOL_OF relationship",
+						},
+					},
+				},
+				HasSBOM: []assembler.HasSBOMIngest{
+					{Pkg: pUrlToPkgDiscardError("pkg:npm/app@1.0.0")},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "SPDX with files that have 0000 hash file representation",
 			additionalOpts: []cmp.Option{
@@ -515,7 +628,7 @@ func Test_spdxParser(t *testing.T) {
 						DepPkg:          pUrlToPkgDiscardError("pkg:guac/files/sha1:ba1c68d88439599dcca7594d610030a19eda4f63#include-file"),
 						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions},
 						IsDependency: &generated.IsDependencyInputSpec{
-							DependencyType: generated.DependencyTypeUnknown,
+							DependencyType: generated.DependencyTypeDirect,
 							Justification:  "top-level package GUAC heuristic connecting to each file/package",
 						},
 					},
@@ -778,7 +891,7 @@ func Test_spdxParser(t *testing.T) {
 						DepPkg:          pUrlToPkgDiscardError("pkg:guac/files/sha1:ba1c68d88439599dcca7594d610030a19eda4f63#include-file"),
 						DepPkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions},
 						IsDependency: &generated.IsDependencyInputSpec{
-							DependencyType: generated.DependencyTypeUnknown,
+							DependencyType: generated.DependencyTypeDirect,
 							Justification:  "top-level package GUAC heuristic connecting to each file/package",
 						},
 					},
@@ -1055,6 +1168,68 @@ func Test_spdxParser(t *testing.T) {
 	}
 }
 
+func Test_spdxParser_dependencyTypes(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	doc := func(relationships string) []byte {
+		return []byte(`{
+			"spdxVersion": "SPDX-2.3",
+			"dataLicense": "CC0-1.0",
+			"SPDXID": "SPDXRef-DOCUMENT",
+			"name": "deps",
+			"documentNamespace": "https://example.com/deps",
+			"creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]},
+			"packages": [
+				{"SPDXID": "SPDXRef-app", "name": "app", "versionInfo": "1.0", "downloadLocation": "NOASSERTION"},
+				{"SPDXID": "SPDXRef-lib", "name": "lib", "versionInfo": "1.0", "downloadLocation": "NOASSERTION"},
+				{"SPDXID": "SPDXRef-leaf", "name": "leaf", "versionInfo": "1.0", "downloadLocation": "NOASSERTION"},
+				{"SPDXID": "SPDXRef-ping", "name": "ping", "versionInfo": "1.0", "downloadLocation": "NOASSERTION"},
+				{"SPDXID": "SPDXRef-pong", "name": "pong", "versionInfo": "1.0", "downloadLocation": "NOASSERTION"}
+			],
+			"relationships": [` + relationships + `
+				{"spdxElementId": "SPDXRef-app", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-lib"},
+				{"spdxElementId": "SPDXRef-leaf", "relationshipType": "DEPENDENCY_OF", "relatedSpdxElement": "SPDXRef-lib"},
+				{"spdxElementId": "SPDXRef-ping", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-pong"},
+				{"spdxElementId": "SPDXRef-pong", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-ping"}
+			]
+		}`)
+	}
+	tests := []struct {
+		name          string
+		relationships string
+		want          []string
+	}{{
+		name:          "described package",
+		relationships: `{"spdxElementId": "SPDXRef-DOCUMENT", "relationshipType": "DESCRIBES", "relatedSpdxElement": "SPDXRef-app"},`,
+		want: []string{
+			"app lib DIRECT", "lib leaf DIRECT", "app leaf INDIRECT",
+			"ping pong DIRECT", "pong ping DIRECT",
+		},
+	}, {
+		name: "heuristic top level package",
+		want: []string{
+			"app lib DIRECT", "lib leaf DIRECT",
+			"ping pong DIRECT", "pong ping DIRECT",
+			"deps app DIRECT", "deps lib INDIRECT", "deps leaf INDIRECT",
+			"deps ping UNKNOWN", "deps pong UNKNOWN",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSpdxParser()
+			if err := s.Parse(ctx, &processor.Document{Blob: doc(tt.relationships), Format: processor.FormatJSON, Type: processor.DocumentSPDX}); err != nil {
+				t.Fatalf("spdxParser.Parse() error = %v", err)
+			}
+			var got []string
+			for _, dep := range s.GetPredicates(ctx).IsDependency {
+				got = append(got, dep.Pkg.Name+" "+dep.DepPkg.Name+" "+string(dep.IsDependency.DependencyType))
+			}
+			if d := cmp.Diff(tt.want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); d != "" {
+				t.Errorf("unexpected dependencies (-want +got):\n%s", d)
+			}
+		})
+	}
+}
+
 func parseRfc3339(s string) time.Time {
 	time, err := time.Parse(time.RFC3339, s)
 	if err != nil {
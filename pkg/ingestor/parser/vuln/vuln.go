@@ -27,7 +27,12 @@
 //
 // - IsVulnerabilities are created between any found vulnerability in the
 // scanner results (OSV) and either a CVE or GHSA vulnerability that is created
-// by parsing the OSV ID.
+// by parsing the OSV ID, and between it and each of its aliases.
+//
+// - VulnMetadata are created for the CVSS scores of the vulnerabilities.
+//
+// - HasMetadata are created on the packages in the subject for the versions
+// that fix each vulnerability, with the key "fixed:<vulnerability id>".
 package vuln
 
 import (
@@ -35,6 +40,8 @@ import (
 	"fmt"
 	"strings"
 
+	cvss2 "github.com/goark/go-cvss/v2/metric"
+	cvss3 "github.com/goark/go-cvss/v3/metric"
 	jsoniter "github.com/json-iterator/go"
 
 	"github.com/guacsec/guac/pkg/assembler"
@@ -43,15 +50,18 @@ import (
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
 type parser struct {
-	packages   []*generated.PkgInputSpec
-	vulnData   *generated.ScanMetadataInput
-	vulns      []*generated.VulnerabilityInputSpec
-	vulnEquals []assembler.VulnEqualIngest
+	packages      []*generated.PkgInputSpec
+	vulnData      *generated.ScanMetadataInput
+	vulns         []*generated.VulnerabilityInputSpec
+	vulnEquals    []assembler.VulnEqualIngest
+	vulnMetadata  []assembler.VulnMetadataIngest
+	fixedVersions []*generated.HasMetadataInputSpec
 }
 
 var noVulnInput *generated.VulnerabilityInputSpec = &generated.VulnerabilityInputSpec{Type: "noVuln", VulnerabilityID: ""}
@@ -79,6 +89,8 @@ func (c *parser) Parse(ctx context.Context, doc *processor.Document) error {
 	}
 	c.vulns = vs
 	c.vulnEquals = ivs
+	c.vulnMetadata = parseVulnMetadata(ctx, statement)
+	c.fixedVersions = parseFixedVersions(statement, doc.SourceInformation)
 	return nil
 }
 
@@ -116,6 +128,7 @@ func parseMetadata(s *attestation_vuln.VulnerabilityStatement) *generated.ScanMe
 // TODO (pxp928): Remove creation of osv node and just create the vulnerability nodes specified
 func parseVulns(ctx context.Context, s *attestation_vuln.VulnerabilityStatement) ([]*generated.VulnerabilityInputSpec,
 	[]assembler.VulnEqualIngest, error) {
+	logger := logging.FromContext(ctx)
 	var vs []*generated.VulnerabilityInputSpec
 	var ivs []assembler.VulnEqualIngest
 	for _, id := range s.Predicate.Scanner.Result {
@@ -136,15 +149,115 @@ func parseVulns(ctx context.Context, s *attestation_vuln.VulnerabilityStatement)
 			},
 		}
 		ivs = append(ivs, iv)
+		for _, alias := range id.Aliases {
+			if alias == "" || strings.EqualFold(alias, id.VulnerabilityId) {
+				continue
+			}
+			aliasVuln, err := helpers.CreateVulnInput(alias)
+			if err != nil {
+				logger.Warnf("skipping alias %q of %s: %v", alias, id.VulnerabilityId, err)
+				continue
+			}
+			ivs = append(ivs, assembler.VulnEqualIngest{
+				Vulnerability:      v,
+				EqualVulnerability: aliasVuln,
+				VulnEqual: &generated.VulnEqualInputSpec{
+					Justification: "OSV alias",
+				},
+			})
+		}
 	}
 	return vs, ivs, nil
 }
 
+// parseVulnMetadata returns the scores of the vulnerabilities that can be
+// computed from their CVSS vectors.
+func parseVulnMetadata(ctx context.Context, s *attestation_vuln.VulnerabilityStatement) []assembler.VulnMetadataIngest {
+	logger := logging.FromContext(ctx)
+	var vms []assembler.VulnMetadataIngest
+	for _, id := range s.Predicate.Scanner.Result {
+		for _, severity := range id.Severity {
+			scoreType, score, err := cvssScore(severity)
+			if err != nil {
+				logger.Debugf("skipping severity of %s: %v", id.VulnerabilityId, err)
+				continue
+			}
+			vms = append(vms, assembler.VulnMetadataIngest{
+				Vulnerability: &generated.VulnerabilityInputSpec{
+					Type:            "osv",
+					VulnerabilityID: strings.ToLower(id.VulnerabilityId),
+				},
+				VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+					ScoreType:  scoreType,
+					ScoreValue: score,
+					Timestamp:  *s.Predicate.Metadata.ScannedOn,
+				},
+			})
+		}
+	}
+	return vms
+}
+
+// cvssScore returns the base score of a CVSS vector.
+func cvssScore(severity attestation_vuln.Severity) (generated.VulnerabilityScoreType, float64, error) {
+	switch severity.Method {
+	case "CVSS_V2":
+		base, err := cvss2.NewBase().Decode(severity.Score)
+		if err != nil {
+			return "", 0, err
+		}
+		return generated.VulnerabilityScoreTypeCvssv2, base.Score(), nil
+	case "CVSS_V3":
+		base, err := cvss3.NewBase().Decode(severity.Score)
+		if err != nil {
+			return "", 0, err
+		}
+		if base.Ver == cvss3.V3_1 {
+			return generated.VulnerabilityScoreTypeCvssv31, base.Score(), nil
+		}
+		return generated.VulnerabilityScoreTypeCvssv3, base.Score(), nil
+	default:
+		return "", 0, fmt.Errorf("unsupported severity method %q", severity.Method)
+	}
+}
+
+// parseFixedVersions returns the versions that fix each vulnerability, as
+// metadata of the scanned packages.
+func parseFixedVersions(s *attestation_vuln.VulnerabilityStatement, srcInfo processor.SourceInformation) []*generated.HasMetadataInputSpec {
+	var fixed []*generated.HasMetadataInputSpec
+	for _, id := range s.Predicate.Scanner.Result {
+		seen := map[string]bool{}
+		for _, r := range id.Affected {
+			if r.Fixed == "" || seen[r.Fixed] {
+				continue
+			}
+			seen[r.Fixed] = true
+			fixed = append(fixed, &generated.HasMetadataInputSpec{
+				Key:           "fixed:" + strings.ToLower(id.VulnerabilityId),
+				Value:         r.Fixed,
+				Timestamp:     *s.Predicate.Metadata.ScannedOn,
+				Justification: "OSV affected range",
+				Origin:        srcInfo.Source,
+				Collector:     srcInfo.Collector,
+			})
+		}
+	}
+	return fixed
+}
+
 func (c *parser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
 	rv := &assembler.IngestPredicates{
-		VulnEqual: c.vulnEquals,
+		VulnEqual:    c.vulnEquals,
+		VulnMetadata: c.vulnMetadata,
 	}
 	for _, p := range c.packages {
+		for _, hm := range c.fixedVersions {
+			rv.HasMetadata = append(rv.HasMetadata, assembler.HasMetadataIngest{
+				Pkg:          p,
+				PkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+				HasMetadata:  hm,
+			})
+		}
 		if len(c.vulns) > 0 {
 			for _, v := range c.vulns {
 				cv := assembler.CertifyVulnIngest{
@@ -35,11 +35,20 @@ import (
 func TestParser(t *testing.T) {
 	ctx := logging.WithLogger(context.Background())
 	tm, _ := time.Parse(time.RFC3339, "2022-11-21T17:45:50.52Z")
+	commonsText := &generated.PkgInputSpec{
+		Type:      "maven",
+		Namespace: ptrfrom.String("org.apache.commons"),
+		Name:      "commons-text",
+		Version:   ptrfrom.String("1.9"),
+		Subpath:   ptrfrom.String(""),
+	}
 	tests := []struct {
 		name    string
 		doc     *processor.Document
 		wantCVs []assembler.CertifyVulnIngest
 		wantIVs []assembler.VulnEqualIngest
+		wantVMs []assembler.VulnMetadataIngest
+		wantHMs []assembler.HasMetadataIngest
 		wantErr bool
 	}{{
 		name: "valid vulnerability certifier document",
@@ -272,11 +281,71 @@ func TestParser(t *testing.T) {
 		}},
 		wantIVs: []assembler.VulnEqualIngest{},
 		wantErr: false,
+	}, {
+		name: "vulnerability certifier document with aliases, severities and affected ranges",
+		doc: &processor.Document{
+			Blob:   testdata.ITE6VulnDetailsExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Vul,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantCVs: []assembler.CertifyVulnIngest{{
+			Pkg:           commonsText,
+			Vulnerability: &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-599f-7c49-w659"},
+			VulnData: &generated.ScanMetadataInput{
+				TimeScanned:    tm,
+				ScannerUri:     "osv.dev",
+				ScannerVersion: "0.0.14",
+			},
+		}},
+		wantIVs: []assembler.VulnEqualIngest{{
+			Vulnerability:      &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-599f-7c49-w659"},
+			EqualVulnerability: &generated.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-599f-7c49-w659"},
+			VulnEqual:          &generated.VulnEqualInputSpec{Justification: "Decoded OSV data"},
+		}, {
+			Vulnerability:      &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-599f-7c49-w659"},
+			EqualVulnerability: &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2022-42889"},
+			VulnEqual:          &generated.VulnEqualInputSpec{Justification: "OSV alias"},
+		}},
+		wantVMs: []assembler.VulnMetadataIngest{{
+			Vulnerability: &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-599f-7c49-w659"},
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
+				ScoreValue: 9.8,
+				Timestamp:  tm,
+			},
+		}, {
+			Vulnerability: &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-599f-7c49-w659"},
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv2,
+				ScoreValue: 7.5,
+				Timestamp:  tm,
+			},
+		}},
+		wantHMs: []assembler.HasMetadataIngest{{
+			Pkg:          commonsText,
+			PkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+			HasMetadata: &generated.HasMetadataInputSpec{
+				Key:           "fixed:ghsa-599f-7c49-w659",
+				Value:         "1.10.0",
+				Timestamp:     tm,
+				Justification: "OSV affected range",
+				Origin:        "TestSource",
+				Collector:     "TestCollector",
+			},
+		}},
+		wantErr: false,
 	}}
 	ivSortOpt := cmp.Transformer("Sort", func(in []assembler.VulnEqualIngest) []assembler.VulnEqualIngest {
 		out := append([]assembler.VulnEqualIngest(nil), in...)
 		sort.Slice(out, func(i, j int) bool {
-			return strings.Compare(out[i].Vulnerability.VulnerabilityID, out[j].Vulnerability.VulnerabilityID) > 0
+			if c := strings.Compare(out[i].Vulnerability.VulnerabilityID, out[j].Vulnerability.VulnerabilityID); c != 0 {
+				return c > 0
+			}
+			return strings.Compare(out[i].EqualVulnerability.VulnerabilityID, out[j].EqualVulnerability.VulnerabilityID) > 0
 		})
 		return out
 	})
@@ -304,6 +373,12 @@ func TestParser(t *testing.T) {
 			if diff := cmp.Diff(tt.wantIVs, ip.VulnEqual, ivSortOpt); diff != "" {
 				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
 			}
+			if diff := cmp.Diff(tt.wantVMs, ip.VulnMetadata); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantHMs, ip.HasMetadata); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
@@ -0,0 +1,337 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trust decides which documents are trusted for ingestion, based on
+// the identities that signed them.
+//
+// A trust policy is a YAML (or JSON) document naming the keys and trust
+// roots that signatures are verified with, and the identities that are
+// trusted to sign each type of document:
+//
+//	enforce: true
+//	keys:
+//	  release: keys/release.pub
+//	pgpKeys:
+//	  maintainer: keys/maintainer.asc
+//	sigstoreRoots: keys/fulcio.pem
+//	transparencyLogKeys: keys/rekor.pub
+//	rules:
+//	  - identities: [release, maintainer]
+//	    documentTypes: [SPDX, CycloneDX]
+//	  - identities: ["https://github.com/example/*"]
+//	    issuers: [https://token.actions.githubusercontent.com]
+//	    documentTypes: [SLSA]
+//
+// Keys are PEM public keys, used for DSSE envelopes and cosign detached
+// signatures, and PGP keys are armored PGP public keys. The identity of a
+// signature made with one of them is the name of the key. The identity of a
+// Sigstore bundle signed with a certificate is the email address or URI of
+// the certificate, which is chained to the sigstoreRoots PEM certificates.
+// As with cosign, certificate identities are only trusted by rules naming the
+// OIDC issuer recorded in the certificate.
+// Certificates are checked at the time the bundle was logged if the log entry
+// is signed by one of the transparencyLogKeys PEM public keys, and otherwise
+// at the current time.
+// Relative paths are resolved against the directory of the policy.
+package trust
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/verifier"
+	"github.com/guacsec/guac/pkg/ingestor/verifier/cosign_verifier"
+	"github.com/guacsec/guac/pkg/ingestor/verifier/pgp_verifier"
+	"github.com/guacsec/guac/pkg/ingestor/verifier/sigstore_bundle_verifier"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Policy maps the identities that sign documents to the types of documents
+// they are trusted for.
+type Policy struct {
+	// Enforce rejects the documents that are not signed by a trusted
+	// identity. Otherwise they are ingested and recorded as unverified.
+	Enforce bool `yaml:"enforce" json:"enforce"`
+	// Keys maps key IDs to the paths of PEM public keys.
+	Keys map[string]string `yaml:"keys,omitempty" json:"keys,omitempty"`
+	// PGPKeys maps key IDs to the paths of armored PGP public keys.
+	PGPKeys map[string]string `yaml:"pgpKeys,omitempty" json:"pgpKeys,omitempty"`
+	// SigstoreRoots is the path of the PEM certificates that the
+	// certificates of Sigstore bundles must chain to.
+	SigstoreRoots string `yaml:"sigstoreRoots,omitempty" json:"sigstoreRoots,omitempty"`
+	// TransparencyLogKeys is the path of the PEM public keys of the
+	// transparency logs trusted to time the signatures of Sigstore bundles.
+	TransparencyLogKeys string `yaml:"transparencyLogKeys,omitempty" json:"transparencyLogKeys,omitempty"`
+	Rules               []Rule `yaml:"rules" json:"rules"`
+
+	// keyNames maps the hashes of the keys to their IDs, so that
+	// signatures that name their key by hash, as DSSE ones do, match rules
+	// naming the key by ID.
+	keyNames map[string]string
+}
+
+// Rule trusts identities to sign documents of the given types. An identity
+// ending with "*" matches every identity with that prefix. Identities of
+// certificates must also have been authenticated by one of the issuers,
+// which match in the same way. A rule without document types applies to all
+// of them.
+type Rule struct {
+	Identities    []string                 `yaml:"identities" json:"identities"`
+	Issuers       []string                 `yaml:"issuers,omitempty" json:"issuers,omitempty"`
+	DocumentTypes []processor.DocumentType `yaml:"documentTypes,omitempty" json:"documentTypes,omitempty"`
+}
+
+// Load reads and validates a trust policy file.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust policy %s: %w", path, err)
+	}
+	p, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	p.resolve(filepath.Dir(path))
+	return p, nil
+}
+
+// Parse parses and validates a YAML or JSON trust policy.
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse trust policy: %w", err)
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (p *Policy) validate() error {
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("trust policy has no rules")
+	}
+	for i, r := range p.Rules {
+		if len(r.Identities) == 0 {
+			return fmt.Errorf("trust policy rule %d has no identities", i)
+		}
+	}
+	for id := range p.PGPKeys {
+		if _, ok := p.Keys[id]; ok {
+			return fmt.Errorf("trust policy key ID %q is used for both a key and a PGP key", id)
+		}
+	}
+	return nil
+}
+
+// resolve makes the relative paths of the policy relative to dir.
+func (p *Policy) resolve(dir string) {
+	abs := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+	for id, path := range p.Keys {
+		p.Keys[id] = abs(path)
+	}
+	for id, path := range p.PGPKeys {
+		p.PGPKeys[id] = abs(path)
+	}
+	p.SigstoreRoots = abs(p.SigstoreRoots)
+	p.TransparencyLogKeys = abs(p.TransparencyLogKeys)
+}
+
+// Trusts reports whether the identity is verified and trusted to sign
+// documents of the type. Identities match by ID, by key hash or by the ID of
+// the policy key with that hash. Identities of certificates must match the
+// issuers of the rule as well.
+func (p *Policy) Trusts(docType processor.DocumentType, identity verifier.Identity) bool {
+	if !identity.Verified {
+		return false
+	}
+	for _, r := range p.Rules {
+		if !r.appliesTo(docType) || !r.issuedBy(identity.Issuer) {
+			continue
+		}
+		for _, pattern := range r.Identities {
+			if matches(pattern, identity.ID) || matches(pattern, identity.Key.Hash) || matches(pattern, p.keyNames[identity.Key.Hash]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Rule) appliesTo(docType processor.DocumentType) bool {
+	if len(r.DocumentTypes) == 0 {
+		return true
+	}
+	for _, t := range r.DocumentTypes {
+		if t == docType {
+			return true
+		}
+	}
+	return false
+}
+
+// issuedBy reports whether the rule trusts identities authenticated by the
+// issuer. Identities of keys have no issuer.
+func (r *Rule) issuedBy(issuer string) bool {
+	if issuer == "" {
+		return true
+	}
+	for _, pattern := range r.Issuers {
+		if matches(pattern, issuer) {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(pattern, s string) bool {
+	if s == "" {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(s, prefix)
+	}
+	return pattern == s
+}
+
+var (
+	mu     sync.RWMutex
+	policy *Policy
+)
+
+// SetPolicy sets the trust policy applied when parsing documents. A nil
+// policy disables signature verification.
+func SetPolicy(p *Policy) {
+	mu.Lock()
+	defer mu.Unlock()
+	policy = p
+}
+
+// GetPolicy returns the trust policy applied when parsing documents, or nil.
+func GetPolicy() *Policy {
+	mu.RLock()
+	defer mu.RUnlock()
+	return policy
+}
+
+// Setup loads the trust policy at path, stores its keys with the key
+// provider, registers the verifiers of detached signatures and sets the
+// policy.
+func Setup(ctx context.Context, path string, keyProvider key.KeyProviderType) error {
+	logger := logging.FromContext(ctx)
+	p, err := Load(path)
+	if err != nil {
+		return err
+	}
+
+	var keyIDs []string
+	p.keyNames = map[string]string{}
+	for id, keyPath := range p.Keys {
+		pemBytes, err := os.ReadFile(keyPath)
+		if err != nil {
+			return fmt.Errorf("failed to read key %s: %w", id, err)
+		}
+		if err := key.Store(ctx, id, pemBytes, keyProvider); err != nil {
+			return fmt.Errorf("failed to store key %s: %w", id, err)
+		}
+		k, err := key.Retrieve(ctx, id, keyProvider)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve key %s: %w", id, err)
+		}
+		// DSSE signatures name their key by its hash
+		if err := key.Store(ctx, k.Hash, pemBytes, keyProvider); err != nil {
+			return fmt.Errorf("failed to store key %s: %w", id, err)
+		}
+		p.keyNames[k.Hash] = id
+		keyIDs = append(keyIDs, id)
+	}
+
+	pgpKeys := map[string][]byte{}
+	for id, keyPath := range p.PGPKeys {
+		armored, err := os.ReadFile(keyPath)
+		if err != nil {
+			return fmt.Errorf("failed to read PGP key %s: %w", id, err)
+		}
+		pgpKeys[id] = armored
+	}
+	pgpVerifier, err := pgp_verifier.NewPGPVerifier(pgpKeys)
+	if err != nil {
+		return err
+	}
+
+	var roots *x509.CertPool
+	if p.SigstoreRoots != "" {
+		pemBytes, err := os.ReadFile(p.SigstoreRoots)
+		if err != nil {
+			return fmt.Errorf("failed to read sigstore roots: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pemBytes) {
+			return fmt.Errorf("no certificates found in sigstore roots %s", p.SigstoreRoots)
+		}
+	}
+
+	var logKeys []crypto.PublicKey
+	if p.TransparencyLogKeys != "" {
+		pemBytes, err := os.ReadFile(p.TransparencyLogKeys)
+		if err != nil {
+			return fmt.Errorf("failed to read transparency log keys: %w", err)
+		}
+		for block, rest := pem.Decode(pemBytes); block != nil; block, rest = pem.Decode(rest) {
+			k, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return fmt.Errorf("failed to parse transparency log key: %w", err)
+			}
+			logKeys = append(logKeys, k)
+		}
+		if len(logKeys) == 0 {
+			return fmt.Errorf("no keys found in transparency log keys %s", p.TransparencyLogKeys)
+		}
+	}
+	bundleVerifier, err := sigstore_bundle_verifier.NewSigstoreBundleVerifier(roots, logKeys...)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range []verifier.Verifier{
+		cosign_verifier.NewCosignVerifier(keyIDs...),
+		pgpVerifier,
+		bundleVerifier,
+	} {
+		if err := verifier.RegisterVerifier(v, v.Type()); err != nil {
+			logger.Debugf("%v", err)
+		}
+	}
+
+	SetPolicy(p)
+	logger.Infof("applying trust policy %s (enforce: %v)", path, p.Enforce)
+	return nil
+}
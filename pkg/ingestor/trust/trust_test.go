@@ -0,0 +1,217 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/key/inmemory"
+	"github.com/guacsec/guac/pkg/ingestor/verifier"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{{
+		name: "valid",
+		policy: `
+enforce: true
+keys:
+  release: release.pub
+rules:
+  - identities: [release]
+    documentTypes: [SPDX]
+`,
+	}, {
+		name:    "no rules",
+		policy:  `enforce: true`,
+		wantErr: true,
+	}, {
+		name: "rule without identities",
+		policy: `
+rules:
+  - documentTypes: [SPDX]
+`,
+		wantErr: true,
+	}, {
+		name: "key ID used twice",
+		policy: `
+keys:
+  release: release.pub
+pgpKeys:
+  release: release.asc
+rules:
+  - identities: [release]
+`,
+		wantErr: true,
+	}, {
+		name:    "not yaml",
+		policy:  `rules: [`,
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.policy)); (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicy_Trusts(t *testing.T) {
+	p, err := Parse([]byte(`
+rules:
+  - identities: [release]
+    documentTypes: [SPDX, CycloneDX]
+  - identities: ["https://github.com/example/*"]
+    documentTypes: [SLSA]
+  - identities: [auditor@example.com]
+  - identities: [builder@example.com]
+    issuers: [https://accounts.example.com]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.keyNames = map[string]string{"0123abcd": "release"}
+	tests := []struct {
+		name     string
+		docType  processor.DocumentType
+		identity verifier.Identity
+		want     bool
+	}{
+		{"trusted key", processor.DocumentSPDX, verifier.Identity{ID: "release", Verified: true}, true},
+		{"unverified", processor.DocumentSPDX, verifier.Identity{ID: "release"}, false},
+		{"other document type", processor.DocumentITE6SLSA, verifier.Identity{ID: "release", Verified: true}, false},
+		{"key hash of a policy key", processor.DocumentCycloneDX, verifier.Identity{ID: "0123abcd", Key: key.Key{Hash: "0123abcd"}, Verified: true}, true},
+		{"prefix", processor.DocumentITE6SLSA, verifier.Identity{ID: "https://github.com/example/repo/.github/workflows/release.yml@refs/heads/main", Verified: true}, true},
+		{"other prefix", processor.DocumentITE6SLSA, verifier.Identity{ID: "https://github.com/other/repo", Verified: true}, false},
+		{"all document types", processor.DocumentOpenVEX, verifier.Identity{ID: "auditor@example.com", Verified: true}, true},
+		{"unknown identity", processor.DocumentSPDX, verifier.Identity{ID: "someone", Verified: true}, false},
+		{"certificate of the issuer", processor.DocumentSPDX, verifier.Identity{ID: "builder@example.com", Issuer: "https://accounts.example.com", Verified: true}, true},
+		{"certificate of another issuer", processor.DocumentSPDX, verifier.Identity{ID: "builder@example.com", Issuer: "https://other.example.com", Verified: true}, false},
+		{"certificate of a rule without issuers", processor.DocumentOpenVEX, verifier.Identity{ID: "auditor@example.com", Issuer: "https://accounts.example.com", Verified: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Trusts(tt.docType, tt.identity); got != tt.want {
+				t.Errorf("Trusts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetup(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	if err := key.RegisterKeyProvider(inmemory.NewInmemoryProvider(), "inmemory"); err != nil {
+		t.Log(err)
+	}
+	defer SetPolicy(nil)
+
+	dir := t.TempDir()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(priv.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "keys"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "keys", "release.pub"), pemBytes, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	policyPath := filepath.Join(dir, "policy.yaml")
+	if err := os.WriteFile(policyPath, []byte(`
+enforce: true
+keys:
+  release: keys/release.pub
+rules:
+  - identities: [release]
+    documentTypes: [SPDX]
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Setup(ctx, policyPath, "inmemory"); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	p := GetPolicy()
+	if p == nil || !p.Enforce {
+		t.Fatalf("GetPolicy() = %v, want the enforced policy", p)
+	}
+
+	payload := []byte(`{"spdxVersion": "SPDX-2.3"}`)
+	s, err := signature.LoadSigner(priv, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := s.SignMessage(bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := &processor.Document{
+		Blob: payload,
+		Type: processor.DocumentSPDX,
+		Signatures: []processor.Signature{{
+			Type: processor.SignatureCosign,
+			Blob: []byte(base64.StdEncoding.EncodeToString(sig)),
+		}},
+	}
+	identities, err := verifier.VerifyIdentity(ctx, doc)
+	if err != nil {
+		t.Fatalf("VerifyIdentity() error = %v", err)
+	}
+	if len(identities) != 1 || !p.Trusts(doc.Type, identities[0]) {
+		t.Errorf("VerifyIdentity() = %v, want an identity trusted by the policy", identities)
+	}
+	// the key is also stored under its hash, which DSSE signatures name
+	if _, err := key.Find(ctx, identities[0].Key.Hash); err != nil {
+		t.Errorf("key.Find() of the key hash error = %v", err)
+	}
+}
+
+func TestSetup_MissingKey(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(policyPath, []byte(`
+keys:
+  release: missing.pub
+rules:
+  - identities: [release]
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Setup(ctx, policyPath, "inmemory"); err == nil {
+		t.Error("Setup() with a missing key did not fail")
+	}
+}
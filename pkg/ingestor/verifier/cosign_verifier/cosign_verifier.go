@@ -0,0 +1,87 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cosign_verifier
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/verifier"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+type cosignVerifier struct {
+	keyIDs []string
+}
+
+// NewCosignVerifier initializes the verifier of cosign detached signatures,
+// as created by "cosign sign-blob --key". The signatures do not name the key
+// that created them, so they are checked against each of the keys with the
+// given IDs, which are found through the registered key providers.
+func NewCosignVerifier(keyIDs ...string) *cosignVerifier {
+	return &cosignVerifier{keyIDs: keyIDs}
+}
+
+// Verify is not supported, as cosign signatures are detached from the
+// payload. See VerifyDetached.
+func (c *cosignVerifier) Verify(ctx context.Context, payloadBytes []byte) ([]verifier.Identity, error) {
+	return nil, errors.New("cosign signatures are detached from the payload they sign")
+}
+
+// VerifyDetached validates the base64 encoded signature of the payload and
+// returns the identity of the key that created it, named by its key ID. No
+// identity is returned when none of the keys verifies the signature.
+// TODO: this currently only supports SHA256 hash function when validating signatures
+func (c *cosignVerifier) VerifyDetached(ctx context.Context, payloadBytes []byte, signatureBytes []byte) ([]verifier.Identity, error) {
+	logger := logging.FromContext(ctx)
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signatureBytes)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cosign signature: %w", err)
+	}
+	for _, id := range c.keyIDs {
+		k, err := key.Find(ctx, id)
+		if err != nil {
+			logger.Warnf("failed to find key %s to verify cosign signature: %v", id, err)
+			continue
+		}
+		if err := verifySignature(k.Val, payloadBytes, sig); err != nil {
+			continue
+		}
+		return []verifier.Identity{{ID: id, Key: *k, Verified: true}}, nil
+	}
+	logger.Errorf("failed to verify cosign signature with any of the provided keys")
+	return []verifier.Identity{}, nil
+}
+
+// Type returns the type of the verifier
+func (c *cosignVerifier) Type() verifier.VerifierType {
+	return processor.SignatureCosign
+}
+
+func verifySignature(k crypto.PublicKey, payload []byte, sig []byte) error {
+	vfr, err := signature.LoadVerifier(k, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("could not load verifier: %w", err)
+	}
+	return vfr.VerifySignature(bytes.NewReader(sig), bytes.NewReader(payload))
+}
@@ -0,0 +1,122 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cosign_verifier
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/key/inmemory"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+func storeKey(t *testing.T, ctx context.Context, id string) *ecdsa.PrivateKey {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(priv.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := key.Store(ctx, id, pemBytes, "inmemory"); err != nil {
+		t.Fatal(err)
+	}
+	return priv
+}
+
+func TestCosignVerifier_VerifyDetached(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	if err := key.RegisterKeyProvider(inmemory.NewInmemoryProvider(), "inmemory"); err != nil {
+		t.Log(err)
+	}
+	signer := storeKey(t, ctx, "release")
+	storeKey(t, ctx, "other")
+
+	payload := []byte(`{"spdxVersion": "SPDX-2.3"}`)
+	s, err := signature.LoadSigner(signer, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := s.SignMessage(bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := []byte(base64.StdEncoding.EncodeToString(sig) + "\n")
+
+	tests := []struct {
+		name      string
+		keyIDs    []string
+		payload   []byte
+		signature []byte
+		wantID    string
+		wantErr   bool
+	}{{
+		name:      "signed by one of the keys",
+		keyIDs:    []string{"other", "release"},
+		payload:   payload,
+		signature: encoded,
+		wantID:    "release",
+	}, {
+		name:      "signed by another key",
+		keyIDs:    []string{"other"},
+		payload:   payload,
+		signature: encoded,
+	}, {
+		name:      "unknown key",
+		keyIDs:    []string{"missing"},
+		payload:   payload,
+		signature: encoded,
+	}, {
+		name:      "modified payload",
+		keyIDs:    []string{"release"},
+		payload:   []byte(`{"spdxVersion": "SPDX-2.2"}`),
+		signature: encoded,
+	}, {
+		name:      "signature not base64",
+		keyIDs:    []string{"release"},
+		payload:   payload,
+		signature: []byte("%%%"),
+		wantErr:   true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCosignVerifier(tt.keyIDs...).VerifyDetached(ctx, tt.payload, tt.signature)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyDetached() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantID == "" {
+				if len(got) != 0 {
+					t.Errorf("VerifyDetached() = %v, want no identity", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0].ID != tt.wantID || !got[0].Verified {
+				t.Errorf("VerifyDetached() = %v, want verified identity %s", got, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgp_verifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/verifier"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+type pgpVerifier struct {
+	keyring openpgp.EntityList
+	// ids maps the fingerprints of the primary keys to their IDs
+	ids map[string]string
+}
+
+// NewPGPVerifier initializes the verifier of detached PGP signatures, such
+// as the .asc files published next to SBOMs. The keys map key IDs to armored
+// PGP public keys.
+func NewPGPVerifier(keys map[string][]byte) (*pgpVerifier, error) {
+	p := &pgpVerifier{ids: map[string]string{}}
+	for id, armored := range keys {
+		entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(armored))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PGP key %s: %w", id, err)
+		}
+		for _, entity := range entities {
+			p.ids[fingerprint(entity)] = id
+		}
+		p.keyring = append(p.keyring, entities...)
+	}
+	return p, nil
+}
+
+// Verify is not supported, as the PGP signatures of documents are detached
+// from them. See VerifyDetached.
+func (p *pgpVerifier) Verify(ctx context.Context, payloadBytes []byte) ([]verifier.Identity, error) {
+	return nil, errors.New("PGP signatures are detached from the payload they sign")
+}
+
+// VerifyDetached validates the armored or binary PGP signature of the
+// payload and returns the identity of the key that created it. No identity is
+// returned when the signature was not created by any of the keys.
+func (p *pgpVerifier) VerifyDetached(ctx context.Context, payloadBytes []byte, signatureBytes []byte) ([]verifier.Identity, error) {
+	check := openpgp.CheckDetachedSignature
+	if bytes.HasPrefix(bytes.TrimSpace(signatureBytes), []byte("-----BEGIN PGP SIGNATURE-----")) {
+		check = openpgp.CheckArmoredDetachedSignature
+	}
+	signer, err := check(p.keyring, bytes.NewReader(payloadBytes), bytes.NewReader(signatureBytes), nil)
+	if err != nil {
+		// logging here as we don't want to fail but record that the signature check failed
+		logger := logging.FromContext(ctx)
+		logger.Errorf("failed to verify PGP signature with the provided keys: %v", err)
+		return []verifier.Identity{}, nil
+	}
+	fp := fingerprint(signer)
+	return []verifier.Identity{{
+		ID: p.ids[fp],
+		Key: key.Key{
+			Hash: fp,
+			Val:  signer.PrimaryKey.PublicKey,
+		},
+		Verified: true,
+	}}, nil
+}
+
+// Type returns the type of the verifier
+func (p *pgpVerifier) Type() verifier.VerifierType {
+	return processor.SignaturePGP
+}
+
+func fingerprint(entity *openpgp.Entity) string {
+	return strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint))
+}
@@ -0,0 +1,121 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgp_verifier
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func newEntity(t *testing.T, name string) (*openpgp.Entity, []byte) {
+	t.Helper()
+	entity, err := openpgp.NewEntity(name, "", name+"@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return entity, buf.Bytes()
+}
+
+func TestPGPVerifier_VerifyDetached(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	signer, signerKey := newEntity(t, "release")
+	_, otherKey := newEntity(t, "other")
+	payload := []byte(`{"bomFormat": "CycloneDX"}`)
+
+	var armored, binary bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&armored, signer, bytes.NewReader(payload), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := openpgp.DetachSign(&binary, signer, bytes.NewReader(payload), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		keys      map[string][]byte
+		payload   []byte
+		signature []byte
+		wantID    string
+	}{{
+		name:      "armored signature",
+		keys:      map[string][]byte{"release": signerKey, "other": otherKey},
+		payload:   payload,
+		signature: armored.Bytes(),
+		wantID:    "release",
+	}, {
+		name:      "binary signature",
+		keys:      map[string][]byte{"release": signerKey},
+		payload:   payload,
+		signature: binary.Bytes(),
+		wantID:    "release",
+	}, {
+		name:      "unknown signer",
+		keys:      map[string][]byte{"other": otherKey},
+		payload:   payload,
+		signature: armored.Bytes(),
+	}, {
+		name:      "modified payload",
+		keys:      map[string][]byte{"release": signerKey},
+		payload:   []byte(`{"bomFormat": "SPDX"}`),
+		signature: armored.Bytes(),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewPGPVerifier(tt.keys)
+			if err != nil {
+				t.Fatalf("NewPGPVerifier() error = %v", err)
+			}
+			got, err := p.VerifyDetached(ctx, tt.payload, tt.signature)
+			if err != nil {
+				t.Fatalf("VerifyDetached() error = %v", err)
+			}
+			if tt.wantID == "" {
+				if len(got) != 0 {
+					t.Errorf("VerifyDetached() = %v, want no identity", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0].ID != tt.wantID || !got[0].Verified {
+				t.Fatalf("VerifyDetached() = %v, want verified identity %s", got, tt.wantID)
+			}
+			if got[0].Key.Hash != fingerprint(signer) {
+				t.Errorf("VerifyDetached() key hash = %s, want %s", got[0].Key.Hash, fingerprint(signer))
+			}
+		})
+	}
+}
+
+func TestNewPGPVerifier_InvalidKey(t *testing.T) {
+	if _, err := NewPGPVerifier(map[string][]byte{"bad": []byte("not a key")}); err == nil {
+		t.Error("NewPGPVerifier() with an invalid key did not fail")
+	}
+}
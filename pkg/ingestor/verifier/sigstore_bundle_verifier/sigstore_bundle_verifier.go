@@ -0,0 +1,452 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigstore_bundle_verifier
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/verifier"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/sigstore/sigstore/pkg/signature"
+	sig_dsse "github.com/sigstore/sigstore/pkg/signature/dsse"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// bundle holds the fields of a Sigstore bundle
+// (application/vnd.dev.sigstore.bundle+json) that are needed to verify it.
+type bundle struct {
+	MediaType            string               `json:"mediaType"`
+	VerificationMaterial verificationMaterial `json:"verificationMaterial"`
+	MessageSignature     *messageSignature    `json:"messageSignature"`
+	DSSEEnvelope         *dsse.Envelope       `json:"dsseEnvelope"`
+}
+
+type verificationMaterial struct {
+	PublicKey *struct {
+		Hint string `json:"hint"`
+	} `json:"publicKey"`
+	X509CertificateChain *struct {
+		Certificates []rawCertificate `json:"certificates"`
+	} `json:"x509CertificateChain"`
+	Certificate *rawCertificate `json:"certificate"`
+	TlogEntries []tlogEntry     `json:"tlogEntries"`
+}
+
+// tlogEntry is the entry of a signature in a transparency log. Its int64
+// fields are encoded as strings by the protobuf JSON mapping.
+type tlogEntry struct {
+	LogIndex jsoniter.RawMessage `json:"logIndex"`
+	LogID    struct {
+		KeyID []byte `json:"keyId"`
+	} `json:"logId"`
+	IntegratedTime   jsoniter.RawMessage `json:"integratedTime"`
+	InclusionPromise *struct {
+		SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+	} `json:"inclusionPromise"`
+	CanonicalizedBody []byte `json:"canonicalizedBody"`
+}
+
+// tlogBody holds the fields of the hashedrekord and dsse entries of a Rekor
+// transparency log that tie them to a signature.
+type tlogBody struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		// hashedrekord
+		Data *struct {
+			Hash tlogHash `json:"hash"`
+		} `json:"data"`
+		Signature *struct {
+			Content   []byte `json:"content"`
+			PublicKey struct {
+				Content []byte `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+		// dsse
+		PayloadHash *tlogHash `json:"payloadHash"`
+		Signatures  []struct {
+			Signature string `json:"signature"`
+			Verifier  []byte `json:"verifier"`
+		} `json:"signatures"`
+	} `json:"spec"`
+}
+
+type tlogHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+type rawCertificate struct {
+	RawBytes []byte `json:"rawBytes"`
+}
+
+type messageSignature struct {
+	MessageDigest *struct {
+		Algorithm string `json:"algorithm"`
+		Digest    []byte `json:"digest"`
+	} `json:"messageDigest"`
+	Signature []byte `json:"signature"`
+}
+
+type sigstoreBundleVerifier struct {
+	roots *x509.CertPool
+	// logs maps the IDs of the trusted transparency logs to the verifiers
+	// of their keys
+	logs map[string]signature.Verifier
+}
+
+// NewSigstoreBundleVerifier initializes the verifier of Sigstore bundles, as
+// created by "cosign sign-blob --bundle" or "cosign attest-blob --bundle".
+// Signing certificates are verified against the given trust root, such as
+// the Fulcio root and intermediate certificates of a Sigstore deployment, so
+// that no network access is needed. Bundles signed with a public key are
+// verified against the key named by the hint of the bundle, which is found
+// through the registered key providers.
+//
+// Sigstore certificates are short-lived, so they are verified at the time
+// their signature was integrated in a transparency log, which is only
+// trusted when the entry is promised by one of the given log keys, such as
+// the Rekor public key, and is a hashedrekord or dsse entry of the signature
+// of the bundle, made with the certificate over the payload. Otherwise
+// certificates are verified at the current time. The inclusion proofs of the
+// entries are not verified.
+func NewSigstoreBundleVerifier(roots *x509.CertPool, logKeys ...crypto.PublicKey) (*sigstoreBundleVerifier, error) {
+	logs := map[string]signature.Verifier{}
+	for _, k := range logKeys {
+		der, err := x509.MarshalPKIXPublicKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal transparency log key: %w", err)
+		}
+		vfr, err := signature.LoadVerifier(k, crypto.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("could not load transparency log verifier: %w", err)
+		}
+		// the ID of a log is the hash of its key
+		id := sha256.Sum256(der)
+		logs[hex.EncodeToString(id[:])] = vfr
+	}
+	return &sigstoreBundleVerifier{roots: roots, logs: logs}, nil
+}
+
+// Verify is not supported, as Sigstore bundles are detached from the payload
+// they sign. See VerifyDetached.
+func (s *sigstoreBundleVerifier) Verify(ctx context.Context, payloadBytes []byte) ([]verifier.Identity, error) {
+	return nil, errors.New("sigstore bundles are detached from the payload they sign")
+}
+
+// VerifyDetached validates the Sigstore bundle of the payload and returns the
+// identity that signed it. For certificates, the identity is the email
+// address or URI of the certificate, issued by the OIDC issuer recorded in
+// it. The identity is not verified when the signature or the certificate
+// chain is invalid.
+// TODO: this currently only supports SHA256 hash function when validating signatures
+func (s *sigstoreBundleVerifier) VerifyDetached(ctx context.Context, payloadBytes []byte, bundleBytes []byte) ([]verifier.Identity, error) {
+	logger := logging.FromContext(ctx)
+	var b bundle
+	if err := json.Unmarshal(bundleBytes, &b); err != nil {
+		return nil, fmt.Errorf("failed to parse sigstore bundle: %w", err)
+	}
+	if !strings.HasPrefix(b.MediaType, "application/vnd.dev.sigstore.bundle") {
+		return nil, fmt.Errorf("unsupported sigstore bundle media type: %q", b.MediaType)
+	}
+
+	identity, chainErr, err := s.identity(ctx, &b, payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+	if chainErr != nil {
+		logger.Errorf("failed to verify sigstore bundle certificate of %s: %v", identity.ID, chainErr)
+	}
+	sigErr := verifySignature(&b, identity.Key.Val, payloadBytes)
+	if sigErr != nil {
+		logger.Errorf("failed to verify sigstore bundle signature of %s: %v", identity.ID, sigErr)
+	}
+	identity.Verified = chainErr == nil && sigErr == nil
+	return []verifier.Identity{identity}, nil
+}
+
+// Type returns the type of the verifier
+func (s *sigstoreBundleVerifier) Type() verifier.VerifierType {
+	return processor.SignatureSigstoreBundle
+}
+
+// identity returns the identity of the verification material of the bundle,
+// along with the error of the verification of its certificate chain, if any.
+func (s *sigstoreBundleVerifier) identity(ctx context.Context, b *bundle, payload []byte) (verifier.Identity, error, error) {
+	m := &b.VerificationMaterial
+	if m.PublicKey != nil {
+		k, err := key.Find(ctx, m.PublicKey.Hint)
+		if err != nil {
+			return verifier.Identity{}, nil, fmt.Errorf("failed to find key %s of sigstore bundle: %w", m.PublicKey.Hint, err)
+		}
+		return verifier.Identity{ID: m.PublicKey.Hint, Key: *k}, nil, nil
+	}
+
+	var raw []rawCertificate
+	if m.Certificate != nil {
+		raw = append(raw, *m.Certificate)
+	}
+	if m.X509CertificateChain != nil {
+		raw = append(raw, m.X509CertificateChain.Certificates...)
+	}
+	if len(raw) == 0 {
+		return verifier.Identity{}, nil, errors.New("sigstore bundle has neither a public key nor a certificate")
+	}
+	var certs []*x509.Certificate
+	for _, r := range raw {
+		cert, err := x509.ParseCertificate(r.RawBytes)
+		if err != nil {
+			return verifier.Identity{}, nil, fmt.Errorf("failed to parse sigstore bundle certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	leaf := certs[0]
+	keyHash, err := dsse.SHA256KeyID(leaf.PublicKey)
+	if err != nil {
+		return verifier.Identity{}, nil, fmt.Errorf("failed to hash sigstore bundle certificate key: %w", err)
+	}
+	identity := verifier.Identity{
+		ID:     certificateIdentity(leaf),
+		Key:    key.Key{Hash: keyHash, Val: leaf.PublicKey},
+		Issuer: certificateIssuer(leaf),
+	}
+	if identity.Issuer == "" {
+		// identities are only trusted along with their issuer
+		return identity, errors.New("certificate has no OIDC issuer"), nil
+	}
+	return identity, s.verifyChain(certs, b, payload), nil
+}
+
+func (s *sigstoreBundleVerifier) verifyChain(certs []*x509.Certificate, b *bundle, payload []byte) error {
+	if s.roots == nil {
+		return errors.New("no sigstore trust root configured")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	// Sigstore certificates are short-lived, so they are checked at the time
+	// the signature was integrated in the transparency log, if the log
+	// promises it
+	at := time.Now()
+	var tlogErr error
+	for _, entry := range b.VerificationMaterial.TlogEntries {
+		integrated, err := s.integratedTime(&entry, b, payload, certs[0])
+		if err == nil {
+			at = integrated
+			tlogErr = nil
+			break
+		}
+		tlogErr = err
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         s.roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil && tlogErr != nil {
+		return fmt.Errorf("%w, and the transparency log entry is not trusted: %v", err, tlogErr)
+	}
+	return err
+}
+
+// integratedTime returns the time the transparency log entry was integrated
+// in the log, once the signed entry timestamp of a trusted log is verified
+// and the entry is known to be of the signature of the bundle.
+func (s *sigstoreBundleVerifier) integratedTime(entry *tlogEntry, b *bundle, payload []byte, cert *x509.Certificate) (time.Time, error) {
+	logID := hex.EncodeToString(entry.LogID.KeyID)
+	vfr, ok := s.logs[logID]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown transparency log %q", logID)
+	}
+	if entry.InclusionPromise == nil {
+		return time.Time{}, errors.New("transparency log entry has no inclusion promise")
+	}
+	integratedTime, err := parseInt64(entry.IntegratedTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid integrated time: %w", err)
+	}
+	logIndex, err := parseInt64(entry.LogIndex)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid log index: %w", err)
+	}
+	if err := matchEntry(entry.CanonicalizedBody, b, payload, cert); err != nil {
+		return time.Time{}, err
+	}
+	// the signed entry timestamp signs the canonical JSON of these fields,
+	// which is how they marshal in this order
+	promise, err := json.Marshal(struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogID          string `json:"logID"`
+		LogIndex       int64  `json:"logIndex"`
+	}{
+		Body:           base64.StdEncoding.EncodeToString(entry.CanonicalizedBody),
+		IntegratedTime: integratedTime,
+		LogID:          logID,
+		LogIndex:       logIndex,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := vfr.VerifySignature(bytes.NewReader(entry.InclusionPromise.SignedEntryTimestamp), bytes.NewReader(promise)); err != nil {
+		return time.Time{}, fmt.Errorf("failed to verify signed entry timestamp: %w", err)
+	}
+	return time.Unix(integratedTime, 0), nil
+}
+
+// matchEntry checks that the body of a transparency log entry records the
+// signature of the bundle, made with the certificate over the payload, so
+// that the entry of another signature of the certificate does not time this
+// one.
+func matchEntry(body []byte, b *bundle, payload []byte, cert *x509.Certificate) error {
+	var e tlogBody
+	if err := json.Unmarshal(body, &e); err != nil {
+		return fmt.Errorf("failed to parse transparency log entry: %w", err)
+	}
+	pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	sum := sha256.Sum256(payload)
+	payloadHash := tlogHash{Algorithm: "sha256", Value: hex.EncodeToString(sum[:])}
+
+	switch e.Kind {
+	case "hashedrekord":
+		if b.MessageSignature == nil || e.Spec.Data == nil || e.Spec.Signature == nil {
+			return errors.New("hashedrekord transparency log entry is not of a message signature")
+		}
+		if e.Spec.Data.Hash != payloadHash {
+			return errors.New("transparency log entry is not of the document")
+		}
+		if !bytes.Equal(e.Spec.Signature.Content, b.MessageSignature.Signature) {
+			return errors.New("transparency log entry is not of the signature")
+		}
+		if !bytes.Equal(e.Spec.Signature.PublicKey.Content, pemCert) {
+			return errors.New("transparency log entry is not of the certificate")
+		}
+		return nil
+	case "dsse":
+		if b.DSSEEnvelope == nil || e.Spec.PayloadHash == nil {
+			return errors.New("dsse transparency log entry is not of a DSSE envelope")
+		}
+		if *e.Spec.PayloadHash != payloadHash {
+			return errors.New("transparency log entry is not of the document")
+		}
+		for _, logged := range e.Spec.Signatures {
+			if !bytes.Equal(logged.Verifier, pemCert) {
+				continue
+			}
+			for _, sig := range b.DSSEEnvelope.Signatures {
+				if sig.Sig == logged.Signature {
+					return nil
+				}
+			}
+		}
+		return errors.New("transparency log entry is not of the signature with the certificate")
+	}
+	return fmt.Errorf("unsupported transparency log entry kind %q", e.Kind)
+}
+
+// parseInt64 parses an int64 of the protobuf JSON mapping, which is usually a
+// string but may be a number.
+func parseInt64(raw jsoniter.RawMessage) (int64, error) {
+	return strconv.ParseInt(strings.Trim(string(raw), `"`), 10, 64)
+}
+
+func verifySignature(b *bundle, k crypto.PublicKey, payload []byte) error {
+	vfr, err := signature.LoadVerifier(k, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("could not load verifier: %w", err)
+	}
+	switch {
+	case b.MessageSignature != nil:
+		if d := b.MessageSignature.MessageDigest; d != nil {
+			sum := sha256.Sum256(payload)
+			if d.Algorithm != "SHA2_256" || !bytes.Equal(d.Digest, sum[:]) {
+				return errors.New("message digest does not match the document")
+			}
+		}
+		return vfr.VerifySignature(bytes.NewReader(b.MessageSignature.Signature), bytes.NewReader(payload))
+	case b.DSSEEnvelope != nil:
+		envelopePayload, err := b.DSSEEnvelope.DecodeB64Payload()
+		if err != nil {
+			return fmt.Errorf("failed to decode DSSE payload: %w", err)
+		}
+		if !bytes.Equal(envelopePayload, payload) {
+			return errors.New("DSSE payload does not match the document")
+		}
+		envelope, err := json.Marshal(b.DSSEEnvelope)
+		if err != nil {
+			return err
+		}
+		return sig_dsse.WrapVerifier(vfr).VerifySignature(bytes.NewReader(envelope), nil)
+	}
+	return errors.New("sigstore bundle has no signature")
+}
+
+// certificateIdentity returns the subject of a Fulcio certificate, which is
+// its email address or, for workload identities, its URI.
+func certificateIdentity(cert *x509.Certificate) string {
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return cert.Subject.CommonName
+}
+
+var (
+	// oidIssuerV2 is the Fulcio extension holding the OIDC issuer as a DER
+	// encoded string, and oidIssuer the deprecated one holding it as is.
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+	oidIssuer   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+)
+
+// certificateIssuer returns the OIDC issuer that authenticated the subject of
+// a Fulcio certificate, or "" if the certificate does not record it.
+func certificateIssuer(cert *x509.Certificate) string {
+	var issuer string
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var s string
+			if _, err := asn1.Unmarshal(ext.Value, &s); err == nil {
+				return s
+			}
+		case ext.Id.Equal(oidIssuer):
+			issuer = string(ext.Value)
+		}
+	}
+	return issuer
+}
@@ -0,0 +1,434 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigstore_bundle_verifier
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/sigstore/sigstore/pkg/signature"
+	sig_dsse "github.com/sigstore/sigstore/pkg/signature/dsse"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newCA(t *testing.T) *testCA {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test root"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, priv.Public(), priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: priv}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+const testIssuer = "https://accounts.example.com"
+
+// issue returns a short-lived signing certificate of the email address,
+// authenticated by testIssuer.
+func (ca *testCA) issue(t *testing.T, email string, notBefore time.Time) ([]byte, *ecdsa.PrivateKey) {
+	t.Helper()
+	issuer, err := asn1.Marshal(testIssuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ca.issueWith(t, email, notBefore, pkix.Extension{Id: oidIssuerV2, Value: issuer})
+}
+
+func (ca *testCA) issueWith(t *testing.T, email string, notBefore time.Time, extensions ...pkix.Extension) ([]byte, *ecdsa.PrivateKey) {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       notBefore,
+		NotAfter:        notBefore.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses:  []string{email},
+		ExtraExtensions: extensions,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, priv.Public(), ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der, priv
+}
+
+// testLog is a transparency log that promises to include entries.
+type testLog struct {
+	key *ecdsa.PrivateKey
+	id  []byte
+}
+
+func newLog(t *testing.T) *testLog {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := sha256.Sum256(der)
+	return &testLog{key: priv, id: id[:]}
+}
+
+func payloadHash(payload []byte) map[string]interface{} {
+	sum := sha256.Sum256(payload)
+	return map[string]interface{}{"algorithm": "sha256", "value": hex.EncodeToString(sum[:])}
+}
+
+// entry returns the hashedrekord entry of the signature of the payload with
+// the certificate, integrated at the given time, with its signed entry
+// timestamp.
+func (l *testLog) entry(t *testing.T, cert, sig, payload []byte, integratedTime int64) map[string]interface{} {
+	t.Helper()
+	return l.integrate(t, map[string]interface{}{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]interface{}{
+			"data": map[string]interface{}{"hash": payloadHash(payload)},
+			"signature": map[string]interface{}{
+				"content":   sig,
+				"publicKey": map[string]interface{}{"content": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})},
+			},
+		},
+	}, integratedTime)
+}
+
+// dsseEntry returns the dsse entry of the envelope of the payload signed with
+// the certificate, as entry does.
+func (l *testLog) dsseEntry(t *testing.T, cert []byte, envelope *dsse.Envelope, payload []byte, integratedTime int64) map[string]interface{} {
+	t.Helper()
+	var signatures []interface{}
+	for _, sig := range envelope.Signatures {
+		signatures = append(signatures, map[string]interface{}{
+			"signature": sig.Sig,
+			"verifier":  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}),
+		})
+	}
+	return l.integrate(t, map[string]interface{}{
+		"apiVersion": "0.0.1",
+		"kind":       "dsse",
+		"spec": map[string]interface{}{
+			"payloadHash": payloadHash(payload),
+			"signatures":  signatures,
+		},
+	}, integratedTime)
+}
+
+func (l *testLog) integrate(t *testing.T, entryBody map[string]interface{}, integratedTime int64) map[string]interface{} {
+	t.Helper()
+	body, err := json.Marshal(entryBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	promise := fmt.Sprintf(`{"body":%q,"integratedTime":%d,"logID":%q,"logIndex":%d}`,
+		base64.StdEncoding.EncodeToString(body), integratedTime, hex.EncodeToString(l.id), 42)
+	s, err := signature.LoadSigner(l.key, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := s.SignMessage(bytes.NewReader([]byte(promise)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]interface{}{
+		"logIndex":          "42",
+		"logId":             map[string]interface{}{"keyId": l.id},
+		"integratedTime":    strconv.FormatInt(integratedTime, 10),
+		"inclusionPromise":  map[string]interface{}{"signedEntryTimestamp": set},
+		"canonicalizedBody": body,
+	}
+}
+
+func sign(t *testing.T, priv crypto.Signer, payload []byte) []byte {
+	t.Helper()
+	s, err := signature.LoadSigner(priv, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := s.SignMessage(bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func messageBundle(t *testing.T, cert, sig, payload []byte, tlogEntries ...map[string]interface{}) []byte {
+	t.Helper()
+	digest := sha256.Sum256(payload)
+	b := map[string]interface{}{
+		"mediaType": "application/vnd.dev.sigstore.bundle+json;version=0.2",
+		"verificationMaterial": map[string]interface{}{
+			"x509CertificateChain": map[string]interface{}{
+				"certificates": []interface{}{map[string]interface{}{"rawBytes": cert}},
+			},
+			"tlogEntries": tlogEntries,
+		},
+		"messageSignature": map[string]interface{}{
+			"messageDigest": map[string]interface{}{"algorithm": "SHA2_256", "digest": digest[:]},
+			"signature":     sig,
+		},
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func envelope(t *testing.T, priv crypto.Signer, payload []byte) *dsse.Envelope {
+	t.Helper()
+	s, err := signature.LoadSigner(priv, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := sig_dsse.WrapSigner(s, "application/vnd.in-toto+json").SignMessage(bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e dsse.Envelope
+	if err := json.Unmarshal(signed, &e); err != nil {
+		t.Fatal(err)
+	}
+	return &e
+}
+
+func dsseBundle(t *testing.T, cert []byte, envelope *dsse.Envelope, tlogEntries ...map[string]interface{}) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string]interface{}{
+		"mediaType": "application/vnd.dev.sigstore.bundle+json;version=0.3",
+		"verificationMaterial": map[string]interface{}{
+			"certificate": map[string]interface{}{"rawBytes": cert},
+			"tlogEntries": tlogEntries,
+		},
+		"dsseEnvelope": envelope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestSigstoreBundleVerifier_VerifyDetached(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	ca := newCA(t)
+	otherCA := newCA(t)
+	payload := []byte(`{"bomFormat": "CycloneDX"}`)
+
+	now := time.Now()
+	cert, priv := ca.issue(t, "release@example.com", now.Add(-time.Minute))
+	expired, expiredPriv := ca.issue(t, "release@example.com", now.Add(-time.Hour))
+	noIssuer, noIssuerPriv := ca.issueWith(t, "release@example.com", now.Add(-time.Minute))
+	sig := sign(t, priv, payload)
+	expiredSig := sign(t, expiredPriv, payload)
+	otherPayload := []byte(`{"bomFormat": "SPDX"}`)
+	otherSig := sign(t, expiredPriv, otherPayload)
+	env := envelope(t, priv, payload)
+	expiredEnv := envelope(t, expiredPriv, payload)
+	integrated := now.Add(-55 * time.Minute).Unix()
+
+	log := newLog(t)
+	otherLog := newLog(t)
+	forged := log.entry(t, expired, expiredSig, payload, now.Unix())
+	forged["integratedTime"] = strconv.FormatInt(integrated, 10)
+
+	tests := []struct {
+		name         string
+		roots        *x509.CertPool
+		payload      []byte
+		bundle       []byte
+		wantVerified bool
+		wantErr      bool
+	}{{
+		name:         "message signature",
+		roots:        ca.pool(),
+		payload:      payload,
+		bundle:       messageBundle(t, cert, sig, payload, log.entry(t, cert, sig, payload, now.Unix())),
+		wantVerified: true,
+	}, {
+		name:         "message signature not logged",
+		roots:        ca.pool(),
+		payload:      payload,
+		bundle:       messageBundle(t, cert, sig, payload),
+		wantVerified: true,
+	}, {
+		name:         "expired certificate used when integrated",
+		roots:        ca.pool(),
+		payload:      payload,
+		bundle:       messageBundle(t, expired, expiredSig, payload, log.entry(t, expired, expiredSig, payload, integrated)),
+		wantVerified: true,
+	}, {
+		name:    "expired certificate used after it expired",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, expired, expiredSig, payload, log.entry(t, expired, expiredSig, payload, now.Unix())),
+	}, {
+		name:    "expired certificate not logged",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, expired, expiredSig, payload),
+	}, {
+		name:    "expired certificate logged by an untrusted log",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, expired, expiredSig, payload, otherLog.entry(t, expired, expiredSig, payload, integrated)),
+	}, {
+		name:    "expired certificate with the entry of another certificate",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, expired, expiredSig, payload, log.entry(t, cert, expiredSig, payload, integrated)),
+	}, {
+		name:    "expired certificate with the entry of another document",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, expired, expiredSig, payload, log.entry(t, expired, otherSig, otherPayload, integrated)),
+	}, {
+		name:    "expired certificate with the entry of another signature",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, expired, expiredSig, payload, log.entry(t, expired, sign(t, expiredPriv, payload), payload, integrated)),
+	}, {
+		name:    "expired certificate with a forged integrated time",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, expired, expiredSig, payload, forged),
+	}, {
+		name:    "certificate without issuer",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, noIssuer, sign(t, noIssuerPriv, payload), payload),
+	}, {
+		name:    "untrusted root",
+		roots:   otherCA.pool(),
+		payload: payload,
+		bundle:  messageBundle(t, cert, sig, payload, log.entry(t, cert, sig, payload, now.Unix())),
+	}, {
+		name:    "no trust root",
+		payload: payload,
+		bundle:  messageBundle(t, cert, sig, payload, log.entry(t, cert, sig, payload, now.Unix())),
+	}, {
+		name:    "modified payload",
+		roots:   ca.pool(),
+		payload: otherPayload,
+		bundle:  messageBundle(t, cert, sig, payload, log.entry(t, cert, sig, payload, now.Unix())),
+	}, {
+		name:         "DSSE envelope",
+		roots:        ca.pool(),
+		payload:      payload,
+		bundle:       dsseBundle(t, cert, env),
+		wantVerified: true,
+	}, {
+		name:         "DSSE envelope of an expired certificate used when integrated",
+		roots:        ca.pool(),
+		payload:      payload,
+		bundle:       dsseBundle(t, expired, expiredEnv, log.dsseEntry(t, expired, expiredEnv, payload, integrated)),
+		wantVerified: true,
+	}, {
+		name:    "DSSE envelope of an expired certificate with the entry of another envelope",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  dsseBundle(t, expired, expiredEnv, log.dsseEntry(t, expired, envelope(t, expiredPriv, payload), payload, integrated)),
+	}, {
+		name:    "DSSE envelope with a hashedrekord entry",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  dsseBundle(t, expired, expiredEnv, log.entry(t, expired, expiredSig, payload, integrated)),
+	}, {
+		name:    "DSSE envelope of another payload",
+		roots:   ca.pool(),
+		payload: otherPayload,
+		bundle:  dsseBundle(t, cert, env),
+	}, {
+		name:    "not a bundle",
+		roots:   ca.pool(),
+		payload: payload,
+		bundle:  []byte(base64.StdEncoding.EncodeToString([]byte("signature"))),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewSigstoreBundleVerifier(tt.roots, log.key.Public())
+			if err != nil {
+				t.Fatalf("NewSigstoreBundleVerifier() error = %v", err)
+			}
+			got, err := v.VerifyDetached(ctx, tt.payload, tt.bundle)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyDetached() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 1 || got[0].ID != "release@example.com" {
+				t.Fatalf("VerifyDetached() = %v, want identity release@example.com", got)
+			}
+			if got[0].Verified && got[0].Issuer != testIssuer {
+				t.Errorf("VerifyDetached() issuer = %q, want %q", got[0].Issuer, testIssuer)
+			}
+			if got[0].Verified != tt.wantVerified {
+				t.Errorf("VerifyDetached() verified = %v, want %v", got[0].Verified, tt.wantVerified)
+			}
+		})
+	}
+}
@@ -42,15 +42,26 @@ type Verifier interface {
 	Type() VerifierType
 }
 
+// DetachedVerifier is a Verifier of signatures that are kept apart from the
+// payload they sign, such as cosign signatures or Sigstore bundles.
+type DetachedVerifier interface {
+	Verifier
+	// VerifyDetached takes in the payload and its signature and returns the
+	// identities that signed it, with the same semantics as Verify.
+	VerifyDetached(ctx context.Context, payloadBytes []byte, signatureBytes []byte) ([]Identity, error)
+}
+
 // Identity struct elements might be nil/empty if the key is invalid or the
 // ID of the identity can't be determined. Verified indicates that the
 // identity has been verified, usually based on signature matching the key.
 // This shouldn't be used to indicate that the Identity is trusted in any
-// way.
+// way. Issuer is the OIDC issuer that authenticated the identity of a
+// Sigstore certificate, and is empty for identities of keys.
 type Identity struct {
 	ID       string
 	Key      key.Key
 	Verified bool
+	Issuer   string
 }
 
 var (
@@ -67,13 +78,37 @@ func RegisterVerifier(k Verifier, providerType VerifierType) error {
 	return nil
 }
 
-// VerifyIdentity goes through the registered providers and verifies the signatures in the payload
+// VerifyIdentity goes through the registered providers and verifies the
+// signatures in the payload, as well as the detached signatures of the
+// document
 func VerifyIdentity(ctx context.Context, doc *processor.Document) ([]Identity, error) {
+	var identities []Identity
+	verified := false
 	switch doc.Type {
 	case processor.DocumentDSSE:
 		if verifier, ok := verifierProviders["sigstore"]; ok {
-			return verifier.Verify(ctx, doc.Blob)
+			found, err := verifier.Verify(ctx, doc.Blob)
+			if err != nil {
+				return nil, err
+			}
+			identities = append(identities, found...)
+			verified = true
+		}
+	}
+	for _, signature := range doc.Signatures {
+		verifier, ok := verifierProviders[VerifierType(signature.Type)].(DetachedVerifier)
+		if !ok {
+			return nil, fmt.Errorf("no verifier registered for detached %s signatures", signature.Type)
+		}
+		found, err := verifier.VerifyDetached(ctx, doc.Blob, signature.Blob)
+		if err != nil {
+			return nil, err
 		}
+		identities = append(identities, found...)
+		verified = true
+	}
+	if !verified {
+		return nil, fmt.Errorf("failed verification for document type: %s", doc.Type)
 	}
-	return nil, fmt.Errorf("failed verification for document type: %s", doc.Type)
+	return identities, nil
 }
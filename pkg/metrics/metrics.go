@@ -15,6 +15,8 @@
 
 package metrics
 
+import "context"
+
 // Metrics is an interface for metrics
 type Metrics interface {
 	// NewSummary creates a new summary metric
@@ -25,4 +27,40 @@ type Metrics interface {
 	ObserveSummary(name string, duration float64)
 	// IncrementCounter increments a counter metric
 	IncrementCounter(name string)
+	// NewSummaryVec creates a new summary metric partitioned by labels
+	NewSummaryVec(name string, labels ...string) error
+	// NewCounterVec creates a new counter metric partitioned by labels
+	NewCounterVec(name string, labels ...string) error
+	// ObserveSummaryVec records a value for the summary of the label values
+	ObserveSummaryVec(name string, value float64, labelValues ...string)
+	// IncrementCounterVec increments the counter of the label values
+	IncrementCounterVec(name string, labelValues ...string)
+}
+
+type metricsKey struct{}
+
+// WithMetrics returns a context that carries the metrics, for the packages
+// of the pipeline to report to.
+func WithMetrics(ctx context.Context, m Metrics) context.Context {
+	return context.WithValue(ctx, metricsKey{}, m)
 }
+
+// FromContext returns the metrics carried by the context, or metrics that
+// report nothing if there are none.
+func FromContext(ctx context.Context) Metrics {
+	if m, ok := ctx.Value(metricsKey{}).(Metrics); ok {
+		return m
+	}
+	return noop{}
+}
+
+type noop struct{}
+
+func (noop) NewSummary(string) error                      { return nil }
+func (noop) NewCounter(string) error                      { return nil }
+func (noop) ObserveSummary(string, float64)               {}
+func (noop) IncrementCounter(string)                      {}
+func (noop) NewSummaryVec(string, ...string) error        { return nil }
+func (noop) NewCounterVec(string, ...string) error        { return nil }
+func (noop) ObserveSummaryVec(string, float64, ...string) {}
+func (noop) IncrementCounterVec(string, ...string)        {}
@@ -0,0 +1,93 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	// DocumentsCollected counts the documents collected, by collector
+	DocumentsCollected = "guac_documents_collected"
+	// ProcessSeconds observes the processing latency, by document type
+	ProcessSeconds = "guac_process_seconds"
+	// ParseSeconds observes the parsing latency, by document type
+	ParseSeconds = "guac_parse_seconds"
+	// AssemblerBatchSize observes the number of predicates of the batches
+	// sent by the assembler, by kind of predicate
+	AssemblerBatchSize = "guac_assembler_batch_size"
+	// ResolverSeconds observes the latency of the GraphQL operations, by
+	// operation type and root field, such as "query packages"
+	ResolverSeconds = "guac_graphql_resolver_seconds"
+)
+
+// NewPipeline returns prometheus metrics with the metrics of the guac
+// pipeline registered.
+func NewPipeline() (Metrics, error) {
+	m := NewPrometheus()
+	var errs []error
+	errs = append(errs, m.NewCounterVec(DocumentsCollected, "collector"))
+	errs = append(errs, m.NewSummaryVec(ProcessSeconds, "document_type"))
+	errs = append(errs, m.NewSummaryVec(ParseSeconds, "document_type"))
+	errs = append(errs, m.NewSummaryVec(AssemblerBatchSize, "predicate"))
+	errs = append(errs, m.NewSummaryVec(ResolverSeconds, "operation"))
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServePipeline serves the metrics of the guac pipeline on the /metrics
+// endpoint of the port, in the background until the context is canceled. It
+// returns the context the pipeline reports its metrics to.
+func ServePipeline(ctx context.Context, port int) (context.Context, error) {
+	m, err := NewPipeline()
+	if err != nil {
+		return ctx, err
+	}
+	go func() {
+		if err := Serve(ctx, port); err != nil {
+			logging.FromContext(ctx).Errorf("metrics server terminated with error: %v", err)
+		}
+	}()
+	return WithMetrics(ctx, m), nil
+}
+
+// Serve serves the /metrics endpoint on the port until the context is
+// canceled.
+func Serve(ctx context.Context, port int) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	srv := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve metrics: %w", err)
+	}
+	return nil
+}
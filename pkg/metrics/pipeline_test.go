@@ -0,0 +1,70 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewPipeline(t *testing.T) {
+	m, err := NewPipeline()
+	if err != nil {
+		t.Fatalf("NewPipeline() error = %v", err)
+	}
+	if err := m.NewCounterVec(DocumentsCollected, "collector"); err == nil {
+		t.Errorf("NewCounterVec() of an existing counter did not fail")
+	}
+	ctx := WithMetrics(context.Background(), m)
+	FromContext(ctx).IncrementCounterVec(DocumentsCollected, "FileCollector")
+	FromContext(ctx).ObserveSummaryVec(ParseSeconds, 0.5, "SPDX")
+	FromContext(ctx).ObserveSummaryVec(AssemblerBatchSize, 12, "Package")
+	// unknown metrics are ignored
+	FromContext(ctx).IncrementCounterVec("unknown", "FileCollector")
+
+	srv := httptest.NewServer(Handler())
+	defer srv.Close()
+	resp, err := srv.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatalf("error getting metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("error reading metrics: %v", err)
+	}
+	for _, want := range []string{
+		`guac_documents_collected_total{collector="FileCollector"} 1`,
+		`guac_parse_seconds_sum{document_type="SPDX"} 0.5`,
+		`guac_assembler_batch_size_count{predicate="Package"} 1`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("metrics do not contain %q", want)
+		}
+	}
+}
+
+func TestFromContext_noop(t *testing.T) {
+	m := FromContext(context.Background())
+	if err := m.NewCounterVec(DocumentsCollected, "collector"); err != nil {
+		t.Errorf("NewCounterVec() error = %v", err)
+	}
+	m.IncrementCounterVec(DocumentsCollected, "FileCollector")
+	m.ObserveSummaryVec(ProcessSeconds, 1, "SPDX")
+}
@@ -17,23 +17,35 @@ package metrics
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type prom struct {
-	counters map[string]prometheus.Counter
-	summary  map[string]prometheus.Summary
+	counters    map[string]prometheus.Counter
+	summary     map[string]prometheus.Summary
+	counterVecs map[string]*prometheus.CounterVec
+	summaryVecs map[string]*prometheus.SummaryVec
 }
 
 // NewPrometheus returns a new prometheus metrics implementation
 func NewPrometheus() Metrics {
 	return &prom{
-		counters: make(map[string]prometheus.Counter),
-		summary:  make(map[string]prometheus.Summary),
+		counters:    make(map[string]prometheus.Counter),
+		summary:     make(map[string]prometheus.Summary),
+		counterVecs: make(map[string]*prometheus.CounterVec),
+		summaryVecs: make(map[string]*prometheus.SummaryVec),
 	}
 }
 
+// Handler returns the handler of the /metrics endpoint, which serves the
+// metrics registered by the prometheus implementation.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
 // NewCounter creates a new counter metric
 func (p *prom) NewCounter(name string) error {
 	if _, ok := p.counters[name]; ok {
@@ -73,3 +85,54 @@ func (p *prom) NewSummary(name string) error {
 func (p *prom) ObserveSummary(funcName string, duration float64) {
 	p.summary[funcName].Observe(duration)
 }
+
+// NewCounterVec creates a new counter metric partitioned by labels
+func (p *prom) NewCounterVec(name string, labels ...string) error {
+	if _, ok := p.counterVecs[name]; ok {
+		return fmt.Errorf("counter %s already exists", name)
+	}
+	counter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: fmt.Sprintf("%s_total", name),
+			Help: fmt.Sprintf("The total number of %s", name),
+		}, labels)
+	if err := prometheus.Register(counter); err != nil {
+		return fmt.Errorf("failed to register counter %s: %w", name, err)
+	}
+	p.counterVecs[name] = counter
+	return nil
+}
+
+// IncrementCounterVec increments the counter of the label values. Unknown
+// counters are ignored.
+func (p *prom) IncrementCounterVec(name string, labelValues ...string) {
+	if counter, ok := p.counterVecs[name]; ok {
+		counter.WithLabelValues(labelValues...).Inc()
+	}
+}
+
+// NewSummaryVec creates a new summary metric partitioned by labels
+func (p *prom) NewSummaryVec(name string, labels ...string) error {
+	if _, ok := p.summaryVecs[name]; ok {
+		return fmt.Errorf("summary %s already exists", name)
+	}
+	summary := prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name:       name,
+			Help:       fmt.Sprintf("The summary of %s", name),
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		}, labels)
+	if err := prometheus.Register(summary); err != nil {
+		return fmt.Errorf("failed to register summary %s: %w", name, err)
+	}
+	p.summaryVecs[name] = summary
+	return nil
+}
+
+// ObserveSummaryVec records a value for the summary of the label values.
+// Unknown summaries are ignored.
+func (p *prom) ObserveSummaryVec(name string, value float64, labelValues ...string) {
+	if summary, ok := p.summaryVecs[name]; ok {
+		summary.WithLabelValues(labelValues...).Observe(value)
+	}
+}
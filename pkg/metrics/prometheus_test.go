@@ -35,8 +35,10 @@ func TestNewPrometheus(t *testing.T) {
 	}{
 		name: "default",
 		want: &prom{
-			counters: make(map[string]prometheus.Counter),
-			summary:  make(map[string]prometheus.Summary),
+			counters:    make(map[string]prometheus.Counter),
+			summary:     make(map[string]prometheus.Summary),
+			counterVecs: make(map[string]*prometheus.CounterVec),
+			summaryVecs: make(map[string]*prometheus.SummaryVec),
 		},
 	}
 	t.Run(test.name, func(t *testing.T) {
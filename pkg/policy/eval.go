@@ -0,0 +1,420 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/misc/depversion"
+)
+
+const (
+	guacType   = "guac"
+	noVulnType = "novuln"
+)
+
+// Report is the outcome of evaluating a policy against a subject.
+type Report struct {
+	Subject string   `json:"subject"`
+	Passed  bool     `json:"passed"`
+	Results []Result `json:"results"`
+}
+
+// Result is the verdict of a rule, with the IDs of the graph nodes it is
+// based on.
+type Result struct {
+	Rule     string   `json:"rule"`
+	Passed   bool     `json:"passed"`
+	Message  string   `json:"message"`
+	Evidence []string `json:"evidence"`
+}
+
+// Write writes a human readable report to w.
+func (r *Report) Write(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "policy evaluation of %s: %s\n", r.Subject, verdict(r.Passed)); err != nil {
+		return err
+	}
+	for _, res := range r.Results {
+		if _, err := fmt.Fprintf(w, "[%s] %s: %s\n", verdict(res.Passed), res.Rule, res.Message); err != nil {
+			return err
+		}
+		if len(res.Evidence) > 0 {
+			if _, err := fmt.Fprintf(w, "       evidence: %s\n", strings.Join(res.Evidence, ", ")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func verdict(passed bool) string {
+	if passed {
+		return "PASS"
+	}
+	return "FAIL"
+}
+
+// pkgNode is a package version with the ID of its name, as attestations can
+// apply to either.
+type pkgNode struct {
+	versionID string
+	nameID    string
+	purl      string
+}
+
+// evaluator walks the neighborhood of the subject, caching the neighbors
+// queried so that rules can share them.
+type evaluator struct {
+	gqlClient graphql.Client
+	neighbors map[string][]model.NeighborsNeighborsNode
+
+	// packages and artifacts of the subject, linked by IsOccurrence
+	packages  []pkgNode
+	artifacts []string
+	// dependencies are the transitive dependencies of the packages, only
+	// looked up when a rule needs them
+	dependencies []pkgNode
+	depsFound    bool
+}
+
+// Evaluate evaluates every rule of the policy against the subject, given as
+// a purl of a package version or as an artifact in the algorithm:digest form.
+func Evaluate(ctx context.Context, gqlClient graphql.Client, p *Policy, subject string) (*Report, error) {
+	e := &evaluator{
+		gqlClient: gqlClient,
+		neighbors: map[string][]model.NeighborsNeighborsNode{},
+	}
+	var err error
+	if strings.HasPrefix(subject, "pkg:") {
+		err = e.resolvePackage(ctx, subject)
+	} else {
+		err = e.resolveArtifact(ctx, subject)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Subject: subject, Passed: true}
+	for _, rule := range p.Rules {
+		var res Result
+		switch {
+		case rule.SLSA != nil:
+			res, err = e.evalSLSA(ctx, rule.SLSA)
+		case rule.Vulnerability != nil:
+			res, err = e.evalVulnerability(ctx, rule.Vulnerability)
+		case rule.Scorecard != nil:
+			res, err = e.evalScorecard(ctx, rule.Scorecard)
+		case rule.CertifyBad != nil:
+			res, err = e.evalCertifyBad(ctx, rule.CertifyBad)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate rule %q: %w", rule.Name, err)
+		}
+		res.Rule = rule.Name
+		res.Evidence = sortAndRemoveDups(res.Evidence)
+		report.Passed = report.Passed && res.Passed
+		report.Results = append(report.Results, res)
+	}
+	return report, nil
+}
+
+func (e *evaluator) resolvePackage(ctx context.Context, purl string) error {
+	pkgInput, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return fmt.Errorf("failed to parse purl %s: %w", purl, err)
+	}
+	if pkgInput.Version == nil {
+		return fmt.Errorf("expected purl %s to have a version", purl)
+	}
+	pkgQualifierFilter := []model.PackageQualifierSpec{}
+	for _, qualifier := range pkgInput.Qualifiers {
+		qualifier := qualifier
+		pkgQualifierFilter = append(pkgQualifierFilter, model.PackageQualifierSpec{
+			Key:   qualifier.Key,
+			Value: &qualifier.Value,
+		})
+	}
+	pkgs, err := clienthelpers.Packages(ctx, e.gqlClient, model.PkgSpec{
+		Type:       &pkgInput.Type,
+		Namespace:  pkgInput.Namespace,
+		Name:       &pkgInput.Name,
+		Version:    pkgInput.Version,
+		Subpath:    pkgInput.Subpath,
+		Qualifiers: pkgQualifierFilter,
+	})
+	if err != nil {
+		return fmt.Errorf("error querying for package %s: %w", purl, err)
+	}
+	if len(pkgs) == 0 {
+		return fmt.Errorf("no package matching purl %s found", purl)
+	}
+	pkg, err := newPkgNode(pkgs[0])
+	if err != nil {
+		return err
+	}
+	e.packages = []pkgNode{pkg}
+
+	neighbors, err := e.neighborsOf(ctx, pkg.versionID, model.EdgePackageIsOccurrence)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		if occ, ok := n.(*model.NeighborsNeighborsIsOccurrence); ok {
+			e.artifacts = append(e.artifacts, occ.Artifact.Id)
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) resolveArtifact(ctx context.Context, artifact string) error {
+	algorithm, digest, ok := strings.Cut(artifact, ":")
+	if !ok {
+		return fmt.Errorf("expected subject to be a purl or an artifact as algorithm:digest, got %s", artifact)
+	}
+	algorithm = strings.ToLower(algorithm)
+	digest = strings.ToLower(digest)
+	arts, err := clienthelpers.Artifacts(ctx, e.gqlClient, model.ArtifactSpec{Algorithm: &algorithm, Digest: &digest})
+	if err != nil {
+		return fmt.Errorf("error querying for artifact %s: %w", artifact, err)
+	}
+	if len(arts) == 0 {
+		return fmt.Errorf("no artifact %s found", artifact)
+	}
+	artID := arts[0].Id
+	e.artifacts = []string{artID}
+
+	neighbors, err := e.neighborsOf(ctx, artID, model.EdgeArtifactIsOccurrence)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		if occ, ok := n.(*model.NeighborsNeighborsIsOccurrence); ok {
+			if pkg, ok := occ.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+				n, err := newPkgNode(pkg.AllPkgTree)
+				if err != nil {
+					return err
+				}
+				e.packages = append(e.packages, n)
+			}
+		}
+	}
+	return nil
+}
+
+// pkgName returns the namespace and name of a package, which queries return
+// as a tree with a single branch.
+func pkgName(pkg model.AllPkgTree) (model.AllPkgTreeNamespacesPackageNamespace, model.AllPkgTreeNamespacesPackageNamespaceNamesPackageName, error) {
+	if len(pkg.Namespaces) == 0 || len(pkg.Namespaces[0].Names) == 0 {
+		return model.AllPkgTreeNamespacesPackageNamespace{}, model.AllPkgTreeNamespacesPackageNamespaceNamesPackageName{},
+			fmt.Errorf("package %s of type %s has no name", pkg.Id, pkg.Type)
+	}
+	return pkg.Namespaces[0], pkg.Namespaces[0].Names[0], nil
+}
+
+func newPkgNode(pkg model.AllPkgTree) (pkgNode, error) {
+	ns, name, err := pkgName(pkg)
+	if err != nil {
+		return pkgNode{}, err
+	}
+	n := pkgNode{nameID: name.Id}
+	var version, subpath string
+	var qualifiers []string
+	if len(name.Versions) > 0 {
+		n.versionID = name.Versions[0].Id
+		version = name.Versions[0].Version
+		subpath = name.Versions[0].Subpath
+		for _, q := range name.Versions[0].Qualifiers {
+			qualifiers = append(qualifiers, q.Key, q.Value)
+		}
+	}
+	n.purl = helpers.PkgToPurl(pkg.Type, ns.Namespace, name.Name, version, subpath, qualifiers)
+	return n, nil
+}
+
+// ids returns the IDs of the package version, if known, and name.
+func (p pkgNode) ids() []string {
+	if p.versionID == "" {
+		return []string{p.nameID}
+	}
+	return []string{p.versionID, p.nameID}
+}
+
+func (e *evaluator) neighborsOf(ctx context.Context, id string, edges ...model.Edge) ([]model.NeighborsNeighborsNode, error) {
+	key := id
+	for _, edge := range edges {
+		key += "," + string(edge)
+	}
+	if n, ok := e.neighbors[key]; ok {
+		return n, nil
+	}
+	response, err := model.Neighbors(ctx, e.gqlClient, id, edges)
+	if err != nil {
+		return nil, fmt.Errorf("error querying neighbors of %s: %w", id, err)
+	}
+	e.neighbors[key] = response.Neighbors
+	return response.Neighbors, nil
+}
+
+// scope returns the packages of the subject, followed by their transitive
+// dependencies when asked for.
+func (e *evaluator) scope(ctx context.Context, transitive bool) ([]pkgNode, error) {
+	if !transitive {
+		return e.packages, nil
+	}
+	if !e.depsFound {
+		if err := e.findDependencies(ctx); err != nil {
+			return nil, err
+		}
+		e.depsFound = true
+	}
+	return append(append([]pkgNode{}, e.packages...), e.dependencies...), nil
+}
+
+// findDependencies walks the IsDependency nodes from the packages of the
+// subject. Dependencies on a version range stand for every ingested version
+// in the range.
+func (e *evaluator) findDependencies(ctx context.Context) error {
+	visited := map[string]bool{}
+	var queue []string
+	for _, p := range e.packages {
+		visited[p.versionID] = true
+		queue = append(queue, p.versionID)
+	}
+
+	for len(queue) > 0 {
+		now := queue[0]
+		queue = queue[1:]
+
+		neighbors, err := e.neighborsOf(ctx, now, model.EdgePackageIsDependency)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			isDep, ok := n.(*model.NeighborsNeighborsIsDependency)
+			if !ok {
+				continue
+			}
+			// only follow dependencies of this package, not on it
+			from, err := newPkgNode(isDep.Package.AllPkgTree)
+			if err != nil {
+				return err
+			}
+			if from.versionID != now {
+				continue
+			}
+			if isDep.DependencyPackage.Type == guacType {
+				continue
+			}
+			deps, err := e.dependencyVersions(ctx, isDep)
+			if err != nil {
+				return err
+			}
+			for _, dep := range deps {
+				id := dep.versionID
+				if id == "" {
+					id = dep.nameID
+				}
+				if visited[id] {
+					continue
+				}
+				visited[id] = true
+				e.dependencies = append(e.dependencies, dep)
+				if dep.versionID != "" {
+					queue = append(queue, dep.versionID)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) dependencyVersions(ctx context.Context, isDep *model.NeighborsNeighborsIsDependency) ([]pkgNode, error) {
+	dep := isDep.DependencyPackage.AllPkgTree
+	depNode, err := newPkgNode(dep)
+	if err != nil {
+		return nil, err
+	}
+	if depNode.versionID != "" {
+		return []pkgNode{depNode}, nil
+	}
+
+	depNS, depName, _ := pkgName(dep)
+	deps, err := clienthelpers.Packages(ctx, e.gqlClient, model.PkgSpec{
+		Type:      &dep.Type,
+		Namespace: &depNS.Namespace,
+		Name:      &depName.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying for dependency package: %w", err)
+	}
+	// the name itself can hold attestations for every version
+	result := []pkgNode{depNode}
+	if len(deps) == 0 {
+		return result, nil
+	}
+	pkg := deps[0]
+	ns, name, err := pkgName(pkg)
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, v := range name.Versions {
+		versions = append(versions, v.Version)
+	}
+	matching, err := depversion.WhichVersionMatches(versions, isDep.VersionRange)
+	if err != nil {
+		// version ranges that cannot be parsed only keep the name
+		return result, nil
+	}
+	for _, v := range name.Versions {
+		if !matching[v.Version] {
+			continue
+		}
+		versionPkg := pkg
+		versionName := name
+		versionName.Versions = []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion{v}
+		versionPkg.Namespaces = []model.AllPkgTreeNamespacesPackageNamespace{{
+			Id:        ns.Id,
+			Namespace: ns.Namespace,
+			Names:     []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageName{versionName},
+		}}
+		n, err := newPkgNode(versionPkg)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, n)
+	}
+	return result, nil
+}
+
+func sortAndRemoveDups(ids []string) []string {
+	sort.Strings(ids)
+	var result []string
+	for i, id := range ids {
+		if i == 0 || ids[i-1] != id {
+			result = append(result, id)
+		}
+	}
+	return result
+}
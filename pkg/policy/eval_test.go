@@ -0,0 +1,307 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/gqlserver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var (
+	tm = time.Date(2023, 7, 17, 17, 45, 50, 0, time.UTC)
+
+	appPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "app", Version: ptrfrom.String("1.0.0")}
+	libPkg = model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "lib", Version: ptrfrom.String("2.0.0")}
+	appArt = model.ArtifactInputSpec{Algorithm: "sha256", Digest: "aaaa"}
+	srcArt = model.ArtifactInputSpec{Algorithm: "sha256", Digest: "bbbb"}
+	appSrc = model.SourceInputSpec{Type: "git", Namespace: "github.com/example", Name: "app"}
+
+	appVuln      = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0001"}
+	libVuln      = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0002"}
+	libVEXedVuln = model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-0003"}
+)
+
+// ingestTestGraph ingests app, built on a trusted builder from a source with a
+// scorecard, depending on lib. app has a low vulnerability and lib two critical
+// ones, the highest of which does not affect it. lib is also certified
+// bad.
+func ingestTestGraph(ctx context.Context, t *testing.T, client graphql.Client) {
+	t.Helper()
+	for _, pkg := range []model.PkgInputSpec{appPkg, libPkg} {
+		if _, err := model.IngestPackage(ctx, client, pkg); err != nil {
+			t.Fatalf("error ingesting package: %v", err)
+		}
+	}
+	for _, art := range []model.ArtifactInputSpec{appArt, srcArt} {
+		if _, err := model.IngestArtifact(ctx, client, art); err != nil {
+			t.Fatalf("error ingesting artifact: %v", err)
+		}
+	}
+	if _, err := model.IngestSource(ctx, client, appSrc); err != nil {
+		t.Fatalf("error ingesting source: %v", err)
+	}
+	if _, err := model.IsDependency(ctx, client, appPkg, libPkg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting dependency: %v", err)
+	}
+	if _, err := model.IsOccurrencePkg(ctx, client, appPkg, appArt, model.IsOccurrenceInputSpec{Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting occurrence: %v", err)
+	}
+
+	builder := model.BuilderInputSpec{Uri: "https://github.com/slsa-framework/slsa-github-generator/generic@v1"}
+	if _, err := model.IngestBuilder(ctx, client, builder); err != nil {
+		t.Fatalf("error ingesting builder: %v", err)
+	}
+	if _, err := model.SLSAForArtifact(ctx, client, appArt, []model.ArtifactInputSpec{srcArt}, builder,
+		model.SLSAInputSpec{BuildType: "test", SlsaPredicate: []model.SLSAPredicateInputSpec{}, SlsaVersion: "v1", Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting HasSLSA: %v", err)
+	}
+
+	if _, err := model.IngestHasSourceAt(ctx, client, appPkg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, appSrc,
+		model.HasSourceAtInputSpec{KnownSince: tm, Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting HasSourceAt: %v", err)
+	}
+	if _, err := model.CertifyScorecard(ctx, client, appSrc, model.ScorecardInputSpec{
+		Checks: []model.ScorecardCheckInputSpec{}, AggregateScore: 7.5, TimeScanned: tm, Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting scorecard: %v", err)
+	}
+
+	vulns := []struct {
+		pkg   model.PkgInputSpec
+		vuln  model.VulnerabilityInputSpec
+		score float64
+	}{
+		{appPkg, appVuln, 5.0},
+		{libPkg, libVuln, 9.8},
+		{libPkg, libVEXedVuln, 9.9},
+	}
+	for _, v := range vulns {
+		if _, err := model.IngestVulnerability(ctx, client, v.vuln); err != nil {
+			t.Fatalf("error ingesting vulnerability: %v", err)
+		}
+		if _, err := model.VulnHasMetadata(ctx, client, v.vuln, model.VulnerabilityMetadataInputSpec{
+			ScoreType: model.VulnerabilityScoreTypeCvssv3, ScoreValue: v.score, Timestamp: tm, Collector: "test"}); err != nil {
+			t.Fatalf("error ingesting vulnerability metadata: %v", err)
+		}
+		if _, err := model.CertifyVulnPkg(ctx, client, v.pkg, v.vuln,
+			model.ScanMetadataInput{TimeScanned: tm, Collector: "test"}); err != nil {
+			t.Fatalf("error ingesting CertifyVuln: %v", err)
+		}
+	}
+	if _, err := model.CertifyVexPkg(ctx, client, libPkg, libVEXedVuln, model.VexStatementInputSpec{
+		Status:           model.VexStatusNotAffected,
+		VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+		KnownSince:       tm,
+		Collector:        "test",
+	}); err != nil {
+		t.Fatalf("error ingesting VEX statement: %v", err)
+	}
+
+	if _, err := model.CertifyBadPkg(ctx, client, libPkg, model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
+		model.CertifyBadInputSpec{Justification: "malicious", KnownSince: tm, Collector: "test"}); err != nil {
+		t.Fatalf("error ingesting CertifyBad: %v", err)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := gqlserver.NewClient(t)
+	ingestTestGraph(ctx, t, client)
+
+	builders := map[string]int{"https://github.com/slsa-framework/*": 3}
+	tests := []struct {
+		name         string
+		subject      string
+		rule         Rule
+		wantPassed   bool
+		wantEvidence int
+		wantErr      bool
+	}{
+		{
+			name:         "trusted builder",
+			subject:      "sha256:aaaa",
+			rule:         Rule{SLSA: &SLSARule{MinBuildLevel: 3, Builders: builders}},
+			wantPassed:   true,
+			wantEvidence: 1,
+		},
+		{
+			name:         "builder level too low",
+			subject:      "pkg:golang/example.com/app@1.0.0",
+			rule:         Rule{SLSA: &SLSARule{MinBuildLevel: 4, Builders: builders}},
+			wantEvidence: 1,
+		},
+		{
+			name:       "no artifact",
+			subject:    "pkg:golang/example.com/lib@2.0.0",
+			rule:       Rule{SLSA: &SLSARule{MinBuildLevel: 1, Builders: builders}},
+			wantPassed: false,
+		},
+		{
+			name:       "direct vulnerabilities below threshold",
+			subject:    "pkg:golang/example.com/app@1.0.0",
+			rule:       Rule{Vulnerability: &VulnerabilityRule{MaxScore: 7}},
+			wantPassed: true,
+		},
+		{
+			name:    "critical vulnerability in dependency",
+			subject: "sha256:aaaa",
+			rule:    Rule{Vulnerability: &VulnerabilityRule{MaxScore: 7, Transitive: true}},
+			// the CertifyVuln and its metadata
+			wantEvidence: 2,
+		},
+		{
+			name:       "not affected vulnerability in dependency",
+			subject:    "pkg:golang/example.com/lib@2.0.0",
+			rule:       Rule{Vulnerability: &VulnerabilityRule{MaxScore: 9.8}},
+			wantPassed: true,
+			// the CertifyVuln and the VEX statement
+			wantEvidence: 2,
+		},
+		{
+			name:         "scorecard",
+			subject:      "sha256:aaaa",
+			rule:         Rule{Scorecard: &ScorecardRule{MinScore: 6}},
+			wantPassed:   true,
+			wantEvidence: 1,
+		},
+		{
+			name:         "scorecard too low",
+			subject:      "pkg:golang/example.com/app@1.0.0",
+			rule:         Rule{Scorecard: &ScorecardRule{MinScore: 8}},
+			wantEvidence: 1,
+		},
+		{
+			name:       "no bad package",
+			subject:    "pkg:golang/example.com/app@1.0.0",
+			rule:       Rule{CertifyBad: &CertifyBadRule{}},
+			wantPassed: true,
+		},
+		{
+			name:         "bad dependency",
+			subject:      "pkg:golang/example.com/app@1.0.0",
+			rule:         Rule{CertifyBad: &CertifyBadRule{Transitive: true}},
+			wantEvidence: 1,
+		},
+		{
+			name:    "unknown subject",
+			subject: "pkg:golang/example.com/unknown@1.0.0",
+			rule:    Rule{CertifyBad: &CertifyBadRule{}},
+			wantErr: true,
+		},
+		{
+			name:    "purl without version",
+			subject: "pkg:golang/example.com/app",
+			rule:    Rule{CertifyBad: &CertifyBadRule{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Name = "rule"
+			report, err := Evaluate(ctx, client, &Policy{Rules: []Rule{tt.rule}}, tt.subject)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Evaluate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if report.Passed != tt.wantPassed {
+				t.Errorf("Evaluate() passed = %v, want %v: %+v", report.Passed, tt.wantPassed, report.Results)
+			}
+			if got := len(report.Results[0].Evidence); got != tt.wantEvidence {
+				t.Errorf("Evaluate() got %d evidence nodes, want %d: %+v", got, tt.wantEvidence, report.Results[0])
+			}
+		})
+	}
+}
+
+func TestReportWrite(t *testing.T) {
+	report := &Report{
+		Subject: "sha256:aaaa",
+		Results: []Result{
+			{Rule: "slsa", Passed: true, Message: "1 artifacts built by a builder of build level 3 or more", Evidence: []string{"12"}},
+			{Rule: "bad", Message: "1 CertifyBad found", Evidence: []string{"20", "21"}},
+		},
+	}
+	var buf bytes.Buffer
+	if err := report.Write(&buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	want := strings.Join([]string{
+		"policy evaluation of sha256:aaaa: FAIL",
+		"[PASS] slsa: 1 artifacts built by a builder of build level 3 or more",
+		"       evidence: 12",
+		"[FAIL] bad: 1 CertifyBad found",
+		"       evidence: 20, 21",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("Write() = %q, want %q", got, want)
+	}
+}
+
+func TestNewPkgNode(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkg      model.AllPkgTree
+		wantPurl string
+		wantErr  bool
+	}{
+		{
+			name: "version",
+			pkg: model.AllPkgTree{Id: "1", Type: "golang", Namespaces: []model.AllPkgTreeNamespacesPackageNamespace{{
+				Id: "2", Namespace: "example.com", Names: []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageName{{
+					Id: "3", Name: "app", Versions: []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion{{
+						Id: "4", Version: "1.0.0",
+					}},
+				}},
+			}}},
+			wantPurl: "pkg:golang/example.com/app@1.0.0",
+		},
+		{
+			name:    "no namespace",
+			pkg:     model.AllPkgTree{Id: "1", Type: "golang"},
+			wantErr: true,
+		},
+		{
+			name: "no name",
+			pkg: model.AllPkgTree{Id: "1", Type: "golang", Namespaces: []model.AllPkgTreeNamespacesPackageNamespace{{
+				Id: "2", Namespace: "example.com",
+			}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newPkgNode(tt.pkg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newPkgNode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.purl != tt.wantPurl {
+				t.Errorf("newPkgNode() purl = %q, want %q", got.purl, tt.wantPurl)
+			}
+		})
+	}
+}
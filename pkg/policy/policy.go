@@ -0,0 +1,162 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy evaluates declarative rules against the evidence that the
+// graph holds about a package or an artifact and its neighborhood.
+//
+// A policy is a YAML (or JSON) document listing named rules, each of exactly
+// one kind:
+//
+//	rules:
+//	  - name: built-on-trusted-builder
+//	    slsa:
+//	      minBuildLevel: 3
+//	      builders:
+//	        https://github.com/slsa-framework/slsa-github-generator/*: 3
+//	  - name: no-unmitigated-high-vulns
+//	    vulnerability:
+//	      maxScore: 7
+//	      transitive: true
+//	  - name: well-maintained-source
+//	    scorecard:
+//	      minScore: 6
+//	  - name: no-bad-dependencies
+//	    certifyBad:
+//	      transitive: true
+package policy
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Policy is a set of rules that must all pass for a subject to comply.
+type Policy struct {
+	Rules []Rule `yaml:"rules" json:"rules"`
+}
+
+// Rule is a named check. Exactly one of its kinds must be set.
+type Rule struct {
+	Name string `yaml:"name" json:"name"`
+
+	SLSA          *SLSARule          `yaml:"slsa,omitempty" json:"slsa,omitempty"`
+	Vulnerability *VulnerabilityRule `yaml:"vulnerability,omitempty" json:"vulnerability,omitempty"`
+	Scorecard     *ScorecardRule     `yaml:"scorecard,omitempty" json:"scorecard,omitempty"`
+	CertifyBad    *CertifyBadRule    `yaml:"certifyBad,omitempty" json:"certifyBad,omitempty"`
+}
+
+// SLSARule requires every artifact of the subject to have a HasSLSA
+// attestation from a builder trusted with at least MinBuildLevel.
+//
+// SLSA provenance does not record the build level, which is a property of the
+// builder, so the level of each trusted builder is given by Builders. A
+// builder URI ending with "*" matches every builder URI with that prefix.
+type SLSARule struct {
+	MinBuildLevel int            `yaml:"minBuildLevel" json:"minBuildLevel"`
+	Builders      map[string]int `yaml:"builders" json:"builders"`
+}
+
+// VulnerabilityRule forbids CertifyVulns with a score above MaxScore unless a
+// VEX statement says the product is not affected or fixed. The score of a
+// vulnerability is its highest VulnerabilityMetadata score, and
+// vulnerabilities without any score are taken to be above MaxScore.
+type VulnerabilityRule struct {
+	MaxScore float64 `yaml:"maxScore" json:"maxScore"`
+	// Transitive also checks the transitive dependencies of the subject.
+	Transitive bool `yaml:"transitive" json:"transitive"`
+}
+
+// ScorecardRule requires the source repositories of the subject to have a
+// latest OpenSSF Scorecard aggregate score of at least MinScore.
+type ScorecardRule struct {
+	MinScore float64 `yaml:"minScore" json:"minScore"`
+}
+
+// CertifyBadRule forbids CertifyBads on the subject, its artifacts and its
+// sources.
+type CertifyBadRule struct {
+	// Transitive also checks the transitive dependencies of the subject.
+	Transitive bool `yaml:"transitive" json:"transitive"`
+}
+
+// Load reads and validates a policy file.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy %s: %w", path, err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates a YAML or JSON policy.
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (p *Policy) validate() error {
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("policy has no rules")
+	}
+	names := map[string]bool{}
+	for i, r := range p.Rules {
+		if r.Name == "" {
+			return fmt.Errorf("rule %d has no name", i)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate rule name %q", r.Name)
+		}
+		names[r.Name] = true
+
+		kinds := 0
+		for _, set := range []bool{r.SLSA != nil, r.Vulnerability != nil, r.Scorecard != nil, r.CertifyBad != nil} {
+			if set {
+				kinds++
+			}
+		}
+		if kinds != 1 {
+			return fmt.Errorf("rule %q must have exactly one of slsa, vulnerability, scorecard or certifyBad", r.Name)
+		}
+		if r.SLSA != nil && len(r.SLSA.Builders) == 0 {
+			return fmt.Errorf("rule %q must list the build level of the trusted builders", r.Name)
+		}
+	}
+	return nil
+}
+
+// builderLevel returns the build level of a trusted builder, or 0 for a
+// builder that is not trusted.
+func (r *SLSARule) builderLevel(uri string) int {
+	if level, ok := r.Builders[uri]; ok {
+		return level
+	}
+	level := 0
+	for prefix, l := range r.Builders {
+		prefix, ok := strings.CutSuffix(prefix, "*")
+		if ok && strings.HasPrefix(uri, prefix) && l > level {
+			level = l
+		}
+	}
+	return level
+}
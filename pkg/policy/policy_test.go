@@ -0,0 +1,137 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    string
+		wantRules int
+		wantErr   bool
+	}{
+		{
+			name: "yaml",
+			policy: `
+rules:
+  - name: slsa
+    slsa:
+      minBuildLevel: 3
+      builders:
+        https://github.com/slsa-framework/*: 3
+  - name: vulns
+    vulnerability:
+      maxScore: 7
+      transitive: true
+  - name: scorecard
+    scorecard:
+      minScore: 6
+  - name: bad
+    certifyBad:
+      transitive: true
+`,
+			wantRules: 4,
+		},
+		{
+			name:      "json",
+			policy:    `{"rules": [{"name": "scorecard", "scorecard": {"minScore": 6}}]}`,
+			wantRules: 1,
+		},
+		{
+			name:    "no rules",
+			policy:  `rules: []`,
+			wantErr: true,
+		},
+		{
+			name: "unnamed rule",
+			policy: `
+rules:
+  - certifyBad: {}
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate names",
+			policy: `
+rules:
+  - name: bad
+    certifyBad: {}
+  - name: bad
+    certifyBad: {}
+`,
+			wantErr: true,
+		},
+		{
+			name: "two kinds",
+			policy: `
+rules:
+  - name: bad
+    certifyBad: {}
+    scorecard:
+      minScore: 6
+`,
+			wantErr: true,
+		},
+		{
+			name: "no trusted builders",
+			policy: `
+rules:
+  - name: slsa
+    slsa:
+      minBuildLevel: 3
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse([]byte(tt.policy))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(p.Rules) != tt.wantRules {
+				t.Errorf("Parse() got %d rules, want %d", len(p.Rules), tt.wantRules)
+			}
+		})
+	}
+}
+
+func TestBuilderLevel(t *testing.T) {
+	rule := &SLSARule{Builders: map[string]int{
+		"https://github.com/slsa-framework/*":                  2,
+		"https://github.com/slsa-framework/generator@v1":       3,
+		"https://github.com/slsa-framework/slsa-github-gen/*":  4,
+		"https://cloudbuild.googleapis.com/GoogleHostedWorker": 3,
+	}}
+	tests := []struct {
+		uri  string
+		want int
+	}{
+		{"https://github.com/slsa-framework/generator@v1", 3},
+		{"https://github.com/slsa-framework/slsa-github-gen/go@v2", 4},
+		{"https://github.com/slsa-framework/other", 2},
+		{"https://cloudbuild.googleapis.com/GoogleHostedWorker", 3},
+		{"https://example.com/builder", 0},
+	}
+	for _, tt := range tests {
+		if got := rule.builderLevel(tt.uri); got != tt.want {
+			t.Errorf("builderLevel(%s) = %d, want %d", tt.uri, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	clienthelpers "github.com/guacsec/guac/pkg/assembler/clients/helpers"
+)
+
+func (e *evaluator) evalSLSA(ctx context.Context, rule *SLSARule) (Result, error) {
+	if len(e.artifacts) == 0 {
+		return Result{Message: "no artifact found for the subject"}, nil
+	}
+	var attested, missing []string
+	for _, art := range e.artifacts {
+		neighbors, err := e.neighborsOf(ctx, art, model.EdgeArtifactHasSlsa)
+		if err != nil {
+			return Result{}, err
+		}
+		found := false
+		for _, n := range neighbors {
+			slsa, ok := n.(*model.NeighborsNeighborsHasSLSA)
+			// the artifact can also be a material of the build
+			if !ok || slsa.Subject.Id != art {
+				continue
+			}
+			if rule.builderLevel(slsa.Slsa.BuiltBy.Uri) >= rule.MinBuildLevel {
+				attested = append(attested, slsa.Id)
+				found = true
+			}
+		}
+		if !found {
+			missing = append(missing, art)
+		}
+	}
+	if len(missing) > 0 {
+		return Result{
+			Message:  fmt.Sprintf("%d of %d artifacts have no HasSLSA from a builder of build level %d or more", len(missing), len(e.artifacts), rule.MinBuildLevel),
+			Evidence: missing,
+		}, nil
+	}
+	return Result{
+		Passed:   true,
+		Message:  fmt.Sprintf("%d artifacts built by a builder of build level %d or more", len(e.artifacts), rule.MinBuildLevel),
+		Evidence: attested,
+	}, nil
+}
+
+func (e *evaluator) evalVulnerability(ctx context.Context, rule *VulnerabilityRule) (Result, error) {
+	pkgs, err := e.scope(ctx, rule.Transitive)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// VEX statements on the artifacts of the subject apply to all of its
+	// packages
+	artifactVEX := map[string]*model.NeighborsNeighborsCertifyVEXStatement{}
+	for _, art := range e.artifacts {
+		neighbors, err := e.neighborsOf(ctx, art, model.EdgeArtifactCertifyVexStatement)
+		if err != nil {
+			return Result{}, err
+		}
+		addLatestVEX(artifactVEX, neighbors)
+	}
+
+	var mitigated, unmitigated []string
+	scores := map[string]vulnScore{}
+	for _, p := range pkgs {
+		if p.versionID == "" {
+			continue
+		}
+		neighbors, err := e.neighborsOf(ctx, p.versionID, model.EdgePackageCertifyVuln, model.EdgePackageCertifyVexStatement)
+		if err != nil {
+			return Result{}, err
+		}
+		pkgVEX := map[string]*model.NeighborsNeighborsCertifyVEXStatement{}
+		addLatestVEX(pkgVEX, neighbors)
+
+		for _, n := range neighbors {
+			certifyVuln, ok := n.(*model.NeighborsNeighborsCertifyVuln)
+			if !ok || certifyVuln.Vulnerability.Type == noVulnType || len(certifyVuln.Vulnerability.VulnerabilityIDs) == 0 {
+				continue
+			}
+			vuln := certifyVuln.Vulnerability.VulnerabilityIDs[0]
+			score, ok := scores[vuln.Id]
+			if !ok {
+				score, err = e.vulnScore(ctx, certifyVuln.Vulnerability.Type, vuln.VulnerabilityID)
+				if err != nil {
+					return Result{}, err
+				}
+				scores[vuln.Id] = score
+			}
+			if score.known && score.value <= rule.MaxScore {
+				continue
+			}
+			if vex := latestVEX(pkgVEX[vuln.Id], artifactVEX[vuln.Id]); vex != nil &&
+				(vex.Status == model.VexStatusNotAffected || vex.Status == model.VexStatusFixed) {
+				mitigated = append(mitigated, certifyVuln.Id, vex.Id)
+				continue
+			}
+			unmitigated = append(unmitigated, certifyVuln.Id)
+			unmitigated = append(unmitigated, score.evidence...)
+		}
+	}
+
+	if len(unmitigated) > 0 {
+		return Result{
+			Message:  fmt.Sprintf("vulnerabilities scored above %.1f or unscored without a not affected or fixed VEX statement in %d packages", rule.MaxScore, len(pkgs)),
+			Evidence: unmitigated,
+		}, nil
+	}
+	return Result{
+		Passed:   true,
+		Message:  fmt.Sprintf("no vulnerability scored above %.1f without a VEX statement in %d packages", rule.MaxScore, len(pkgs)),
+		Evidence: mitigated,
+	}, nil
+}
+
+type vulnScore struct {
+	value    float64
+	known    bool
+	evidence []string
+}
+
+// vulnScore returns the highest score of a vulnerability.
+func (e *evaluator) vulnScore(ctx context.Context, vulnType, vulnID string) (vulnScore, error) {
+	vulnMetadata, err := clienthelpers.VulnerabilityMetadata(ctx, e.gqlClient, model.VulnerabilityMetadataSpec{
+		Vulnerability: &model.VulnerabilitySpec{Type: &vulnType, VulnerabilityID: &vulnID},
+	})
+	if err != nil {
+		return vulnScore{}, fmt.Errorf("error querying for metadata of vulnerability %s: %w", vulnID, err)
+	}
+	var score vulnScore
+	for _, m := range vulnMetadata {
+		if !score.known || m.ScoreValue > score.value {
+			score.value = m.ScoreValue
+			score.evidence = []string{m.Id}
+		}
+		score.known = true
+	}
+	return score, nil
+}
+
+// addLatestVEX keeps the latest VEX statement of each vulnerability ID.
+func addLatestVEX(statements map[string]*model.NeighborsNeighborsCertifyVEXStatement, neighbors []model.NeighborsNeighborsNode) {
+	for _, n := range neighbors {
+		vex, ok := n.(*model.NeighborsNeighborsCertifyVEXStatement)
+		if !ok {
+			continue
+		}
+		for _, vuln := range vex.Vulnerability.VulnerabilityIDs {
+			statements[vuln.Id] = latestVEX(statements[vuln.Id], vex)
+		}
+	}
+}
+
+func latestVEX(a, b *model.NeighborsNeighborsCertifyVEXStatement) *model.NeighborsNeighborsCertifyVEXStatement {
+	if a == nil || (b != nil && b.KnownSince.After(a.KnownSince)) {
+		return b
+	}
+	return a
+}
+
+func (e *evaluator) evalScorecard(ctx context.Context, rule *ScorecardRule) (Result, error) {
+	sources, err := e.sources(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(sources) == 0 {
+		return Result{Message: "no source repository found for the subject"}, nil
+	}
+
+	var passing, failing []string
+	for _, src := range sources {
+		neighbors, err := e.neighborsOf(ctx, src, model.EdgeSourceCertifyScorecard)
+		if err != nil {
+			return Result{}, err
+		}
+		var latest *model.NeighborsNeighborsCertifyScorecard
+		for _, n := range neighbors {
+			if scorecard, ok := n.(*model.NeighborsNeighborsCertifyScorecard); ok {
+				if latest == nil || scorecard.Scorecard.TimeScanned.After(latest.Scorecard.TimeScanned) {
+					latest = scorecard
+				}
+			}
+		}
+		switch {
+		case latest == nil:
+			failing = append(failing, src)
+		case latest.Scorecard.AggregateScore < rule.MinScore:
+			failing = append(failing, latest.Id)
+		default:
+			passing = append(passing, latest.Id)
+		}
+	}
+
+	if len(failing) > 0 {
+		return Result{
+			Message:  fmt.Sprintf("%d of %d sources have no scorecard or a score below %.1f", len(failing), len(sources), rule.MinScore),
+			Evidence: failing,
+		}, nil
+	}
+	return Result{
+		Passed:   true,
+		Message:  fmt.Sprintf("%d sources have a score of %.1f or more", len(sources), rule.MinScore),
+		Evidence: passing,
+	}, nil
+}
+
+// sources returns the IDs of the source names of the packages of the subject.
+func (e *evaluator) sources(ctx context.Context) ([]string, error) {
+	var sources []string
+	for _, p := range e.packages {
+		for _, id := range p.ids() {
+			neighbors, err := e.neighborsOf(ctx, id, model.EdgePackageHasSourceAt)
+			if err != nil {
+				return nil, err
+			}
+			for _, n := range neighbors {
+				hasSourceAt, ok := n.(*model.NeighborsNeighborsHasSourceAt)
+				if !ok {
+					continue
+				}
+				src := hasSourceAt.Source
+				if len(src.Namespaces) == 0 || len(src.Namespaces[0].Names) == 0 {
+					return nil, fmt.Errorf("source %s of HasSourceAt %s has no name", src.Id, hasSourceAt.Id)
+				}
+				sources = append(sources, src.Namespaces[0].Names[0].Id)
+			}
+		}
+	}
+	return sortAndRemoveDups(sources), nil
+}
+
+func (e *evaluator) evalCertifyBad(ctx context.Context, rule *CertifyBadRule) (Result, error) {
+	pkgs, err := e.scope(ctx, rule.Transitive)
+	if err != nil {
+		return Result{}, err
+	}
+	sources, err := e.sources(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	type subject struct {
+		id   string
+		edge model.Edge
+	}
+	var subjects []subject
+	for _, p := range pkgs {
+		for _, id := range p.ids() {
+			subjects = append(subjects, subject{id, model.EdgePackageCertifyBad})
+		}
+	}
+	for _, art := range e.artifacts {
+		subjects = append(subjects, subject{art, model.EdgeArtifactCertifyBad})
+	}
+	for _, src := range sources {
+		subjects = append(subjects, subject{src, model.EdgeSourceCertifyBad})
+	}
+
+	var bad []string
+	for _, s := range subjects {
+		neighbors, err := e.neighborsOf(ctx, s.id, s.edge)
+		if err != nil {
+			return Result{}, err
+		}
+		for _, n := range neighbors {
+			if certifyBad, ok := n.(*model.NeighborsNeighborsCertifyBad); ok {
+				bad = append(bad, certifyBad.Id)
+			}
+		}
+	}
+
+	if len(bad) > 0 {
+		return Result{
+			Message:  fmt.Sprintf("%d CertifyBad found", len(sortAndRemoveDups(bad))),
+			Evidence: bad,
+		}, nil
+	}
+	return Result{
+		Passed:  true,
+		Message: fmt.Sprintf("no CertifyBad on %d packages, %d artifacts and %d sources", len(pkgs), len(e.artifacts), len(sources)),
+	}, nil
+}